@@ -18,11 +18,13 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/applicationserver"
+	"go.thethings.network/lorawan-stack/pkg/retry"
 )
 
 // DefaultApplicationServerConfig is the default configuration for the Application Server.
 var DefaultApplicationServerConfig = applicationserver.Config{
-	LinkMode: "all",
+	LinkMode:            "all",
+	DeviceRegistryRetry: retry.DefaultConfig,
 	MQTT: applicationserver.MQTTConfig{
 		Listen:    ":1883",
 		ListenTLS: ":8883",
@@ -98,6 +98,14 @@ var DefaultRightsConfig = config.Rights{
 // DefaultKeyVaultConfig is the default config for key vaults.
 var DefaultKeyVaultConfig = config.KeyVault{}
 
+// DefaultRateLimitingConfig is the default config for rate limiting.
+var DefaultRateLimitingConfig = config.RateLimiting{
+	Provider: "memory",
+	Memory: config.RateLimitingMemory{
+		MaxSize: 1 << 17,
+	},
+}
+
 // DefaultServiceBase is the default base config for a service.
 var DefaultServiceBase = config.ServiceBase{
 	Base:             DefaultBaseConfig,
@@ -112,6 +120,7 @@ var DefaultServiceBase = config.ServiceBase{
 	DeviceRepository: DefaultDeviceRepositoryConfig,
 	Rights:           DefaultRightsConfig,
 	KeyVault:         DefaultKeyVaultConfig,
+	RateLimiting:     DefaultRateLimitingConfig,
 }
 
 // DefaultPublicURL is the default public URL where the stack is served.
@@ -15,13 +15,27 @@
 package shared
 
 import (
+	"time"
+
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
 )
 
 // DefaultGatewayServerConfig is the default configuration for the GatewayServer.
 var DefaultGatewayServerConfig = gatewayserver.Config{
-	RequireRegisteredGateways: false,
+	RequireRegisteredGateways:     false,
+	UpdateConnectionStatsInterval: 10 * time.Second,
+	History: gatewayserver.HistoryConfig{
+		Retention:       7 * 24 * time.Hour,
+		TrafficInterval: time.Minute,
+	},
+	Forward: gatewayserver.ForwardConfig{
+		Timeout: 5 * time.Second,
+	},
+	Emissions: gatewayserver.EmissionsConfig{
+		Enable:       true,
+		SyncInterval: 10 * time.Second,
+	},
 	UDP: gatewayserver.UDPConfig{
 		Config: udp.DefaultConfig,
 		Listeners: map[string]string{
@@ -48,7 +48,14 @@ func init() {
 	DefaultIdentityServerConfig.UserRegistration.PasswordRequirements.MinLength = 8
 	DefaultIdentityServerConfig.UserRegistration.PasswordRequirements.MinUppercase = 1
 	DefaultIdentityServerConfig.UserRegistration.PasswordRequirements.MinDigits = 1
+	DefaultIdentityServerConfig.Delete.PurgeAfter = 30 * 24 * time.Hour
 	DefaultIdentityServerConfig.ProfilePicture.Bucket = "profile_pictures"
+	DefaultIdentityServerConfig.APIKeys.ExpiryNotice = 7 * 24 * time.Hour
 	DefaultIdentityServerConfig.ProfilePicture.BucketURL = path.Join(shared.DefaultAssetsBaseURL, "blob", "profile_pictures")
 	DefaultIdentityServerConfig.ProfilePicture.UseGravatar = true
+	DefaultIdentityServerConfig.Email.SenderName = "The Things Network Stack for LoRaWAN"
+	DefaultIdentityServerConfig.Email.SenderAddress = "noreply@localhost"
+	DefaultIdentityServerConfig.Email.Network.Name = "The Things Network Stack for LoRaWAN"
+	DefaultIdentityServerConfig.Email.Network.IdentityServerURL = shared.DefaultOAuthPublicURL
+	DefaultIdentityServerConfig.Email.Network.ConsoleURL = shared.DefaultConsolePublicURL
 }
@@ -16,11 +16,13 @@ package joinserver
 
 import (
 	"go.thethings.network/lorawan-stack/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/pkg/retry"
 	"go.thethings.network/lorawan-stack/pkg/types"
 )
 
 // DefaultJoinServerConfig is the default configuration for the JoinServer
 var DefaultJoinServerConfig = joinserver.Config{
+	DeviceRegistryRetry: retry.DefaultConfig,
 	JoinEUIPrefixes: []*types.EUI64Prefix{
 		{},
 	},
@@ -18,10 +18,12 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/pkg/retry"
 )
 
 // DefaultNetworkServerConfig is the default configuration for the NetworkServer
 var DefaultNetworkServerConfig = networkserver.Config{
+	DeviceRegistryRetry: retry.DefaultConfig,
 	DeduplicationWindow: 200 * time.Millisecond,
 	CooldownWindow:      time.Second,
 	DownlinkPriorities: networkserver.DownlinkPriorityConfig{
@@ -29,4 +31,25 @@ var DefaultNetworkServerConfig = networkserver.Config{
 		MACCommands:            "highest",
 		MaxApplicationDownlink: "high",
 	},
+	DefaultMACSettings: networkserver.MACSettingConfig{
+		ADRMargin:     networkserver.DefaultADRMargin,
+		ADRAlgorithm:  networkserver.MarginADRAlgorithm,
+		ClassBTimeout: networkserver.DefaultClassBTimeout,
+		ClassCTimeout: networkserver.DefaultClassCTimeout,
+	},
+	DownlinkGatewaySelection: networkserver.DownlinkGatewaySelectionConfig{
+		Strategy:        networkserver.SignalDownlinkGatewaySelection,
+		TxFailureWindow: networkserver.DefaultTxFailureWindow,
+		Balanced: networkserver.BalancedDownlinkGatewayScore{
+			SNRWeight:           1,
+			RSSIWeight:          0.1,
+			UtilizationWeight:   10,
+			QueueDepthWeight:    1,
+			TxFailureWeight:     5,
+			LoadSpreadingMargin: 2,
+		},
+	},
+	PassiveRoaming: networkserver.PassiveRoamingConfig{
+		Timeout: networkserver.DefaultPassiveRoamingTimeout,
+	},
 }
@@ -18,6 +18,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
@@ -145,6 +146,10 @@ var (
 				return errNoApplicationID
 			}
 			name, _ := cmd.Flags().GetString("name")
+			expiresAt, allowedIPs, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			rights := getRights(cmd.Flags())
 			if len(rights) == 0 {
@@ -160,6 +165,8 @@ var (
 				ApplicationIdentifiers: *appID,
 				Name:                   name,
 				Rights:                 rights,
+				ExpiresAt:              expiresAt,
+				AllowedIPs:             allowedIPs,
 			})
 			if err != nil {
 				return err
@@ -187,6 +194,10 @@ var (
 				return errNoApplicationID
 			}
 			name, _ := cmd.Flags().GetString("name")
+			expiresAt, allowedIPs, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			rights := getRights(cmd.Flags())
 			if len(rights) == 0 {
@@ -200,10 +211,13 @@ var (
 			_, err = ttnpb.NewApplicationAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateApplicationAPIKeyRequest{
 				ApplicationIdentifiers: *appID,
 				APIKey: ttnpb.APIKey{
-					ID:     id,
-					Name:   name,
-					Rights: rights,
+					ID:         id,
+					Name:       name,
+					Rights:     rights,
+					ExpiresAt:  expiresAt,
+					AllowedIPs: allowedIPs,
 				},
+				FieldMask: types.FieldMask{Paths: getAPIKeyUpdatePaths(cmd.Flags())},
 			})
 			if err != nil {
 				return err
@@ -232,10 +246,12 @@ func init() {
 	applicationAPIKeys.AddCommand(applicationAPIKeysList)
 	applicationAPIKeysCreate.Flags().String("name", "", "")
 	applicationAPIKeysCreate.Flags().AddFlagSet(applicationRightsFlags)
+	applicationAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	applicationAPIKeys.AddCommand(applicationAPIKeysCreate)
 	applicationAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	applicationAPIKeysUpdate.Flags().String("name", "", "")
 	applicationAPIKeysUpdate.Flags().AddFlagSet(applicationRightsFlags)
+	applicationAPIKeysUpdate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	applicationAPIKeys.AddCommand(applicationAPIKeysUpdate)
 	applicationAPIKeys.PersistentFlags().AddFlagSet(applicationIDFlags())
 	applicationsCommand.AddCommand(applicationAPIKeys)
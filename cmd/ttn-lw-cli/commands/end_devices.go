@@ -160,6 +160,9 @@ var (
 			if err != nil {
 				return err
 			}
+			if stats, _ := cmd.Flags().GetBool("stats"); stats {
+				return getEndDeviceStats(*devID)
+			}
 			paths := util.SelectFieldMask(cmd.Flags(), selectEndDeviceFlags)
 			if len(paths) == 0 {
 				logger.Warnf("No fields selected, selecting %v", defaultGetPaths)
@@ -625,6 +628,7 @@ func init() {
 	endDevicesCommand.AddCommand(endDevicesListCommand)
 	endDevicesGetCommand.Flags().AddFlagSet(endDeviceIDFlags())
 	endDevicesGetCommand.Flags().AddFlagSet(selectEndDeviceFlags)
+	endDevicesGetCommand.Flags().Bool("stats", false, "get traffic statistics from the Network Server")
 	endDevicesCommand.AddCommand(endDevicesGetCommand)
 	endDevicesCreateCommand.Flags().AddFlagSet(endDeviceIDFlags())
 	endDevicesCreateCommand.Flags().AddFlagSet(setEndDeviceFlags)
@@ -652,6 +656,34 @@ func init() {
 	Root.AddCommand(endDevicesCommand)
 }
 
+func getEndDeviceStats(devID ttnpb.EndDeviceIdentifiers) error {
+	is, err := api.Dial(ctx, config.IdentityServerAddress)
+	if err != nil {
+		return err
+	}
+	device, err := ttnpb.NewEndDeviceRegistryClient(is).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: devID,
+		FieldMask:            pbtypes.FieldMask{Paths: []string{"network_server_address"}},
+	})
+	if err != nil {
+		return err
+	}
+
+	compareServerAddresses(device, config)
+
+	ns, err := api.Dial(ctx, config.NetworkServerAddress)
+	if err != nil {
+		return err
+	}
+	stats, err := ttnpb.NewNsEndDeviceRegistryClient(ns).GetEndDeviceStats(ctx, &ttnpb.GetEndDeviceStatsRequest{
+		EndDeviceIdentifiers: device.EndDeviceIdentifiers,
+	})
+	if err != nil {
+		return err
+	}
+	return io.Write(os.Stdout, config.OutputFormat, stats)
+}
+
 func compareServerAddresses(device *ttnpb.EndDevice, config *Config) {
 	if device.NetworkServerAddress != "" && device.NetworkServerAddress != config.NetworkServerAddress {
 		logger.WithFields(log.Fields(
@@ -17,6 +17,7 @@ package commands
 import (
 	"io/ioutil"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 	"go.thethings.network/lorawan-stack/pkg/errors"
@@ -108,6 +109,43 @@ func getAPIKeyID(flagSet *pflag.FlagSet, args []string) string {
 	return apiKeyID
 }
 
+func apiKeyRestrictionFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("expires-at", "", "expiry time of the API key (RFC3339)")
+	flagSet.StringSlice("allowed-ips", nil, "IP addresses or CIDR ranges from which the API key may be used")
+	return flagSet
+}
+
+var errInvalidExpiresAt = errors.DefineInvalidArgument("invalid_expires_at", "invalid expiry time `{expires_at}`")
+
+func getAPIKeyRestrictions(flagSet *pflag.FlagSet) (expiresAt *time.Time, allowedIPs []string, err error) {
+	if str, _ := flagSet.GetString("expires-at"); str != "" {
+		t, err := time.Parse(time.RFC3339, str)
+		if err != nil {
+			return nil, nil, errInvalidExpiresAt.WithCause(err).WithAttributes("expires_at", str)
+		}
+		expiresAt = &t
+	}
+	allowedIPs, _ = flagSet.GetStringSlice("allowed-ips")
+	return expiresAt, allowedIPs, nil
+}
+
+// getAPIKeyUpdatePaths returns the field mask paths of an API key update.
+// The rights are always updated, other fields only if their flags are set.
+func getAPIKeyUpdatePaths(flagSet *pflag.FlagSet) []string {
+	paths := []string{"rights"}
+	for flag, path := range map[string]string{
+		"name":        "name",
+		"expires-at":  "expires_at",
+		"allowed-ips": "allowed_ips",
+	} {
+		if flagSet.Changed(flag) {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
 func searchFlags() *pflag.FlagSet {
 	flagSet := &pflag.FlagSet{}
 	flagSet.String("id-contains", "", "")
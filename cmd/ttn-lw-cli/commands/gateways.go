@@ -317,6 +317,48 @@ var (
 			return nil
 		},
 	}
+	gatewaysRestoreCommand = &cobra.Command{
+		Use:   "restore",
+		Short: "Restore a deleted gateway",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewGatewayRegistryClient(is).Restore(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	gatewaysPurgeCommand = &cobra.Command{
+		Use:   "purge",
+		Short: "Purge a gateway (admin only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewGatewayRegistryClient(is).Purge(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
 	gatewaysConnectionStats = &cobra.Command{
 		Use:   "connection-stats",
 		Short: "Get connection stats for a gateway",
@@ -338,6 +380,33 @@ var (
 			return io.Write(os.Stdout, config.OutputFormat, res)
 		},
 	}
+	gatewaysListConnected = &cobra.Command{
+		Use:     "list-connected",
+		Aliases: []string{"ls-connected"},
+		Short:   "List gateways that are connected to the Gateway Server",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			frequencyPlanID, _ := cmd.Flags().GetString("frequency-plan-id")
+			protocol, _ := cmd.Flags().GetString("protocol")
+			limit, _ := cmd.Flags().GetUint32("limit")
+			page, _ := cmd.Flags().GetUint32("page")
+
+			gs, err := api.Dial(ctx, config.GatewayServerAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).ListConnectedGateways(ctx, &ttnpb.ListConnectedGatewaysRequest{
+				FrequencyPlanID: frequencyPlanID,
+				Protocol:        protocol,
+				Limit:           limit,
+				Page:            page,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Gateways)
+		},
+	}
 	gatewaysContactInfoCommand = contactInfoCommands("gateway", func(cmd *cobra.Command) (*ttnpb.EntityIdentifiers, error) {
 		gtwID, err := getGatewayID(cmd.Flags(), nil, true)
 		if err != nil {
@@ -373,8 +442,17 @@ func init() {
 	gatewaysCommand.AddCommand(gatewaysUpdateCommand)
 	gatewaysDeleteCommand.Flags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysDeleteCommand)
+	gatewaysRestoreCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCommand.AddCommand(gatewaysRestoreCommand)
+	gatewaysPurgeCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCommand.AddCommand(gatewaysPurgeCommand)
 	gatewaysConnectionStats.Flags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysConnectionStats)
+	gatewaysListConnected.Flags().String("frequency-plan-id", "", "only list gateways with this frequency plan ID")
+	gatewaysListConnected.Flags().String("protocol", "", "only list gateways connected with this protocol")
+	gatewaysListConnected.Flags().Uint32("limit", 0, "maximum number of gateways to list")
+	gatewaysListConnected.Flags().Uint32("page", 0, "page number, starting at 1")
+	gatewaysCommand.AddCommand(gatewaysListConnected)
 	gatewaysContactInfoCommand.PersistentFlags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysContactInfoCommand)
 	Root.AddCommand(gatewaysCommand)
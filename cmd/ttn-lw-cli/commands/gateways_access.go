@@ -18,6 +18,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
@@ -145,6 +146,10 @@ var (
 				return err
 			}
 			name, _ := cmd.Flags().GetString("name")
+			expiresAt, allowedIPs, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			rights := getRights(cmd.Flags())
 			if len(rights) == 0 {
@@ -160,6 +165,8 @@ var (
 				GatewayIdentifiers: *gtwID,
 				Name:               name,
 				Rights:             rights,
+				ExpiresAt:          expiresAt,
+				AllowedIPs:         allowedIPs,
 			})
 			if err != nil {
 				return err
@@ -187,6 +194,10 @@ var (
 				return err
 			}
 			name, _ := cmd.Flags().GetString("name")
+			expiresAt, allowedIPs, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			rights := getRights(cmd.Flags())
 			if len(rights) == 0 {
@@ -200,10 +211,13 @@ var (
 			_, err = ttnpb.NewGatewayAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateGatewayAPIKeyRequest{
 				GatewayIdentifiers: *gtwID,
 				APIKey: ttnpb.APIKey{
-					ID:     id,
-					Name:   name,
-					Rights: rights,
+					ID:         id,
+					Name:       name,
+					Rights:     rights,
+					ExpiresAt:  expiresAt,
+					AllowedIPs: allowedIPs,
 				},
+				FieldMask: types.FieldMask{Paths: getAPIKeyUpdatePaths(cmd.Flags())},
 			})
 			if err != nil {
 				return err
@@ -232,10 +246,12 @@ func init() {
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysList)
 	gatewayAPIKeysCreate.Flags().String("name", "", "")
 	gatewayAPIKeysCreate.Flags().AddFlagSet(gatewayRightsFlags)
+	gatewayAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysCreate)
 	gatewayAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	gatewayAPIKeysUpdate.Flags().String("name", "", "")
 	gatewayAPIKeysUpdate.Flags().AddFlagSet(gatewayRightsFlags)
+	gatewayAPIKeysUpdate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysUpdate)
 	gatewayAPIKeys.PersistentFlags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewayAPIKeys)
@@ -243,6 +243,48 @@ var (
 			return nil
 		},
 	}
+	organizationsRestoreCommand = &cobra.Command{
+		Use:   "restore",
+		Short: "Restore a deleted organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgID := getOrganizationID(cmd.Flags(), args)
+			if orgID == nil {
+				return errNoOrganizationID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewOrganizationRegistryClient(is).Restore(ctx, orgID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	organizationsPurgeCommand = &cobra.Command{
+		Use:   "purge",
+		Short: "Purge an organization (admin only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgID := getOrganizationID(cmd.Flags(), args)
+			if orgID == nil {
+				return errNoOrganizationID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewOrganizationRegistryClient(is).Purge(ctx, orgID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
 	organizationsContactInfoCommand = contactInfoCommands("organization", func(cmd *cobra.Command) (*ttnpb.EntityIdentifiers, error) {
 		orgID := getOrganizationID(cmd.Flags(), nil)
 		if orgID == nil {
@@ -273,6 +315,10 @@ func init() {
 	organizationsCommand.AddCommand(organizationsUpdateCommand)
 	organizationsDeleteCommand.Flags().AddFlagSet(organizationIDFlags())
 	organizationsCommand.AddCommand(organizationsDeleteCommand)
+	organizationsRestoreCommand.Flags().AddFlagSet(organizationIDFlags())
+	organizationsCommand.AddCommand(organizationsRestoreCommand)
+	organizationsPurgeCommand.Flags().AddFlagSet(organizationIDFlags())
+	organizationsCommand.AddCommand(organizationsPurgeCommand)
 	organizationsContactInfoCommand.PersistentFlags().AddFlagSet(organizationIDFlags())
 	organizationsCommand.AddCommand(organizationsContactInfoCommand)
 	Root.AddCommand(organizationsCommand)
@@ -18,6 +18,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
@@ -145,6 +146,10 @@ var (
 				return errNoOrganizationID
 			}
 			name, _ := cmd.Flags().GetString("name")
+			expiresAt, allowedIPs, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			rights := getRights(cmd.Flags())
 			if len(rights) == 0 {
@@ -160,6 +165,8 @@ var (
 				OrganizationIdentifiers: *orgID,
 				Name:                    name,
 				Rights:                  rights,
+				ExpiresAt:               expiresAt,
+				AllowedIPs:              allowedIPs,
 			})
 			if err != nil {
 				return err
@@ -187,6 +194,10 @@ var (
 				return errNoOrganizationID
 			}
 			name, _ := cmd.Flags().GetString("name")
+			expiresAt, allowedIPs, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			rights := getRights(cmd.Flags())
 			if len(rights) == 0 {
@@ -200,10 +211,13 @@ var (
 			_, err = ttnpb.NewOrganizationAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateOrganizationAPIKeyRequest{
 				OrganizationIdentifiers: *orgID,
 				APIKey: ttnpb.APIKey{
-					ID:     id,
-					Name:   name,
-					Rights: rights,
+					ID:         id,
+					Name:       name,
+					Rights:     rights,
+					ExpiresAt:  expiresAt,
+					AllowedIPs: allowedIPs,
 				},
+				FieldMask: types.FieldMask{Paths: getAPIKeyUpdatePaths(cmd.Flags())},
 			})
 			if err != nil {
 				return err
@@ -232,10 +246,12 @@ func init() {
 	organizationAPIKeys.AddCommand(organizationAPIKeysList)
 	organizationAPIKeysCreate.Flags().String("name", "", "")
 	organizationAPIKeysCreate.Flags().AddFlagSet(organizationRightsFlags)
+	organizationAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	organizationAPIKeys.AddCommand(organizationAPIKeysCreate)
 	organizationAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	organizationAPIKeysUpdate.Flags().String("name", "", "")
 	organizationAPIKeysUpdate.Flags().AddFlagSet(organizationRightsFlags)
+	organizationAPIKeysUpdate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	organizationAPIKeys.AddCommand(organizationAPIKeysUpdate)
 	organizationAPIKeys.PersistentFlags().AddFlagSet(organizationIDFlags())
 	organizationsCommand.AddCommand(organizationAPIKeys)
@@ -0,0 +1,200 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+func stateFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("state", "requested", "requested, approved, rejected, flagged or suspended")
+	return flagSet
+}
+
+var errInvalidState = errors.DefineInvalidArgument("invalid_state", "invalid state `{state}`")
+
+func getState(flagSet *pflag.FlagSet) (ttnpb.State, error) {
+	str, _ := flagSet.GetString("state")
+	state, ok := ttnpb.State_value["STATE_"+strings.ToUpper(str)]
+	if !ok {
+		return 0, errInvalidState.WithAttributes("state", str)
+	}
+	return ttnpb.State(state), nil
+}
+
+func getListEntitiesByStateRequest(flagSet *pflag.FlagSet, selectFlags *pflag.FlagSet) (*ttnpb.ListEntitiesByStateRequest, error) {
+	state, err := getState(flagSet)
+	if err != nil {
+		return nil, err
+	}
+	paths := util.SelectFieldMask(flagSet, selectFlags)
+	if len(paths) == 0 {
+		logger.Warnf("No fields selected, selecting %v", defaultGetPaths)
+		paths = append(paths, defaultGetPaths...)
+	}
+	req := &ttnpb.ListEntitiesByStateRequest{State: state}
+	req.FieldMask.Paths = append(paths, "state")
+	return req, nil
+}
+
+var (
+	reviewCommand = &cobra.Command{
+		Use:     "review",
+		Aliases: []string{"reviews"},
+		Short:   "Review commands (admin only)",
+	}
+	reviewUsersCommand = &cobra.Command{
+		Use:     "users",
+		Aliases: []string{"user", "usr", "u"},
+		Short:   "List users in a state",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := getListEntitiesByStateRequest(cmd.Flags(), selectUserFlags)
+			if err != nil {
+				return err
+			}
+			is, err := api.Dial(ctx, config.IdentityServerAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewEntityReviewRegistryClient(is).ListUsers(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Users)
+		},
+	}
+	reviewApplicationsCommand = &cobra.Command{
+		Use:     "applications",
+		Aliases: []string{"application", "apps", "app", "a"},
+		Short:   "List applications in a state",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := getListEntitiesByStateRequest(cmd.Flags(), selectApplicationFlags)
+			if err != nil {
+				return err
+			}
+			is, err := api.Dial(ctx, config.IdentityServerAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewEntityReviewRegistryClient(is).ListApplications(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Applications)
+		},
+	}
+	reviewOrganizationsCommand = &cobra.Command{
+		Use:     "organizations",
+		Aliases: []string{"organization", "orgs", "org", "o"},
+		Short:   "List organizations in a state",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := getListEntitiesByStateRequest(cmd.Flags(), selectOrganizationFlags)
+			if err != nil {
+				return err
+			}
+			is, err := api.Dial(ctx, config.IdentityServerAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewEntityReviewRegistryClient(is).ListOrganizations(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Organizations)
+		},
+	}
+	reviewSetCommand = &cobra.Command{
+		Use:   "set",
+		Short: "Set the state of users, applications, clients or organizations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids := getCombinedIdentifiers(cmd.Flags())
+			if len(ids.GetEntityIdentifiers()) == 0 {
+				return errNoIDs
+			}
+			state, err := getState(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			reason, _ := cmd.Flags().GetString("reason")
+
+			is, err := api.Dial(ctx, config.IdentityServerAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewEntityReviewRegistryClient(is).Review(ctx, &ttnpb.ReviewEntitiesRequest{
+				EntityIDs: ids.GetEntityIdentifiers(),
+				State:     state,
+				Reason:    reason,
+			})
+			return err
+		},
+	}
+	reviewHistoryCommand = &cobra.Command{
+		Use:   "history",
+		Short: "Get the review history of a user, application, client or organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids := getCombinedIdentifiers(cmd.Flags()).GetEntityIdentifiers()
+			if len(ids) == 0 {
+				return errNoIDs
+			}
+			if len(ids) > 1 {
+				logger.Warn("Multiple IDs set, considering only the first")
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewEntityReviewRegistryClient(is).GetReviewHistory(ctx, ids[0])
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Records)
+		},
+	}
+)
+
+func init() {
+	reviewUsersCommand.Flags().AddFlagSet(stateFlags())
+	reviewUsersCommand.Flags().AddFlagSet(selectUserFlags)
+	reviewCommand.AddCommand(reviewUsersCommand)
+	reviewApplicationsCommand.Flags().AddFlagSet(stateFlags())
+	reviewApplicationsCommand.Flags().AddFlagSet(selectApplicationFlags)
+	reviewCommand.AddCommand(reviewApplicationsCommand)
+	reviewOrganizationsCommand.Flags().AddFlagSet(stateFlags())
+	reviewOrganizationsCommand.Flags().AddFlagSet(selectOrganizationFlags)
+	reviewCommand.AddCommand(reviewOrganizationsCommand)
+	reviewSetCommand.Flags().AddFlagSet(combinedIdentifiersFlags())
+	reviewSetCommand.Flags().String("state", "", "approved, rejected, flagged, suspended or requested")
+	reviewSetCommand.Flags().String("reason", "", "")
+	reviewCommand.AddCommand(reviewSetCommand)
+	reviewHistoryCommand.Flags().AddFlagSet(combinedIdentifiersFlags())
+	reviewCommand.AddCommand(reviewHistoryCommand)
+	Root.AddCommand(reviewCommand)
+}
@@ -0,0 +1,726 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	stdio "io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"google.golang.org/grpc"
+)
+
+func simulateGatewayFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("gateway-api-key", "", "API key of the gateway (defaults to the CLI authentication)")
+	flagSet.Duration("downlink-timeout", 10*time.Second, "time to wait for downlink messages")
+	return flagSet
+}
+
+func simulateUplinkFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.Uint64("frequency", 868100000, "uplink frequency (Hz)")
+	flagSet.Uint32("bandwidth", 125000, "uplink LoRa bandwidth (Hz)")
+	flagSet.Uint32("spreading-factor", 7, "uplink LoRa spreading factor")
+	flagSet.String("coding-rate", "4/5", "uplink LoRa coding rate")
+	flagSet.Uint32("data-rate-index", 5, "uplink data rate index")
+	flagSet.Uint32("channel-index", 0, "uplink channel index")
+	flagSet.Float32("rssi", -42, "uplink RSSI (dBm)")
+	flagSet.Float32("snr", 4.2, "uplink SNR (dB)")
+	return flagSet
+}
+
+func simulateEndDeviceFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("lorawan-version", ttnpb.MAC_V1_0_2.String(), "LoRaWAN version of the end device")
+	flagSet.String("join-eui", "", "(hex)")
+	flagSet.String("dev-eui", "", "(hex)")
+	flagSet.String("dev-nonce", "", "DevNonce of the join-request (hex, defaults to random)")
+	flagSet.String("app-key", "", "(hex)")
+	flagSet.String("nwk-key", "", "LoRaWAN 1.1 and higher (hex)")
+	flagSet.String("dev-addr", "", "DevAddr of an activated end device, to skip the join (hex)")
+	flagSet.String("f-nwk-s-int-key", "", "NwkSKey in LoRaWAN 1.0.x (hex)")
+	flagSet.String("s-nwk-s-int-key", "", "LoRaWAN 1.1 and higher (hex)")
+	flagSet.String("nwk-s-enc-key", "", "LoRaWAN 1.1 and higher (hex)")
+	flagSet.String("app-s-key", "", "(hex)")
+	flagSet.Uint32("f-cnt", 0, "frame counter of the first uplink")
+	flagSet.Uint32("f-port", 1, "")
+	flagSet.String("frm-payload", "", "uplink application payload (hex)")
+	flagSet.Bool("confirmed", false, "send confirmed uplinks")
+	flagSet.Int("count", 1, "number of uplinks to send")
+	flagSet.Duration("interval", 10*time.Second, "interval between uplinks")
+	return flagSet
+}
+
+// simulatedGateway links to the Gateway Server as a gateway.
+type simulatedGateway struct {
+	ids   ttnpb.GatewayIdentifiers
+	conn  *grpc.ClientConn
+	start time.Time
+
+	sendMu sync.Mutex
+	link   ttnpb.GtwGs_LinkGatewayClient
+
+	downCh chan *ttnpb.DownlinkMessage
+	err    error
+}
+
+func linkSimulatedGateway(ctx context.Context, flagSet *pflag.FlagSet, ids ttnpb.GatewayIdentifiers) (*simulatedGateway, error) {
+	md := rpcmetadata.MD{
+		ID: ids.GatewayID,
+	}
+	if apiKey, _ := flagSet.GetString("gateway-api-key"); apiKey != "" {
+		md.AuthType, md.AuthValue = "Bearer", apiKey
+	}
+	conn, err := api.DialWithMetadata(ctx, config.GatewayServerAddress, md)
+	if err != nil {
+		return nil, err
+	}
+	link, err := ttnpb.NewGtwGsClient(conn).LinkGateway(ctx)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	gtw := &simulatedGateway{
+		ids:    ids,
+		conn:   conn,
+		start:  time.Now(),
+		link:   link,
+		downCh: make(chan *ttnpb.DownlinkMessage, 16),
+	}
+	go gtw.receive()
+	return gtw, nil
+}
+
+// receive receives downlink messages and acknowledges them, until the link is closed.
+func (gtw *simulatedGateway) receive() {
+	defer close(gtw.downCh)
+	for {
+		down, err := gtw.link.Recv()
+		if err != nil {
+			gtw.err = err
+			return
+		}
+		if down.DownlinkMessage == nil {
+			continue
+		}
+		if err := gtw.send(&ttnpb.GatewayUp{
+			TxAcknowledgment: &ttnpb.TxAcknowledgment{
+				CorrelationIDs: down.DownlinkMessage.CorrelationIDs,
+				Result:         ttnpb.TxAcknowledgment_SUCCESS,
+			},
+		}); err != nil {
+			logger.WithError(err).Warn("Failed to acknowledge downlink message")
+		}
+		gtw.downCh <- down.DownlinkMessage
+	}
+}
+
+func (gtw *simulatedGateway) send(up *ttnpb.GatewayUp) error {
+	gtw.sendMu.Lock()
+	defer gtw.sendMu.Unlock()
+	return gtw.link.Send(up)
+}
+
+// timestamp returns the concentrator timestamp of the gateway.
+func (gtw *simulatedGateway) timestamp() uint32 {
+	return uint32(time.Since(gtw.start) / time.Microsecond)
+}
+
+// sendUplink sends the uplink message, filling the reception metadata of the gateway if it is not set.
+func (gtw *simulatedGateway) sendUplink(up *ttnpb.UplinkMessage) error {
+	timestamp := gtw.timestamp()
+	if up.Settings.Timestamp == 0 {
+		up.Settings.Timestamp = timestamp
+	}
+	if len(up.RxMetadata) == 0 {
+		up.RxMetadata = []*ttnpb.RxMetadata{{}}
+	}
+	for _, md := range up.RxMetadata {
+		md.GatewayIdentifiers = gtw.ids
+		if md.Timestamp == 0 {
+			md.Timestamp = timestamp
+		}
+	}
+	return gtw.send(&ttnpb.GatewayUp{
+		UplinkMessages: []*ttnpb.UplinkMessage{up},
+	})
+}
+
+// awaitDownlink returns the next downlink message, or nil if there is none within the timeout.
+func (gtw *simulatedGateway) awaitDownlink(timeout time.Duration) (*ttnpb.DownlinkMessage, error) {
+	select {
+	case down, ok := <-gtw.downCh:
+		if !ok {
+			return nil, gtw.err
+		}
+		return down, nil
+	case <-time.After(timeout):
+		return nil, nil
+	}
+}
+
+func (gtw *simulatedGateway) Close() error {
+	gtw.link.CloseSend()
+	return gtw.conn.Close()
+}
+
+// simulatedEndDevice is a LoRaWAN end device that is simulated in the CLI.
+type simulatedEndDevice struct {
+	macVersion      ttnpb.MACVersion
+	joinEUI, devEUI types.EUI64
+	devNonce        types.DevNonce
+	appKey, nwkKey  types.AES128Key
+
+	devAddr                                       types.DevAddr
+	fNwkSIntKey, sNwkSIntKey, nwkSEncKey, appSKey types.AES128Key
+	fCnt                                          uint32
+
+	lastConfirmedFCntUp *uint32
+	ackFCntDown         *uint32
+}
+
+var (
+	errInvalidJoinAccept = errors.DefineInvalidArgument("invalid_join_accept", "invalid join-accept")
+	errInvalidMIC        = errors.DefineInvalidArgument("invalid_mic", "invalid MIC")
+	errNoJoinAccept      = errors.DefineUnavailable("no_join_accept", "no join-accept received")
+	errMissingFlag       = errors.DefineInvalidArgument("missing_flag", "missing flag `{flag}`")
+)
+
+func (dev *simulatedEndDevice) isLegacy() bool {
+	return dev.macVersion.Compare(ttnpb.MAC_V1_1) < 0
+}
+
+func (dev *simulatedEndDevice) joinRequest() ([]byte, error) {
+	b, err := lorawan.MarshalMessage(ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_JOIN_REQUEST,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_JoinRequestPayload{
+			JoinRequestPayload: &ttnpb.JoinRequestPayload{
+				JoinEUI:  dev.joinEUI,
+				DevEUI:   dev.devEUI,
+				DevNonce: dev.devNonce,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	key := dev.nwkKey
+	if dev.isLegacy() {
+		key = dev.appKey
+	}
+	mic, err := crypto.ComputeJoinRequestMIC(key, b)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, mic[:]...), nil
+}
+
+// handleJoinAccept decrypts and verifies the join-accept and derives the session.
+func (dev *simulatedEndDevice) handleJoinAccept(b []byte) (*ttnpb.JoinAcceptPayload, error) {
+	if n := len(b); n != 17 && n != 33 {
+		return nil, errInvalidJoinAccept
+	}
+	var mhdr ttnpb.MHDR
+	if err := lorawan.UnmarshalMHDR(b[0:1], &mhdr); err != nil || mhdr.MType != ttnpb.MType_JOIN_ACCEPT {
+		return nil, errInvalidJoinAccept
+	}
+	key := dev.nwkKey
+	if dev.isLegacy() {
+		key = dev.appKey
+	}
+	decrypted, err := crypto.DecryptJoinAccept(key, b[1:])
+	if err != nil {
+		return nil, err
+	}
+	payload, mic := decrypted[:len(decrypted)-4], decrypted[len(decrypted)-4:]
+	ja := &ttnpb.JoinAcceptPayload{}
+	if err := lorawan.UnmarshalJoinAcceptPayload(payload, ja); err != nil {
+		return nil, err
+	}
+	optNeg := !dev.isLegacy() && ja.OptNeg
+	var expectedMIC [4]byte
+	if optNeg {
+		jsIntKey := crypto.DeriveJSIntKey(dev.nwkKey, dev.devEUI)
+		expectedMIC, err = crypto.ComputeJoinAcceptMIC(jsIntKey, 0xff, dev.joinEUI, dev.devNonce, append(b[0:1:1], payload...))
+	} else {
+		expectedMIC, err = crypto.ComputeLegacyJoinAcceptMIC(key, append(b[0:1:1], payload...))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(expectedMIC[:], mic) {
+		return nil, errInvalidMIC
+	}
+	if optNeg {
+		dev.fNwkSIntKey = crypto.DeriveFNwkSIntKey(dev.nwkKey, ja.JoinNonce, dev.joinEUI, dev.devNonce)
+		dev.sNwkSIntKey = crypto.DeriveSNwkSIntKey(dev.nwkKey, ja.JoinNonce, dev.joinEUI, dev.devNonce)
+		dev.nwkSEncKey = crypto.DeriveNwkSEncKey(dev.nwkKey, ja.JoinNonce, dev.joinEUI, dev.devNonce)
+		dev.appSKey = crypto.DeriveAppSKey(dev.appKey, ja.JoinNonce, dev.joinEUI, dev.devNonce)
+	} else {
+		nwkSKey := crypto.DeriveLegacyNwkSKey(key, ja.JoinNonce, ja.NetID, dev.devNonce)
+		dev.fNwkSIntKey, dev.sNwkSIntKey, dev.nwkSEncKey = nwkSKey, nwkSKey, nwkSKey
+		dev.appSKey = crypto.DeriveLegacyAppSKey(key, ja.JoinNonce, ja.NetID, dev.devNonce)
+	}
+	dev.devAddr = ja.DevAddr
+	dev.fCnt = 0
+	dev.lastConfirmedFCntUp, dev.ackFCntDown = nil, nil
+	return ja, nil
+}
+
+func (dev *simulatedEndDevice) session() *ttnpb.Session {
+	return &ttnpb.Session{
+		DevAddr: dev.devAddr,
+		SessionKeys: ttnpb.SessionKeys{
+			FNwkSIntKey: &ttnpb.KeyEnvelope{Key: dev.fNwkSIntKey[:]},
+			SNwkSIntKey: &ttnpb.KeyEnvelope{Key: dev.sNwkSIntKey[:]},
+			NwkSEncKey:  &ttnpb.KeyEnvelope{Key: dev.nwkSEncKey[:]},
+			AppSKey:     &ttnpb.KeyEnvelope{Key: dev.appSKey[:]},
+		},
+	}
+}
+
+// dataUplink returns the next data uplink with the given payload and increments the frame counter.
+func (dev *simulatedEndDevice) dataUplink(confirmed bool, fPort uint32, frmPayload []byte, drIdx, chIdx uint8) ([]byte, error) {
+	mType := ttnpb.MType_UNCONFIRMED_UP
+	if confirmed {
+		mType = ttnpb.MType_CONFIRMED_UP
+	}
+	pld := &ttnpb.MACPayload{
+		FHDR: ttnpb.FHDR{
+			DevAddr: dev.devAddr,
+			FCtrl: ttnpb.FCtrl{
+				Ack: dev.ackFCntDown != nil,
+			},
+			FCnt: dev.fCnt,
+		},
+		FPort: fPort,
+	}
+	if len(frmPayload) > 0 {
+		key := dev.appSKey
+		if fPort == 0 {
+			key = dev.nwkSEncKey
+		}
+		encrypted, err := crypto.EncryptUplink(key, dev.devAddr, dev.fCnt, frmPayload)
+		if err != nil {
+			return nil, err
+		}
+		pld.FRMPayload = encrypted
+	}
+	b, err := lorawan.MarshalMessage(ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: mType,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_MACPayload{
+			MACPayload: pld,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var mic [4]byte
+	if dev.isLegacy() {
+		mic, err = crypto.ComputeLegacyUplinkMIC(dev.fNwkSIntKey, dev.devAddr, dev.fCnt, b)
+	} else {
+		var confFCnt uint32
+		if dev.ackFCntDown != nil {
+			confFCnt = *dev.ackFCntDown
+		}
+		mic, err = crypto.ComputeUplinkMIC(dev.sNwkSIntKey, dev.fNwkSIntKey, confFCnt, drIdx, chIdx, dev.devAddr, dev.fCnt, b)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if confirmed {
+		fCnt := dev.fCnt
+		dev.lastConfirmedFCntUp = &fCnt
+	} else {
+		dev.lastConfirmedFCntUp = nil
+	}
+	dev.ackFCntDown = nil
+	dev.fCnt++
+	return append(b, mic[:]...), nil
+}
+
+// handleDataDownlink verifies the data downlink and decrypts the FRMPayload.
+// Downlink messages for other end devices are ignored and nil is returned.
+func (dev *simulatedEndDevice) handleDataDownlink(b []byte) (*ttnpb.Message, error) {
+	msg := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(b, msg); err != nil {
+		return nil, err
+	}
+	pld := msg.GetMACPayload()
+	if pld == nil || pld.DevAddr != dev.devAddr {
+		return nil, nil
+	}
+	var (
+		mic [4]byte
+		err error
+	)
+	if dev.isLegacy() {
+		mic, err = crypto.ComputeLegacyDownlinkMIC(dev.sNwkSIntKey, dev.devAddr, pld.FCnt, b[:len(b)-4])
+	} else {
+		var confFCnt uint32
+		if pld.Ack && dev.lastConfirmedFCntUp != nil {
+			confFCnt = *dev.lastConfirmedFCntUp
+		}
+		mic, err = crypto.ComputeDownlinkMIC(dev.sNwkSIntKey, dev.devAddr, confFCnt, pld.FCnt, b[:len(b)-4])
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(mic[:], msg.MIC) {
+		return nil, errInvalidMIC
+	}
+	if len(pld.FRMPayload) > 0 {
+		key := dev.appSKey
+		if pld.FPort == 0 {
+			key = dev.nwkSEncKey
+		}
+		if pld.FRMPayload, err = crypto.DecryptDownlink(key, dev.devAddr, pld.FCnt, pld.FRMPayload); err != nil {
+			return nil, err
+		}
+	}
+	if msg.MType == ttnpb.MType_CONFIRMED_DOWN {
+		fCnt := pld.FCnt
+		dev.ackFCntDown = &fCnt
+	}
+	return msg, nil
+}
+
+func getSimulatedUplinkSettings(flagSet *pflag.FlagSet) ttnpb.TxSettings {
+	frequency, _ := flagSet.GetUint64("frequency")
+	bandwidth, _ := flagSet.GetUint32("bandwidth")
+	spreadingFactor, _ := flagSet.GetUint32("spreading-factor")
+	codingRate, _ := flagSet.GetString("coding-rate")
+	drIdx, _ := flagSet.GetUint32("data-rate-index")
+	return ttnpb.TxSettings{
+		DataRate: ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_LoRa{
+				LoRa: &ttnpb.LoRaDataRate{
+					Bandwidth:       bandwidth,
+					SpreadingFactor: spreadingFactor,
+				},
+			},
+		},
+		DataRateIndex: ttnpb.DataRateIndex(drIdx),
+		CodingRate:    codingRate,
+		Frequency:     frequency,
+		EnableCRC:     true,
+	}
+}
+
+func getSimulatedRxMetadata(flagSet *pflag.FlagSet) []*ttnpb.RxMetadata {
+	rssi, _ := flagSet.GetFloat32("rssi")
+	snr, _ := flagSet.GetFloat32("snr")
+	return []*ttnpb.RxMetadata{
+		{
+			RSSI:        rssi,
+			ChannelRSSI: rssi,
+			SNR:         snr,
+		},
+	}
+}
+
+func getHexFlag(flagSet *pflag.FlagSet, name string, dst interface{ UnmarshalText([]byte) error }) (bool, error) {
+	s, _ := flagSet.GetString(name)
+	if s == "" {
+		return false, nil
+	}
+	if err := dst.UnmarshalText([]byte(s)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func getSimulatedEndDevice(flagSet *pflag.FlagSet) (*simulatedEndDevice, bool, error) {
+	dev := &simulatedEndDevice{}
+	version, _ := flagSet.GetString("lorawan-version")
+	if err := dev.macVersion.UnmarshalText([]byte(version)); err != nil {
+		return nil, false, err
+	}
+	dev.fCnt, _ = flagSet.GetUint32("f-cnt")
+
+	activated, err := getHexFlag(flagSet, "dev-addr", &dev.devAddr)
+	if err != nil {
+		return nil, false, err
+	}
+	if activated {
+		var required []string
+		if dev.isLegacy() {
+			required = []string{"f-nwk-s-int-key", "app-s-key"}
+		} else {
+			required = []string{"f-nwk-s-int-key", "s-nwk-s-int-key", "nwk-s-enc-key", "app-s-key"}
+		}
+		for name, key := range map[string]*types.AES128Key{
+			"f-nwk-s-int-key": &dev.fNwkSIntKey,
+			"s-nwk-s-int-key": &dev.sNwkSIntKey,
+			"nwk-s-enc-key":   &dev.nwkSEncKey,
+			"app-s-key":       &dev.appSKey,
+		} {
+			if _, err := getHexFlag(flagSet, name, key); err != nil {
+				return nil, false, err
+			}
+		}
+		for _, name := range required {
+			if s, _ := flagSet.GetString(name); s == "" {
+				return nil, false, errMissingFlag.WithAttributes("flag", name)
+			}
+		}
+		if dev.isLegacy() {
+			dev.sNwkSIntKey, dev.nwkSEncKey = dev.fNwkSIntKey, dev.fNwkSIntKey
+		}
+		return dev, false, nil
+	}
+
+	required := []string{"join-eui", "dev-eui", "app-key"}
+	if !dev.isLegacy() {
+		required = append(required, "nwk-key")
+	}
+	for _, name := range required {
+		if s, _ := flagSet.GetString(name); s == "" {
+			return nil, false, errMissingFlag.WithAttributes("flag", name)
+		}
+	}
+	for name, dst := range map[string]interface{ UnmarshalText([]byte) error }{
+		"join-eui": &dev.joinEUI,
+		"dev-eui":  &dev.devEUI,
+		"app-key":  &dev.appKey,
+		"nwk-key":  &dev.nwkKey,
+	} {
+		if _, err := getHexFlag(flagSet, name, dst); err != nil {
+			return nil, false, err
+		}
+	}
+	if ok, err := getHexFlag(flagSet, "dev-nonce", &dev.devNonce); err != nil {
+		return nil, false, err
+	} else if !ok {
+		random.Read(dev.devNonce[:])
+	}
+	return dev, true, nil
+}
+
+var (
+	simulateCommand = &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate gateways and end devices",
+	}
+	simulateGatewayCommand = &cobra.Command{
+		Use:     "gateway",
+		Aliases: []string{"gtw", "g"},
+		Short:   "Simulate a gateway",
+		Long: `Simulate a gateway that links to the Gateway Server.
+Gateway uplink messages are read from stdin and forwarded to the Gateway Server.
+Downlink messages are acknowledged and written to stdout.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			gtw, err := linkSimulatedGateway(ctx, cmd.Flags(), *gtwID)
+			if err != nil {
+				return err
+			}
+			defer gtw.Close()
+
+			if inputDecoder != nil {
+				for {
+					var up ttnpb.GatewayUp
+					if _, err := inputDecoder.Decode(&up); err == stdio.EOF {
+						break
+					} else if err != nil {
+						return err
+					}
+					for _, msg := range up.UplinkMessages {
+						if err := gtw.sendUplink(msg); err != nil {
+							return err
+						}
+					}
+					if up.GatewayStatus != nil || up.TxAcknowledgment != nil {
+						if err := gtw.send(&ttnpb.GatewayUp{
+							GatewayStatus:    up.GatewayStatus,
+							TxAcknowledgment: up.TxAcknowledgment,
+						}); err != nil {
+							return err
+						}
+					}
+				}
+			}
+
+			if inputDecoder == nil {
+				// Without input, write downlink messages until the link is closed.
+				for down := range gtw.downCh {
+					if err := io.Write(os.Stdout, config.OutputFormat, down); err != nil {
+						return err
+					}
+				}
+				return gtw.err
+			}
+			timeout, _ := cmd.Flags().GetDuration("downlink-timeout")
+			for {
+				down, err := gtw.awaitDownlink(timeout)
+				if err != nil {
+					return err
+				}
+				if down == nil {
+					return nil
+				}
+				if err := io.Write(os.Stdout, config.OutputFormat, down); err != nil {
+					return err
+				}
+			}
+		},
+	}
+	simulateEndDeviceCommand = &cobra.Command{
+		Use:     "end-device",
+		Aliases: []string{"end-devices", "device", "dev", "ed", "d"},
+		Short:   "Simulate an end device",
+		Long: `Simulate an end device that sends uplink messages through a simulated gateway.
+If no DevAddr is given, the end device joins the network first. The received
+join-accept and downlink messages are verified, decrypted and written to stdout.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), nil, true)
+			if err != nil {
+				return err
+			}
+			dev, join, err := getSimulatedEndDevice(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			fPort, _ := cmd.Flags().GetUint32("f-port")
+			var frmPayload []byte
+			if s, _ := cmd.Flags().GetString("frm-payload"); s != "" {
+				if frmPayload, err = hex.DecodeString(s); err != nil {
+					return err
+				}
+			}
+			confirmed, _ := cmd.Flags().GetBool("confirmed")
+			count, _ := cmd.Flags().GetInt("count")
+			interval, _ := cmd.Flags().GetDuration("interval")
+			timeout, _ := cmd.Flags().GetDuration("downlink-timeout")
+			drIdx, _ := cmd.Flags().GetUint32("data-rate-index")
+			chIdx, _ := cmd.Flags().GetUint32("channel-index")
+
+			gtw, err := linkSimulatedGateway(ctx, cmd.Flags(), *gtwID)
+			if err != nil {
+				return err
+			}
+			defer gtw.Close()
+
+			sendUplink := func(b []byte) error {
+				return gtw.sendUplink(&ttnpb.UplinkMessage{
+					RawPayload: b,
+					Settings:   getSimulatedUplinkSettings(cmd.Flags()),
+					RxMetadata: getSimulatedRxMetadata(cmd.Flags()),
+				})
+			}
+
+			if join {
+				b, err := dev.joinRequest()
+				if err != nil {
+					return err
+				}
+				logger.WithField("dev_nonce", dev.devNonce).Info("Send join-request")
+				if err := sendUplink(b); err != nil {
+					return err
+				}
+				var ja *ttnpb.JoinAcceptPayload
+				for ja == nil {
+					down, err := gtw.awaitDownlink(timeout)
+					if err != nil {
+						return err
+					}
+					if down == nil {
+						return errNoJoinAccept
+					}
+					if ja, err = dev.handleJoinAccept(down.RawPayload); err != nil {
+						logger.WithError(err).Debug("Ignore downlink message")
+					}
+				}
+				logger.WithField("dev_addr", dev.devAddr).Info("Joined")
+				if err := io.Write(os.Stdout, config.OutputFormat, dev.session()); err != nil {
+					return err
+				}
+			}
+
+			for i := 0; i < count; i++ {
+				if i > 0 {
+					time.Sleep(interval)
+				}
+				b, err := dev.dataUplink(confirmed, fPort, frmPayload, uint8(drIdx), uint8(chIdx))
+				if err != nil {
+					return err
+				}
+				logger.WithField("f_cnt", dev.fCnt-1).Info("Send data uplink")
+				if err := sendUplink(b); err != nil {
+					return err
+				}
+				for {
+					down, err := gtw.awaitDownlink(timeout)
+					if err != nil {
+						return err
+					}
+					if down == nil {
+						break
+					}
+					msg, err := dev.handleDataDownlink(down.RawPayload)
+					if err != nil {
+						logger.WithError(err).Warn("Invalid downlink message")
+						continue
+					}
+					if msg == nil {
+						continue
+					}
+					if err := io.Write(os.Stdout, config.OutputFormat, msg); err != nil {
+						return err
+					}
+					break
+				}
+			}
+			return nil
+		},
+	}
+)
+
+func init() {
+	simulateGatewayCommand.Flags().AddFlagSet(gatewayIDFlags())
+	simulateGatewayCommand.Flags().AddFlagSet(simulateGatewayFlags())
+	simulateCommand.AddCommand(simulateGatewayCommand)
+	simulateEndDeviceCommand.Flags().AddFlagSet(gatewayIDFlags())
+	simulateEndDeviceCommand.Flags().AddFlagSet(simulateGatewayFlags())
+	simulateEndDeviceCommand.Flags().AddFlagSet(simulateUplinkFlags())
+	simulateEndDeviceCommand.Flags().AddFlagSet(simulateEndDeviceFlags())
+	simulateCommand.AddCommand(simulateEndDeviceCommand)
+	Root.AddCommand(simulateCommand)
+}
@@ -18,6 +18,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
@@ -83,6 +84,10 @@ var (
 				return errNoUserID
 			}
 			name, _ := cmd.Flags().GetString("name")
+			expiresAt, allowedIPs, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			rights := getRights(cmd.Flags())
 			if len(rights) == 0 {
@@ -98,6 +103,8 @@ var (
 				UserIdentifiers: *usrID,
 				Name:            name,
 				Rights:          rights,
+				ExpiresAt:       expiresAt,
+				AllowedIPs:      allowedIPs,
 			})
 			if err != nil {
 				return err
@@ -125,6 +132,10 @@ var (
 				return errNoUserID
 			}
 			name, _ := cmd.Flags().GetString("name")
+			expiresAt, allowedIPs, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			rights := getRights(cmd.Flags())
 			if len(rights) == 0 {
@@ -138,10 +149,13 @@ var (
 			_, err = ttnpb.NewUserAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateUserAPIKeyRequest{
 				UserIdentifiers: *usrID,
 				APIKey: ttnpb.APIKey{
-					ID:     id,
-					Name:   name,
-					Rights: rights,
+					ID:         id,
+					Name:       name,
+					Rights:     rights,
+					ExpiresAt:  expiresAt,
+					AllowedIPs: allowedIPs,
 				},
+				FieldMask: types.FieldMask{Paths: getAPIKeyUpdatePaths(cmd.Flags())},
 			})
 			if err != nil {
 				return err
@@ -163,10 +177,12 @@ func init() {
 	userAPIKeys.AddCommand(userAPIKeysList)
 	userAPIKeysCreate.Flags().String("name", "", "")
 	userAPIKeysCreate.Flags().AddFlagSet(userRightsFlags)
+	userAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	userAPIKeys.AddCommand(userAPIKeysCreate)
 	userAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	userAPIKeysUpdate.Flags().String("name", "", "")
 	userAPIKeysUpdate.Flags().AddFlagSet(userRightsFlags)
+	userAPIKeysUpdate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	userAPIKeys.AddCommand(userAPIKeysUpdate)
 	userAPIKeys.PersistentFlags().AddFlagSet(userIDFlags())
 	usersCommand.AddCommand(userAPIKeys)
@@ -112,6 +112,25 @@ func Dial(ctx context.Context, target string) (*grpc.ClientConn, error) {
 	return conn, nil
 }
 
+// DialWithMetadata dials the target with the given request metadata. If the metadata does not contain authentication,
+// the configured authentication is used. The connection is not shared and must be closed by the caller.
+func DialWithMetadata(ctx context.Context, target string, md rpcmetadata.MD) (*grpc.ClientConn, error) {
+	if md.AuthType == "" && auth != nil {
+		md.AuthType, md.AuthValue = auth.AuthType, auth.AuthValue
+	}
+	opts := rpcclient.DefaultDialOptions(ctx)
+	if withInsecure {
+		md.AllowInsecure = true
+		opts = append(opts, grpc.WithInsecure())
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	}
+	if md.AuthType != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(md))
+	}
+	return grpc.DialContext(ctx, target, opts...)
+}
+
 func dialContext(ctx context.Context, target string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	opts = append(append(rpcclient.DefaultDialOptions(ctx), GetDialOptions()...), opts...)
 	return grpc.DialContext(ctx, target, opts...)
@@ -29,6 +29,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/console"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
+	gsredis "go.thethings.network/lorawan-stack/pkg/gatewayserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/identityserver"
 	"go.thethings.network/lorawan-stack/pkg/joinserver"
 	jsredis "go.thethings.network/lorawan-stack/pkg/joinserver/redis"
@@ -109,6 +110,20 @@ var (
 
 			if start.GatewayServer || startDefault {
 				logger.Info("Setting up Gateway Server")
+				if config.GS.Emissions.Enable {
+					config.GS.Emissions.Registry = &gsredis.EmissionRegistry{Redis: redis.New(&redis.Config{
+						Redis:     config.Redis,
+						Namespace: []string{"gs", "emissions"},
+					})}
+				}
+				config.GS.ConnectionStats = &gsredis.GatewayConnectionStatsRegistry{Redis: redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"gs", "connection", "stats"},
+				})}
+				config.GS.History.Registry = &gsredis.GatewayStatusHistoryRegistry{Redis: redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"gs", "history"},
+				})}
 				gs, err := gatewayserver.New(c, &config.GS)
 				if err != nil {
 					return shared.ErrInitializeGatewayServer.WithCause(err)
@@ -118,10 +133,14 @@ var (
 
 			if start.NetworkServer || startDefault {
 				logger.Info("Setting up Network Server")
-				config.NS.Devices = &nsredis.DeviceRegistry{Redis: redis.New(&redis.Config{
+				nsDevices := &nsredis.DeviceRegistry{Redis: redis.New(&redis.Config{
 					Redis:     config.Redis,
 					Namespace: []string{"ns", "devices"},
 				})}
+				if err := nsDevices.Init(); err != nil {
+					return shared.ErrInitializeNetworkServer.WithCause(err)
+				}
+				config.NS.Devices = nsDevices
 				nsDownlinkTasks := nsredis.NewDownlinkTaskQueue(redis.New(&redis.Config{
 					Redis:     config.Redis,
 					Namespace: []string{"ns", "tasks"},
@@ -143,10 +162,14 @@ var (
 					Redis:     config.Redis,
 					Namespace: []string{"as", "links"},
 				})}
-				config.AS.Devices = &asredis.DeviceRegistry{Redis: redis.New(&redis.Config{
+				asDevices := &asredis.DeviceRegistry{Redis: redis.New(&redis.Config{
 					Redis:     config.Redis,
 					Namespace: []string{"as", "devices"},
 				})}
+				if err := asDevices.Init(); err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				config.AS.Devices = asDevices
 				if config.AS.Webhooks.Target != "" {
 					config.AS.Webhooks.Registry = &asiowebredis.WebhookRegistry{Redis: redis.New(&redis.Config{
 						Redis:     config.Redis,
@@ -162,10 +185,14 @@ var (
 
 			if start.JoinServer || startDefault {
 				logger.Info("Setting up Join Server")
-				config.JS.Devices = &jsredis.DeviceRegistry{Redis: redis.New(&redis.Config{
+				jsDevices := &jsredis.DeviceRegistry{Redis: redis.New(&redis.Config{
 					Redis:     config.Redis,
 					Namespace: []string{"js", "devices"},
 				})}
+				if err := jsDevices.Init(); err != nil {
+					return shared.ErrInitializeJoinServer.WithCause(err)
+				}
+				config.JS.Devices = jsDevices
 				config.JS.Keys = &jsredis.KeyRegistry{Redis: redis.New(&redis.Config{
 					Redis:     config.Redis,
 					Namespace: []string{"js", "keys"},
@@ -29,6 +29,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
@@ -38,6 +39,8 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors/cayennelpp"
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors/javascript"
+	"go.thethings.network/lorawan-stack/pkg/retry"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 	"google.golang.org/grpc"
@@ -52,6 +55,7 @@ type ApplicationServer struct {
 	linkMode       LinkMode
 	linkRegistry   LinkRegistry
 	deviceRegistry DeviceRegistry
+	deviceRetry    retry.Config
 	formatter      payloadFormatter
 	webhooks       web.Webhooks
 
@@ -77,6 +81,7 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		linkMode:       linkMode,
 		linkRegistry:   conf.Links,
 		deviceRegistry: conf.Devices,
+		deviceRetry:    conf.DeviceRegistryRetry.WithDefaults(),
 		formatter: payloadFormatter{
 			repository: c.GetBaseConfig(c.Context()).DeviceRepository.Client(),
 			upFormatters: map[ttnpb.PayloadFormatter]messageprocessors.PayloadDecoder{
@@ -149,10 +154,15 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		c.RegisterWeb(webhooks)
 	}
 
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsEndDeviceRegistry", cluster.HookName, c.ClusterAuthUnaryHook())
+
 	c.RegisterGRPC(as)
 	if as.linkMode == LinkAll {
 		c.RegisterTask("link_all", as.linkAll, component.TaskRestartOnFailure)
 	}
+	if conf.DeviceReconciliationInterval > 0 {
+		c.RegisterTask("reconcile_devices", as.reconcileDevices(conf.DeviceReconciliationInterval), component.TaskRestartOnFailure)
+	}
 	return as, nil
 }
 
@@ -160,6 +170,7 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterAsServer(s, as)
 	ttnpb.RegisterAsEndDeviceRegistryServer(s, &deviceRegistryRPC{
+		as:       as,
 		registry: as.deviceRegistry,
 	})
 	ttnpb.RegisterAppAsServer(s, iogrpc.New(as))
@@ -229,7 +240,12 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 		return err
 	}
 	<-link.connReady
-	_, err = as.deviceRegistry.Set(ctx, ids,
+	// forwarded is set when the items are forwarded to the Network Server in an attempt of the transaction below.
+	// If the transaction is retried, the items are not forwarded again.
+	var forwarded bool
+	var sessionKeyID []byte
+	var lastAFCntDown uint32
+	_, err = SetDeviceWithRetry(ctx, as.deviceRegistry, as.deviceRetry, ids,
 		[]string{
 			"session",
 			"formatters",
@@ -242,6 +258,14 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 			if dev.Session == nil {
 				return nil, nil, errNoDeviceSession
 			}
+			if forwarded {
+				// The items were forwarded in an attempt that was aborted due to a concurrent modification of the device.
+				// Only record the frame counter that the items were encrypted with.
+				if bytes.Equal(dev.Session.SessionKeyID, sessionKeyID) && dev.Session.LastAFCntDown < lastAFCntDown {
+					dev.Session.LastAFCntDown = lastAFCntDown
+				}
+				return dev, []string{"session.last_a_f_cnt_down"}, nil
+			}
 			for _, item := range items {
 				registerReceiveDownlink(ctx, ids, item)
 				item.SessionKeyID = dev.Session.SessionKeyID
@@ -267,9 +291,7 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 				}
 				return nil, nil, err
 			}
-			for _, item := range items {
-				registerForwardDownlink(ctx, ids, item, link.connName)
-			}
+			forwarded, sessionKeyID, lastAFCntDown = true, dev.Session.SessionKeyID, dev.Session.LastAFCntDown
 			return dev, []string{"session.last_a_f_cnt_down"}, nil
 		},
 	)
@@ -383,7 +405,7 @@ func (as *ApplicationServer) handleJoinAccept(ctx context.Context, ids ttnpb.End
 		"dev_eui", ids.DevEUI,
 		"session_key_id", joinAccept.SessionKeyID,
 	))
-	_, err := as.deviceRegistry.Set(ctx, ids,
+	_, err := SetDeviceWithRetry(ctx, as.deviceRegistry, as.deviceRetry, ids,
 		[]string{
 			"session",
 			"pending_session",
@@ -443,7 +465,7 @@ func (as *ApplicationServer) handleJoinAccept(ctx context.Context, ids ttnpb.End
 func (as *ApplicationServer) handleUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, uplink *ttnpb.ApplicationUplink, link *link) error {
 	ctx = log.NewContextWithField(ctx, "session_key_id", uplink.SessionKeyID)
 	logger := log.FromContext(ctx)
-	dev, err := as.deviceRegistry.Set(ctx, ids,
+	dev, err := SetDeviceWithRetry(ctx, as.deviceRegistry, as.deviceRetry, ids,
 		[]string{
 			"session",
 			"pending_session",
@@ -516,7 +538,7 @@ func (as *ApplicationServer) handleUplink(ctx context.Context, ids ttnpb.EndDevi
 }
 
 func (as *ApplicationServer) handleDownlinkQueueInvalidated(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, invalid *ttnpb.ApplicationInvalidatedDownlinks, link *link) error {
-	_, err := as.deviceRegistry.Set(ctx, ids,
+	_, err := SetDeviceWithRetry(ctx, as.deviceRegistry, as.deviceRetry, ids,
 		[]string{
 			"session",
 		},
@@ -544,7 +566,7 @@ func (as *ApplicationServer) handleDownlinkNack(ctx context.Context, ids ttnpb.E
 		registerDropDownlink(ctx, ids, msg, err)
 	} else {
 		queue := append([]*ttnpb.ApplicationDownlink{msg}, res.Downlinks...)
-		_, err := as.deviceRegistry.Set(ctx, ids,
+		_, err := SetDeviceWithRetry(ctx, as.deviceRegistry, as.deviceRetry, ids,
 			[]string{
 				"session",
 			},
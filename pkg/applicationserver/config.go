@@ -23,6 +23,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/retry"
 )
 
 // LinkMode defines how applications are linked to their Network Server.
@@ -37,11 +38,13 @@ const (
 
 // Config represents the ApplicationServer configuration.
 type Config struct {
-	LinkMode string         `name:"link-mode" description:"Mode to link applications to their Network Server (all, explicit)"`
-	Devices  DeviceRegistry `name:"-"`
-	Links    LinkRegistry   `name:"-"`
-	MQTT     MQTTConfig     `name:"mqtt" description:"MQTT configuration"`
-	Webhooks WebhooksConfig `name:"webhooks" description:"Webhooks configuration"`
+	LinkMode                     string         `name:"link-mode" description:"Mode to link applications to their Network Server (all, explicit)"`
+	Devices                      DeviceRegistry `name:"-"`
+	DeviceRegistryRetry          retry.Config   `name:"device-registry-retry" description:"Retries of device registry transactions aborted due to concurrent modifications"`
+	Links                        LinkRegistry   `name:"-"`
+	MQTT                         MQTTConfig     `name:"mqtt" description:"MQTT configuration"`
+	Webhooks                     WebhooksConfig `name:"webhooks" description:"Webhooks configuration"`
+	DeviceReconciliationInterval time.Duration  `name:"device-reconciliation-interval" description:"Interval in which end devices that no longer exist in the Entity Registry are deleted (0 means never)"`
 }
 
 var errLinkMode = errors.DefineInvalidArgument("link_mode", "invalid link mode `{value}`")
@@ -18,11 +18,13 @@ import (
 	"context"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
 type deviceRegistryRPC struct {
+	as       *ApplicationServer
 	registry DeviceRegistry
 }
 
@@ -48,7 +50,16 @@ func (r *deviceRegistryRPC) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequ
 
 // Delete implements ttnpb.AsEndDeviceRegistryServer.
 func (r *deviceRegistryRPC) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
-	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+	// The Identity Server deletes end devices from the cluster when they are deleted from the Entity Registry.
+	// Cluster peers can therefore only delete end devices that no longer exist in the Entity Registry.
+	if clusterauth.HasAuth(ctx) {
+		if err := clusterauth.Authorized(ctx); err != nil {
+			return nil, err
+		}
+		if err := r.as.requireDeletedFromEntityRegistry(ctx, *ids); err != nil {
+			return nil, err
+		}
+	} else if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
 	// TODO: Validate field mask (https://github.com/TheThingsNetwork/lorawan-stack/issues/39)
@@ -0,0 +1,155 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	errEntityRegistryNotFound = errors.DefineNotFound("entity_registry_not_found", "Entity Registry not found")
+	errDeviceRegistered       = errors.DefineFailedPrecondition("device_registered", "device `{device_uid}` is registered in the Entity Registry")
+	errIncompleteListing      = errors.DefineUnavailable("incomplete_listing", "listed {listed} of {total} end devices")
+)
+
+// listRegisteredDevices lists the IDs of the end devices of the application in the Entity Registry.
+// The listing is only returned if it is complete, so that end devices are not considered orphaned
+// because they are missing from a partial listing.
+func (as *ApplicationServer) listRegisteredDevices(ctx context.Context, client ttnpb.EndDeviceRegistryClient, appIDs ttnpb.ApplicationIdentifiers) (map[string]struct{}, error) {
+	var header metadata.MD
+	res, err := client.List(ctx, &ttnpb.ListEndDevicesRequest{
+		ApplicationIdentifiers: appIDs,
+		FieldMask:              pbtypes.FieldMask{Paths: []string{"ids"}},
+	}, as.WithClusterAuth(), grpc.Header(&header))
+	if err != nil {
+		return nil, err
+	}
+	if totalHeader := header.Get("x-total-count"); len(totalHeader) > 0 {
+		total, err := strconv.ParseUint(totalHeader[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(res.EndDevices)) != total {
+			return nil, errIncompleteListing.WithAttributes("listed", len(res.EndDevices), "total", total)
+		}
+	}
+	registered := make(map[string]struct{}, len(res.EndDevices))
+	for _, dev := range res.EndDevices {
+		registered[dev.DeviceID] = struct{}{}
+	}
+	return registered, nil
+}
+
+// requireDeletedFromEntityRegistry returns an error if the end device still exists in the Entity Registry.
+func (as *ApplicationServer) requireDeletedFromEntityRegistry(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error {
+	er := as.GetPeer(ctx, ttnpb.PeerInfo_ENTITY_REGISTRY, nil)
+	if er == nil {
+		return errEntityRegistryNotFound
+	}
+	_, err := ttnpb.NewEndDeviceRegistryClient(er.Conn()).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+		FieldMask:            pbtypes.FieldMask{Paths: []string{"ids"}},
+	}, as.WithClusterAuth())
+	if err == nil {
+		return errDeviceRegistered.WithAttributes("device_uid", unique.ID(ctx, ids))
+	}
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// deleteOrphanedDevices deletes the end devices from the device registry that are no longer
+// in the Entity Registry, for instance because the Application Server was unavailable when
+// the end device or its application got deleted.
+// End devices are only considered orphaned if they are missing from a complete listing of the end devices
+// of the application, and they are only deleted after the Entity Registry confirms that they do not exist.
+func (as *ApplicationServer) deleteOrphanedDevices(ctx context.Context) error {
+	er := as.GetPeer(ctx, ttnpb.PeerInfo_ENTITY_REGISTRY, nil)
+	if er == nil {
+		return errEntityRegistryNotFound
+	}
+	logger := log.FromContext(ctx)
+
+	devices := make(map[ttnpb.ApplicationIdentifiers][]ttnpb.EndDeviceIdentifiers)
+	err := as.deviceRegistry.Range(ctx, []string{"ids"}, func(dev *ttnpb.EndDevice) bool {
+		devices[dev.ApplicationIdentifiers] = append(devices[dev.ApplicationIdentifiers], dev.EndDeviceIdentifiers)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	client := ttnpb.NewEndDeviceRegistryClient(er.Conn())
+	for appIDs, devIDs := range devices {
+		logger := logger.WithField("application_uid", unique.ID(ctx, appIDs))
+		registered, err := as.listRegisteredDevices(ctx, client, appIDs)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to list end devices in Entity Registry")
+			continue
+		}
+		var orphaned []ttnpb.EndDeviceIdentifiers
+		for _, ids := range devIDs {
+			if _, ok := registered[ids.DeviceID]; !ok {
+				orphaned = append(orphaned, ids)
+			}
+		}
+		if len(orphaned) == 0 {
+			continue
+		}
+		logger.WithField("count", len(orphaned)).Info("Found orphaned end devices")
+		for _, ids := range orphaned {
+			logger := logger.WithField("device_uid", unique.ID(ctx, ids))
+			if err := as.requireDeletedFromEntityRegistry(ctx, ids); err != nil {
+				logger.WithError(err).Warn("Failed to confirm that orphaned end device is deleted from Entity Registry")
+				continue
+			}
+			_, err := as.deviceRegistry.Set(ctx, ids, nil, func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+				return nil, nil, nil
+			})
+			if err != nil {
+				logger.WithError(err).Warn("Failed to delete orphaned end device")
+				continue
+			}
+			logger.Info("Deleted orphaned end device")
+		}
+	}
+	return nil
+}
+
+func (as *ApplicationServer) reconcileDevices(interval time.Duration) func(context.Context) error {
+	return func(ctx context.Context) error {
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+			if err := as.deleteOrphanedDevices(ctx); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to delete orphaned end devices")
+			}
+		}
+	}
+}
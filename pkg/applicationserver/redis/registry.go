@@ -16,9 +16,11 @@ package redis
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -53,9 +55,33 @@ func (r *DeviceRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers
 	return applyDeviceFieldMask(nil, pb, paths...)
 }
 
+// Init adds the end devices that were stored before the index of all end devices was introduced to that index.
+func (r *DeviceRegistry) Init() error {
+	prefix := r.Redis.Key("")
+	return ttnredis.AddKeysToSet(r.Redis, r.Redis.Key(allKey), r.Redis.Key("*"), func(k string) (string, bool) {
+		uid := strings.TrimPrefix(k, prefix)
+		return uid, uid != allKey
+	})
+}
+
+// Range ranges the end devices and calls the callback function, until false is returned.
+func (r *DeviceRegistry) Range(ctx context.Context, paths []string, f func(*ttnpb.EndDevice) bool) error {
+	return ttnredis.FindProtos(r.Redis, r.Redis.Key(allKey), r.Redis.Key).Range(func() (proto.Message, func() (bool, error)) {
+		pb := &ttnpb.EndDevice{}
+		return pb, func() (bool, error) {
+			pb, err := applyDeviceFieldMask(nil, pb, paths...)
+			if err != nil {
+				return false, err
+			}
+			return f(pb), nil
+		}
+	})
+}
+
 // Set creates, updates or deletes the end device by its identifiers.
 func (r *DeviceRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
-	k := r.Redis.Key(unique.ID(ctx, ids))
+	uid := unique.ID(ctx, ids)
+	k := r.Redis.Key(uid)
 
 	var pb *ttnpb.EndDevice
 	err := r.Redis.Watch(func(tx *redis.Tx) error {
@@ -87,6 +113,7 @@ func (r *DeviceRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers
 		if pb == nil {
 			f = func(p redis.Pipeliner) error {
 				p.Del(k)
+				p.SRem(r.Redis.Key(allKey), uid)
 				return nil
 			}
 		} else {
@@ -110,8 +137,11 @@ func (r *DeviceRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers
 				return err
 			}
 			f = func(p redis.Pipeliner) error {
-				_, err := ttnredis.SetProto(p, k, stored, 0)
-				return err
+				if _, err := ttnredis.SetProto(p, k, stored, 0); err != nil {
+					return err
+				}
+				p.SAdd(r.Redis.Key(allKey), uid)
+				return nil
 			}
 		}
 
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/retry"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -29,10 +30,24 @@ var (
 type DeviceRegistry interface {
 	// Get returns the end device by its identifiers.
 	Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.EndDevice, error)
+	// Range ranges the end devices and calls the callback function, until false is returned.
+	Range(ctx context.Context, paths []string, f func(*ttnpb.EndDevice) bool) error
 	// Set creates, updates or deletes the end device by its identifiers.
 	Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error)
 }
 
+// SetDeviceWithRetry calls r.Set and retries the transaction according to conf if it is aborted due to
+// a concurrent modification of the end device. As f may be called multiple times, it must not have side effects
+// that cannot be repeated.
+func SetDeviceWithRetry(ctx context.Context, r DeviceRegistry, conf retry.Config, ids ttnpb.EndDeviceIdentifiers, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+	var dev *ttnpb.EndDevice
+	err := retry.Transaction(ctx, "as_device_registry", conf, func() (err error) {
+		dev, err = r.Set(ctx, ids, paths, f)
+		return err
+	})
+	return dev, err
+}
+
 // LinkRegistry is a store for application links.
 type LinkRegistry interface {
 	// Get returns the link by the application identifiers.
@@ -22,6 +22,7 @@ import (
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -138,3 +139,50 @@ func TestLinkRegistry(t *testing.T) {
 		}
 	}
 }
+
+func TestRedisDeviceRegistryInit(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "applicationserver_test")
+	defer flush()
+	defer cl.Close()
+
+	reg := &redis.DeviceRegistry{Redis: cl}
+
+	indexedIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "app-1"},
+		DeviceID:               "dev-1",
+	}
+	_, err := reg.Set(ctx, indexedIDs, nil, func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		return &ttnpb.EndDevice{EndDeviceIdentifiers: indexedIDs}, []string{"ids"}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// End devices stored before the index of all end devices was introduced are not in the index.
+	legacyIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "app-1"},
+		DeviceID:               "dev-2",
+	}
+	_, err = ttnredis.SetProto(cl, cl.Key(unique.ID(ctx, legacyIDs)), &ttnpb.EndDevice{EndDeviceIdentifiers: legacyIDs}, 0)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	rangeIDs := func() (ids []ttnpb.EndDeviceIdentifiers) {
+		err := reg.Range(ctx, []string{"ids"}, func(dev *ttnpb.EndDevice) bool {
+			ids = append(ids, dev.EndDeviceIdentifiers)
+			return true
+		})
+		a.So(err, should.BeNil)
+		return ids
+	}
+	a.So(rangeIDs(), should.Resemble, []ttnpb.EndDeviceIdentifiers{indexedIDs})
+
+	for i := 0; i < 2; i++ {
+		a.So(reg.Init(), should.BeNil)
+		a.So(rangeIDs(), should.HaveSameElementsDeep, []ttnpb.EndDeviceIdentifiers{indexedIDs, legacyIDs})
+	}
+}
@@ -88,3 +88,9 @@ func Authorized(ctx context.Context) error {
 	}
 	return ctx.Value(clusterAuthFailureKey).(error)
 }
+
+// HasAuth returns whether the call in the context uses cluster authentication.
+// This does not verify the cluster key; use Authorized for that.
+func HasAuth(ctx context.Context) bool {
+	return rpcmetadata.FromIncomingContext(ctx).AuthType == AuthType
+}
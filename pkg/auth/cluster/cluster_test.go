@@ -74,6 +74,18 @@ func TestVerify(t *testing.T) {
 	}
 }
 
+func TestHasAuth(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(cluster.HasAuth(context.Background()), should.BeFalse)
+
+	md := metadata.MD{"authorization": []string{"Bearer foo"}}
+	a.So(cluster.HasAuth(metadata.NewIncomingContext(context.Background(), md)), should.BeFalse)
+
+	md = metadata.MD{"authorization": []string{fmt.Sprintf("%s %X", cluster.AuthType, []byte{0x00, 0xaa})}}
+	a.So(cluster.HasAuth(metadata.NewIncomingContext(context.Background(), md)), should.BeTrue)
+}
+
 func ExampleAuthorized() {
 	var ( // Assume this comes from a hypothetical inter-cluster RPC call.
 		ctx context.Context
@@ -18,19 +18,34 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
-var as_923 Band
+var (
+	as_923   Band
+	as_923_2 Band
+	as_923_3 Band
+	as_923_4 Band
+)
 
-// AS_923 is the ID of the Asian 923Mhz band
-const AS_923 = "AS_923"
+const (
+	// AS_923 is the ID of the Asian 923Mhz band
+	AS_923 = "AS_923"
+	// AS_923_2 is the ID of the Asian 923Mhz band with a frequency offset of -1.8Mhz (AS923-2)
+	AS_923_2 = "AS_923_2"
+	// AS_923_3 is the ID of the Asian 923Mhz band with a frequency offset of -6.6Mhz (AS923-3)
+	AS_923_3 = "AS_923_3"
+	// AS_923_4 is the ID of the Asian 923Mhz band with a frequency offset of -5.9Mhz (AS923-4)
+	AS_923_4 = "AS_923_4"
+)
 
-func init() {
+// makeAS923 returns the AS923 band with the given ID, which has all frequencies shifted by frequencyOffset Hz.
+func makeAS923(id string, frequencyOffset int64) Band {
+	offset := func(freq uint64) uint64 { return uint64(int64(freq) + frequencyOffset) }
 	defaultChannels := []Channel{
-		{Frequency: 923200000, MinDataRate: 0, MaxDataRate: 5},
-		{Frequency: 923400000, MinDataRate: 0, MaxDataRate: 5},
+		{Frequency: offset(923200000), MinDataRate: 0, MaxDataRate: 5},
+		{Frequency: offset(923400000), MinDataRate: 0, MaxDataRate: 5},
 	}
-	asBeaconChannel := uint32(923400000)
-	as_923 = Band{
-		ID: AS_923,
+	asBeaconChannel := uint32(offset(923400000))
+	return Band{
+		ID: id,
 
 		MaxUplinkChannels: 16,
 		UplinkChannels:    defaultChannels,
@@ -40,8 +55,8 @@ func init() {
 
 		SubBands: []SubBandParameters{
 			{
-				MinFrequency: 923000000,
-				MaxFrequency: 923500000,
+				MinFrequency: offset(923000000),
+				MaxFrequency: offset(923500000),
 				DutyCycle:    0.01,
 				MaxTxPower:   14.0,
 			},
@@ -133,7 +148,7 @@ func init() {
 		GenerateChMasks: generateChMask16,
 		ParseChMask:     parseChMask16,
 
-		DefaultRx2Parameters: Rx2Parameters{2, 923200000},
+		DefaultRx2Parameters: Rx2Parameters{2, offset(923200000)},
 
 		Beacon: Beacon{
 			DataRateIndex:    3,
@@ -143,12 +158,31 @@ func init() {
 		},
 
 		TxParamSetupReqSupport: true,
-
-		// No LoRaWAN Regional Parameters 1.0
-		// No LoRaWAN Regional Parameters 1.0.1
-		regionalParameters1_0_2RevA: bandIdentity,
-		regionalParameters1_0_2RevB: bandIdentity,
-		regionalParameters1_1RevA:   bandIdentity,
 	}
+}
+
+func init() {
+	as_923 = makeAS923(AS_923, 0)
+	// No LoRaWAN Regional Parameters 1.0
+	// No LoRaWAN Regional Parameters 1.0.1
+	as_923.regionalParameters1_0_2RevA = bandIdentity
+	as_923.regionalParameters1_0_2RevB = bandIdentity
+	as_923.regionalParameters1_0_3RevA = bandIdentity
+	as_923.regionalParameters1_1RevA = bandIdentity
+	as_923.regionalParameters1_1RevB = bandIdentity
+	as_923.regionalParametersRP002_1_0_0 = bandIdentity
 	All[AS_923] = as_923
+
+	// AS923 groups 2, 3 and 4 are only defined by LoRaWAN Regional Parameters RP002.
+	as_923_2 = makeAS923(AS_923_2, -1800000)
+	as_923_2.regionalParametersRP002_1_0_0 = bandIdentity
+	All[AS_923_2] = as_923_2
+
+	as_923_3 = makeAS923(AS_923_3, -6600000)
+	as_923_3.regionalParametersRP002_1_0_0 = bandIdentity
+	All[AS_923_3] = as_923_3
+
+	as_923_4 = makeAS923(AS_923_4, -5900000)
+	as_923_4.regionalParametersRP002_1_0_0 = bandIdentity
+	All[AS_923_4] = as_923_4
 }
@@ -72,56 +72,56 @@ func init() {
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 12,
 				Bandwidth:       125000,
-			}}}, DefaultMaxSize: constPayloadSizer(59)},
+			}}}, DefaultMaxSize: dwellTimePayloadSizer{59, 0}},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 11,
 				Bandwidth:       125000,
-			}}}, DefaultMaxSize: constPayloadSizer(59)},
+			}}}, DefaultMaxSize: dwellTimePayloadSizer{59, 0}},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 10,
 				Bandwidth:       125000,
-			}}}, DefaultMaxSize: constPayloadSizer(59)},
+			}}}, DefaultMaxSize: dwellTimePayloadSizer{59, 19}},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 9,
 				Bandwidth:       125000,
-			}}}, DefaultMaxSize: constPayloadSizer(123)},
+			}}}, DefaultMaxSize: dwellTimePayloadSizer{123, 61}},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 8,
 				Bandwidth:       125000,
-			}}}, DefaultMaxSize: constPayloadSizer(230)},
+			}}}, DefaultMaxSize: dwellTimePayloadSizer{250, 133}},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 7,
 				Bandwidth:       125000,
-			}}}, DefaultMaxSize: constPayloadSizer(230)},
+			}}}, DefaultMaxSize: dwellTimePayloadSizer{250, 250}},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 8,
 				Bandwidth:       500000,
-			}}}, DefaultMaxSize: constPayloadSizer(230)},
+			}}}, DefaultMaxSize: dwellTimePayloadSizer{250, 250}},
 			{}, // RFU
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 12,
 				Bandwidth:       500000,
-			}}}, DefaultMaxSize: constPayloadSizer(41)},
+			}}}, DefaultMaxSize: constPayloadSizer(61)},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 11,
 				Bandwidth:       500000,
-			}}}, DefaultMaxSize: constPayloadSizer(117)},
+			}}}, DefaultMaxSize: constPayloadSizer(137)},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 10,
 				Bandwidth:       500000,
-			}}}, DefaultMaxSize: constPayloadSizer(230)},
+			}}}, DefaultMaxSize: constPayloadSizer(250)},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 9,
 				Bandwidth:       500000,
-			}}}, DefaultMaxSize: constPayloadSizer(230)},
+			}}}, DefaultMaxSize: constPayloadSizer(250)},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 8,
 				Bandwidth:       500000,
-			}}}, DefaultMaxSize: constPayloadSizer(230)},
+			}}}, DefaultMaxSize: constPayloadSizer(250)},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 7,
 				Bandwidth:       500000,
-			}}}, DefaultMaxSize: constPayloadSizer(230)},
+			}}}, DefaultMaxSize: constPayloadSizer(250)},
 			{}, // Used by LinkADRReq starting from LoRaWAN Regional Parameters 1.1, RFU for previous versions
 		},
 		MaxADRDataRateIndex: 5,
@@ -147,7 +147,7 @@ func init() {
 		MaxTxPowerIndex: 14,
 
 		ImplementsCFList: true,
-		CFListType:       ttnpb.CFListType_FREQUENCIES,
+		CFListType:       ttnpb.CFListType_CHANNEL_MASKS,
 
 		Rx1Channel: channelIndexModulo(8),
 		Rx1DataRate: func(idx ttnpb.DataRateIndex, offset uint32, _ bool) (ttnpb.DataRateIndex, error) {
@@ -180,11 +180,16 @@ func init() {
 			PingSlotChannels: usAuBeaconFrequencies[:],
 		},
 
+		TxParamSetupReqSupport: true,
+
 		// No LoRaWAN Regional Parameters 1.0
-		regionalParameters1_0_1:     bandIdentity,
-		regionalParameters1_0_2RevA: auDataRates1_0_2,
-		regionalParameters1_0_2RevB: disableChMaskCntl51_0_2,
-		regionalParameters1_1RevA:   bandIdentity,
+		regionalParameters1_0_1:       bandIdentity,
+		regionalParameters1_0_2RevA:   auDataRates1_0_2,
+		regionalParameters1_0_2RevB:   composeSwaps(disableCFList1_0_2, disableChMaskCntl51_0_2),
+		regionalParameters1_0_3RevA:   bandIdentity,
+		regionalParameters1_1RevA:     bandIdentity,
+		regionalParameters1_1RevB:     auDataRates1_1,
+		regionalParametersRP002_1_0_0: auDwellTimeRP002_1_0_0,
 	}
 	All[AU_915_928] = au_915_928
 }
@@ -176,16 +176,21 @@ type Band struct {
 	// then channel with index i should be enabled, otherwise it should be disabled.
 	GenerateChMasks func(chs []bool) ([]ChMaskCntlPair, error)
 	// ParseChMask computes the channels that have to be masked given ChMask mask and ChMaskCntl cntl.
+	// Channels, which are not affected by the ChMaskCntl, are not contained in the returned map, such that
+	// the masks of a sequence of LinkADRReq commands can be applied in order.
 	ParseChMask func(mask [16]bool, cntl uint8) (map[uint8]bool, error)
 
 	// DefaultRx2Parameters are the default parameters that determine the settings for a Tx sent during Rx2.
 	DefaultRx2Parameters Rx2Parameters
 
-	regionalParameters1_0       versionSwap
-	regionalParameters1_0_1     versionSwap
-	regionalParameters1_0_2RevA versionSwap
-	regionalParameters1_0_2RevB versionSwap
-	regionalParameters1_1RevA   versionSwap
+	regionalParameters1_0         versionSwap
+	regionalParameters1_0_1       versionSwap
+	regionalParameters1_0_2RevA   versionSwap
+	regionalParameters1_0_2RevB   versionSwap
+	regionalParameters1_0_3RevA   versionSwap
+	regionalParameters1_1RevA     versionSwap
+	regionalParameters1_1RevB     versionSwap
+	regionalParametersRP002_1_0_0 versionSwap
 }
 
 // SubBandParameters contains the sub-band frequency range, duty cycle and Tx power.
@@ -224,8 +229,11 @@ type swapParameters struct {
 
 func (b Band) downgrades() []swapParameters {
 	return []swapParameters{
-		{version: ttnpb.PHY_V1_1_REV_B, downgrade: bandIdentity},
+		{version: ttnpb.RP002_V1_0_1, downgrade: bandIdentity},
+		{version: ttnpb.RP002_V1_0_0, downgrade: b.regionalParametersRP002_1_0_0},
+		{version: ttnpb.PHY_V1_1_REV_B, downgrade: b.regionalParameters1_1RevB},
 		{version: ttnpb.PHY_V1_1_REV_A, downgrade: b.regionalParameters1_1RevA},
+		{version: ttnpb.PHY_V1_0_3_REV_A, downgrade: b.regionalParameters1_0_3RevA},
 		{version: ttnpb.PHY_V1_0_2_REV_B, downgrade: b.regionalParameters1_0_2RevB},
 		{version: ttnpb.PHY_V1_0_2_REV_A, downgrade: b.regionalParameters1_0_2RevA},
 		{version: ttnpb.PHY_V1_0_1, downgrade: b.regionalParameters1_0_1},
@@ -299,8 +307,9 @@ func parseChMask72(mask [16]bool, cntl uint8) (map[uint8]bool, error) {
 	chans := make(map[uint8]bool, 72)
 	switch cntl {
 	case 0, 1, 2, 3, 4:
-		for i := uint8(0); i < 72; i++ {
-			chans[i] = (i >= cntl*16 && i < (cntl+1)*16) && mask[i%16]
+		// ChMaskCntl 0-4 only affect the channels of the 16-channel block.
+		for i := cntl * 16; i < 72 && i < (cntl+1)*16; i++ {
+			chans[i] = mask[i%16]
 		}
 	case 5:
 		for i := uint8(0); i < 64; i++ {
@@ -326,8 +335,9 @@ func parseChMask96(mask [16]bool, cntl uint8) (map[uint8]bool, error) {
 	chans := make(map[uint8]bool, 96)
 	switch cntl {
 	case 0, 1, 2, 3, 4, 5:
-		for i := uint8(0); i < 96; i++ {
-			chans[i] = (i >= cntl*16 && i < (cntl+1)*16) && mask[i%16]
+		// ChMaskCntl 0-5 only affect the channels of the 16-channel block.
+		for i := cntl * 16; i < (cntl+1)*16; i++ {
+			chans[i] = mask[i%16]
 		}
 	case 6:
 		for i := uint8(0); i < 96; i++ {
@@ -403,105 +413,120 @@ func equalChMasks(a, b []bool) bool {
 	return true
 }
 
-func makeGenerateChMask72(supportChMaskCntl5 bool) func([]bool) ([]ChMaskCntlPair, error) {
-	return func(mask []bool) ([]ChMaskCntlPair, error) {
-		if len(mask) != 72 {
-			return nil, errInvalidChannelCount
-		}
+// generateChMask72Blocks generates a ChMaskCntl sequence for 72 channels, which first enables (ChMaskCntl 6)
+// or disables (ChMaskCntl 7) all 125 kHz channels and sets the 500 kHz channels, and then sets each
+// 16-channel block of 125 kHz channels, which differs from that. The shorter of both sequences is returned.
+func generateChMask72Blocks(mask []bool) ([]ChMaskCntlPair, error) {
+	block500, err := generateChMaskBlock(mask[64:72])
+	if err != nil {
+		return nil, err
+	}
 
-		on125 := uint8(0)
-		for i := 0; i < 64; i++ {
-			if mask[i] {
-				on125++
-			}
+	var onBlocks, offBlocks []ChMaskCntlPair
+	for cntl := uint8(0); cntl < 4; cntl++ {
+		block, err := generateChMaskBlock(mask[cntl*16 : cntl*16+16])
+		if err != nil {
+			return nil, err
 		}
 
-		if on125 == 0 || on125 == 64 {
-			block, err := generateChMaskBlock(mask[64:72])
-			if err != nil {
-				return nil, err
-			}
-
-			idx := uint8(6)
-			if on125 == 0 {
-				idx = 7
-			}
-			return []ChMaskCntlPair{{Cntl: idx, Mask: block}}, nil
+		allOn, allOff := true, true
+		for _, on := range block {
+			allOn = allOn && on
+			allOff = allOff && !on
 		}
-
-		if !supportChMaskCntl5 {
-			return generateChMaskMatrix(mask)
+		if !allOff {
+			onBlocks = append(onBlocks, ChMaskCntlPair{Cntl: cntl, Mask: block})
+		}
+		if !allOn {
+			offBlocks = append(offBlocks, ChMaskCntlPair{Cntl: cntl, Mask: block})
+		}
+	}
+	if len(offBlocks) < len(onBlocks) {
+		return append([]ChMaskCntlPair{{Cntl: 6, Mask: block500}}, offBlocks...), nil
+	}
+	return append([]ChMaskCntlPair{{Cntl: 7, Mask: block500}}, onBlocks...), nil
+}
+
+// generateChMask72Cntl5 generates a ChMaskCntl sequence for 72 channels, which first sets the FSBs using ChMaskCntl 5
+// and then sets each 16-channel block, which differs from that.
+func generateChMask72Cntl5(mask []bool) ([]ChMaskCntlPair, error) {
+	// Find the majority mask. The majority mask is the mask of
+	// FSBs that appears the most in the requested channels mask.
+	// A majority mask of 0b00000001 for example represents the
+	// first FSB.
+	var majorityMask [8]bool
+	majorityCount := 0
+	for i := 0; i < 8; i++ {
+		var currentMask [8]bool
+		for ch := 0; ch < 8; ch++ {
+			currentMask[ch] = mask[ch*8+i]
 		}
 
-		// Find the majority mask. The majority mask is the mask of
-		// FSBs that appears the most in the requested channels mask.
-		// A majority mask of 0b00000001 for example represents the
-		// first FSB.
-		var majorityMask [8]bool
-		majorityCount := 0
-		for i := 0; i < 8; i++ {
-			var currentMask [8]bool
-			for ch := 0; ch < 8; ch++ {
-				currentMask[ch] = mask[ch*8+i]
-			}
-
-			if majorityCount == 0 {
-				majorityMask = currentMask
-				majorityCount = 1
+		if majorityCount == 0 {
+			majorityMask = currentMask
+			majorityCount = 1
+		} else {
+			if equalChMasks(currentMask[:], majorityMask[:]) {
+				majorityCount++
 			} else {
-				if equalChMasks(currentMask[:], majorityMask[:]) {
-					majorityCount++
-				} else {
-					majorityCount--
-				}
+				majorityCount--
 			}
 		}
+	}
 
-		// Find the channels which are not respecting the majority mask.
-		// Since we can set two FSBs at a time using only one ChMaskCntl
-		// command, we iterate them in pairs.
-		n := len(mask)
-		var outliers []int
-		for fsb := 0; fsb < 8; fsb++ {
-			for i := 0; i < 8; i += 2 {
-				if mask[i*8+fsb] != majorityMask[i] || mask[(i+1)*8+fsb] != majorityMask[i+1] {
-					outliers = append(outliers, i/2)
-					break
-				}
+	// Find the 16-channel blocks, which are not respecting the majority mask.
+	// Since we can set two FSBs at a time using only one ChMaskCntl
+	// command, we iterate them in pairs.
+	var outliers [5]bool
+	for fsb := 0; fsb < 8; fsb++ {
+		for i := 0; i < 8; i += 2 {
+			if mask[i*8+fsb] != majorityMask[i] || mask[(i+1)*8+fsb] != majorityMask[i+1] {
+				outliers[i/2] = true
 			}
 		}
-		if !equalChMasks(majorityMask[:], mask[64:72]) {
-			outliers = append(outliers, 4)
+	}
+	outliers[4] = !equalChMasks(majorityMask[:], mask[64:72])
+
+	var fsbMask [16]bool
+	copy(fsbMask[:], majorityMask[:])
+	cmds := []ChMaskCntlPair{{Cntl: 5, Mask: fsbMask}}
+	for cntl, isOutlier := range outliers {
+		if !isOutlier {
+			continue
+		}
+		end := cntl*16 + 16
+		if end > len(mask) {
+			end = len(mask)
 		}
 
-		// In order to ensure the minimality of the commands, we must
-		// ensure that the mask couldn't have been generated only using
-		// ChMaskCntl 0-4.
-		if len(outliers) < 5 {
-			var fsbMask [16]bool
-			for i := 0; i < 8; i++ {
-				fsbMask[15-i] = majorityMask[i]
-			}
+		block, err := generateChMaskBlock(mask[cntl*16 : end])
+		if err != nil {
+			return nil, err
+		}
+		cmds = append(cmds, ChMaskCntlPair{Cntl: uint8(cntl), Mask: block})
+	}
+	return cmds, nil
+}
 
-			cmds := make([]ChMaskCntlPair, len(outliers)+1)
-			cmds[0] = ChMaskCntlPair{Cntl: 5, Mask: fsbMask}
+func makeGenerateChMask72(supportChMaskCntl5 bool) func([]bool) ([]ChMaskCntlPair, error) {
+	return func(mask []bool) ([]ChMaskCntlPair, error) {
+		if len(mask) != 72 {
+			return nil, errInvalidChannelCount
+		}
 
-			for i, cntl := range outliers {
-				end := cntl*16 + 16
-				if end > n {
-					end = n
-				}
+		cmds, err := generateChMask72Blocks(mask)
+		if err != nil || !supportChMaskCntl5 || len(cmds) == 1 {
+			return cmds, err
+		}
 
-				block, err := generateChMaskBlock(mask[cntl*16 : end])
-				if err != nil {
-					return nil, err
-				}
-				cmds[i+1] = ChMaskCntlPair{Cntl: uint8(cntl), Mask: block}
-			}
-			return cmds, nil
+		cntl5Cmds, err := generateChMask72Cntl5(mask)
+		if err != nil {
+			return nil, err
+		}
+		if len(cntl5Cmds) <= len(cmds) {
+			return cntl5Cmds, nil
 		}
-		// Fallback to ChMaskCntl 0-4.
-		return generateChMaskMatrix(mask)
+		return cmds, nil
 	}
 }
 
@@ -224,11 +224,9 @@ func TestGenerateChMask(t *testing.T) {
 				false, false, true, false, true, false, false, false,
 			},
 			Expected: []ChMaskCntlPair{
+				{7, [16]bool{false, false, true, false, true, false, false, false, false, false, false, false, false, false, false, false}},
 				{0, [16]bool{true, true, true, true, true, true, true, true, true, true, true, true, true, true, true, true}},
-				{1, [16]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false}},
 				{2, [16]bool{false, false, false, false, false, false, false, false, false, true, false, false, false, false, false, false}},
-				{3, [16]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false}},
-				{4, [16]bool{false, false, true, false, true, false, false, false, false, false, false, false, false, false, false, false}},
 			},
 		},
 		{
@@ -246,7 +244,7 @@ func TestGenerateChMask(t *testing.T) {
 				false, false, true, false, true, false, false, false,
 			},
 			Expected: []ChMaskCntlPair{
-				{5, [16]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, true, true}},
+				{5, [16]bool{true, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false}},
 				{2, [16]bool{false, false, false, false, false, false, false, false, false, true, false, false, false, false, false, false}},
 				{4, [16]bool{false, false, true, false, true, false, false, false, false, false, false, false, false, false, false, false}},
 			},
@@ -294,7 +292,7 @@ func TestGenerateChMask(t *testing.T) {
 				true, false, false, false, false, false, false, false,
 			},
 			Expected: []ChMaskCntlPair{
-				{5, [16]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}},
+				{5, [16]bool{true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false}},
 			},
 		},
 		{
@@ -312,7 +310,7 @@ func TestGenerateChMask(t *testing.T) {
 				true, false, false, false, false, false, false, false,
 			},
 			Expected: []ChMaskCntlPair{
-				{5, [16]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, true}},
+				{5, [16]bool{true, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false}},
 				{0, [16]bool{true, false, true, true, true, true, true, true, false, false, false, false, false, false, false, false}},
 			},
 		},
@@ -331,7 +329,7 @@ func TestGenerateChMask(t *testing.T) {
 				false, false, true, true, false, false, false, false,
 			},
 			Expected: []ChMaskCntlPair{
-				{5, [16]bool{false, false, false, false, false, false, false, false, false, false, false, false, true, true, false, false}},
+				{5, [16]bool{false, false, true, true, false, false, false, false, false, false, false, false, false, false, false, false}},
 			},
 		},
 		{
@@ -349,7 +347,7 @@ func TestGenerateChMask(t *testing.T) {
 				false, false, false, false, false, false, false, false,
 			},
 			Expected: []ChMaskCntlPair{
-				{5, [16]bool{false, false, false, false, false, false, false, false, false, false, false, false, true, true, false, false}},
+				{5, [16]bool{false, false, true, true, false, false, false, false, false, false, false, false, false, false, false, false}},
 				{4, [16]bool{false, false, false, false, false, false, false, false, false, false, false, false, false, false, false, false}},
 			},
 		},
@@ -388,6 +386,43 @@ func TestGenerateChMask(t *testing.T) {
 				{6, [16]bool{}},
 			},
 		},
+		{
+			Name:     "72 channels/FSB 2 on (Cntl5 off)",
+			Generate: makeGenerateChMask72(false),
+			Mask: []bool{
+				false, false, false, false, false, false, false, false,
+				true, true, true, true, true, true, true, true,
+				false, false, false, false, false, false, false, false,
+				false, false, false, false, false, false, false, false,
+				false, false, false, false, false, false, false, false,
+				false, false, false, false, false, false, false, false,
+				false, false, false, false, false, false, false, false,
+				false, false, false, false, false, false, false, false,
+				false, true, false, false, false, false, false, false,
+			},
+			Expected: []ChMaskCntlPair{
+				{7, [16]bool{false, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false}},
+				{0, [16]bool{false, false, false, false, false, false, false, false, true, true, true, true, true, true, true, true}},
+			},
+		},
+		{
+			Name:     "72 channels/FSB 2 on (Cntl5 on)",
+			Generate: makeGenerateChMask72(true),
+			Mask: []bool{
+				false, false, false, false, false, false, false, false,
+				true, true, true, true, true, true, true, true,
+				false, false, false, false, false, false, false, false,
+				false, false, false, false, false, false, false, false,
+				false, false, false, false, false, false, false, false,
+				false, false, false, false, false, false, false, false,
+				false, false, false, false, false, false, false, false,
+				false, false, false, false, false, false, false, false,
+				false, true, false, false, false, false, false, false,
+			},
+			Expected: []ChMaskCntlPair{
+				{5, [16]bool{false, true, false, false, false, false, false, false, false, false, false, false, false, false, false, false}},
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
@@ -398,6 +433,31 @@ func TestGenerateChMask(t *testing.T) {
 				t.FailNow()
 			}
 			a.So(ret, should.Resemble, tc.Expected)
+			if tc.Error != nil {
+				return
+			}
+
+			parseChMask := map[int]func([16]bool, uint8) (map[uint8]bool, error){
+				16: parseChMask16,
+				72: parseChMask72,
+				96: parseChMask96,
+			}[len(tc.Mask)]
+			for _, initial := range []bool{false, true} {
+				chs := make([]bool, len(tc.Mask))
+				for i := range chs {
+					chs[i] = initial
+				}
+				for _, pair := range ret {
+					m, err := parseChMask(pair.Mask, pair.Cntl)
+					if !a.So(err, should.BeNil) {
+						t.FailNow()
+					}
+					for i, on := range m {
+						chs[i] = on
+					}
+				}
+				a.So(chs, should.Resemble, tc.Mask)
+			}
 		})
 	}
 }
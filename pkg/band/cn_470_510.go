@@ -143,10 +143,13 @@ func init() {
 		CFListType:       ttnpb.CFListType_CHANNEL_MASKS,
 
 		// No LoRaWAN Regional Parameters 1.0
-		regionalParameters1_0_1:     bandIdentity,
-		regionalParameters1_0_2RevA: bandIdentity,
-		regionalParameters1_0_2RevB: disableCFList1_0_2,
-		regionalParameters1_1RevA:   bandIdentity,
+		regionalParameters1_0_1:       bandIdentity,
+		regionalParameters1_0_2RevA:   bandIdentity,
+		regionalParameters1_0_2RevB:   disableCFList1_0_2,
+		regionalParameters1_0_3RevA:   bandIdentity,
+		regionalParameters1_1RevA:     bandIdentity,
+		regionalParameters1_1RevB:     bandIdentity,
+		regionalParametersRP002_1_0_0: bandIdentity,
 	}
 	All[CN_470_510] = cn_470_510
 }
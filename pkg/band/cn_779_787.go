@@ -140,11 +140,14 @@ func init() {
 			BroadcastChannel: func(_ float64) uint32 { return cnBeaconChannel },
 		},
 
-		regionalParameters1_0:       bandIdentity,
-		regionalParameters1_0_1:     bandIdentity,
-		regionalParameters1_0_2RevA: bandIdentity,
-		regionalParameters1_0_2RevB: bandIdentity,
-		regionalParameters1_1RevA:   bandIdentity,
+		regionalParameters1_0:         bandIdentity,
+		regionalParameters1_0_1:       bandIdentity,
+		regionalParameters1_0_2RevA:   bandIdentity,
+		regionalParameters1_0_2RevB:   bandIdentity,
+		regionalParameters1_0_3RevA:   bandIdentity,
+		regionalParameters1_1RevA:     bandIdentity,
+		regionalParameters1_1RevB:     bandIdentity,
+		regionalParametersRP002_1_0_0: bandIdentity,
 	}
 	All[CN_779_787] = cn_779_787
 }
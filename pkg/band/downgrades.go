@@ -14,15 +14,41 @@
 
 package band
 
-// LoRaWAN 1.1 -> 1.0.2rB downgrades
+// LoRaWAN Regional Parameters RP002-1.0.1 -> RP002-1.0.0 downgrades
 
-func disableCFList1_0_2(b Band) Band {
-	b.ImplementsCFList = false
+func auDwellTimeRP002_1_0_0(b Band) Band {
+	b.TxParamSetupReqSupport = false
+	for i := 0; i < 7; i++ {
+		b.DataRates[i].DefaultMaxSize = constPayloadSizer(b.DataRates[i].DefaultMaxSize.PayloadSize(false))
+	}
+	return b
+}
+
+// LoRaWAN Regional Parameters RP002-1.0.0 -> 1.1rB downgrades
+
+func usAuDownlinkDataRates1_1(b Band) Band {
+	b.DataRates[8].DefaultMaxSize = constPayloadSizer(41)
+	b.DataRates[9].DefaultMaxSize = constPayloadSizer(117)
+	for i := 10; i < 14; i++ {
+		b.DataRates[i].DefaultMaxSize = constPayloadSizer(230)
+	}
 	return b
 }
 
+func auDataRates1_1(b Band) Band {
+	for i := 4; i < 7; i++ {
+		b.DataRates[i].DefaultMaxSize = constPayloadSizer(230)
+	}
+	return usAuDownlinkDataRates1_1(b)
+}
+
 // LoRaWAN 1.0.3rA -> 1.0.2rB downgrades
 
+func disableCFList1_0_2(b Band) Band {
+	b.ImplementsCFList = false
+	return b
+}
+
 func disableChMaskCntl51_0_2(b Band) Band {
 	b.GenerateChMasks = makeGenerateChMask72(false)
 	return b
@@ -15,6 +15,7 @@
 package band_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/smartystreets/assertions"
@@ -59,7 +60,66 @@ func TestBands(t *testing.T) {
 	verifyCompatibility(ttnpb.PHY_V1_0_2_REV_B, "1.0.2", bands...)
 
 	bands = append(bands, band.RU_864_870)
+	verifyCompatibility(ttnpb.PHY_V1_0_3_REV_A, "1.0.3", bands...)
 	verifyCompatibility(ttnpb.PHY_V1_1_REV_A, "1.1", bands...)
+
+	bands = append(bands, band.AS_923_2, band.AS_923_3, band.AS_923_4)
+	verifyCompatibility(ttnpb.RP002_V1_0_0, "RP002-1.0.0", bands...)
+	verifyCompatibility(ttnpb.RP002_V1_0_1, "RP002-1.0.1", bands...)
+}
+
+func TestDataRateDowngrades(t *testing.T) {
+	for _, tc := range []struct {
+		BandID    string
+		Version   ttnpb.PHYVersion
+		DataRate  int
+		DwellTime bool
+		MaxSize   uint16
+	}{
+		{BandID: band.US_902_928, Version: ttnpb.RP002_V1_0_1, DataRate: 8, MaxSize: 61},
+		{BandID: band.US_902_928, Version: ttnpb.PHY_V1_1_REV_B, DataRate: 8, MaxSize: 41},
+		{BandID: band.US_902_928, Version: ttnpb.RP002_V1_0_0, DataRate: 13, MaxSize: 250},
+		{BandID: band.US_902_928, Version: ttnpb.PHY_V1_1_REV_B, DataRate: 13, MaxSize: 230},
+		{BandID: band.AU_915_928, Version: ttnpb.RP002_V1_0_1, DataRate: 3, DwellTime: true, MaxSize: 61},
+		{BandID: band.AU_915_928, Version: ttnpb.RP002_V1_0_0, DataRate: 3, DwellTime: true, MaxSize: 123},
+		{BandID: band.AU_915_928, Version: ttnpb.RP002_V1_0_0, DataRate: 5, MaxSize: 250},
+		{BandID: band.AU_915_928, Version: ttnpb.PHY_V1_1_REV_B, DataRate: 5, MaxSize: 230},
+		{BandID: band.AU_915_928, Version: ttnpb.PHY_V1_1_REV_B, DataRate: 9, MaxSize: 117},
+	} {
+		t.Run(fmt.Sprintf("%s/%s/DR%d", tc.BandID, tc.Version, tc.DataRate), func(t *testing.T) {
+			a := assertions.New(t)
+			b, err := band.GetByID(tc.BandID)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			b, err = b.Version(tc.Version)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(b.DataRates[tc.DataRate].DefaultMaxSize.PayloadSize(tc.DwellTime), should.Equal, tc.MaxSize)
+		})
+	}
+}
+
+func TestAS923FrequencyOffsets(t *testing.T) {
+	for id, offset := range map[string]int64{
+		band.AS_923:   0,
+		band.AS_923_2: -1800000,
+		band.AS_923_3: -6600000,
+		band.AS_923_4: -5900000,
+	} {
+		t.Run(id, func(t *testing.T) {
+			a := assertions.New(t)
+			b, err := band.GetByID(id)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(b.UplinkChannels[0].Frequency, should.Equal, uint64(923200000+offset))
+			a.So(b.UplinkChannels[1].Frequency, should.Equal, uint64(923400000+offset))
+			a.So(b.DefaultRx2Parameters.Frequency, should.Equal, uint64(923200000+offset))
+			a.So(b.Beacon.BroadcastChannel(0), should.Equal, uint32(923400000+offset))
+		})
+	}
 }
 
 func TestUnsupportedBand(t *testing.T) {
@@ -72,4 +132,12 @@ func TestUnsupportedBand(t *testing.T) {
 	if !a.So(err, should.NotBeNil) {
 		t.Log("LoRaWAN Regional Parameters 1.0 is not supported for the Indian band")
 	}
+
+	b, err = band.GetByID(band.AS_923_2)
+	a.So(err, should.BeNil)
+
+	_, err = b.Version(ttnpb.PHY_V1_1_REV_B)
+	if !a.So(err, should.NotBeNil) {
+		t.Log("LoRaWAN Regional Parameters 1.1 is not supported for AS923-2")
+	}
 }
@@ -137,11 +137,14 @@ func init() {
 			PingSlotChannels: []uint32{eu433BeaconChannel},
 		},
 
-		regionalParameters1_0:       bandIdentity,
-		regionalParameters1_0_1:     bandIdentity,
-		regionalParameters1_0_2RevA: bandIdentity,
-		regionalParameters1_0_2RevB: bandIdentity,
-		regionalParameters1_1RevA:   bandIdentity,
+		regionalParameters1_0:         bandIdentity,
+		regionalParameters1_0_1:       bandIdentity,
+		regionalParameters1_0_2RevA:   bandIdentity,
+		regionalParameters1_0_2RevB:   bandIdentity,
+		regionalParameters1_0_3RevA:   bandIdentity,
+		regionalParameters1_1RevA:     bandIdentity,
+		regionalParameters1_1RevB:     bandIdentity,
+		regionalParametersRP002_1_0_0: bandIdentity,
 	}
 	All[EU_433] = eu_433
 }
@@ -174,11 +174,14 @@ func init() {
 			PingSlotChannels: []uint32{euBeaconChannel},
 		},
 
-		regionalParameters1_0:       bandIdentity,
-		regionalParameters1_0_1:     bandIdentity,
-		regionalParameters1_0_2RevA: bandIdentity,
-		regionalParameters1_0_2RevB: bandIdentity,
-		regionalParameters1_1RevA:   bandIdentity,
+		regionalParameters1_0:         bandIdentity,
+		regionalParameters1_0_1:       bandIdentity,
+		regionalParameters1_0_2RevA:   bandIdentity,
+		regionalParameters1_0_2RevB:   bandIdentity,
+		regionalParameters1_0_3RevA:   bandIdentity,
+		regionalParameters1_1RevA:     bandIdentity,
+		regionalParameters1_1RevB:     bandIdentity,
+		regionalParametersRP002_1_0_0: bandIdentity,
 	}
 	All[EU_863_870] = eu_863_870
 }
@@ -135,7 +135,10 @@ func init() {
 		// No LoRaWAN Regional Parameters 1.0
 		// No LoRaWAN Regional Parameters 1.0.1
 		// No LoRaWAN Regional Parameters 1.0.2
-		regionalParameters1_1RevA: bandIdentity,
+		regionalParameters1_0_3RevA:   bandIdentity,
+		regionalParameters1_1RevA:     bandIdentity,
+		regionalParameters1_1RevB:     bandIdentity,
+		regionalParametersRP002_1_0_0: bandIdentity,
 	}
 	All[RU_864_870] = ru_864_870
 }
@@ -93,27 +93,27 @@ func init() {
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 12,
 				Bandwidth:       500000,
-			}}}, DefaultMaxSize: constPayloadSizer(41)},
+			}}}, DefaultMaxSize: constPayloadSizer(61)},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 11,
 				Bandwidth:       500000,
-			}}}, DefaultMaxSize: constPayloadSizer(117)},
+			}}}, DefaultMaxSize: constPayloadSizer(137)},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 10,
 				Bandwidth:       500000,
-			}}}, DefaultMaxSize: constPayloadSizer(230)},
+			}}}, DefaultMaxSize: constPayloadSizer(250)},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 9,
 				Bandwidth:       500000,
-			}}}, DefaultMaxSize: constPayloadSizer(230)},
+			}}}, DefaultMaxSize: constPayloadSizer(250)},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 8,
 				Bandwidth:       500000,
-			}}}, DefaultMaxSize: constPayloadSizer(230)},
+			}}}, DefaultMaxSize: constPayloadSizer(250)},
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 7,
 				Bandwidth:       500000,
-			}}}, DefaultMaxSize: constPayloadSizer(230)},
+			}}}, DefaultMaxSize: constPayloadSizer(250)},
 			{}, // Used by LinkADRReq starting from LoRaWAN Regional Parameters 1.1, RFU before
 		},
 		MaxADRDataRateIndex: 3,
@@ -172,11 +172,14 @@ func init() {
 			PingSlotChannels: usAuBeaconFrequencies[:],
 		},
 
-		regionalParameters1_0:       bandIdentity,
-		regionalParameters1_0_1:     bandIdentity,
-		regionalParameters1_0_2RevA: usBeacon1_0_2,
-		regionalParameters1_0_2RevB: composeSwaps(disableCFList1_0_2, disableChMaskCntl51_0_2),
-		regionalParameters1_1RevA:   bandIdentity,
+		regionalParameters1_0:         bandIdentity,
+		regionalParameters1_0_1:       bandIdentity,
+		regionalParameters1_0_2RevA:   usBeacon1_0_2,
+		regionalParameters1_0_2RevB:   composeSwaps(disableCFList1_0_2, disableChMaskCntl51_0_2),
+		regionalParameters1_0_3RevA:   bandIdentity,
+		regionalParameters1_1RevA:     bandIdentity,
+		regionalParameters1_1RevB:     usAuDownlinkDataRates1_1,
+		regionalParametersRP002_1_0_0: bandIdentity,
 	}
 	All[US_902_928] = us_902_928
 }
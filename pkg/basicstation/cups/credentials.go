@@ -0,0 +1,52 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+var errInvalidTrust = errors.DefineInvalidArgument("invalid_trust", "invalid trust certificate in `{file}`")
+
+// readTrust reads the PEM encoded certificate from the given file and returns it DER encoded.
+func readTrust(file string) ([]byte, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errInvalidTrust.WithAttributes("file", file)
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return nil, errInvalidTrust.WithAttributes("file", file).WithCause(err)
+	}
+	return block.Bytes, nil
+}
+
+// TokenCredentials returns the Basic Station credentials consisting of the DER encoded trust certificate, without
+// client certificate, and the authorization header that the station sends to the server.
+func TokenCredentials(trust []byte, authorization string) []byte {
+	creds := make([]byte, 0, len(trust)+4+len(authorization)+17)
+	creds = append(creds, trust...)
+	creds = append(creds, 0x00, 0x00, 0x00, 0x00)
+	creds = append(creds, fmt.Sprintf("Authorization: %s\r\n", authorization)...)
+	return creds
+}
@@ -0,0 +1,93 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"gocloud.dev/blob"
+)
+
+const firmwareVersionMetadataKey = "version"
+
+// FirmwareKey returns the key of the firmware blob for the given station model.
+// The firmware version is stored in the version metadata of the blob.
+func FirmwareKey(model string) string {
+	return path.Join(model, "firmware")
+}
+
+// FirmwareSignatureKey returns the key of the signature blob of the firmware for the given station model, signed with
+// the key with the given CRC.
+func FirmwareSignatureKey(model string, keyCRC uint32) string {
+	return fmt.Sprintf("%s.sig-%08x", FirmwareKey(model), keyCRC)
+}
+
+type firmwareUpdate struct {
+	Version   string
+	KeyCRC    uint32
+	Signature []byte
+	Data      []byte
+}
+
+var errNoFirmwareSignature = errors.DefineNotFound(
+	"no_firmware_signature",
+	"no signature of firmware version `{version}` for model `{model}` with any of the station keys",
+)
+
+// getFirmwareUpdate returns the firmware update for the given station model from the bucket, or nil if the station
+// already runs the current version or if there is no firmware for the model.
+// The firmware must be signed with one of the keys of the station.
+func getFirmwareUpdate(ctx context.Context, bucket *blob.Bucket, model, version string, keyCRCs []uint32) (*firmwareUpdate, error) {
+	if model == "" {
+		return nil, nil
+	}
+	attrs, err := bucket.Attributes(ctx, FirmwareKey(model))
+	if err != nil {
+		if blob.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	update := &firmwareUpdate{
+		Version: attrs.Metadata[firmwareVersionMetadataKey],
+	}
+	if update.Version == "" || update.Version == version {
+		return nil, nil
+	}
+	for _, keyCRC := range keyCRCs {
+		sig, err := bucket.ReadAll(ctx, FirmwareSignatureKey(model, keyCRC))
+		if err != nil {
+			if blob.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		update.KeyCRC, update.Signature = keyCRC, sig
+		break
+	}
+	if update.Signature == nil {
+		return nil, errNoFirmwareSignature.WithAttributes(
+			"version", update.Version,
+			"model", model,
+		)
+	}
+	if update.Data, err = bucket.ReadAll(ctx, FirmwareKey(model)); err != nil {
+		return nil, err
+	}
+	return update, nil
+}
@@ -0,0 +1,157 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+var errInvalidRouter = errors.DefineInvalidArgument("invalid_router", "invalid router `{router}`")
+
+// EUI is an EUI that can be unmarshaled from the router formats used by Basic Station: a JSON number, an ID6 string
+// or a hexadecimal string.
+type EUI struct {
+	types.EUI64
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (eui *EUI) UnmarshalJSON(data []byte) error {
+	var n uint64
+	if err := json.Unmarshal(data, &n); err == nil {
+		binary.BigEndian.PutUint64(eui.EUI64[:], n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errInvalidRouter.WithAttributes("router", string(data)).WithCause(err)
+	}
+	if strings.Contains(s, ":") {
+		return eui.parseID6(s)
+	}
+	if err := eui.EUI64.UnmarshalText([]byte(strings.Replace(s, "-", "", -1))); err != nil {
+		return errInvalidRouter.WithAttributes("router", s).WithCause(err)
+	}
+	return nil
+}
+
+// parseID6 parses the ID6 representation of an EUI, which consists of four groups of 16 bits in hexadecimal
+// notation, separated by colons. Consecutive groups of zeros may be compressed to ::, like in IPv6 addresses.
+func (eui *EUI) parseID6(s string) error {
+	split := func(s string) []string {
+		if s == "" {
+			return nil
+		}
+		return strings.Split(s, ":")
+	}
+	var groups []string
+	switch parts := strings.Split(s, "::"); len(parts) {
+	case 1:
+		groups = split(s)
+	case 2:
+		head, tail := split(parts[0]), split(parts[1])
+		if len(head)+len(tail) > 3 {
+			return errInvalidRouter.WithAttributes("router", s)
+		}
+		groups = append(head, make([]string, 4-len(head)-len(tail))...)
+		groups = append(groups, tail...)
+	default:
+		return errInvalidRouter.WithAttributes("router", s)
+	}
+	if len(groups) != 4 {
+		return errInvalidRouter.WithAttributes("router", s)
+	}
+	for i, group := range groups {
+		if group == "" {
+			continue
+		}
+		v, err := strconv.ParseUint(group, 16, 16)
+		if err != nil {
+			return errInvalidRouter.WithAttributes("router", s).WithCause(err)
+		}
+		binary.BigEndian.PutUint16(eui.EUI64[2*i:], uint16(v))
+	}
+	return nil
+}
+
+// UpdateInfoRequest is the request of a gateway to the CUPS server to check for updates.
+type UpdateInfoRequest struct {
+	Router             EUI      `json:"router"`
+	CUPSURI            string   `json:"cupsUri"`
+	LNSURI             string   `json:"tcUri"`
+	CUPSCredentialsCRC uint32   `json:"cupsCredCrc"`
+	LNSCredentialsCRC  uint32   `json:"tcCredCrc"`
+	Station            string   `json:"station"`
+	Model              string   `json:"model"`
+	Package            string   `json:"package"`
+	KeyCRCs            []uint32 `json:"keys"`
+}
+
+// UpdateInfoResponse is the response of the CUPS server to an UpdateInfoRequest.
+// Empty fields are not updated by the gateway.
+type UpdateInfoResponse struct {
+	CUPSURI         string
+	LNSURI          string
+	CUPSCredentials []byte
+	LNSCredentials  []byte
+	SignatureKeyCRC uint32
+	Signature       []byte
+	UpdateData      []byte
+}
+
+var errFieldTooLong = errors.DefineInvalidArgument("field_too_long", "field `{field}` exceeds maximum length of `{max}` bytes")
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (r UpdateInfoResponse) MarshalBinary() ([]byte, error) {
+	for _, field := range []struct {
+		Name string
+		Len  int
+		Max  int
+	}{
+		{Name: "cups_uri", Len: len(r.CUPSURI), Max: 0xff},
+		{Name: "lns_uri", Len: len(r.LNSURI), Max: 0xff},
+		{Name: "cups_credentials", Len: len(r.CUPSCredentials), Max: 0xffff},
+		{Name: "lns_credentials", Len: len(r.LNSCredentials), Max: 0xffff},
+	} {
+		if field.Len > field.Max {
+			return nil, errFieldTooLong.WithAttributes("field", field.Name, "max", field.Max)
+		}
+	}
+	var b bytes.Buffer
+	b.WriteByte(byte(len(r.CUPSURI)))
+	b.WriteString(r.CUPSURI)
+	b.WriteByte(byte(len(r.LNSURI)))
+	b.WriteString(r.LNSURI)
+	binary.Write(&b, binary.LittleEndian, uint16(len(r.CUPSCredentials)))
+	b.Write(r.CUPSCredentials)
+	binary.Write(&b, binary.LittleEndian, uint16(len(r.LNSCredentials)))
+	b.Write(r.LNSCredentials)
+	if len(r.Signature) > 0 {
+		binary.Write(&b, binary.LittleEndian, uint32(4+len(r.Signature)))
+		binary.Write(&b, binary.LittleEndian, r.SignatureKeyCRC)
+		b.Write(r.Signature)
+	} else {
+		binary.Write(&b, binary.LittleEndian, uint32(0))
+	}
+	binary.Write(&b, binary.LittleEndian, uint32(len(r.UpdateData)))
+	b.Write(r.UpdateData)
+	return b.Bytes(), nil
+}
@@ -0,0 +1,161 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/basicstation/cups"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestUnmarshalRouter(t *testing.T) {
+	for _, tc := range []struct {
+		JSON  string
+		EUI   types.EUI64
+		Error bool
+	}{
+		{
+			JSON: `{"router":"b827:ebff:fe61:51e1"}`,
+			EUI:  types.EUI64{0xb8, 0x27, 0xeb, 0xff, 0xfe, 0x61, 0x51, 0xe1},
+		},
+		{
+			JSON: `{"router":"::1"}`,
+			EUI:  types.EUI64{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+		},
+		{
+			JSON: `{"router":"1::"}`,
+			EUI:  types.EUI64{0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			JSON: `{"router":"1:2::4"}`,
+			EUI:  types.EUI64{0x00, 0x01, 0x00, 0x02, 0x00, 0x00, 0x00, 0x04},
+		},
+		{
+			JSON: `{"router":"b827ebfffe6151e1"}`,
+			EUI:  types.EUI64{0xb8, 0x27, 0xeb, 0xff, 0xfe, 0x61, 0x51, 0xe1},
+		},
+		{
+			JSON: `{"router":"b8-27-eb-ff-fe-61-51-e1"}`,
+			EUI:  types.EUI64{0xb8, 0x27, 0xeb, 0xff, 0xfe, 0x61, 0x51, 0xe1},
+		},
+		{
+			JSON: `{"router":13269834311787434465}`,
+			EUI:  types.EUI64{0xb8, 0x27, 0xeb, 0xff, 0xfe, 0x61, 0x51, 0xe1},
+		},
+		{
+			JSON:  `{"router":"1:2:3"}`,
+			Error: true,
+		},
+		{
+			JSON:  `{"router":"1::2::3"}`,
+			Error: true,
+		},
+		{
+			JSON:  `{"router":"1:2:3:4::5"}`,
+			Error: true,
+		},
+		{
+			JSON:  `{"router":"xyz"}`,
+			Error: true,
+		},
+	} {
+		t.Run(tc.JSON, func(t *testing.T) {
+			a := assertions.New(t)
+			var req UpdateInfoRequest
+			err := json.Unmarshal([]byte(tc.JSON), &req)
+			if tc.Error {
+				a.So(err, should.NotBeNil)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(req.Router.EUI64, should.Equal, tc.EUI)
+		})
+	}
+}
+
+func TestMarshalUpdateInfoResponse(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Response UpdateInfoResponse
+		Expected []byte
+		Error    bool
+	}{
+		{
+			Name: "Empty",
+			Expected: []byte{
+				0x00,
+				0x00,
+				0x00, 0x00,
+				0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00,
+			},
+		},
+		{
+			Name: "LNS",
+			Response: UpdateInfoResponse{
+				LNSURI:         "wss://lns",
+				LNSCredentials: []byte{0x01, 0x02, 0x03},
+			},
+			Expected: []byte{
+				0x00,
+				0x09, 'w', 's', 's', ':', '/', '/', 'l', 'n', 's',
+				0x00, 0x00,
+				0x03, 0x00, 0x01, 0x02, 0x03,
+				0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00,
+			},
+		},
+		{
+			Name: "Update",
+			Response: UpdateInfoResponse{
+				CUPSURI:         "https://cups",
+				SignatureKeyCRC: 0x04030201,
+				Signature:       []byte{0xaa, 0xbb},
+				UpdateData:      []byte{0x11, 0x22, 0x33},
+			},
+			Expected: []byte{
+				0x0c, 'h', 't', 't', 'p', 's', ':', '/', '/', 'c', 'u', 'p', 's',
+				0x00,
+				0x00, 0x00,
+				0x00, 0x00,
+				0x06, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03, 0x04, 0xaa, 0xbb,
+				0x03, 0x00, 0x00, 0x00, 0x11, 0x22, 0x33,
+			},
+		},
+		{
+			Name: "TooLongURI",
+			Response: UpdateInfoResponse{
+				LNSURI: string(make([]byte, 256)),
+			},
+			Error: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			b, err := tc.Response.MarshalBinary()
+			if tc.Error {
+				a.So(err, should.NotBeNil)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(b, should.Resemble, tc.Expected)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cups implements the Configuration and Update Server (CUPS) of LoRa Basic Station gateways.
+package cups
+
+import (
+	"context"
+	"encoding/json"
+	"hash/crc32"
+	"net/http"
+	"strings"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/labstack/echo"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	ttnblob "go.thethings.network/lorawan-stack/pkg/blob"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	web_errors "go.thethings.network/lorawan-stack/pkg/errors/web"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/web"
+	"google.golang.org/grpc/metadata"
+)
+
+// Config is the configuration of the CUPS server.
+type Config struct {
+	LNSURI               string            `name:"lns-uri" description:"URI of the LoRa Basic Station LNS that gateways connect to, unless the Gateway Server address of the gateway is a WebSocket URI"`
+	LNSTrust             string            `name:"lns-trust" description:"Path to the PEM encoded CA certificate that gateways use to verify the LNS connection"`
+	UpdateChannelBuckets map[string]string `name:"update-channel-buckets" description:"Blob buckets with firmware updates per update channel"`
+}
+
+// Attributes of the gateway in which the versions reported by the station are recorded.
+const (
+	StationAttribute = "cups-station"
+	ModelAttribute   = "cups-model"
+	PackageAttribute = "cups-package"
+)
+
+// Server is the CUPS server.
+type Server struct {
+	component *component.Component
+	config    Config
+	lnsTrust  []byte
+}
+
+// NewServer returns a new CUPS server.
+func NewServer(c *component.Component, conf Config) (*Server, error) {
+	s := &Server{
+		component: c,
+		config:    conf,
+	}
+	if conf.LNSTrust != "" {
+		trust, err := readTrust(conf.LNSTrust)
+		if err != nil {
+			return nil, err
+		}
+		s.lnsTrust = trust
+	}
+	return s, nil
+}
+
+// RegisterRoutes implements web.Registerer.
+func (s *Server) RegisterRoutes(server *web.Server) {
+	server.POST("/update-info", s.UpdateInfo, s.handleError())
+}
+
+func (s *Server) handleError() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			err := next(c)
+			if err == nil || c.Response().Committed {
+				return err
+			}
+			log.FromContext(c.Request().Context()).WithError(err).Debug("CUPS request failed")
+			statusCode, err := web_errors.ProcessError(err)
+			return c.String(statusCode, err.Error())
+		}
+	}
+}
+
+var (
+	errInvalidRequest         = errors.DefineInvalidArgument("invalid_request", "invalid update info request")
+	errEntityRegistryNotFound = errors.DefineNotFound("entity_registry_not_found", "Entity Registry not found")
+)
+
+// UpdateInfo handles the update info request of a gateway. The gateway is identified by the router EUI and
+// authenticated by the Authorization header that it sends. The response contains the LNS URI and credentials if they
+// changed, and the firmware update from the bucket of the update channel of the gateway if it has automatic updates
+// enabled and the station runs a different version.
+func (s *Server) UpdateInfo(c echo.Context) error {
+	ctx := s.component.FillContext(c.Request().Context())
+	var req UpdateInfoRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+		return errInvalidRequest.WithCause(err)
+	}
+
+	er := s.component.GetPeer(ctx, ttnpb.PeerInfo_ENTITY_REGISTRY, nil)
+	if er == nil {
+		return errEntityRegistryNotFound
+	}
+	client := ttnpb.NewGatewayRegistryClient(er.Conn())
+	ids, err := client.GetIdentifiersForEUI(ctx, &ttnpb.GetGatewayIdentifiersForEUIRequest{
+		EUI: req.Router.EUI64,
+	}, s.component.WithClusterAuth())
+	if err != nil {
+		return err
+	}
+	ctx = log.NewContextWithField(ctx, "gateway_uid", ids.GatewayID)
+
+	authorization := c.Request().Header.Get(echo.HeaderAuthorization)
+	md := metadata.New(map[string]string{
+		"id":            ids.GatewayID,
+		"authorization": authorization,
+	})
+	if ctxMd, ok := metadata.FromIncomingContext(ctx); ok {
+		md = metadata.Join(ctxMd, md)
+	}
+	ctx = metadata.NewIncomingContext(ctx, md)
+	if err := rights.RequireGateway(ctx, *ids, ttnpb.RIGHT_GATEWAY_INFO); err != nil {
+		return err
+	}
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, s.component.AllowInsecureForCredentials())
+	if err != nil {
+		return err
+	}
+	gtw, err := client.Get(ctx, &ttnpb.GetGatewayRequest{
+		GatewayIdentifiers: *ids,
+		FieldMask: pbtypes.FieldMask{
+			Paths: []string{
+				"attributes",
+				"auto_update",
+				"gateway_server_address",
+				"update_channel",
+			},
+		},
+	}, callOpt)
+	if err != nil {
+		return err
+	}
+
+	logger := log.FromContext(ctx)
+	if gtw.Attributes[StationAttribute] != req.Station ||
+		gtw.Attributes[ModelAttribute] != req.Model ||
+		gtw.Attributes[PackageAttribute] != req.Package {
+		attributes := make(map[string]string, len(gtw.Attributes)+3)
+		for k, v := range gtw.Attributes {
+			attributes[k] = v
+		}
+		attributes[StationAttribute] = req.Station
+		attributes[ModelAttribute] = req.Model
+		attributes[PackageAttribute] = req.Package
+		if _, err := client.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: *ids,
+				Attributes:         attributes,
+			},
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{"attributes"},
+			},
+		}, callOpt); err != nil {
+			// Recording the versions requires the GATEWAY_SETTINGS_BASIC right, which stations may not have.
+			logger.WithError(err).Warn("Failed to record station version, API key may lack the GATEWAY_SETTINGS_BASIC right")
+		}
+	}
+
+	var res UpdateInfoResponse
+	if uri := s.lnsURI(gtw.GatewayServerAddress); uri != "" && uri != req.LNSURI {
+		res.LNSURI = uri
+	}
+	if creds := TokenCredentials(s.lnsTrust, authorization); crc32.ChecksumIEEE(creds) != req.LNSCredentialsCRC {
+		res.LNSCredentials = creds
+	}
+	if gtw.AutoUpdate {
+		update, err := s.firmwareUpdate(ctx, gtw.UpdateChannel, req)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to get firmware update")
+		} else if update != nil {
+			logger.WithFields(log.Fields(
+				"model", req.Model,
+				"version", update.Version,
+			)).Info("Send firmware update")
+			res.SignatureKeyCRC, res.Signature, res.UpdateData = update.KeyCRC, update.Signature, update.Data
+		}
+	}
+
+	b, err := res.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return c.Blob(http.StatusOK, echo.MIMEOctetStream, b)
+}
+
+func (s *Server) firmwareUpdate(ctx context.Context, channel string, req UpdateInfoRequest) (*firmwareUpdate, error) {
+	bucketName, ok := s.config.UpdateChannelBuckets[channel]
+	if !ok {
+		return nil, nil
+	}
+	bucket, err := ttnblob.Config(s.component.GetBaseConfig(ctx).Blob).GetBucket(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	return getFirmwareUpdate(ctx, bucket, req.Model, req.Package, req.KeyCRCs)
+}
+
+// lnsURI returns the LNS URI for the given Gateway Server address.
+// If the address is a WebSocket URI, it is the LNS URI of the gateway. Otherwise, the configured LNS URI is used.
+func (s *Server) lnsURI(address string) string {
+	if strings.HasPrefix(address, "ws://") || strings.HasPrefix(address, "wss://") {
+		return address
+	}
+	return s.config.LNSURI
+}
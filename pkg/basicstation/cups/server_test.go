@@ -0,0 +1,301 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"hash/crc32"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo"
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/basicstation/cups"
+	ttnblob "go.thethings.network/lorawan-stack/pkg/blob"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	gatewayEUI = types.EUI64{0xb8, 0x27, 0xeb, 0xff, 0xfe, 0x61, 0x51, 0xe1}
+	gatewayIDs = ttnpb.GatewayIdentifiers{
+		GatewayID: "test-gateway",
+		EUI:       &gatewayEUI,
+	}
+	gatewayAuth = "Bearer secret"
+)
+
+type mockIS struct {
+	ttnpb.GatewayRegistryServer
+	ttnpb.GatewayAccessServer
+	gateway *ttnpb.Gateway
+}
+
+func startMockIS(ctx context.Context, gtw *ttnpb.Gateway) (*mockIS, string) {
+	is := &mockIS{
+		gateway: gtw,
+	}
+	srv := rpcserver.New(ctx)
+	ttnpb.RegisterGatewayRegistryServer(srv.Server, is)
+	ttnpb.RegisterGatewayAccessServer(srv.Server, is)
+	lis, err := net.Listen("tcp", ":0")
+	if err != nil {
+		panic(err)
+	}
+	go srv.Serve(lis)
+	return is, lis.Addr().String()
+}
+
+var errNotFound = errors.DefineNotFound("not_found", "not found")
+
+func (is *mockIS) GetIdentifiersForEUI(ctx context.Context, req *ttnpb.GetGatewayIdentifiersForEUIRequest) (*ttnpb.GatewayIdentifiers, error) {
+	if req.EUI != gatewayEUI {
+		return nil, errNotFound
+	}
+	return &gatewayIDs, nil
+}
+
+func (is *mockIS) Get(ctx context.Context, req *ttnpb.GetGatewayRequest) (*ttnpb.Gateway, error) {
+	if req.GatewayID != gatewayIDs.GatewayID {
+		return nil, errNotFound
+	}
+	return is.gateway, nil
+}
+
+func (is *mockIS) Update(ctx context.Context, req *ttnpb.UpdateGatewayRequest) (*ttnpb.Gateway, error) {
+	if req.GatewayID != gatewayIDs.GatewayID {
+		return nil, errNotFound
+	}
+	if err := is.gateway.SetFields(&req.Gateway, req.FieldMask.Paths...); err != nil {
+		return nil, err
+	}
+	return is.gateway, nil
+}
+
+func (is *mockIS) ListRights(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*ttnpb.Rights, error) {
+	res := &ttnpb.Rights{}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || ids.GatewayID != gatewayIDs.GatewayID {
+		return res, nil
+	}
+	if authorization := md["authorization"]; len(authorization) > 0 && authorization[0] == gatewayAuth {
+		res.Rights = append(res.Rights, ttnpb.RIGHT_GATEWAY_INFO, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC)
+	}
+	return res, nil
+}
+
+func TestUpdateInfo(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	blobDir, err := ioutil.TempDir("", "cups")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(blobDir)
+	blobConf := config.Blob{Provider: "local"}
+	blobConf.Local.Directory = blobDir
+	bucket, err := ttnblob.Config(blobConf).GetBucket(ctx, "stable")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	firmware := []byte{0x01, 0x02, 0x03, 0x04}
+	signature := []byte{0xaa, 0xbb, 0xcc}
+	a.So(bucket.WriteAll(ctx, FirmwareKey("corecell"), firmware, ttnblob.WriterOptions("application/octet-stream", "version", "2.0.1")), should.BeNil)
+	a.So(bucket.WriteAll(ctx, FirmwareSignatureKey("corecell", 0x42), signature, nil), should.BeNil)
+
+	is, isAddr := startMockIS(ctx, &ttnpb.Gateway{
+		GatewayIdentifiers:   gatewayIDs,
+		GatewayServerAddress: "gs.example.com:8887",
+		AutoUpdate:           true,
+		UpdateChannel:        "stable",
+		Attributes: map[string]string{
+			"foo": "bar",
+		},
+	})
+
+	c := component.MustNew(test.GetLogger(t), &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: config.Cluster{
+				IdentityServer: isAddr,
+			},
+			Blob: blobConf,
+		},
+	})
+	test.Must(nil, c.Start())
+	defer c.Close()
+	for i := 0; i < 20 && c.GetPeer(ctx, ttnpb.PeerInfo_ENTITY_REGISTRY, nil) == nil; i++ {
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	s, err := NewServer(c, Config{
+		LNSURI: "wss://lns.example.com:8887",
+		UpdateChannelBuckets: map[string]string{
+			"stable": "stable",
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	lnsCreds := TokenCredentials(nil, gatewayAuth)
+
+	for _, tc := range []struct {
+		Name                 string
+		GatewayServerAddress string
+		Authorization        string
+		Request              UpdateInfoRequest
+		StatusCode           int
+		Response             UpdateInfoResponse
+	}{
+		{
+			Name:          "Unauthorized",
+			Authorization: "Bearer other",
+			Request: UpdateInfoRequest{
+				Router: EUI{gatewayEUI},
+			},
+			StatusCode: http.StatusForbidden,
+		},
+		{
+			Name:          "UnknownRouter",
+			Authorization: gatewayAuth,
+			Request: UpdateInfoRequest{
+				Router: EUI{types.EUI64{0x01}},
+			},
+			StatusCode: http.StatusNotFound,
+		},
+		{
+			Name:          "Initial",
+			Authorization: gatewayAuth,
+			Request: UpdateInfoRequest{
+				Router:  EUI{gatewayEUI},
+				Station: "2.0.0(corecell/std)",
+				Model:   "corecell",
+				Package: "2.0.0",
+				KeyCRCs: []uint32{0x41, 0x42},
+			},
+			StatusCode: http.StatusOK,
+			Response: UpdateInfoResponse{
+				LNSURI:          "wss://lns.example.com:8887",
+				LNSCredentials:  lnsCreds,
+				SignatureKeyCRC: 0x42,
+				Signature:       signature,
+				UpdateData:      firmware,
+			},
+		},
+		{
+			Name:          "UpToDate",
+			Authorization: gatewayAuth,
+			Request: UpdateInfoRequest{
+				Router:            EUI{gatewayEUI},
+				LNSURI:            "wss://lns.example.com:8887",
+				LNSCredentialsCRC: crc32.ChecksumIEEE(lnsCreds),
+				Station:           "2.0.1(corecell/std)",
+				Model:             "corecell",
+				Package:           "2.0.1",
+				KeyCRCs:           []uint32{0x42},
+			},
+			StatusCode: http.StatusOK,
+		},
+		{
+			Name:          "NoSignature",
+			Authorization: gatewayAuth,
+			Request: UpdateInfoRequest{
+				Router:            EUI{gatewayEUI},
+				LNSURI:            "wss://lns.example.com:8887",
+				LNSCredentialsCRC: crc32.ChecksumIEEE(lnsCreds),
+				Station:           "2.0.0(corecell/std)",
+				Model:             "corecell",
+				Package:           "2.0.0",
+				KeyCRCs:           []uint32{0x41},
+			},
+			StatusCode: http.StatusOK,
+		},
+		{
+			Name:                 "GatewayLNSURI",
+			GatewayServerAddress: "wss://gs.example.com:8887",
+			Authorization:        gatewayAuth,
+			Request: UpdateInfoRequest{
+				Router:            EUI{gatewayEUI},
+				LNSURI:            "wss://lns.example.com:8887",
+				LNSCredentialsCRC: crc32.ChecksumIEEE(lnsCreds),
+				Station:           "2.0.1(corecell/std)",
+				Model:             "corecell",
+				Package:           "2.0.1",
+				KeyCRCs:           []uint32{0x42},
+			},
+			StatusCode: http.StatusOK,
+			Response: UpdateInfoResponse{
+				LNSURI: "wss://gs.example.com:8887",
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			is.gateway.GatewayServerAddress = "gs.example.com:8887"
+			if tc.GatewayServerAddress != "" {
+				is.gateway.GatewayServerAddress = tc.GatewayServerAddress
+			}
+
+			body, err := json.Marshal(tc.Request)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			req := httptest.NewRequest(http.MethodPost, "/update-info", bytes.NewReader(body)).WithContext(ctx)
+			req.Header.Set(echo.HeaderAuthorization, tc.Authorization)
+			rec := httptest.NewRecorder()
+			e := echo.New()
+			err = s.UpdateInfo(e.NewContext(req, rec))
+			if tc.StatusCode != http.StatusOK {
+				if !a.So(err, should.NotBeNil) {
+					t.FailNow()
+				}
+				a.So(errors.ToHTTPStatusCode(err), should.Equal, tc.StatusCode)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(rec.Code, should.Equal, tc.StatusCode)
+			expected, err := tc.Response.MarshalBinary()
+			a.So(err, should.BeNil)
+			a.So(rec.Body.Bytes(), should.Resemble, expected)
+			a.So(is.gateway.Attributes, should.Resemble, map[string]string{
+				"foo":            "bar",
+				StationAttribute: tc.Request.Station,
+				ModelAttribute:   tc.Request.Model,
+				PackageAttribute: tc.Request.Package,
+			})
+		})
+	}
+}
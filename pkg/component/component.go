@@ -32,6 +32,8 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/log/middleware/sentry"
+	"go.thethings.network/lorawan-stack/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/rpcserver"
 	"go.thethings.network/lorawan-stack/pkg/web"
 	"google.golang.org/grpc"
@@ -74,6 +76,9 @@ type Component struct {
 
 	rightsFetcher rights.Fetcher
 
+	rateLimiter    ratelimit.Interface
+	trustedProxies rpcmetadata.TrustedProxies
+
 	tasks []task
 }
 
@@ -133,7 +138,19 @@ func New(logger log.Stack, config *Config, opts ...Option) (*Component, error) {
 		c.clusterNew = cluster.New
 	}
 
-	c.web, err = web.New(c.ctx, config.HTTP)
+	c.rateLimiter, err = ratelimit.New(config.RateLimiting, config.Redis)
+	if err != nil {
+		return nil, err
+	}
+
+	c.web, err = web.New(c.ctx, config.HTTP, web.WithRateLimiter(c.rateLimiter))
+	if err != nil {
+		return nil, err
+	}
+
+	// Calls of the HTTP API reach the gRPC server through the gRPC gateway, which forwards the
+	// X-Forwarded-For header, so the proxies trusted by the HTTP server are trusted by the gRPC server too.
+	c.trustedProxies, err = rpcmetadata.ParseTrustedProxies(append(append([]string{}, config.GRPC.TrustedProxies...), config.HTTP.TrustedProxies...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -160,6 +177,11 @@ func (c *Component) Logger() log.Stack {
 	return c.logger
 }
 
+// RateLimiter returns the rate limiter of the component.
+func (c *Component) RateLimiter() ratelimit.Interface {
+	return c.rateLimiter
+}
+
 // LogDebug returns whether the component should log debug messages.
 func (c *Component) LogDebug() bool {
 	return c.config.Log.Level == log.DebugLevel
@@ -23,6 +23,7 @@ import (
 	"github.com/labstack/echo"
 	"github.com/labstack/echo/middleware"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/pkg/rpcserver"
@@ -35,8 +36,9 @@ func (c *Component) initGRPC() {
 
 	c.grpc = rpcserver.New(
 		c.ctx,
-		rpcserver.WithContextFiller(c.FillContext),
+		rpcserver.WithContextFiller(c.FillContext, rpcmetadata.RemoteIPFiller(c.trustedProxies)),
 		rpcserver.WithSentry(c.sentry),
+		rpcserver.WithRateLimiter(c.rateLimiter),
 	)
 }
 
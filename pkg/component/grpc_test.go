@@ -82,6 +82,14 @@ func (gs *gsImplementation) GetGatewayConnectionStats(ctx context.Context, _ *tt
 	return &ttnpb.GatewayConnectionStats{}, nil
 }
 
+func (gs *gsImplementation) ListConnectedGateways(context.Context, *ttnpb.ListConnectedGatewaysRequest) (*ttnpb.ConnectedGateways, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (gs *gsImplementation) GetGatewayStatusHistory(context.Context, *ttnpb.GetGatewayStatusHistoryRequest) (*ttnpb.GatewayStatusHistory, error) {
+	return nil, errors.New("not implemented")
+}
+
 func TestHooks(t *testing.T) {
 	a := assertions.New(t)
 
@@ -59,8 +59,9 @@ type Cluster struct {
 type GRPC struct {
 	AllowInsecureForCredentials bool `name:"allow-insecure-for-credentials" description:"Allow transmission of credentials over insecure transport"`
 
-	Listen    string `name:"listen" description:"Address for the TCP gRPC server to listen on"`
-	ListenTLS string `name:"listen-tls" description:"Address for the TLS gRPC server to listen on"`
+	Listen         string   `name:"listen" description:"Address for the TCP gRPC server to listen on"`
+	ListenTLS      string   `name:"listen-tls" description:"Address for the TLS gRPC server to listen on"`
+	TrustedProxies []string `name:"trusted-proxies" description:"CIDRs of trusted reverse proxies and cluster components that set X-Forwarded-For"`
 }
 
 // Cookie represents cookie configuration.
@@ -92,12 +93,13 @@ type HTTPStaticConfig struct {
 
 // HTTP represents the HTTP and HTTPS server configuration.
 type HTTP struct {
-	Listen    string           `name:"listen" description:"Address for the HTTP server to listen on"`
-	ListenTLS string           `name:"listen-tls" description:"Address for the HTTPS server to listen on"`
-	Static    HTTPStaticConfig `name:"static"`
-	Cookie    Cookie           `name:"cookie"`
-	PProf     PProf            `name:"pprof"`
-	Metrics   Metrics          `name:"metrics"`
+	Listen         string           `name:"listen" description:"Address for the HTTP server to listen on"`
+	ListenTLS      string           `name:"listen-tls" description:"Address for the HTTPS server to listen on"`
+	TrustedProxies []string         `name:"trusted-proxies" description:"CIDRs of trusted reverse proxies that set X-Forwarded-For"`
+	Static         HTTPStaticConfig `name:"static"`
+	Cookie         Cookie           `name:"cookie"`
+	PProf          PProf            `name:"pprof"`
+	Metrics        Metrics          `name:"metrics"`
 }
 
 // Redis represents Redis configuration.
@@ -117,6 +119,27 @@ type Events struct {
 	Redis   Redis  `name:"redis"`
 }
 
+// RateLimitingProfile represents configuration for a rate limiting class.
+type RateLimitingProfile struct {
+	Name         string   `name:"name" description:"Rate limiting profile name"`
+	MaxPerMin    uint     `name:"max-per-min" description:"Maximum allowed rate (per minute)"`
+	MaxBurst     uint     `name:"max-burst" description:"Maximum rate allowed for short bursts"`
+	Associations []string `name:"associations" description:"List of classes to apply this profile on"`
+}
+
+// RateLimitingMemory represents configuration for the in-memory rate limiting store.
+type RateLimitingMemory struct {
+	MaxSize uint `name:"max-size" description:"Maximum number of rate limiting keys kept in memory"`
+}
+
+// RateLimiting represents configuration for rate limiting.
+type RateLimiting struct {
+	Provider string                `name:"provider" description:"Rate limiting store provider (memory, redis)"`
+	Memory   RateLimitingMemory    `name:"memory"`
+	Redis    Redis                 `name:"redis"`
+	Profiles []RateLimitingProfile `name:"profiles" description:"Rate limiting profiles" file-only:"true"`
+}
+
 // Rights represents the configuration to apply when fetching entity rights.
 type Rights struct {
 	// TTL is the duration that entries will remain in the cache before being
@@ -235,4 +258,5 @@ type ServiceBase struct {
 	DeviceRepository DeviceRepositoryConfig `name:"device-repository" description:"Source of the device repository"`
 	Rights           Rights                 `name:"rights"`
 	KeyVault         KeyVault               `name:"key-vault"`
+	RateLimiting     RateLimiting           `name:"rate-limiting"`
 }
@@ -38,6 +38,7 @@ func TestCodes(t *testing.T) {
 
 	a.So(errors.IsCanceled(context.Canceled), should.BeTrue)
 	a.So(errors.IsDeadlineExceeded(context.DeadlineExceeded), should.BeTrue)
+	a.So(errors.IsDeadlineExceeded(errors.DefineDeadlineExceeded("test_codes_deadline_exceeded", "")), should.BeTrue)
 	a.So(errors.IsInvalidArgument(errors.DefineInvalidArgument("test_codes_invalid_argument", "")), should.BeTrue)
 	a.So(errors.IsNotFound(errors.DefineNotFound("test_codes_not_found", "")), should.BeTrue)
 	a.So(errors.IsAlreadyExists(errors.DefineAlreadyExists("test_codes_already_exists", "")), should.BeTrue)
@@ -163,7 +163,12 @@ func DefineInvalidArgument(name, messageFormat string, publicAttributes ...strin
 	return def
 }
 
-// DeadlineExceeded - not used for now; should be created by expiring context.
+// DefineDeadlineExceeded defines a registered error of type DeadlineExceeded.
+// Errors of expiring contexts are not defined, but returned by the context.
+func DefineDeadlineExceeded(name, messageFormat string, publicAttributes ...string) Definition {
+	def := define(uint32(codes.DeadlineExceeded), name, messageFormat, publicAttributes...)
+	return def
+}
 
 // DefineNotFound defines a registered error of type NotFound.
 func DefineNotFound(name, messageFormat string, publicAttributes ...string) Definition {
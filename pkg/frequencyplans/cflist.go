@@ -54,7 +54,7 @@ func chMaskCFList(fp FrequencyPlan, band band.Band) *ttnpb.CFList {
 	}
 
 	for _, bandChannel := range band.UplinkChannels {
-		var channelEnabled bool
+		channelEnabled := fp.LoRaStandardChannel != nil && fp.LoRaStandardChannel.Frequency == bandChannel.Frequency
 		for _, fpChannel := range fp.UplinkChannels {
 			if fpChannel.Frequency == bandChannel.Frequency {
 				channelEnabled = true
@@ -81,11 +81,12 @@ func TestChannelMasksCFList(t *testing.T) {
 			{Frequency: 905100000},
 			{Frequency: 905300000},
 		},
+		LoRaStandardChannel: &frequencyplans.LoRaStandardChannel{Frequency: 904600000},
 	}
 
 	cfList := frequencyplans.CFList(usFP, ttnpb.PHY_V1_1_REV_B)
 
-	enabledChannels := []int{8, 9, 10, 11, 12, 13, 14, 15}
+	enabledChannels := []int{8, 9, 10, 11, 12, 13, 14, 15, 65}
 chMaskLoop:
 	for index, chMaskEntry := range cfList.ChMasks {
 		for _, enabledChannel := range enabledChannels {
@@ -118,3 +119,33 @@ func TestUnimplementedCFList(t *testing.T) {
 	cfList := frequencyplans.CFList(usFP, ttnpb.PHY_V1_0)
 	a.So(cfList, should.BeNil)
 }
+
+func TestAUChannelMasksCFList(t *testing.T) {
+	a := assertions.New(t)
+
+	auFP := frequencyplans.FrequencyPlan{
+		BandID: "AU_915_928",
+		UplinkChannels: []frequencyplans.Channel{
+			{Frequency: 916800000},
+			{Frequency: 917000000},
+			{Frequency: 917200000},
+			{Frequency: 917400000},
+			{Frequency: 917600000},
+			{Frequency: 917800000},
+			{Frequency: 918000000},
+			{Frequency: 918200000},
+		},
+		LoRaStandardChannel: &frequencyplans.LoRaStandardChannel{Frequency: 917500000},
+	}
+
+	cfList := frequencyplans.CFList(auFP, ttnpb.PHY_V1_1_REV_B)
+	if !a.So(cfList, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(cfList.Type, should.Equal, ttnpb.CFListType_CHANNEL_MASKS)
+	for i, on := range cfList.ChMasks {
+		a.So(on, should.Equal, i >= 8 && i < 16 || i == 65)
+	}
+
+	a.So(frequencyplans.CFList(auFP, ttnpb.PHY_V1_0_2_REV_B), should.BeNil)
+}
@@ -14,7 +14,12 @@
 
 package gatewayserver
 
-import "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/basicstation/cups"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
+)
 
 // MQTTConfig contains MQTT configuration of the Gateway Server.
 type MQTTConfig struct {
@@ -28,11 +33,55 @@ type UDPConfig struct {
 	Listeners  map[string]string `name:"listeners" description:"Listen addresses with (optional) fallback frequency plan ID for non-registered gateways"`
 }
 
+// HistoryConfig defines the gateway status and traffic history configuration of the Gateway Server.
+type HistoryConfig struct {
+	Registry        GatewayStatusHistoryRegistry `name:"-"`
+	Retention       time.Duration                `name:"retention" description:"Time for which the status and traffic history of gateways is retained"`
+	TrafficInterval time.Duration                `name:"traffic-interval" description:"Interval at which the traffic of connected gateways is recorded in the history"`
+}
+
+// EmissionsConfig defines the storage of downlink emissions of the Gateway Server, used to share the duty-cycle
+// utilization of gateways across reconnects and Gateway Server instances.
+type EmissionsConfig struct {
+	Registry     EmissionRegistry `name:"-"`
+	Enable       bool             `name:"enable" description:"Store emissions to share the duty-cycle utilization of gateways"`
+	SyncInterval time.Duration    `name:"sync-interval" description:"Interval at which the emissions of other connections of the same gateway are loaded"`
+}
+
+// UplinkFilterConfig defines the uplink messages that the Gateway Server accepts. Empty lists accept all messages.
+// The filter is overridden per gateway by the gateway attributes.
+type UplinkFilterConfig struct {
+	NetIDs          []string `name:"net-ids" description:"NetIDs of the devices of which data uplink messages are accepted"`
+	DevAddrPrefixes []string `name:"dev-addr-prefixes" description:"DevAddr prefixes of the devices of which data uplink messages are accepted"`
+	JoinEUIPrefixes []string `name:"join-eui-prefixes" description:"JoinEUI prefixes of the devices of which join-request messages are accepted"`
+}
+
+// ForwardConfig defines the forwarding of data uplink messages of foreign devices to the Network Servers of partner
+// networks.
+type ForwardConfig struct {
+	Targets  map[string][]string `name:"targets" description:"Forward data uplink messages of the NetIDs and DevAddr prefixes to the Network Server at the address"`
+	Keys     map[string]string   `name:"keys" description:"Hex encoded cluster keys to authenticate with the Network Server at the address"`
+	Timeout  time.Duration       `name:"timeout" description:"Timeout of forwarding a data uplink message"`
+	Insecure bool                `name:"insecure" description:"Connect to the Network Servers without TLS (only for testing)"`
+}
+
 // Config represents the Gateway Server configuration.
 type Config struct {
 	RequireRegisteredGateways bool `name:"require-registered-gateways" description:"Require the gateways to be registered in the Identity Server"`
 
+	ConnectionStats GatewayConnectionStatsRegistry `name:"-"`
+
+	UpdateConnectionStatsInterval time.Duration `name:"update-connection-stats-interval" description:"Interval at which the connection stats of connected gateways are stored"`
+
+	History   HistoryConfig   `name:"history"`
+	Emissions EmissionsConfig `name:"emissions"`
+
+	Filter  UplinkFilterConfig `name:"filter"`
+	Forward ForwardConfig      `name:"forward"`
+
 	MQTT   MQTTConfig `name:"mqtt"`
 	MQTTV2 MQTTConfig `name:"mqtt-v2"`
 	UDP    UDPConfig  `name:"udp"`
+
+	CUPS cups.Config `name:"cups"`
 }
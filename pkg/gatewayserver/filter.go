@@ -0,0 +1,303 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Attributes of the gateway that override the uplink filter of the Gateway Server configuration.
+// The values are comma separated lists.
+const (
+	FilterNetIDsAttribute          = "uplink-filter-net-ids"
+	FilterDevAddrPrefixesAttribute = "uplink-filter-dev-addr-prefixes"
+	FilterJoinEUIPrefixesAttribute = "uplink-filter-join-eui-prefixes"
+)
+
+var (
+	errInvalidNetID         = errors.DefineInvalidArgument("invalid_net_id", "invalid NetID `{net_id}`")
+	errInvalidDevAddrPrefix = errors.DefineInvalidArgument("invalid_dev_addr_prefix", "invalid DevAddr prefix `{prefix}`")
+	errInvalidJoinEUIPrefix = errors.DefineInvalidArgument("invalid_join_eui_prefix", "invalid JoinEUI prefix `{prefix}`")
+	errUplinkNotAccepted    = errors.DefineFailedPrecondition("uplink_not_accepted", "uplink message not accepted by filter")
+)
+
+// netIDPrefix returns the DevAddr prefix that covers the device addresses of the given NetID.
+func netIDPrefix(netID types.NetID) (types.DevAddrPrefix, error) {
+	devAddr, err := types.NewDevAddr(netID, nil)
+	if err != nil {
+		return types.DevAddrPrefix{}, err
+	}
+	return types.DevAddrPrefix{
+		DevAddr: devAddr,
+		Length:  uint8(32 - types.NwkAddrBits(netID)),
+	}, nil
+}
+
+func parseNetIDPrefixes(netIDs []string) ([]types.DevAddrPrefix, error) {
+	prefixes := make([]types.DevAddrPrefix, 0, len(netIDs))
+	for _, s := range netIDs {
+		var netID types.NetID
+		if err := netID.UnmarshalText([]byte(s)); err != nil {
+			return nil, errInvalidNetID.WithAttributes("net_id", s).WithCause(err)
+		}
+		prefix, err := netIDPrefix(netID)
+		if err != nil {
+			return nil, errInvalidNetID.WithAttributes("net_id", s).WithCause(err)
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes, nil
+}
+
+func parseDevAddrPrefixes(prefixes []string) ([]types.DevAddrPrefix, error) {
+	res := make([]types.DevAddrPrefix, 0, len(prefixes))
+	for _, s := range prefixes {
+		var prefix types.DevAddrPrefix
+		if err := prefix.UnmarshalText([]byte(s)); err != nil {
+			return nil, errInvalidDevAddrPrefix.WithAttributes("prefix", s).WithCause(err)
+		}
+		res = append(res, prefix)
+	}
+	return res, nil
+}
+
+func parseJoinEUIPrefixes(prefixes []string) ([]types.EUI64Prefix, error) {
+	res := make([]types.EUI64Prefix, 0, len(prefixes))
+	for _, s := range prefixes {
+		var prefix types.EUI64Prefix
+		if err := prefix.UnmarshalText([]byte(s)); err != nil {
+			return nil, errInvalidJoinEUIPrefix.WithAttributes("prefix", s).WithCause(err)
+		}
+		res = append(res, prefix)
+	}
+	return res, nil
+}
+
+// parseNetIDsAndDevAddrPrefixes parses the values as NetIDs, or as DevAddr prefixes if they contain a prefix length.
+func parseNetIDsAndDevAddrPrefixes(values []string) ([]types.DevAddrPrefix, error) {
+	var netIDs, devAddrPrefixes []string
+	for _, v := range values {
+		if strings.Contains(v, "/") {
+			devAddrPrefixes = append(devAddrPrefixes, v)
+		} else {
+			netIDs = append(netIDs, v)
+		}
+	}
+	res, err := parseNetIDPrefixes(netIDs)
+	if err != nil {
+		return nil, err
+	}
+	prefixes, err := parseDevAddrPrefixes(devAddrPrefixes)
+	if err != nil {
+		return nil, err
+	}
+	return append(res, prefixes...), nil
+}
+
+// uplinkFilter filters uplink messages by DevAddr and JoinEUI. An empty list of prefixes accepts all messages.
+type uplinkFilter struct {
+	devAddrPrefixes []types.DevAddrPrefix
+	joinEUIPrefixes []types.EUI64Prefix
+}
+
+func newUplinkFilter(conf UplinkFilterConfig) (*uplinkFilter, error) {
+	devAddrPrefixes, err := parseNetIDPrefixes(conf.NetIDs)
+	if err != nil {
+		return nil, err
+	}
+	prefixes, err := parseDevAddrPrefixes(conf.DevAddrPrefixes)
+	if err != nil {
+		return nil, err
+	}
+	joinEUIPrefixes, err := parseJoinEUIPrefixes(conf.JoinEUIPrefixes)
+	if err != nil {
+		return nil, err
+	}
+	return &uplinkFilter{
+		devAddrPrefixes: append(devAddrPrefixes, prefixes...),
+		joinEUIPrefixes: joinEUIPrefixes,
+	}, nil
+}
+
+func splitAttribute(value string) []string {
+	var res []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// withAttributes returns the filter with the prefixes overridden by the given gateway attributes.
+// If either the NetIDs or the DevAddr prefixes are set in the attributes, they both replace the DevAddr prefixes.
+func (f *uplinkFilter) withAttributes(attributes map[string]string) (*uplinkFilter, error) {
+	res := *f
+	netIDs, hasNetIDs := attributes[FilterNetIDsAttribute]
+	devAddrPrefixes, hasDevAddrPrefixes := attributes[FilterDevAddrPrefixesAttribute]
+	if hasNetIDs || hasDevAddrPrefixes {
+		prefixes, err := parseNetIDPrefixes(splitAttribute(netIDs))
+		if err != nil {
+			return nil, err
+		}
+		other, err := parseDevAddrPrefixes(splitAttribute(devAddrPrefixes))
+		if err != nil {
+			return nil, err
+		}
+		res.devAddrPrefixes = append(prefixes, other...)
+	}
+	if joinEUIPrefixes, ok := attributes[FilterJoinEUIPrefixesAttribute]; ok {
+		prefixes, err := parseJoinEUIPrefixes(splitAttribute(joinEUIPrefixes))
+		if err != nil {
+			return nil, err
+		}
+		res.joinEUIPrefixes = prefixes
+	}
+	return &res, nil
+}
+
+func matchDevAddr(prefixes []types.DevAddrPrefix, devAddr types.DevAddr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Matches(devAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+// accept returns whether the uplink message with the given identifiers passes the filter.
+func (f *uplinkFilter) accept(ids ttnpb.EndDeviceIdentifiers) bool {
+	if ids.DevAddr != nil && len(f.devAddrPrefixes) > 0 && !matchDevAddr(f.devAddrPrefixes, *ids.DevAddr) {
+		return false
+	}
+	if ids.JoinEUI != nil && len(f.joinEUIPrefixes) > 0 {
+		for _, prefix := range f.joinEUIPrefixes {
+			if prefix.Matches(*ids.JoinEUI) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// uplinkForwarder forwards the uplink messages of foreign devices to the Network Server of another network.
+type uplinkForwarder struct {
+	target          string
+	devAddrPrefixes []types.DevAddrPrefix
+	client          ttnpb.GsNsClient
+	auth            grpc.CallOption
+	timeout         time.Duration
+}
+
+var (
+	errNoForwardKey      = errors.DefineInvalidArgument("no_forward_key", "no key for forwarding to `{target}`")
+	errInvalidForwardKey = errors.DefineInvalidArgument("invalid_forward_key", "invalid key for forwarding to `{target}`")
+)
+
+// defaultForwardTimeout is the timeout of forwarding an uplink message if no timeout is configured.
+const defaultForwardTimeout = 5 * time.Second
+
+// newUplinkForwarders dials the forwarding targets. The connections are closed when the context is done.
+// Each target requires a cluster key to authenticate with the Network Server of the other network.
+func newUplinkForwarders(ctx context.Context, conf ForwardConfig) ([]*uplinkForwarder, error) {
+	options := rpcclient.DefaultDialOptions(ctx)
+	if conf.Insecure {
+		options = append(options, grpc.WithInsecure())
+	} else {
+		options = append(options, grpc.WithTransportCredentials(credentials.NewTLS(nil)))
+	}
+	timeout := conf.Timeout
+	if timeout <= 0 {
+		timeout = defaultForwardTimeout
+	}
+	forwarders := make([]*uplinkForwarder, 0, len(conf.Targets))
+	for target, values := range conf.Targets {
+		prefixes, err := parseNetIDsAndDevAddrPrefixes(values)
+		if err != nil {
+			return nil, err
+		}
+		key, ok := conf.Keys[target]
+		if !ok || key == "" {
+			return nil, errNoForwardKey.WithAttributes("target", target)
+		}
+		if b, err := hex.DecodeString(key); err != nil {
+			return nil, errInvalidForwardKey.WithAttributes("target", target).WithCause(err)
+		} else if l := len(b); l != 16 && l != 24 && l != 32 {
+			return nil, errInvalidForwardKey.WithAttributes("target", target)
+		}
+		conn, err := grpc.DialContext(ctx, target, options...)
+		if err != nil {
+			return nil, err
+		}
+		go func() {
+			<-ctx.Done()
+			conn.Close()
+		}()
+		forwarders = append(forwarders, &uplinkForwarder{
+			target:          target,
+			devAddrPrefixes: prefixes,
+			client:          ttnpb.NewGsNsClient(conn),
+			auth: grpc.PerRPCCredentials(rpcmetadata.MD{
+				AuthType:      clusterauth.AuthType,
+				AuthValue:     key,
+				AllowInsecure: conf.Insecure,
+			}),
+			timeout: timeout,
+		})
+	}
+	return forwarders, nil
+}
+
+// forward forwards the uplink message to the Network Server of the other network.
+// The uplink tokens are removed, as they are only meaningful to the Network Servers of this network.
+func (f *uplinkForwarder) forward(ctx context.Context, msg *ttnpb.UplinkMessage) error {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+	fwd := *msg
+	fwd.RxMetadata = make([]*ttnpb.RxMetadata, 0, len(msg.RxMetadata))
+	for _, md := range msg.RxMetadata {
+		md := *md
+		md.UplinkToken = nil
+		fwd.RxMetadata = append(fwd.RxMetadata, &md)
+	}
+	_, err := f.client.HandleUplink(ctx, &fwd, f.auth)
+	return err
+}
+
+// forwarderFor returns the forwarder for the given identifiers, or nil if the device is not foreign.
+func (gs *GatewayServer) forwarderFor(ids ttnpb.EndDeviceIdentifiers) *uplinkForwarder {
+	if ids.DevAddr == nil {
+		return nil
+	}
+	for _, f := range gs.forwarders {
+		if matchDevAddr(f.devAddrPrefixes, *ids.DevAddr) {
+			return f
+		}
+	}
+	return nil
+}
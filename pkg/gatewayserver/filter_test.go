@@ -0,0 +1,158 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestUplinkFilter(t *testing.T) {
+	devAddr := func(addr types.DevAddr) ttnpb.EndDeviceIdentifiers {
+		return ttnpb.EndDeviceIdentifiers{DevAddr: &addr}
+	}
+	joinEUI := func(eui types.EUI64) ttnpb.EndDeviceIdentifiers {
+		return ttnpb.EndDeviceIdentifiers{JoinEUI: &eui, DevEUI: &types.EUI64{0x42}}
+	}
+
+	for _, tc := range []struct {
+		Name       string
+		Config     UplinkFilterConfig
+		Attributes map[string]string
+		IDs        ttnpb.EndDeviceIdentifiers
+		Accept     bool
+		Error      bool
+	}{
+		{
+			Name:   "Empty/DevAddr",
+			IDs:    devAddr(types.DevAddr{0x26, 0x01, 0x02, 0x03}),
+			Accept: true,
+		},
+		{
+			Name:   "Empty/JoinEUI",
+			IDs:    joinEUI(types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}),
+			Accept: true,
+		},
+		{
+			Name: "NetID/Match",
+			Config: UplinkFilterConfig{
+				NetIDs: []string{"000013"},
+			},
+			IDs:    devAddr(types.DevAddr{0x26, 0x01, 0x02, 0x03}),
+			Accept: true,
+		},
+		{
+			Name: "NetID/NoMatch",
+			Config: UplinkFilterConfig{
+				NetIDs: []string{"000013"},
+			},
+			IDs:    devAddr(types.DevAddr{0x28, 0x01, 0x02, 0x03}),
+			Accept: false,
+		},
+		{
+			Name: "NetID/Type6",
+			Config: UplinkFilterConfig{
+				NetIDs: []string{"C00053"},
+			},
+			IDs:    devAddr(types.DevAddr{0xfc, 0x01, 0x4c, 0x01}),
+			Accept: true,
+		},
+		{
+			Name: "DevAddrPrefix/Match",
+			Config: UplinkFilterConfig{
+				NetIDs:          []string{"000013"},
+				DevAddrPrefixes: []string{"28000000/8"},
+			},
+			IDs:    devAddr(types.DevAddr{0x28, 0x01, 0x02, 0x03}),
+			Accept: true,
+		},
+		{
+			Name: "DevAddrPrefix/JoinRequest",
+			Config: UplinkFilterConfig{
+				DevAddrPrefixes: []string{"28000000/8"},
+			},
+			IDs:    joinEUI(types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}),
+			Accept: true,
+		},
+		{
+			Name: "JoinEUIPrefix/Match",
+			Config: UplinkFilterConfig{
+				JoinEUIPrefixes: []string{"70b3d57ed0000000/40"},
+			},
+			IDs:    joinEUI(types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}),
+			Accept: true,
+		},
+		{
+			Name: "JoinEUIPrefix/NoMatch",
+			Config: UplinkFilterConfig{
+				JoinEUIPrefixes: []string{"70b3d57ed0000000/40"},
+			},
+			IDs:    joinEUI(types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd1, 0x00, 0x00, 0x01}),
+			Accept: false,
+		},
+		{
+			Name: "Attributes/Override",
+			Config: UplinkFilterConfig{
+				NetIDs: []string{"000013"},
+			},
+			Attributes: map[string]string{
+				FilterDevAddrPrefixesAttribute: "28000000/8, 2a000000/8",
+			},
+			IDs:    devAddr(types.DevAddr{0x26, 0x01, 0x02, 0x03}),
+			Accept: false,
+		},
+		{
+			Name: "Attributes/AcceptAll",
+			Config: UplinkFilterConfig{
+				JoinEUIPrefixes: []string{"70b3d57ed0000000/40"},
+			},
+			Attributes: map[string]string{
+				FilterJoinEUIPrefixesAttribute: "",
+			},
+			IDs:    joinEUI(types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd1, 0x00, 0x00, 0x01}),
+			Accept: true,
+		},
+		{
+			Name: "Invalid/NetID",
+			Config: UplinkFilterConfig{
+				NetIDs: []string{"0013"},
+			},
+			Error: true,
+		},
+		{
+			Name: "Invalid/Attribute",
+			Attributes: map[string]string{
+				FilterDevAddrPrefixesAttribute: "28000000",
+			},
+			Error: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			accept, err := AcceptUplink(tc.Config, tc.Attributes, tc.IDs)
+			if tc.Error {
+				a.So(err, should.NotBeNil)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(accept, should.Equal, tc.Accept)
+		})
+	}
+}
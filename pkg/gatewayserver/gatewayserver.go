@@ -19,12 +19,15 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/basicstation/cups"
 	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
@@ -51,9 +54,13 @@ type GatewayServer struct {
 	*component.Component
 	io.Server
 
-	config *Config
+	config   *Config
+	instance string
 
 	connections sync.Map
+
+	uplinkFilter *uplinkFilter
+	forwarders   []*uplinkForwarder
 }
 
 var (
@@ -69,6 +76,10 @@ func New(c *component.Component, conf *Config) (gs *GatewayServer, err error) {
 	gs = &GatewayServer{
 		Component: c,
 		config:    conf,
+		instance:  c.GetBaseConfig(c.Context()).Cluster.Name,
+	}
+	if gs.instance == "" {
+		gs.instance, _ = os.Hostname()
 	}
 
 	ctx, cancel := context.WithCancel(c.Context())
@@ -78,6 +89,13 @@ func New(c *component.Component, conf *Config) (gs *GatewayServer, err error) {
 		}
 	}()
 
+	if gs.uplinkFilter, err = newUplinkFilter(conf.Filter); err != nil {
+		return nil, err
+	}
+	if gs.forwarders, err = newUplinkForwarders(ctx, conf.Forward); err != nil {
+		return nil, err
+	}
+
 	for addr, fallbackFrequencyPlanID := range conf.UDP.Listeners {
 		var conn *net.UDPConn
 		conn, err = gs.ListenUDP(addr)
@@ -142,6 +160,12 @@ func New(c *component.Component, conf *Config) (gs *GatewayServer, err error) {
 		}
 	}
 
+	cupsServer, err := cups.NewServer(c, conf.CUPS)
+	if err != nil {
+		return nil, err
+	}
+	c.RegisterWeb(cupsServer)
+
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsGs", cluster.HookName, c.ClusterAuthUnaryHook())
 
 	c.RegisterGRPC(gs)
@@ -223,16 +247,15 @@ var (
 	)
 )
 
-// Connect connects a gateway by its identifiers to the Gateway Server, and returns a io.Connection for traffic and
-// control.
-func (gs *GatewayServer) Connect(ctx context.Context, protocol string, ids ttnpb.GatewayIdentifiers) (*io.Connection, error) {
+// GetGateway gets the gateway by its identifiers with the fields that are needed to connect the gateway with the
+// given protocol.
+func (gs *GatewayServer) GetGateway(ctx context.Context, protocol string, ids ttnpb.GatewayIdentifiers) (*ttnpb.Gateway, error) {
 	if err := rights.RequireGateway(ctx, ids, ttnpb.RIGHT_GATEWAY_LINK); err != nil {
 		return nil, err
 	}
 
 	uid := unique.ID(ctx, ids)
 	logger := log.FromContext(ctx).WithField("gateway_uid", uid)
-	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:conn:%s", events.NewCorrelationID()))
 
 	er := gs.GetPeer(ctx, ttnpb.PeerInfo_ENTITY_REGISTRY, nil)
 	if er == nil {
@@ -246,16 +269,19 @@ func (gs *GatewayServer) Connect(ctx context.Context, protocol string, ids ttnpb
 	} else if err != nil {
 		return nil, err
 	}
+	paths := []string{
+		"attributes",
+		"frequency_plan_id",
+		"schedule_downlink_late",
+		"enforce_duty_cycle",
+		"downlink_path_constraint",
+	}
+	if protocol == "udp" {
+		paths = append(paths, "udp_allowed_ips", "udp_token")
+	}
 	gtw, err := ttnpb.NewGatewayRegistryClient(er.Conn()).Get(ctx, &ttnpb.GetGatewayRequest{
 		GatewayIdentifiers: ids,
-		FieldMask: types.FieldMask{
-			Paths: []string{
-				"frequency_plan_id",
-				"schedule_downlink_late",
-				"enforce_duty_cycle",
-				"downlink_path_constraint",
-			},
-		},
+		FieldMask:          types.FieldMask{Paths: paths},
 	}, callOpt)
 	if errors.IsNotFound(err) {
 		if gs.config.RequireRegisteredGateways {
@@ -275,11 +301,33 @@ func (gs *GatewayServer) Connect(ctx context.Context, protocol string, ids ttnpb
 	} else if err != nil {
 		return nil, err
 	}
+	return gtw, nil
+}
+
+// Connect connects a gateway by its identifiers to the Gateway Server, and returns a io.Connection for traffic and
+// control.
+func (gs *GatewayServer) Connect(ctx context.Context, protocol string, ids ttnpb.GatewayIdentifiers) (*io.Connection, error) {
+	gtw, err := gs.GetGateway(ctx, protocol, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	uid := unique.ID(ctx, ids)
+	logger := log.FromContext(ctx).WithField("gateway_uid", uid)
+	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:conn:%s", events.NewCorrelationID()))
+
 	fp, err := gs.FrequencyPlans.GetByID(gtw.FrequencyPlanID)
 	if err != nil {
 		return nil, err
 	}
-	scheduler, err := scheduling.NewScheduler(ctx, fp, gtw.EnforceDutyCycle)
+	var emissions scheduling.EmissionStore
+	if gs.config.Emissions.Registry != nil {
+		emissions = &gatewayEmissionStore{
+			registry: gs.config.Emissions.Registry,
+			ids:      ids,
+		}
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fp, gtw.EnforceDutyCycle, emissions, gs.config.Emissions.SyncInterval)
 	if err != nil {
 		return nil, err
 	}
@@ -305,12 +353,86 @@ var (
 	errNoNetworkServer = errors.DefineNotFound("no_network_server", "no Network Server found to handle message")
 )
 
+const (
+	defaultUpdateConnectionStatsInterval = 10 * time.Second
+	// connectionStatsTTLFactor is the number of update intervals after which stored connection stats expire.
+	// This removes gateways of Gateway Server instances that did not shut down gracefully.
+	connectionStatsTTLFactor = 3
+)
+
+func (gs *GatewayServer) updateConnectionStatsInterval() time.Duration {
+	if gs.config.UpdateConnectionStatsInterval > 0 {
+		return gs.config.UpdateConnectionStatsInterval
+	}
+	return defaultUpdateConnectionStatsInterval
+}
+
+// updateConnectionStats stores the connection stats of the gateway in the registry, so that they are available to all
+// Gateway Server instances in the cluster.
+func (gs *GatewayServer) updateConnectionStats(ctx context.Context, conn *io.Connection) {
+	ids := conn.Gateway().GatewayIdentifiers
+	ttl := connectionStatsTTLFactor * gs.updateConnectionStatsInterval()
+	if err := gs.config.ConnectionStats.Set(ctx, ids, gs.connectionStats(conn), ttl); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to update connection stats")
+	}
+}
+
+// clearConnectionStats removes the connection stats of the gateway from the registry, unless the gateway reconnected
+// in the meantime.
+func (gs *GatewayServer) clearConnectionStats(ctx context.Context, conn *io.Connection) {
+	logger := log.FromContext(ctx)
+	ids := conn.Gateway().GatewayIdentifiers
+	stats, err := gs.config.ConnectionStats.Get(ctx, ids)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			logger.WithError(err).Warn("Failed to get connection stats")
+		}
+		return
+	}
+	if stats.GatewayServerInstance != gs.instance || stats.ConnectedAt == nil || !stats.ConnectedAt.Equal(conn.ConnectTime()) {
+		return
+	}
+	if err := gs.config.ConnectionStats.Set(ctx, ids, nil, 0); err != nil {
+		logger.WithError(err).Warn("Failed to clear connection stats")
+	}
+}
+
 func (gs *GatewayServer) handleUpstream(conn *io.Connection) {
 	ctx := conn.Context()
 	logger := log.FromContext(ctx)
+	filter, err := gs.uplinkFilter.withAttributes(conn.Gateway().Attributes)
+	if err != nil {
+		logger.WithError(err).Warn("Invalid uplink filter in gateway attributes, using default filter")
+		filter = gs.uplinkFilter
+	}
+	var updateStatsCh <-chan time.Time
+	if gs.config.ConnectionStats != nil {
+		gs.updateConnectionStats(ctx, conn)
+		ticker := time.NewTicker(gs.updateConnectionStatsInterval())
+		defer ticker.Stop()
+		updateStatsCh = ticker.C
+	}
+	var history *historyRecorder
+	var recordTrafficCh <-chan time.Time
+	if gs.config.History.Registry != nil {
+		history = &historyRecorder{
+			registry:  gs.config.History.Registry,
+			retention: gs.historyRetention(),
+			conn:      conn,
+		}
+		ticker := time.NewTicker(gs.historyTrafficInterval())
+		defer ticker.Stop()
+		recordTrafficCh = ticker.C
+	}
 	defer func() {
 		ids := conn.Gateway().GatewayIdentifiers
 		gs.connections.Delete(unique.ID(ctx, ids))
+		if gs.config.ConnectionStats != nil {
+			gs.clearConnectionStats(ctx, conn)
+		}
+		if history != nil {
+			history.record(ctx, nil)
+		}
 		gs.UnclaimDownlink(ctx, ids)
 		registerGatewayDisconnect(ctx, ids)
 		logger.Info("Disconnected")
@@ -321,6 +443,10 @@ func (gs *GatewayServer) handleUpstream(conn *io.Connection) {
 			return
 		case <-ctx.Done():
 			return
+		case <-updateStatsCh:
+			gs.updateConnectionStats(ctx, conn)
+		case <-recordTrafficCh:
+			history.record(ctx, nil)
 		case msg := <-conn.Up():
 			ctx := events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:uplink:%s", events.NewCorrelationID()))
 			msg.CorrelationIDs = append(msg.CorrelationIDs, events.CorrelationIDsFromContext(ctx)...)
@@ -344,6 +470,21 @@ func (gs *GatewayServer) handleUpstream(conn *io.Connection) {
 				drop(ttnpb.EndDeviceIdentifiers{}, err)
 				break
 			}
+			if f := gs.forwarderFor(ids); f != nil {
+				// Forward asynchronously so that a slow partner network does not delay the uplink messages of this gateway.
+				go func(msg *ttnpb.UplinkMessage) {
+					if err := f.forward(ctx, msg); err != nil {
+						drop(ids, err)
+						return
+					}
+					registerForwardUplink(ctx, ids, conn.Gateway(), msg, f.target)
+				}(msg)
+				break
+			}
+			if !filter.accept(ids) {
+				drop(ids, errUplinkNotAccepted)
+				break
+			}
 			ns := gs.GetPeer(ctx, ttnpb.PeerInfo_NETWORK_SERVER, ids)
 			if ns == nil {
 				drop(ids, errNoNetworkServer)
@@ -357,13 +498,31 @@ func (gs *GatewayServer) handleUpstream(conn *io.Connection) {
 		case status := <-conn.Status():
 			ctx := events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:status:%s", events.NewCorrelationID()))
 			registerReceiveStatus(ctx, conn.Gateway(), status)
+			if history != nil {
+				history.record(ctx, status)
+			}
 		case ack := <-conn.TxAck():
 			ctx := events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:tx_ack:%s", events.NewCorrelationID()))
 			ack.CorrelationIDs = append(ack.CorrelationIDs, events.CorrelationIDsFromContext(ctx)...)
 			if ack.Result == ttnpb.TxAcknowledgment_SUCCESS {
 				registerSuccessDownlink(ctx, conn.Gateway())
-			} else {
-				registerFailDownlink(ctx, conn.Gateway(), ack)
+				break
+			}
+			registerFailDownlink(ctx, conn.Gateway(), ack)
+			// Report failures to the Network Server so that the downlink can be rolled back and retried.
+			if down := ack.DownlinkMessage; down != nil && down.EndDeviceIDs != nil {
+				ids := *down.EndDeviceIDs
+				ns := gs.GetPeer(ctx, ttnpb.PeerInfo_NETWORK_SERVER, ids)
+				if ns == nil {
+					logger.WithError(errNoNetworkServer).Debug("Failed to report Tx acknowledgment")
+					break
+				}
+				if _, err := ttnpb.NewGsNsClient(ns.Conn()).ReportTxAcknowledgment(ctx, &ttnpb.GatewayTxAcknowledgment{
+					GatewayIdentifiers: conn.Gateway().GatewayIdentifiers,
+					TxAck:              *ack,
+				}, gs.WithClusterAuth()); err != nil {
+					logger.WithError(err).Warn("Failed to report Tx acknowledgment")
+				}
 			}
 		}
 	}
@@ -14,6 +14,23 @@
 
 package gatewayserver
 
+import "go.thethings.network/lorawan-stack/pkg/ttnpb"
+
 var (
 	ErrSchedule = errSchedule
+
+	DownsampleHistory = downsampleHistory
 )
+
+// AcceptUplink returns whether the uplink filter of the configuration, overridden by the gateway attributes, accepts
+// the uplink message with the given identifiers.
+func AcceptUplink(conf UplinkFilterConfig, attributes map[string]string, ids ttnpb.EndDeviceIdentifiers) (bool, error) {
+	f, err := newUplinkFilter(conf)
+	if err != nil {
+		return false, err
+	}
+	if f, err = f.withAttributes(attributes); err != nil {
+		return false, err
+	}
+	return f.accept(ids), nil
+}
@@ -15,7 +15,9 @@
 package gatewayserver_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"net"
 	"sync"
@@ -61,7 +63,9 @@ func TestGatewayServer(t *testing.T) {
 
 	ctx := test.Context()
 	is, isAddr := startMockIS(ctx)
-	ns, nsAddr := startMockNS(ctx)
+	clusterKey, partnerKey := bytes.Repeat([]byte{0x01}, 16), bytes.Repeat([]byte{0x02}, 16)
+	ns, nsAddr := startMockNS(ctx, clusterKey)
+	partnerNS, partnerNSAddr := startMockNS(ctx, partnerKey)
 
 	c := component.MustNew(test.GetLogger(t), &component.Config{
 		ServiceBase: config.ServiceBase{
@@ -72,6 +76,7 @@ func TestGatewayServer(t *testing.T) {
 			Cluster: config.Cluster{
 				IdentityServer: isAddr,
 				NetworkServer:  nsAddr,
+				Keys:           []string{hex.EncodeToString(clusterKey)},
 			},
 		},
 	})
@@ -94,6 +99,15 @@ func TestGatewayServer(t *testing.T) {
 				":1700": test.EUFrequencyPlanID,
 			},
 		},
+		Forward: gatewayserver.ForwardConfig{
+			Targets: map[string][]string{
+				partnerNSAddr: {"28000000/8"},
+			},
+			Keys: map[string]string{
+				partnerNSAddr: hex.EncodeToString(partnerKey),
+			},
+			Insecure: true,
+		},
 	}
 	gs, err := gatewayserver.New(c, config)
 	if !a.So(err, should.BeNil) {
@@ -495,6 +509,8 @@ func TestGatewayServer(t *testing.T) {
 					Name     string
 					Up       *ttnpb.GatewayUp
 					Forwards []int // Indices of uplink messages in Up that are being forwarded.
+					// PartnerForwards are the indices of uplink messages in Up that are being forwarded to the partner network.
+					PartnerForwards []int
 				}{
 					{
 						Name: "GatewayStatus",
@@ -572,6 +588,37 @@ func TestGatewayServer(t *testing.T) {
 						},
 						Forwards: []int{0},
 					},
+					{
+						Name: "OneForeignLoRa",
+						Up: &ttnpb.GatewayUp{
+							UplinkMessages: []*ttnpb.UplinkMessage{
+								{
+									Settings: ttnpb.TxSettings{
+										DataRate: ttnpb.DataRate{
+											Modulation: &ttnpb.DataRate_LoRa{
+												LoRa: &ttnpb.LoRaDataRate{
+													SpreadingFactor: 7,
+													Bandwidth:       125000,
+												},
+											},
+										},
+										CodingRate: "4/5",
+										Frequency:  868300000,
+									},
+									RxMetadata: []*ttnpb.RxMetadata{
+										{
+											GatewayIdentifiers: ids,
+											Timestamp:          4242000,
+											RSSI:               -69,
+											SNR:                11,
+										},
+									},
+									RawPayload: randomUpDataPayload(types.DevAddr{0x28, 0x01, 0xff, 0xff}, 1, 6),
+								},
+							},
+						},
+						PartnerForwards: []int{0},
+					},
 					{
 						Name: "OneGarbageWithStatus",
 						Up: &ttnpb.GatewayUp{
@@ -666,26 +713,38 @@ func TestGatewayServer(t *testing.T) {
 						}
 						uplinkCount += len(tc.Up.UplinkMessages)
 
-						for _, msgIdx := range tc.Forwards {
-							select {
-							case msg := <-ns.upCh:
-								expected := tc.Up.UplinkMessages[msgIdx]
-								a.So(time.Since(msg.ReceivedAt), should.BeLessThan, timeout)
-								a.So(msg.Settings, should.Resemble, expected.Settings)
-								for _, md := range msg.RxMetadata {
-									a.So(md.UplinkToken, should.NotBeEmpty)
-									md.UplinkToken = nil
+						for _, fwd := range []struct {
+							NS      *mockNS
+							Indices []int
+						}{
+							{NS: ns, Indices: tc.Forwards},
+							{NS: partnerNS, Indices: tc.PartnerForwards},
+						} {
+							for _, msgIdx := range fwd.Indices {
+								select {
+								case msg := <-fwd.NS.upCh:
+									expected := tc.Up.UplinkMessages[msgIdx]
+									a.So(time.Since(msg.ReceivedAt), should.BeLessThan, timeout)
+									a.So(msg.Settings, should.Resemble, expected.Settings)
+									for _, md := range msg.RxMetadata {
+										if fwd.NS == partnerNS {
+											a.So(md.UplinkToken, should.BeEmpty)
+										} else {
+											a.So(md.UplinkToken, should.NotBeEmpty)
+										}
+										md.UplinkToken = nil
+									}
+									a.So(msg.RxMetadata, should.Resemble, expected.RxMetadata)
+									a.So(msg.RawPayload, should.Resemble, expected.RawPayload)
+								case <-time.After(timeout):
+									t.Fatal("Expected uplink timeout")
+								}
+								select {
+								case evt := <-upEvents["gs.up.receive"]:
+									a.So(evt.Name(), should.Equal, "gs.up.receive")
+								case <-time.After(timeout):
+									t.Fatal("Expected uplink event timeout")
 								}
-								a.So(msg.RxMetadata, should.Resemble, expected.RxMetadata)
-								a.So(msg.RawPayload, should.Resemble, expected.RawPayload)
-							case <-time.After(timeout):
-								t.Fatal("Expected uplink timeout")
-							}
-							select {
-							case evt := <-upEvents["gs.up.receive"]:
-								a.So(evt.Name(), should.Equal, "gs.up.receive")
-							case <-time.After(timeout):
-								t.Fatal("Expected uplink event timeout")
 							}
 						}
 						if expected := tc.Up.TxAcknowledgment; expected != nil {
@@ -723,6 +782,26 @@ func TestGatewayServer(t *testing.T) {
 							}
 							a.So(stats.LastStatus.Time, should.Equal, tc.Up.GatewayStatus.Time)
 						}
+
+						connected, err := statsClient.ListConnectedGateways(statsCtx, &ttnpb.ListConnectedGatewaysRequest{
+							FrequencyPlanID: test.EUFrequencyPlanID,
+							Protocol:        ptc.Protocol,
+						})
+						if !a.So(err, should.BeNil) {
+							t.FailNow()
+						}
+						if a.So(connected.Gateways, should.HaveLength, 1) {
+							a.So(connected.Gateways[0].GatewayID, should.Equal, ids.GatewayID)
+							a.So(connected.Gateways[0].Stats.UplinkCount, should.Equal, uplinkCount)
+						}
+
+						connected, err = statsClient.ListConnectedGateways(statsCtx, &ttnpb.ListConnectedGatewaysRequest{
+							Limit: 1,
+							Page:  2,
+						})
+						if a.So(err, should.BeNil) {
+							a.So(connected.Gateways, should.BeEmpty)
+						}
 					})
 				}
 			})
@@ -842,6 +921,12 @@ func TestGatewayServer(t *testing.T) {
 						Name: "ValidClassC",
 						Message: &ttnpb.DownlinkMessage{
 							RawPayload: randomDownDataPayload(types.DevAddr{0x26, 0x02, 0xff, 0xff}, 1, 6),
+							EndDeviceIDs: &ttnpb.EndDeviceIdentifiers{
+								ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+									ApplicationID: "foo-app",
+								},
+								DeviceID: "foo-device",
+							},
 							Settings: &ttnpb.DownlinkMessage_Request{
 								Request: &ttnpb.TxRequest{
 									Class: ttnpb.CLASS_C,
@@ -905,6 +990,29 @@ func TestGatewayServer(t *testing.T) {
 								request := msg.DownlinkMessage.GetRequest()
 								a.So(request, should.NotBeNil)
 							}
+							// The UDP protocol correlates Tx acknowledgments by the downlink token.
+							if msg.DownlinkMessage.EndDeviceIDs != nil && ptc.Protocol != "udp" {
+								select {
+								case upCh <- &ttnpb.GatewayUp{
+									TxAcknowledgment: &ttnpb.TxAcknowledgment{
+										CorrelationIDs: msg.DownlinkMessage.CorrelationIDs,
+										Result:         ttnpb.TxAcknowledgment_TOO_LATE,
+									},
+								}:
+								case <-time.After(timeout):
+									t.Fatalf("Failed to send message to upstream channel")
+								}
+								select {
+								case ack := <-ns.txAckCh:
+									a.So(ack.GatewayIdentifiers.GatewayID, should.Equal, registeredGatewayID)
+									a.So(ack.TxAck.Result, should.Equal, ttnpb.TxAcknowledgment_TOO_LATE)
+									if a.So(ack.TxAck.DownlinkMessage, should.NotBeNil) {
+										a.So(ack.TxAck.DownlinkMessage.EndDeviceIDs, should.Resemble, tc.Message.EndDeviceIDs)
+									}
+								case <-time.After(timeout):
+									t.Fatal("Expected Tx acknowledgment report timeout")
+								}
+							}
 						case <-time.After(timeout):
 							t.Fatal("Expected downlink timeout")
 						}
@@ -914,6 +1022,9 @@ func TestGatewayServer(t *testing.T) {
 							t.FailNow()
 						}
 						a.So(stats.DownlinkCount, should.Equal, downlinkCount)
+						if conn, ok := gs.GetConnection(ctx, ids); ok && conn.HasScheduler() {
+							a.So(stats.SubBands, should.NotBeEmpty)
+						}
 					})
 				}
 			})
@@ -930,6 +1041,9 @@ func TestGatewayServer(t *testing.T) {
 				if !a.So(errors.IsNotFound(err), should.BeTrue) {
 					t.Fatalf("Expected gateway to be disconnected, but it's not")
 				}
+				connected, err := statsClient.ListConnectedGateways(statsCtx, &ttnpb.ListConnectedGatewaysRequest{})
+				a.So(err, should.BeNil)
+				a.So(connected.GetGateways(), should.BeEmpty)
 			})
 		})
 	}
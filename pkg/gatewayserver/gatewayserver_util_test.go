@@ -21,6 +21,7 @@ import (
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
@@ -45,12 +46,16 @@ func mustHavePeer(ctx context.Context, c *component.Component, role ttnpb.PeerIn
 }
 
 type mockNS struct {
-	upCh chan *ttnpb.UplinkMessage
+	keys    [][]byte
+	upCh    chan *ttnpb.UplinkMessage
+	txAckCh chan *ttnpb.GatewayTxAcknowledgment
 }
 
-func startMockNS(ctx context.Context) (*mockNS, string) {
+func startMockNS(ctx context.Context, keys ...[]byte) (*mockNS, string) {
 	ns := &mockNS{
-		upCh: make(chan *ttnpb.UplinkMessage, 1),
+		keys:    keys,
+		upCh:    make(chan *ttnpb.UplinkMessage, 1),
+		txAckCh: make(chan *ttnpb.GatewayTxAcknowledgment, 1),
 	}
 	srv := rpcserver.New(ctx)
 	ttnpb.RegisterGsNsServer(srv.Server, ns)
@@ -63,10 +68,21 @@ func startMockNS(ctx context.Context) (*mockNS, string) {
 }
 
 func (ns *mockNS) HandleUplink(ctx context.Context, msg *ttnpb.UplinkMessage) (*pbtypes.Empty, error) {
+	if err := cluster.Authorized(cluster.VerifySource(ctx, ns.keys)); err != nil {
+		return nil, err
+	}
 	ns.upCh <- msg
 	return &pbtypes.Empty{}, nil
 }
 
+func (ns *mockNS) ReportTxAcknowledgment(ctx context.Context, msg *ttnpb.GatewayTxAcknowledgment) (*pbtypes.Empty, error) {
+	if err := cluster.Authorized(cluster.VerifySource(ctx, ns.keys)); err != nil {
+		return nil, err
+	}
+	ns.txAckCh <- msg
+	return &pbtypes.Empty{}, nil
+}
+
 type mockIS struct {
 	gateways     map[string]*ttnpb.Gateway
 	gatewayAuths map[string][]string
@@ -80,6 +96,7 @@ func startMockIS(ctx context.Context) (*mockIS, string) {
 	srv := rpcserver.New(ctx)
 	ttnpb.RegisterGatewayRegistryServer(srv.Server, is)
 	ttnpb.RegisterGatewayAccessServer(srv.Server, is)
+	ttnpb.RegisterEntityAccessServer(srv.Server, is)
 	lis, err := net.Listen("tcp", ":0")
 	if err != nil {
 		panic(err)
@@ -152,8 +169,28 @@ func (is *mockIS) ListRights(ctx context.Context, ids *ttnpb.GatewayIdentifiers)
 func (is *mockIS) Create(context.Context, *ttnpb.CreateGatewayRequest) (*ttnpb.Gateway, error) {
 	return nil, errors.New("not implemented")
 }
-func (is *mockIS) List(context.Context, *ttnpb.ListGatewaysRequest) (*ttnpb.Gateways, error) {
-	return nil, errors.New("not implemented")
+func (is *mockIS) List(ctx context.Context, req *ttnpb.ListGatewaysRequest) (*ttnpb.Gateways, error) {
+	res := &ttnpb.Gateways{}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return res, nil
+	}
+	authorization, ok := md["authorization"]
+	if !ok || len(authorization) == 0 {
+		return res, nil
+	}
+	for uid, auths := range is.gatewayAuths {
+		for _, auth := range auths {
+			if auth == authorization[0] {
+				res.Gateways = append(res.Gateways, is.gateways[uid])
+				break
+			}
+		}
+	}
+	return res, nil
+}
+func (is *mockIS) AuthInfo(context.Context, *pbtypes.Empty) (*ttnpb.AuthInfoResponse, error) {
+	return &ttnpb.AuthInfoResponse{}, nil
 }
 func (is *mockIS) Update(context.Context, *ttnpb.UpdateGatewayRequest) (*ttnpb.Gateway, error) {
 	return nil, errors.New("not implemented")
@@ -161,6 +198,12 @@ func (is *mockIS) Update(context.Context, *ttnpb.UpdateGatewayRequest) (*ttnpb.G
 func (is *mockIS) Delete(context.Context, *ttnpb.GatewayIdentifiers) (*pbtypes.Empty, error) {
 	return nil, errors.New("not implemented")
 }
+func (is *mockIS) Restore(context.Context, *ttnpb.GatewayIdentifiers) (*pbtypes.Empty, error) {
+	return nil, errors.New("not implemented")
+}
+func (is *mockIS) Purge(context.Context, *ttnpb.GatewayIdentifiers) (*pbtypes.Empty, error) {
+	return nil, errors.New("not implemented")
+}
 func (is *mockIS) CreateAPIKey(context.Context, *ttnpb.CreateGatewayAPIKeyRequest) (*ttnpb.APIKey, error) {
 	return nil, errors.New("not implemented")
 }
@@ -16,30 +16,193 @@ package gatewayserver
 
 import (
 	"context"
+	"sort"
+	"strconv"
+	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // GetGatewayConnectionStats returns statistics about a gateway connection.
+// If the gateway is not connected to this instance, the connection stats are retrieved from the registry.
 func (gs *GatewayServer) GetGatewayConnectionStats(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*ttnpb.GatewayConnectionStats, error) {
 	if err := rights.RequireGateway(ctx, *ids, ttnpb.RIGHT_GATEWAY_STATUS_READ); err != nil {
 		return nil, err
 	}
 
 	uid := unique.ID(ctx, ids)
-	val, ok := gs.connections.Load(uid)
-	if !ok {
+	if val, ok := gs.connections.Load(uid); ok {
+		return gs.connectionStats(val.(*io.Connection)), nil
+	}
+	if gs.config.ConnectionStats == nil {
 		return nil, errNotConnected.WithAttributes("gateway_uid", uid)
 	}
-	conn := val.(*io.Connection)
+	stats, err := gs.config.ConnectionStats.Get(ctx, *ids)
+	if errors.IsNotFound(err) {
+		return nil, errNotConnected.WithAttributes("gateway_uid", uid).WithCause(err)
+	} else if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// callerGateways returns the unique IDs of the gateways of the caller, as registered in the entity registry.
+// This method returns nil if the caller has universal rights to read the status of any gateway.
+func (gs *GatewayServer) callerGateways(ctx context.Context) (map[string]struct{}, error) {
+	er := gs.GetPeer(ctx, ttnpb.PeerInfo_ENTITY_REGISTRY, nil)
+	if er == nil {
+		return nil, errEntityRegistryNotFound
+	}
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, gs.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+	authInfo, err := ttnpb.NewEntityAccessClient(er.Conn()).AuthInfo(ctx, ttnpb.Empty, callOpt)
+	if err != nil {
+		return nil, err
+	}
+	if authInfo.GetUniversalRights().IncludesAll(ttnpb.RIGHT_GATEWAY_STATUS_READ) {
+		return nil, nil
+	}
+	gtws, err := ttnpb.NewGatewayRegistryClient(er.Conn()).List(ctx, &ttnpb.ListGatewaysRequest{}, callOpt)
+	if err != nil {
+		return nil, err
+	}
+	uids := make(map[string]struct{}, len(gtws.Gateways))
+	for _, gtw := range gtws.Gateways {
+		uids[unique.ID(ctx, gtw.GatewayIdentifiers)] = struct{}{}
+	}
+	return uids, nil
+}
+
+// ListConnectedGateways lists the gateways that are connected to the Gateway Server cluster.
+// Only gateways of the caller of which the caller has the right to read the status are listed, unless the caller has
+// universal rights.
+func (gs *GatewayServer) ListConnectedGateways(ctx context.Context, req *ttnpb.ListConnectedGatewaysRequest) (*ttnpb.ConnectedGateways, error) {
+	uids, err := gs.callerGateways(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []*ttnpb.ConnectedGateway
+	add := func(ids ttnpb.GatewayIdentifiers, stats *ttnpb.GatewayConnectionStats) bool {
+		if uids != nil {
+			if _, ok := uids[unique.ID(ctx, ids)]; !ok {
+				return true
+			}
+		}
+		if req.FrequencyPlanID != "" && stats.FrequencyPlanID != req.FrequencyPlanID {
+			return true
+		}
+		if req.Protocol != "" && stats.Protocol != req.Protocol {
+			return true
+		}
+		candidates = append(candidates, &ttnpb.ConnectedGateway{
+			GatewayIdentifiers: ids,
+			Stats:              *stats,
+		})
+		return true
+	}
+	if gs.config.ConnectionStats != nil {
+		if err := gs.config.ConnectionStats.Range(ctx, add); err != nil {
+			return nil, err
+		}
+	} else {
+		gs.connections.Range(func(_, val interface{}) bool {
+			conn := val.(*io.Connection)
+			return add(conn.Gateway().GatewayIdentifiers, gs.connectionStats(conn))
+		})
+	}
+
+	res := &ttnpb.ConnectedGateways{}
+	for _, gtw := range candidates {
+		if uids != nil {
+			// The caller may be a collaborator of the gateway without the right to read the status.
+			if err := rights.RequireGateway(ctx, gtw.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_STATUS_READ); err != nil {
+				if errors.IsPermissionDenied(err) {
+					continue
+				}
+				return nil, err
+			}
+		}
+		res.Gateways = append(res.Gateways, gtw)
+	}
+	sort.Slice(res.Gateways, func(i, j int) bool {
+		return unique.ID(ctx, res.Gateways[i].GatewayIdentifiers) < unique.ID(ctx, res.Gateways[j].GatewayIdentifiers)
+	})
+
+	total := len(res.Gateways)
+	grpc.SetHeader(ctx, metadata.Pairs("x-total-count", strconv.Itoa(total)))
+	if req.Limit > 0 {
+		page := req.Page
+		if page == 0 {
+			page = 1
+		}
+		from := int(req.Limit) * int(page-1)
+		if from > total {
+			from = total
+		}
+		to := from + int(req.Limit)
+		if to > total {
+			to = total
+		}
+		res.Gateways = res.Gateways[from:to]
+	}
+	return res, nil
+}
+
+var (
+	errNoHistory        = errors.DefineFailedPrecondition("no_history", "gateway status history is not available")
+	errInvalidTimeRange = errors.DefineInvalidArgument("invalid_time_range", "invalid time range from `{from}` to `{to}`")
+)
+
+// GetGatewayStatusHistory returns the status and traffic history of the gateway.
+func (gs *GatewayServer) GetGatewayStatusHistory(ctx context.Context, req *ttnpb.GetGatewayStatusHistoryRequest) (*ttnpb.GatewayStatusHistory, error) {
+	if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_STATUS_READ); err != nil {
+		return nil, err
+	}
+	if gs.config.History.Registry == nil {
+		return nil, errNoHistory
+	}
+
+	to := time.Now()
+	if req.To != nil {
+		to = *req.To
+	}
+	from := to.Add(-gs.historyRetention())
+	if req.From != nil {
+		from = *req.From
+	}
+	if from.After(to) {
+		return nil, errInvalidTimeRange.WithAttributes("from", from, "to", to)
+	}
 
-	stats := &ttnpb.GatewayConnectionStats{}
+	samples, err := gs.config.History.Registry.Find(ctx, req.GatewayIdentifiers, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if req.Interval > 0 {
+		samples = downsampleHistory(samples, from, req.Interval)
+	}
+	return &ttnpb.GatewayStatusHistory{
+		Samples: samples,
+	}, nil
+}
+
+func (gs *GatewayServer) connectionStats(conn *io.Connection) *ttnpb.GatewayConnectionStats {
+	stats := &ttnpb.GatewayConnectionStats{
+		Protocol:              conn.Protocol(),
+		FrequencyPlanID:       conn.Gateway().FrequencyPlanID,
+		GatewayServerInstance: gs.instance,
+	}
 	ct := conn.ConnectTime()
 	stats.ConnectedAt = &ct
-	stats.Protocol = conn.Protocol()
 	if s, t, ok := conn.StatusStats(); ok {
 		stats.LastStatusReceivedAt = &t
 		stats.LastStatus = s
@@ -52,5 +215,8 @@ func (gs *GatewayServer) GetGatewayConnectionStats(ctx context.Context, ids *ttn
 		stats.LastDownlinkReceivedAt = &t
 		stats.DownlinkCount = c
 	}
-	return stats, nil
+	if s, ok := conn.SubBandStats(); ok {
+		stats.SubBands = s
+	}
+	return stats
 }
@@ -74,14 +74,15 @@ func (gs *GatewayServer) ScheduleDownlink(ctx context.Context, down *ttnpb.Downl
 		}
 		down := deepcopy.Copy(down).(*ttnpb.DownlinkMessage) // Let the connection own the DownlinkMessage.
 		down.GetRequest().DownlinkPaths = nil                // And do not leak the downlink paths to the gateway.
+		// Set the correlation IDs before sending, so that Tx acknowledgments can be correlated to the downlink message.
+		ctx := events.ContextWithCorrelationID(ctx, events.CorrelationIDsFromContext(conn.Context())...)
+		down.CorrelationIDs = append(down.CorrelationIDs, events.CorrelationIDsFromContext(ctx)...)
 		delay, err := conn.SendDown(path, down)
 		if err != nil {
 			logger.WithField("gateway_uid", uid).WithError(err).Debug("Failed to schedule on path")
 			details = append(details, errSchedulePath.WithCause(err).WithAttributes("gateway_uid", uid))
 			continue
 		}
-		ctx = events.ContextWithCorrelationID(ctx, events.CorrelationIDsFromContext(conn.Context())...)
-		down.CorrelationIDs = append(down.CorrelationIDs, events.CorrelationIDsFromContext(ctx)...)
 		registerSendDownlink(ctx, conn.Gateway(), down)
 		return &ttnpb.ScheduleDownlinkResponse{
 			Delay: delay,
@@ -0,0 +1,123 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+const (
+	defaultHistoryRetention       = 7 * 24 * time.Hour
+	defaultHistoryTrafficInterval = time.Minute
+)
+
+func (gs *GatewayServer) historyRetention() time.Duration {
+	if gs.config.History.Retention > 0 {
+		return gs.config.History.Retention
+	}
+	return defaultHistoryRetention
+}
+
+func (gs *GatewayServer) historyTrafficInterval() time.Duration {
+	if gs.config.History.TrafficInterval > 0 {
+		return gs.config.History.TrafficInterval
+	}
+	return defaultHistoryTrafficInterval
+}
+
+// historyRecorder records the status and traffic history of a gateway connection.
+type historyRecorder struct {
+	registry  GatewayStatusHistoryRegistry
+	retention time.Duration
+	conn      *io.Connection
+
+	uplinkCount, downlinkCount uint64
+}
+
+// record adds a sample with the given status, if any, and the traffic since the previous sample to the history.
+// If there is no status and no traffic, no sample is added.
+func (r *historyRecorder) record(ctx context.Context, status *ttnpb.GatewayStatus) {
+	sample := &ttnpb.GatewayStatusHistory_Sample{
+		Time:   time.Now(),
+		Status: status,
+	}
+	if c, _, ok := r.conn.UpStats(); ok {
+		sample.UplinkCount = c - r.uplinkCount
+		r.uplinkCount = c
+	}
+	if c, _, ok := r.conn.DownStats(); ok {
+		sample.DownlinkCount = c - r.downlinkCount
+		r.downlinkCount = c
+	}
+	if sample.Status == nil && sample.UplinkCount == 0 && sample.DownlinkCount == 0 {
+		return
+	}
+	if err := r.registry.Add(ctx, r.conn.Gateway().GatewayIdentifiers, sample, r.retention); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to record gateway history")
+	}
+}
+
+// downsampleHistory aggregates the samples in consecutive intervals starting at from.
+// The traffic counters are summed, and the status is the last status in the interval with the metrics averaged over
+// the interval. Intervals without samples are omitted.
+func downsampleHistory(samples []*ttnpb.GatewayStatusHistory_Sample, from time.Time, interval time.Duration) []*ttnpb.GatewayStatusHistory_Sample {
+	var (
+		res          []*ttnpb.GatewayStatusHistory_Sample
+		current      *ttnpb.GatewayStatusHistory_Sample
+		metricSums   map[string]float32
+		metricCounts map[string]int
+	)
+	flush := func() {
+		if current == nil {
+			return
+		}
+		if current.Status != nil && len(metricSums) > 0 {
+			status := *current.Status
+			status.Metrics = make(map[string]float32, len(metricSums))
+			for k, sum := range metricSums {
+				status.Metrics[k] = sum / float32(metricCounts[k])
+			}
+			current.Status = &status
+		}
+		res = append(res, current)
+	}
+	for _, sample := range samples {
+		start := from.Add(sample.Time.Sub(from) / interval * interval)
+		if current == nil || !current.Time.Equal(start) {
+			flush()
+			current = &ttnpb.GatewayStatusHistory_Sample{
+				Time: start,
+			}
+			metricSums = make(map[string]float32)
+			metricCounts = make(map[string]int)
+		}
+		current.UplinkCount += sample.UplinkCount
+		current.DownlinkCount += sample.DownlinkCount
+		if sample.Status != nil {
+			current.Status = sample.Status
+			for k, v := range sample.Status.Metrics {
+				metricSums[k] += v
+				metricCounts[k]++
+			}
+		}
+	}
+	flush()
+	return res
+}
@@ -0,0 +1,108 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestDownsampleHistory(t *testing.T) {
+	from := time.Unix(1000, 0)
+	at := func(d time.Duration) time.Time {
+		return from.Add(d)
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		Samples  []*ttnpb.GatewayStatusHistory_Sample
+		Interval time.Duration
+		Expected []*ttnpb.GatewayStatusHistory_Sample
+	}{
+		{
+			Name:     "Empty",
+			Interval: time.Minute,
+		},
+		{
+			Name: "Traffic",
+			Samples: []*ttnpb.GatewayStatusHistory_Sample{
+				{Time: at(10 * time.Second), UplinkCount: 1},
+				{Time: at(50 * time.Second), UplinkCount: 2, DownlinkCount: 1},
+				{Time: at(3*time.Minute + 10*time.Second), UplinkCount: 4},
+			},
+			Interval: time.Minute,
+			Expected: []*ttnpb.GatewayStatusHistory_Sample{
+				{Time: at(0), UplinkCount: 3, DownlinkCount: 1},
+				{Time: at(3 * time.Minute), UplinkCount: 4},
+			},
+		},
+		{
+			Name: "Status",
+			Samples: []*ttnpb.GatewayStatusHistory_Sample{
+				{
+					Time: at(10 * time.Second),
+					Status: &ttnpb.GatewayStatus{
+						Time:     at(10 * time.Second),
+						Versions: map[string]string{"firmware": "1.0"},
+						Metrics:  map[string]float32{"temp": 20, "rxok": 2},
+					},
+				},
+				{Time: at(20 * time.Second), UplinkCount: 2},
+				{
+					Time: at(40 * time.Second),
+					Status: &ttnpb.GatewayStatus{
+						Time:     at(40 * time.Second),
+						Versions: map[string]string{"firmware": "1.1"},
+						Metrics:  map[string]float32{"temp": 30},
+					},
+				},
+				{
+					Time: at(70 * time.Second),
+					Status: &ttnpb.GatewayStatus{
+						Time: at(70 * time.Second),
+					},
+				},
+			},
+			Interval: time.Minute,
+			Expected: []*ttnpb.GatewayStatusHistory_Sample{
+				{
+					Time: at(0),
+					Status: &ttnpb.GatewayStatus{
+						Time:     at(40 * time.Second),
+						Versions: map[string]string{"firmware": "1.1"},
+						Metrics:  map[string]float32{"temp": 25, "rxok": 2},
+					},
+					UplinkCount: 2,
+				},
+				{
+					Time: at(time.Minute),
+					Status: &ttnpb.GatewayStatus{
+						Time: at(70 * time.Second),
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(gatewayserver.DownsampleHistory(tc.Samples, from, tc.Interval), should.Resemble, tc.Expected)
+		})
+	}
+}
@@ -17,6 +17,7 @@ package io
 import (
 	"context"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -29,12 +30,20 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
-const bufferSize = 10
+const (
+	bufferSize = 10
+
+	// maxSentDownlinks is the number of sent downlink messages that are kept to correlate Tx acknowledgments with.
+	maxSentDownlinks = 16
+)
 
 // Server represents the Gateway Server to gateway frontends.
 type Server interface {
 	// FillGatewayContext fills the given context and identifiers.
 	FillGatewayContext(ctx context.Context, ids ttnpb.GatewayIdentifiers) (context.Context, ttnpb.GatewayIdentifiers, error)
+	// GetGateway gets the gateway by its identifiers with the fields that are needed to connect the gateway with the
+	// given protocol.
+	GetGateway(ctx context.Context, protocol string, ids ttnpb.GatewayIdentifiers) (*ttnpb.Gateway, error)
 	// Connect connects a gateway by its identifiers to the Gateway Server, and returns a Connection for traffic and
 	// control.
 	Connect(ctx context.Context, protocol string, ids ttnpb.GatewayIdentifiers) (*Connection, error)
@@ -69,6 +78,9 @@ type Connection struct {
 	downCh   chan *ttnpb.DownlinkMessage
 	statusCh chan *ttnpb.GatewayStatus
 	txAckCh  chan *ttnpb.TxAcknowledgment
+
+	sentDownlinksMu sync.Mutex
+	sentDownlinks   []*ttnpb.DownlinkMessage
 }
 
 // NewConnection instantiates a new gateway connection.
@@ -115,12 +127,8 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 	} else {
 		c.scheduler.Sync(up.Settings.Timestamp, up.ReceivedAt)
 	}
+	utilization, queueDepth := c.scheduler.DutyCycleUtilization(), uint32(c.scheduler.QueueDepth())
 	for _, md := range up.RxMetadata {
-		if md.AntennaIndex != 0 {
-			// TODO: Support downlink path to multiple antennas (https://github.com/TheThingsNetwork/lorawan-stack/issues/48)
-			md.DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER
-			continue
-		}
 		buf, err := UplinkToken(ttnpb.GatewayAntennaIdentifiers{
 			GatewayIdentifiers: c.gateway.GatewayIdentifiers,
 			AntennaIndex:       md.AntennaIndex,
@@ -130,6 +138,8 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 		}
 		md.UplinkToken = buf
 		md.DownlinkPathConstraint = c.gateway.DownlinkPathConstraint
+		md.DownlinkUtilization = utilization
+		md.DownlinkQueueDepth = queueDepth
 	}
 
 	select {
@@ -159,7 +169,17 @@ func (c *Connection) HandleStatus(status *ttnpb.GatewayStatus) error {
 }
 
 // HandleTxAck sends the acknowledgment to the status channel.
+// If the acknowledgment is not yet correlated to a downlink message, the downlink message that was sent with the
+// acknowledgment's correlation IDs is set as acknowledged downlink message.
 func (c *Connection) HandleTxAck(ack *ttnpb.TxAcknowledgment) error {
+	if ack.DownlinkMessage == nil {
+		ack.DownlinkMessage = c.popSentDownlink(ack.CorrelationIDs)
+	}
+	if ack.Result == ttnpb.TxAcknowledgment_CHANNEL_BUSY {
+		if settings := ack.DownlinkMessage.GetScheduled(); settings != nil {
+			c.scheduler.ChannelBusy(settings.Frequency)
+		}
+	}
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
@@ -272,6 +292,7 @@ func (c *Connection) SendDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkMessa
 				DataRateIndex: rx.dataRateIndex,
 				Frequency:     rx.frequency,
 				TxPower:       int32(maxEIRP),
+				AntennaIndex:  ids.AntennaIndex,
 			}
 			if int(ids.AntennaIndex) < len(c.gateway.Antennas) {
 				settings.TxPower -= int32(c.gateway.Antennas[ids.AntennaIndex].Gain)
@@ -330,12 +351,53 @@ func (c *Connection) SendDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkMessa
 	case c.downCh <- msg:
 		atomic.AddUint64(&c.downlinks, 1)
 		atomic.StoreInt64(&c.lastDownlinkTime, time.Now().UnixNano())
+		c.pushSentDownlink(msg)
 	default:
 		return 0, errBufferFull
 	}
 	return delay, nil
 }
 
+func (c *Connection) pushSentDownlink(msg *ttnpb.DownlinkMessage) {
+	c.sentDownlinksMu.Lock()
+	if len(c.sentDownlinks) == maxSentDownlinks {
+		c.sentDownlinks = append(c.sentDownlinks[:0], c.sentDownlinks[1:]...)
+	}
+	c.sentDownlinks = append(c.sentDownlinks, msg)
+	c.sentDownlinksMu.Unlock()
+}
+
+// popSentDownlink returns and forgets the most recently sent downlink message that has all the given correlation IDs.
+// This returns nil if there is no such downlink message.
+func (c *Connection) popSentDownlink(correlationIDs []string) *ttnpb.DownlinkMessage {
+	if len(correlationIDs) == 0 {
+		return nil
+	}
+	c.sentDownlinksMu.Lock()
+	defer c.sentDownlinksMu.Unlock()
+outer:
+	for i := len(c.sentDownlinks) - 1; i >= 0; i-- {
+		msg := c.sentDownlinks[i]
+		for _, id := range correlationIDs {
+			if !hasCorrelationID(msg.CorrelationIDs, id) {
+				continue outer
+			}
+		}
+		c.sentDownlinks = append(c.sentDownlinks[:i], c.sentDownlinks[i+1:]...)
+		return msg
+	}
+	return nil
+}
+
+func hasCorrelationID(ids []string, id string) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
+
 // Status returns the status channel.
 func (c *Connection) Status() <-chan *ttnpb.GatewayStatus {
 	return c.statusCh
@@ -384,3 +446,19 @@ func (c *Connection) DownStats() (total uint64, t time.Time, ok bool) {
 	}
 	return
 }
+
+// SubBandStats returns the duty-cycle utilization per sub-band.
+func (c *Connection) SubBandStats() (stats []*ttnpb.GatewayConnectionStats_SubBand, ok bool) {
+	if c.scheduler == nil {
+		return nil, false
+	}
+	for _, sb := range c.scheduler.SubBands() {
+		stats = append(stats, &ttnpb.GatewayConnectionStats_SubBand{
+			MinFrequency:             sb.MinFrequency,
+			MaxFrequency:             sb.MaxFrequency,
+			DownlinkUtilizationLimit: sb.DutyCycle,
+			DownlinkUtilization:      sb.DutyCycleUtilization(),
+		})
+	}
+	return stats, true
+}
@@ -50,6 +50,9 @@ func Test(t *testing.T) {
 			{
 				Gain: 3,
 			},
+			{
+				Gain: 6,
+			},
 		},
 	}
 	gs.RegisterGateway(ctx, ids, gtw)
@@ -89,15 +92,22 @@ func Test(t *testing.T) {
 					AntennaIndex: 0,
 					Timestamp:    100,
 				},
+				{
+					AntennaIndex: 1,
+					Timestamp:    100,
+				},
 			},
 		}
 		select {
 		case up := <-conn.Up():
-			tokenIDs, timestamp, err := io.ParseUplinkToken(up.RxMetadata[0].UplinkToken)
-			a.So(err, should.BeNil)
-			a.So(tokenIDs.GatewayIdentifiers, should.Resemble, ids)
-			a.So(tokenIDs.AntennaIndex, should.Equal, 0)
-			a.So(timestamp, should.Equal, 100)
+			for i, md := range up.RxMetadata {
+				tokenIDs, timestamp, err := io.ParseUplinkToken(md.UplinkToken)
+				a.So(err, should.BeNil)
+				a.So(tokenIDs.GatewayIdentifiers, should.Resemble, ids)
+				a.So(tokenIDs.AntennaIndex, should.Equal, i)
+				a.So(timestamp, should.Equal, 100)
+				a.So(md.DownlinkPathConstraint, should.Equal, gtw.DownlinkPathConstraint)
+			}
 		case <-time.After(timeout):
 			t.Fatalf("Expected uplink message time-out")
 		}
@@ -197,6 +207,25 @@ func Test(t *testing.T) {
 				errors.IsFailedPrecondition, // Rx2 not provided.
 			},
 		},
+		{
+			Name: "ValidClassASecondAntenna",
+			Path: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: io.MustUplinkToken(ttnpb.GatewayAntennaIdentifiers{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "foo-gateway"}, AntennaIndex: 1}, 5000000),
+				},
+			},
+			Message: &ttnpb.DownlinkMessage{
+				RawPayload: []byte{0x01},
+				Settings: &ttnpb.DownlinkMessage_Request{
+					Request: &ttnpb.TxRequest{
+						Class:            ttnpb.CLASS_A,
+						Priority:         ttnpb.TxSchedulePriority_NORMAL,
+						Rx1DataRateIndex: 5,
+						Rx1Frequency:     868100000,
+					},
+				},
+			},
+		},
 		{
 			Name: "NoUplinkTokenClassA",
 			Path: &ttnpb.DownlinkPath{
@@ -368,7 +397,14 @@ func Test(t *testing.T) {
 			case msg := <-frontend.Down:
 				scheduled := msg.GetScheduled()
 				a.So(scheduled, should.NotBeNil)
-				a.So(scheduled.TxPower, should.Equal, int32(maxEirp-gtw.Antennas[0].Gain))
+				var antennaIndex uint32
+				if token := tc.Path.GetUplinkToken(); token != nil {
+					ids, _, err := io.ParseUplinkToken(token)
+					a.So(err, should.BeNil)
+					antennaIndex = ids.AntennaIndex
+				}
+				a.So(scheduled.AntennaIndex, should.Equal, antennaIndex)
+				a.So(scheduled.TxPower, should.Equal, int32(maxEirp-gtw.Antennas[antennaIndex].Gain))
 			case <-time.After(timeout):
 				t.Fatalf("Expected downlink message timeout")
 			}
@@ -378,4 +414,62 @@ func Test(t *testing.T) {
 			a.So(time.Since(last), should.BeLessThan, timeout)
 		})
 	}
+
+	t.Run("TxAckCorrelation", func(t *testing.T) {
+		a := assertions.New(t)
+		down := &ttnpb.DownlinkMessage{
+			RawPayload: []byte{0x01},
+			Settings: &ttnpb.DownlinkMessage_Request{
+				Request: &ttnpb.TxRequest{
+					Class:            ttnpb.CLASS_C,
+					Priority:         ttnpb.TxSchedulePriority_NORMAL,
+					Rx2DataRateIndex: 5,
+					Rx2Frequency:     869525000,
+				},
+			},
+			CorrelationIDs: []string{"test:downlink:1"},
+		}
+		path := &ttnpb.DownlinkPath{
+			Path: &ttnpb.DownlinkPath_Fixed{
+				Fixed: &ttnpb.GatewayAntennaIdentifiers{
+					GatewayIdentifiers: ids,
+				},
+			},
+		}
+		if _, err := conn.SendDown(path, down); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case <-frontend.Down:
+		case <-time.After(timeout):
+			t.Fatalf("Expected downlink message timeout")
+		}
+
+		for _, tc := range []struct {
+			CorrelationIDs []string
+			Expected       *ttnpb.DownlinkMessage
+		}{
+			{
+				CorrelationIDs: []string{"test:downlink:2"},
+			},
+			{
+				CorrelationIDs: []string{"test:downlink:1"},
+				Expected:       down,
+			},
+			{
+				CorrelationIDs: []string{"test:downlink:1"}, // The downlink message is already acknowledged.
+			},
+		} {
+			frontend.TxAck <- &ttnpb.TxAcknowledgment{
+				CorrelationIDs: tc.CorrelationIDs,
+				Result:         ttnpb.TxAcknowledgment_TOO_LATE,
+			}
+			select {
+			case ack := <-conn.TxAck():
+				a.So(ack.DownlinkMessage, should.Equal, tc.Expected)
+			case <-time.After(timeout):
+				t.Fatalf("Expected Tx acknowledgement time-out")
+			}
+		}
+	})
 }
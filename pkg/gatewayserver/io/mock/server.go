@@ -70,8 +70,8 @@ func (s *server) FillGatewayContext(ctx context.Context, ids ttnpb.GatewayIdenti
 	return ctx, ids, nil
 }
 
-// Connect implements io.Server.
-func (s *server) Connect(ctx context.Context, protocol string, ids ttnpb.GatewayIdentifiers) (*io.Connection, error) {
+// GetGateway implements io.Server.
+func (s *server) GetGateway(ctx context.Context, protocol string, ids ttnpb.GatewayIdentifiers) (*ttnpb.Gateway, error) {
 	if err := rights.RequireGateway(ctx, ids, ttnpb.RIGHT_GATEWAY_LINK); err != nil {
 		return nil, err
 	}
@@ -82,11 +82,20 @@ func (s *server) Connect(ctx context.Context, protocol string, ids ttnpb.Gateway
 			FrequencyPlanID:    test.EUFrequencyPlanID,
 		}
 	}
+	return gtw, nil
+}
+
+// Connect implements io.Server.
+func (s *server) Connect(ctx context.Context, protocol string, ids ttnpb.GatewayIdentifiers) (*io.Connection, error) {
+	gtw, err := s.GetGateway(ctx, protocol, ids)
+	if err != nil {
+		return nil, err
+	}
 	fp, err := s.GetFrequencyPlan(ctx, ids)
 	if err != nil {
 		return nil, err
 	}
-	scheduler, err := scheduling.NewScheduler(ctx, fp, true)
+	scheduler, err := scheduling.NewScheduler(ctx, fp, true, nil, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +92,7 @@ func (protobufv2) FromDownlink(down *ttnpb.DownlinkMessage) ([]byte, error) {
 			Frequency:             settings.Frequency,
 			Power:                 settings.TxPower - eirpDelta,
 			PolarizationInversion: true,
-			RfChain:               0,
+			RfChain:               settings.AntennaIndex,
 			Timestamp:             settings.Timestamp,
 		},
 		ProtocolConfiguration: legacyttnpb.ProtocolTxConfiguration{
@@ -17,15 +17,35 @@ package udp
 import (
 	"context"
 	"net"
+	"strings"
 	"sync"
 	"time"
 
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	encoding "go.thethings.network/lorawan-stack/pkg/ttnpb/udp"
 )
 
 // Firewall filters packets by tracking addresses and time.
+// The gateway is nil if it is not known yet.
 type Firewall interface {
-	Filter(packet encoding.Packet) bool
+	Filter(packet encoding.Packet, gtw *ttnpb.Gateway) bool
+}
+
+type firewalls []Firewall
+
+// NewFirewalls returns a Firewall that only accepts packets that are accepted by all the given firewalls.
+func NewFirewalls(fws ...Firewall) Firewall {
+	return firewalls(fws)
+}
+
+func (fws firewalls) Filter(packet encoding.Packet, gtw *ttnpb.Gateway) bool {
+	for _, fw := range fws {
+		if !fw.Filter(packet, gtw) {
+			return false
+		}
+	}
+	return true
 }
 
 type addrTime struct {
@@ -81,7 +101,7 @@ func (v *memoryFirewall) filter(packet encoding.Packet, store *sync.Map) bool {
 	return true
 }
 
-func (v *memoryFirewall) Filter(packet encoding.Packet) bool {
+func (v *memoryFirewall) Filter(packet encoding.Packet, _ *ttnpb.Gateway) bool {
 	switch packet.PacketType {
 	case encoding.PullData, encoding.TxAck:
 		return v.filter(packet, &v.pull)
@@ -105,3 +125,114 @@ func (v *memoryFirewall) gc() {
 	gcStore(&v.pull)
 	gcStore(&v.push)
 }
+
+func parseAllowedIP(allowedIP string) (*net.IPNet, bool) {
+	if !strings.Contains(allowedIP, "/") {
+		ip := net.ParseIP(allowedIP)
+		if ip == nil {
+			return nil, false
+		}
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, true
+	}
+	_, ipNet, err := net.ParseCIDR(allowedIP)
+	if err != nil {
+		return nil, false
+	}
+	return ipNet, true
+}
+
+func allowsIP(allowedIPs []string, ip net.IP) bool {
+	for _, allowedIP := range allowedIPs {
+		if ipNet, ok := parseAllowedIP(allowedIP); ok && ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+type ipTime struct {
+	net.IP
+	lastSeen time.Time
+}
+
+type gatewayFirewall struct {
+	authenticated sync.Map
+	expires       time.Duration
+}
+
+// NewGatewayFirewall returns a Firewall that enforces the allowed IP addresses and the token of the gateway.
+// If the gateway has a token, packets are only accepted from the IP address from which the gateway last sent a status
+// message with that token. The authentication expires if no packets are received for the given duration.
+func NewGatewayFirewall(ctx context.Context, expires time.Duration) Firewall {
+	v := &gatewayFirewall{
+		expires: expires,
+	}
+	go func() {
+		ticker := time.NewTicker(expires)
+		for {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				v.gc()
+			}
+		}
+	}()
+	return v
+}
+
+func (v *gatewayFirewall) Filter(packet encoding.Packet, gtw *ttnpb.Gateway) bool {
+	if gtw == nil {
+		return true
+	}
+	if packet.GatewayEUI == nil || packet.GatewayAddr == nil {
+		return false
+	}
+	if len(gtw.UDPAllowedIPs) > 0 && !allowsIP(gtw.UDPAllowedIPs, packet.GatewayAddr.IP) {
+		return false
+	}
+	if gtw.UDPToken == "" {
+		return true
+	}
+	now := time.Now().UTC()
+	eui := *packet.GatewayEUI
+	if val, ok := v.authenticated.Load(eui); ok {
+		a := val.(ipTime)
+		if a.IP.Equal(packet.GatewayAddr.IP) && !a.lastSeen.Add(v.expires).Before(now) {
+			v.authenticated.Store(eui, ipTime{
+				IP:       a.IP,
+				lastSeen: now,
+			})
+			return true
+		}
+	}
+	if packet.PacketType != encoding.PushData || packet.Data == nil || packet.Data.Stat == nil || packet.Data.Stat.Token == "" {
+		return false
+	}
+	// The registry stores a hash of the token. As validating the token is expensive, the token is only validated when
+	// the gateway is not authenticated from this address.
+	if ok, err := auth.Password(gtw.UDPToken).Validate(packet.Data.Stat.Token); err != nil || !ok {
+		return false
+	}
+	v.authenticated.Store(eui, ipTime{
+		IP:       packet.GatewayAddr.IP,
+		lastSeen: now,
+	})
+	return true
+}
+
+func (v *gatewayFirewall) gc() {
+	now := time.Now().UTC()
+	v.authenticated.Range(func(k, val interface{}) bool {
+		a := val.(ipTime)
+		if a.lastSeen.Add(v.expires).Before(now) {
+			v.authenticated.Delete(k)
+		}
+		return true
+	})
+}
@@ -21,7 +21,9 @@ import (
 	"time"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/auth"
 	. "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	encoding "go.thethings.network/lorawan-stack/pkg/ttnpb/udp"
 	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -121,7 +123,147 @@ func TestMemoryFirewall(t *testing.T) {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
 			a := assertions.New(t)
 
-			actual := v.Filter(tc.Packet)
+			actual := v.Filter(tc.Packet, nil)
+			if !a.So(actual, should.Equal, tc.OK) {
+				t.FailNow()
+			}
+
+			time.Sleep(tc.WaitAfter)
+		})
+	}
+}
+
+func TestGatewayFirewall(t *testing.T) {
+	ctx := test.Context()
+
+	expires := 10 * time.Millisecond
+	v := NewGatewayFirewall(ctx, expires)
+
+	eui := types.EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	addr1 := net.UDPAddr{
+		IP:   []byte{0x01, 0x01, 0x01, 0x01},
+		Port: 1,
+	}
+	addr2 := net.UDPAddr{
+		IP:   []byte{0x01, 0x01, 0x01, 0x01},
+		Port: 2,
+	}
+	addr3 := net.UDPAddr{
+		IP:   []byte{0x03, 0x03, 0x03, 0x03},
+		Port: 3,
+	}
+	allowedIPs := &ttnpb.Gateway{
+		UDPAllowedIPs: []string{"1.1.1.0/24", "2.2.2.2"},
+	}
+	hashedToken, err := auth.Hash("secret")
+	if err != nil {
+		t.Fatalf("Failed to hash token: %v", err)
+	}
+	token := &ttnpb.Gateway{
+		UDPToken: string(hashedToken),
+	}
+	stat := func(token string) *encoding.Data {
+		return &encoding.Data{
+			Stat: &encoding.Stat{
+				Token: token,
+			},
+		}
+	}
+
+	for i, tc := range []struct {
+		Packet    encoding.Packet
+		Gateway   *ttnpb.Gateway
+		OK        bool
+		WaitAfter time.Duration
+	}{
+		{
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui,
+				GatewayAddr: &addr3,
+				PacketType:  encoding.PullData,
+			},
+			OK: true, // unknown gateway
+		},
+		{
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui,
+				GatewayAddr: &addr1,
+				PacketType:  encoding.PullData,
+			},
+			Gateway: allowedIPs,
+			OK:      true, // allowed IP range
+		},
+		{
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui,
+				GatewayAddr: &addr3,
+				PacketType:  encoding.PushData,
+			},
+			Gateway: allowedIPs,
+			OK:      false, // IP not allowed
+		},
+		{
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui,
+				GatewayAddr: &addr1,
+				PacketType:  encoding.PullData,
+			},
+			Gateway: token,
+			OK:      false, // not authenticated yet
+		},
+		{
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui,
+				GatewayAddr: &addr1,
+				PacketType:  encoding.PushData,
+				Data:        stat("wrong"),
+			},
+			Gateway: token,
+			OK:      false, // invalid token
+		},
+		{
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui,
+				GatewayAddr: &addr1,
+				PacketType:  encoding.PushData,
+				Data:        stat("secret"),
+			},
+			Gateway: token,
+			OK:      true, // authenticate
+		},
+		{
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui,
+				GatewayAddr: &addr2,
+				PacketType:  encoding.PullData,
+			},
+			Gateway: token,
+			OK:      true, // authenticated IP address
+		},
+		{
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui,
+				GatewayAddr: &addr3,
+				PacketType:  encoding.PushData,
+			},
+			Gateway:   token,
+			WaitAfter: expires * 2,
+			OK:        false, // other IP address
+		},
+		{
+			Packet: encoding.Packet{
+				GatewayEUI:  &eui,
+				GatewayAddr: &addr1,
+				PacketType:  encoding.PullData,
+			},
+			Gateway: token,
+			OK:      false, // authentication expired
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			a := assertions.New(t)
+
+			actual := v.Filter(tc.Packet, tc.Gateway)
 			if !a.So(actual, should.Equal, tc.OK) {
 				t.FailNow()
 			}
@@ -78,10 +78,11 @@ type srv struct {
 // Start starts the UDP frontend.
 func Start(ctx context.Context, server io.Server, conn *net.UDPConn, config Config) {
 	ctx = log.NewContextWithField(ctx, "namespace", "gatewayserver/io/udp")
-	var firewall Firewall
+	firewalls := []Firewall{NewGatewayFirewall(ctx, config.ConnectionExpires)}
 	if config.AddrChangeBlock > 0 {
-		firewall = NewMemoryFirewall(ctx, config.AddrChangeBlock)
+		firewalls = append(firewalls, NewMemoryFirewall(ctx, config.AddrChangeBlock))
 	}
+	firewall := NewFirewalls(firewalls...)
 	s := &srv{
 		ctx:      ctx,
 		config:   config,
@@ -162,14 +163,13 @@ func (s *srv) handlePackets() {
 				}
 			}
 
-			if s.firewall != nil && !s.firewall.Filter(packet) {
-				logger.Warn("Packet filtered")
-				break
-			}
-
-			cs, err := s.connect(ctx, eui)
+			cs, err := s.connect(ctx, eui, packet)
 			if err != nil {
-				logger.WithError(err).Warn("Failed to connect")
+				if errors.Resemble(err, errPacketFiltered) {
+					logger.Warn("Packet filtered")
+				} else {
+					logger.WithError(err).Warn("Failed to connect")
+				}
 				break
 			}
 
@@ -178,7 +178,17 @@ func (s *srv) handlePackets() {
 	}
 }
 
-func (s *srv) connect(ctx context.Context, eui types.EUI64) (*state, error) {
+var errPacketFiltered = errors.DefinePermissionDenied("packet_filtered", "packet filtered")
+
+// filter returns whether the packet of the given gateway is accepted by the firewall.
+func (s *srv) filter(packet encoding.Packet, gtw *ttnpb.Gateway) bool {
+	return s.firewall == nil || s.firewall.Filter(packet, gtw)
+}
+
+// connect returns the state of the connection of the gateway, and connects the gateway if it is not connected.
+// The packet is filtered before connecting, so that packets that are not accepted by the firewall do not affect the
+// connection. If the packet is filtered, errPacketFiltered is returned.
+func (s *srv) connect(ctx context.Context, eui types.EUI64, packet encoding.Packet) (*state, error) {
 	cs := &state{
 		ioWait:          make(chan struct{}),
 		startHandleDown: &sync.Once{},
@@ -211,6 +221,15 @@ func (s *srv) connect(ctx context.Context, eui types.EUI64) (*state, error) {
 				},
 			},
 		})
+		var gtw *ttnpb.Gateway
+		gtw, err = s.server.GetGateway(ctx, "udp", ids)
+		if err != nil {
+			return nil, err
+		}
+		if !s.filter(packet, gtw) {
+			err = errPacketFiltered
+			return nil, err
+		}
 		io, err = s.server.Connect(ctx, "udp", ids)
 		if err != nil {
 			return nil, err
@@ -220,6 +239,9 @@ func (s *srv) connect(ctx context.Context, eui types.EUI64) (*state, error) {
 		if cs.ioErr != nil {
 			return nil, cs.ioErr
 		}
+		if !s.filter(packet, cs.io.Gateway()) {
+			return nil, errPacketFiltered
+		}
 	}
 	return cs, nil
 }
@@ -299,7 +321,6 @@ func (s *srv) handleUp(ctx context.Context, state *state, packet encoding.Packet
 		if err := state.io.HandleTxAck(msg.TxAcknowledgment); err != nil {
 			logger.WithError(err).Warn("Failed to handle Tx acknowledgement")
 		}
-		// TODO: Send event to NS (https://github.com/TheThingsNetwork/lorawan-stack/issues/76)
 	}
 
 	return nil
@@ -310,6 +331,17 @@ var (
 	errDownlinkPathExpired = errors.DefineAborted("downlink_path_expired", "downlink path expired")
 )
 
+// reportTxFailure handles a Tx acknowledgment for a downlink message that could not be sent to the gateway.
+func (s *srv) reportTxFailure(ctx context.Context, state *state, down *ttnpb.DownlinkMessage) {
+	ack := &ttnpb.TxAcknowledgment{
+		CorrelationIDs: append(down.CorrelationIDs[:0:0], down.CorrelationIDs...),
+		Result:         ttnpb.TxAcknowledgment_UNKNOWN_ERROR,
+	}
+	if err := state.io.HandleTxAck(ack); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to handle Tx acknowledgement")
+	}
+}
+
 func (s *srv) handleDown(ctx context.Context, state *state) error {
 	logger := log.FromContext(ctx)
 	if err := s.server.ClaimDownlink(ctx, state.io.Gateway().GatewayIdentifiers); err != nil {
@@ -329,7 +361,7 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 			tx, err := encoding.FromDownlinkMessage(down)
 			if err != nil {
 				logger.WithError(err).Warn("Failed to marshal downlink message")
-				// TODO: Report to Network Server: https://github.com/TheThingsNetwork/lorawan-stack/issues/76
+				s.reportTxFailure(ctx, state, down)
 				break
 			}
 			downlinkPath := state.lastDownlinkPath.Load().(downlinkPath)
@@ -351,7 +383,7 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 				logger.Debug("Writing downlink message")
 				if err := s.write(packet); err != nil {
 					logger.WithError(err).Warn("Failed to write downlink message")
-					// TODO: Report to Network Server: https://github.com/TheThingsNetwork/lorawan-stack/issues/76
+					s.reportTxFailure(ctx, state, down)
 				}
 			}
 			canImmediate := atomic.LoadUint32(&state.receivedTxAck) == 1
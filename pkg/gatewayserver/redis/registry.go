@@ -0,0 +1,242 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/scheduling"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// EmissionRegistry is a Redis emission registry.
+// The emissions of a gateway's sub-band are stored in a sorted set, scored by the time the emission ends.
+type EmissionRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *EmissionRegistry) key(ctx context.Context, ids ttnpb.GatewayIdentifiers, subBand string) string {
+	return r.Redis.Key(unique.ID(ctx, ids), subBand)
+}
+
+func encodeEmission(em scheduling.StoredEmission) string {
+	return fmt.Sprintf("%d:%d:%s", em.Starts.UnixNano(), int64(em.Duration), em.Origin)
+}
+
+var errInvalidEmission = errors.DefineCorruption("invalid_emission", "invalid emission `{value}`")
+
+func decodeEmission(s string) (scheduling.StoredEmission, error) {
+	parts := strings.SplitN(s, ":", 3)
+	if len(parts) != 3 {
+		return scheduling.StoredEmission{}, errInvalidEmission.WithAttributes("value", s)
+	}
+	starts, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return scheduling.StoredEmission{}, errInvalidEmission.WithAttributes("value", s).WithCause(err)
+	}
+	d, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return scheduling.StoredEmission{}, errInvalidEmission.WithAttributes("value", s).WithCause(err)
+	}
+	return scheduling.StoredEmission{
+		Origin:   parts[2],
+		Starts:   time.Unix(0, starts),
+		Duration: time.Duration(d),
+	}, nil
+}
+
+// Add adds the emission of the gateway in the given sub-band.
+// Emissions that ended before the duty-cycle window are removed.
+func (r *EmissionRegistry) Add(ctx context.Context, ids ttnpb.GatewayIdentifiers, subBand string, em scheduling.StoredEmission) error {
+	k := r.key(ctx, ids, subBand)
+	ends := em.Starts.Add(em.Duration)
+	expired := time.Now().Add(-scheduling.DutyCycleWindow)
+	_, err := r.Redis.TxPipelined(func(p redis.Pipeliner) error {
+		p.ZAdd(k, redis.Z{
+			Score:  float64(ends.UnixNano()),
+			Member: encodeEmission(em),
+		})
+		p.ZRemRangeByScore(k, "-inf", fmt.Sprintf("(%d", expired.UnixNano()))
+		p.PExpireAt(k, ends.Add(scheduling.DutyCycleWindow))
+		return nil
+	})
+	return ttnredis.ConvertError(err)
+}
+
+// Find returns the emissions of the gateway in the given sub-band that end after the given time.
+func (r *EmissionRegistry) Find(ctx context.Context, ids ttnpb.GatewayIdentifiers, subBand string, from time.Time) ([]scheduling.StoredEmission, error) {
+	members, err := r.Redis.ZRangeByScore(r.key(ctx, ids, subBand), redis.ZRangeBy{
+		Min: strconv.FormatInt(from.UnixNano(), 10),
+		Max: "+inf",
+	}).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	ems := make([]scheduling.StoredEmission, 0, len(members))
+	for _, member := range members {
+		em, err := decodeEmission(member)
+		if err != nil {
+			return nil, err
+		}
+		ems = append(ems, em)
+	}
+	return ems, nil
+}
+
+// GatewayConnectionStatsRegistry is a Redis gateway connection stats registry.
+// The connection stats of each gateway are stored in a key that expires, and the unique IDs of the connected gateways
+// are stored in a set.
+type GatewayConnectionStatsRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *GatewayConnectionStatsRegistry) uidKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+func (r *GatewayConnectionStatsRegistry) connectedKey() string {
+	return r.Redis.Key("connected")
+}
+
+// Get returns the connection stats of the gateway.
+func (r *GatewayConnectionStatsRegistry) Get(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*ttnpb.GatewayConnectionStats, error) {
+	stats := &ttnpb.GatewayConnectionStats{}
+	if err := ttnredis.GetProto(r.Redis, r.uidKey(unique.ID(ctx, ids))).ScanProto(stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// Set sets the connection stats of the gateway, which expire after the given TTL.
+// If stats is nil, the connection stats are removed.
+func (r *GatewayConnectionStatsRegistry) Set(ctx context.Context, ids ttnpb.GatewayIdentifiers, stats *ttnpb.GatewayConnectionStats, ttl time.Duration) error {
+	uid := unique.ID(ctx, ids)
+	_, err := r.Redis.TxPipelined(func(p redis.Pipeliner) error {
+		if stats == nil {
+			p.Del(r.uidKey(uid))
+			p.SRem(r.connectedKey(), uid)
+			return nil
+		}
+		if _, err := ttnredis.SetProto(p, r.uidKey(uid), stats, ttl); err != nil {
+			return err
+		}
+		p.SAdd(r.connectedKey(), uid)
+		return nil
+	})
+	return ttnredis.ConvertError(err)
+}
+
+// Range calls f for the connection stats of each connected gateway, until f returns false.
+// Gateways of which the connection stats expired are removed from the set of connected gateways.
+func (r *GatewayConnectionStatsRegistry) Range(ctx context.Context, f func(ttnpb.GatewayIdentifiers, *ttnpb.GatewayConnectionStats) bool) error {
+	uids, err := r.Redis.SMembers(r.connectedKey()).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	if len(uids) == 0 {
+		return nil
+	}
+	ks := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		ks = append(ks, r.uidKey(uid))
+	}
+	vs, err := r.Redis.MGet(ks...).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	var expired []interface{}
+	defer func() {
+		if len(expired) > 0 {
+			r.Redis.SRem(r.connectedKey(), expired...)
+		}
+	}()
+	for i, v := range vs {
+		s, ok := v.(string)
+		if !ok {
+			expired = append(expired, uids[i])
+			continue
+		}
+		ids, err := unique.ToGatewayID(uids[i])
+		if err != nil {
+			return err
+		}
+		stats := &ttnpb.GatewayConnectionStats{}
+		if err := ttnredis.UnmarshalProto(s, stats); err != nil {
+			return err
+		}
+		if !f(ids, stats) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// GatewayStatusHistoryRegistry is a Redis gateway status history registry.
+// The samples of a gateway are stored in a sorted set, scored by the time of the sample.
+type GatewayStatusHistoryRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *GatewayStatusHistoryRegistry) key(ctx context.Context, ids ttnpb.GatewayIdentifiers) string {
+	return r.Redis.Key(unique.ID(ctx, ids))
+}
+
+// Add adds the sample to the history of the gateway. Samples that are older than the given retention are removed.
+func (r *GatewayStatusHistoryRegistry) Add(ctx context.Context, ids ttnpb.GatewayIdentifiers, sample *ttnpb.GatewayStatusHistory_Sample, retention time.Duration) error {
+	s, err := ttnredis.MarshalProto(sample)
+	if err != nil {
+		return err
+	}
+	k := r.key(ctx, ids)
+	expired := time.Now().Add(-retention)
+	_, err = r.Redis.TxPipelined(func(p redis.Pipeliner) error {
+		p.ZAdd(k, redis.Z{
+			Score:  float64(sample.Time.UnixNano()),
+			Member: s,
+		})
+		p.ZRemRangeByScore(k, "-inf", fmt.Sprintf("(%d", expired.UnixNano()))
+		p.PExpireAt(k, sample.Time.Add(retention))
+		return nil
+	})
+	return ttnredis.ConvertError(err)
+}
+
+// Find returns the samples of the gateway between from and to, in chronological order.
+func (r *GatewayStatusHistoryRegistry) Find(ctx context.Context, ids ttnpb.GatewayIdentifiers, from, to time.Time) ([]*ttnpb.GatewayStatusHistory_Sample, error) {
+	members, err := r.Redis.ZRangeByScore(r.key(ctx, ids), redis.ZRangeBy{
+		Min: strconv.FormatInt(from.UnixNano(), 10),
+		Max: strconv.FormatInt(to.UnixNano(), 10),
+	}).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	samples := make([]*ttnpb.GatewayStatusHistory_Sample, 0, len(members))
+	for _, member := range members {
+		sample := &ttnpb.GatewayStatusHistory_Sample{}
+		if err := ttnredis.UnmarshalProto(member, sample); err != nil {
+			return nil, err
+		}
+		samples = append(samples, sample)
+	}
+	return samples, nil
+}
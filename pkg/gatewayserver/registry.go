@@ -0,0 +1,68 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// EmissionRegistry is a store for emissions of gateways, used for duty-cycle accounting.
+type EmissionRegistry interface {
+	// Add adds the emission of the gateway in the sub-band identified by the given key.
+	Add(ctx context.Context, ids ttnpb.GatewayIdentifiers, subBand string, em scheduling.StoredEmission) error
+	// Find returns the emissions of the gateway in the sub-band identified by the given key that end after the given
+	// time.
+	Find(ctx context.Context, ids ttnpb.GatewayIdentifiers, subBand string, from time.Time) ([]scheduling.StoredEmission, error)
+}
+
+// gatewayEmissionStore is a scheduling.EmissionStore for a gateway in an EmissionRegistry.
+type gatewayEmissionStore struct {
+	registry EmissionRegistry
+	ids      ttnpb.GatewayIdentifiers
+}
+
+// AddEmission implements scheduling.EmissionStore.
+func (s *gatewayEmissionStore) AddEmission(ctx context.Context, subBand string, em scheduling.StoredEmission) error {
+	return s.registry.Add(ctx, s.ids, subBand, em)
+}
+
+// FindEmissions implements scheduling.EmissionStore.
+func (s *gatewayEmissionStore) FindEmissions(ctx context.Context, subBand string, from time.Time) ([]scheduling.StoredEmission, error) {
+	return s.registry.Find(ctx, s.ids, subBand, from)
+}
+
+// GatewayConnectionStatsRegistry is a store for connection stats of gateways that are connected to any Gateway Server
+// instance in the cluster.
+type GatewayConnectionStatsRegistry interface {
+	// Get returns the connection stats of the gateway.
+	Get(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*ttnpb.GatewayConnectionStats, error)
+	// Set sets the connection stats of the gateway, which expire after the given TTL.
+	// If stats is nil, the connection stats are removed.
+	Set(ctx context.Context, ids ttnpb.GatewayIdentifiers, stats *ttnpb.GatewayConnectionStats, ttl time.Duration) error
+	// Range calls f for the connection stats of each connected gateway, until f returns false.
+	Range(ctx context.Context, f func(ttnpb.GatewayIdentifiers, *ttnpb.GatewayConnectionStats) bool) error
+}
+
+// GatewayStatusHistoryRegistry is a store for the status and traffic history of gateways.
+type GatewayStatusHistoryRegistry interface {
+	// Add adds the sample to the history of the gateway. Samples that are older than the given retention are removed.
+	Add(ctx context.Context, ids ttnpb.GatewayIdentifiers, sample *ttnpb.GatewayStatusHistory_Sample, retention time.Duration) error
+	// Find returns the samples of the gateway between from and to, in chronological order.
+	Find(ctx context.Context, ids ttnpb.GatewayIdentifiers, from, to time.Time) ([]*ttnpb.GatewayStatusHistory_Sample, error)
+}
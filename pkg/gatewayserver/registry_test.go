@@ -0,0 +1,232 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func handleEmissionRegistryTest(t *testing.T, reg gatewayserver.EmissionRegistry) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayID: "foo-gateway",
+	}
+	now := time.Unix(0, time.Now().UnixNano())
+
+	ems, err := reg.Find(ctx, ids, "868000000-868600000", now.Add(-time.Minute))
+	a.So(err, should.BeNil)
+	a.So(ems, should.BeEmpty)
+
+	em1 := scheduling.StoredEmission{
+		Origin:   "scheduler-1",
+		Starts:   now.Add(-2 * time.Second),
+		Duration: 100 * time.Millisecond,
+	}
+	em2 := scheduling.StoredEmission{
+		Origin:   "scheduler-2",
+		Starts:   now.Add(time.Second),
+		Duration: 200 * time.Millisecond,
+	}
+	for _, em := range []scheduling.StoredEmission{em1, em2} {
+		err := reg.Add(ctx, ids, "868000000-868600000", em)
+		a.So(err, should.BeNil)
+	}
+
+	ems, err = reg.Find(ctx, ids, "868000000-868600000", now.Add(-time.Minute))
+	a.So(err, should.BeNil)
+	a.So(ems, should.Resemble, []scheduling.StoredEmission{em1, em2})
+
+	ems, err = reg.Find(ctx, ids, "868000000-868600000", now)
+	a.So(err, should.BeNil)
+	a.So(ems, should.Resemble, []scheduling.StoredEmission{em2})
+
+	ems, err = reg.Find(ctx, ids, "869400000-869650000", now.Add(-time.Minute))
+	a.So(err, should.BeNil)
+	a.So(ems, should.BeEmpty)
+
+	ems, err = reg.Find(ctx, ttnpb.GatewayIdentifiers{GatewayID: "bar-gateway"}, "868000000-868600000", now.Add(-time.Minute))
+	a.So(err, should.BeNil)
+	a.So(ems, should.BeEmpty)
+}
+
+func handleConnectionStatsRegistryTest(t *testing.T, reg gatewayserver.GatewayConnectionStatsRegistry) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	ids1 := ttnpb.GatewayIdentifiers{
+		GatewayID: "foo-gateway",
+	}
+	ids2 := ttnpb.GatewayIdentifiers{
+		GatewayID: "bar-gateway",
+	}
+	connectedAt := time.Unix(0, time.Now().UnixNano()).UTC()
+
+	rangeAll := func() map[string]*ttnpb.GatewayConnectionStats {
+		res := make(map[string]*ttnpb.GatewayConnectionStats)
+		err := reg.Range(ctx, func(ids ttnpb.GatewayIdentifiers, stats *ttnpb.GatewayConnectionStats) bool {
+			res[ids.GatewayID] = stats
+			return true
+		})
+		a.So(err, should.BeNil)
+		return res
+	}
+
+	_, err := reg.Get(ctx, ids1)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	a.So(rangeAll(), should.BeEmpty)
+
+	stats1 := &ttnpb.GatewayConnectionStats{
+		ConnectedAt:           &connectedAt,
+		Protocol:              "udp",
+		UplinkCount:           42,
+		FrequencyPlanID:       test.EUFrequencyPlanID,
+		GatewayServerInstance: "gs-1",
+	}
+	stats2 := &ttnpb.GatewayConnectionStats{
+		ConnectedAt:           &connectedAt,
+		Protocol:              "mqtt",
+		FrequencyPlanID:       test.EUFrequencyPlanID,
+		GatewayServerInstance: "gs-2",
+	}
+	a.So(reg.Set(ctx, ids1, stats1, time.Minute), should.BeNil)
+	a.So(reg.Set(ctx, ids2, stats2, time.Minute), should.BeNil)
+
+	stats, err := reg.Get(ctx, ids1)
+	a.So(err, should.BeNil)
+	a.So(stats, should.Resemble, stats1)
+	a.So(rangeAll(), should.Resemble, map[string]*ttnpb.GatewayConnectionStats{
+		"foo-gateway": stats1,
+		"bar-gateway": stats2,
+	})
+
+	a.So(reg.Set(ctx, ids1, nil, 0), should.BeNil)
+	_, err = reg.Get(ctx, ids1)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	a.So(rangeAll(), should.Resemble, map[string]*ttnpb.GatewayConnectionStats{
+		"bar-gateway": stats2,
+	})
+
+	a.So(reg.Set(ctx, ids2, stats2, test.Delay), should.BeNil)
+	time.Sleep(2 * test.Delay)
+	_, err = reg.Get(ctx, ids2)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	a.So(rangeAll(), should.BeEmpty)
+}
+
+func handleStatusHistoryRegistryTest(t *testing.T, reg gatewayserver.GatewayStatusHistoryRegistry) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	ids := ttnpb.GatewayIdentifiers{
+		GatewayID: "foo-gateway",
+	}
+	now := time.Unix(0, time.Now().UnixNano()).UTC()
+
+	samples, err := reg.Find(ctx, ids, now.Add(-time.Hour), now)
+	a.So(err, should.BeNil)
+	a.So(samples, should.BeEmpty)
+
+	sample1 := &ttnpb.GatewayStatusHistory_Sample{
+		Time:        now.Add(-2 * time.Hour),
+		UplinkCount: 1,
+	}
+	sample2 := &ttnpb.GatewayStatusHistory_Sample{
+		Time: now.Add(-30 * time.Minute),
+		Status: &ttnpb.GatewayStatus{
+			Time:     now.Add(-30 * time.Minute),
+			BootTime: now.Add(-24 * time.Hour),
+			Metrics:  map[string]float32{"temp": 25},
+		},
+	}
+	sample3 := &ttnpb.GatewayStatusHistory_Sample{
+		Time:          now.Add(-time.Minute),
+		UplinkCount:   3,
+		DownlinkCount: 1,
+	}
+	for _, sample := range []*ttnpb.GatewayStatusHistory_Sample{sample1, sample2, sample3} {
+		err := reg.Add(ctx, ids, sample, 3*time.Hour)
+		a.So(err, should.BeNil)
+	}
+
+	samples, err = reg.Find(ctx, ids, now.Add(-time.Hour), now)
+	a.So(err, should.BeNil)
+	a.So(samples, should.Resemble, []*ttnpb.GatewayStatusHistory_Sample{sample2, sample3})
+
+	samples, err = reg.Find(ctx, ids, now.Add(-3*time.Hour), now.Add(-time.Hour))
+	a.So(err, should.BeNil)
+	a.So(samples, should.Resemble, []*ttnpb.GatewayStatusHistory_Sample{sample1})
+
+	samples, err = reg.Find(ctx, ttnpb.GatewayIdentifiers{GatewayID: "bar-gateway"}, now.Add(-3*time.Hour), now)
+	a.So(err, should.BeNil)
+	a.So(samples, should.BeEmpty)
+
+	// Adding a sample with a shorter retention removes the samples that are older.
+	err = reg.Add(ctx, ids, &ttnpb.GatewayStatusHistory_Sample{
+		Time:        now,
+		UplinkCount: 4,
+	}, time.Hour)
+	a.So(err, should.BeNil)
+	samples, err = reg.Find(ctx, ids, now.Add(-3*time.Hour), now)
+	a.So(err, should.BeNil)
+	a.So(samples, should.HaveLength, 3)
+}
+
+func TestStatusHistoryRegistries(t *testing.T) {
+	namespace := [...]string{
+		"gatewayserver_test",
+	}
+
+	t.Run("Redis", func(t *testing.T) {
+		cl, flush := test.NewRedis(t, namespace[:]...)
+		defer flush()
+		defer cl.Close()
+		handleStatusHistoryRegistryTest(t, &redis.GatewayStatusHistoryRegistry{Redis: cl})
+	})
+}
+
+func TestConnectionStatsRegistries(t *testing.T) {
+	namespace := [...]string{
+		"gatewayserver_test",
+	}
+
+	t.Run("Redis", func(t *testing.T) {
+		cl, flush := test.NewRedis(t, namespace[:]...)
+		defer flush()
+		defer cl.Close()
+		handleConnectionStatsRegistryTest(t, &redis.GatewayConnectionStatsRegistry{Redis: cl})
+	})
+}
+
+func TestEmissionRegistries(t *testing.T) {
+	namespace := [...]string{
+		"gatewayserver_test",
+	}
+
+	t.Run("Redis", func(t *testing.T) {
+		cl, flush := test.NewRedis(t, namespace[:]...)
+		defer flush()
+		defer cl.Close()
+		handleEmissionRegistryTest(t, &redis.EmissionRegistry{Redis: cl})
+	})
+}
@@ -15,6 +15,7 @@
 package scheduling
 
 import (
+	"context"
 	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
@@ -90,3 +91,21 @@ func (ems Emissions) Insert(em Emission) Emissions {
 	}
 	return append(ems, em)
 }
+
+// StoredEmission is an emission in server time, as stored in an EmissionStore.
+type StoredEmission struct {
+	// Origin identifies the scheduler that scheduled the emission.
+	Origin   string
+	Starts   time.Time
+	Duration time.Duration
+}
+
+// EmissionStore stores the emissions of a gateway in server time.
+// The store is used to restore the duty-cycle utilization when a gateway reconnects, and to share the utilization
+// between Gateway Server instances that serve the same gateway.
+type EmissionStore interface {
+	// AddEmission adds the emission in the sub-band identified by the given key.
+	AddEmission(ctx context.Context, subBand string, em StoredEmission) error
+	// FindEmissions returns the emissions in the sub-band identified by the given key that end after the given time.
+	FindEmissions(ctx context.Context, subBand string, from time.Time) ([]StoredEmission, error)
+}
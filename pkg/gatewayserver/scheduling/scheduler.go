@@ -16,10 +16,13 @@ package scheduling
 
 import (
 	"context"
+	"crypto/rand"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/oklog/ulid"
 	"go.thethings.network/lorawan-stack/pkg/band"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
@@ -42,22 +45,49 @@ var (
 	// This time is comprised of a higher network latency and QueueDelay. This delay is used for pseudo-immediate
 	// scheduling, see ScheduleAnytime.
 	ScheduleTimeLong = 300*time.Millisecond + QueueDelay
+
+	// LBTTxStartDelay is the time the concentrator needs between the end of the listen-before-talk scan and the start of
+	// the emission.
+	LBTTxStartDelay = 1500 * time.Microsecond
+
+	// ChannelBusyBackoff is the time during which no emissions are scheduled on a channel that was reported busy by
+	// listen-before-talk.
+	ChannelBusyBackoff = 10 * time.Second
+
+	// UtilizationInterval is the interval at which the duty-cycle utilization reported by DutyCycleUtilization is
+	// updated, in addition to the updates when emissions are scheduled.
+	UtilizationInterval = 10 * time.Second
 )
 
 // NewScheduler instantiates a new Scheduler for the given frequency plan.
-func NewScheduler(ctx context.Context, fp *frequencyplans.FrequencyPlan, enforceDutyCycle bool) (*Scheduler, error) {
+// If the duty-cycle is enforced and the given emission store is not nil, the emissions are stored so that the duty-cycle
+// utilization is restored on reconnects and shared with other schedulers of the same gateway. The emissions of other
+// schedulers are loaded from the store when the clock is synced and, if non-zero, at the given sync interval.
+func NewScheduler(ctx context.Context, fp *frequencyplans.FrequencyPlan, enforceDutyCycle bool, store EmissionStore, syncInterval time.Duration) (*Scheduler, error) {
 	s := &Scheduler{
 		clock:             &RolloverClock{},
 		respectsDwellTime: fp.RespectsDwellTime,
 		timeOffAir:        fp.TimeOffAir,
+		queueDelay:        QueueDelay,
+		busyChannels:      make(map[uint64]ConcentratorTime),
+	}
+	if fp.LBT != nil {
+		// With listen-before-talk, the concentrator scans the channel before each emission, so the emission effectively
+		// starts earlier. The RSSI target is evaluated by the gateway.
+		s.lbtTime = fp.LBT.ScanTime + LBTTxStartDelay
+		s.queueDelay += s.lbtTime
 	}
 	if enforceDutyCycle {
 		band, err := band.GetByID(fp.BandID)
 		if err != nil {
 			return nil, err
 		}
+		origin := ulid.MustNew(ulid.Now(), rand.Reader).String()
 		for _, subBand := range band.SubBands {
 			sb := NewSubBand(ctx, subBand, s.clock, nil)
+			if store != nil {
+				sb.useStore(ctx, store, origin, syncInterval, &s.mu)
+			}
 			s.subBands = append(s.subBands, sb)
 		}
 	} else {
@@ -68,6 +98,7 @@ func NewScheduler(ctx context.Context, fp *frequencyplans.FrequencyPlan, enforce
 		}, s.clock, nil)
 		s.subBands = append(s.subBands, sb)
 	}
+	go s.updateUtilizationEvery(ctx, UtilizationInterval)
 	return s, nil
 }
 
@@ -76,9 +107,13 @@ type Scheduler struct {
 	clock             *RolloverClock
 	respectsDwellTime func(isDownlink bool, frequency uint64, duration time.Duration) bool
 	timeOffAir        frequencyplans.TimeOffAir
+	lbtTime           time.Duration
+	queueDelay        time.Duration
 	subBands          []*SubBand
 	mu                sync.Mutex
 	emissions         Emissions
+	busyChannels      map[uint64]ConcentratorTime
+	utilization       uint32 // float32 bits; accessed atomically.
 }
 
 var errSubBandNotFound = errors.DefineFailedPrecondition("sub_band_not_found", "sub-band not found for frequency `{frequency}` Hz")
@@ -114,10 +149,25 @@ func (s *Scheduler) newEmission(payloadSize int, settings ttnpb.TxSettings) (Emi
 }
 
 var (
-	errConflict = errors.DefineResourceExhausted("conflict", "scheduling conflict")
-	errTooLate  = errors.DefineFailedPrecondition("too_late", "too late to transmission scheduled time (delta is `{delta}`)")
+	errConflict    = errors.DefineResourceExhausted("conflict", "scheduling conflict")
+	errTooLate     = errors.DefineFailedPrecondition("too_late", "too late to transmission scheduled time (delta is `{delta}`)")
+	errChannelBusy = errors.DefineResourceExhausted("channel_busy", "channel `{frequency}` Hz is busy")
 )
 
+// busyUntil returns the time until which the channel of the given frequency is busy.
+// This method returns false if the channel is not busy.
+func (s *Scheduler) busyUntil(frequency uint64) (ConcentratorTime, bool) {
+	t, ok := s.busyChannels[frequency]
+	if !ok {
+		return 0, false
+	}
+	if !s.clock.IsSynced() || t < s.clock.ServerTime(time.Now()) {
+		delete(s.busyChannels, frequency)
+		return 0, false
+	}
+	return t, true
+}
+
 // ScheduleAt attempts to schedule the given Tx settings with the given priority.
 func (s *Scheduler) ScheduleAt(ctx context.Context, payloadSize int, settings ttnpb.TxSettings, priority ttnpb.TxSchedulePriority) (Emission, error) {
 	s.mu.Lock()
@@ -125,10 +175,10 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, payloadSize int, settings tt
 	if s.clock.IsSynced() {
 		now := s.clock.ServerTime(time.Now())
 		if settings.Time != nil {
-			if delta := time.Duration(s.clock.GatewayTime(*settings.Time) - now); delta < ScheduleTimeShort {
+			if delta := time.Duration(s.clock.GatewayTime(*settings.Time) - now); delta < ScheduleTimeShort+s.lbtTime {
 				return Emission{}, errTooLate.WithAttributes("delta", delta)
 			}
-		} else if delta := time.Duration(s.clock.TimestampTime(settings.Timestamp) - s.clock.ServerTime(time.Now())); delta < ScheduleTimeShort {
+		} else if delta := time.Duration(s.clock.TimestampTime(settings.Timestamp) - s.clock.ServerTime(time.Now())); delta < ScheduleTimeShort+s.lbtTime {
 			return Emission{}, errTooLate.WithAttributes("delta", delta)
 		}
 	}
@@ -140,8 +190,11 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, payloadSize int, settings tt
 	if err != nil {
 		return Emission{}, err
 	}
+	if until, ok := s.busyUntil(settings.Frequency); ok && em.t < until {
+		return Emission{}, errChannelBusy.WithAttributes("frequency", settings.Frequency)
+	}
 	for _, other := range s.emissions {
-		if em.AfterWithOffAir(other, s.timeOffAir)-QueueDelay < 0 && em.BeforeWithOffAir(other, s.timeOffAir)-QueueDelay < 0 {
+		if em.AfterWithOffAir(other, s.timeOffAir)-s.queueDelay < 0 && em.BeforeWithOffAir(other, s.timeOffAir)-s.queueDelay < 0 {
 			return Emission{}, errConflict
 		}
 	}
@@ -149,6 +202,7 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, payloadSize int, settings tt
 		return Emission{}, err
 	}
 	s.emissions = s.emissions.Insert(em)
+	s.updateUtilization()
 	return em, nil
 }
 
@@ -167,12 +221,12 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, payloadSize int, settin
 		if settings.Timestamp == 0 && settings.Time == nil {
 			settings.Timestamp = uint32((time.Duration(now) + ScheduleTimeLong) / time.Microsecond)
 		} else if settings.Time != nil {
-			if delta := time.Duration(s.clock.GatewayTime(*settings.Time) - now); delta < ScheduleTimeShort {
-				t := settings.Time.Add(ScheduleTimeShort - delta)
+			if delta := time.Duration(s.clock.GatewayTime(*settings.Time) - now); delta < ScheduleTimeShort+s.lbtTime {
+				t := settings.Time.Add(ScheduleTimeShort + s.lbtTime - delta)
 				settings.Time = &t
 			}
-		} else if delta := time.Duration(s.clock.TimestampTime(settings.Timestamp) - now); delta < ScheduleTimeShort {
-			settings.Timestamp += uint32((ScheduleTimeShort - delta) / time.Microsecond)
+		} else if delta := time.Duration(s.clock.TimestampTime(settings.Timestamp) - now); delta < ScheduleTimeShort+s.lbtTime {
+			settings.Timestamp += uint32((ScheduleTimeShort + s.lbtTime - delta) / time.Microsecond)
 		}
 	}
 	sb, err := s.findSubBand(settings.Frequency)
@@ -183,6 +237,11 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, payloadSize int, settin
 	if err != nil {
 		return Emission{}, err
 	}
+	if until, ok := s.busyUntil(settings.Frequency); ok && em.t < until {
+		// Schedule after the channel is no longer considered busy.
+		em.t = until
+	}
+	lbt := s.lbtTime > 0
 	i := 0
 	next := func() ConcentratorTime {
 		if len(s.emissions) == 0 {
@@ -190,16 +249,21 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, payloadSize int, settin
 			return em.t
 		}
 		for i < len(s.emissions)-1 {
+			if lbt && em.AfterWithOffAir(s.emissions[i+1], s.timeOffAir)-s.queueDelay >= 0 {
+				// The emission starts after the next because the channel was busy; try the next window.
+				i++
+				continue
+			}
 			// Find a window between two emissions that does not conflict with either side.
-			prevConflicts := s.emissions[i].AfterWithOffAir(em, s.timeOffAir)-QueueDelay < 0
+			prevConflicts := s.emissions[i].AfterWithOffAir(em, s.timeOffAir)-s.queueDelay < 0
 			if prevConflicts {
 				// Schedule right after previous to resolve conflict.
-				em.t = s.emissions[i].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(QueueDelay)
+				em.t = s.emissions[i].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(s.queueDelay)
 			}
-			nextConflicts := em.BeforeWithOffAir(s.emissions[i+1], s.timeOffAir)-QueueDelay < 0
+			nextConflicts := em.BeforeWithOffAir(s.emissions[i+1], s.timeOffAir)-s.queueDelay < 0
 			if nextConflicts {
 				// If it conflicts with the next, try the next window.
-				em.t = s.emissions[i+1].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(QueueDelay)
+				em.t = s.emissions[i+1].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(s.queueDelay)
 				i++
 				continue
 			}
@@ -209,21 +273,98 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, payloadSize int, settin
 			return em.t
 		}
 		// No emissions to schedule in between; schedule after last emission.
-		return s.emissions[len(s.emissions)-1].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(QueueDelay)
+		// With listen-before-talk, the emission may start later because the channel was busy.
+		if t := s.emissions[len(s.emissions)-1].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(s.queueDelay); !lbt || t > em.t {
+			return t
+		}
+		return em.t
 	}
 	em, err = sb.ScheduleAnytime(em.d, next, priority)
 	if err != nil {
 		return Emission{}, err
 	}
 	s.emissions = s.emissions.Insert(em)
+	s.updateUtilization()
 	return em, nil
 }
 
+// ChannelBusy marks the channel of the given frequency busy, because the gateway reported that the channel was busy
+// during listen-before-talk. No emissions are scheduled on the channel for ChannelBusyBackoff, so that downlink
+// messages are scheduled in another window or on another channel instead.
+// This method has no effect if the frequency plan does not use listen-before-talk or if the clock is not synced with
+// the server.
+func (s *Scheduler) ChannelBusy(frequency uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lbtTime == 0 || !s.clock.IsSynced() {
+		return
+	}
+	s.busyChannels[frequency] = s.clock.ServerTime(time.Now()) + ConcentratorTime(ChannelBusyBackoff)
+}
+
+// updateUtilization computes the highest utilization of the sub-bands and stores it to be returned by
+// DutyCycleUtilization. The caller must hold s.mu, as the sub-bands read the clock.
+func (s *Scheduler) updateUtilization() {
+	var max float32
+	for _, sb := range s.subBands {
+		if utilization := sb.DutyCycleUtilization(); utilization > max {
+			max = utilization
+		}
+	}
+	atomic.StoreUint32(&s.utilization, math.Float32bits(max))
+}
+
+func (s *Scheduler) updateUtilizationEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			s.updateUtilization()
+			s.mu.Unlock()
+		}
+	}
+}
+
+// DutyCycleUtilization returns the highest utilization of the sub-bands, as a fraction of the available duty-cycle.
+// The utilization is updated when emissions are scheduled and every UtilizationInterval, so that this method is cheap
+// to call for every uplink message.
+func (s *Scheduler) DutyCycleUtilization() float32 {
+	return math.Float32frombits(atomic.LoadUint32(&s.utilization))
+}
+
+// QueueDepth returns the number of scheduled emissions that did not start yet.
+// This method returns 0 if the clock is not synced with the server.
+func (s *Scheduler) QueueDepth() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.clock.IsSynced() {
+		return 0
+	}
+	now := s.clock.ServerTime(time.Now())
+	n := 0
+	for i := len(s.emissions) - 1; i >= 0 && s.emissions[i].Starts() > now; i-- {
+		n++
+	}
+	return n
+}
+
+// SubBands returns the sub-bands of the scheduler.
+func (s *Scheduler) SubBands() []*SubBand {
+	return s.subBands
+}
+
 // Sync synchronizes the clock with the given concentrator time v and the server time.
 func (s *Scheduler) Sync(v uint32, server time.Time) {
 	s.mu.Lock()
 	s.clock.Sync(v, server)
 	s.mu.Unlock()
+	for _, sb := range s.subBands {
+		sb.requestLoad()
+	}
 }
 
 // SyncWithGateway synchronizes the clock with the given concentrator time v, the server time and the gateway time that
@@ -232,6 +373,9 @@ func (s *Scheduler) SyncWithGateway(v uint32, server, gateway time.Time) {
 	s.mu.Lock()
 	s.clock.SyncWithGateway(v, server, gateway)
 	s.mu.Unlock()
+	for _, sb := range s.subBands {
+		sb.requestLoad()
+	}
 }
 
 // Now returns an indication of the current concentrator time.
@@ -15,8 +15,9 @@
 package scheduling
 
 var (
-	ErrConflict  = errConflict
-	ErrDwellTime = errDwellTime
-	ErrTooLate   = errTooLate
-	ErrDutyCycle = errDutyCycle
+	ErrConflict    = errConflict
+	ErrChannelBusy = errChannelBusy
+	ErrDwellTime   = errDwellTime
+	ErrTooLate     = errTooLate
+	ErrDutyCycle   = errDutyCycle
 )
@@ -15,6 +15,7 @@
 package scheduling_test
 
 import (
+	"context"
 	"strconv"
 	"testing"
 	"time"
@@ -43,7 +44,7 @@ func TestScheduleAt(t *testing.T) {
 			Duration:  durationPtr(2 * time.Second),
 		},
 	}
-	scheduler, err := scheduling.NewScheduler(ctx, fp, true)
+	scheduler, err := scheduling.NewScheduler(ctx, fp, true, nil, 0)
 	a.So(err, should.BeNil)
 	scheduler.SyncWithGateway(0, time.Now(), time.Unix(0, 0))
 
@@ -266,33 +267,17 @@ func TestScheduleAnytime(t *testing.T) {
 			Duration:  durationPtr(2 * time.Second),
 		},
 	}
-	scheduler, err := scheduling.NewScheduler(ctx, fp, true)
+	scheduler, err := scheduling.NewScheduler(ctx, fp, true, nil, 0)
 	a.So(err, should.BeNil)
 	scheduler.SyncWithGateway(0, time.Now(), time.Unix(0, 0))
 
-	settingsAt := func(frequency uint64, sf, t uint32) ttnpb.TxSettings {
-		return ttnpb.TxSettings{
-			DataRate: ttnpb.DataRate{
-				Modulation: &ttnpb.DataRate_LoRa{
-					LoRa: &ttnpb.LoRaDataRate{
-						Bandwidth:       125000,
-						SpreadingFactor: sf,
-					},
-				},
-			},
-			CodingRate: "4/5",
-			Frequency:  frequency,
-			Timestamp:  t,
-		}
-	}
-
 	// Scheduling two items, occupying considering time-on-air, time-off-air and queue delay.
 	// Time-on-air is 41216 us, time-off-air is 1000000 us, queue delay is 30000 us.
 	// 1: [1000000, 2071216]
 	// 2: [4000000, 5071216]
-	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(869525000, 7, 1000000), ttnpb.TxSchedulePriority_NORMAL)
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(869525000, 7, nil, 1000000), ttnpb.TxSchedulePriority_NORMAL)
 	a.So(err, should.BeNil)
-	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(869525000, 7, 4000000), ttnpb.TxSchedulePriority_NORMAL)
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(869525000, 7, nil, 4000000), ttnpb.TxSchedulePriority_NORMAL)
 	a.So(err, should.BeNil)
 
 	// Try schedule a transmission from 1000000 us.
@@ -301,7 +286,7 @@ func TestScheduleAnytime(t *testing.T) {
 	// 1: [1000000, 2071216]
 	// 3: [2071216, 3142432]
 	// 2: [4000000, 5071216]
-	em, err := scheduler.ScheduleAnytime(ctx, 10, settingsAt(869525000, 7, 1000000), ttnpb.TxSchedulePriority_NORMAL)
+	em, err := scheduler.ScheduleAnytime(ctx, 10, settingsAt(869525000, 7, nil, 1000000), ttnpb.TxSchedulePriority_NORMAL)
 	a.So(err, should.BeNil)
 	a.So(em.Starts(), should.Equal, 2071216*time.Microsecond)
 
@@ -312,7 +297,7 @@ func TestScheduleAnytime(t *testing.T) {
 	// 3: [2071216, 3142432]
 	// 2: [4000000, 5071216]
 	// 4: [5071216, 6142432]
-	em, err = scheduler.ScheduleAnytime(ctx, 10, settingsAt(869525000, 7, 1000000), ttnpb.TxSchedulePriority_NORMAL)
+	em, err = scheduler.ScheduleAnytime(ctx, 10, settingsAt(869525000, 7, nil, 1000000), ttnpb.TxSchedulePriority_NORMAL)
 	a.So(err, should.BeNil)
 	a.So(em.Starts(), should.Equal, 5071216*time.Microsecond)
 
@@ -325,18 +310,18 @@ func TestScheduleAnytime(t *testing.T) {
 	// 2: [4000000, 5071216]
 	// 4: [5071216, 6142432]
 	// 5: [14121200, 15112432]
-	em, err = scheduler.ScheduleAnytime(ctx, 10, settingsAt(869525000, 12, 1000000), ttnpb.TxSchedulePriority_HIGHEST)
+	em, err = scheduler.ScheduleAnytime(ctx, 10, settingsAt(869525000, 12, nil, 1000000), ttnpb.TxSchedulePriority_HIGHEST)
 	a.So(err, should.BeNil)
 	a.So(em.Starts(), should.Equal, 14121200*time.Microsecond)
 
 	// Try schedule another transmission from 1000000 us.
 	// Time-on-air is 991232 us, time-off-air is 1000000 us, queue delay is 30000 us.
 	// It's 9.91% in a 1% duty-cycle sub-band, so it hits the duty-cycle limitation.
-	_, err = scheduler.ScheduleAnytime(ctx, 10, settingsAt(868100000, 12, 1000000), ttnpb.TxSchedulePriority_HIGHEST)
+	_, err = scheduler.ScheduleAnytime(ctx, 10, settingsAt(868100000, 12, nil, 1000000), ttnpb.TxSchedulePriority_HIGHEST)
 	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrDutyCycle)
 }
 
-func TestScheduleAnytimeShort(t *testing.T) {
+func TestSchedulerUtilization(t *testing.T) {
 	a := assertions.New(t)
 	ctx := test.Context()
 	fp := &frequencyplans.FrequencyPlan{
@@ -344,32 +329,56 @@ func TestScheduleAnytimeShort(t *testing.T) {
 		TimeOffAir: frequencyplans.TimeOffAir{
 			Duration: time.Second,
 		},
-		DwellTime: frequencyplans.DwellTime{
-			Downlinks: boolPtr(true),
-			Duration:  durationPtr(2 * time.Second),
-		},
 	}
+	scheduler, err := scheduling.NewScheduler(ctx, fp, true, nil, 0)
+	a.So(err, should.BeNil)
+	a.So(scheduler.QueueDepth(), should.Equal, 0)
+	scheduler.SyncWithGateway(0, time.Now(), time.Unix(0, 0))
+	a.So(scheduler.QueueDepth(), should.Equal, 0)
+	a.So(scheduler.DutyCycleUtilization(), should.Equal, 0)
 
-	settingsAt := func(frequency uint64, sf uint32, time *time.Time, timestamp uint32) ttnpb.TxSettings {
-		return ttnpb.TxSettings{
+	for _, t := range []uint32{1000000, 4000000} {
+		_, err = scheduler.ScheduleAt(ctx, 10, ttnpb.TxSettings{
 			DataRate: ttnpb.DataRate{
 				Modulation: &ttnpb.DataRate_LoRa{
 					LoRa: &ttnpb.LoRaDataRate{
 						Bandwidth:       125000,
-						SpreadingFactor: sf,
+						SpreadingFactor: 7,
 					},
 				},
 			},
 			CodingRate: "4/5",
-			Frequency:  frequency,
-			Time:       time,
-			Timestamp:  timestamp,
-		}
+			Frequency:  869525000,
+			Timestamp:  t,
+		}, ttnpb.TxSchedulePriority_NORMAL)
+		a.So(err, should.BeNil)
+	}
+	a.So(scheduler.QueueDepth(), should.Equal, 2)
+	a.So(scheduler.DutyCycleUtilization(), should.Equal, 0)
+
+	// The utilization is updated periodically after the first emission.
+	time.Sleep(1100*time.Millisecond + 2*scheduling.UtilizationInterval)
+	a.So(scheduler.QueueDepth(), should.Equal, 1)
+	a.So(scheduler.DutyCycleUtilization(), should.BeGreaterThan, 0)
+}
+
+func TestScheduleAnytimeShort(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	fp := &frequencyplans.FrequencyPlan{
+		BandID: band.EU_863_870,
+		TimeOffAir: frequencyplans.TimeOffAir{
+			Duration: time.Second,
+		},
+		DwellTime: frequencyplans.DwellTime{
+			Downlinks: boolPtr(true),
+			Duration:  durationPtr(2 * time.Second),
+		},
 	}
 
 	// Gateway time; too late (100 ms).
 	{
-		scheduler, err := scheduling.NewScheduler(ctx, fp, true)
+		scheduler, err := scheduling.NewScheduler(ctx, fp, true, nil, 0)
 		a.So(err, should.BeNil)
 		scheduler.SyncWithGateway(0, time.Now(), time.Unix(0, 0))
 		em, err := scheduler.ScheduleAnytime(ctx, 10, settingsAt(869525000, 7, timePtr(time.Unix(0, int64(100*time.Millisecond))), 0), ttnpb.TxSchedulePriority_NORMAL)
@@ -379,7 +388,7 @@ func TestScheduleAnytimeShort(t *testing.T) {
 
 	// Timestamp; too late (100 ms).
 	{
-		scheduler, err := scheduling.NewScheduler(ctx, fp, true)
+		scheduler, err := scheduling.NewScheduler(ctx, fp, true, nil, 0)
 		a.So(err, should.BeNil)
 		scheduler.SyncWithGateway(0, time.Now(), time.Unix(0, 0))
 		em, err := scheduler.ScheduleAnytime(ctx, 10, settingsAt(869525000, 7, nil, 100*1000), ttnpb.TxSchedulePriority_NORMAL)
@@ -387,3 +396,142 @@ func TestScheduleAnytimeShort(t *testing.T) {
 		a.So(time.Duration(em.Starts()), should.AlmostEqual, scheduling.ScheduleTimeShort, test.Delay/1000)
 	}
 }
+
+func TestScheduleLBT(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	fp := &frequencyplans.FrequencyPlan{
+		BandID: band.EU_863_870,
+		LBT: &frequencyplans.LBT{
+			RSSITarget: -80,
+			ScanTime:   5 * time.Millisecond,
+		},
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fp, false, nil, 0)
+	a.So(err, should.BeNil)
+	scheduler.SyncWithGateway(0, time.Now(), time.Unix(0, 0))
+
+	// Time-on-air is 41216 us, queue delay is 30000 us, scan time is 5000 us and Tx start delay is 1500 us.
+	// 1: [1000000, 1041216]
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(869525000, 7, nil, 1000000), ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.BeNil)
+
+	// The scan of the next emission starts before the queue delay has passed.
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(869525000, 7, nil, 1077715), ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrConflict)
+
+	// 2: [1077716, 1118932]
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(869525000, 7, nil, 1077716), ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.BeNil)
+
+	// 3: [1155432, 1196648]
+	em, err := scheduler.ScheduleAnytime(ctx, 10, settingsAt(869525000, 7, nil, 1000000), ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.BeNil)
+	a.So(em.Starts(), should.Equal, 1155432*time.Microsecond)
+}
+
+func TestScheduleChannelBusy(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	fp := &frequencyplans.FrequencyPlan{
+		BandID: band.EU_863_870,
+		LBT: &frequencyplans.LBT{
+			RSSITarget: -80,
+			ScanTime:   5 * time.Millisecond,
+		},
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fp, false, nil, 0)
+	a.So(err, should.BeNil)
+	scheduler.SyncWithGateway(0, time.Now(), time.Unix(0, 0))
+	scheduler.ChannelBusy(869525000)
+
+	// The busy channel is avoided for the backoff time.
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(869525000, 7, nil, 1000000), ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrChannelBusy)
+
+	// Other channels are not affected.
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(868100000, 7, nil, 1000000), ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.BeNil)
+
+	// Scheduling anytime on the busy channel is postponed until after the backoff time.
+	em, err := scheduler.ScheduleAnytime(ctx, 10, settingsAt(869525000, 7, nil, 1000000), ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.BeNil)
+	a.So(time.Duration(em.Starts()), should.BeGreaterThanOrEqualTo, scheduling.ChannelBusyBackoff)
+
+	// Without listen-before-talk, channels are never considered busy.
+	scheduler, err = scheduling.NewScheduler(ctx, &frequencyplans.FrequencyPlan{BandID: band.EU_863_870}, false, nil, 0)
+	a.So(err, should.BeNil)
+	scheduler.SyncWithGateway(0, time.Now(), time.Unix(0, 0))
+	scheduler.ChannelBusy(869525000)
+	_, err = scheduler.ScheduleAt(ctx, 10, settingsAt(869525000, 7, nil, 1000000), ttnpb.TxSchedulePriority_NORMAL)
+	a.So(err, should.BeNil)
+}
+
+func TestScheduleWithEmissionStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	fp := &frequencyplans.FrequencyPlan{
+		BandID: band.EU_863_870,
+		TimeOffAir: frequencyplans.TimeOffAir{
+			Duration: time.Second,
+		},
+	}
+	store := &memoryEmissionStore{}
+
+	// The sub-band of 868.1 MHz has a duty-cycle of 1%, which is 100 ms in the window of 10 seconds.
+	// Time-on-air is 41216 us, so two emissions fit with the highest priority, but three do not.
+	s1, err := scheduling.NewScheduler(ctx, fp, true, store, time.Minute)
+	a.So(err, should.BeNil)
+	s1.Sync(0, time.Now())
+	_, err = s1.ScheduleAt(ctx, 10, settingsAt(868100000, 7, nil, 1000000), ttnpb.TxSchedulePriority_HIGHEST)
+	a.So(err, should.BeNil)
+	// Own emissions in the store are not accounted twice.
+	_, err = s1.ScheduleAt(ctx, 10, settingsAt(868100000, 7, nil, 3000000), ttnpb.TxSchedulePriority_HIGHEST)
+	a.So(err, should.BeNil)
+	// Emissions are stored in the background.
+	time.Sleep(test.Delay)
+
+	// Another scheduler of the same gateway, for example after a reconnect, has a different concentrator time.
+	s2, err := scheduling.NewScheduler(ctx, fp, true, store, time.Minute)
+	a.So(err, should.BeNil)
+	s2.Sync(1000000000, time.Now())
+	// Emissions of other schedulers are loaded in the background when the clock is synced.
+	time.Sleep(test.Delay)
+	_, err = s2.ScheduleAt(ctx, 10, settingsAt(868100000, 7, nil, 1005000000), ttnpb.TxSchedulePriority_HIGHEST)
+	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrDutyCycle)
+
+	// Without store, the emissions of the other scheduler are unknown.
+	s3, err := scheduling.NewScheduler(ctx, fp, true, nil, 0)
+	a.So(err, should.BeNil)
+	s3.Sync(1000000000, time.Now())
+	_, err = s3.ScheduleAt(ctx, 10, settingsAt(868100000, 7, nil, 1005000000), ttnpb.TxSchedulePriority_HIGHEST)
+	a.So(err, should.BeNil)
+}
+
+func TestScheduleWithEmissionStoreConcurrentSync(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	fp := &frequencyplans.FrequencyPlan{
+		BandID: band.EU_863_870,
+	}
+	store := &memoryEmissionStore{}
+
+	scheduler, err := scheduling.NewScheduler(ctx, fp, true, store, time.Millisecond)
+	a.So(err, should.BeNil)
+
+	// Syncing the clock requests loading the emissions, while loading also happens at the sync interval.
+	// Run with the race detector to verify that the clock is not accessed concurrently.
+	for i := 0; i < 100; i++ {
+		now := time.Now()
+		if i%2 == 0 {
+			scheduler.Sync(uint32(i*1000), now)
+		} else {
+			scheduler.SyncWithGateway(uint32(i*1000), now, now)
+		}
+		_, err := scheduler.ScheduleAnytime(ctx, 10, settingsAt(868100000, 7, nil, uint32(i*1000+1000000)), ttnpb.TxSchedulePriority_HIGHEST)
+		a.So(err, should.BeNil)
+		time.Sleep(100 * time.Microsecond)
+	}
+}
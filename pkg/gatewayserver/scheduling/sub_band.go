@@ -22,6 +22,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/pkg/band"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -47,10 +48,21 @@ var DefaultDutyCycleCeilings DutyCycleCeilings = map[ttnpb.TxSchedulePriority]fl
 // SubBand tracks the utilization and controls the duty-cycle of a sub-band.
 type SubBand struct {
 	band.SubBandParameters
+	ctx       context.Context
 	mu        sync.RWMutex
 	clock     Clock
 	ceilings  DutyCycleCeilings
 	emissions Emissions
+
+	// store is an optional store of emissions. When set, emissions of other schedulers of the same gateway are
+	// loaded in foreign, and emissions scheduled in this sub-band are added with origin.
+	// The clock is shared with the scheduler and guarded by clockMu.
+	store     EmissionStore
+	clockMu   sync.Locker
+	origin    string
+	foreign   Emissions
+	loadCh    chan struct{}
+	persistCh chan StoredEmission
 }
 
 // NewSubBand returns a new SubBand for the given band's duty-cycle, clock and optionally duty-cycle ceilings.
@@ -60,6 +72,7 @@ func NewSubBand(ctx context.Context, band band.SubBandParameters, clock Clock, c
 	}
 	sb := &SubBand{
 		SubBandParameters: band,
+		ctx:               ctx,
 		clock:             clock,
 		ceilings:          ceilings,
 	}
@@ -91,13 +104,123 @@ func (sb *SubBand) gc(ctx context.Context) error {
 	}
 }
 
-// sum returns the total emission durations in the given window.
+// key returns the key of the sub-band in the emission store.
+func (sb *SubBand) key() string {
+	return fmt.Sprintf("%d-%d", sb.MinFrequency, sb.MaxFrequency)
+}
+
+// emissionBufferSize is the number of emissions that are buffered to be added to the store.
+const emissionBufferSize = 16
+
+// useStore sets the emission store and starts synchronizing with the store at the given interval.
+// If the interval is zero, the emissions of other schedulers are only loaded when requested.
+// The emissions in memory are authoritative; the store is accessed in the background so that scheduling does not
+// depend on the latency of the store.
+// The given lock guards the clock of the sub-band; it is acquired when loading emissions.
+func (sb *SubBand) useStore(ctx context.Context, store EmissionStore, origin string, interval time.Duration, clockMu sync.Locker) {
+	sb.store = store
+	sb.clockMu = clockMu
+	sb.origin = origin
+	sb.loadCh = make(chan struct{}, 1)
+	sb.persistCh = make(chan StoredEmission, emissionBufferSize)
+	go sb.sync(ctx, interval)
+}
+
+func (sb *SubBand) sync(ctx context.Context, interval time.Duration) error {
+	var tickCh <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tickCh = ticker.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case em := <-sb.persistCh:
+			if err := sb.store.AddEmission(ctx, sb.key(), em); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to store emission")
+			}
+		case <-sb.loadCh:
+			sb.load(ctx)
+		case <-tickCh:
+			sb.load(ctx)
+		}
+	}
+}
+
+// requestLoad requests the emissions of other schedulers to be loaded from the store.
+func (sb *SubBand) requestLoad() {
+	if sb.store == nil {
+		return
+	}
+	select {
+	case sb.loadCh <- struct{}{}:
+	default:
+	}
+}
+
+// load loads the emissions of other schedulers from the store.
+// If loading fails, the previously loaded emissions are kept.
+// This method must not be called with the lock or the clock lock held.
+func (sb *SubBand) load(ctx context.Context) {
+	sb.clockMu.Lock()
+	synced := sb.clock.IsSynced()
+	sb.clockMu.Unlock()
+	if !synced {
+		return
+	}
+	now := time.Now()
+	stored, err := sb.store.FindEmissions(ctx, sb.key(), now.Add(-DutyCycleWindow))
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to load emissions")
+		return
+	}
+	sb.clockMu.Lock()
+	defer sb.clockMu.Unlock()
+	offset := sb.clock.ServerTime(now) - ConcentratorTime(now.UnixNano())
+	foreign := make(Emissions, 0, len(stored))
+	for _, em := range stored {
+		if em.Origin == sb.origin {
+			continue
+		}
+		foreign = foreign.Insert(NewEmission(ConcentratorTime(em.Starts.UnixNano())+offset, em.Duration))
+	}
+	sb.mu.Lock()
+	sb.foreign = foreign
+	sb.mu.Unlock()
+}
+
+// persist queues the given emission to be added to the store.
+// If the buffer is full, the emission is not stored.
+// This method must be called with the clock lock held.
+func (sb *SubBand) persist(em Emission) {
+	if sb.store == nil || !sb.clock.IsSynced() {
+		return
+	}
+	now := time.Now()
+	stored := StoredEmission{
+		Origin:   sb.origin,
+		Starts:   now.Add(time.Duration(em.t - sb.clock.ServerTime(now))),
+		Duration: em.d,
+	}
+	select {
+	case sb.persistCh <- stored:
+	default:
+		log.FromContext(sb.ctx).Warn("Emission buffer full, not storing emission")
+	}
+}
+
+// sum returns the total emission durations in the given window, including the emissions of other schedulers.
 // This method requires the read lock to be held.
 func (sb *SubBand) sum(from, to ConcentratorTime) time.Duration {
 	total := time.Duration(0)
 	for _, em := range sb.emissions {
 		total += em.Within(from, to)
 	}
+	for _, em := range sb.foreign {
+		total += em.Within(from, to)
+	}
 	return total
 }
 
@@ -142,6 +265,7 @@ func (sb *SubBand) Schedule(em Emission, p ttnpb.TxSchedulePriority) error {
 		}
 	}
 	sb.emissions = sb.emissions.Insert(em)
+	sb.persist(em)
 	return nil
 }
 
@@ -177,8 +301,16 @@ func (sb *SubBand) ScheduleAnytime(d time.Duration, next func() ConcentratorTime
 				continue
 			}
 			// The caller has no later option; find the last emission after which we consider the duty-cycle window.
-			for i := len(sb.emissions) - 1; i >= 0; i-- {
-				other := sb.emissions[i]
+			all := sb.emissions
+			if len(sb.foreign) > 0 {
+				all = make(Emissions, len(sb.emissions), len(sb.emissions)+len(sb.foreign))
+				copy(all, sb.emissions)
+				for _, other := range sb.foreign {
+					all = all.Insert(other)
+				}
+			}
+			for i := len(all) - 1; i >= 0; i-- {
+				other := all[i]
 				used += float32(other.d) / float32(DutyCycleWindow)
 				if used > usable {
 					em.t = other.Ends() + ConcentratorTime(DutyCycleWindow) - ConcentratorTime(em.d)
@@ -189,5 +321,6 @@ func (sb *SubBand) ScheduleAnytime(d time.Duration, next func() ConcentratorTime
 		}
 	}
 	sb.emissions = sb.emissions.Insert(em)
+	sb.persist(em)
 	return em, nil
 }
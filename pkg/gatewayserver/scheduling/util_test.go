@@ -15,9 +15,12 @@
 package scheduling_test
 
 import (
+	"context"
+	"sync"
 	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
 type mockClock struct {
@@ -41,6 +44,52 @@ func boolPtr(v bool) *bool                       { return &v }
 func durationPtr(d time.Duration) *time.Duration { return &d }
 func timePtr(t time.Time) *time.Time             { return &t }
 
+// settingsAt returns LoRa Tx settings at the given frequency, spreading factor, time and timestamp.
+func settingsAt(frequency uint64, sf uint32, t *time.Time, timestamp uint32) ttnpb.TxSettings {
+	return ttnpb.TxSettings{
+		DataRate: ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_LoRa{
+				LoRa: &ttnpb.LoRaDataRate{
+					Bandwidth:       125000,
+					SpreadingFactor: sf,
+				},
+			},
+		},
+		CodingRate: "4/5",
+		Frequency:  frequency,
+		Time:       t,
+		Timestamp:  timestamp,
+	}
+}
+
 func init() {
 	scheduling.DutyCycleWindow = 10 * time.Second
+	scheduling.UtilizationInterval = 100 * time.Millisecond
+}
+
+type memoryEmissionStore struct {
+	mu        sync.Mutex
+	emissions map[string][]scheduling.StoredEmission
+}
+
+func (s *memoryEmissionStore) AddEmission(ctx context.Context, subBand string, em scheduling.StoredEmission) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.emissions == nil {
+		s.emissions = make(map[string][]scheduling.StoredEmission)
+	}
+	s.emissions[subBand] = append(s.emissions[subBand], em)
+	return nil
+}
+
+func (s *memoryEmissionStore) FindEmissions(ctx context.Context, subBand string, from time.Time) ([]scheduling.StoredEmission, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var res []scheduling.StoredEmission
+	for _, em := range s.emissions[subBand] {
+		if em.Starts.Add(em.Duration).After(from) {
+			res = append(res, em)
+		}
+	}
+	return res, nil
 }
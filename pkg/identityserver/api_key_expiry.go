@@ -0,0 +1,100 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/emails"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// apiKeyExpiryInterval is the interval in which API keys are checked for upcoming expiry.
+const apiKeyExpiryInterval = time.Hour
+
+// notifyExpiringAPIKeys notifies the entities of API keys that expire within
+// the given notice period, with an event and an email to the user or to the
+// email contacts of the entity. Each entity is notified once per API key,
+// unless the expiry of the API key is changed.
+func (is *IdentityServer) notifyExpiringAPIKeys(ctx context.Context, expiryNotice time.Duration) error {
+	logger := log.FromContext(ctx)
+	var expiring []*store.ExpiringAPIKey
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		expiring, err = store.GetAPIKeyStore(db).FindExpiringAPIKeys(ctx, time.Now().Add(expiryNotice))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range expiring {
+		var evt events.Event
+		switch ids := key.EntityIdentifiers.Identifiers().(type) {
+		case *ttnpb.ApplicationIdentifiers:
+			evt = evtExpiringApplicationAPIKey(ctx, ids, key.APIKey)
+		case *ttnpb.GatewayIdentifiers:
+			evt = evtExpiringGatewayAPIKey(ctx, ids, key.APIKey)
+		case *ttnpb.OrganizationIdentifiers:
+			evt = evtExpiringOrganizationAPIKey(ctx, ids, key.APIKey)
+		case *ttnpb.UserIdentifiers:
+			evt = evtExpiringUserAPIKey(ctx, ids, key.APIKey)
+		}
+		var recipients []emailRecipient
+		err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+			recipients, err = emailRecipients(ctx, db, key.EntityIdentifiers)
+			if err != nil {
+				return err
+			}
+			return store.GetAPIKeyStore(db).SetAPIKeyExpiryNotified(ctx, key.APIKey.ID, time.Now())
+		})
+		if err != nil {
+			logger.WithError(err).WithField("api_key_id", key.APIKey.ID).Warn("Failed to mark API key expiry as notified")
+			continue
+		}
+		if evt != nil {
+			events.Publish(evt)
+		}
+		for _, recipient := range recipients {
+			is.sendEmail(ctx, emails.APIKeyExpiring{
+				Data:       is.emailData(ctx, recipient.userID, recipient.name, recipient.address),
+				EntityType: entityTypeName(key.EntityIdentifiers),
+				EntityID:   key.EntityIdentifiers.IDString(),
+				KeyID:      key.APIKey.ID,
+				KeyName:    key.APIKey.Name,
+				ExpiresAt:  *key.APIKey.ExpiresAt,
+			})
+		}
+	}
+	return nil
+}
+
+func (is *IdentityServer) notifyExpiringAPIKeysTask(expiryNotice time.Duration) func(context.Context) error {
+	return func(ctx context.Context) error {
+		for {
+			if err := is.notifyExpiringAPIKeys(ctx, expiryNotice); err != nil {
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(apiKeyExpiryInterval):
+			}
+		}
+	}
+}
@@ -16,12 +16,21 @@ package identityserver
 
 import (
 	"context"
+	"net"
+	"strings"
+	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
-func generateAPIKey(ctx context.Context, name string, rights ...ttnpb.Right) (key *ttnpb.APIKey, token string, err error) {
+var (
+	errAPIKeyExpiryInPast = errors.DefineInvalidArgument("api_key_expiry_in_past", "API key expiry `{expires_at}` is in the past")
+	errInvalidAllowedIP   = errors.DefineInvalidArgument("invalid_allowed_ip", "invalid allowed IP address or CIDR range `{allowed_ip}`")
+)
+
+func generateAPIKey(ctx context.Context, name string, expiresAt *time.Time, allowedIPs []string, rights ...ttnpb.Right) (key *ttnpb.APIKey, token string, err error) {
 	token, err = auth.APIKey.Generate(ctx, "")
 	if err != nil {
 		return nil, "", err
@@ -35,10 +44,94 @@ func generateAPIKey(ctx context.Context, name string, rights ...ttnpb.Right) (ke
 		return nil, "", err
 	}
 	key = &ttnpb.APIKey{
-		ID:     generatedID,
-		Key:    string(hashedKey),
-		Name:   name,
-		Rights: rights,
+		ID:         generatedID,
+		Key:        string(hashedKey),
+		Name:       name,
+		Rights:     rights,
+		ExpiresAt:  expiresAt,
+		AllowedIPs: allowedIPs,
 	}
 	return key, token, nil
 }
+
+// parseAllowedIP parses an allowed IP of an API key, which is either a CIDR range
+// or a single IP address.
+func parseAllowedIP(allowedIP string) (*net.IPNet, error) {
+	if !strings.Contains(allowedIP, "/") {
+		ip := net.ParseIP(allowedIP)
+		if ip == nil {
+			return nil, errInvalidAllowedIP.WithAttributes("allowed_ip", allowedIP)
+		}
+		bits := 8 * net.IPv6len
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 8*net.IPv4len
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, ipNet, err := net.ParseCIDR(allowedIP)
+	if err != nil {
+		return nil, errInvalidAllowedIP.WithCause(err).WithAttributes("allowed_ip", allowedIP)
+	}
+	return ipNet, nil
+}
+
+// validateAPIKeyRestrictions validates the expiry and allowed IPs of the API key.
+func validateAPIKeyRestrictions(expiresAt *time.Time, allowedIPs []string) error {
+	if expiresAt != nil && expiresAt.Before(time.Now()) {
+		return errAPIKeyExpiryInPast.WithAttributes("expires_at", expiresAt.Format(time.RFC3339))
+	}
+	for _, allowedIP := range allowedIPs {
+		if _, err := parseAllowedIP(allowedIP); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// apiKeyUpdatePaths returns the paths of an API key update. If no paths are given,
+// the name and rights of the API key are updated.
+func apiKeyUpdatePaths(paths []string) []string {
+	if len(paths) == 0 {
+		return []string{"name", "rights"}
+	}
+	return paths
+}
+
+// isAPIKeyDeletion returns whether the API key update deletes the API key.
+func isAPIKeyDeletion(key *ttnpb.APIKey, paths []string) bool {
+	return ttnpb.HasAnyField(paths, "rights") && len(key.Rights) == 0
+}
+
+// validateAPIKeyUpdate validates the expiry and allowed IPs of the API key if they are updated.
+func validateAPIKeyUpdate(key *ttnpb.APIKey, paths []string) error {
+	var expiresAt *time.Time
+	if ttnpb.HasAnyField(paths, "expires_at") {
+		expiresAt = key.ExpiresAt
+	}
+	var allowedIPs []string
+	if ttnpb.HasAnyField(paths, "allowed_ips") {
+		allowedIPs = key.AllowedIPs
+	}
+	return validateAPIKeyRestrictions(expiresAt, allowedIPs)
+}
+
+// apiKeyAllowsIP returns whether the API key may be used from the given remote IP.
+func apiKeyAllowsIP(key *ttnpb.APIKey, remoteIP string) bool {
+	if len(key.AllowedIPs) == 0 {
+		return true
+	}
+	ip := net.ParseIP(remoteIP)
+	if ip == nil {
+		return false
+	}
+	for _, allowedIP := range key.AllowedIPs {
+		ipNet, err := parseAllowedIP(allowedIP)
+		if err != nil {
+			continue
+		}
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
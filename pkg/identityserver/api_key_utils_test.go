@@ -0,0 +1,60 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestValidateAPIKeyRestrictions(t *testing.T) {
+	a := assertions.New(t)
+
+	past, future := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	a.So(validateAPIKeyRestrictions(nil, nil), should.BeNil)
+	a.So(validateAPIKeyRestrictions(&future, []string{"10.0.0.0/8", "192.168.1.1", "2001:db8::/32"}), should.BeNil)
+	a.So(errors.IsInvalidArgument(validateAPIKeyRestrictions(&past, nil)), should.BeTrue)
+	a.So(errors.IsInvalidArgument(validateAPIKeyRestrictions(nil, []string{"10.0.0.0/33"})), should.BeTrue)
+	a.So(errors.IsInvalidArgument(validateAPIKeyRestrictions(nil, []string{"localhost"})), should.BeTrue)
+}
+
+func TestAPIKeyAllowsIP(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(apiKeyAllowsIP(&ttnpb.APIKey{}, "10.0.0.1"), should.BeTrue)
+
+	key := &ttnpb.APIKey{AllowedIPs: []string{"10.0.0.0/8", "192.168.1.1", "2001:db8::/32"}}
+	for ip, allowed := range map[string]bool{
+		"10.1.2.3":       true,
+		"192.168.1.1":    true,
+		"192.168.1.2":    false,
+		"2001:db8::1":    true,
+		"2001:db9::1":    false,
+		"::ffff:a00:1":   true,
+		"not-an-ip":      false,
+		"":               false,
+		"172.16.0.1":     false,
+		"::1":            false,
+		"127.0.0.1":      false,
+		"10.255.255.255": true,
+	} {
+		a.So(apiKeyAllowsIP(key, ip), should.Equal, allowed)
+	}
+}
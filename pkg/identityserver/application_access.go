@@ -30,6 +30,7 @@ var (
 	evtCreateApplicationAPIKey       = events.Define("application.api-key.create", "Create application API key")
 	evtUpdateApplicationAPIKey       = events.Define("application.api-key.update", "Update application API key")
 	evtDeleteApplicationAPIKey       = events.Define("application.api-key.delete", "Delete application API key")
+	evtExpiringApplicationAPIKey     = events.Define("application.api-key.expiring", "Application API key expiring")
 	evtUpdateApplicationCollaborator = events.Define("application.collaborator.update", "Update application collaborator")
 	evtDeleteApplicationCollaborator = events.Define("application.collaborator.delete", "Delete application collaborator")
 )
@@ -55,7 +56,10 @@ func (is *IdentityServer) createApplicationAPIKey(ctx context.Context, req *ttnp
 	if err = rights.RequireApplication(ctx, req.ApplicationIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
-	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
+	if err = validateAPIKeyRestrictions(req.ExpiresAt, req.AllowedIPs); err != nil {
+		return nil, err
+	}
+	key, token, err := generateAPIKey(ctx, req.Name, req.ExpiresAt, req.AllowedIPs, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
@@ -98,8 +102,15 @@ func (is *IdentityServer) updateApplicationAPIKey(ctx context.Context, req *ttnp
 	if err = rights.RequireApplication(ctx, req.ApplicationIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
+	req.FieldMask.Paths = apiKeyUpdatePaths(req.FieldMask.Paths)
+	deleteKey := isAPIKeyDeletion(&req.APIKey, req.FieldMask.Paths)
+	if !deleteKey {
+		if err = validateAPIKeyUpdate(&req.APIKey, req.FieldMask.Paths); err != nil {
+			return nil, err
+		}
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.ApplicationIdentifiers.EntityIdentifiers(), &req.APIKey)
+		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.ApplicationIdentifiers.EntityIdentifiers(), &req.APIKey, &req.FieldMask)
 		return err
 	})
 	if err != nil {
@@ -109,7 +120,7 @@ func (is *IdentityServer) updateApplicationAPIKey(ctx context.Context, req *ttnp
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	if len(req.Rights) > 0 {
+	if !deleteKey {
 		events.Publish(evtUpdateApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil))
 		// TODO: Send notification email (https://github.com/TheThingsNetwork/lorawan-stack/issues/72).
 	} else {
@@ -20,6 +20,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/blacklist"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
@@ -28,9 +29,11 @@ import (
 )
 
 var (
-	evtCreateApplication = events.Define("application.create", "Create application")
-	evtUpdateApplication = events.Define("application.update", "Update application")
-	evtDeleteApplication = events.Define("application.delete", "Delete application")
+	evtCreateApplication  = events.Define("application.create", "Create application")
+	evtUpdateApplication  = events.Define("application.update", "Update application")
+	evtDeleteApplication  = events.Define("application.delete", "Delete application")
+	evtRestoreApplication = events.Define("application.restore", "Restore application")
+	evtPurgeApplication   = events.Define("application.purge", "Purge application")
 )
 
 func (is *IdentityServer) createApplication(ctx context.Context, req *ttnpb.CreateApplicationRequest) (app *ttnpb.Application, err error) {
@@ -49,6 +52,9 @@ func (is *IdentityServer) createApplication(ctx context.Context, req *ttnpb.Crea
 	if err := validateContactInfo(req.Application.ContactInfo); err != nil {
 		return nil, err
 	}
+	if !is.UniversalRights(ctx).IncludesAll(ttnpb.RIGHT_ALL) {
+		req.Application.State = ttnpb.STATE_APPROVED
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		app, err = store.GetApplicationStore(db).CreateApplication(ctx, &req.Application)
 		if err != nil {
@@ -182,6 +188,10 @@ func (is *IdentityServer) listApplications(ctx context.Context, req *ttnpb.ListA
 	return apps, nil
 }
 
+var (
+	errUpdateApplicationAdminField = errors.DefinePermissionDenied("application_update_admin_field", "only admins can update the `{field}` field")
+)
+
 func (is *IdentityServer) updateApplication(ctx context.Context, req *ttnpb.UpdateApplicationRequest) (app *ttnpb.Application, err error) {
 	if err = rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
 		return nil, err
@@ -189,6 +199,9 @@ func (is *IdentityServer) updateApplication(ctx context.Context, req *ttnpb.Upda
 	if err := validateContactInfo(req.Application.ContactInfo); err != nil {
 		return nil, err
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "state") && !is.UniversalRights(ctx).IncludesAll(ttnpb.RIGHT_ALL) {
+		return nil, errUpdateApplicationAdminField.WithAttributes("field", "state")
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		app, err = store.GetApplicationStore(db).UpdateApplication(ctx, &req.Application, &req.FieldMask)
 		if err != nil {
@@ -214,6 +227,7 @@ func (is *IdentityServer) deleteApplication(ctx context.Context, ids *ttnpb.Appl
 	if err := rights.RequireApplication(ctx, *ids, ttnpb.RIGHT_APPLICATION_DELETE); err != nil {
 		return nil, err
 	}
+	// The end devices are kept until the application is purged, so that they are restored with the application.
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
 		return store.GetApplicationStore(db).DeleteApplication(ctx, ids)
 	})
@@ -224,6 +238,37 @@ func (is *IdentityServer) deleteApplication(ctx context.Context, ids *ttnpb.Appl
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) restoreApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	if err := rights.RequireApplication(ctx, *ids, ttnpb.RIGHT_APPLICATION_DELETE); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetApplicationStore(db).RestoreApplication(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtRestoreApplication(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	if err := is.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var devIDs []ttnpb.EndDeviceIdentifiers
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		devIDs, err = purgeApplication(ctx, db, ids)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtPurgeApplication(ctx, ids, nil))
+	is.deleteEndDevicesFromCluster(ctx, devIDs...)
+	return ttnpb.Empty, nil
+}
+
 type applicationRegistry struct {
 	*IdentityServer
 }
@@ -243,3 +288,9 @@ func (ar *applicationRegistry) Update(ctx context.Context, req *ttnpb.UpdateAppl
 func (ar *applicationRegistry) Delete(ctx context.Context, req *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
 	return ar.deleteApplication(ctx, req)
 }
+func (ar *applicationRegistry) Restore(ctx context.Context, req *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	return ar.restoreApplication(ctx, req)
+}
+func (ar *applicationRegistry) Purge(ctx context.Context, req *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	return ar.purgeApplication(ctx, req)
+}
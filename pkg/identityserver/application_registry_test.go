@@ -192,6 +192,17 @@ func TestApplicationsCRUD(t *testing.T) {
 		_, err = reg.Delete(ctx, &created.ApplicationIdentifiers, creds)
 		a.So(err, should.BeNil)
 
+		_, err = reg.Restore(ctx, &created.ApplicationIdentifiers, creds)
+		a.So(err, should.BeNil)
+
+		_, err = reg.Purge(ctx, &created.ApplicationIdentifiers, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.Purge(ctx, &created.ApplicationIdentifiers, userCreds(adminUserIdx))
+		a.So(err, should.BeNil)
+
 	})
 }
 
@@ -0,0 +1,121 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/email"
+	"go.thethings.network/lorawan-stack/pkg/email/sendgrid"
+	"go.thethings.network/lorawan-stack/pkg/email/smtp"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/emails"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var errEmailProvider = errors.DefineInvalidArgument("email_provider", "unknown email provider `{provider}`")
+
+func (is *IdentityServer) initEmail() (err error) {
+	config := is.config.Email
+	switch config.Provider {
+	case "":
+		return nil
+	case "sendgrid":
+		is.emailSender, err = sendgrid.New(is.Logger(), config.Config, config.SendGrid)
+	case "smtp":
+		is.emailSender, err = smtp.New(is.Context(), config.Config, config.SMTP)
+	default:
+		return errEmailProvider.WithAttributes("provider", config.Provider)
+	}
+	if err != nil {
+		return err
+	}
+	is.emailTemplates = email.NewTemplateRegistry(nil)
+	return nil
+}
+
+// emailData returns the data for emails to the given recipient.
+func (is *IdentityServer) emailData(ctx context.Context, userID, name, address string) emails.Data {
+	var data emails.Data
+	network := is.configFromContext(ctx).Email.Network
+	data.Network.Name = network.Name
+	data.Network.IdentityServerURL = network.IdentityServerURL
+	data.Network.ConsoleURL = network.ConsoleURL
+	data.User.ID, data.User.Name, data.User.Email = userID, name, address
+	return data
+}
+
+// sendEmail renders and sends the email. Emails are not sent if no email
+// provider is configured. Failures are logged, but not returned, as emails
+// are notifications of operations that have already completed.
+func (is *IdentityServer) sendEmail(ctx context.Context, data email.MessageData) {
+	if is.emailSender == nil {
+		return
+	}
+	_, address := data.Recipient()
+	if address == "" {
+		return
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"template_name", data.TemplateName(),
+		"recipient", address,
+	))
+	message, err := is.emailTemplates.Render(data)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to render email")
+		return
+	}
+	if err = is.emailSender.Send(message); err != nil {
+		logger.WithError(err).Warn("Failed to send email")
+		return
+	}
+	logger.Debug("Sent email")
+}
+
+// emailRecipient is the recipient of an email notification.
+type emailRecipient struct {
+	userID, name, address string
+}
+
+// emailRecipients returns the recipients of notifications about the given
+// entity. Users are notified on their primary email address, other entities
+// on their email contact info.
+func emailRecipients(ctx context.Context, db *gorm.DB, entityID *ttnpb.EntityIdentifiers) ([]emailRecipient, error) {
+	if usrIDs := entityID.GetUserIDs(); usrIDs != nil {
+		usr, err := store.GetUserStore(db).GetUser(ctx, usrIDs, &types.FieldMask{Paths: []string{"name", "primary_email_address"}})
+		if err != nil {
+			return nil, err
+		}
+		return []emailRecipient{{userID: usr.UserID, name: usr.Name, address: usr.PrimaryEmailAddress}}, nil
+	}
+	contactInfo, err := store.GetContactInfoStore(db).GetContactInfo(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+	var recipients []emailRecipient
+	seen := make(map[string]bool)
+	for _, info := range contactInfo {
+		if info.ContactMethod != ttnpb.CONTACT_METHOD_EMAIL || seen[info.Value] {
+			continue
+		}
+		seen[info.Value] = true
+		recipients = append(recipients, emailRecipient{address: info.Value})
+	}
+	return recipients, nil
+}
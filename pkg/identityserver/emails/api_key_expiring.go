@@ -0,0 +1,48 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emails
+
+import "time"
+
+// APIKeyExpiring is the email that is sent when an API key is about to expire.
+type APIKeyExpiring struct {
+	Data
+	EntityType string
+	EntityID   string
+	KeyID      string
+	KeyName    string
+	ExpiresAt  time.Time
+}
+
+// TemplateName returns the name of the template to use for this email.
+func (APIKeyExpiring) TemplateName() string { return "api_key_expiring" }
+
+const apiKeyExpiringSubject = `An API key of your {{.EntityType}} {{.EntityID}} is about to expire`
+
+const apiKeyExpiringHTML = `<p>Dear {{with .User.Name}}{{.}}{{else}}{{with .User.ID}}{{.}}{{else}}{{$.User.Email}}{{end}}{{end}},</p>
+<p>The API key <code>{{.KeyID}}</code>{{with .KeyName}} ({{.}}){{end}} of your {{.EntityType}} <code>{{.EntityID}}</code> on {{.Network.Name}} expires at <b>{{.ExpiresAt.Format "2006-01-02 15:04:05 MST"}}</b>.</p>
+<p>After that, requests with this API key will be refused. You can go to <a href="{{.Network.ConsoleURL}}">the Console</a> to create a new API key or to change the expiry of this API key.</p>`
+
+const apiKeyExpiringText = `Dear {{with .User.Name}}{{.}}{{else}}{{with .User.ID}}{{.}}{{else}}{{$.User.Email}}{{end}}{{end}},
+
+The API key {{.KeyID}}{{with .KeyName}} ({{.}}){{end}} of your {{.EntityType}} {{.EntityID}} on {{.Network.Name}} expires at {{.ExpiresAt.Format "2006-01-02 15:04:05 MST"}}.
+
+After that, requests with this API key will be refused. You can go to the Console at {{.Network.ConsoleURL}} to create a new API key or to change the expiry of this API key.
+`
+
+// DefaultTemplates returns the default templates for this email.
+func (APIKeyExpiring) DefaultTemplates() (subject, html, text string) {
+	return apiKeyExpiringSubject, apiKeyExpiringHTML, apiKeyExpiringText
+}
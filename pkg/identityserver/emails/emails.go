@@ -0,0 +1,33 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package emails contains the emails that are sent by the Identity Server.
+package emails
+
+// Data for the emails of the Identity Server.
+type Data struct {
+	Network struct {
+		Name              string
+		IdentityServerURL string
+		ConsoleURL        string
+	}
+	User struct {
+		ID    string
+		Name  string
+		Email string
+	}
+}
+
+// Recipient implements email.MessageData.
+func (d Data) Recipient() (name, address string) { return d.User.Name, d.User.Email }
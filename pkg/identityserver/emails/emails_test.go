@@ -0,0 +1,83 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emails_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/email"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/emails"
+)
+
+func TestEntityReviewed(t *testing.T) {
+	a := assertions.New(t)
+
+	data := emails.EntityReviewed{
+		EntityType: "application",
+		EntityID:   "foo-app",
+		State:      "suspended",
+		Reason:     "Abuse of the network",
+	}
+	data.Network.Name = "The Things Network"
+	data.Network.ConsoleURL = "https://console.example.com"
+	data.User.ID = "foo-usr"
+	data.User.Email = "foo@example.com"
+
+	message, err := email.NewTemplateRegistry(nil).Render(data)
+	a.So(err, should.BeNil)
+	if !a.So(message, should.NotBeNil) {
+		t.FailNow()
+	}
+
+	a.So(message.TemplateName, should.Equal, "entity_reviewed")
+	a.So(message.RecipientAddress, should.Equal, "foo@example.com")
+	a.So(message.Subject, should.Equal, "Your application foo-app has been reviewed")
+	a.So(message.HTMLBody, should.ContainSubstring, "Dear foo-usr")
+	a.So(message.HTMLBody, should.ContainSubstring, "<b>suspended</b>")
+	a.So(message.TextBody, should.ContainSubstring, "Reason: Abuse of the network")
+	a.So(message.TextBody, should.ContainSubstring, "https://console.example.com")
+}
+
+func TestAPIKeyExpiring(t *testing.T) {
+	a := assertions.New(t)
+
+	data := emails.APIKeyExpiring{
+		EntityType: "gateway",
+		EntityID:   "foo-gtw",
+		KeyID:      "FOOKEYID",
+		KeyName:    "Foo key",
+		ExpiresAt:  time.Date(2019, time.March, 1, 12, 0, 0, 0, time.UTC),
+	}
+	data.Network.Name = "The Things Network"
+	data.Network.ConsoleURL = "https://console.example.com"
+	data.User.Email = "foo@example.com"
+
+	message, err := email.NewTemplateRegistry(nil).Render(data)
+	a.So(err, should.BeNil)
+	if !a.So(message, should.NotBeNil) {
+		t.FailNow()
+	}
+
+	a.So(message.TemplateName, should.Equal, "api_key_expiring")
+	a.So(message.RecipientAddress, should.Equal, "foo@example.com")
+	a.So(message.Subject, should.Equal, "An API key of your gateway foo-gtw is about to expire")
+	a.So(message.HTMLBody, should.ContainSubstring, "Dear foo@example.com")
+	a.So(message.HTMLBody, should.ContainSubstring, "<code>FOOKEYID</code> (Foo key)")
+	a.So(message.TextBody, should.ContainSubstring, "expires at 2019-03-01 12:00:00 UTC")
+	a.So(message.TextBody, should.ContainSubstring, "https://console.example.com")
+}
@@ -0,0 +1,48 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emails
+
+// EntityReviewed is the email that is sent when an admin reviews an entity.
+type EntityReviewed struct {
+	Data
+	EntityType string
+	EntityID   string
+	State      string
+	Reason     string
+}
+
+// TemplateName returns the name of the template to use for this email.
+func (EntityReviewed) TemplateName() string { return "entity_reviewed" }
+
+const entityReviewedSubject = `Your {{.EntityType}} {{.EntityID}} has been reviewed`
+
+const entityReviewedHTML = `<p>Dear {{with .User.Name}}{{.}}{{else}}{{.User.ID}}{{end}},</p>
+<p>An administrator of {{.Network.Name}} has reviewed your {{.EntityType}} <code>{{.EntityID}}</code>. Its state is now <b>{{.State}}</b>.</p>
+{{with .Reason}}<p>Reason: {{.}}</p>{{end}}
+<p>You can go to <a href="{{.Network.ConsoleURL}}">the Console</a> to see your {{.EntityType}}.</p>`
+
+const entityReviewedText = `Dear {{with .User.Name}}{{.}}{{else}}{{.User.ID}}{{end}},
+
+An administrator of {{.Network.Name}} has reviewed your {{.EntityType}} {{.EntityID}}. Its state is now {{.State}}.
+{{with .Reason}}
+Reason: {{.}}
+{{end}}
+You can go to the Console at {{.Network.ConsoleURL}} to see your {{.EntityType}}.
+`
+
+// DefaultTemplates returns the default templates for this email.
+func (EntityReviewed) DefaultTemplates() (subject, html, text string) {
+	return entityReviewedSubject, entityReviewedHTML, entityReviewedText
+}
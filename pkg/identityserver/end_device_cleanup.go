@@ -0,0 +1,117 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// clusterCleanupAttempts is the number of attempts to delete end devices from the cluster.
+// End devices that can not be deleted after this, are left to the reconciliation
+// of the Network Server, Application Server and Join Server.
+const clusterCleanupAttempts = 10
+
+var clusterCleanupBackoff = []time.Duration{
+	time.Second,
+	5 * time.Second,
+	30 * time.Second,
+	time.Minute,
+	5 * time.Minute,
+}
+
+// purgeApplication purges the application and its end devices from the database,
+// and returns the identifiers of the end devices, so that they can be deleted from the cluster.
+func purgeApplication(ctx context.Context, db *gorm.DB, ids *ttnpb.ApplicationIdentifiers) ([]ttnpb.EndDeviceIdentifiers, error) {
+	devs, err := store.GetEndDeviceStore(db).ListEndDevices(ctx, ids, &types.FieldMask{Paths: []string{"ids"}})
+	if err != nil {
+		return nil, err
+	}
+	if err := store.GetApplicationStore(db).PurgeApplication(ctx, ids); err != nil {
+		return nil, err
+	}
+	devIDs := make([]ttnpb.EndDeviceIdentifiers, len(devs))
+	for i, dev := range devs {
+		devIDs[i] = dev.EndDeviceIdentifiers
+	}
+	return devIDs, nil
+}
+
+// deleteEndDeviceFromCluster deletes the end device from the Application Server,
+// Network Server and Join Server in the cluster. End devices that are not found
+// are considered deleted.
+func (is *IdentityServer) deleteEndDeviceFromCluster(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error {
+	if as := is.GetPeer(ctx, ttnpb.PeerInfo_APPLICATION_SERVER, ids); as != nil {
+		_, err := ttnpb.NewAsEndDeviceRegistryClient(as.Conn()).Delete(ctx, &ids, is.WithClusterAuth())
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	if ns := is.GetPeer(ctx, ttnpb.PeerInfo_NETWORK_SERVER, ids); ns != nil {
+		_, err := ttnpb.NewNsEndDeviceRegistryClient(ns.Conn()).Delete(ctx, &ids, is.WithClusterAuth())
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	if ids.JoinEUI != nil && ids.DevEUI != nil {
+		if js := is.GetPeer(ctx, ttnpb.PeerInfo_JOIN_SERVER, ids); js != nil {
+			_, err := ttnpb.NewJsEndDeviceRegistryClient(js.Conn()).Delete(ctx, &ids, is.WithClusterAuth())
+			if err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteEndDevicesFromCluster starts a task that deletes the end devices from
+// the cluster. Failed deletions are retried with backoff.
+func (is *IdentityServer) deleteEndDevicesFromCluster(ctx context.Context, ids ...ttnpb.EndDeviceIdentifiers) {
+	if len(ids) == 0 {
+		return
+	}
+	logger := log.FromContext(ctx)
+	pending, attempt := ids, 0
+	is.StartTask(is.Context(), "delete_end_devices_from_cluster", func(ctx context.Context) error {
+		attempt++
+		var failed []ttnpb.EndDeviceIdentifiers
+		var lastErr error
+		for _, devIDs := range pending {
+			if err := is.deleteEndDeviceFromCluster(ctx, devIDs); err != nil {
+				logger.WithError(err).WithField("device_uid", unique.ID(ctx, devIDs)).Debug("Failed to delete end device from cluster")
+				failed = append(failed, devIDs)
+				lastErr = err
+			}
+		}
+		pending = failed
+		if len(pending) == 0 {
+			return nil
+		}
+		if attempt >= clusterCleanupAttempts {
+			logger.WithError(lastErr).WithField("end_devices", len(pending)).Error("Failed to delete end devices from cluster")
+			return nil
+		}
+		return lastErr
+	}, component.TaskRestartOnFailure, 0.1, clusterCleanupBackoff...)
+}
@@ -111,13 +111,19 @@ func (is *IdentityServer) deleteEndDevice(ctx context.Context, ids *ttnpb.EndDev
 	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
-	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+	var dev *ttnpb.EndDevice
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		dev, err = store.GetEndDeviceStore(db).GetEndDevice(ctx, ids, &types.FieldMask{Paths: []string{"ids"}})
+		if err != nil {
+			return err
+		}
 		return store.GetEndDeviceStore(db).DeleteEndDevice(ctx, ids)
 	})
 	if err != nil {
 		return nil, err
 	}
 	events.Publish(evtDeleteEndDevice(ctx, ids, nil))
+	is.deleteEndDevicesFromCluster(ctx, dev.EndDeviceIdentifiers)
 	return ttnpb.Empty, nil
 }
 
@@ -26,6 +26,8 @@ import (
 	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ratelimit"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/warning"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -36,10 +38,17 @@ var (
 	errUnsupportedAuthorization = errors.DefineUnauthenticated("unsupported_authorization", "Unsupported authorization method")
 	errInvalidAuthorization     = errors.DefinePermissionDenied("invalid_authorization", "invalid authorization")
 	errTokenExpired             = errors.DefineUnauthenticated("token_expired", "access token expired")
+	errAPIKeyExpired            = errors.DefineUnauthenticated("api_key_expired", "API key expired")
+	errAPIKeyIPNotAllowed       = errors.DefinePermissionDenied("api_key_ip_not_allowed", "API key can not be used from IP address `{remote_ip}`")
 	errOAuthClientRejected      = errors.DefinePermissionDenied("oauth_client_rejected", "OAuth client was rejected")
 	errOAuthClientSuspended     = errors.DefinePermissionDenied("oauth_client_suspended", "OAuth client was suspended")
+	errAdminOnly                = errors.DefinePermissionDenied("admin_only", "only admins can perform this action")
 )
 
+// apiKeyLastUsedResolution is the resolution of the time at which API keys were last used.
+// This avoids writing to the database on every request.
+const apiKeyLastUsedResolution = time.Minute
+
 type requestAccessKeyType struct{}
 
 var requestAccessKey requestAccessKeyType
@@ -93,6 +102,7 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 	clientFieldMask := &types.FieldMask{Paths: []string{"state"}}
 	var user *ttnpb.User
 	var userRights *ttnpb.Rights
+	var usedAPIKeyID string
 
 	switch tokenType {
 	case auth.APIKey:
@@ -108,6 +118,16 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 			if !valid {
 				return errInvalidAuthorization
 			}
+			if apiKey.ExpiresAt != nil && apiKey.ExpiresAt.Before(time.Now()) {
+				return errAPIKeyExpired
+			}
+			if remoteIP := rpcmetadata.RemoteIP(ctx); !apiKeyAllowsIP(apiKey, remoteIP) {
+				return errAPIKeyIPNotAllowed.WithAttributes("remote_ip", remoteIP)
+			}
+			if apiKey.LastUsedAt == nil || time.Since(*apiKey.LastUsedAt) > apiKeyLastUsedResolution {
+				now := time.Now()
+				usedAPIKeyID, apiKey.LastUsedAt = apiKey.ID, &now
+			}
 			apiKey.Key = ""
 			apiKey.Rights = ttnpb.RightsFrom(apiKey.Rights...).Implied().GetRights()
 			res.AccessMethod = &ttnpb.AuthInfoResponse_APIKey{
@@ -179,7 +199,20 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 		return nil, err
 	}
 
+	if usedAPIKeyID != "" {
+		err := is.withDatabase(ctx, func(db *gorm.DB) error {
+			return store.GetAPIKeyStore(db).SetAPIKeyLastUsed(ctx, usedAPIKeyID, *res.GetAPIKey().LastUsedAt)
+		})
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to update time at which API key was last used")
+		}
+	}
+
 	if user != nil {
+		if err := ratelimit.Require(ctx, is.RateLimiter(), ratelimit.UserResource(user.UserID)); err != nil {
+			return nil, err
+		}
+
 		if user.Admin {
 			res.UniversalRights = ttnpb.AllRights.Implied().Intersect(userRights)
 		}
@@ -244,6 +277,14 @@ func (is *IdentityServer) UniversalRights(ctx context.Context) *ttnpb.Rights {
 	return nil
 }
 
+// requireAdmin returns an error if the caller does not have admin (all universal) rights.
+func (is *IdentityServer) requireAdmin(ctx context.Context) error {
+	if !is.UniversalRights(ctx).IncludesAll(ttnpb.RIGHT_ALL) {
+		return errAdminOnly
+	}
+	return nil
+}
+
 func restrictRights(info *ttnpb.AuthInfoResponse, rights *ttnpb.Rights) {
 	if apiKey := info.GetAPIKey(); apiKey != nil {
 		apiKey.Rights = ttnpb.RightsFrom(apiKey.Rights...).Intersect(rights).GetRights()
@@ -280,6 +321,16 @@ func (is *IdentityServer) entityRights(ctx context.Context, authInfo *ttnpb.Auth
 	}
 	entityRights := make(map[*ttnpb.EntityIdentifiers]*ttnpb.Rights)
 	entityRights[ids] = rights
+	if orgIDs := ids.GetOrganizationIDs(); orgIDs != nil {
+		// API keys of organizations that are not approved can not be used on the entities of the organization.
+		stateRights, err := is.organizationStateRights(ctx, *orgIDs)
+		if err != nil {
+			return nil, err
+		}
+		if stateRights != nil {
+			return entityRights, nil
+		}
+	}
 	memberRights, err := is.memberRights(ctx, ids)
 	if err != nil {
 		return nil, err
@@ -322,6 +373,16 @@ func (is *IdentityServer) memberRights(ctx context.Context, ids *ttnpb.EntityIde
 	entityRights = make(map[*ttnpb.EntityIdentifiers]*ttnpb.Rights)
 	for ids, rights := range memberships {
 		entityRights[ids] = rights
+		if orgIDs := ids.GetOrganizationIDs(); orgIDs != nil {
+			// Members of organizations that are not approved can not act on the entities of the organization.
+			stateRights, err := is.organizationStateRights(ctx, *orgIDs)
+			if err != nil {
+				return nil, err
+			}
+			if stateRights != nil {
+				continue
+			}
+		}
 		subMemberRights, err := is.memberRights(ctx, ids)
 		if err != nil {
 			return nil, err
@@ -18,8 +18,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/email"
+	"go.thethings.network/lorawan-stack/pkg/email/mock"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
@@ -88,6 +95,106 @@ func TestEntityAccess(t *testing.T) {
 			a.So(authInfo.GetUniversalRights().GetRights(), should.NotBeEmpty)
 		})
 
+		t.Run("Restricted API Key", func(t *testing.T) {
+			a := assertions.New(t)
+
+			userID, creds := population.Users[defaultUserIdx].UserIdentifiers, userCreds(defaultUserIdx)
+			reg := ttnpb.NewUserAccessClient(cc)
+
+			past := time.Now().Add(-time.Hour)
+			_, err := reg.CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+				UserIdentifiers: userID,
+				Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+				ExpiresAt:       &past,
+			}, creds)
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+			_, err = reg.CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+				UserIdentifiers: userID,
+				Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+				AllowedIPs:      []string{"not-an-ip"},
+			}, creds)
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+			future := time.Now().Add(time.Hour)
+			created, err := reg.CreateAPIKey(ctx, &ttnpb.CreateUserAPIKeyRequest{
+				UserIdentifiers: userID,
+				Rights:          []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+				ExpiresAt:       &future,
+				AllowedIPs:      []string{"10.0.0.0/8"},
+			}, creds)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			keyCreds := grpc.PerRPCCredentials(rpcmetadata.MD{
+				AuthType:      "bearer",
+				AuthValue:     created.Key,
+				AllowInsecure: true,
+			})
+
+			// The user is notified once about the upcoming expiry.
+			emailSender := mock.New()
+			is.emailSender, is.emailTemplates = emailSender, email.NewTemplateRegistry(nil)
+			defer func() {
+				is.emailSender, is.emailTemplates = nil, nil
+			}()
+			for i := 0; i < 2; i++ {
+				err = is.notifyExpiringAPIKeys(ctx, 2*time.Hour)
+				a.So(err, should.BeNil)
+			}
+			if a.So(emailSender.Messages, should.HaveLength, 1) {
+				a.So(emailSender.Messages[0].TemplateName, should.Equal, "api_key_expiring")
+				a.So(emailSender.Messages[0].RecipientAddress, should.Equal, population.Users[defaultUserIdx].PrimaryEmailAddress)
+				a.So(emailSender.Messages[0].TextBody, should.ContainSubstring, created.ID)
+			}
+
+			// The test client connects from the loopback interface.
+			_, err = cli.AuthInfo(ctx, ttnpb.Empty, keyCreds)
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+			created.AllowedIPs = []string{"127.0.0.0/8", "::1"}
+			_, err = reg.UpdateAPIKey(ctx, &ttnpb.UpdateUserAPIKeyRequest{
+				UserIdentifiers: userID,
+				APIKey:          *created,
+				FieldMask:       types.FieldMask{Paths: []string{"allowed_ips"}},
+			}, creds)
+			a.So(err, should.BeNil)
+
+			authInfo, err := cli.AuthInfo(ctx, ttnpb.Empty, keyCreds)
+			a.So(err, should.BeNil)
+			a.So(authInfo.GetAPIKey().LastUsedAt, should.NotBeNil)
+
+			// Updating the rights does not remove the restrictions.
+			_, err = reg.UpdateAPIKey(ctx, &ttnpb.UpdateUserAPIKeyRequest{
+				UserIdentifiers: userID,
+				APIKey: ttnpb.APIKey{
+					ID:     created.ID,
+					Rights: []ttnpb.Right{ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_USER_SETTINGS_BASIC},
+				},
+				FieldMask: types.FieldMask{Paths: []string{"rights"}},
+			}, creds)
+			a.So(err, should.BeNil)
+
+			authInfo, err = cli.AuthInfo(ctx, ttnpb.Empty, keyCreds)
+			a.So(err, should.BeNil)
+			a.So(authInfo.GetAPIKey().AllowedIPs, should.Resemble, created.AllowedIPs)
+			a.So(authInfo.GetAPIKey().ExpiresAt, should.NotBeNil)
+
+			err = is.withDatabase(ctx, func(db *gorm.DB) error {
+				return db.Model(&store.APIKey{}).Where(&store.APIKey{APIKeyID: created.ID}).UpdateColumn("expires_at", past).Error
+			})
+			a.So(err, should.BeNil)
+
+			_, err = cli.AuthInfo(ctx, ttnpb.Empty, keyCreds)
+			a.So(errors.IsUnauthenticated(err), should.BeTrue)
+
+			_, err = reg.UpdateAPIKey(ctx, &ttnpb.UpdateUserAPIKeyRequest{
+				UserIdentifiers: userID,
+				APIKey:          ttnpb.APIKey{ID: created.ID},
+			}, creds)
+			a.So(err, should.BeNil)
+		})
+
 		t.Run("Membership Cache", func(t *testing.T) {
 			a := assertions.New(t)
 
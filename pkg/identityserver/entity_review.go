@@ -0,0 +1,263 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/emails"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	evtReviewApplication  = events.Define("application.review", "Review application")
+	evtReviewClient       = events.Define("client.review", "Review OAuth client")
+	evtReviewOrganization = events.Define("organization.review", "Review organization")
+	evtReviewUser         = events.Define("user.review", "Review user")
+)
+
+func (is *IdentityServer) listUsersByState(ctx context.Context, req *ttnpb.ListEntitiesByStateRequest) (usrs *ttnpb.Users, err error) {
+	if err = is.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var total uint64
+	ctx = store.SetTotalCount(ctx, &total)
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, total)
+		}
+	}()
+	usrs = &ttnpb.Users{}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		entityIDs, err := store.GetEntitySearch(db).FindEntitiesInState(ctx, "user", req.State)
+		if err != nil || len(entityIDs) == 0 {
+			return err
+		}
+		ids := make([]*ttnpb.UserIdentifiers, len(entityIDs))
+		index := make(map[string]int, len(entityIDs))
+		for i, id := range entityIDs {
+			ids[i] = id.GetUserIDs()
+			index[ids[i].UserID] = i
+		}
+		usrs.Users, err = store.GetUserStore(db).FindUsers(store.WithoutPagination(ctx), ids, &req.FieldMask)
+		if err != nil {
+			return err
+		}
+		sort.Slice(usrs.Users, func(i, j int) bool {
+			return index[usrs.Users[i].UserID] < index[usrs.Users[j].UserID]
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return usrs, nil
+}
+
+func (is *IdentityServer) listApplicationsByState(ctx context.Context, req *ttnpb.ListEntitiesByStateRequest) (apps *ttnpb.Applications, err error) {
+	if err = is.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var total uint64
+	ctx = store.SetTotalCount(ctx, &total)
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, total)
+		}
+	}()
+	apps = &ttnpb.Applications{}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		entityIDs, err := store.GetEntitySearch(db).FindEntitiesInState(ctx, "application", req.State)
+		if err != nil || len(entityIDs) == 0 {
+			return err
+		}
+		ids := make([]*ttnpb.ApplicationIdentifiers, len(entityIDs))
+		index := make(map[string]int, len(entityIDs))
+		for i, id := range entityIDs {
+			ids[i] = id.GetApplicationIDs()
+			index[ids[i].ApplicationID] = i
+		}
+		apps.Applications, err = store.GetApplicationStore(db).FindApplications(store.WithoutPagination(ctx), ids, &req.FieldMask)
+		if err != nil {
+			return err
+		}
+		sort.Slice(apps.Applications, func(i, j int) bool {
+			return index[apps.Applications[i].ApplicationID] < index[apps.Applications[j].ApplicationID]
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return apps, nil
+}
+
+func (is *IdentityServer) listOrganizationsByState(ctx context.Context, req *ttnpb.ListEntitiesByStateRequest) (orgs *ttnpb.Organizations, err error) {
+	if err = is.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var total uint64
+	ctx = store.SetTotalCount(ctx, &total)
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, total)
+		}
+	}()
+	orgs = &ttnpb.Organizations{}
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		entityIDs, err := store.GetEntitySearch(db).FindEntitiesInState(ctx, "organization", req.State)
+		if err != nil || len(entityIDs) == 0 {
+			return err
+		}
+		ids := make([]*ttnpb.OrganizationIdentifiers, len(entityIDs))
+		index := make(map[string]int, len(entityIDs))
+		for i, id := range entityIDs {
+			ids[i] = id.GetOrganizationIDs()
+			index[ids[i].OrganizationID] = i
+		}
+		orgs.Organizations, err = store.GetOrganizationStore(db).FindOrganizations(store.WithoutPagination(ctx), ids, &req.FieldMask)
+		if err != nil {
+			return err
+		}
+		sort.Slice(orgs.Organizations, func(i, j int) bool {
+			return index[orgs.Organizations[i].OrganizationID] < index[orgs.Organizations[j].OrganizationID]
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orgs, nil
+}
+
+func (is *IdentityServer) reviewEntities(ctx context.Context, req *ttnpb.ReviewEntitiesRequest) (*types.Empty, error) {
+	if err := is.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	authInfo, err := is.authInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	callerIDs, _ := entityRights(authInfo)
+	review := &ttnpb.ReviewRecord{
+		State:       req.State,
+		Reason:      req.Reason,
+		ReviewerIDs: callerIDs.GetUserIDs(),
+	}
+	reviews := make([]*ttnpb.ReviewRecord, len(req.EntityIDs))
+	recipients := make([][]emailRecipient, len(req.EntityIDs))
+	err = is.withDatabase(ctx, func(db *gorm.DB) error {
+		for i, entityID := range req.EntityIDs {
+			reviews[i], err = store.GetReviewStore(db).ReviewEntity(ctx, entityID, review)
+			if err != nil {
+				return err
+			}
+			recipients[i], err = emailRecipients(ctx, db, entityID)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	state := strings.ToLower(strings.TrimPrefix(req.State.String(), "STATE_"))
+	for i, entityID := range req.EntityIDs {
+		switch ids := entityID.Identifiers().(type) {
+		case *ttnpb.ApplicationIdentifiers:
+			events.Publish(evtReviewApplication(ctx, ids, reviews[i]))
+		case *ttnpb.ClientIdentifiers:
+			events.Publish(evtReviewClient(ctx, ids, reviews[i]))
+		case *ttnpb.OrganizationIdentifiers:
+			events.Publish(evtReviewOrganization(ctx, ids, reviews[i]))
+		case *ttnpb.UserIdentifiers:
+			events.Publish(evtReviewUser(ctx, ids, reviews[i]))
+		}
+		for _, recipient := range recipients[i] {
+			is.sendEmail(ctx, emails.EntityReviewed{
+				Data:       is.emailData(ctx, recipient.userID, recipient.name, recipient.address),
+				EntityType: entityTypeName(entityID),
+				EntityID:   entityID.IDString(),
+				State:      state,
+				Reason:     req.Reason,
+			})
+		}
+	}
+	return ttnpb.Empty, nil
+}
+
+// entityTypeName returns the human-readable name of the type of the entity.
+func entityTypeName(entityID *ttnpb.EntityIdentifiers) string {
+	switch entityID.Identifiers().(type) {
+	case *ttnpb.ApplicationIdentifiers:
+		return "application"
+	case *ttnpb.ClientIdentifiers:
+		return "OAuth client"
+	case *ttnpb.GatewayIdentifiers:
+		return "gateway"
+	case *ttnpb.OrganizationIdentifiers:
+		return "organization"
+	case *ttnpb.UserIdentifiers:
+		return "user account"
+	default:
+		return "entity"
+	}
+}
+
+func (is *IdentityServer) getReviewHistory(ctx context.Context, req *ttnpb.EntityIdentifiers) (*ttnpb.ReviewRecords, error) {
+	if err := is.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.ReviewRecords{}
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		res.Records, err = store.GetReviewStore(db).FindReviews(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type entityReviewRegistry struct {
+	*IdentityServer
+}
+
+func (rr *entityReviewRegistry) ListUsers(ctx context.Context, req *ttnpb.ListEntitiesByStateRequest) (*ttnpb.Users, error) {
+	return rr.listUsersByState(ctx, req)
+}
+
+func (rr *entityReviewRegistry) ListApplications(ctx context.Context, req *ttnpb.ListEntitiesByStateRequest) (*ttnpb.Applications, error) {
+	return rr.listApplicationsByState(ctx, req)
+}
+
+func (rr *entityReviewRegistry) ListOrganizations(ctx context.Context, req *ttnpb.ListEntitiesByStateRequest) (*ttnpb.Organizations, error) {
+	return rr.listOrganizationsByState(ctx, req)
+}
+
+func (rr *entityReviewRegistry) Review(ctx context.Context, req *ttnpb.ReviewEntitiesRequest) (*types.Empty, error) {
+	return rr.reviewEntities(ctx, req)
+}
+
+func (rr *entityReviewRegistry) GetReviewHistory(ctx context.Context, req *ttnpb.EntityIdentifiers) (*ttnpb.ReviewRecords, error) {
+	return rr.getReviewHistory(ctx, req)
+}
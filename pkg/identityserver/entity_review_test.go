@@ -0,0 +1,216 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestEntityReviewRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewEntityReviewRegistryClient(cc)
+
+		_, err := reg.ListUsers(ctx, &ttnpb.ListEntitiesByStateRequest{
+			State: ttnpb.STATE_REQUESTED,
+		}, userCreds(defaultUserIdx))
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		creds := userCreds(adminUserIdx)
+
+		usrs, err := reg.ListUsers(ctx, &ttnpb.ListEntitiesByStateRequest{
+			State:     ttnpb.STATE_REQUESTED,
+			FieldMask: types.FieldMask{Paths: []string{"state"}},
+		}, creds)
+		a.So(err, should.BeNil)
+		var found bool
+		for _, usr := range usrs.Users {
+			a.So(usr.State, should.Equal, ttnpb.STATE_REQUESTED)
+			if usr.UserID == newUser.UserID {
+				found = true
+			}
+		}
+		a.So(found, should.BeTrue)
+
+		appIDs := population.Applications[0].ApplicationIdentifiers
+
+		_, err = reg.Review(ctx, &ttnpb.ReviewEntitiesRequest{
+			EntityIDs: []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()},
+			State:     ttnpb.STATE_FLAGGED,
+			Reason:    "Suspicious activity",
+		}, creds)
+		a.So(err, should.BeNil)
+
+		apps, err := reg.ListApplications(ctx, &ttnpb.ListEntitiesByStateRequest{
+			State:     ttnpb.STATE_FLAGGED,
+			FieldMask: types.FieldMask{Paths: []string{"state"}},
+		}, creds)
+		a.So(err, should.BeNil)
+		found = false
+		for _, app := range apps.Applications {
+			a.So(app.State, should.Equal, ttnpb.STATE_FLAGGED)
+			if app.ApplicationID == appIDs.ApplicationID {
+				found = true
+			}
+		}
+		a.So(found, should.BeTrue)
+
+		_, err = reg.Review(ctx, &ttnpb.ReviewEntitiesRequest{
+			EntityIDs: []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()},
+			State:     ttnpb.STATE_APPROVED,
+		}, creds)
+		a.So(err, should.BeNil)
+
+		history, err := reg.GetReviewHistory(ctx, appIDs.EntityIdentifiers(), creds)
+		a.So(err, should.BeNil)
+		if a.So(history.Records, should.HaveLength, 2) {
+			a.So(history.Records[0].State, should.Equal, ttnpb.STATE_FLAGGED)
+			a.So(history.Records[0].Reason, should.Equal, "Suspicious activity")
+			a.So(history.Records[0].ReviewerIDs.GetUserID(), should.Equal, adminUser.UserID)
+			a.So(history.Records[1].State, should.Equal, ttnpb.STATE_APPROVED)
+		}
+
+		_, err = reg.Review(ctx, &ttnpb.ReviewEntitiesRequest{
+			EntityIDs: []*ttnpb.EntityIdentifiers{appIDs.EntityIdentifiers()},
+			State:     ttnpb.STATE_SUSPENDED,
+		}, userCreds(defaultUserIdx))
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		usrID, usrCreds := population.Users[defaultUserIdx].UserIdentifiers, userCreds(defaultUserIdx)
+		usrAppIDs := userApplications(&usrID).Applications[0].ApplicationIdentifiers
+		usrOrgIDs := userOrganizations(&usrID).Organizations[0].OrganizationIdentifiers
+		appReg := ttnpb.NewApplicationRegistryClient(cc)
+		orgReg := ttnpb.NewOrganizationRegistryClient(cc)
+
+		_, err = reg.Review(ctx, &ttnpb.ReviewEntitiesRequest{
+			EntityIDs: []*ttnpb.EntityIdentifiers{usrAppIDs.EntityIdentifiers(), usrOrgIDs.EntityIdentifiers()},
+			State:     ttnpb.STATE_SUSPENDED,
+		}, creds)
+		a.So(err, should.BeNil)
+
+		// The rights of collaborators are restricted while the entities are suspended.
+		_, err = appReg.Get(ctx, &ttnpb.GetApplicationRequest{ApplicationIdentifiers: usrAppIDs}, usrCreds)
+		a.So(err, should.BeNil)
+		_, err = appReg.Update(ctx, &ttnpb.UpdateApplicationRequest{
+			Application: ttnpb.Application{ApplicationIdentifiers: usrAppIDs, Name: "Suspended"},
+			FieldMask:   types.FieldMask{Paths: []string{"name"}},
+		}, usrCreds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+		_, err = orgReg.Update(ctx, &ttnpb.UpdateOrganizationRequest{
+			Organization: ttnpb.Organization{OrganizationIdentifiers: usrOrgIDs, Name: "Suspended"},
+			FieldMask:    types.FieldMask{Paths: []string{"name"}},
+		}, usrCreds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		// Admins can still manage suspended entities.
+		_, err = appReg.Update(ctx, &ttnpb.UpdateApplicationRequest{
+			Application: ttnpb.Application{ApplicationIdentifiers: usrAppIDs, Name: "Suspended"},
+			FieldMask:   types.FieldMask{Paths: []string{"name"}},
+		}, creds)
+		a.So(err, should.BeNil)
+
+		_, err = reg.Review(ctx, &ttnpb.ReviewEntitiesRequest{
+			EntityIDs: []*ttnpb.EntityIdentifiers{usrAppIDs.EntityIdentifiers(), usrOrgIDs.EntityIdentifiers()},
+			State:     ttnpb.STATE_APPROVED,
+		}, creds)
+		a.So(err, should.BeNil)
+
+		_, err = appReg.Update(ctx, &ttnpb.UpdateApplicationRequest{
+			Application: ttnpb.Application{ApplicationIdentifiers: usrAppIDs, Name: "Approved"},
+			FieldMask:   types.FieldMask{Paths: []string{"name"}},
+		}, usrCreds)
+		a.So(err, should.BeNil)
+	})
+}
+
+func TestEntityReviewRegistryPagination(t *testing.T) {
+	a := assertions.New(t)
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewEntityReviewRegistryClient(cc)
+		appReg := ttnpb.NewApplicationRegistryClient(cc)
+		creds := userCreds(adminUserIdx)
+
+		// The applications are created in the reverse order of their IDs.
+		appIDs := []ttnpb.ApplicationIdentifiers{
+			{ApplicationID: "review-pagination-c"},
+			{ApplicationID: "review-pagination-b"},
+			{ApplicationID: "review-pagination-a"},
+		}
+		entityIDs := make([]*ttnpb.EntityIdentifiers, len(appIDs))
+		for i, ids := range appIDs {
+			_, err := appReg.Create(test.Context(), &ttnpb.CreateApplicationRequest{
+				Application:  ttnpb.Application{ApplicationIdentifiers: ids},
+				Collaborator: *adminUser.OrganizationOrUserIdentifiers(),
+			}, creds)
+			a.So(err, should.BeNil)
+			entityIDs[i] = ids.EntityIdentifiers()
+		}
+		_, err := reg.Review(test.Context(), &ttnpb.ReviewEntitiesRequest{
+			EntityIDs: entityIDs,
+			State:     ttnpb.STATE_FLAGGED,
+		}, creds)
+		a.So(err, should.BeNil)
+
+		// The applications are listed by creation time, not by ID, over all pages.
+		var listed []string
+		for page := uint64(1); page < 10; page++ {
+			ctx := rpcmetadata.MD{Limit: 2, Page: page}.ToOutgoingContext(test.Context())
+			apps, err := reg.ListApplications(ctx, &ttnpb.ListEntitiesByStateRequest{
+				State:     ttnpb.STATE_FLAGGED,
+				FieldMask: types.FieldMask{Paths: []string{"state"}},
+			}, creds)
+			if !a.So(err, should.BeNil) {
+				break
+			}
+			a.So(len(apps.Applications), should.BeLessThanOrEqualTo, 2)
+			if len(apps.Applications) == 0 {
+				break
+			}
+			for _, app := range apps.Applications {
+				if strings.HasPrefix(app.ApplicationID, "review-pagination-") {
+					listed = append(listed, app.ApplicationID)
+				}
+			}
+		}
+		a.So(listed, should.Resemble, []string{"review-pagination-c", "review-pagination-b", "review-pagination-a"})
+
+		_, err = reg.Review(test.Context(), &ttnpb.ReviewEntitiesRequest{
+			EntityIDs: entityIDs,
+			State:     ttnpb.STATE_APPROVED,
+		}, creds)
+		a.So(err, should.BeNil)
+	})
+}
@@ -30,6 +30,7 @@ var (
 	evtCreateGatewayAPIKey       = events.Define("gateway.api-key.create", "Create gateway API key")
 	evtUpdateGatewayAPIKey       = events.Define("gateway.api-key.update", "Update gateway API key")
 	evtDeleteGatewayAPIKey       = events.Define("gateway.api-key.delete", "Delete gateway API key")
+	evtExpiringGatewayAPIKey     = events.Define("gateway.api-key.expiring", "Gateway API key expiring")
 	evtUpdateGatewayCollaborator = events.Define("gateway.collaborator.update", "Update gateway collaborator")
 	evtDeleteGatewayCollaborator = events.Define("gateway.collaborator.delete", "Delete gateway collaborator")
 )
@@ -55,7 +56,10 @@ func (is *IdentityServer) createGatewayAPIKey(ctx context.Context, req *ttnpb.Cr
 	if err = rights.RequireGateway(ctx, req.GatewayIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
-	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
+	if err = validateAPIKeyRestrictions(req.ExpiresAt, req.AllowedIPs); err != nil {
+		return nil, err
+	}
+	key, token, err := generateAPIKey(ctx, req.Name, req.ExpiresAt, req.AllowedIPs, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
@@ -98,8 +102,15 @@ func (is *IdentityServer) updateGatewayAPIKey(ctx context.Context, req *ttnpb.Up
 	if err = rights.RequireGateway(ctx, req.GatewayIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
+	req.FieldMask.Paths = apiKeyUpdatePaths(req.FieldMask.Paths)
+	deleteKey := isAPIKeyDeletion(&req.APIKey, req.FieldMask.Paths)
+	if !deleteKey {
+		if err = validateAPIKeyUpdate(&req.APIKey, req.FieldMask.Paths); err != nil {
+			return nil, err
+		}
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.GatewayIdentifiers.EntityIdentifiers(), &req.APIKey)
+		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.GatewayIdentifiers.EntityIdentifiers(), &req.APIKey, &req.FieldMask)
 		return err
 	})
 	if err != nil {
@@ -109,7 +120,7 @@ func (is *IdentityServer) updateGatewayAPIKey(ctx context.Context, req *ttnpb.Up
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	if len(req.Rights) > 0 {
+	if !deleteKey {
 		events.Publish(evtUpdateGatewayAPIKey(ctx, req.GatewayIdentifiers, nil))
 		// TODO: Send notification email (https://github.com/TheThingsNetwork/lorawan-stack/issues/72).
 	} else {
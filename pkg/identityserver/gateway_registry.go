@@ -19,6 +19,8 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/blacklist"
@@ -28,11 +30,22 @@ import (
 )
 
 var (
-	evtCreateGateway = events.Define("gateway.create", "Create gateway")
-	evtUpdateGateway = events.Define("gateway.update", "Update gateway")
-	evtDeleteGateway = events.Define("gateway.delete", "Delete gateway")
+	evtCreateGateway  = events.Define("gateway.create", "Create gateway")
+	evtUpdateGateway  = events.Define("gateway.update", "Update gateway")
+	evtDeleteGateway  = events.Define("gateway.delete", "Delete gateway")
+	evtRestoreGateway = events.Define("gateway.restore", "Restore gateway")
+	evtPurgeGateway   = events.Define("gateway.purge", "Purge gateway")
 )
 
+func validateUDPAllowedIPs(allowedIPs []string) error {
+	for _, allowedIP := range allowedIPs {
+		if _, err := parseAllowedIP(allowedIP); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGatewayRequest) (gtw *ttnpb.Gateway, err error) {
 	if err = blacklist.Check(ctx, req.GatewayID); err != nil {
 		return nil, err
@@ -49,6 +62,17 @@ func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGa
 	if err := validateContactInfo(req.Gateway.ContactInfo); err != nil {
 		return nil, err
 	}
+	if err := validateUDPAllowedIPs(req.Gateway.UDPAllowedIPs); err != nil {
+		return nil, err
+	}
+	udpToken := req.Gateway.UDPToken
+	if udpToken != "" {
+		hashedUDPToken, err := auth.Hash(udpToken)
+		if err != nil {
+			return nil, err
+		}
+		req.Gateway.UDPToken = string(hashedUDPToken)
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		gtw, err = store.GetGatewayStore(db).CreateGateway(ctx, &req.Gateway)
 		if err != nil {
@@ -74,6 +98,9 @@ func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGa
 	if err != nil {
 		return nil, err
 	}
+	if udpToken != "" {
+		gtw.UDPToken = udpToken // Return the unhashed token.
+	}
 	events.Publish(evtCreateGateway(ctx, req.GatewayIdentifiers, nil))
 	is.invalidateCachedMembershipsForAccount(ctx, &req.Collaborator)
 	return gtw, nil
@@ -90,6 +117,12 @@ func (is *IdentityServer) getGateway(ctx context.Context, req *ttnpb.GetGatewayR
 			return nil, err
 		}
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "udp_token") && clusterauth.Authorized(ctx) != nil {
+		// The UDP token is a secret, so it is only returned to the cluster and to callers that can change it.
+		if err = rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC); err != nil {
+			return nil, err
+		}
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		gtw, err = store.GetGatewayStore(db).GetGateway(ctx, &req.GatewayIdentifiers, &req.FieldMask)
 		if err != nil {
@@ -190,7 +223,11 @@ func (is *IdentityServer) listGateways(ctx context.Context, req *ttnpb.ListGatew
 			return err
 		}
 		for _, gtw := range gtws.Gateways {
-			if !gtwRights[unique.ID(ctx, gtw.GatewayIdentifiers)].IncludesAll(ttnpb.RIGHT_GATEWAY_INFO) {
+			rights := gtwRights[unique.ID(ctx, gtw.GatewayIdentifiers)]
+			if !rights.IncludesAll(ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC) {
+				gtw.UDPToken = ""
+			}
+			if !rights.IncludesAll(ttnpb.RIGHT_GATEWAY_INFO) {
 				gtw = gtw.PublicSafe()
 			}
 		}
@@ -209,6 +246,20 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 	if err := validateContactInfo(req.Gateway.ContactInfo); err != nil {
 		return nil, err
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "udp_allowed_ips") {
+		if err := validateUDPAllowedIPs(req.Gateway.UDPAllowedIPs); err != nil {
+			return nil, err
+		}
+	}
+	var udpToken string
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "udp_token") && req.Gateway.UDPToken != "" {
+		udpToken = req.Gateway.UDPToken
+		hashedUDPToken, err := auth.Hash(udpToken)
+		if err != nil {
+			return nil, err
+		}
+		req.Gateway.UDPToken = string(hashedUDPToken)
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		gtw, err = store.GetGatewayStore(db).UpdateGateway(ctx, &req.Gateway, &req.FieldMask)
 		if err != nil {
@@ -226,6 +277,9 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 	if err != nil {
 		return nil, err
 	}
+	if udpToken != "" {
+		gtw.UDPToken = udpToken // Return the unhashed token.
+	}
 	events.Publish(evtUpdateGateway(ctx, req.GatewayIdentifiers, req.FieldMask.Paths))
 	return gtw, nil
 }
@@ -244,6 +298,34 @@ func (is *IdentityServer) deleteGateway(ctx context.Context, ids *ttnpb.GatewayI
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) restoreGateway(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	if err := rights.RequireGateway(ctx, *ids, ttnpb.RIGHT_GATEWAY_DELETE); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetGatewayStore(db).RestoreGateway(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtRestoreGateway(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeGateway(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	if err := is.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetGatewayStore(db).PurgeGateway(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtPurgeGateway(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
 type gatewayRegistry struct {
 	*IdentityServer
 }
@@ -266,3 +348,9 @@ func (gr *gatewayRegistry) Update(ctx context.Context, req *ttnpb.UpdateGatewayR
 func (gr *gatewayRegistry) Delete(ctx context.Context, req *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
 	return gr.deleteGateway(ctx, req)
 }
+func (gr *gatewayRegistry) Restore(ctx context.Context, req *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	return gr.restoreGateway(ctx, req)
+}
+func (gr *gatewayRegistry) Purge(ctx context.Context, req *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	return gr.purgeGateway(ctx, req)
+}
@@ -20,6 +20,7 @@ import (
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/auth"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -187,6 +188,42 @@ func TestGatewaysCRUD(t *testing.T) {
 		a.So(err, should.BeNil)
 		a.So(updated.Name, should.Equal, "Updated Name")
 
+		_, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: created.GatewayIdentifiers,
+				UDPAllowedIPs:      []string{"not-an-ip"},
+			},
+			FieldMask: ptypes.FieldMask{Paths: []string{"udp_allowed_ips"}},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: created.GatewayIdentifiers,
+				UDPAllowedIPs:      []string{"10.0.0.0/8", "192.168.1.1"},
+				UDPToken:           "secret",
+			},
+			FieldMask: ptypes.FieldMask{Paths: []string{"udp_allowed_ips", "udp_token"}},
+		}, creds)
+
+		a.So(err, should.BeNil)
+
+		got, err = reg.Get(ctx, &ttnpb.GetGatewayRequest{
+			GatewayIdentifiers: created.GatewayIdentifiers,
+			FieldMask:          ptypes.FieldMask{Paths: []string{"udp_allowed_ips", "udp_token"}},
+		}, creds)
+
+		if a.So(err, should.BeNil) {
+			a.So(got.UDPAllowedIPs, should.Resemble, []string{"10.0.0.0/8", "192.168.1.1"})
+			a.So(got.UDPToken, should.NotEqual, "secret")
+			ok, err := auth.Password(got.UDPToken).Validate("secret")
+			a.So(err, should.BeNil)
+			a.So(ok, should.BeTrue)
+		}
+
 		for _, collaborator := range []*ttnpb.OrganizationOrUserIdentifiers{nil, userID.OrganizationOrUserIdentifiers()} {
 			list, err := reg.List(ctx, &ttnpb.ListGatewaysRequest{
 				FieldMask:    ptypes.FieldMask{Paths: []string{"name"}},
@@ -208,6 +245,17 @@ func TestGatewaysCRUD(t *testing.T) {
 		_, err = reg.Delete(ctx, &created.GatewayIdentifiers, creds)
 		a.So(err, should.BeNil)
 
+		_, err = reg.Restore(ctx, &created.GatewayIdentifiers, creds)
+		a.So(err, should.BeNil)
+
+		_, err = reg.Purge(ctx, &created.GatewayIdentifiers, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.Purge(ctx, &created.GatewayIdentifiers, userCreds(adminUserIdx))
+		a.So(err, should.BeNil)
+
 	})
 }
 
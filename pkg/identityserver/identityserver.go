@@ -24,6 +24,9 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/email"
+	"go.thethings.network/lorawan-stack/pkg/email/sendgrid"
+	"go.thethings.network/lorawan-stack/pkg/email/smtp"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/pkg/oauth"
 	"go.thethings.network/lorawan-stack/pkg/redis"
@@ -56,12 +59,28 @@ type Config struct {
 	AuthCache struct {
 		MembershipTTL time.Duration `name:"membership-ttl" description:"TTL of membership caches"`
 	} `name:"auth-cache"`
-	OAuth          oauth.Config `name:"oauth"`
+	OAuth  oauth.Config `name:"oauth"`
+	Delete struct {
+		PurgeAfter time.Duration `name:"purge-after" description:"Period after which deleted entities are purged and their IDs released (0 means never)"`
+	} `name:"delete"`
+	APIKeys struct {
+		ExpiryNotice time.Duration `name:"expiry-notice" description:"Period before the expiry of API keys in which their entities are notified (0 means never)"`
+	} `name:"api-keys"`
 	ProfilePicture struct {
 		UseGravatar bool   `name:"use-gravatar" description:"Use Gravatar fallback for users without profile picture"`
 		Bucket      string `name:"bucket" description:"Bucket used for storing profile pictures"`
 		BucketURL   string `name:"bucket-url" description:"Base URL for public bucket access"`
 	} `name:"profile-picture"`
+	Email struct {
+		email.Config `name:",squash"`
+		SendGrid     sendgrid.Config `name:"sendgrid"`
+		SMTP         smtp.Config     `name:"smtp"`
+		Network      struct {
+			Name              string `name:"name" description:"The name of the network"`
+			IdentityServerURL string `name:"identity-server-url" description:"The URL of the Identity Server"`
+			ConsoleURL        string `name:"console-url" description:"The URL of the Console"`
+		} `name:"network"`
+	} `name:"email"`
 }
 
 // IdentityServer implements the Identity Server component.
@@ -74,6 +93,9 @@ type IdentityServer struct {
 	db     *gorm.DB
 	oauth  oauth.Server
 
+	emailSender    email.Sender
+	emailTemplates *email.TemplateRegistry
+
 	redis *redis.Client
 }
 
@@ -114,6 +136,10 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 		is.db.Close()
 	}()
 
+	if err = is.initEmail(); err != nil {
+		return nil, err
+	}
+
 	is.oauth = oauth.NewServer(is.Context(), struct {
 		store.UserStore
 		store.UserSessionStore
@@ -153,6 +179,13 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 	}
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.EntityAccess", cluster.HookName, c.ClusterAuthUnaryHook())
 
+	if purgeAfter := is.config.Delete.PurgeAfter; purgeAfter > 0 {
+		c.RegisterTask("purge_deleted_entities", is.purgeDeletedEntitiesTask(purgeAfter), component.TaskRestartOnFailure)
+	}
+	if expiryNotice := is.config.APIKeys.ExpiryNotice; expiryNotice > 0 {
+		c.RegisterTask("notify_expiring_api_keys", is.notifyExpiringAPIKeysTask(expiryNotice), component.TaskRestartOnFailure)
+	}
+
 	c.RegisterGRPC(is)
 	c.RegisterWeb(is.oauth)
 
@@ -180,6 +213,7 @@ func (is *IdentityServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterUserInvitationRegistryServer(s, &invitationRegistry{IdentityServer: is})
 	ttnpb.RegisterEntityRegistrySearchServer(s, &registrySearch{IdentityServer: is, adminOnly: true})
 	ttnpb.RegisterContactInfoRegistryServer(s, &contactInfoRegistry{IdentityServer: is})
+	ttnpb.RegisterEntityReviewRegistryServer(s, &entityReviewRegistry{IdentityServer: is})
 }
 
 // RegisterHandlers registers gRPC handlers.
@@ -199,6 +233,7 @@ func (is *IdentityServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Clien
 	ttnpb.RegisterUserInvitationRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterEntityRegistrySearchHandler(is.Context(), s, conn)
 	ttnpb.RegisterContactInfoRegistryHandler(is.Context(), s, conn)
+	ttnpb.RegisterEntityReviewRegistryHandler(is.Context(), s, conn)
 }
 
 // Roles returns the roles that the Identity Server fulfills.
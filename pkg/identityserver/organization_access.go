@@ -30,6 +30,7 @@ var (
 	evtCreateOrganizationAPIKey       = events.Define("organization.api-key.create", "Create organization API key")
 	evtUpdateOrganizationAPIKey       = events.Define("organization.api-key.update", "Update organization API key")
 	evtDeleteOrganizationAPIKey       = events.Define("organization.api-key.delete", "Delete organization API key")
+	evtExpiringOrganizationAPIKey     = events.Define("organization.api-key.expiring", "Organization API key expiring")
 	evtUpdateOrganizationCollaborator = events.Define("organization.collaborator.update", "Update organization collaborator")
 	evtDeleteOrganizationCollaborator = events.Define("organization.collaborator.delete", "Delete organization collaborator")
 )
@@ -55,7 +56,10 @@ func (is *IdentityServer) createOrganizationAPIKey(ctx context.Context, req *ttn
 	if err = rights.RequireOrganization(ctx, req.OrganizationIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
-	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
+	if err = validateAPIKeyRestrictions(req.ExpiresAt, req.AllowedIPs); err != nil {
+		return nil, err
+	}
+	key, token, err := generateAPIKey(ctx, req.Name, req.ExpiresAt, req.AllowedIPs, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
@@ -98,8 +102,15 @@ func (is *IdentityServer) updateOrganizationAPIKey(ctx context.Context, req *ttn
 	if err = rights.RequireOrganization(ctx, req.OrganizationIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
+	req.FieldMask.Paths = apiKeyUpdatePaths(req.FieldMask.Paths)
+	deleteKey := isAPIKeyDeletion(&req.APIKey, req.FieldMask.Paths)
+	if !deleteKey {
+		if err = validateAPIKeyUpdate(&req.APIKey, req.FieldMask.Paths); err != nil {
+			return nil, err
+		}
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.OrganizationIdentifiers.EntityIdentifiers(), &req.APIKey)
+		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.OrganizationIdentifiers.EntityIdentifiers(), &req.APIKey, &req.FieldMask)
 		return err
 	})
 	if err != nil {
@@ -109,7 +120,7 @@ func (is *IdentityServer) updateOrganizationAPIKey(ctx context.Context, req *ttn
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	if len(req.Rights) > 0 {
+	if !deleteKey {
 		events.Publish(evtUpdateOrganizationAPIKey(ctx, req.OrganizationIdentifiers, nil))
 		// TODO: Send notification email (https://github.com/TheThingsNetwork/lorawan-stack/issues/72).
 	} else {
@@ -29,9 +29,11 @@ import (
 )
 
 var (
-	evtCreateOrganization = events.Define("organization.create", "Create organization")
-	evtUpdateOrganization = events.Define("organization.update", "Update organization")
-	evtDeleteOrganization = events.Define("organization.delete", "Delete organization")
+	evtCreateOrganization  = events.Define("organization.create", "Create organization")
+	evtUpdateOrganization  = events.Define("organization.update", "Update organization")
+	evtDeleteOrganization  = events.Define("organization.delete", "Delete organization")
+	evtRestoreOrganization = events.Define("organization.restore", "Restore organization")
+	evtPurgeOrganization   = events.Define("organization.purge", "Purge organization")
 )
 
 var errNestedOrganizations = errors.DefineInvalidArgument("nested_organizations", "organizations can not be nested")
@@ -50,6 +52,9 @@ func (is *IdentityServer) createOrganization(ctx context.Context, req *ttnpb.Cre
 	if err := validateContactInfo(req.Organization.ContactInfo); err != nil {
 		return nil, err
 	}
+	if !is.UniversalRights(ctx).IncludesAll(ttnpb.RIGHT_ALL) {
+		req.Organization.State = ttnpb.STATE_APPROVED
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		org, err = store.GetOrganizationStore(db).CreateOrganization(ctx, &req.Organization)
 		if err != nil {
@@ -181,6 +186,10 @@ func (is *IdentityServer) listOrganizations(ctx context.Context, req *ttnpb.List
 	return orgs, nil
 }
 
+var (
+	errUpdateOrganizationAdminField = errors.DefinePermissionDenied("organization_update_admin_field", "only admins can update the `{field}` field")
+)
+
 func (is *IdentityServer) updateOrganization(ctx context.Context, req *ttnpb.UpdateOrganizationRequest) (org *ttnpb.Organization, err error) {
 	if err = rights.RequireOrganization(ctx, req.OrganizationIdentifiers, ttnpb.RIGHT_ORGANIZATION_SETTINGS_BASIC); err != nil {
 		return nil, err
@@ -188,6 +197,9 @@ func (is *IdentityServer) updateOrganization(ctx context.Context, req *ttnpb.Upd
 	if err := validateContactInfo(req.Organization.ContactInfo); err != nil {
 		return nil, err
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "state") && !is.UniversalRights(ctx).IncludesAll(ttnpb.RIGHT_ALL) {
+		return nil, errUpdateOrganizationAdminField.WithAttributes("field", "state")
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		org, err = store.GetOrganizationStore(db).UpdateOrganization(ctx, &req.Organization, &req.FieldMask)
 		if err != nil {
@@ -223,6 +235,34 @@ func (is *IdentityServer) deleteOrganization(ctx context.Context, ids *ttnpb.Org
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) restoreOrganization(ctx context.Context, ids *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	if err := rights.RequireOrganization(ctx, *ids, ttnpb.RIGHT_ORGANIZATION_DELETE); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetOrganizationStore(db).RestoreOrganization(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtRestoreOrganization(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeOrganization(ctx context.Context, ids *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	if err := is.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetOrganizationStore(db).PurgeOrganization(ctx, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtPurgeOrganization(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
+
 type organizationRegistry struct {
 	*IdentityServer
 }
@@ -242,3 +282,9 @@ func (or *organizationRegistry) Update(ctx context.Context, req *ttnpb.UpdateOrg
 func (or *organizationRegistry) Delete(ctx context.Context, req *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
 	return or.deleteOrganization(ctx, req)
 }
+func (or *organizationRegistry) Restore(ctx context.Context, req *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	return or.restoreOrganization(ctx, req)
+}
+func (or *organizationRegistry) Purge(ctx context.Context, req *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	return or.purgeOrganization(ctx, req)
+}
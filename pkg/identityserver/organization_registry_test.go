@@ -224,6 +224,17 @@ func TestOrganizationsCRUD(t *testing.T) {
 
 		_, err = reg.Delete(ctx, &created.OrganizationIdentifiers, creds)
 		a.So(err, should.BeNil)
+
+		_, err = reg.Restore(ctx, &created.OrganizationIdentifiers, creds)
+		a.So(err, should.BeNil)
+
+		_, err = reg.Purge(ctx, &created.OrganizationIdentifiers, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.Purge(ctx, &created.OrganizationIdentifiers, userCreds(adminUserIdx))
+		a.So(err, should.BeNil)
 	})
 }
 
@@ -0,0 +1,94 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// purgeInterval is the interval in which deleted entities are checked for purging.
+const purgeInterval = time.Hour
+
+// purgeDeletedEntities purges the applications, gateways and organizations
+// that were deleted longer than the configured purge period ago. Until then,
+// their IDs stay reserved and the entities can be restored.
+func (is *IdentityServer) purgeDeletedEntities(ctx context.Context, purgeAfter time.Duration) error {
+	logger := log.FromContext(ctx)
+	var deleted []*ttnpb.EntityIdentifiers
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		for _, entityType := range []string{"application", "gateway", "organization"} {
+			ids, err := store.GetEntitySearch(db).FindDeletedEntities(ctx, entityType, time.Now().Add(-purgeAfter))
+			if err != nil {
+				return err
+			}
+			deleted = append(deleted, ids...)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, id := range deleted {
+		var evt events.Event
+		var devIDs []ttnpb.EndDeviceIdentifiers
+		err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+			switch id := id.Identifiers().(type) {
+			case *ttnpb.ApplicationIdentifiers:
+				evt = evtPurgeApplication(ctx, id, nil)
+				devIDs, err = purgeApplication(ctx, db, id)
+				return err
+			case *ttnpb.GatewayIdentifiers:
+				evt = evtPurgeGateway(ctx, id, nil)
+				return store.GetGatewayStore(db).PurgeGateway(ctx, id)
+			case *ttnpb.OrganizationIdentifiers:
+				evt = evtPurgeOrganization(ctx, id, nil)
+				return store.GetOrganizationStore(db).PurgeOrganization(ctx, id)
+			}
+			return nil
+		})
+		if err != nil {
+			logger.WithError(err).WithField("entity_uid", unique.ID(ctx, id.Identifiers())).Warn("Failed to purge deleted entity")
+			continue
+		}
+		if evt != nil {
+			events.Publish(evt)
+		}
+		is.deleteEndDevicesFromCluster(ctx, devIDs...)
+	}
+	return nil
+}
+
+func (is *IdentityServer) purgeDeletedEntitiesTask(purgeAfter time.Duration) func(context.Context) error {
+	return func(ctx context.Context) error {
+		for {
+			if err := is.purgeDeletedEntities(ctx, purgeAfter); err != nil {
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(purgeInterval):
+			}
+		}
+	}
+}
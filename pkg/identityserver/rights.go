@@ -17,9 +17,66 @@ package identityserver
 import (
 	"context"
 
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/warning"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
+var stateFieldMask = &types.FieldMask{Paths: []string{"state"}}
+
+// stateRights returns the rights that can be used on an entity in the given
+// state, or nil if the state does not restrict the rights. The rights are
+// restricted in the same way as those of users in the same state.
+func stateRights(state ttnpb.State, info, settingsBasic, delete ttnpb.Right) *ttnpb.Rights {
+	switch state {
+	case ttnpb.STATE_REQUESTED:
+		return ttnpb.RightsFrom(info, settingsBasic, delete)
+	case ttnpb.STATE_REJECTED:
+		return ttnpb.RightsFrom(info, delete)
+	case ttnpb.STATE_SUSPENDED:
+		return ttnpb.RightsFrom(info)
+	default:
+		return nil
+	}
+}
+
+// applicationStateRights returns the rights that can be used on the application
+// in its current state, or nil if its state does not restrict the rights.
+func (is *IdentityServer) applicationStateRights(ctx context.Context, appIDs ttnpb.ApplicationIdentifiers) (*ttnpb.Rights, error) {
+	var app *ttnpb.Application
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		app, err = store.GetApplicationStore(db).GetApplication(ctx, &appIDs, stateFieldMask)
+		return err
+	})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return stateRights(app.State, ttnpb.RIGHT_APPLICATION_INFO, ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC, ttnpb.RIGHT_APPLICATION_DELETE), nil
+}
+
+// organizationStateRights returns the rights that can be used on the organization
+// in its current state, or nil if its state does not restrict the rights.
+func (is *IdentityServer) organizationStateRights(ctx context.Context, orgIDs ttnpb.OrganizationIdentifiers) (*ttnpb.Rights, error) {
+	var org *ttnpb.Organization
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		org, err = store.GetOrganizationStore(db).GetOrganization(ctx, &orgIDs, stateFieldMask)
+		return err
+	})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return stateRights(org.State, ttnpb.RIGHT_ORGANIZATION_INFO, ttnpb.RIGHT_ORGANIZATION_SETTINGS_BASIC, ttnpb.RIGHT_ORGANIZATION_DELETE), nil
+}
+
 func (is *IdentityServer) getRights(ctx context.Context) (entity map[*ttnpb.EntityIdentifiers]*ttnpb.Rights, universal *ttnpb.Rights, err error) {
 	authInfo, err := is.authInfo(ctx)
 	if err != nil {
@@ -41,6 +98,14 @@ func (is *IdentityServer) ApplicationRights(ctx context.Context, appIDs ttnpb.Ap
 	}
 	for ids, rights := range entity {
 		if ids := ids.GetApplicationIDs(); ids != nil && ids.ApplicationID == appIDs.ApplicationID {
+			stateRights, err := is.applicationStateRights(ctx, appIDs)
+			if err != nil {
+				return nil, err
+			}
+			if stateRights != nil {
+				rights = rights.Intersect(stateRights)
+				warning.Add(ctx, "Restricted rights because of application state")
+			}
 			return rights.Union(universal), nil
 		}
 	}
@@ -83,6 +148,14 @@ func (is *IdentityServer) OrganizationRights(ctx context.Context, orgIDs ttnpb.O
 	}
 	for ids, rights := range entity {
 		if ids := ids.GetOrganizationIDs(); ids != nil && ids.OrganizationID == orgIDs.OrganizationID {
+			stateRights, err := is.organizationStateRights(ctx, orgIDs)
+			if err != nil {
+				return nil, err
+			}
+			if stateRights != nil {
+				rights = rights.Intersect(stateRights)
+				warning.Add(ctx, "Restricted rights because of organization state")
+			}
 			return rights.Union(universal), nil
 		}
 	}
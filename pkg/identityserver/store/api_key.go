@@ -14,7 +14,12 @@
 
 package store
 
-import "go.thethings.network/lorawan-stack/pkg/ttnpb"
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
 
 // APIKey model.
 type APIKey struct {
@@ -26,6 +31,13 @@ type APIKey struct {
 	Rights Rights `gorm:"type:INT ARRAY"`
 	Name   string `gorm:"type:VARCHAR"`
 
+	ExpiresAt  *time.Time     `gorm:"index"`
+	AllowedIPs pq.StringArray `gorm:"type:VARCHAR ARRAY;column:allowed_ips"`
+	LastUsedAt *time.Time
+
+	// ExpiryNotifiedAt is the time at which the entity was notified of the upcoming expiry of the API key.
+	ExpiryNotifiedAt *time.Time
+
 	EntityID   string `gorm:"type:UUID;index:api_key_entity_index;not null"`
 	EntityType string `gorm:"type:VARCHAR(32);index:api_key_entity_index;not null"`
 }
@@ -36,9 +48,12 @@ func init() {
 
 func (k APIKey) toPB() *ttnpb.APIKey {
 	return &ttnpb.APIKey{
-		ID:     k.APIKeyID,
-		Key:    k.Key,
-		Name:   k.Name,
-		Rights: k.Rights.Rights,
+		ID:         k.APIKeyID,
+		Key:        k.Key,
+		Name:       k.Name,
+		Rights:     k.Rights.Rights,
+		ExpiresAt:  cleanTimePtr(k.ExpiresAt),
+		AllowedIPs: k.AllowedIPs,
+		LastUsedAt: cleanTimePtr(k.LastUsedAt),
 	}
 }
@@ -16,8 +16,11 @@ package store
 
 import (
 	"context"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -41,6 +44,8 @@ func (s *apiKeyStore) CreateAPIKey(ctx context.Context, entityID *ttnpb.EntityId
 		Key:        key.Key,
 		Rights:     Rights{Rights: key.Rights},
 		Name:       key.Name,
+		ExpiresAt:  cleanTimePtr(key.ExpiresAt),
+		AllowedIPs: pq.StringArray(key.AllowedIPs),
 		EntityID:   entity.PrimaryKey(),
 		EntityType: entityTypeForID(entityID),
 	}
@@ -89,7 +94,7 @@ func (s *apiKeyStore) GetAPIKey(ctx context.Context, id string) (*ttnpb.EntityId
 	return ids, keyModel.toPB(), nil
 }
 
-func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID *ttnpb.EntityIdentifiers, key *ttnpb.APIKey) (*ttnpb.APIKey, error) {
+func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID *ttnpb.EntityIdentifiers, key *ttnpb.APIKey, fieldMask *types.FieldMask) (*ttnpb.APIKey, error) {
 	entity, err := findEntity(ctx, s.db, entityID, "id")
 	if err != nil {
 		return nil, err
@@ -106,13 +111,77 @@ func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID *ttnpb.EntityId
 		}
 		return nil, err
 	}
-	if len(key.Rights) == 0 {
-		return nil, s.db.Delete(&APIKey{}).Error
+	updates := make(map[string]interface{})
+	for _, path := range fieldMask.GetPaths() {
+		switch path {
+		case "name":
+			updates["name"] = key.Name
+		case "rights":
+			if len(key.Rights) == 0 {
+				return nil, s.db.Delete(&keyModel).Error
+			}
+			updates["rights"] = Rights{Rights: key.Rights}
+		case "expires_at":
+			updates["expires_at"] = cleanTimePtr(key.ExpiresAt)
+			if !timePtrEqual(keyModel.ExpiresAt, key.ExpiresAt) {
+				// Notify again about the new expiry.
+				updates["expiry_notified_at"] = nil
+			}
+		case "allowed_ips":
+			updates["allowed_ips"] = pq.StringArray(key.AllowedIPs)
+		}
 	}
-	keyModel.Name = key.Name
-	keyModel.Rights = Rights{Rights: key.Rights}
-	if err = s.db.Model(&keyModel).Select("name", "rights").Updates(&keyModel).Error; err != nil {
-		return nil, err
+	if len(updates) > 0 {
+		if err = s.db.Model(&keyModel).Updates(updates).Error; err != nil {
+			return nil, err
+		}
 	}
 	return keyModel.toPB(), nil
 }
+
+func timePtrEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return cleanTime(*a).Equal(cleanTime(*b))
+}
+
+func (s *apiKeyStore) SetAPIKeyLastUsed(ctx context.Context, id string, lastUsedAt time.Time) error {
+	return s.db.Model(&APIKey{}).Where(APIKey{APIKeyID: id}).UpdateColumn("last_used_at", cleanTime(lastUsedAt)).Error
+}
+
+func (s *apiKeyStore) FindExpiringAPIKeys(ctx context.Context, before time.Time) ([]*ExpiringAPIKey, error) {
+	var keyModels []APIKey
+	err := s.db.Scopes(withContext(ctx)).
+		Where("expires_at IS NOT NULL AND expires_at < ? AND expiry_notified_at IS NULL", cleanTime(before)).
+		Find(&keyModels).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(keyModels) == 0 {
+		return nil, nil
+	}
+	entities := make([]polymorphicEntity, len(keyModels))
+	for i, keyModel := range keyModels {
+		entities[i] = polymorphicEntity{EntityType: keyModel.EntityType, EntityUUID: keyModel.EntityID}
+	}
+	identifiers, err := identifiers(s.db, entities...)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*ExpiringAPIKey, 0, len(keyModels))
+	for i, keyModel := range keyModels {
+		ids, ok := identifiers[entities[i]]
+		if !ok {
+			continue // Entity was deleted.
+		}
+		key := keyModel.toPB()
+		key.Key = ""
+		keys = append(keys, &ExpiringAPIKey{EntityIdentifiers: ids, APIKey: key})
+	}
+	return keys, nil
+}
+
+func (s *apiKeyStore) SetAPIKeyExpiryNotified(ctx context.Context, id string, notifiedAt time.Time) error {
+	return s.db.Model(&APIKey{}).Where(APIKey{APIKeyID: id}).UpdateColumn("expiry_notified_at", cleanTime(notifiedAt)).Error
+}
@@ -18,7 +18,9 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
@@ -50,6 +52,8 @@ func TestAPIKeyStore(t *testing.T) {
 		db.Create(&Gateway{GatewayID: "test-gtw"})
 		gtwIDs := &ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"}
 
+		expiresAt := cleanTime(time.Now().Add(24 * time.Hour))
+
 		for _, tt := range []struct {
 			Name        string
 			Identifiers *ttnpb.EntityIdentifiers
@@ -80,10 +84,12 @@ func TestAPIKeyStore(t *testing.T) {
 				a := assertions.New(t)
 
 				key := &ttnpb.APIKey{
-					ID:     strings.ToUpper(fmt.Sprintf("%sKEYID", tt.Name)),
-					Key:    strings.ToUpper(fmt.Sprintf("%sKEY", tt.Name)),
-					Name:   fmt.Sprintf("%s API key", tt.Name),
-					Rights: tt.Rights,
+					ID:         strings.ToUpper(fmt.Sprintf("%sKEYID", tt.Name)),
+					Key:        strings.ToUpper(fmt.Sprintf("%sKEY", tt.Name)),
+					Name:       fmt.Sprintf("%s API key", tt.Name),
+					Rights:     tt.Rights,
+					ExpiresAt:  &expiresAt,
+					AllowedIPs: []string{"10.0.0.0/8"},
 				}
 
 				err := store.CreateAPIKey(ctx, tt.Identifiers, key)
@@ -100,24 +106,75 @@ func TestAPIKeyStore(t *testing.T) {
 				a.So(ids, should.Resemble, tt.Identifiers)
 				a.So(got, should.Resemble, key)
 
+				expiring, err := store.FindExpiringAPIKeys(ctx, expiresAt.Add(-time.Hour))
+				a.So(err, should.BeNil)
+				a.So(expiring, should.BeEmpty)
+
+				expiring, err = store.FindExpiringAPIKeys(ctx, expiresAt.Add(time.Hour))
+				a.So(err, should.BeNil)
+				if a.So(expiring, should.HaveLength, 1) {
+					a.So(expiring[0].EntityIdentifiers, should.Resemble, tt.Identifiers)
+					a.So(expiring[0].APIKey.ID, should.Equal, key.ID)
+					a.So(expiring[0].APIKey.Key, should.BeEmpty)
+				}
+
+				err = store.SetAPIKeyExpiryNotified(ctx, key.ID, time.Now())
+				a.So(err, should.BeNil)
+
+				expiring, err = store.FindExpiringAPIKeys(ctx, expiresAt.Add(time.Hour))
+				a.So(err, should.BeNil)
+				a.So(expiring, should.BeEmpty)
+
+				lastUsedAt := cleanTime(time.Now())
+				err = store.SetAPIKeyLastUsed(ctx, key.ID, lastUsedAt)
+				a.So(err, should.BeNil)
+
+				_, got, err = store.GetAPIKey(ctx, key.ID)
+				a.So(err, should.BeNil)
+				if a.So(got.LastUsedAt, should.NotBeNil) {
+					a.So(got.LastUsedAt.Equal(lastUsedAt), should.BeTrue)
+				}
+
+				newExpiresAt := expiresAt.Add(time.Minute)
 				updated, err := store.UpdateAPIKey(ctx, tt.Identifiers, &ttnpb.APIKey{
-					ID:     strings.ToUpper(fmt.Sprintf("%sKEYID", tt.Name)),
-					Name:   fmt.Sprintf("Updated %s API key", tt.Name),
-					Rights: tt.Rights,
-				})
+					ID:        strings.ToUpper(fmt.Sprintf("%sKEYID", tt.Name)),
+					Name:      fmt.Sprintf("Updated %s API key", tt.Name),
+					Rights:    tt.Rights,
+					ExpiresAt: &newExpiresAt,
+				}, &types.FieldMask{Paths: []string{"name", "rights", "expires_at"}})
 				a.So(err, should.BeNil)
 
+				// The entity is notified again after the expiry changed.
+				expiring, err = store.FindExpiringAPIKeys(ctx, expiresAt.Add(time.Hour))
+				a.So(err, should.BeNil)
+				a.So(expiring, should.HaveLength, 1)
+
 				ids, got, err = store.GetAPIKey(ctx, key.ID)
 				a.So(err, should.BeNil)
 				a.So(got, should.Resemble, updated)
 				a.So(ids, should.Resemble, tt.Identifiers)
 				a.So(got.Name, should.NotEqual, key.Name)
 				a.So(got.Rights, should.Resemble, key.Rights)
+				a.So(got.AllowedIPs, should.Resemble, key.AllowedIPs)
+				if a.So(got.ExpiresAt, should.NotBeNil) {
+					a.So(got.ExpiresAt.Equal(newExpiresAt), should.BeTrue)
+				}
+
+				// Fields that are not in the field mask are not updated.
+				updated, err = store.UpdateAPIKey(ctx, tt.Identifiers, &ttnpb.APIKey{
+					ID: strings.ToUpper(fmt.Sprintf("%sKEYID", tt.Name)),
+				}, &types.FieldMask{Paths: []string{"allowed_ips"}})
+				a.So(err, should.BeNil)
+				if a.So(updated, should.NotBeNil) {
+					a.So(updated.AllowedIPs, should.BeEmpty)
+					a.So(updated.Rights, should.Resemble, key.Rights)
+					a.So(updated.ExpiresAt, should.NotBeNil)
+				}
 
 				updated, err = store.UpdateAPIKey(ctx, tt.Identifiers, &ttnpb.APIKey{
 					ID: strings.ToUpper(fmt.Sprintf("%sKEYID", tt.Name)),
 					// Empty rights
-				})
+				}, &types.FieldMask{Paths: []string{"rights"}})
 				a.So(err, should.BeNil)
 				a.So(updated, should.BeNil)
 
@@ -32,6 +32,8 @@ type Application struct {
 	APIKeys       []APIKey     `gorm:"polymorphic:Entity;polymorphic_value:application"`
 	Memberships   []Membership `gorm:"polymorphic:Entity;polymorphic_value:application"`
 	// END common fields
+
+	State int `gorm:"not null;default:1"`
 }
 
 func init() {
@@ -43,6 +45,7 @@ var applicationPBSetters = map[string]func(*ttnpb.Application, *Application){
 	nameField:        func(pb *ttnpb.Application, app *Application) { pb.Name = app.Name },
 	descriptionField: func(pb *ttnpb.Application, app *Application) { pb.Description = app.Description },
 	attributesField:  func(pb *ttnpb.Application, app *Application) { pb.Attributes = attributes(app.Attributes).toMap() },
+	stateField:       func(pb *ttnpb.Application, app *Application) { pb.State = ttnpb.State(app.State) },
 }
 
 // functions to set fields from the application proto into the application model.
@@ -52,6 +55,7 @@ var applicationModelSetters = map[string]func(*Application, *ttnpb.Application){
 	attributesField: func(app *Application, pb *ttnpb.Application) {
 		app.Attributes = attributes(app.Attributes).updateFromMap(pb.Attributes)
 	},
+	stateField: func(app *Application, pb *ttnpb.Application) { app.State = int(pb.State) },
 }
 
 // fieldMask to use if a nil or empty fieldmask is passed.
@@ -71,6 +75,7 @@ var applicationColumnNames = map[string][]string{
 	contactInfoField: {},
 	nameField:        {nameField},
 	descriptionField: {descriptionField},
+	stateField:       {stateField},
 }
 
 func (app Application) toPB(pb *ttnpb.Application, fieldMask *types.FieldMask) {
@@ -152,3 +152,11 @@ func (s *applicationStore) UpdateApplication(ctx context.Context, app *ttnpb.App
 func (s *applicationStore) DeleteApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error {
 	return deleteEntity(ctx, s.db, id.EntityIdentifiers())
 }
+
+func (s *applicationStore) RestoreApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error {
+	return restoreEntity(ctx, s.db, id.EntityIdentifiers())
+}
+
+func (s *applicationStore) PurgeApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error {
+	return purgeEntity(ctx, s.db, id.EntityIdentifiers())
+}
@@ -32,7 +32,8 @@ func TestApplicationStore(t *testing.T) {
 	ctx := test.Context()
 
 	WithDB(t, func(t *testing.T, db *gorm.DB) {
-		prepareTest(db, &Application{}, &Attribute{})
+		prepareTest(db, &Application{}, &Attribute{}, &APIKey{}, &Membership{}, &ContactInfo{}, &EndDevice{}, &EndDeviceLocation{})
+		deviceStore := GetEndDeviceStore(db)
 		store := GetApplicationStore(db)
 
 		created, err := store.CreateApplication(ctx, &ttnpb.Application{
@@ -100,6 +101,20 @@ func TestApplicationStore(t *testing.T) {
 			a.So(list[0].Name, should.EndWith, got.Name)
 		}
 
+		_, err = deviceStore.CreateEndDevice(ctx, &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo"},
+				DeviceID:               "foo-device",
+			},
+			Attributes: map[string]string{
+				"foo": "bar",
+			},
+			Locations: map[string]*ttnpb.Location{
+				"": {Latitude: 12.345, Longitude: 23.456, Source: ttnpb.SOURCE_REGISTRY},
+			},
+		})
+		a.So(err, should.BeNil)
+
 		err = store.DeleteApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"})
 		a.So(err, should.BeNil)
 
@@ -112,5 +127,49 @@ func TestApplicationStore(t *testing.T) {
 		a.So(err, should.BeNil)
 		a.So(list, should.BeEmpty)
 
+		_, err = store.CreateApplication(ctx, &ttnpb.Application{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo"},
+		})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsAlreadyExists(err), should.BeTrue)
+		}
+
+		err = store.RestoreApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"})
+		a.So(err, should.BeNil)
+
+		got, err = store.GetApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"}, nil)
+		a.So(err, should.BeNil)
+
+		devices, err := deviceStore.ListEndDevices(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"}, nil)
+		a.So(err, should.BeNil)
+		a.So(devices, should.HaveLength, 1)
+
+		err = store.RestoreApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.PurgeApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"})
+		a.So(err, should.BeNil)
+
+		devices, err = deviceStore.ListEndDevices(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"}, nil)
+		a.So(err, should.BeNil)
+		a.So(devices, should.BeEmpty)
+
+		var deviceAttributes, deviceLocations int
+		a.So(db.Model(&Attribute{}).Where(&Attribute{EntityType: "device"}).Count(&deviceAttributes).Error, should.BeNil)
+		a.So(deviceAttributes, should.Equal, 0)
+		a.So(db.Model(&EndDeviceLocation{}).Count(&deviceLocations).Error, should.BeNil)
+		a.So(deviceLocations, should.Equal, 0)
+
+		err = store.RestoreApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		_, err = store.CreateApplication(ctx, &ttnpb.Application{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo"},
+		})
+		a.So(err, should.BeNil)
 	})
 }
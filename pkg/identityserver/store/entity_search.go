@@ -17,6 +17,7 @@ package store
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -70,19 +71,91 @@ func (s *entitySearch) FindEntities(ctx context.Context, req *ttnpb.SearchEntiti
 
 	identifiers := make([]*ttnpb.EntityIdentifiers, len(entities))
 	for i, entity := range entities {
-		switch entityType {
-		case "application":
-			identifiers[i] = ttnpb.ApplicationIdentifiers{ApplicationID: entity.ID}.EntityIdentifiers()
-		case "client":
-			identifiers[i] = ttnpb.ClientIdentifiers{ClientID: entity.ID}.EntityIdentifiers()
-		case "gateway":
-			identifiers[i] = ttnpb.GatewayIdentifiers{GatewayID: entity.ID}.EntityIdentifiers()
-		case "organization":
-			identifiers[i] = ttnpb.OrganizationIdentifiers{OrganizationID: entity.ID}.EntityIdentifiers()
-		case "user":
-			identifiers[i] = ttnpb.UserIdentifiers{UserID: entity.ID}.EntityIdentifiers()
-		}
+		identifiers[i] = entityIdentifiers(entityType, entity.ID)
 	}
 
 	return identifiers, nil
 }
+
+func (s *entitySearch) FindDeletedEntities(ctx context.Context, entityType string, deletedBefore time.Time) ([]*ttnpb.EntityIdentifiers, error) {
+	table := entityType + "s"
+	db := s.db.Scopes(withContext(ctx)).Table(table)
+	idField := fmt.Sprintf("%s_id", entityType)
+	if entityType == "user" || entityType == "organization" {
+		idField = "accounts.uid"
+		db = db.Joins(fmt.Sprintf("JOIN accounts ON accounts.account_type = ? AND accounts.account_id = %s.id", table), entityType)
+	}
+	db = db.Select(fmt.Sprintf("%s AS id", idField)).
+		Where(fmt.Sprintf("%s.deleted_at IS NOT NULL AND %s.deleted_at < ?", table, table), cleanTime(deletedBefore))
+
+	var entities []struct {
+		ID string
+	}
+	if err := db.Scan(&entities).Error; err != nil {
+		return nil, err
+	}
+
+	if len(entities) == 0 {
+		return nil, nil
+	}
+
+	identifiers := make([]*ttnpb.EntityIdentifiers, len(entities))
+	for i, entity := range entities {
+		identifiers[i] = entityIdentifiers(entityType, entity.ID)
+	}
+
+	return identifiers, nil
+}
+
+func (s *entitySearch) FindEntitiesInState(ctx context.Context, entityType string, state ttnpb.State) ([]*ttnpb.EntityIdentifiers, error) {
+	table := entityType + "s"
+	db := s.db.Scopes(withContext(ctx)).Table(table)
+	idField := fmt.Sprintf("%s_id", entityType)
+	if entityType == "user" || entityType == "organization" {
+		idField = "accounts.uid"
+		db = db.Joins(fmt.Sprintf("JOIN accounts ON accounts.account_type = ? AND accounts.account_id = %s.id", table), entityType)
+	}
+	db = db.Where(fmt.Sprintf("%s.deleted_at IS NULL AND %s.state = ?", table, table), int(state))
+	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
+		countTotal(ctx, db)
+		db = db.Limit(limit).Offset(offset)
+	}
+	db = db.Select(fmt.Sprintf("%s AS id", idField)).
+		Order(fmt.Sprintf("%s.created_at, %s", table, idField))
+
+	var entities []struct {
+		ID string
+	}
+	if err := db.Scan(&entities).Error; err != nil {
+		return nil, err
+	}
+	setTotal(ctx, uint64(len(entities)))
+
+	if len(entities) == 0 {
+		return nil, nil
+	}
+
+	identifiers := make([]*ttnpb.EntityIdentifiers, len(entities))
+	for i, entity := range entities {
+		identifiers[i] = entityIdentifiers(entityType, entity.ID)
+	}
+
+	return identifiers, nil
+}
+
+func entityIdentifiers(entityType, id string) *ttnpb.EntityIdentifiers {
+	switch entityType {
+	case "application":
+		return ttnpb.ApplicationIdentifiers{ApplicationID: id}.EntityIdentifiers()
+	case "client":
+		return ttnpb.ClientIdentifiers{ClientID: id}.EntityIdentifiers()
+	case "gateway":
+		return ttnpb.GatewayIdentifiers{GatewayID: id}.EntityIdentifiers()
+	case "organization":
+		return ttnpb.OrganizationIdentifiers{OrganizationID: id}.EntityIdentifiers()
+	case "user":
+		return ttnpb.UserIdentifiers{UserID: id}.EntityIdentifiers()
+	default:
+		panic(fmt.Sprintf("can't find identifiers for entity type %s", entityType))
+	}
+}
@@ -55,6 +55,8 @@ const (
 	temporaryPasswordCreatedAtField     = "temporary_password_created_at"
 	temporaryPasswordExpiresAtField     = "temporary_password_expires_at"
 	temporaryPasswordField              = "temporary_password"
+	udpAllowedIPsField                  = "udp_allowed_ips"
+	udpTokenField                       = "udp_token"
 	updateChannelField                  = "update_channel"
 	versionIDsField                     = "version_ids"
 )
@@ -18,6 +18,7 @@ import (
 	"sort"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/lib/pq"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -56,6 +57,9 @@ type Gateway struct {
 	EnforceDutyCycle       bool `gorm:"not null"`
 	DownlinkPathConstraint int
 
+	UDPAllowedIPs pq.StringArray `gorm:"type:VARCHAR ARRAY;column:udp_allowed_ips"`
+	UDPToken      string         `gorm:"type:VARCHAR;column:udp_token"`
+
 	Antennas []GatewayAntenna
 }
 
@@ -92,6 +96,8 @@ var gatewayPBSetters = map[string]func(*ttnpb.Gateway, *Gateway){
 	downlinkPathConstraintField: func(pb *ttnpb.Gateway, gtw *Gateway) {
 		pb.DownlinkPathConstraint = ttnpb.DownlinkPathConstraint(gtw.DownlinkPathConstraint)
 	},
+	udpAllowedIPsField: func(pb *ttnpb.Gateway, gtw *Gateway) { pb.UDPAllowedIPs = gtw.UDPAllowedIPs },
+	udpTokenField:      func(pb *ttnpb.Gateway, gtw *Gateway) { pb.UDPToken = gtw.UDPToken },
 	antennasField: func(pb *ttnpb.Gateway, gtw *Gateway) {
 		sort.Slice(gtw.Antennas, func(i int, j int) bool { return gtw.Antennas[i].Index < gtw.Antennas[j].Index })
 		pb.Antennas = make([]ttnpb.GatewayAntenna, len(gtw.Antennas))
@@ -128,6 +134,8 @@ var gatewayModelSetters = map[string]func(*Gateway, *ttnpb.Gateway){
 	scheduleDownlinkLateField:   func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.ScheduleDownlinkLate = pb.ScheduleDownlinkLate },
 	enforceDutyCycleField:       func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.EnforceDutyCycle = pb.EnforceDutyCycle },
 	downlinkPathConstraintField: func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.DownlinkPathConstraint = int(pb.DownlinkPathConstraint) },
+	udpAllowedIPsField:          func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.UDPAllowedIPs = pq.StringArray(pb.UDPAllowedIPs) },
+	udpTokenField:               func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.UDPToken = pb.UDPToken },
 	antennasField: func(gtw *Gateway, pb *ttnpb.Gateway) {
 		sort.Slice(gtw.Antennas, func(i int, j int) bool { return gtw.Antennas[i].Index < gtw.Antennas[j].Index })
 		antennas := make([]GatewayAntenna, len(pb.Antennas))
@@ -174,6 +182,8 @@ var gatewayColumnNames = map[string][]string{
 	scheduleDownlinkLateField:   {scheduleDownlinkLateField},
 	enforceDutyCycleField:       {enforceDutyCycleField},
 	downlinkPathConstraintField: {downlinkPathConstraintField},
+	udpAllowedIPsField:          {udpAllowedIPsField},
+	udpTokenField:               {udpTokenField},
 	antennasField:               {},
 }
 
@@ -162,3 +162,11 @@ func (s *gatewayStore) UpdateGateway(ctx context.Context, gtw *ttnpb.Gateway, fi
 func (s *gatewayStore) DeleteGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error {
 	return deleteEntity(ctx, s.db, id.EntityIdentifiers())
 }
+
+func (s *gatewayStore) RestoreGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error {
+	return restoreEntity(ctx, s.db, id.EntityIdentifiers())
+}
+
+func (s *gatewayStore) PurgeGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error {
+	return purgeEntity(ctx, s.db, id.EntityIdentifiers())
+}
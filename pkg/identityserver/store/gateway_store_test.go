@@ -33,7 +33,7 @@ func TestGatewayStore(t *testing.T) {
 	ctx := test.Context()
 
 	WithDB(t, func(t *testing.T, db *gorm.DB) {
-		prepareTest(db, &Gateway{}, &GatewayAntenna{}, &Attribute{})
+		prepareTest(db, &Gateway{}, &GatewayAntenna{}, &Attribute{}, &APIKey{}, &Membership{}, &ContactInfo{})
 		store := GetGatewayStore(db)
 
 		created, err := store.CreateGateway(ctx, &ttnpb.Gateway{
@@ -145,5 +145,36 @@ func TestGatewayStore(t *testing.T) {
 		list, err = store.FindGateways(ctx, nil, nil)
 		a.So(err, should.BeNil)
 		a.So(list, should.BeEmpty)
+
+		_, err = store.CreateGateway(ctx, &ttnpb.Gateway{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "foo"},
+		})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsAlreadyExists(err), should.BeTrue)
+		}
+
+		err = store.RestoreGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"})
+		a.So(err, should.BeNil)
+
+		got, err = store.GetGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"}, nil)
+		a.So(err, should.BeNil)
+
+		err = store.RestoreGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.PurgeGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"})
+		a.So(err, should.BeNil)
+
+		err = store.RestoreGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		_, err = store.CreateGateway(ctx, &ttnpb.Gateway{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "foo"},
+		})
+		a.So(err, should.BeNil)
 	})
 }
@@ -35,6 +35,8 @@ type Organization struct {
 	APIKeys     []APIKey     `gorm:"polymorphic:Entity;polymorphic_value:organization"`
 	Memberships []Membership `gorm:"polymorphic:Entity;polymorphic_value:organization"`
 	// END common fields
+
+	State int `gorm:"not null;default:1"`
 }
 
 func init() {
@@ -52,6 +54,7 @@ var organizationPBSetters = map[string]func(*ttnpb.Organization, *Organization){
 	nameField:        func(pb *ttnpb.Organization, org *Organization) { pb.Name = org.Name },
 	descriptionField: func(pb *ttnpb.Organization, org *Organization) { pb.Description = org.Description },
 	attributesField:  func(pb *ttnpb.Organization, org *Organization) { pb.Attributes = attributes(org.Attributes).toMap() },
+	stateField:       func(pb *ttnpb.Organization, org *Organization) { pb.State = ttnpb.State(org.State) },
 }
 
 // functions to set fields from the organization proto into the organization model.
@@ -61,6 +64,7 @@ var organizationModelSetters = map[string]func(*Organization, *ttnpb.Organizatio
 	attributesField: func(org *Organization, pb *ttnpb.Organization) {
 		org.Attributes = attributes(org.Attributes).updateFromMap(pb.Attributes)
 	},
+	stateField: func(org *Organization, pb *ttnpb.Organization) { org.State = int(pb.State) },
 }
 
 // fieldMask to use if a nil or empty fieldmask is passed.
@@ -80,6 +84,7 @@ var organizationColumnNames = map[string][]string{
 	contactInfoField: {},
 	nameField:        {nameField},
 	descriptionField: {descriptionField},
+	stateField:       {stateField},
 }
 
 func (org Organization) toPB(pb *ttnpb.Organization, fieldMask *types.FieldMask) {
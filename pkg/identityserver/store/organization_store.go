@@ -167,3 +167,11 @@ func (s *organizationStore) DeleteOrganization(ctx context.Context, id *ttnpb.Or
 	}
 	return s.db.Delete(&orgModel).Error
 }
+
+func (s *organizationStore) RestoreOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error {
+	return restoreEntity(ctx, s.db, id.EntityIdentifiers())
+}
+
+func (s *organizationStore) PurgeOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error {
+	return purgeEntity(ctx, s.db, id.EntityIdentifiers())
+}
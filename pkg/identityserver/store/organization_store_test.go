@@ -32,7 +32,7 @@ func TestOrganizationStore(t *testing.T) {
 	ctx := test.Context()
 
 	WithDB(t, func(t *testing.T, db *gorm.DB) {
-		prepareTest(db, &Account{}, &Organization{}, &Attribute{})
+		prepareTest(db, &Account{}, &Organization{}, &Attribute{}, &APIKey{}, &Membership{}, &ContactInfo{})
 		store := GetOrganizationStore(db)
 
 		created, err := store.CreateOrganization(ctx, &ttnpb.Organization{
@@ -112,5 +112,35 @@ func TestOrganizationStore(t *testing.T) {
 		a.So(err, should.BeNil)
 		a.So(list, should.BeEmpty)
 
+		_, err = store.CreateOrganization(ctx, &ttnpb.Organization{
+			OrganizationIdentifiers: ttnpb.OrganizationIdentifiers{OrganizationID: "foo"},
+		})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsAlreadyExists(err), should.BeTrue)
+		}
+
+		err = store.RestoreOrganization(ctx, &ttnpb.OrganizationIdentifiers{OrganizationID: "foo"})
+		a.So(err, should.BeNil)
+
+		got, err = store.GetOrganization(ctx, &ttnpb.OrganizationIdentifiers{OrganizationID: "foo"}, nil)
+		a.So(err, should.BeNil)
+
+		err = store.RestoreOrganization(ctx, &ttnpb.OrganizationIdentifiers{OrganizationID: "foo"})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.PurgeOrganization(ctx, &ttnpb.OrganizationIdentifiers{OrganizationID: "foo"})
+		a.So(err, should.BeNil)
+
+		err = store.RestoreOrganization(ctx, &ttnpb.OrganizationIdentifiers{OrganizationID: "foo"})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		_, err = store.CreateOrganization(ctx, &ttnpb.Organization{
+			OrganizationIdentifiers: ttnpb.OrganizationIdentifiers{OrganizationID: "foo"},
+		})
+		a.So(err, should.BeNil)
 	})
 }
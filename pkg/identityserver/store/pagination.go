@@ -19,6 +19,7 @@ import (
 
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"google.golang.org/grpc/metadata"
 )
 
 type totalKeyType struct{}
@@ -31,6 +32,19 @@ func SetTotalCount(ctx context.Context, total *uint64) context.Context {
 	return context.WithValue(ctx, totalKey, total)
 }
 
+// WithoutPagination returns a context in which List operations are not paginated.
+// This is used to find the entities of identifiers that were already paginated.
+func WithoutPagination(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	md = md.Copy()
+	delete(md, "limit")
+	delete(md, "page")
+	return metadata.NewIncomingContext(ctx, md)
+}
+
 func limitAndOffsetFromContext(ctx context.Context) (limit uint64, offset uint64) {
 	md := rpcmetadata.FromIncomingContext(ctx)
 	offset = (md.Page - 1) * md.Limit
@@ -0,0 +1,53 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import "go.thethings.network/lorawan-stack/pkg/ttnpb"
+
+// Review model.
+type Review struct {
+	Model
+
+	EntityID   string `gorm:"type:UUID;index:review_entity_index;not null"`
+	EntityType string `gorm:"type:VARCHAR(32);index:review_entity_index;not null"`
+
+	State      int    `gorm:"not null"`
+	Reason     string `gorm:"type:TEXT"`
+	ReviewerID string `gorm:"type:VARCHAR(36)"`
+}
+
+func init() {
+	registerModel(&Review{})
+}
+
+func (r Review) toPB() *ttnpb.ReviewRecord {
+	pb := &ttnpb.ReviewRecord{
+		State:      ttnpb.State(r.State),
+		Reason:     r.Reason,
+		ReviewedAt: cleanTimePtr(&r.CreatedAt),
+	}
+	if r.ReviewerID != "" {
+		pb.ReviewerIDs = &ttnpb.UserIdentifiers{UserID: r.ReviewerID}
+	}
+	return pb
+}
+
+func (r *Review) fromPB(pb *ttnpb.ReviewRecord) {
+	r.State = int(pb.State)
+	r.Reason = pb.Reason
+	if pb.ReviewerIDs != nil {
+		r.ReviewerID = pb.ReviewerIDs.UserID
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// GetReviewStore returns an ReviewStore on the given db (or transaction).
+func GetReviewStore(db *gorm.DB) ReviewStore {
+	return &reviewStore{db: db}
+}
+
+type reviewStore struct {
+	db *gorm.DB
+}
+
+var errReviewEntityType = errors.DefineInvalidArgument("review_entity_type", "can not review entity of type `{entity_type}`")
+
+func (s *reviewStore) ReviewEntity(ctx context.Context, entityID *ttnpb.EntityIdentifiers, review *ttnpb.ReviewRecord) (*ttnpb.ReviewRecord, error) {
+	entityType := entityTypeForID(entityID)
+	switch entityType {
+	case "application", "client", "organization", "user":
+	default:
+		return nil, errReviewEntityType.WithAttributes("entity_type", entityType)
+	}
+	entity, err := findEntity(ctx, s.db, entityID, "id")
+	if err != nil {
+		return nil, err
+	}
+	if err = s.db.Model(entity).Update("state", int(review.State)).Error; err != nil {
+		return nil, err
+	}
+	model := &Review{
+		EntityType: entityType,
+		EntityID:   entity.PrimaryKey(),
+	}
+	model.fromPB(review)
+	model.SetContext(ctx)
+	if err = s.db.Create(model).Error; err != nil {
+		return nil, err
+	}
+	return model.toPB(), nil
+}
+
+func (s *reviewStore) FindReviews(ctx context.Context, entityID *ttnpb.EntityIdentifiers) ([]*ttnpb.ReviewRecord, error) {
+	entity, err := findEntity(ctx, s.db, entityID, "id")
+	if err != nil {
+		return nil, err
+	}
+	var models []Review
+	err = s.db.Where(Review{
+		EntityType: entityTypeForID(entityID),
+		EntityID:   entity.PrimaryKey(),
+	}).Order("created_at").Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	pb := make([]*ttnpb.ReviewRecord, len(models))
+	for i, model := range models {
+		pb[i] = model.toPB()
+	}
+	return pb, nil
+}
@@ -0,0 +1,116 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestReviewStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db, &Review{}, &Application{}, &Gateway{})
+
+		appStore := GetApplicationStore(db)
+
+		app, err := appStore.CreateApplication(ctx, &ttnpb.Application{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo"},
+		})
+		a.So(err, should.BeNil)
+		a.So(app.State, should.Equal, ttnpb.STATE_APPROVED)
+
+		s := GetReviewStore(db)
+		search := GetEntitySearch(db)
+
+		reviews, err := s.FindReviews(ctx, app.EntityIdentifiers())
+		a.So(err, should.BeNil)
+		a.So(reviews, should.BeEmpty)
+
+		review, err := s.ReviewEntity(ctx, app.EntityIdentifiers(), &ttnpb.ReviewRecord{
+			State:       ttnpb.STATE_FLAGGED,
+			Reason:      "suspicious traffic",
+			ReviewerIDs: &ttnpb.UserIdentifiers{UserID: "admin"},
+		})
+		a.So(err, should.BeNil)
+		a.So(review.State, should.Equal, ttnpb.STATE_FLAGGED)
+		a.So(review.ReviewedAt, should.NotBeNil)
+
+		ids, err := search.FindEntitiesInState(ctx, "application", ttnpb.STATE_FLAGGED)
+		a.So(err, should.BeNil)
+		if a.So(ids, should.HaveLength, 1) {
+			a.So(ids[0].GetApplicationIDs().GetApplicationID(), should.Equal, "foo")
+		}
+
+		_, err = s.ReviewEntity(ctx, app.EntityIdentifiers(), &ttnpb.ReviewRecord{
+			State:       ttnpb.STATE_APPROVED,
+			ReviewerIDs: &ttnpb.UserIdentifiers{UserID: "admin"},
+		})
+		a.So(err, should.BeNil)
+
+		ids, err = search.FindEntitiesInState(ctx, "application", ttnpb.STATE_FLAGGED)
+		a.So(err, should.BeNil)
+		a.So(ids, should.BeEmpty)
+
+		// Entities in the state are ordered by creation time and paginated.
+		for _, id := range []string{"qux", "bar"} {
+			created, err := appStore.CreateApplication(ctx, &ttnpb.Application{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: id},
+			})
+			a.So(err, should.BeNil)
+			_, err = s.ReviewEntity(ctx, created.EntityIdentifiers(), &ttnpb.ReviewRecord{
+				State:       ttnpb.STATE_FLAGGED,
+				ReviewerIDs: &ttnpb.UserIdentifiers{UserID: "admin"},
+			})
+			a.So(err, should.BeNil)
+		}
+		var total uint64
+		pageCtx := SetTotalCount(rpcmetadata.MD{Limit: 1, Page: 1}.ToIncomingContext(ctx), &total)
+		ids, err = search.FindEntitiesInState(pageCtx, "application", ttnpb.STATE_FLAGGED)
+		a.So(err, should.BeNil)
+		a.So(total, should.Equal, 2)
+		if a.So(ids, should.HaveLength, 1) {
+			a.So(ids[0].GetApplicationIDs().GetApplicationID(), should.Equal, "qux")
+		}
+		pageCtx = rpcmetadata.MD{Limit: 1, Page: 2}.ToIncomingContext(ctx)
+		ids, err = search.FindEntitiesInState(pageCtx, "application", ttnpb.STATE_FLAGGED)
+		a.So(err, should.BeNil)
+		if a.So(ids, should.HaveLength, 1) {
+			a.So(ids[0].GetApplicationIDs().GetApplicationID(), should.Equal, "bar")
+		}
+
+		reviews, err = s.FindReviews(ctx, app.EntityIdentifiers())
+		a.So(err, should.BeNil)
+		if a.So(reviews, should.HaveLength, 2) {
+			a.So(reviews[0].State, should.Equal, ttnpb.STATE_FLAGGED)
+			a.So(reviews[0].Reason, should.Equal, "suspicious traffic")
+			a.So(reviews[0].ReviewerIDs.GetUserID(), should.Equal, "admin")
+			a.So(reviews[1].State, should.Equal, ttnpb.STATE_APPROVED)
+		}
+
+		_, err = s.ReviewEntity(ctx, ttnpb.GatewayIdentifiers{GatewayID: "foo"}.EntityIdentifiers(), &ttnpb.ReviewRecord{
+			State: ttnpb.STATE_APPROVED,
+		})
+		a.So(err, should.NotBeNil)
+	})
+}
@@ -185,6 +185,88 @@ func deleteEntity(ctx context.Context, db *gorm.DB, entityID *ttnpb.EntityIdenti
 	return err
 }
 
+// findDeletedEntity finds the soft-deleted entity with the given identifiers.
+func findDeletedEntity(ctx context.Context, db *gorm.DB, entityID *ttnpb.EntityIdentifiers) (modelInterface, error) {
+	table := entityTypeForID(entityID) + "s"
+	query := db.Unscoped().Scopes(withContext(ctx), withID(entityID)).
+		Where(fmt.Sprintf("%s.deleted_at IS NOT NULL", table)).
+		Select(table + ".id")
+	entity := modelForID(entityID)
+	if err := query.First(entity).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errNotFoundForID(entityID)
+		}
+		return nil, convertError(err)
+	}
+	return entity, nil
+}
+
+func restoreEntity(ctx context.Context, db *gorm.DB, entityID *ttnpb.EntityIdentifiers) error {
+	entity, err := findDeletedEntity(ctx, db, entityID)
+	if err != nil {
+		return err
+	}
+	return db.Unscoped().Model(entity).UpdateColumn("deleted_at", gorm.Expr("NULL")).Error
+}
+
+// purgeEntity permanently deletes the entity, regardless of whether it was
+// (soft-)deleted before. This also deletes the attributes, API keys, memberships
+// and contact info of the entity, so that its IDs can be reused. Purging an
+// application also deletes its end devices, including their attributes and
+// locations.
+func purgeEntity(ctx context.Context, db *gorm.DB, entityID *ttnpb.EntityIdentifiers) error {
+	entity, err := findEntity(ctx, db.Unscoped(), entityID, "id")
+	if err != nil {
+		return err
+	}
+	entityType, entityUUID := entityTypeForID(entityID), entity.PrimaryKey()
+	for _, model := range []interface{}{&Attribute{}, &APIKey{}, &Membership{}, &ContactInfo{}, &Review{}} {
+		err = db.Where("entity_type = ? AND entity_id = ?", entityType, entityUUID).Delete(model).Error
+		if err != nil {
+			return err
+		}
+	}
+	switch entityType {
+	case "application":
+		var deviceUUIDs []string
+		err = db.Model(&EndDevice{}).Where(&EndDevice{ApplicationID: entityID.IDString()}).Pluck("id", &deviceUUIDs).Error
+		if err != nil {
+			return err
+		}
+		if len(deviceUUIDs) > 0 {
+			err = db.Where("entity_type = ? AND entity_id IN (?)", "device", deviceUUIDs).Delete(&Attribute{}).Error
+			if err != nil {
+				return err
+			}
+			if err = db.Where("end_device_id IN (?)", deviceUUIDs).Delete(&EndDeviceLocation{}).Error; err != nil {
+				return err
+			}
+		}
+		if err = db.Where(&EndDevice{ApplicationID: entityID.IDString()}).Delete(&EndDevice{}).Error; err != nil {
+			return err
+		}
+	case "gateway":
+		if err = db.Where(&GatewayAntenna{GatewayID: entityUUID}).Delete(&GatewayAntenna{}).Error; err != nil {
+			return err
+		}
+	case "organization":
+		var account Account
+		err = db.Unscoped().Where(&Account{AccountType: entityType, AccountID: entityUUID}).First(&account).Error
+		if err != nil && !gorm.IsRecordNotFoundError(err) {
+			return err
+		}
+		if err == nil {
+			if err = db.Where(&Membership{AccountID: account.ID}).Delete(&Membership{}).Error; err != nil {
+				return err
+			}
+			if err = db.Unscoped().Delete(&account).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return db.Unscoped().Delete(entity).Error
+}
+
 // SetLogger sets the database logger.
 func SetLogger(db *gorm.DB, log log.Interface) {
 	db.SetLogger(logger{Interface: log})
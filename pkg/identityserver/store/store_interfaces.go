@@ -16,6 +16,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -31,6 +32,8 @@ type ApplicationStore interface {
 	GetApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Application, error)
 	UpdateApplication(ctx context.Context, app *ttnpb.Application, fieldMask *types.FieldMask) (*ttnpb.Application, error)
 	DeleteApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error
+	RestoreApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error
+	PurgeApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error
 }
 
 // ClientStore interface for storing Clients.
@@ -68,6 +71,8 @@ type GatewayStore interface {
 	GetGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Gateway, error)
 	UpdateGateway(ctx context.Context, gtw *ttnpb.Gateway, fieldMask *types.FieldMask) (*ttnpb.Gateway, error)
 	DeleteGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error
+	RestoreGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error
+	PurgeGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error
 }
 
 // OrganizationStore interface for storing Organizations.
@@ -80,6 +85,8 @@ type OrganizationStore interface {
 	GetOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Organization, error)
 	UpdateOrganization(ctx context.Context, org *ttnpb.Organization, fieldMask *types.FieldMask) (*ttnpb.Organization, error)
 	DeleteOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error
+	RestoreOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error
+	PurgeOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error
 }
 
 // UserStore interface for storing Users.
@@ -112,10 +119,10 @@ type UserSessionStore interface {
 // As the operations in this store may be quite expensive, the results of FindXXX
 // operations should typically be cached. The recommended cache behavior is:
 //
-// - The results of FindMembers are cached by (entity_type,entity_id)
-// - The results of FindMemberRights are cached by (account_id,[entityType])
-// - Any (successful or unsuccessful) call to SetMember expires the caches
-//   for (entity_type,entity_id) and (account_id,[entityType]).
+//   - The results of FindMembers are cached by (entity_type,entity_id)
+//   - The results of FindMemberRights are cached by (account_id,[entityType])
+//   - Any (successful or unsuccessful) call to SetMember expires the caches
+//     for (entity_type,entity_id) and (account_id,[entityType]).
 type MembershipStore interface {
 	// Find direct members and rights of the given entity.
 	FindMembers(ctx context.Context, entityID *ttnpb.EntityIdentifiers) (map[*ttnpb.OrganizationOrUserIdentifiers]*ttnpb.Rights, error)
@@ -134,8 +141,22 @@ type APIKeyStore interface {
 	FindAPIKeys(ctx context.Context, entityID *ttnpb.EntityIdentifiers) ([]*ttnpb.APIKey, error)
 	// Get an API key by its ID.
 	GetAPIKey(ctx context.Context, id string) (*ttnpb.EntityIdentifiers, *ttnpb.APIKey, error)
-	// Update key rights on an entity. Rights can be deleted by not passing any rights, in which case the returned API key will be nil.
-	UpdateAPIKey(ctx context.Context, entityID *ttnpb.EntityIdentifiers, key *ttnpb.APIKey) (*ttnpb.APIKey, error)
+	// Update the fields of an API key of an entity that are in the field mask.
+	// The API key can be deleted by updating the rights to none, in which case the returned API key will be nil.
+	UpdateAPIKey(ctx context.Context, entityID *ttnpb.EntityIdentifiers, key *ttnpb.APIKey, fieldMask *types.FieldMask) (*ttnpb.APIKey, error)
+	// Set the time at which the API key was last used.
+	SetAPIKeyLastUsed(ctx context.Context, id string, lastUsedAt time.Time) error
+	// Find API keys that expire before the given time, and of which the entity was not yet notified.
+	// The secret values of the returned API keys are not set.
+	FindExpiringAPIKeys(ctx context.Context, before time.Time) ([]*ExpiringAPIKey, error)
+	// Set the time at which the entity was notified of the upcoming expiry of the API key.
+	SetAPIKeyExpiryNotified(ctx context.Context, id string, notifiedAt time.Time) error
+}
+
+// ExpiringAPIKey is an API key that is about to expire, along with the entity it belongs to.
+type ExpiringAPIKey struct {
+	EntityIdentifiers *ttnpb.EntityIdentifiers
+	APIKey            *ttnpb.APIKey
 }
 
 // OAuthStore interface for the OAuth server.
@@ -167,6 +188,19 @@ type InvitationStore interface {
 // EntitySearch interface for searching entities.
 type EntitySearch interface {
 	FindEntities(ctx context.Context, req *ttnpb.SearchEntitiesRequest, entityType string) ([]*ttnpb.EntityIdentifiers, error)
+	// Find entities of the given type that were deleted before the given time.
+	FindDeletedEntities(ctx context.Context, entityType string, deletedBefore time.Time) ([]*ttnpb.EntityIdentifiers, error)
+	// Find entities of the given type that are in the given state, ordered by creation time.
+	// The results are paginated with the limit and page in the context.
+	FindEntitiesInState(ctx context.Context, entityType string, state ttnpb.State) ([]*ttnpb.EntityIdentifiers, error)
+}
+
+// ReviewStore interface for reviewing entities.
+type ReviewStore interface {
+	// Set the state of the entity and add the review to its review history.
+	ReviewEntity(ctx context.Context, entityID *ttnpb.EntityIdentifiers, review *ttnpb.ReviewRecord) (*ttnpb.ReviewRecord, error)
+	// Find the review history of the entity, oldest first.
+	FindReviews(ctx context.Context, entityID *ttnpb.EntityIdentifiers) ([]*ttnpb.ReviewRecord, error)
 }
 
 // ContactInfoStore interface for contact info validation.
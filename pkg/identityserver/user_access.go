@@ -26,9 +26,10 @@ import (
 )
 
 var (
-	evtCreateUserAPIKey = events.Define("user.api-key.create", "Create user API key")
-	evtUpdateUserAPIKey = events.Define("user.api-key.update", "Update user API key")
-	evtDeleteUserAPIKey = events.Define("user.api-key.delete", "Delete user API key")
+	evtCreateUserAPIKey   = events.Define("user.api-key.create", "Create user API key")
+	evtUpdateUserAPIKey   = events.Define("user.api-key.update", "Update user API key")
+	evtDeleteUserAPIKey   = events.Define("user.api-key.delete", "Delete user API key")
+	evtExpiringUserAPIKey = events.Define("user.api-key.expiring", "User API key expiring")
 )
 
 func (is *IdentityServer) listUserRights(ctx context.Context, ids *ttnpb.UserIdentifiers) (*ttnpb.Rights, error) {
@@ -52,7 +53,10 @@ func (is *IdentityServer) createUserAPIKey(ctx context.Context, req *ttnpb.Creat
 	if err = rights.RequireUser(ctx, req.UserIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
-	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
+	if err = validateAPIKeyRestrictions(req.ExpiresAt, req.AllowedIPs); err != nil {
+		return nil, err
+	}
+	key, token, err := generateAPIKey(ctx, req.Name, req.ExpiresAt, req.AllowedIPs, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
@@ -95,8 +99,15 @@ func (is *IdentityServer) updateUserAPIKey(ctx context.Context, req *ttnpb.Updat
 	if err = rights.RequireUser(ctx, req.UserIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
+	req.FieldMask.Paths = apiKeyUpdatePaths(req.FieldMask.Paths)
+	deleteKey := isAPIKeyDeletion(&req.APIKey, req.FieldMask.Paths)
+	if !deleteKey {
+		if err = validateAPIKeyUpdate(&req.APIKey, req.FieldMask.Paths); err != nil {
+			return nil, err
+		}
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.UserIdentifiers.EntityIdentifiers(), &req.APIKey)
+		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.UserIdentifiers.EntityIdentifiers(), &req.APIKey, &req.FieldMask)
 		return err
 	})
 	if err != nil {
@@ -106,7 +117,7 @@ func (is *IdentityServer) updateUserAPIKey(ctx context.Context, req *ttnpb.Updat
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	if len(req.Rights) > 0 {
+	if !deleteKey {
 		events.Publish(evtUpdateUserAPIKey(ctx, req.UserIdentifiers, nil))
 		// TODO: Send notification email (https://github.com/TheThingsNetwork/lorawan-stack/issues/72).
 	} else {
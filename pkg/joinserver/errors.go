@@ -24,13 +24,16 @@ var (
 	errDeriveNwkSKeys            = errors.Define("derive_nwk_s_keys", "failed to derive network session keys")
 	errDevNonceTooHigh           = errors.DefineInvalidArgument("dev_nonce_too_high", "DevNonce is too high")
 	errDevNonceTooSmall          = errors.DefineInvalidArgument("dev_nonce_too_small", "DevNonce is too small")
+	errDeviceRegistered          = errors.DefineFailedPrecondition("device_registered", "device `{device_uid}` is registered in the Entity Registry")
 	errDuplicateIdentifiers      = errors.DefineAlreadyExists("duplicate_identifiers", "a device identified by the identifiers already exists")
 	errEncodePayload             = errors.DefineInvalidArgument("encode_payload", "failed to encode payload")
 	errEncryptPayload            = errors.Define("encrypt_payload", "failed to encrypt JoinAccept")
 	errEndDeviceRequest          = errors.DefineInvalidArgument("end_device_request", "GetEndDeviceRequest is invalid")
+	errEntityRegistryNotFound    = errors.DefineNotFound("entity_registry_not_found", "Entity Registry not found")
 	errForwardJoinRequest        = errors.Define("forward_join_request", "failed to forward JoinRequest")
 	errGenerateSessionKeyID      = errors.Define("generate_session_key_id", "failed to generate session key ID")
 	errDeviceNotFound            = errors.DefineNotFound("device_not_found", "device not found")
+	errIncompleteListing         = errors.DefineUnavailable("incomplete_listing", "listed {listed} of {total} devices")
 	errInvalidIdentifiers        = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
 	errJoinNonceTooHigh          = errors.Define("join_nonce_too_high", "JoinNonce is too high")
 	errMICMismatch               = errors.DefineInvalidArgument("mic_mismatch", "MIC mismatch")
@@ -19,6 +19,7 @@ import (
 	"encoding/binary"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoservices"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
@@ -266,7 +267,16 @@ func (srv jsEndDeviceRegistryServer) Delete(ctx context.Context, ids *ttnpb.EndD
 	if ids.DevEUI == nil || ids.DevEUI.IsZero() {
 		return nil, errNoDevEUI
 	}
-	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+	// The Identity Server deletes end devices from the cluster when they are deleted from the Entity Registry.
+	// Cluster peers can therefore only delete end devices that no longer exist in the Entity Registry.
+	if clusterauth.HasAuth(ctx) {
+		if err := clusterauth.Authorized(ctx); err != nil {
+			return nil, err
+		}
+		if err := srv.JS.requireDeletedFromEntityRegistry(ctx, *ids); err != nil {
+			return nil, err
+		}
+	} else if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
 	_, err := srv.JS.devices.SetByEUI(ctx, *ids.JoinEUI, *ids.DevEUI, nil, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
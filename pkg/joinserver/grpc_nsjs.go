@@ -40,6 +40,8 @@ var supportedMACVersions = [...]ttnpb.MACVersion{
 	ttnpb.MAC_V1_0,
 	ttnpb.MAC_V1_0_1,
 	ttnpb.MAC_V1_0_2,
+	ttnpb.MAC_V1_0_3,
+	ttnpb.MAC_V1_0_4,
 	ttnpb.MAC_V1_1,
 }
 
@@ -117,7 +119,7 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 		return nil, errForwardJoinRequest
 	}
 
-	dev, err := srv.JS.devices.SetByEUI(ctx, pld.JoinEUI, pld.DevEUI,
+	dev, err := SetDeviceWithRetry(ctx, srv.JS.devices, srv.JS.deviceRetry, pld.JoinEUI, pld.DevEUI,
 		[]string{
 			"last_dev_nonce",
 			"last_join_nonce",
@@ -132,7 +134,7 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 
 			dn := uint32(binary.BigEndian.Uint16(pld.DevNonce[:]))
 			switch req.SelectedMACVersion {
-			case ttnpb.MAC_V1_1:
+			case ttnpb.MAC_V1_0_4, ttnpb.MAC_V1_1:
 				if (dn != 0 || dev.LastDevNonce != 0 || dev.LastJoinNonce != 0) && !dev.ResetsJoinNonces {
 					if dn <= dev.LastDevNonce {
 						return nil, nil, errDevNonceTooSmall
@@ -143,7 +145,7 @@ func (srv nsJsServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 				}
 				dev.LastDevNonce = dn
 				paths = append(paths, "last_dev_nonce")
-			case ttnpb.MAC_V1_0, ttnpb.MAC_V1_0_1, ttnpb.MAC_V1_0_2:
+			case ttnpb.MAC_V1_0, ttnpb.MAC_V1_0_1, ttnpb.MAC_V1_0_2, ttnpb.MAC_V1_0_3:
 				i := sort.Search(len(dev.UsedDevNonces), func(i int) bool { return dev.UsedDevNonces[i] >= dn })
 				if i < len(dev.UsedDevNonces) && dev.UsedDevNonces[i] == dn {
 					return nil, nil, errReuseDevNonce
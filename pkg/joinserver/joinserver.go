@@ -25,6 +25,7 @@ import (
 	"github.com/oklog/ulid"
 	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/retry"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
@@ -33,9 +34,11 @@ import (
 
 // Config represents the JoinServer configuration.
 type Config struct {
-	Devices         DeviceRegistry       `name:"-"`
-	Keys            KeyRegistry          `name:"-"`
-	JoinEUIPrefixes []*types.EUI64Prefix `name:"join-eui-prefix" description:"JoinEUI prefixes handled by this JS"`
+	Devices                      DeviceRegistry       `name:"-"`
+	Keys                         KeyRegistry          `name:"-"`
+	DeviceRegistryRetry          retry.Config         `name:"device-registry-retry" description:"Retries of device registry transactions aborted due to concurrent modifications"`
+	JoinEUIPrefixes              []*types.EUI64Prefix `name:"join-eui-prefix" description:"JoinEUI prefixes handled by this JS"`
+	DeviceReconciliationInterval time.Duration        `name:"device-reconciliation-interval" description:"Interval in which devices that no longer exist in the Entity Registry are deleted (0 means never)"`
 }
 
 // JoinServer implements the Join Server component.
@@ -44,8 +47,9 @@ type Config struct {
 type JoinServer struct {
 	*component.Component
 
-	devices DeviceRegistry
-	keys    KeyRegistry
+	devices     DeviceRegistry
+	deviceRetry retry.Config
+	keys        KeyRegistry
 
 	euiPrefixes []*types.EUI64Prefix
 
@@ -64,8 +68,9 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 	js := &JoinServer{
 		Component: c,
 
-		devices: conf.Devices,
-		keys:    conf.Keys,
+		devices:     conf.Devices,
+		deviceRetry: conf.DeviceRegistryRetry.WithDefaults(),
+		keys:        conf.Keys,
 
 		euiPrefixes: conf.JoinEUIPrefixes,
 
@@ -80,6 +85,11 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 	// TODO: Support authentication from non-cluster-local NS and AS (https://github.com/TheThingsNetwork/lorawan-stack/issues/4).
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsJs", cluster.HookName, c.ClusterAuthUnaryHook())
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsJs", cluster.HookName, c.ClusterAuthUnaryHook())
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.JsEndDeviceRegistry", cluster.HookName, c.ClusterAuthUnaryHook())
+
+	if conf.DeviceReconciliationInterval > 0 {
+		c.RegisterTask("reconcile_devices", js.reconcileDevicesTask(conf.DeviceReconciliationInterval), component.TaskRestartOnFailure)
+	}
 
 	c.RegisterGRPC(js)
 	return js, nil
@@ -40,6 +40,7 @@ type JsDeviceServer = jsEndDeviceRegistryServer
 
 type MockDeviceRegistry struct {
 	GetByEUIFunc func(context.Context, types.EUI64, types.EUI64, []string) (*ttnpb.EndDevice, error)
+	RangeFunc    func(context.Context, []string, func(*ttnpb.EndDevice) bool) error
 	SetByEUIFunc func(context.Context, types.EUI64, types.EUI64, []string, func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error)
 }
 
@@ -50,6 +51,13 @@ func (r *MockDeviceRegistry) GetByEUI(ctx context.Context, joinEUI types.EUI64,
 	return r.GetByEUIFunc(ctx, joinEUI, devEUI, paths)
 }
 
+func (r *MockDeviceRegistry) Range(ctx context.Context, paths []string, f func(*ttnpb.EndDevice) bool) error {
+	if r.RangeFunc == nil {
+		return errors.New("Not implemented")
+	}
+	return r.RangeFunc(ctx, paths, f)
+}
+
 func (r *MockDeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devEUI types.EUI64, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
 	if r.SetByEUIFunc == nil {
 		return nil, errors.New("Not implemented")
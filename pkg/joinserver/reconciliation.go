@@ -0,0 +1,161 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// listRegisteredDevices lists the devices of the application in the Entity Registry.
+// The listing is only returned if it is complete, so that devices are not considered orphaned
+// because they are missing from a partial listing.
+func (js *JoinServer) listRegisteredDevices(ctx context.Context, cl ttnpb.EndDeviceRegistryClient, appID ttnpb.ApplicationIdentifiers) ([]*ttnpb.EndDevice, error) {
+	var header metadata.MD
+	res, err := cl.List(ctx, &ttnpb.ListEndDevicesRequest{
+		ApplicationIdentifiers: appID,
+		FieldMask:              pbtypes.FieldMask{Paths: []string{"ids"}},
+	}, js.WithClusterAuth(), grpc.Header(&header))
+	if err != nil {
+		return nil, err
+	}
+	if totalHeader := header.Get("x-total-count"); len(totalHeader) > 0 {
+		total, err := strconv.ParseUint(totalHeader[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(res.EndDevices)) != total {
+			return nil, errIncompleteListing.WithAttributes("listed", len(res.EndDevices), "total", total)
+		}
+	}
+	return res.EndDevices, nil
+}
+
+// registeredWithEUIs returns whether the device has the same EUIs as the given identifiers.
+func registeredWithEUIs(dev *ttnpb.EndDevice, ids ttnpb.EndDeviceIdentifiers) bool {
+	return dev.JoinEUI != nil && dev.JoinEUI.Equal(*ids.JoinEUI) &&
+		dev.DevEUI != nil && dev.DevEUI.Equal(*ids.DevEUI)
+}
+
+// requireDeletedFromEntityRegistry returns an error if the device still exists with the same EUIs in the Entity Registry.
+func (js *JoinServer) requireDeletedFromEntityRegistry(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error {
+	er := js.GetPeer(ctx, ttnpb.PeerInfo_ENTITY_REGISTRY, nil)
+	if er == nil {
+		return errEntityRegistryNotFound
+	}
+	dev, err := ttnpb.NewEndDeviceRegistryClient(er.Conn()).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+		FieldMask:            pbtypes.FieldMask{Paths: []string{"ids"}},
+	}, js.WithClusterAuth())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if registeredWithEUIs(dev, ids) {
+		return errDeviceRegistered.WithAttributes("device_uid", unique.ID(ctx, ids))
+	}
+	return nil
+}
+
+// deleteOrphanedDevices deletes the devices that are no longer registered in the Entity Registry.
+// A device is considered orphaned if the Entity Registry has no device with the same identifiers
+// and EUIs in the application the device belongs to, in a complete listing of the devices of the application.
+// Devices are only deleted after the Entity Registry confirms that they do not exist.
+func (js *JoinServer) deleteOrphanedDevices(ctx context.Context) error {
+	er := js.GetPeer(ctx, ttnpb.PeerInfo_ENTITY_REGISTRY, nil)
+	if er == nil {
+		return errEntityRegistryNotFound
+	}
+	logger := log.FromContext(ctx)
+
+	devsByApp := make(map[ttnpb.ApplicationIdentifiers][]ttnpb.EndDeviceIdentifiers)
+	err := js.devices.Range(ctx, []string{"ids"}, func(dev *ttnpb.EndDevice) bool {
+		if dev.ApplicationID == "" || dev.JoinEUI == nil || dev.DevEUI == nil {
+			return true
+		}
+		devsByApp[dev.ApplicationIdentifiers] = append(devsByApp[dev.ApplicationIdentifiers], dev.EndDeviceIdentifiers)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	cl := ttnpb.NewEndDeviceRegistryClient(er.Conn())
+	for appID, devs := range devsByApp {
+		logger := logger.WithField("application_uid", unique.ID(ctx, appID))
+		registered, err := js.listRegisteredDevices(ctx, cl, appID)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to list devices in Entity Registry")
+			continue
+		}
+		var orphaned []ttnpb.EndDeviceIdentifiers
+	outer:
+		for _, ids := range devs {
+			for _, dev := range registered {
+				if dev.DeviceID == ids.DeviceID && registeredWithEUIs(dev, ids) {
+					continue outer
+				}
+			}
+			orphaned = append(orphaned, ids)
+		}
+		if len(orphaned) == 0 {
+			continue
+		}
+		logger.WithField("count", len(orphaned)).Info("Found orphaned devices")
+		for _, ids := range orphaned {
+			logger := logger.WithFields(log.Fields(
+				"device_uid", unique.ID(ctx, ids),
+				"join_eui", *ids.JoinEUI,
+				"dev_eui", *ids.DevEUI,
+			))
+			if err := js.requireDeletedFromEntityRegistry(ctx, ids); err != nil {
+				logger.WithError(err).Warn("Failed to confirm that orphaned device is deleted from Entity Registry")
+				continue
+			}
+			if err := DeleteDevice(ctx, js.devices, *ids.JoinEUI, *ids.DevEUI); err != nil {
+				logger.WithError(err).Warn("Failed to delete orphaned device")
+				continue
+			}
+			logger.Info("Deleted orphaned device")
+		}
+	}
+	return nil
+}
+
+func (js *JoinServer) reconcileDevicesTask(interval time.Duration) func(context.Context) error {
+	return func(ctx context.Context) error {
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+			if err := js.deleteOrphanedDevices(ctx); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to delete orphaned devices")
+			}
+		}
+	}
+}
@@ -17,9 +17,11 @@ package redis
 import (
 	"context"
 	"encoding/base64"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -30,6 +32,8 @@ var (
 	errInvalidIdentifiers = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
 )
 
+const allKey = "all"
+
 func applyDeviceFieldMask(dst, src *ttnpb.EndDevice, paths ...string) (*ttnpb.EndDevice, error) {
 	if dst == nil {
 		dst = &ttnpb.EndDevice{}
@@ -59,13 +63,37 @@ func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI types.EUI64, devE
 	return applyDeviceFieldMask(&ttnpb.EndDevice{}, pb, paths...)
 }
 
+// Init adds the devices that were stored before the index of all devices was introduced to that index.
+func (r *DeviceRegistry) Init() error {
+	prefix := r.Redis.Key("")
+	return ttnredis.AddKeysToSet(r.Redis, r.Redis.Key(allKey), r.Redis.Key("*"), func(k string) (string, bool) {
+		euis := strings.TrimPrefix(k, prefix)
+		return euis, euis != allKey
+	})
+}
+
+// Range ranges over all devices.
+func (r *DeviceRegistry) Range(ctx context.Context, paths []string, f func(*ttnpb.EndDevice) bool) error {
+	return ttnredis.FindProtos(r.Redis, r.Redis.Key(allKey), r.Redis.Key).Range(func() (proto.Message, func() (bool, error)) {
+		pb := &ttnpb.EndDevice{}
+		return pb, func() (bool, error) {
+			pb, err := applyDeviceFieldMask(nil, pb, paths...)
+			if err != nil {
+				return false, err
+			}
+			return f(pb), nil
+		}
+	})
+}
+
 // SetByEUI sets device by joinEUI, devEUI.
 func (r *DeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devEUI types.EUI64, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
 	if joinEUI.IsZero() || devEUI.IsZero() {
 		return nil, errInvalidIdentifiers
 	}
 
-	k := r.Redis.Key(joinEUI.String(), devEUI.String())
+	euis := ttnredis.Key(joinEUI.String(), devEUI.String())
+	k := r.Redis.Key(euis)
 
 	var pb *ttnpb.EndDevice
 	err := r.Redis.Watch(func(tx *redis.Tx) error {
@@ -97,6 +125,7 @@ func (r *DeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devE
 		if pb == nil {
 			f = func(p redis.Pipeliner) error {
 				p.Del(k)
+				p.SRem(r.Redis.Key(allKey), euis)
 				return nil
 			}
 		} else {
@@ -121,8 +150,11 @@ func (r *DeviceRegistry) SetByEUI(ctx context.Context, joinEUI types.EUI64, devE
 				return err
 			}
 			f = func(p redis.Pipeliner) error {
-				_, err := ttnredis.SetProto(p, k, stored, 0)
-				return err
+				if _, err := ttnredis.SetProto(p, k, stored, 0); err != nil {
+					return err
+				}
+				p.SAdd(r.Redis.Key(allKey), euis)
+				return nil
 			}
 		}
 
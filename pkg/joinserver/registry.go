@@ -17,6 +17,7 @@ package joinserver
 import (
 	"context"
 
+	"go.thethings.network/lorawan-stack/pkg/retry"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
 )
@@ -24,9 +25,22 @@ import (
 // DeviceRegistry is a registry, containing devices.
 type DeviceRegistry interface {
 	GetByEUI(ctx context.Context, joinEUI types.EUI64, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error)
+	Range(ctx context.Context, paths []string, f func(*ttnpb.EndDevice) bool) error
 	SetByEUI(ctx context.Context, joinEUI types.EUI64, devEUI types.EUI64, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error)
 }
 
+// SetDeviceWithRetry calls r.SetByEUI and retries the transaction according to conf if it is aborted due to
+// a concurrent modification of the device. As f may be called multiple times, it must not have side effects
+// that cannot be repeated.
+func SetDeviceWithRetry(ctx context.Context, r DeviceRegistry, conf retry.Config, joinEUI types.EUI64, devEUI types.EUI64, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+	var dev *ttnpb.EndDevice
+	err := retry.Transaction(ctx, "js_device_registry", conf, func() (err error) {
+		dev, err = r.SetByEUI(ctx, joinEUI, devEUI, paths, f)
+		return err
+	})
+	return dev, err
+}
+
 // DeleteDevice deletes device identified by joinEUI, devEUI from r.
 func DeleteDevice(ctx context.Context, r DeviceRegistry, joinEUI types.EUI64, devEUI types.EUI64) error {
 	_, err := r.SetByEUI(ctx, joinEUI, devEUI, ttnpb.EndDeviceFieldPathsTopLevel, func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) { return nil, nil, nil })
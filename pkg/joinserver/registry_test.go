@@ -24,6 +24,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	. "go.thethings.network/lorawan-stack/pkg/joinserver"
 	"go.thethings.network/lorawan-stack/pkg/joinserver/redis"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -94,6 +95,14 @@ func handleDeviceRegistryTest(t *testing.T, reg DeviceRegistry) {
 	a.So(err, should.BeNil)
 	a.So(ret, should.HaveEmptyDiff, pbOther)
 
+	var rets []*ttnpb.EndDevice
+	err = reg.Range(ctx, ttnpb.EndDeviceFieldPathsTopLevel, func(dev *ttnpb.EndDevice) bool {
+		rets = append(rets, dev)
+		return true
+	})
+	a.So(err, should.BeNil)
+	a.So(rets, should.HaveSameElementsDiff, []*ttnpb.EndDevice{pb, pbOther})
+
 	err = DeleteDevice(ctx, reg, *pb.EndDeviceIdentifiers.JoinEUI, *pb.EndDeviceIdentifiers.DevEUI)
 	if !a.So(err, should.BeNil) {
 		t.FailNow()
@@ -105,6 +114,14 @@ func handleDeviceRegistryTest(t *testing.T, reg DeviceRegistry) {
 	}
 	a.So(ret, should.BeNil)
 
+	rets = nil
+	err = reg.Range(ctx, ttnpb.EndDeviceFieldPathsTopLevel, func(dev *ttnpb.EndDevice) bool {
+		rets = append(rets, dev)
+		return true
+	})
+	a.So(err, should.BeNil)
+	a.So(rets, should.HaveSameElementsDiff, []*ttnpb.EndDevice{pbOther})
+
 	err = DeleteDevice(ctx, reg, *pbOther.EndDeviceIdentifiers.JoinEUI, *pbOther.EndDeviceIdentifiers.DevEUI)
 	if !a.So(err, should.BeNil) {
 		t.FailNow()
@@ -167,6 +184,56 @@ func TestDeviceRegistries(t *testing.T) {
 	}
 }
 
+func TestRedisDeviceRegistryInit(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "joinserver_test")
+	defer flush()
+	defer cl.Close()
+
+	reg := &redis.DeviceRegistry{Redis: cl}
+
+	indexed := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			JoinEUI: &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			DevEUI:  &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		},
+	}
+	_, err := CreateDevice(ctx, reg, indexed)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// Devices stored before the index of all devices was introduced are not in the index.
+	legacy := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			JoinEUI: &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			DevEUI:  &types.EUI64{0x43, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		},
+	}
+	_, err = ttnredis.SetProto(cl, cl.Key(legacy.JoinEUI.String(), legacy.DevEUI.String()), legacy, 0)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	rangeIDs := func() (ids []ttnpb.EndDeviceIdentifiers) {
+		err := reg.Range(ctx, []string{"ids"}, func(dev *ttnpb.EndDevice) bool {
+			ids = append(ids, dev.EndDeviceIdentifiers)
+			return true
+		})
+		a.So(err, should.BeNil)
+		return ids
+	}
+	a.So(rangeIDs(), should.Resemble, []ttnpb.EndDeviceIdentifiers{indexed.EndDeviceIdentifiers})
+
+	for i := 0; i < 2; i++ {
+		a.So(reg.Init(), should.BeNil)
+		a.So(rangeIDs(), should.HaveSameElementsDeep, []ttnpb.EndDeviceIdentifiers{indexed.EndDeviceIdentifiers, legacy.EndDeviceIdentifiers})
+	}
+}
+
 // handleKeyRegistryTest runs a test suite on reg.
 func handleKeyRegistryTest(t *testing.T, reg KeyRegistry) {
 	a := assertions.New(t)
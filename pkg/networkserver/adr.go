@@ -15,6 +15,7 @@
 package networkserver
 
 import (
+	"go.thethings.network/lorawan-stack/pkg/band"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -72,12 +73,48 @@ const maxNbTrans = 3
 // optimalADRUplinkCount is the amount of uplinks required to ensure optimal results from the ADR algorithm.
 const optimalADRUplinkCount = 20
 
-func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store) error {
-	ups := dev.RecentADRUplinks
-	if len(ups) == 0 {
-		return nil
+// ADRBounds defines the ADR parameters, which an ADRAlgorithm may assign.
+type ADRBounds struct {
+	MinDataRateIndex ttnpb.DataRateIndex
+	MaxDataRateIndex ttnpb.DataRateIndex
+	MinTxPowerIndex  uint32
+	MaxTxPowerIndex  uint32
+}
+
+// ADRAlgorithm computes the desired ADR parameters of end devices.
+type ADRAlgorithm interface {
+	// AdaptDataRate computes the desired ADR data rate index, Tx power index and NbTrans of dev
+	// using dev.RecentADRUplinks. The desired data rate and Tx power indexes must be within bounds.
+	AdaptDataRate(dev *ttnpb.EndDevice, phy band.Band, bounds ADRBounds) error
+}
+
+// ADRAlgorithmFunc is a function, which implements ADRAlgorithm.
+type ADRAlgorithmFunc func(dev *ttnpb.EndDevice, phy band.Band, bounds ADRBounds) error
+
+// AdaptDataRate implements ADRAlgorithm.
+func (f ADRAlgorithmFunc) AdaptDataRate(dev *ttnpb.EndDevice, phy band.Band, bounds ADRBounds) error {
+	return f(dev, phy, bounds)
+}
+
+const (
+	// MarginADRAlgorithm is the name of the ADR algorithm, which adapts the data rate and Tx power
+	// based on the link margin of recent uplinks. This is the default ADR algorithm.
+	MarginADRAlgorithm = "margin"
+	// LossAwareADRAlgorithm is the name of the ADR algorithm, which adapts the data rate and Tx power
+	// like MarginADRAlgorithm, but raises NbTrans and does not increase the data rate if recent uplinks were lost.
+	LossAwareADRAlgorithm = "loss-aware"
+)
+
+// defaultADRAlgorithms returns the ADR algorithms provided by the Network Server by name.
+func defaultADRAlgorithms() map[string]ADRAlgorithm {
+	return map[string]ADRAlgorithm{
+		MarginADRAlgorithm:    ADRAlgorithmFunc(marginADR),
+		LossAwareADRAlgorithm: ADRAlgorithmFunc(lossAwareADR),
 	}
+}
 
+// adrLinkMargin returns the link margin in dB of the uplinks in ups, which the data rate and Tx power can be adapted by.
+func adrLinkMargin(dev *ttnpb.EndDevice, ups []*ttnpb.UplinkMessage) (float32, error) {
 	maxSNR := ups[0].RxMetadata[0].SNR
 	for _, up := range ups {
 		for _, md := range up.RxMetadata {
@@ -87,23 +124,13 @@ func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store) error {
 		}
 	}
 
-	_, band, err := getDeviceBandVersion(dev, fps)
-	if err != nil {
-		return err
-	}
-
-	up := ups[len(ups)-1]
-
-	dev.MACState.DesiredParameters.ADRDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
-	dev.MACState.DesiredParameters.ADRTxPowerIndex = dev.MACState.CurrentParameters.ADRTxPowerIndex
-
 	// NOTE: We currently assume that the uplink's SF and BW correspond to CurrentParameters.ADRDataRateIndex.
 	var df float32
-	if dr := up.Settings.DataRate.GetLoRa(); dr != nil {
+	if dr := ups[len(ups)-1].Settings.DataRate.GetLoRa(); dr != nil {
 		var ok bool
 		df, ok = demodulationFloor[dr.SpreadingFactor][dr.Bandwidth]
 		if !ok {
-			return errInvalidDataRate
+			return 0, errInvalidDataRate
 		}
 	}
 
@@ -115,10 +142,19 @@ func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store) error {
 	if len(ups) < optimalADRUplinkCount {
 		margin -= safetyMargin
 	}
+	return margin, nil
+}
+
+// adaptDataRateAndTxPower sets the desired data rate and Tx power indexes of dev given the link margin.
+// The data rate index is not increased beyond maxDataRateIndex, which must not exceed bounds.MaxDataRateIndex.
+// The Tx power index is not increased beyond maxTxPowerIndex, which must not exceed bounds.MaxTxPowerIndex.
+func adaptDataRateAndTxPower(dev *ttnpb.EndDevice, phy band.Band, bounds ADRBounds, margin float32, maxDataRateIndex ttnpb.DataRateIndex, maxTxPowerIndex uint32) {
+	dev.MACState.DesiredParameters.ADRDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
+	dev.MACState.DesiredParameters.ADRTxPowerIndex = dev.MACState.CurrentParameters.ADRTxPowerIndex
 
 	// As long as we have enough margin to increase the data rate, we do that.
 	// If we change the DR, we reset the Tx power.
-	for int(dev.MACState.DesiredParameters.ADRDataRateIndex) < int(band.MaxADRDataRateIndex) {
+	for dev.MACState.DesiredParameters.ADRDataRateIndex < maxDataRateIndex {
 		newMargin := margin - drStep
 		if newMargin < 0 {
 			break
@@ -128,15 +164,41 @@ func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store) error {
 		dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
 	}
 
+	switch {
+	case dev.MACState.DesiredParameters.ADRDataRateIndex < bounds.MinDataRateIndex:
+		margin -= drStep * float32(bounds.MinDataRateIndex-dev.MACState.DesiredParameters.ADRDataRateIndex)
+		dev.MACState.DesiredParameters.ADRDataRateIndex = bounds.MinDataRateIndex
+		dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+	case dev.MACState.DesiredParameters.ADRDataRateIndex > bounds.MaxDataRateIndex:
+		dev.MACState.DesiredParameters.ADRDataRateIndex = bounds.MaxDataRateIndex
+		dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+	}
+	if dev.MACState.DesiredParameters.ADRTxPowerIndex < bounds.MinTxPowerIndex {
+		dev.MACState.DesiredParameters.ADRTxPowerIndex = bounds.MinTxPowerIndex
+	}
+
 	// If we still have margin left, we decrease the Tx power (increase the index).
-	for int(dev.MACState.DesiredParameters.ADRTxPowerIndex) < int(band.MaxTxPowerIndex) {
-		newMargin := margin - (band.TxOffset[dev.MACState.DesiredParameters.ADRTxPowerIndex] - band.TxOffset[dev.MACState.DesiredParameters.ADRTxPowerIndex+1])
+	for dev.MACState.DesiredParameters.ADRTxPowerIndex < maxTxPowerIndex {
+		newMargin := margin - (phy.TxOffset[dev.MACState.DesiredParameters.ADRTxPowerIndex] - phy.TxOffset[dev.MACState.DesiredParameters.ADRTxPowerIndex+1])
 		if newMargin < 0 {
 			break
 		}
 		margin = newMargin
 		dev.MACState.DesiredParameters.ADRTxPowerIndex++
 	}
+	if dev.MACState.DesiredParameters.ADRTxPowerIndex > bounds.MaxTxPowerIndex {
+		dev.MACState.DesiredParameters.ADRTxPowerIndex = bounds.MaxTxPowerIndex
+	}
+}
+
+// marginADR implements MarginADRAlgorithm.
+func marginADR(dev *ttnpb.EndDevice, phy band.Band, bounds ADRBounds) error {
+	ups := dev.RecentADRUplinks
+	margin, err := adrLinkMargin(dev, ups)
+	if err != nil {
+		return err
+	}
+	adaptDataRateAndTxPower(dev, phy, bounds, margin, bounds.MaxDataRateIndex, bounds.MaxTxPowerIndex)
 
 	dev.MACState.DesiredParameters.ADRNbTrans = dev.MACState.CurrentParameters.ADRNbTrans
 	if dev.MACState.DesiredParameters.ADRNbTrans > maxNbTrans {
@@ -144,9 +206,7 @@ func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store) error {
 	}
 
 	if len(ups) >= 2 {
-		lossRate := float32(up.Payload.GetMACPayload().FHDR.FCnt-ups[0].Payload.GetMACPayload().FHDR.FCnt-uint32(len(ups))) /
-			float32(up.Payload.GetMACPayload().FHDR.FCnt-ups[0].Payload.GetMACPayload().FHDR.FCnt)
-		switch {
+		switch lossRate := adrLossRate(ups); {
 		case lossRate < 0.05:
 			dev.MACState.DesiredParameters.ADRNbTrans = 1 + dev.MACState.DesiredParameters.ADRNbTrans/3
 		case lossRate < 0.10:
@@ -158,3 +218,115 @@ func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store) error {
 	}
 	return nil
 }
+
+// adrLossRate returns the fraction of uplinks lost in the FCnt range covered by ups.
+// Retransmissions, i.e. uplinks with the same FCnt, are counted once.
+func adrLossRate(ups []*ttnpb.UplinkMessage) float32 {
+	if len(ups) < 2 {
+		return 0
+	}
+	first := ups[0].Payload.GetMACPayload().FHDR.FCnt
+	last := ups[len(ups)-1].Payload.GetMACPayload().FHDR.FCnt
+	if last <= first {
+		return 0
+	}
+	received := make(map[uint32]struct{}, len(ups))
+	for _, up := range ups {
+		fCnt := up.Payload.GetMACPayload().FHDR.FCnt
+		if fCnt >= first && fCnt <= last {
+			received[fCnt] = struct{}{}
+		}
+	}
+	total := last - first + 1
+	return float32(total-uint32(len(received))) / float32(total)
+}
+
+// lossAwareADRThreshold is the loss rate, at or above which LossAwareADRAlgorithm does not increase the data rate
+// and does not decrease the Tx power.
+const lossAwareADRThreshold = 0.30
+
+// lossAwareADR implements LossAwareADRAlgorithm.
+func lossAwareADR(dev *ttnpb.EndDevice, phy band.Band, bounds ADRBounds) error {
+	ups := dev.RecentADRUplinks
+	margin, err := adrLinkMargin(dev, ups)
+	if err != nil {
+		return err
+	}
+
+	lossRate := adrLossRate(ups)
+
+	maxDataRateIndex, maxTxPowerIndex := bounds.MaxDataRateIndex, bounds.MaxTxPowerIndex
+	if lossRate >= lossAwareADRThreshold {
+		if dev.MACState.CurrentParameters.ADRDataRateIndex < maxDataRateIndex {
+			maxDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
+		}
+		if dev.MACState.CurrentParameters.ADRTxPowerIndex < maxTxPowerIndex {
+			maxTxPowerIndex = dev.MACState.CurrentParameters.ADRTxPowerIndex
+		}
+	}
+	adaptDataRateAndTxPower(dev, phy, bounds, margin, maxDataRateIndex, maxTxPowerIndex)
+
+	nbTrans := dev.MACState.CurrentParameters.ADRNbTrans
+	if nbTrans == 0 {
+		nbTrans = 1
+	}
+	switch {
+	case lossRate < 0.05:
+		if nbTrans > 1 {
+			nbTrans--
+		}
+	case lossRate < 0.10:
+	case lossRate < lossAwareADRThreshold:
+		nbTrans++
+	default:
+		nbTrans = maxNbTrans
+	}
+	if nbTrans > maxNbTrans {
+		nbTrans = maxNbTrans
+	}
+	dev.MACState.DesiredParameters.ADRNbTrans = nbTrans
+	return nil
+}
+
+// adaptDataRate computes the desired ADR parameters of dev using alg.
+// If profile is not nil, the desired data rate and Tx power are bounded by the ADR indexes defined by the MAC profile.
+// adaptDataRate returns whether the desired ADR parameters were computed, which is not the case if dev has no recent ADR uplinks.
+func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store, profile *MACProfile, alg ADRAlgorithm) (bool, error) {
+	if len(dev.RecentADRUplinks) == 0 {
+		return false, nil
+	}
+
+	_, phy, err := getDeviceBandVersion(dev, fps)
+	if err != nil {
+		return false, err
+	}
+
+	bounds := ADRBounds{
+		MaxDataRateIndex: ttnpb.DataRateIndex(phy.MaxADRDataRateIndex),
+		MaxTxPowerIndex:  uint32(phy.MaxTxPowerIndex),
+	}
+	if profile != nil {
+		if profile.ADRMinDataRateIndex != nil {
+			bounds.MinDataRateIndex = ttnpb.DataRateIndex(*profile.ADRMinDataRateIndex)
+		}
+		if profile.ADRMaxDataRateIndex != nil && ttnpb.DataRateIndex(*profile.ADRMaxDataRateIndex) < bounds.MaxDataRateIndex {
+			bounds.MaxDataRateIndex = ttnpb.DataRateIndex(*profile.ADRMaxDataRateIndex)
+		}
+		if profile.ADRMinTxPowerIndex != nil {
+			bounds.MinTxPowerIndex = *profile.ADRMinTxPowerIndex
+		}
+		if profile.ADRMaxTxPowerIndex != nil && *profile.ADRMaxTxPowerIndex < bounds.MaxTxPowerIndex {
+			bounds.MaxTxPowerIndex = *profile.ADRMaxTxPowerIndex
+		}
+	}
+	if bounds.MinDataRateIndex > bounds.MaxDataRateIndex {
+		bounds.MinDataRateIndex = bounds.MaxDataRateIndex
+	}
+	if bounds.MinTxPowerIndex > bounds.MaxTxPowerIndex {
+		bounds.MinTxPowerIndex = bounds.MaxTxPowerIndex
+	}
+	if err := alg.AdaptDataRate(dev, phy, bounds); err != nil {
+		return false, err
+	}
+	return true, nil
+}
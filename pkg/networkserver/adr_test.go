@@ -80,10 +80,116 @@ func adrMatrixToUplinks(m []adrMatrixRow) (ups []*ttnpb.UplinkMessage) {
 	return
 }
 
+func TestADRLossRate(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		FCnts    []uint32
+		LossRate float32
+	}{
+		{
+			Name:     "no uplinks",
+			LossRate: 0,
+		},
+		{
+			Name:     "single uplink",
+			FCnts:    []uint32{42},
+			LossRate: 0,
+		},
+		{
+			Name:     "no loss",
+			FCnts:    []uint32{1, 2, 3, 4},
+			LossRate: 0,
+		},
+		{
+			Name:     "retransmissions",
+			FCnts:    []uint32{1, 1, 2, 2, 3, 3, 4},
+			LossRate: 0,
+		},
+		{
+			Name:     "half lost",
+			FCnts:    []uint32{1, 3, 5, 7},
+			LossRate: 3.0 / 7.0,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			m := make([]adrMatrixRow, 0, len(tc.FCnts))
+			for _, fCnt := range tc.FCnts {
+				m = append(m, adrMatrixRow{FCnt: fCnt, MaxSNR: -5, GtwDiversity: 1})
+			}
+			assertions.New(t).So(adrLossRate(adrMatrixToUplinks(m)), should.AlmostEqual, tc.LossRate, 0.0001)
+		})
+	}
+}
+
 func TestAdaptDataRate(t *testing.T) {
+	sf12Settings := ttnpb.TxSettings{
+		DataRate: ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_LoRa{
+				LoRa: &ttnpb.LoRaDataRate{
+					SpreadingFactor: 12,
+					Bandwidth:       125000,
+				},
+			},
+		},
+		DataRateIndex: 0,
+	}
+	semtechMatrix := []adrMatrixRow{
+		{FCnt: 10, MaxSNR: -6, GtwDiversity: 2},
+		{FCnt: 11, MaxSNR: -7, GtwDiversity: 2},
+		{FCnt: 12, MaxSNR: -25, GtwDiversity: 1},
+		{FCnt: 13, MaxSNR: -25, GtwDiversity: 1},
+		{FCnt: 14, MaxSNR: -10, GtwDiversity: 2},
+		{FCnt: 16, MaxSNR: -25, GtwDiversity: 1},
+		{FCnt: 17, MaxSNR: -10, GtwDiversity: 2},
+		{FCnt: 19, MaxSNR: -10, GtwDiversity: 3},
+		{FCnt: 20, MaxSNR: -6, GtwDiversity: 2},
+		{FCnt: 21, MaxSNR: -7, GtwDiversity: 2},
+		{FCnt: 22, MaxSNR: -25, GtwDiversity: 1},
+		{FCnt: 23, MaxSNR: -25, GtwDiversity: 1},
+		{FCnt: 24, MaxSNR: -10, GtwDiversity: 2},
+		{FCnt: 25, MaxSNR: -10, GtwDiversity: 2},
+		{FCnt: 26, MaxSNR: -25, GtwDiversity: 1},
+		{FCnt: 27, MaxSNR: -8, GtwDiversity: 2},
+		{FCnt: 28, MaxSNR: -10, GtwDiversity: 2},
+		{FCnt: 29, MaxSNR: -10, GtwDiversity: 3},
+		{FCnt: 30, MaxSNR: -9, GtwDiversity: 3},
+		{FCnt: 31, MaxSNR: -7, GtwDiversity: 2, TxSettings: sf12Settings},
+	}
+	lossyMatrix := make([]adrMatrixRow, 0, 20)
+	for i := uint32(0); i < 20; i++ {
+		lossyMatrix = append(lossyMatrix, adrMatrixRow{FCnt: 10 + 2*i, MaxSNR: -10, GtwDiversity: 1})
+	}
+	lossyMatrix[0].MaxSNR = -6
+	lossyMatrix[19].TxSettings = sf12Settings
+
+	newDevice := func(m []adrMatrixRow) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+			MACState: &ttnpb.MACState{
+				CurrentParameters: ttnpb.MACParameters{
+					ADRDataRateIndex: 0,
+					ADRNbTrans:       0,
+					ADRTxPowerIndex:  1,
+				},
+				DesiredParameters: ttnpb.MACParameters{
+					ADRDataRateIndex: 5,
+					ADRNbTrans:       3,
+					ADRTxPowerIndex:  2,
+				},
+			},
+			MACSettings: &ttnpb.MACSettings{
+				ADRMargin: 2,
+			},
+			FrequencyPlanID:  test.EUFrequencyPlanID,
+			RecentADRUplinks: adrMatrixToUplinks(m),
+		}
+	}
+
 	for _, tc := range []struct {
 		Name       string
 		Device     *ttnpb.EndDevice
+		Profile    *MACProfile
+		Algorithm  ADRAlgorithm
 		DeviceDiff func(*ttnpb.EndDevice)
 		Error      error
 	}{
@@ -142,19 +248,68 @@ func TestAdaptDataRate(t *testing.T) {
 					},
 				}),
 			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = 4
+				dev.MACState.DesiredParameters.ADRNbTrans = 0
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
+			},
+		},
+		{
+			Name:   "adapted example from Semtech paper/MAC profile bounds",
+			Device: newDevice(semtechMatrix),
+			Profile: &MACProfile{
+				ADRMaxDataRateIndex: func(v uint32) *uint32 { return &v }(3),
+				ADRMaxTxPowerIndex:  func(v uint32) *uint32 { return &v }(1),
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = 3
+				dev.MACState.DesiredParameters.ADRNbTrans = 0
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
+			},
+		},
+		{
+			Name:   "adapted example from Semtech paper/MAC profile minimum data rate",
+			Device: newDevice(semtechMatrix),
+			Profile: &MACProfile{
+				ADRMinDataRateIndex: func(v uint32) *uint32 { return &v }(5),
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = 5
+				dev.MACState.DesiredParameters.ADRNbTrans = 0
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+			},
+		},
+		{
+			Name:      "adapted example from Semtech paper/loss-aware",
+			Device:    newDevice(semtechMatrix),
+			Algorithm: ADRAlgorithmFunc(lossAwareADR),
 			DeviceDiff: func(dev *ttnpb.EndDevice) {
 				dev.MACState.DesiredParameters.ADRDataRateIndex = 4
 				dev.MACState.DesiredParameters.ADRNbTrans = 1
 				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
 			},
 		},
+		{
+			Name:      "high loss/loss-aware",
+			Device:    newDevice(lossyMatrix),
+			Algorithm: ADRAlgorithmFunc(lossAwareADR),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = 0
+				dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
 
 			dev := CopyEndDevice(tc.Device)
 
-			err := adaptDataRate(dev, frequencyplans.NewStore(test.FrequencyPlansFetcher))
+			alg := tc.Algorithm
+			if alg == nil {
+				alg = ADRAlgorithmFunc(marginADR)
+			}
+			_, err := adaptDataRate(dev, frequencyplans.NewStore(test.FrequencyPlansFetcher), tc.Profile, alg)
 			if err != nil && !a.So(err, should.Equal, tc.Error) ||
 				err == nil && !a.So(err, should.BeNil) {
 				t.FailNow()
@@ -18,16 +18,154 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/retry"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
 // Config represents the NetworkServer configuration.
 type Config struct {
-	Devices             DeviceRegistry         `name:"-"`
-	DownlinkTasks       DownlinkTaskQueue      `name:"-"`
-	DeduplicationWindow time.Duration          `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
-	CooldownWindow      time.Duration          `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
-	DownlinkPriorities  DownlinkPriorityConfig `name:"downlink-priorities" description:"Downlink message priorities"`
+	Devices                      DeviceRegistry                 `name:"-"`
+	DownlinkTasks                DownlinkTaskQueue              `name:"-"`
+	DeviceRegistryRetry          retry.Config                   `name:"device-registry-retry" description:"Retries of device registry transactions aborted due to concurrent modifications"`
+	DeduplicationWindow          time.Duration                  `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
+	CooldownWindow               time.Duration                  `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
+	DownlinkPriorities           DownlinkPriorityConfig         `name:"downlink-priorities" description:"Downlink message priorities"`
+	DeviceReconciliationInterval time.Duration                  `name:"device-reconciliation-interval" description:"Interval in which devices that no longer exist in the Entity Registry are deleted (0 means never)"`
+	DefaultMACSettings           MACSettingConfig               `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device or MAC profile"`
+	MACProfiles                  []MACProfile                   `name:"mac-profiles" description:"MAC profiles, which end devices can reference by name" file-only:"true"`
+	NetID                        string                         `name:"net-id" description:"NetID of the network (defaults to 000000)"`
+	DevAddrPrefixes              []string                       `name:"dev-addr-prefixes" description:"DevAddr prefixes to allocate DevAddrs from (defaults to the DevAddr prefix of the NetID)"`
+	ApplicationDevAddrPrefixes   map[string][]string            `name:"application-dev-addr-prefixes" description:"DevAddr prefixes to allocate DevAddrs from for devices of specific applications (application ID=prefix)"`
+	DownlinkGatewaySelection     DownlinkGatewaySelectionConfig `name:"downlink-gateway-selection" description:"Selection of gateways for downlink"`
+	PassiveRoaming               PassiveRoamingConfig           `name:"passive-roaming" description:"Passive roaming with Network Servers of other networks"`
+}
+
+// MACSettingConfig defines the Network Server-wide defaults of the MAC settings of end devices.
+type MACSettingConfig struct {
+	ADRMargin                 uint32        `name:"adr-margin" description:"Margin in dB the Network Server adds in ADR requests"`
+	ADRAlgorithm              string        `name:"adr-algorithm" description:"Name of the ADR algorithm (margin, loss-aware)"`
+	ClassBTimeout             time.Duration `name:"class-b-timeout" description:"Deadline for a class B device to respond to requests from the Network Server"`
+	ClassCTimeout             time.Duration `name:"class-c-timeout" description:"Deadline for a class C device to respond to requests from the Network Server"`
+	StatusTimePeriodicity     time.Duration `name:"status-time-periodicity" description:"Interval after which a DevStatusReq MAC command shall be sent (0 means never)"`
+	StatusCountPeriodicity    uint32        `name:"status-count-periodicity" description:"Number of uplink messages after which a DevStatusReq MAC command shall be sent (0 means never)"`
+	ConfirmedDownlinkAttempts uint32        `name:"confirmed-downlink-attempts" description:"Maximum number of transmissions of a confirmed application downlink (0 means no retransmission by the Network Server)"`
+}
+
+// MACProfile is a named set of MAC settings and parameters, which end devices reference by mac_settings.profile_id.
+// Values that are not set fallback to the band, frequency plan and Network Server defaults.
+type MACProfile struct {
+	Name string `name:"name" description:"Name of the MAC profile"`
+
+	ADRMargin           *uint32 `name:"adr-margin" description:"Margin in dB the Network Server adds in ADR requests"`
+	ADRMinDataRateIndex *uint32 `name:"adr-min-data-rate-index" description:"Minimum data rate index the ADR algorithm may assign"`
+	ADRMaxDataRateIndex *uint32 `name:"adr-max-data-rate-index" description:"Maximum data rate index the ADR algorithm may assign"`
+	ADRMinTxPowerIndex  *uint32 `name:"adr-min-tx-power-index" description:"Minimum Tx power index the ADR algorithm may assign"`
+	ADRMaxTxPowerIndex  *uint32 `name:"adr-max-tx-power-index" description:"Maximum Tx power index the ADR algorithm may assign"`
+	ADRAlgorithm        string  `name:"adr-algorithm" description:"Name of the ADR algorithm (margin, loss-aware)"`
+
+	Rx1Delay                 *uint32  `name:"rx1-delay" description:"Rx1 delay in seconds (1-15)"`
+	Rx2DataRateIndex         *uint32  `name:"rx2-data-rate-index" description:"Rx2 data rate index"`
+	Rx2Frequency             uint64   `name:"rx2-frequency" description:"Rx2 frequency in Hz"`
+	FactoryPresetFrequencies []uint64 `name:"factory-preset-frequencies" description:"Uplink frequencies in Hz, which ABP devices are configured with in addition to the band default channels"`
+	DesiredEnabledChannels   []uint   `name:"desired-enabled-channels" description:"Indexes of the uplink channels, which should be enabled on the device. All other channels are disabled"`
+	MaxDutyCycle             uint32   `name:"max-duty-cycle" description:"Maximum aggregated duty cycle of the device as 1/N, where N is a power of 2 up to 32768"`
+	PingSlotPeriodicity      uint32   `name:"ping-slot-periodicity" description:"Class B ping slot periodicity in seconds (1, 2, 4, ..., 128)"`
+	PingSlotDataRateIndex    *uint32  `name:"ping-slot-data-rate-index" description:"Class B ping slot data rate index"`
+	PingSlotFrequency        uint64   `name:"ping-slot-frequency" description:"Class B ping slot frequency in Hz"`
+	Supports32BitFCnt        *bool    `name:"supports-32-bit-f-cnt" description:"Whether the device uses 32-bit frame counters"`
+
+	ClassBTimeout          time.Duration  `name:"class-b-timeout" description:"Deadline for a class B device to respond to requests from the Network Server"`
+	ClassCTimeout          time.Duration  `name:"class-c-timeout" description:"Deadline for a class C device to respond to requests from the Network Server"`
+	StatusTimePeriodicity  *time.Duration `name:"status-time-periodicity" description:"Interval after which a DevStatusReq MAC command shall be sent (0 means never)"`
+	StatusCountPeriodicity *uint32        `name:"status-count-periodicity" description:"Number of uplink messages after which a DevStatusReq MAC command shall be sent (0 means never)"`
+}
+
+var errInvalidMACProfile = errors.DefineInvalidArgument("mac_profile", "invalid MAC profile `{name}`")
+
+// powerOfTwoExponent returns n, such that 2^n = v, and whether such n exists and does not exceed max.
+func powerOfTwoExponent(v uint32, max uint) (uint, bool) {
+	for n := uint(0); n <= max; n++ {
+		if v == 1<<n {
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+// Validate returns an error if the MAC profile is invalid.
+func (p MACProfile) Validate() error {
+	switch {
+	case p.Name == "":
+		return errInvalidMACProfile.WithAttributes("name", p.Name).WithCause(errors.New("name is empty"))
+	case p.Rx1Delay != nil && (*p.Rx1Delay < 1 || *p.Rx1Delay > 15):
+		return errInvalidMACProfile.WithAttributes("name", p.Name).WithCause(errors.New("Rx1 delay must be in range from 1 to 15"))
+	case p.ADRMinDataRateIndex != nil && p.ADRMaxDataRateIndex != nil && *p.ADRMinDataRateIndex > *p.ADRMaxDataRateIndex:
+		return errInvalidMACProfile.WithAttributes("name", p.Name).WithCause(errors.New("minimum ADR data rate index exceeds the maximum"))
+	case p.ADRMinTxPowerIndex != nil && p.ADRMaxTxPowerIndex != nil && *p.ADRMinTxPowerIndex > *p.ADRMaxTxPowerIndex:
+		return errInvalidMACProfile.WithAttributes("name", p.Name).WithCause(errors.New("minimum ADR Tx power index exceeds the maximum"))
+	case p.ADRMargin != nil && *p.ADRMargin == 0:
+		return errInvalidMACProfile.WithAttributes("name", p.Name).WithCause(errors.New("ADR margin must not be zero"))
+	case p.ClassBTimeout < 0 || p.ClassCTimeout < 0:
+		return errInvalidMACProfile.WithAttributes("name", p.Name).WithCause(errors.New("class B and C timeouts must not be negative"))
+	case p.StatusTimePeriodicity != nil && *p.StatusTimePeriodicity < 0:
+		return errInvalidMACProfile.WithAttributes("name", p.Name).WithCause(errors.New("status time periodicity must not be negative"))
+	}
+	for _, idx := range []*uint32{p.ADRMinDataRateIndex, p.ADRMaxDataRateIndex, p.Rx2DataRateIndex, p.PingSlotDataRateIndex} {
+		if idx != nil && *idx > uint32(ttnpb.DATA_RATE_15) {
+			return errInvalidMACProfile.WithAttributes("name", p.Name).WithCause(errors.New("data rate index must not exceed 15"))
+		}
+	}
+	if _, ok := powerOfTwoExponent(p.MaxDutyCycle, 15); p.MaxDutyCycle != 0 && !ok {
+		return errInvalidMACProfile.WithAttributes("name", p.Name).WithCause(errors.New("max duty cycle must be a power of 2 up to 32768"))
+	}
+	if _, ok := powerOfTwoExponent(p.PingSlotPeriodicity, 7); p.PingSlotPeriodicity != 0 && !ok {
+		return errInvalidMACProfile.WithAttributes("name", p.Name).WithCause(errors.New("ping slot periodicity must be a power of 2 up to 128"))
+	}
+	return nil
+}
+
+// AggregatedDutyCycle returns the maximum duty cycle of the profile and whether it is set.
+func (p MACProfile) AggregatedDutyCycle() (ttnpb.AggregatedDutyCycle, bool) {
+	n, ok := powerOfTwoExponent(p.MaxDutyCycle, 15)
+	return ttnpb.AggregatedDutyCycle(n), ok
+}
+
+// PingSlotPeriod returns the ping slot periodicity of the profile and whether it is set.
+func (p MACProfile) PingSlotPeriod() (ttnpb.PingSlotPeriod, bool) {
+	n, ok := powerOfTwoExponent(p.PingSlotPeriodicity, 7)
+	return ttnpb.PingSlotPeriod(n), ok
+}
+
+// DownlinkGatewaySelectionConfig configures the selection of gateways for downlink.
+type DownlinkGatewaySelectionConfig struct {
+	Strategy        string                       `name:"strategy" description:"Name of the downlink gateway selection strategy (signal, balanced)"`
+	TxFailureWindow time.Duration                `name:"tx-failure-window" description:"Time window in which failed transmissions of a gateway are taken into account (tracked per Network Server instance)"`
+	Balanced        BalancedDownlinkGatewayScore `name:"balanced" description:"Weights of the balanced downlink gateway selection strategy"`
+}
+
+// BalancedDownlinkGatewayScore defines the weights of the balanced downlink gateway selection strategy.
+// The score of a gateway is the weighted SNR and RSSI, minus the weighted utilization, queue depth and Tx failures.
+type BalancedDownlinkGatewayScore struct {
+	SNRWeight           float64 `name:"snr-weight" description:"Weight of the SNR in dB"`
+	RSSIWeight          float64 `name:"rssi-weight" description:"Weight of the RSSI in dBm"`
+	UtilizationWeight   float64 `name:"utilization-weight" description:"Weight of the duty-cycle utilization, as a fraction of the duty-cycle limit"`
+	QueueDepthWeight    float64 `name:"queue-depth-weight" description:"Weight of the number of downlink messages queued on the gateway"`
+	TxFailureWeight     float64 `name:"tx-failure-weight" description:"Weight of the number of recent failed transmissions seen by this Network Server instance"`
+	LoadSpreadingMargin float64 `name:"load-spreading-margin" description:"Score difference within which gateways are considered equally good and selected at random"`
+}
+
+// PassiveRoamingConfig configures passive roaming with the Network Servers of other networks.
+type PassiveRoamingConfig struct {
+	BandID  string               `name:"band-id" description:"Band of the gateways of this network, used for the uplink messages forwarded to roaming peers"`
+	Timeout time.Duration        `name:"timeout" description:"Timeout of requests to roaming peers"`
+	Peers   []PassiveRoamingPeer `name:"peers" description:"Network Servers of other networks, by NetID" file-only:"true"`
+}
+
+// PassiveRoamingPeer defines the Network Server of another network.
+type PassiveRoamingPeer struct {
+	NetID         string `name:"net-id" description:"NetID of the network"`
+	URL           string `name:"url" description:"URL of the passive roaming endpoint of the Network Server"`
+	Authorization string `name:"authorization" description:"Authorization header value sent to and expected from the Network Server (required)"`
 }
 
 // DownlinkPriorityConfig defines priorities for downlink messages.
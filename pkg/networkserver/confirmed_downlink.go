@@ -0,0 +1,112 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// confirmedDownlinkAttempts returns the maximum number of transmissions of a confirmed application downlink to dev.
+// 0 means that confirmed application downlinks are not retransmitted by the Network Server and a nack is forwarded to
+// the Application Server instead.
+func (ns *NetworkServer) confirmedDownlinkAttempts(dev *ttnpb.EndDevice) uint32 {
+	if dev.MACSettings != nil && dev.MACSettings.ConfirmedDownlinkAttempts > 0 {
+		return dev.MACSettings.ConfirmedDownlinkAttempts
+	}
+	return ns.defaultMACSettings.ConfirmedDownlinkAttempts
+}
+
+// pendingApplicationDownlinkTimedOut reports whether the acknowledgment of the pending application downlink of a class C dev
+// did not arrive within the class C timeout.
+func pendingApplicationDownlinkTimedOut(dev *ttnpb.EndDevice, now time.Time) bool {
+	return dev.MACState.DeviceClass == ttnpb.CLASS_C &&
+		dev.MACState.PendingApplicationDownlink != nil &&
+		dev.MACState.LastConfirmedDownlinkAt != nil &&
+		!dev.MACState.LastConfirmedDownlinkAt.Add(dev.MACSettings.ClassCTimeout).After(now)
+}
+
+// handleUnacknowledgedApplicationDownlink handles the pending application downlink of dev, which was not acknowledged
+// by the device. If transmission attempts are left, the downlink is queued for retransmission with the same FCnt and nil is returned.
+// Otherwise, the pending application downlink is cleared and returned, such that a nack can be sent to the Application Server.
+// handleUnacknowledgedApplicationDownlink must only be called if ns.confirmedDownlinkAttempts(dev) is not 0.
+func (ns *NetworkServer) handleUnacknowledgedApplicationDownlink(ctx context.Context, dev *ttnpb.EndDevice) *ttnpb.ApplicationDownlink {
+	down := dev.MACState.PendingApplicationDownlink
+	dev.MACState.PendingApplicationDownlink = nil
+
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"attempts", dev.MACState.PendingApplicationDownlinkAttempts,
+		"f_cnt", down.FCnt,
+	))
+	if dev.MACState.PendingApplicationDownlinkAttempts < ns.confirmedDownlinkAttempts(dev) {
+		logger.Debug("Confirmed downlink not acknowledged, retransmit")
+		publishEvents(ctx, evtRetransmitDownlink(ctx, dev.EndDeviceIdentifiers, down.FCnt))
+		dev.QueuedApplicationDownlinks = append([]*ttnpb.ApplicationDownlink{down}, dev.QueuedApplicationDownlinks...)
+		return nil
+	}
+	logger.WithError(errConfirmedDownlinkTimeout.WithAttributes(
+		"attempts", dev.MACState.PendingApplicationDownlinkAttempts,
+	)).Debug("Confirmed downlink not acknowledged, no attempts left")
+	publishEvents(ctx, evtFailConfirmedDownlink(ctx, dev.EndDeviceIdentifiers, down.FCnt))
+	return down
+}
+
+// retransmittedApplicationDownlink returns the application downlink at the head of the queue of dev and the payload of
+// its last transmission, if the application downlink is an unacknowledged confirmed downlink queued for retransmission.
+// The payload is taken from the recent downlinks of dev, such that the retransmission is identical to the last transmission.
+// retransmittedApplicationDownlink returns nil if there is no retransmission or if the payload of the last transmission is not known.
+func retransmittedApplicationDownlink(dev *ttnpb.EndDevice) ([]byte, *ttnpb.ApplicationDownlink) {
+	if dev.MACState.PendingApplicationDownlinkAttempts == 0 || len(dev.QueuedApplicationDownlinks) == 0 {
+		return nil, nil
+	}
+	down := dev.QueuedApplicationDownlinks[0]
+	if !down.Confirmed || down.FCnt != dev.Session.LastConfFCntDown {
+		return nil, nil
+	}
+	for i := len(dev.RecentDownlinks) - 1; i >= 0; i-- {
+		msg := &ttnpb.Message{}
+		if err := lorawan.UnmarshalMessage(dev.RecentDownlinks[i].RawPayload, msg); err != nil {
+			continue
+		}
+		pld := msg.GetMACPayload()
+		if msg.MType != ttnpb.MType_CONFIRMED_DOWN || pld == nil || pld.FCnt != down.FCnt || pld.FPort != down.FPort {
+			continue
+		}
+		return dev.RecentDownlinks[i].RawPayload, down
+	}
+	return nil, nil
+}
+
+// sendApplicationDownlinkNack sends a nack of the unacknowledged confirmed downlink down to the Application Server,
+// after all transmission attempts failed.
+func (ns *NetworkServer) sendApplicationDownlinkNack(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, down *ttnpb.ApplicationDownlink) {
+	ok, err := ns.handleASUplink(ctx, ids.ApplicationIdentifiers, &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ids,
+		CorrelationIDs:       append(down.CorrelationIDs, events.CorrelationIDsFromContext(ctx)...),
+		Up: &ttnpb.ApplicationUp_DownlinkNack{
+			DownlinkNack: down,
+		},
+	})
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to send confirmed downlink nack to Application Server")
+	} else if !ok {
+		log.FromContext(ctx).Warn("Application Server not found, confirmed downlink nack not sent")
+	}
+}
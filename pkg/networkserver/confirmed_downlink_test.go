@@ -0,0 +1,215 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestConfirmedDownlinkAttempts(t *testing.T) {
+	ns := &NetworkServer{
+		defaultMACSettings: MACSettingConfig{
+			ConfirmedDownlinkAttempts: 3,
+		},
+	}
+	for _, tc := range []struct {
+		Name     string
+		Device   *ttnpb.EndDevice
+		Attempts uint32
+	}{
+		{
+			Name:     "no MAC settings",
+			Device:   &ttnpb.EndDevice{},
+			Attempts: 3,
+		},
+		{
+			Name: "default",
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{},
+			},
+			Attempts: 3,
+		},
+		{
+			Name: "device",
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{
+					ConfirmedDownlinkAttempts: 5,
+				},
+			},
+			Attempts: 5,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			assertions.New(t).So(ns.confirmedDownlinkAttempts(tc.Device), should.Equal, tc.Attempts)
+		})
+	}
+}
+
+func TestPendingApplicationDownlinkTimedOut(t *testing.T) {
+	now := time.Unix(42, 0)
+	for _, tc := range []struct {
+		Name     string
+		MACState *ttnpb.MACState
+		TimedOut bool
+	}{
+		{
+			Name: "class A",
+			MACState: &ttnpb.MACState{
+				DeviceClass:                ttnpb.CLASS_A,
+				PendingApplicationDownlink: &ttnpb.ApplicationDownlink{},
+				LastConfirmedDownlinkAt:    TimePtr(now.Add(-time.Minute)),
+			},
+		},
+		{
+			Name: "class C/no pending downlink",
+			MACState: &ttnpb.MACState{
+				DeviceClass:             ttnpb.CLASS_C,
+				LastConfirmedDownlinkAt: TimePtr(now.Add(-time.Minute)),
+			},
+		},
+		{
+			Name: "class C/within timeout",
+			MACState: &ttnpb.MACState{
+				DeviceClass:                ttnpb.CLASS_C,
+				PendingApplicationDownlink: &ttnpb.ApplicationDownlink{},
+				LastConfirmedDownlinkAt:    TimePtr(now.Add(-time.Second)),
+			},
+		},
+		{
+			Name: "class C/timeout",
+			MACState: &ttnpb.MACState{
+				DeviceClass:                ttnpb.CLASS_C,
+				PendingApplicationDownlink: &ttnpb.ApplicationDownlink{},
+				LastConfirmedDownlinkAt:    TimePtr(now.Add(-10 * time.Second)),
+			},
+			TimedOut: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			dev := &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{
+					ClassCTimeout: 10 * time.Second,
+				},
+				MACState: tc.MACState,
+			}
+			assertions.New(t).So(pendingApplicationDownlinkTimedOut(dev, now), should.Equal, tc.TimedOut)
+		})
+	}
+}
+
+func TestHandleUnacknowledgedApplicationDownlink(t *testing.T) {
+	pending := &ttnpb.ApplicationDownlink{
+		Confirmed:  true,
+		FCnt:       42,
+		FPort:      1,
+		FRMPayload: []byte("test"),
+	}
+	queued := &ttnpb.ApplicationDownlink{
+		FCnt:       43,
+		FPort:      1,
+		FRMPayload: []byte("next"),
+	}
+
+	for _, tc := range []struct {
+		Name       string
+		Attempts   uint32
+		DeviceDiff func(*ttnpb.EndDevice)
+		Failed     *ttnpb.ApplicationDownlink
+	}{
+		{
+			Name:     "retransmit",
+			Attempts: 1,
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.PendingApplicationDownlink = nil
+				dev.QueuedApplicationDownlinks = []*ttnpb.ApplicationDownlink{pending, queued}
+			},
+		},
+		{
+			Name:     "no attempts left",
+			Attempts: 3,
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.PendingApplicationDownlink = nil
+			},
+			Failed: pending,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ns := &NetworkServer{
+				defaultMACSettings: MACSettingConfig{
+					ConfirmedDownlinkAttempts: 3,
+				},
+			}
+			newDevice := func() *ttnpb.EndDevice {
+				return &ttnpb.EndDevice{
+					MACState: &ttnpb.MACState{
+						PendingApplicationDownlink:         pending,
+						PendingApplicationDownlinkAttempts: tc.Attempts,
+					},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{queued},
+				}
+			}
+
+			dev := newDevice()
+			failed := ns.handleUnacknowledgedApplicationDownlink(test.Context(), dev)
+			a.So(failed, should.Resemble, tc.Failed)
+
+			expected := newDevice()
+			tc.DeviceDiff(expected)
+			a.So(dev, should.Resemble, expected)
+		})
+	}
+}
+
+func TestSendApplicationDownlinkNack(t *testing.T) {
+	a := assertions.New(t)
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+		DeviceID:               "test-dev",
+	}
+	down := &ttnpb.ApplicationDownlink{
+		Confirmed:      true,
+		FCnt:           42,
+		FPort:          1,
+		FRMPayload:     []byte("test"),
+		CorrelationIDs: []string{"test"},
+	}
+
+	var sent *ttnpb.ApplicationUp
+	ns := &NetworkServer{
+		handleASUplink: func(_ context.Context, appIDs ttnpb.ApplicationIdentifiers, up *ttnpb.ApplicationUp) (bool, error) {
+			a.So(appIDs, should.Resemble, ids.ApplicationIdentifiers)
+			sent = up
+			return true, nil
+		},
+	}
+	ns.sendApplicationDownlinkNack(test.Context(), ids, down)
+
+	if !a.So(sent, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(sent.EndDeviceIdentifiers, should.Resemble, ids)
+	a.So(sent.CorrelationIDs, should.Contain, "test")
+	a.So(sent.GetDownlinkNack(), should.Resemble, down)
+}
@@ -0,0 +1,124 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// devAddrCandidates is the maximum number of DevAddrs generated by newDevAddr to find a DevAddr,
+// which is not assigned to any device yet. This bounds the number of registry lookups per join.
+const devAddrCandidates = 2
+
+// netIDPrefix returns the DevAddr prefix that covers the device addresses of the given NetID.
+func netIDPrefix(netID types.NetID) (types.DevAddrPrefix, error) {
+	devAddr, err := types.NewDevAddr(netID, nil)
+	if err != nil {
+		return types.DevAddrPrefix{}, err
+	}
+	return types.DevAddrPrefix{
+		DevAddr: devAddr,
+		Length:  uint8(32 - types.NwkAddrBits(netID)),
+	}, nil
+}
+
+// parseDevAddrPrefixes parses the DevAddr prefixes, which must be within the DevAddr prefix of netID.
+func parseDevAddrPrefixes(netID types.NetID, prefixes []string) ([]types.DevAddrPrefix, error) {
+	netIDPrefix, err := netIDPrefix(netID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.DevAddrPrefix, 0, len(prefixes))
+	for _, s := range prefixes {
+		var prefix types.DevAddrPrefix
+		if err := prefix.UnmarshalText([]byte(s)); err != nil {
+			return nil, errInvalidDevAddrPrefix.WithAttributes("prefix", s).WithCause(err)
+		}
+		if prefix.Length > 32 {
+			return nil, errInvalidDevAddrPrefix.WithAttributes("prefix", s)
+		}
+		if prefix.Length < netIDPrefix.Length || !prefix.DevAddr.HasPrefix(netIDPrefix) {
+			return nil, errDevAddrPrefixNotInNetID.WithAttributes("prefix", s, "net_id", netID)
+		}
+		res = append(res, prefix)
+	}
+	return res, nil
+}
+
+// applicationPrefixes returns the DevAddr prefixes, from which DevAddrs are allocated to devices of the given application.
+// If no DevAddr prefixes are configured, the DevAddr prefix of the NetID of ns is returned.
+func (ns *NetworkServer) applicationPrefixes(ids ttnpb.ApplicationIdentifiers) []types.DevAddrPrefix {
+	if prefixes, ok := ns.applicationDevAddrPrefixes[ids.ApplicationID]; ok {
+		return prefixes
+	}
+	if len(ns.devAddrPrefixes) > 0 {
+		return ns.devAddrPrefixes
+	}
+	prefix, err := netIDPrefix(ns.NetID)
+	if err != nil {
+		panic(errors.New("failed to create DevAddr prefix of NetID").WithCause(err))
+	}
+	return []types.DevAddrPrefix{prefix}
+}
+
+// ownsDevAddr returns whether devAddr is within the DevAddr prefixes of the given application.
+// If no DevAddr prefixes are configured, any DevAddr is accepted.
+func (ns *NetworkServer) ownsDevAddr(ids ttnpb.ApplicationIdentifiers, devAddr types.DevAddr) bool {
+	if len(ns.devAddrPrefixes) == 0 && len(ns.applicationDevAddrPrefixes) == 0 {
+		return true
+	}
+	for _, prefix := range ns.applicationPrefixes(ids) {
+		if devAddr.HasPrefix(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// newDevAddr generates a DevAddr for specified EndDevice within the DevAddr prefixes of its application.
+// To keep matching of uplinks by DevAddr fast, a random DevAddr not assigned to any device is preferred.
+// At most devAddrCandidates DevAddrs are looked up in the device registry, after which the last candidate is returned.
+// The DevAddr of the current session of dev is never returned, unless it is the only DevAddr available.
+func (ns *NetworkServer) newDevAddr(ctx context.Context, dev *ttnpb.EndDevice) types.DevAddr {
+	prefixes := ns.applicationPrefixes(dev.ApplicationIdentifiers)
+
+	var devAddr types.DevAddr
+	for i := 0; i < devAddrCandidates; i++ {
+		random.Read(devAddr[:])
+		devAddr = devAddr.WithPrefix(prefixes[random.Intn(len(prefixes))])
+		if dev.Session != nil && devAddr.Equal(dev.Session.DevAddr) {
+			continue
+		}
+
+		var assigned bool
+		if err := ns.devices.RangeByAddr(devAddr, nil, func(*ttnpb.EndDevice) bool {
+			assigned = true
+			return false
+		}); err != nil {
+			log.FromContext(ctx).WithError(err).WithField("dev_addr", devAddr).Warn("Failed to look up devices by DevAddr")
+			return devAddr
+		}
+		if !assigned {
+			return devAddr
+		}
+	}
+	return devAddr
+}
@@ -0,0 +1,228 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestNewDevAddr(t *testing.T) {
+	nsPrefixes := []types.DevAddrPrefix{
+		{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 16},
+		{DevAddr: types.DevAddr{0x26, 0x02, 0x00, 0x00}, Length: 16},
+	}
+	appPrefixes := []types.DevAddrPrefix{
+		{DevAddr: types.DevAddr{0x26, 0x03, 0x42, 0x00}, Length: 24},
+	}
+
+	var queried []types.DevAddr
+	assigned := func(devAddr types.DevAddr) int {
+		return int(devAddr[3] % 4)
+	}
+
+	for _, tc := range []struct {
+		Name          string
+		NetID         types.NetID
+		Prefixes      []types.DevAddrPrefix
+		Device        *ttnpb.EndDevice
+		RangeByAddr   func(devAddr types.DevAddr, paths []string, f func(*ttnpb.EndDevice) bool) error
+		Assertion     func(a *assertions.Assertion, devAddr types.DevAddr) bool
+		AllowsAnyAddr bool
+	}{
+		{
+			Name:  "NetID prefix",
+			NetID: types.NetID{0x00, 0x00, 0x13},
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+				},
+			},
+			Assertion: func(a *assertions.Assertion, devAddr types.DevAddr) bool {
+				return a.So(devAddr.HasPrefix(types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x00, 0x00, 0x00}, Length: 7}), should.BeTrue)
+			},
+			AllowsAnyAddr: true,
+		},
+		{
+			Name:     "Network Server prefixes",
+			Prefixes: nsPrefixes,
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+				},
+			},
+			Assertion: func(a *assertions.Assertion, devAddr types.DevAddr) bool {
+				return a.So(devAddr.HasPrefix(nsPrefixes[0]) || devAddr.HasPrefix(nsPrefixes[1]), should.BeTrue)
+			},
+		},
+		{
+			Name:     "Application prefixes",
+			Prefixes: nsPrefixes,
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "other-app"},
+				},
+			},
+			Assertion: func(a *assertions.Assertion, devAddr types.DevAddr) bool {
+				return a.So(devAddr.HasPrefix(appPrefixes[0]), should.BeTrue)
+			},
+		},
+		{
+			Name: "Prefer unassigned",
+			Prefixes: []types.DevAddrPrefix{
+				{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 24},
+			},
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+				},
+			},
+			RangeByAddr: func(devAddr types.DevAddr, paths []string, f func(*ttnpb.EndDevice) bool) error {
+				queried = append(queried, devAddr)
+				for i := 0; i < assigned(devAddr); i++ {
+					if !f(&ttnpb.EndDevice{}) {
+						break
+					}
+				}
+				return nil
+			},
+			Assertion: func(a *assertions.Assertion, devAddr types.DevAddr) bool {
+				defer func() { queried = nil }()
+				if !a.So(queried, should.NotBeEmpty) ||
+					!a.So(len(queried), should.BeLessThanOrEqualTo, devAddrCandidates) ||
+					!a.So(queried[len(queried)-1], should.Equal, devAddr) {
+					return false
+				}
+				for _, q := range queried[:len(queried)-1] {
+					if !a.So(assigned(q), should.BeGreaterThan, 0) {
+						return false
+					}
+				}
+				if len(queried) < devAddrCandidates {
+					return a.So(assigned(devAddr), should.Equal, 0)
+				}
+				return true
+			},
+		},
+		{
+			Name: "Avoid current session",
+			Prefixes: []types.DevAddrPrefix{
+				{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 24},
+			},
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+				},
+				Session: &ttnpb.Session{
+					DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x01},
+				},
+			},
+			RangeByAddr: func(devAddr types.DevAddr, paths []string, f func(*ttnpb.EndDevice) bool) error {
+				return errors.New("test")
+			},
+			Assertion: func(a *assertions.Assertion, devAddr types.DevAddr) bool {
+				return a.So(devAddr, should.NotEqual, types.DevAddr{0x26, 0x01, 0x00, 0x01}) &&
+					a.So(devAddr.HasPrefix(types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 24}), should.BeTrue)
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			rangeByAddr := tc.RangeByAddr
+			if rangeByAddr == nil {
+				rangeByAddr = func(types.DevAddr, []string, func(*ttnpb.EndDevice) bool) error {
+					return nil
+				}
+			}
+			ns := &NetworkServer{
+				NetID:           tc.NetID,
+				devAddrPrefixes: tc.Prefixes,
+				applicationDevAddrPrefixes: map[string][]types.DevAddrPrefix{
+					"other-app": appPrefixes,
+				},
+				devices: &MockDeviceRegistry{
+					RangeByAddrFunc: rangeByAddr,
+				},
+			}
+			if tc.Prefixes == nil {
+				ns.applicationDevAddrPrefixes = nil
+			}
+
+			for i := 0; i < 100; i++ {
+				devAddr := ns.newDevAddr(context.Background(), tc.Device)
+				if !tc.Assertion(a, devAddr) {
+					t.Fatalf("Invalid DevAddr: %s", devAddr)
+				}
+				a.So(ns.ownsDevAddr(tc.Device.ApplicationIdentifiers, devAddr), should.BeTrue)
+			}
+			a.So(ns.ownsDevAddr(tc.Device.ApplicationIdentifiers, types.DevAddr{0xff, 0xff, 0xff, 0xff}), should.Equal, tc.AllowsAnyAddr)
+		})
+	}
+}
+
+func TestParseDevAddrPrefixes(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		NetID    types.NetID
+		Prefixes []string
+		Expected []types.DevAddrPrefix
+		Error    bool
+	}{
+		{
+			Name:     "Within NetID",
+			NetID:    types.NetID{0x00, 0x00, 0x13},
+			Prefixes: []string{"26010000/16", "26000000/7"},
+			Expected: []types.DevAddrPrefix{
+				{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 16},
+				{DevAddr: types.DevAddr{0x26, 0x00, 0x00, 0x00}, Length: 7},
+			},
+		},
+		{
+			Name:     "Outside NetID",
+			NetID:    types.NetID{0x00, 0x00, 0x13},
+			Prefixes: []string{"28010000/16"},
+			Error:    true,
+		},
+		{
+			Name:     "Wider than NetID",
+			NetID:    types.NetID{0x00, 0x00, 0x13},
+			Prefixes: []string{"26000000/6"},
+			Error:    true,
+		},
+		{
+			Name:     "Invalid",
+			Prefixes: []string{"invalid"},
+			Error:    true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			prefixes, err := parseDevAddrPrefixes(tc.NetID, tc.Prefixes)
+			if tc.Error {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(prefixes, should.Resemble, tc.Expected)
+		})
+	}
+}
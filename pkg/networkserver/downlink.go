@@ -18,7 +18,6 @@ import (
 	"context"
 	"fmt"
 	"math"
-	"sort"
 	"time"
 
 	"github.com/mohae/deepcopy"
@@ -76,6 +75,26 @@ func generateDownlink(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, max
 	if maxDownLen < 5 || maxUpLen < 5 {
 		panic("payload length limits too short to generate downlink")
 	}
+
+	if b, down := retransmittedApplicationDownlink(dev); b != nil && len(b) <= int(maxDownLen) {
+		// NOTE: The retransmission of an unacknowledged confirmed downlink reuses the payload of the last transmission,
+		// including the FOpts, such that the frame with the same FCnt is identical.
+		if dev.MACState.DeviceClass == ttnpb.CLASS_C {
+			if dev.MACState.LastConfirmedDownlinkAt != nil && dev.MACState.LastConfirmedDownlinkAt.Add(dev.MACSettings.ClassCTimeout).After(time.Now()) {
+				return nil, nil, errScheduleTooSoon
+			}
+			dev.MACState.LastConfirmedDownlinkAt = timePtr(time.Now().UTC())
+		}
+		dev.QueuedApplicationDownlinks = dev.QueuedApplicationDownlinks[1:]
+		dev.MACState.PendingApplicationDownlink = down
+		dev.MACState.PendingApplicationDownlinkAttempts++
+		logger.WithFields(log.Fields(
+			"attempts", dev.MACState.PendingApplicationDownlinkAttempts,
+			"f_cnt", down.FCnt,
+			"payload_length", len(b),
+		)).Debug("Retransmit confirmed downlink")
+		return b, down, nil
+	}
 	maxDownLen, maxUpLen = maxDownLen-5, maxUpLen-5
 
 	spec := lorawan.DefaultMACCommands
@@ -192,6 +211,12 @@ outer:
 			if down.Confirmed {
 				mType = ttnpb.MType_CONFIRMED_DOWN
 
+				if dev.MACState.PendingApplicationDownlinkAttempts > 0 && pld.FCnt == dev.Session.LastConfFCntDown {
+					// Retransmission of an unacknowledged downlink, which reuses the FCnt.
+					dev.MACState.PendingApplicationDownlinkAttempts++
+				} else {
+					dev.MACState.PendingApplicationDownlinkAttempts = 1
+				}
 				dev.MACState.PendingApplicationDownlink = down
 				dev.Session.LastConfFCntDown = pld.FCnt
 			}
@@ -305,13 +330,17 @@ outer:
 type downlinkPath struct {
 	ttnpb.GatewayIdentifiers
 	*ttnpb.DownlinkPath
+	// roamingNetID is the NetID of the roaming peer, which schedules the downlink on the gateway, if any.
+	roamingNetID *types.NetID
 }
 
-func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
+// downlinkPathsFromMetadata returns the downlink paths of the gateways in mds, ordered by the downlink gateway selection strategy.
+// Gateways that prefer other gateways are ordered last, and gateways that must not be used for downlink are excluded.
+func (ns *NetworkServer) downlinkPathsFromMetadata(ctx context.Context, mds ...*ttnpb.RxMetadata) []downlinkPath {
 	mds = append(mds[:0:0], mds...)
-	sort.SliceStable(mds, func(i, j int) bool {
-		// TODO: Improve the sorting algorithm (https://github.com/TheThingsNetwork/lorawan-stack/issues/13)
-		return mds[i].SNR > mds[j].SNR
+	now := time.Now()
+	ns.downlinkGatewaySelectors[ns.downlinkGatewaySelectionStrategy].SortDownlinkGateways(ctx, mds, func(ids ttnpb.GatewayIdentifiers) int {
+		return ns.txFailures.Count(ids, now)
 	})
 	head := make([]downlinkPath, 0, len(mds))
 	tail := make([]downlinkPath, 0, len(mds))
@@ -327,6 +356,7 @@ func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
 					UplinkToken: md.UplinkToken,
 				},
 			},
+			roamingNetID: roamingNetID(md),
 		}
 		switch md.DownlinkPathConstraint {
 		case ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE:
@@ -339,9 +369,9 @@ func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
 	return append(head, tail...)
 }
 
-func downlinkPathsFromRecentUplinks(ups ...*ttnpb.UplinkMessage) []downlinkPath {
+func (ns *NetworkServer) downlinkPathsFromRecentUplinks(ctx context.Context, ups ...*ttnpb.UplinkMessage) []downlinkPath {
 	for i := len(ups) - 1; i >= 0; i-- {
-		if paths := downlinkPathsFromMetadata(ups[i].RxMetadata...); len(paths) > 0 {
+		if paths := ns.downlinkPathsFromMetadata(ctx, ups[i].RxMetadata...); len(paths) > 0 {
 			return paths
 		}
 	}
@@ -359,8 +389,9 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb
 	logger := log.FromContext(ctx)
 
 	type attempt struct {
-		peer  cluster.Peer
-		paths []*ttnpb.DownlinkPath
+		peer        cluster.Peer
+		roamingPeer *roamingPeer
+		paths       []downlinkPath
 	}
 	attempts := make([]*attempt, 0, len(paths))
 
@@ -369,28 +400,42 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb
 			"gateway_uid", unique.ID(ctx, path.GatewayIdentifiers),
 		)
 
-		p := ns.GetPeer(ctx, ttnpb.PeerInfo_GATEWAY_SERVER, path.GatewayIdentifiers)
-		if p == nil {
-			logger.Debug("Could not get Gateway Server")
-			continue
+		var p cluster.Peer
+		var rp *roamingPeer
+		if path.roamingNetID != nil {
+			rp = ns.roamingPeerByNetID(*path.roamingNetID)
+			if rp == nil {
+				logger.WithField("net_id", *path.roamingNetID).Debug("Could not get roaming peer")
+				continue
+			}
+		} else {
+			p = ns.GetPeer(ctx, ttnpb.PeerInfo_GATEWAY_SERVER, path.GatewayIdentifiers)
+			if p == nil {
+				logger.Debug("Could not get Gateway Server")
+				continue
+			}
 		}
 
 		var a *attempt
-		if len(attempts) > 0 && attempts[len(attempts)-1].peer == p {
+		if len(attempts) > 0 && attempts[len(attempts)-1].peer == p && attempts[len(attempts)-1].roamingPeer == rp {
 			a = attempts[len(attempts)-1]
 		} else {
 			a = &attempt{
-				peer: p,
+				peer:        p,
+				roamingPeer: rp,
 			}
 			attempts = append(attempts, a)
 		}
-		a.paths = append(a.paths, path.DownlinkPath)
+		a.paths = append(a.paths, path)
 	}
 
 	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("ns:downlink:%s", events.NewCorrelationID()))
 	errs := make([]error, 0, len(attempts))
 	for _, a := range attempts {
-		req.DownlinkPaths = a.paths
+		req.DownlinkPaths = make([]*ttnpb.DownlinkPath, 0, len(a.paths))
+		for _, path := range a.paths {
+			req.DownlinkPaths = append(req.DownlinkPaths, path.DownlinkPath)
+		}
 		down := &ttnpb.DownlinkMessage{
 			RawPayload:     b,
 			EndDeviceIDs:   &devID,
@@ -400,8 +445,17 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb
 			},
 		}
 
-		logger.WithField("path_count", len(req.DownlinkPaths)).Debug("Scheduling downlink...")
-		_, err := ttnpb.NewNsGsClient(a.peer.Conn()).ScheduleDownlink(ctx, down, ns.WithClusterAuth())
+		var err error
+		if a.roamingPeer != nil {
+			logger.WithFields(log.Fields(
+				"net_id", a.roamingPeer.netID,
+				"path_count", len(req.DownlinkPaths),
+			)).Debug("Scheduling downlink via roaming peer...")
+			err = ns.scheduleRoamingDownlink(ctx, a.roamingPeer, down, a.paths)
+		} else {
+			logger.WithField("path_count", len(req.DownlinkPaths)).Debug("Scheduling downlink...")
+			_, err = ttnpb.NewNsGsClient(a.peer.Conn()).ScheduleDownlink(ctx, down, ns.WithClusterAuth())
+		}
 		if err != nil {
 			errs = append(errs, err)
 			continue
@@ -453,7 +507,12 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 		logger.Debug("Processing downlink task...")
 
 		var nextDownlinkAt time.Time
-		_, err := ns.devices.SetByID(ctx, devID.ApplicationIdentifiers, devID.DeviceID,
+		var failedDown *ttnpb.ApplicationDownlink
+		// scheduled is the downlink scheduled in an attempt of the transaction below. If the transaction is retried,
+		// the downlink is not scheduled again.
+		var scheduled *ttnpb.DownlinkMessage
+		evtCtx, evts := newEventBufferContext(ctx)
+		_, err := SetDeviceWithRetry(ctx, ns.devices, ns.deviceRegistryRetry, devID.ApplicationIdentifiers, devID.DeviceID,
 			[]string{
 				"frequency_plan_id",
 				"last_dev_status_received_at",
@@ -464,8 +523,29 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 				"recent_downlinks",
 				"recent_uplinks",
 				"session",
+				"stats",
 			},
 			func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+				ctx, logger := evtCtx, logger
+				if scheduled != nil {
+					// A downlink was scheduled in an attempt that was aborted due to a concurrent modification of the device.
+					// Record that the Rx windows are used, so that another downlink is not scheduled in the same windows.
+					if dev == nil {
+						return nil, nil, errDeviceNotFound
+					}
+					if dev.MACState != nil {
+						dev.MACState.RxWindowsAvailable = false
+					}
+					appendRecentDownlink(dev, scheduled, time.Now().UTC())
+					return dev, []string{
+						"mac_state.rx_windows_available",
+						"recent_downlinks",
+						"stats",
+					}, nil
+				}
+				evts.Reset()
+				failedDown, nextDownlinkAt = nil, time.Time{}
+
 				switch {
 				case dev == nil:
 					return nil, nil, errDeviceNotFound
@@ -483,6 +563,16 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 				}
 				logger = logger.WithField("device_class", dev.MACState.DeviceClass)
 
+				if ns.confirmedDownlinkAttempts(dev) > 0 && pendingApplicationDownlinkTimedOut(dev, time.Now()) {
+					if down := ns.handleUnacknowledgedApplicationDownlink(ctx, dev); down != nil {
+						failedDown = down
+						nextDownlinkAt = time.Now()
+						return dev, []string{
+							"mac_state.pending_application_downlink",
+						}, nil
+					}
+				}
+
 				fp, band, err := getDeviceBandVersion(dev, ns.FrequencyPlans)
 				if err != nil {
 					return nil, nil, errUnknownBand.WithCause(err)
@@ -556,7 +646,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 							req,
 							dev.EndDeviceIdentifiers,
 							dev.MACState.QueuedJoinAccept.Payload,
-							downlinkPathsFromRecentUplinks(dev.RecentUplinks...)...,
+							ns.downlinkPathsFromRecentUplinks(ctx, dev.RecentUplinks...)...,
 						)
 						if err != nil {
 							scheduleErr = true
@@ -568,10 +658,8 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 								SessionKeys: dev.MACState.QueuedJoinAccept.Keys,
 							}
 							dev.MACState.QueuedJoinAccept = nil
-							dev.RecentDownlinks = append(dev.RecentDownlinks, down)
-							if len(dev.RecentDownlinks) > recentDownlinkCount {
-								dev.RecentDownlinks = append(dev.RecentDownlinks[:0], dev.RecentDownlinks[len(dev.RecentDownlinks)-recentDownlinkCount:]...)
-							}
+							scheduled = down
+							appendRecentDownlink(dev, down, time.Now().UTC())
 							return dev, []string{
 								"ids.dev_addr",
 								"mac_state.pending_join_request",
@@ -579,6 +667,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 								"mac_state.rx_windows_available",
 								"pending_session",
 								"recent_downlinks",
+								"stats",
 							}, nil
 						}
 
@@ -616,7 +705,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 							req,
 							dev.EndDeviceIdentifiers,
 							b,
-							downlinkPathsFromRecentUplinks(dev.RecentUplinks...)...,
+							ns.downlinkPathsFromRecentUplinks(ctx, dev.RecentUplinks...)...,
 						)
 						if err != nil {
 							scheduleErr = true
@@ -625,15 +714,14 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 								go ns.sendQueueInvalidationToAS(ctx, dev)
 							}
 							dev.MACState.RxWindowsAvailable = false
-							dev.RecentDownlinks = append(dev.RecentDownlinks, down)
-							if len(dev.RecentDownlinks) > recentDownlinkCount {
-								dev.RecentDownlinks = append(dev.RecentDownlinks[:0], dev.RecentDownlinks[len(dev.RecentDownlinks)-recentDownlinkCount:]...)
-							}
+							scheduled = down
+							appendRecentDownlink(dev, down, time.Now().UTC())
 							return dev, []string{
 								"mac_state",
 								"queued_application_downlinks",
 								"recent_downlinks",
 								"session",
+								"stats",
 							}, nil
 						}
 
@@ -684,7 +772,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 								})
 							}
 						} else if appDown == nil || appDown.ClassBC == nil {
-							paths = downlinkPathsFromRecentUplinks(dev.RecentUplinks...)
+							paths = ns.downlinkPathsFromRecentUplinks(ctx, dev.RecentUplinks...)
 						}
 						// NOTE: We must skip Rx1 if appDown.ClassBC.AbsoluteTime is set
 
@@ -711,15 +799,14 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 									go ns.sendQueueInvalidationToAS(ctx, dev)
 								}
 								dev.MACState.RxWindowsAvailable = false
-								dev.RecentDownlinks = append(dev.RecentDownlinks, down)
-								if len(dev.RecentDownlinks) > recentDownlinkCount {
-									dev.RecentDownlinks = append(dev.RecentDownlinks[:0], dev.RecentDownlinks[len(dev.RecentDownlinks)-recentDownlinkCount:]...)
-								}
+								scheduled = down
+								appendRecentDownlink(dev, down, time.Now().UTC())
 								return dev, []string{
 									"mac_state",
 									"queued_application_downlinks",
 									"recent_downlinks",
 									"session",
+									"stats",
 								}, nil
 							}
 						}
@@ -769,7 +856,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 						}
 						up := dev.RecentUplinks[len(dev.RecentUplinks)-1]
 						ctx = events.ContextWithCorrelationID(ctx, up.CorrelationIDs...)
-						paths = downlinkPathsFromRecentUplinks(dev.RecentUplinks...)
+						paths = ns.downlinkPathsFromRecentUplinks(ctx, dev.RecentUplinks...)
 					}
 
 					down, err := ns.scheduleDownlinkByPaths(
@@ -789,20 +876,22 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 						if appDown == nil && len(dev.QueuedApplicationDownlinks) > 0 && dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
 							go ns.sendQueueInvalidationToAS(ctx, dev)
 						}
-						dev.RecentDownlinks = append(dev.RecentDownlinks, down)
-						if len(dev.RecentDownlinks) > recentDownlinkCount {
-							dev.RecentDownlinks = append(dev.RecentDownlinks[:0], dev.RecentDownlinks[len(dev.RecentDownlinks)-recentDownlinkCount:]...)
-						}
+						scheduled = down
+						appendRecentDownlink(dev, down, time.Now().UTC())
 						return dev, []string{
 							"mac_state",
 							"queued_application_downlinks",
 							"recent_downlinks",
 							"session",
+							"stats",
 						}, nil
 					}
 				}
 				return nil, nil, errSchedule
 			})
+		if err == nil {
+			evts.Publish()
+		}
 
 		switch {
 		case scheduleErr:
@@ -818,6 +907,9 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 			setErr = true
 			logger.WithError(err).Error("Failed to update device in registry")
 			return err
+
+		case failedDown != nil:
+			ns.sendApplicationDownlinkNack(ctx, devID, failedDown)
 		}
 
 		if nextDownlinkAt.IsZero() {
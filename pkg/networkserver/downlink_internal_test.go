@@ -273,6 +273,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"recent_downlinks",
 					"recent_uplinks",
 					"session",
+					"stats",
 				})
 
 				defer test.MustIncrementContextCounter(ctx, setByIDCallKey{}, 1)
@@ -289,6 +290,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"queued_application_downlinks",
 					"recent_downlinks",
 					"session",
+					"stats",
 				})
 				if !a.So(ret, should.NotBeNil) || !a.So(ret.RecentDownlinks, should.HaveLength, 1) {
 					t.FailNow()
@@ -348,6 +350,12 @@ func TestProcessDownlinkTask(t *testing.T) {
 						},
 					},
 				})
+				if a.So(ret.Stats, should.NotBeNil) && a.So(ret.Stats.LastDownlinkScheduledAt, should.NotBeNil) {
+					expected.Stats = &ttnpb.EndDeviceStats{
+						LastDownlinkScheduledAt: ret.Stats.LastDownlinkScheduledAt,
+						DownlinkCount:           1,
+					}
+				}
 				a.So(ret, should.Resemble, expected)
 
 				return ret, nil
@@ -701,6 +709,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"recent_downlinks",
 					"recent_uplinks",
 					"session",
+					"stats",
 				})
 
 				defer test.MustIncrementContextCounter(ctx, setByIDCallKey{}, 1)
@@ -717,6 +726,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"queued_application_downlinks",
 					"recent_downlinks",
 					"session",
+					"stats",
 				})
 				if !a.So(ret, should.NotBeNil) || !a.So(ret.RecentDownlinks, should.HaveLength, 1) {
 					t.FailNow()
@@ -774,6 +784,12 @@ func TestProcessDownlinkTask(t *testing.T) {
 						},
 					},
 				})
+				if a.So(ret.Stats, should.NotBeNil) && a.So(ret.Stats.LastDownlinkScheduledAt, should.NotBeNil) {
+					expected.Stats = &ttnpb.EndDeviceStats{
+						LastDownlinkScheduledAt: ret.Stats.LastDownlinkScheduledAt,
+						DownlinkCount:           1,
+					}
+				}
 				a.So(ret, should.Resemble, expected)
 
 				return ret, nil
@@ -1118,6 +1134,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"recent_downlinks",
 					"recent_uplinks",
 					"session",
+					"stats",
 				})
 
 				defer test.MustIncrementContextCounter(ctx, setByIDCallKey{}, 1)
@@ -1134,6 +1151,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"queued_application_downlinks",
 					"recent_downlinks",
 					"session",
+					"stats",
 				})
 				if !a.So(ret, should.NotBeNil) || !a.So(ret.RecentDownlinks, should.HaveLength, 1) {
 					t.FailNow()
@@ -1188,6 +1206,12 @@ func TestProcessDownlinkTask(t *testing.T) {
 						},
 					},
 				})
+				if a.So(ret.Stats, should.NotBeNil) && a.So(ret.Stats.LastDownlinkScheduledAt, should.NotBeNil) {
+					expected.Stats = &ttnpb.EndDeviceStats{
+						LastDownlinkScheduledAt: ret.Stats.LastDownlinkScheduledAt,
+						DownlinkCount:           1,
+					}
+				}
 				a.So(ret, should.Resemble, expected)
 
 				return ret, nil
@@ -1700,6 +1724,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"recent_downlinks",
 					"recent_uplinks",
 					"session",
+					"stats",
 				})
 
 				defer test.MustIncrementContextCounter(ctx, setByIDCallKey{}, 1)
@@ -1716,6 +1741,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"queued_application_downlinks",
 					"recent_downlinks",
 					"session",
+					"stats",
 				})
 				if !a.So(ret, should.NotBeNil) || !a.So(ret.RecentDownlinks, should.HaveLength, 1) {
 					t.FailNow()
@@ -1773,6 +1799,12 @@ func TestProcessDownlinkTask(t *testing.T) {
 						},
 					},
 				})
+				if a.So(ret.Stats, should.NotBeNil) && a.So(ret.Stats.LastDownlinkScheduledAt, should.NotBeNil) {
+					expected.Stats = &ttnpb.EndDeviceStats{
+						LastDownlinkScheduledAt: ret.Stats.LastDownlinkScheduledAt,
+						DownlinkCount:           1,
+					}
+				}
 				a.So(ret, should.Resemble, expected)
 
 				return ret, nil
@@ -2286,6 +2318,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"recent_downlinks",
 					"recent_uplinks",
 					"session",
+					"stats",
 				})
 
 				defer test.MustIncrementContextCounter(ctx, setByIDCallKey{}, 1)
@@ -2304,6 +2337,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 					"mac_state.rx_windows_available",
 					"pending_session",
 					"recent_downlinks",
+					"stats",
 				})
 				if !a.So(ret, should.NotBeNil) || !a.So(ret.RecentDownlinks, should.HaveLength, 1) {
 					t.FailNow()
@@ -2352,6 +2386,12 @@ func TestProcessDownlinkTask(t *testing.T) {
 						},
 					},
 				})
+				if a.So(ret.Stats, should.NotBeNil) && a.So(ret.Stats.LastDownlinkScheduledAt, should.NotBeNil) {
+					expected.Stats = &ttnpb.EndDeviceStats{
+						LastDownlinkScheduledAt: ret.Stats.LastDownlinkScheduledAt,
+						DownlinkCount:           1,
+					}
+				}
 				a.So(ret, should.Resemble, expected)
 
 				return ret, nil
@@ -2639,6 +2679,29 @@ func TestGenerateDownlink(t *testing.T) {
 		return
 	}
 
+	// retransmittedPayload is the payload of the last transmission of an unacknowledged confirmed downlink,
+	// which includes an ack and MAC commands, which are not generated anymore.
+	retransmittedPayload := encodeMessage(&ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_CONFIRMED_DOWN,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_MACPayload{
+			MACPayload: &ttnpb.MACPayload{
+				FHDR: ttnpb.FHDR{
+					DevAddr: DevAddr,
+					FCtrl: ttnpb.FCtrl{
+						Ack: true,
+					},
+					FCnt:  42,
+					FOpts: encodeMAC(ttnpb.CID_DEV_STATUS.MACCommand()),
+				},
+				FPort:      1,
+				FRMPayload: []byte("test"),
+			},
+		},
+	}, ttnpb.MAC_V1_1, 24)
+
 	for _, tc := range []struct {
 		Name       string
 		Device     *ttnpb.EndDevice
@@ -2999,9 +3062,136 @@ func TestGenerateDownlink(t *testing.T) {
 			DeviceDiff: func(dev *ttnpb.EndDevice) {
 				i := len(dev.QueuedApplicationDownlinks) - 1
 				dev.QueuedApplicationDownlinks, dev.MACState.PendingApplicationDownlink = dev.QueuedApplicationDownlinks[:i], dev.QueuedApplicationDownlinks[i]
+				dev.MACState.PendingApplicationDownlinkAttempts = 1
 				dev.Session.LastConfFCntDown = 42
 			},
 		},
+		{
+			Name:    "1.1/confirmed app downlink/no MAC/no ack/retransmission",
+			Context: test.Context(),
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: ApplicationID},
+					DeviceID:               DeviceID,
+					DevAddr:                &DevAddr,
+				},
+				MACSettings: &ttnpb.MACSettings{},
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion:                     ttnpb.MAC_V1_1,
+					PendingApplicationDownlinkAttempts: 1,
+				},
+				Session: &ttnpb.Session{
+					LastConfFCntDown: 42,
+					SessionKeys: ttnpb.SessionKeys{
+						NwkSEncKey: &ttnpb.KeyEnvelope{
+							Key: NwkSEncKey[:],
+						},
+						SNwkSIntKey: &ttnpb.KeyEnvelope{
+							Key: SNwkSIntKey[:],
+						},
+					},
+				},
+				QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+					{
+						Confirmed:  true,
+						FCnt:       42,
+						FPort:      1,
+						FRMPayload: []byte("test"),
+					},
+				},
+				LoRaWANPHYVersion:       ttnpb.PHY_V1_1_REV_B,
+				LastDevStatusReceivedAt: TimePtr(time.Unix(42, 0)),
+				RecentUplinks: []*ttnpb.UplinkMessage{{
+					Payload: &ttnpb.Message{
+						MHDR: ttnpb.MHDR{
+							MType: ttnpb.MType_UNCONFIRMED_UP,
+						},
+						Payload: &ttnpb.Message_MACPayload{MACPayload: &ttnpb.MACPayload{}},
+					},
+				}},
+			},
+			Bytes: encodeMessage(&ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_CONFIRMED_DOWN,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_MACPayload{
+					MACPayload: &ttnpb.MACPayload{
+						FHDR: ttnpb.FHDR{
+							DevAddr: DevAddr,
+							FCtrl: ttnpb.FCtrl{
+								Ack: false,
+							},
+							FCnt: 42,
+						},
+						FPort:      1,
+						FRMPayload: []byte("test"),
+					},
+				},
+			}, ttnpb.MAC_V1_1, 0),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				i := len(dev.QueuedApplicationDownlinks) - 1
+				dev.QueuedApplicationDownlinks, dev.MACState.PendingApplicationDownlink = dev.QueuedApplicationDownlinks[:i], dev.QueuedApplicationDownlinks[i]
+				dev.MACState.PendingApplicationDownlinkAttempts = 2
+			},
+		},
+		{
+			Name:    "1.1/confirmed app downlink/no MAC/no ack/retransmission of last transmission",
+			Context: test.Context(),
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: ApplicationID},
+					DeviceID:               DeviceID,
+					DevAddr:                &DevAddr,
+				},
+				MACSettings: &ttnpb.MACSettings{},
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion:                     ttnpb.MAC_V1_1,
+					PendingApplicationDownlinkAttempts: 1,
+					PendingRequests: []*ttnpb.MACCommand{
+						ttnpb.CID_DEV_STATUS.MACCommand(),
+					},
+				},
+				Session: &ttnpb.Session{
+					LastConfFCntDown: 42,
+					SessionKeys: ttnpb.SessionKeys{
+						NwkSEncKey: &ttnpb.KeyEnvelope{
+							Key: NwkSEncKey[:],
+						},
+						SNwkSIntKey: &ttnpb.KeyEnvelope{
+							Key: SNwkSIntKey[:],
+						},
+					},
+				},
+				QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+					{
+						Confirmed:  true,
+						FCnt:       42,
+						FPort:      1,
+						FRMPayload: []byte("test"),
+					},
+				},
+				LoRaWANPHYVersion:       ttnpb.PHY_V1_1_REV_B,
+				LastDevStatusReceivedAt: TimePtr(time.Unix(42, 0)),
+				RecentUplinks: []*ttnpb.UplinkMessage{{
+					Payload: &ttnpb.Message{
+						MHDR: ttnpb.MHDR{
+							MType: ttnpb.MType_UNCONFIRMED_UP,
+						},
+						Payload: &ttnpb.Message_MACPayload{MACPayload: &ttnpb.MACPayload{}},
+					},
+				}},
+				RecentDownlinks: []*ttnpb.DownlinkMessage{
+					{RawPayload: retransmittedPayload},
+				},
+			},
+			Bytes: retransmittedPayload,
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				i := len(dev.QueuedApplicationDownlinks) - 1
+				dev.QueuedApplicationDownlinks, dev.MACState.PendingApplicationDownlink = dev.QueuedApplicationDownlinks[:i], dev.QueuedApplicationDownlinks[i]
+				dev.MACState.PendingApplicationDownlinkAttempts = 2
+			},
+		},
 		{
 			Name:    "1.1/confirmed app downlink/no MAC/ack",
 			Context: test.Context(),
@@ -3072,6 +3262,7 @@ func TestGenerateDownlink(t *testing.T) {
 			DeviceDiff: func(dev *ttnpb.EndDevice) {
 				i := len(dev.QueuedApplicationDownlinks) - 1
 				dev.QueuedApplicationDownlinks, dev.MACState.PendingApplicationDownlink = dev.QueuedApplicationDownlinks[:i], dev.QueuedApplicationDownlinks[i]
+				dev.MACState.PendingApplicationDownlinkAttempts = 1
 				dev.Session.LastConfFCntDown = 42
 			},
 		},
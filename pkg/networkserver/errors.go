@@ -19,53 +19,71 @@ import (
 )
 
 var (
-	errCIDOutOfRange             = errors.DefineInvalidArgument("cid_out_of_range", "CID must be in range from {min} to {max}")
-	errComputeMIC                = errors.DefineInvalidArgument("compute_mic", "failed to compute MIC")
-	errCorruptedMACState         = errors.DefineCorruption("corrupted_mac_state", "MAC state is corrupted")
-	errDataRateNotFound          = errors.DefineNotFound("data_rate_not_found", "data rate not found")
-	errDecodePayload             = errors.DefineInvalidArgument("decode_payload", "failed to decode payload")
-	errDecrypt                   = errors.DefineInvalidArgument("decrypt", "failed to decrypt")
-	errDeviceNotFound            = errors.DefineNotFound("device_not_found", "device not found")
-	errDuplicateCIDHandler       = errors.DefineAlreadyExists("duplicate_cid_handler", "a handler for MAC command with CID {cid} is already registered")
-	errDuplicateIdentifiers      = errors.DefineAlreadyExists("duplicate_identifiers", "a device identified by the identifiers already exists")
-	errDuplicateSubscription     = errors.DefineAlreadyExists("duplicate_subscription", "another subscription already started")
-	errEmptySession              = errors.DefineFailedPrecondition("empty_session", "session in empty")
-	errEncodeMAC                 = errors.DefineInternal("encode_mac", "failed to encode MAC commands")
-	errEncodePayload             = errors.Define("encode_payload", "failed to encode payload")
-	errEncryptMAC                = errors.DefineInternal("encrypt_mac", "failed to encrypt MAC commands")
-	errFCntTooHigh               = errors.DefineInvalidArgument("f_cnt_too_high", "FCnt is too high")
-	errGatewayServerNotFound     = errors.DefineNotFound("gateway_server_not_found", "Gateway Server not found")
-	errInvalidADRMargin          = errors.DefineInvalidArgument("adr_margin", "invalid ADR margin")
-	errInvalidChannelIndex       = errors.DefineInvalidArgument("channel_index", "invalid channel index")
-	errInvalidClassBTimeout      = errors.DefineInvalidArgument("class_b_timeout", "invalid class B timeout")
-	errInvalidClassCTimeout      = errors.DefineInvalidArgument("class_c_timeout", "invalid class C timeout")
-	errInvalidConfiguration      = errors.DefineInvalidArgument("configuration", "invalid configuration")
-	errInvalidDataRate           = errors.DefineInvalidArgument("data_rate", "invalid data rate")
-	errInvalidFieldMask          = errors.DefineInvalidArgument("field_mask", "invalid field mask")
-	errInvalidFNwkSIntKey        = errors.DefineInvalidArgument("invalid_f_nwk_s_int_key", "invalid FNwkSIntKey")
-	errInvalidNwkSEncKey         = errors.DefineInvalidArgument("invalid_nwk_s_enc_key", "invalid NwkSEncKey")
-	errInvalidPayload            = errors.DefineInvalidArgument("payload", "invalid payload")
-	errInvalidRx2DataRateIndex   = errors.DefineInvalidArgument("rx2_data_rate_index", "invalid Rx2 data rate index")
-	errInvalidSNwkSIntKey        = errors.DefineInvalidArgument("invalid_s_nwk_s_int_key", "invalid SNwkSIntKey")
-	errJoinServerNotFound        = errors.DefineNotFound("join_server_not_found", "Join Server not found")
-	errMACRequestNotFound        = errors.DefineInvalidArgument("mac_request_not_found", "MAC response received, but corresponding request not found")
-	errNoFrequencyPlan           = errors.DefineInvalidArgument("no_frequency_plan", "no frequency plan specified")
-	errNoMACSettings             = errors.DefineInvalidArgument("no_mac_settings", "no mac settings specified")
-	errNoPath                    = errors.DefineNotFound("no_downlink_path", "no downlink path available")
-	errNoPayload                 = errors.DefineInvalidArgument("no_payload", "no message payload specified")
-	errNoRekey                   = errors.DefineInvalidArgument("no_rekey", "rekey not received after join-accept")
-	errOutdatedData              = errors.DefineNotFound("outdated_data", "data is outdated")
-	errRawPayloadTooShort        = errors.Define("raw_payload_too_short", "length of RawPayload must not be less than 4")
-	errSchedule                  = errors.Define("schedule", "all downlink scheduling attempts failed")
-	errScheduleTooSoon           = errors.DefineUnavailable("schedule_too_soon", "confirmed downlink is scheduled too soon")
-	errUnknownBand               = errors.Define("unknown_band", "band is unknown")
-	errUnknownChannel            = errors.Define("unknown_chanel", "channel is unknown")
-	errUnknownFNwkSIntKey        = errors.DefineNotFound("unknown_f_nwk_s_int_key", "FNwkSIntKey is unknown")
-	errUnknownFrequencyPlan      = errors.Define("unknown_frequency_plan", "frequency plan is unknown")
-	errUnknownMACState           = errors.DefineFailedPrecondition("unknown_mac_state", "MAC state is unknown")
-	errUnknownNwkSEncKey         = errors.DefineNotFound("unknown_nwk_s_enc_key", "NwkSEncKey is unknown")
-	errUnknownSNwkSIntKey        = errors.DefineNotFound("unknown_s_nwk_s_int_key", "SNwkSIntKey is unknown")
-	errUnsupportedLoRaWANVersion = errors.DefineInvalidArgument("unsupported_lorawan_version", "unsupported LoRaWAN version: {version}", "version")
-	errUplinkChannelNotFound     = errors.DefineNotFound("uplink_channel_not_found", "uplink channel not found")
-	errUplinkNotFound            = errors.DefineNotFound("uplink_not_found", "uplink not found")
+	errADRAlgorithmNotFound            = errors.DefineNotFound("adr_algorithm_not_found", "ADR algorithm `{name}` not found")
+	errCIDOutOfRange                   = errors.DefineInvalidArgument("cid_out_of_range", "CID must be in range from {min} to {max}")
+	errComputeMIC                      = errors.DefineInvalidArgument("compute_mic", "failed to compute MIC")
+	errConfirmedDownlinkTimeout        = errors.DefineDeadlineExceeded("confirmed_downlink_timeout", "confirmed downlink not acknowledged after `{attempts}` attempts")
+	errCorruptedMACState               = errors.DefineCorruption("corrupted_mac_state", "MAC state is corrupted")
+	errDataRateNotFound                = errors.DefineNotFound("data_rate_not_found", "data rate not found")
+	errDecodePayload                   = errors.DefineInvalidArgument("decode_payload", "failed to decode payload")
+	errDecrypt                         = errors.DefineInvalidArgument("decrypt", "failed to decrypt")
+	errDevAddrNotOwned                 = errors.DefineInvalidArgument("dev_addr_not_owned", "DevAddr `{dev_addr}` is not within the DevAddr prefixes of the Network Server")
+	errDevAddrPrefixNotInNetID         = errors.DefineInvalidArgument("dev_addr_prefix_not_in_net_id", "DevAddr prefix `{prefix}` is not within NetID `{net_id}`")
+	errDeviceNotFound                  = errors.DefineNotFound("device_not_found", "device not found")
+	errDeviceRegistered                = errors.DefineFailedPrecondition("device_registered", "device `{device_uid}` is registered in the Entity Registry")
+	errDownlinkGatewaySelectorNotFound = errors.DefineNotFound("downlink_gateway_selector_not_found", "downlink gateway selection strategy `{name}` not found")
+	errDuplicateCIDHandler             = errors.DefineAlreadyExists("duplicate_cid_handler", "a handler for MAC command with CID {cid} is already registered")
+	errDuplicateIdentifiers            = errors.DefineAlreadyExists("duplicate_identifiers", "a device identified by the identifiers already exists")
+	errDuplicateSubscription           = errors.DefineAlreadyExists("duplicate_subscription", "another subscription already started")
+	errEmptySession                    = errors.DefineFailedPrecondition("empty_session", "session in empty")
+	errEncodeMAC                       = errors.DefineInternal("encode_mac", "failed to encode MAC commands")
+	errEncodePayload                   = errors.Define("encode_payload", "failed to encode payload")
+	errEncryptMAC                      = errors.DefineInternal("encrypt_mac", "failed to encrypt MAC commands")
+	errEntityRegistryNotFound          = errors.DefineNotFound("entity_registry_not_found", "Entity Registry not found")
+	errFCntTooHigh                     = errors.DefineInvalidArgument("f_cnt_too_high", "FCnt is too high")
+	errGatewayServerNotFound           = errors.DefineNotFound("gateway_server_not_found", "Gateway Server not found")
+	errIncompleteListing               = errors.DefineUnavailable("incomplete_listing", "listed {listed} of {total} devices")
+	errInvalidADRMargin                = errors.DefineInvalidArgument("adr_margin", "invalid ADR margin")
+	errInvalidChannelIndex             = errors.DefineInvalidArgument("channel_index", "invalid channel index")
+	errInvalidClassBTimeout            = errors.DefineInvalidArgument("class_b_timeout", "invalid class B timeout")
+	errInvalidClassCTimeout            = errors.DefineInvalidArgument("class_c_timeout", "invalid class C timeout")
+	errInvalidConfiguration            = errors.DefineInvalidArgument("configuration", "invalid configuration")
+	errInvalidDataRate                 = errors.DefineInvalidArgument("data_rate", "invalid data rate")
+	errInvalidDevAddrPrefix            = errors.DefineInvalidArgument("dev_addr_prefix", "invalid DevAddr prefix `{prefix}`")
+	errInvalidFNwkSIntKey              = errors.DefineInvalidArgument("invalid_f_nwk_s_int_key", "invalid FNwkSIntKey")
+	errInvalidFieldMask                = errors.DefineInvalidArgument("field_mask", "invalid field mask")
+	errInvalidNwkSEncKey               = errors.DefineInvalidArgument("invalid_nwk_s_enc_key", "invalid NwkSEncKey")
+	errInvalidPayload                  = errors.DefineInvalidArgument("payload", "invalid payload")
+	errInvalidRoamingMessage           = errors.DefineInvalidArgument("roaming_message", "invalid roaming message")
+	errInvalidRoamingPeer              = errors.DefineInvalidArgument("roaming_peer", "invalid roaming peer `{net_id}`")
+	errInvalidRx2DataRateIndex         = errors.DefineInvalidArgument("rx2_data_rate_index", "invalid Rx2 data rate index")
+	errInvalidSNwkSIntKey              = errors.DefineInvalidArgument("invalid_s_nwk_s_int_key", "invalid SNwkSIntKey")
+	errJoinServerNotFound              = errors.DefineNotFound("join_server_not_found", "Join Server not found")
+	errMACProfileNotFound              = errors.DefineNotFound("mac_profile_not_found", "MAC profile `{name}` not found")
+	errMACRequestNotFound              = errors.DefineInvalidArgument("mac_request_not_found", "MAC response received, but corresponding request not found")
+	errNoDownlinkMessage               = errors.DefineInvalidArgument("no_downlink_message", "no downlink message specified")
+	errNoFrequencyPlan                 = errors.DefineInvalidArgument("no_frequency_plan", "no frequency plan specified")
+	errNoMACSettings                   = errors.DefineInvalidArgument("no_mac_settings", "no mac settings specified")
+	errNoPath                          = errors.DefineNotFound("no_downlink_path", "no downlink path available")
+	errNoPayload                       = errors.DefineInvalidArgument("no_payload", "no message payload specified")
+	errNoRekey                         = errors.DefineInvalidArgument("no_rekey", "rekey not received after join-accept")
+	errOutdatedData                    = errors.DefineNotFound("outdated_data", "data is outdated")
+	errRawPayloadTooShort              = errors.Define("raw_payload_too_short", "length of RawPayload must not be less than 4")
+	errRoamingPeerNotFound             = errors.DefineNotFound("roaming_peer_not_found", "roaming peer `{net_id}` not found")
+	errRoamingRequest                  = errors.DefineUnavailable("roaming_request", "request to roaming peer `{net_id}` failed with status `{code}`")
+	errRoamingResult                   = errors.DefineUnavailable("roaming_result", "roaming peer `{net_id}` answered with result `{result_code}`: {description}")
+	errRoamingUnauthenticated          = errors.DefineUnauthenticated("roaming_unauthenticated", "roaming peer `{net_id}` is not authenticated")
+	errSchedule                        = errors.Define("schedule", "all downlink scheduling attempts failed")
+	errScheduleTooSoon                 = errors.DefineUnavailable("schedule_too_soon", "confirmed downlink is scheduled too soon")
+	errUnknownBand                     = errors.Define("unknown_band", "band is unknown")
+	errUnknownChannel                  = errors.Define("unknown_chanel", "channel is unknown")
+	errUnknownFNwkSIntKey              = errors.DefineNotFound("unknown_f_nwk_s_int_key", "FNwkSIntKey is unknown")
+	errUnknownFrequencyPlan            = errors.Define("unknown_frequency_plan", "frequency plan is unknown")
+	errUnknownMACState                 = errors.DefineFailedPrecondition("unknown_mac_state", "MAC state is unknown")
+	errUnknownNwkSEncKey               = errors.DefineNotFound("unknown_nwk_s_enc_key", "NwkSEncKey is unknown")
+	errUnknownRFRegion                 = errors.DefineInvalidArgument("unknown_rf_region", "RF region `{rf_region}` is unknown")
+	errUnknownSNwkSIntKey              = errors.DefineNotFound("unknown_s_nwk_s_int_key", "SNwkSIntKey is unknown")
+	errUnsupportedLoRaWANVersion       = errors.DefineInvalidArgument("unsupported_lorawan_version", "unsupported LoRaWAN version: {version}", "version")
+	errUplinkChannelNotFound           = errors.DefineNotFound("uplink_channel_not_found", "uplink channel not found")
+	errUplinkNotFound                  = errors.DefineNotFound("uplink_not_found", "uplink not found")
 )
@@ -0,0 +1,153 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// DownlinkGatewaySelector orders the gateways, which received an uplink message, by preference for transmitting downlink.
+type DownlinkGatewaySelector interface {
+	// SortDownlinkGateways sorts mds in place, most preferred gateway first.
+	// txFailures returns the number of recent failed transmissions of the gateway.
+	// DownlinkPathConstraint is applied by the Network Server after sorting.
+	SortDownlinkGateways(ctx context.Context, mds []*ttnpb.RxMetadata, txFailures func(ttnpb.GatewayIdentifiers) int)
+}
+
+// DownlinkGatewaySelectorFunc is a function, which implements DownlinkGatewaySelector.
+type DownlinkGatewaySelectorFunc func(ctx context.Context, mds []*ttnpb.RxMetadata, txFailures func(ttnpb.GatewayIdentifiers) int)
+
+// SortDownlinkGateways implements DownlinkGatewaySelector.
+func (f DownlinkGatewaySelectorFunc) SortDownlinkGateways(ctx context.Context, mds []*ttnpb.RxMetadata, txFailures func(ttnpb.GatewayIdentifiers) int) {
+	f(ctx, mds, txFailures)
+}
+
+const (
+	// SignalDownlinkGatewaySelection is the name of the downlink gateway selection strategy, which prefers
+	// the gateway with the highest SNR. This is the default strategy.
+	SignalDownlinkGatewaySelection = "signal"
+	// BalancedDownlinkGatewaySelection is the name of the downlink gateway selection strategy, which scores gateways
+	// by signal quality, downlink utilization, queue depth and recent Tx failures, and spreads load across
+	// gateways with similar scores.
+	BalancedDownlinkGatewaySelection = "balanced"
+)
+
+// DefaultTxFailureWindow is the default time window in which failed transmissions of a gateway are taken into account.
+const DefaultTxFailureWindow = 10 * time.Minute
+
+// Score returns the score of md, given the number of recent failed transmissions of the gateway.
+func (s BalancedDownlinkGatewayScore) Score(md *ttnpb.RxMetadata, txFailures int) float64 {
+	return s.SNRWeight*float64(md.SNR) +
+		s.RSSIWeight*float64(md.RSSI) -
+		s.UtilizationWeight*float64(md.DownlinkUtilization) -
+		s.QueueDepthWeight*float64(md.DownlinkQueueDepth) -
+		s.TxFailureWeight*float64(txFailures)
+}
+
+// SortDownlinkGateways implements DownlinkGatewaySelector.
+func (s BalancedDownlinkGatewayScore) SortDownlinkGateways(ctx context.Context, mds []*ttnpb.RxMetadata, txFailures func(ttnpb.GatewayIdentifiers) int) {
+	if len(mds) == 0 {
+		return
+	}
+	scores := make(map[*ttnpb.RxMetadata]float64, len(mds))
+	for _, md := range mds {
+		scores[md] = s.Score(md, txFailures(md.GatewayIdentifiers))
+	}
+	sort.SliceStable(mds, func(i, j int) bool {
+		return scores[mds[i]] > scores[mds[j]]
+	})
+	if s.LoadSpreadingMargin <= 0 {
+		return
+	}
+	n := 1
+	for n < len(mds) && scores[mds[0]]-scores[mds[n]] <= s.LoadSpreadingMargin {
+		n++
+	}
+	for i := n - 1; i > 0; i-- {
+		j := random.Intn(i + 1)
+		mds[i], mds[j] = mds[j], mds[i]
+	}
+}
+
+// sortBySNR implements SignalDownlinkGatewaySelection.
+func sortBySNR(ctx context.Context, mds []*ttnpb.RxMetadata, _ func(ttnpb.GatewayIdentifiers) int) {
+	sort.SliceStable(mds, func(i, j int) bool {
+		return mds[i].SNR > mds[j].SNR
+	})
+}
+
+// defaultDownlinkGatewaySelectors returns the downlink gateway selection strategies provided by the Network Server by name.
+func defaultDownlinkGatewaySelectors(conf DownlinkGatewaySelectionConfig) map[string]DownlinkGatewaySelector {
+	return map[string]DownlinkGatewaySelector{
+		SignalDownlinkGatewaySelection:   DownlinkGatewaySelectorFunc(sortBySNR),
+		BalancedDownlinkGatewaySelection: conf.Balanced,
+	}
+}
+
+// txFailureTracker keeps track of the failed transmissions of gateways within a time window.
+// The failures are kept in memory and are not shared between Network Server instances: when multiple instances
+// handle the downlink of a gateway, each instance only accounts for the failed transmissions it has seen itself,
+// and the failures are lost when the instance restarts.
+type txFailureTracker struct {
+	window time.Duration
+
+	mu       sync.Mutex
+	failures map[string][]time.Time
+}
+
+func newTxFailureTracker(window time.Duration) *txFailureTracker {
+	return &txFailureTracker{
+		window:   window,
+		failures: make(map[string][]time.Time),
+	}
+}
+
+// expire removes the failures of the gateway, which are older than the window.
+// This method requires the lock to be held.
+func (t *txFailureTracker) expire(gtwID string, now time.Time) []time.Time {
+	failures := t.failures[gtwID]
+	i := 0
+	for i < len(failures) && now.Sub(failures[i]) > t.window {
+		i++
+	}
+	failures = failures[i:]
+	if len(failures) == 0 {
+		delete(t.failures, gtwID)
+		return nil
+	}
+	t.failures[gtwID] = failures
+	return failures
+}
+
+// Add records a failed transmission of the gateway at the given time.
+func (t *txFailureTracker) Add(ids ttnpb.GatewayIdentifiers, at time.Time) {
+	t.mu.Lock()
+	t.failures[ids.GatewayID] = append(t.expire(ids.GatewayID, at), at)
+	t.mu.Unlock()
+}
+
+// Count returns the number of failed transmissions of the gateway within the window before now.
+func (t *txFailureTracker) Count(ids ttnpb.GatewayIdentifiers, now time.Time) int {
+	t.mu.Lock()
+	n := len(t.expire(ids.GatewayID, now))
+	t.mu.Unlock()
+	return n
+}
@@ -0,0 +1,179 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestDownlinkPathsFromMetadata(t *testing.T) {
+	md := func(gtwID string, snr float32, constraint ttnpb.DownlinkPathConstraint) *ttnpb.RxMetadata {
+		return &ttnpb.RxMetadata{
+			GatewayIdentifiers:     ttnpb.GatewayIdentifiers{GatewayID: gtwID},
+			SNR:                    snr,
+			RSSI:                   -100,
+			UplinkToken:            []byte(gtwID),
+			DownlinkPathConstraint: constraint,
+		}
+	}
+	balanced := BalancedDownlinkGatewayScore{
+		SNRWeight:         1,
+		RSSIWeight:        0.1,
+		UtilizationWeight: 10,
+		QueueDepthWeight:  1,
+		TxFailureWeight:   5,
+	}
+
+	for _, tc := range []struct {
+		Name       string
+		Strategy   string
+		Metadata   []*ttnpb.RxMetadata
+		TxFailures []string
+		Expected   []string
+	}{
+		{
+			Name:     "Signal",
+			Strategy: SignalDownlinkGatewaySelection,
+			Metadata: []*ttnpb.RxMetadata{
+				md("gtw-a", 1, ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE),
+				md("gtw-b", 5, ttnpb.DOWNLINK_PATH_CONSTRAINT_PREFER_OTHER),
+				md("gtw-c", 3, ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE),
+				md("gtw-d", 9, ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER),
+			},
+			TxFailures: []string{"gtw-c"},
+			Expected:   []string{"gtw-c", "gtw-a", "gtw-b"},
+		},
+		{
+			Name:     "Balanced/Utilization",
+			Strategy: BalancedDownlinkGatewaySelection,
+			Metadata: []*ttnpb.RxMetadata{
+				func() *ttnpb.RxMetadata {
+					md := md("gtw-a", 5, ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE)
+					md.DownlinkUtilization = 0.9
+					return md
+				}(),
+				md("gtw-b", 1, ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE),
+			},
+			Expected: []string{"gtw-b", "gtw-a"},
+		},
+		{
+			Name:     "Balanced/QueueDepth",
+			Strategy: BalancedDownlinkGatewaySelection,
+			Metadata: []*ttnpb.RxMetadata{
+				func() *ttnpb.RxMetadata {
+					md := md("gtw-a", 5, ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE)
+					md.DownlinkQueueDepth = 6
+					return md
+				}(),
+				md("gtw-b", 1, ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE),
+			},
+			Expected: []string{"gtw-b", "gtw-a"},
+		},
+		{
+			Name:     "Balanced/TxFailures",
+			Strategy: BalancedDownlinkGatewaySelection,
+			Metadata: []*ttnpb.RxMetadata{
+				md("gtw-a", 5, ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE),
+				md("gtw-b", 1, ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE),
+			},
+			TxFailures: []string{"gtw-a"},
+			Expected:   []string{"gtw-b", "gtw-a"},
+		},
+		{
+			Name:     "Balanced/Constraints",
+			Strategy: BalancedDownlinkGatewaySelection,
+			Metadata: []*ttnpb.RxMetadata{
+				md("gtw-a", 5, ttnpb.DOWNLINK_PATH_CONSTRAINT_PREFER_OTHER),
+				md("gtw-b", 1, ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE),
+				md("gtw-c", 9, ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER),
+			},
+			Expected: []string{"gtw-b", "gtw-a"},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ns := &NetworkServer{
+				downlinkGatewaySelectionStrategy: tc.Strategy,
+				downlinkGatewaySelectors: defaultDownlinkGatewaySelectors(DownlinkGatewaySelectionConfig{
+					Balanced: balanced,
+				}),
+				txFailures: newTxFailureTracker(time.Minute),
+			}
+			for _, gtwID := range tc.TxFailures {
+				ns.txFailures.Add(ttnpb.GatewayIdentifiers{GatewayID: gtwID}, time.Now())
+			}
+			mds := append(tc.Metadata[:0:0], tc.Metadata...)
+
+			paths := ns.downlinkPathsFromMetadata(context.Background(), tc.Metadata...)
+			gtwIDs := make([]string, 0, len(paths))
+			for _, path := range paths {
+				gtwIDs = append(gtwIDs, path.GatewayID)
+			}
+			a.So(gtwIDs, should.Resemble, tc.Expected)
+			a.So(tc.Metadata, should.Resemble, mds)
+		})
+	}
+}
+
+func TestBalancedDownlinkGatewayLoadSpreading(t *testing.T) {
+	a := assertions.New(t)
+
+	sel := BalancedDownlinkGatewayScore{
+		SNRWeight:           1,
+		LoadSpreadingMargin: 1,
+	}
+	noFailures := func(ttnpb.GatewayIdentifiers) int { return 0 }
+
+	selected := make(map[string]int)
+	for i := 0; i < 200; i++ {
+		mds := []*ttnpb.RxMetadata{
+			{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-a"}, SNR: 5},
+			{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-b"}, SNR: 4.5},
+			{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gtw-c"}, SNR: 1},
+		}
+		sel.SortDownlinkGateways(context.Background(), mds, noFailures)
+		selected[mds[0].GatewayID]++
+		a.So(mds[2].GatewayID, should.Equal, "gtw-c")
+	}
+	a.So(selected["gtw-a"], should.BeGreaterThan, 0)
+	a.So(selected["gtw-b"], should.BeGreaterThan, 0)
+	a.So(selected["gtw-c"], should.Equal, 0)
+}
+
+func TestTxFailureTracker(t *testing.T) {
+	a := assertions.New(t)
+
+	ids := ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"}
+	other := ttnpb.GatewayIdentifiers{GatewayID: "other-gtw"}
+	now := time.Unix(1000, 0)
+
+	tracker := newTxFailureTracker(time.Minute)
+	a.So(tracker.Count(ids, now), should.Equal, 0)
+
+	tracker.Add(ids, now)
+	tracker.Add(ids, now.Add(30*time.Second))
+	a.So(tracker.Count(ids, now.Add(30*time.Second)), should.Equal, 2)
+	a.So(tracker.Count(other, now.Add(30*time.Second)), should.Equal, 0)
+	a.So(tracker.Count(ids, now.Add(time.Minute+time.Second)), should.Equal, 1)
+	a.So(tracker.Count(ids, now.Add(2*time.Minute)), should.Equal, 0)
+	a.So(tracker.failures, should.BeEmpty)
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -33,6 +34,29 @@ func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest
 	return ns.devices.GetByID(ctx, req.ApplicationIdentifiers, req.DeviceID, req.FieldMask.Paths)
 }
 
+// GetEndDeviceStats implements NsEndDeviceRegistryServer.
+// The field mask paths in req are relative to ttnpb.EndDeviceStats. If no paths are specified, all statistics are returned.
+func (ns *NetworkServer) GetEndDeviceStats(ctx context.Context, req *ttnpb.GetEndDeviceStatsRequest) (*ttnpb.EndDeviceStats, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	dev, err := ns.devices.GetByID(ctx, req.ApplicationIdentifiers, req.DeviceID, []string{"stats"})
+	if err != nil {
+		return nil, err
+	}
+	if dev.Stats == nil {
+		return &ttnpb.EndDeviceStats{}, nil
+	}
+	if len(req.FieldMask.Paths) == 0 {
+		return dev.Stats, nil
+	}
+	stats := &ttnpb.EndDeviceStats{}
+	if err := stats.SetFields(dev.Stats, req.FieldMask.Paths...); err != nil {
+		return nil, errInvalidFieldMask.WithCause(err)
+	}
+	return stats, nil
+}
+
 func validABPSessionKey(key *ttnpb.KeyEnvelope) bool {
 	return key != nil &&
 		key.KEKLabel == "" &&
@@ -40,14 +64,86 @@ func validABPSessionKey(key *ttnpb.KeyEnvelope) bool {
 		!bytes.Equal(key.Key, bytes.Repeat([]byte{0}, 16))
 }
 
+// applyMACProfileSettings sets the fields of dev that are defined by MAC profile p, except the fields in paths, so that
+// values set explicitly take precedence over the MAC profile. It returns paths with the fields that are set.
+func applyMACProfileSettings(dev *ttnpb.EndDevice, paths []string, p *MACProfile) []string {
+	if p.Supports32BitFCnt != nil && !ttnpb.HasAnyField(paths, "uses_32_bit_f_cnt") {
+		dev.Uses32BitFCnt = *p.Supports32BitFCnt
+		paths = append(paths, "uses_32_bit_f_cnt")
+	}
+	if dev.MACSettings == nil {
+		return paths
+	}
+	if p.ADRMargin != nil && !ttnpb.HasAnyField(paths, "mac_settings.adr_margin") {
+		dev.MACSettings.ADRMargin = *p.ADRMargin
+		paths = append(paths, "mac_settings.adr_margin")
+	}
+	if p.ClassBTimeout != 0 && !ttnpb.HasAnyField(paths, "mac_settings.class_b_timeout") {
+		dev.MACSettings.ClassBTimeout = p.ClassBTimeout
+		paths = append(paths, "mac_settings.class_b_timeout")
+	}
+	if p.ClassCTimeout != 0 && !ttnpb.HasAnyField(paths, "mac_settings.class_c_timeout") {
+		dev.MACSettings.ClassCTimeout = p.ClassCTimeout
+		paths = append(paths, "mac_settings.class_c_timeout")
+	}
+	if p.StatusTimePeriodicity != nil && !ttnpb.HasAnyField(paths, "mac_settings.status_time_periodicity") {
+		dev.MACSettings.StatusTimePeriodicity = *p.StatusTimePeriodicity
+		paths = append(paths, "mac_settings.status_time_periodicity")
+	}
+	if p.StatusCountPeriodicity != nil && !ttnpb.HasAnyField(paths, "mac_settings.status_count_periodicity") {
+		dev.MACSettings.StatusCountPeriodicity = *p.StatusCountPeriodicity
+		paths = append(paths, "mac_settings.status_count_periodicity")
+	}
+	return paths
+}
+
+// statsFieldPaths are the paths of the end device statistics, which are maintained by the Network Server
+// and cannot be set by clients.
+var statsFieldPaths = func() []string {
+	paths := make([]string, 0, len(ttnpb.EndDeviceStatsFieldPathsTopLevel))
+	for _, path := range ttnpb.EndDeviceStatsFieldPathsTopLevel {
+		paths = append(paths, "stats."+path)
+	}
+	return paths
+}()
+
 // Set implements NsEndDeviceRegistryServer.
 func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error) {
 	if err := rights.RequireApplication(ctx, req.Device.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
+	if err := ttnpb.ProhibitFields(req.FieldMask.Paths, statsFieldPaths...); err != nil {
+		return nil, errInvalidFieldMask.WithCause(err)
+	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "mac_settings.adr_algorithm") {
+		if name := req.Device.GetMACSettings().GetADRAlgorithm(); name != "" {
+			if _, ok := ns.adrAlgorithms[name]; !ok {
+				return nil, errADRAlgorithmNotFound.WithAttributes("name", name)
+			}
+		}
+	}
 	var addDownlinkTask bool
 	dev, err := ns.devices.SetByID(ctx, req.Device.EndDeviceIdentifiers.ApplicationIdentifiers, req.Device.EndDeviceIdentifiers.DeviceID, req.FieldMask.Paths, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 		paths := req.FieldMask.Paths
+
+		var profile *MACProfile
+		if ttnpb.HasAnyField(paths, "mac_settings.profile_id") {
+			var err error
+			profile, err = ns.macProfile(&req.Device)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if ttnpb.HasAnyField(paths, "session.dev_addr") && req.Device.Session != nil &&
+			!ns.ownsDevAddr(req.Device.ApplicationIdentifiers, req.Device.Session.DevAddr) {
+			return nil, nil, errDevAddrNotOwned.WithAttributes("dev_addr", req.Device.Session.DevAddr)
+		}
+
+		if profile != nil {
+			paths = applyMACProfileSettings(&req.Device, paths, profile)
+		}
+
 		if dev != nil {
 			addDownlinkTask = ttnpb.HasAnyField(paths, "mac_state.device_class") && req.Device.MACState.DeviceClass != ttnpb.CLASS_A ||
 				ttnpb.HasAnyField(paths, "queued_application_downlinks") && len(req.Device.QueuedApplicationDownlinks) > 0
@@ -78,29 +174,36 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 		}
 
 		if !ttnpb.HasAnyField(paths, "mac_settings.adr_margin") {
-			// TODO: Apply NS-wide default (https://github.com/TheThingsIndustries/lorawan-stack/issues/1544)
-			req.Device.MACSettings.ADRMargin = 15
+			req.Device.MACSettings.ADRMargin = ns.defaultMACSettings.ADRMargin
 			paths = append(paths, "mac_settings.adr_margin")
 		} else if req.Device.MACSettings.ADRMargin == 0 {
 			return nil, nil, errInvalidADRMargin
 		}
 
 		if !ttnpb.HasAnyField(paths, "mac_settings.class_b_timeout") {
-			// TODO: Apply NS-wide default if not set (https://github.com/TheThingsIndustries/lorawan-stack/issues/1544)
-			req.Device.MACSettings.ClassBTimeout = time.Minute
+			req.Device.MACSettings.ClassBTimeout = ns.defaultMACSettings.ClassBTimeout
 			paths = append(paths, "mac_settings.class_b_timeout")
 		} else if req.Device.MACSettings.ClassBTimeout == 0 {
 			return nil, nil, errInvalidClassBTimeout
 		}
 
 		if !ttnpb.HasAnyField(paths, "mac_settings.class_c_timeout") {
-			// TODO: Apply NS-wide default if not set (https://github.com/TheThingsIndustries/lorawan-stack/issues/1544)
-			req.Device.MACSettings.ClassCTimeout = 10 * time.Second
+			req.Device.MACSettings.ClassCTimeout = ns.defaultMACSettings.ClassCTimeout
 			paths = append(paths, "mac_settings.class_c_timeout")
 		} else if req.Device.MACSettings.ClassCTimeout == 0 {
 			return nil, nil, errInvalidClassCTimeout
 		}
 
+		if !ttnpb.HasAnyField(paths, "mac_settings.status_time_periodicity") && ns.defaultMACSettings.StatusTimePeriodicity != 0 {
+			req.Device.MACSettings.StatusTimePeriodicity = ns.defaultMACSettings.StatusTimePeriodicity
+			paths = append(paths, "mac_settings.status_time_periodicity")
+		}
+
+		if !ttnpb.HasAnyField(paths, "mac_settings.status_count_periodicity") && ns.defaultMACSettings.StatusCountPeriodicity != 0 {
+			req.Device.MACSettings.StatusCountPeriodicity = ns.defaultMACSettings.StatusCountPeriodicity
+			paths = append(paths, "mac_settings.status_count_periodicity")
+		}
+
 		if req.Device.SupportsJoin {
 			return &req.Device, paths, nil
 		}
@@ -149,7 +252,7 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 		req.Device.Session.StartedAt = time.Now().UTC()
 		paths = append(paths, "session.started_at")
 
-		if err := resetMACState(&req.Device, ns.FrequencyPlans); err != nil {
+		if err := resetMACState(&req.Device, ns.FrequencyPlans, profile); err != nil {
 			return nil, nil, err
 		}
 		if req.Device.MACState.DeviceClass != ttnpb.CLASS_A {
@@ -173,7 +276,16 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 
 // Delete implements NsEndDeviceRegistryServer.
 func (ns *NetworkServer) Delete(ctx context.Context, req *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
-	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+	// The Identity Server deletes end devices from the cluster when they are deleted from the Entity Registry.
+	// Cluster peers can therefore only delete end devices that no longer exist in the Entity Registry.
+	if clusterauth.HasAuth(ctx) {
+		if err := clusterauth.Authorized(ctx); err != nil {
+			return nil, err
+		}
+		if err := ns.requireDeletedFromEntityRegistry(ctx, *req); err != nil {
+			return nil, err
+		}
+	} else if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
 	_, err := ns.devices.SetByID(ctx, req.ApplicationIdentifiers, req.DeviceID, nil, func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
@@ -22,14 +22,21 @@ import (
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/mohae/deepcopy"
 	"github.com/smartystreets/assertions"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	. "go.thethings.network/lorawan-stack/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/rpcserver"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
 )
 
 func TestDeviceRegistryGet(t *testing.T) {
@@ -175,6 +182,179 @@ func TestDeviceRegistryGet(t *testing.T) {
 	}
 }
 
+func TestDeviceRegistryGetEndDeviceStats(t *testing.T) {
+	type getByIDCallKey struct{}
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		DeviceID: DeviceID,
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: ApplicationID,
+		},
+	}
+	readRights := func(ctx context.Context) context.Context {
+		return rights.NewContext(ctx, rights.Rights{
+			ApplicationRights: map[string]*ttnpb.Rights{
+				unique.ID(ctx, ids.ApplicationIdentifiers): {
+					Rights: []ttnpb.Right{
+						ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+					},
+				},
+			},
+		})
+	}
+	lastUplinkAt := time.Unix(42, 0).UTC()
+
+	for _, tc := range []struct {
+		Name             string
+		ContextFunc      func(context.Context) context.Context
+		GetByIDFunc      func(context.Context, ttnpb.ApplicationIdentifiers, string, []string) (*ttnpb.EndDevice, error)
+		Request          *ttnpb.GetEndDeviceStatsRequest
+		Stats            *ttnpb.EndDeviceStats
+		ErrorAssertion   func(*testing.T, error) bool
+		ContextAssertion func(context.Context) bool
+	}{
+		{
+			Name: "No device read rights",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ids.ApplicationIdentifiers): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC,
+							},
+						},
+					},
+				})
+			},
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Fatal("GetByIDFunc must not be called")
+				panic("Unreachable")
+			},
+			Request: &ttnpb.GetEndDeviceStatsRequest{
+				EndDeviceIdentifiers: ids,
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				if !assertions.New(t).So(errors.IsPermissionDenied(err), should.BeTrue) {
+					t.Errorf("Received error: %s", err)
+					return false
+				}
+				return true
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, getByIDCallKey{}), should.Equal, 0)
+			},
+		},
+
+		{
+			Name:        "No statistics",
+			ContextFunc: readRights,
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, error) {
+				defer test.MustIncrementContextCounter(ctx, getByIDCallKey{}, 1)
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(paths, should.HaveSameElementsDeep, []string{
+					"stats",
+				})
+				return &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+				}, nil
+			},
+			Request: &ttnpb.GetEndDeviceStatsRequest{
+				EndDeviceIdentifiers: ids,
+			},
+			Stats: &ttnpb.EndDeviceStats{},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, getByIDCallKey{}), should.Equal, 1)
+			},
+		},
+
+		{
+			Name:        "Field mask",
+			ContextFunc: readRights,
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, error) {
+				defer test.MustIncrementContextCounter(ctx, getByIDCallKey{}, 1)
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(appID, should.Resemble, ids.ApplicationIdentifiers)
+				a.So(devID, should.Equal, DeviceID)
+				a.So(paths, should.HaveSameElementsDeep, []string{
+					"stats",
+				})
+				return &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					Stats: &ttnpb.EndDeviceStats{
+						LastUplinkReceivedAt: &lastUplinkAt,
+						UplinkCount:          42,
+						DownlinkCount:        3,
+						PacketErrorRate:      0.25,
+					},
+				}, nil
+			},
+			Request: &ttnpb.GetEndDeviceStatsRequest{
+				EndDeviceIdentifiers: ids,
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"last_uplink_received_at",
+						"packet_error_rate",
+						"uplink_count",
+					},
+				},
+			},
+			Stats: &ttnpb.EndDeviceStats{
+				LastUplinkReceivedAt: &lastUplinkAt,
+				UplinkCount:          42,
+				PacketErrorRate:      0.25,
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, getByIDCallKey{}), should.Equal, 1)
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ns := test.Must(New(
+				component.MustNew(test.GetLogger(t), &component.Config{}),
+				&Config{
+					Devices: &MockDeviceRegistry{
+						GetByIDFunc: tc.GetByIDFunc,
+					},
+					DeduplicationWindow: 42,
+					CooldownWindow:      42,
+					DownlinkTasks:       &MockDownlinkTaskQueue{},
+				})).(*NetworkServer)
+
+			ns.AddContextFiller(tc.ContextFunc)
+			ns.AddContextFiller(func(ctx context.Context) context.Context {
+				return test.ContextWithCounter(ctx, getByIDCallKey{})
+			})
+			ns.AddContextFiller(func(ctx context.Context) context.Context {
+				ctx, cancel := context.WithDeadline(ctx, time.Now().Add(Timeout))
+				_ = cancel
+				return ctx
+			})
+			ns.AddContextFiller(func(ctx context.Context) context.Context {
+				return test.ContextWithT(ctx, t)
+			})
+			test.Must(nil, ns.Start())
+			defer ns.Close()
+
+			req := deepcopy.Copy(tc.Request).(*ttnpb.GetEndDeviceStatsRequest)
+
+			stats, err := ttnpb.NewNsEndDeviceRegistryClient(ns.LoopbackConn()).GetEndDeviceStats(test.Context(), req)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(t, err), should.BeTrue)
+				a.So(stats, should.BeNil)
+			} else {
+				a.So(err, should.BeNil)
+				a.So(stats, should.Resemble, tc.Stats)
+			}
+			a.So(req, should.Resemble, tc.Request)
+		})
+	}
+}
+
 func TestDeviceRegistrySet(t *testing.T) {
 	type setByIDCallKey struct{}
 
@@ -347,6 +527,375 @@ func TestDeviceRegistrySet(t *testing.T) {
 				return a.So(test.MustCounterFromContext(ctx, setByIDCallKey{}), should.Equal, 1)
 			},
 		},
+		{
+			Name: "Create OTAA device with MAC profile",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ids.ApplicationIdentifiers): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							},
+						},
+					},
+				})
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				defer test.MustIncrementContextCounter(ctx, setByIDCallKey{}, 1)
+				a := assertions.New(test.MustTFromContext(ctx))
+
+				dev, sets, err := f(nil)
+				a.So(err, should.BeNil)
+				a.So(sets, should.HaveSameElementsDeep, []string{
+					"frequency_plan_id",
+					"lorawan_phy_version",
+					"lorawan_version",
+					"mac_settings.adr_margin",
+					"mac_settings.class_b_timeout",
+					"mac_settings.class_c_timeout",
+					"mac_settings.profile_id",
+					"mac_settings.status_count_periodicity",
+					"mac_settings.use_adr",
+					"resets_f_cnt",
+					"resets_join_nonces",
+					"supports_class_b",
+					"supports_class_c",
+					"supports_join",
+					"uses_32_bit_f_cnt",
+				})
+				a.So(dev, should.Resemble, &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					SupportsJoin:         true,
+					Uses32BitFCnt:        true,
+					MACSettings: &ttnpb.MACSettings{
+						ADRMargin:              10,
+						ClassBTimeout:          time.Minute,
+						ClassCTimeout:          20 * time.Second,
+						ProfileID:              "test",
+						StatusCountPeriodicity: 5,
+					},
+				})
+				return dev, nil
+			},
+			Request: &ttnpb.SetEndDeviceRequest{
+				Device: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					SupportsJoin:         true,
+					MACSettings: &ttnpb.MACSettings{
+						ProfileID: "test",
+					},
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"frequency_plan_id",
+						"lorawan_phy_version",
+						"lorawan_version",
+						"mac_settings.profile_id",
+						"mac_settings.use_adr",
+						"resets_f_cnt",
+						"resets_join_nonces",
+						"supports_class_b",
+						"supports_class_c",
+						"supports_join",
+					},
+				},
+			},
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				SupportsJoin:         true,
+				Uses32BitFCnt:        true,
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin:              10,
+					ClassBTimeout:          time.Minute,
+					ClassCTimeout:          20 * time.Second,
+					ProfileID:              "test",
+					StatusCountPeriodicity: 5,
+				},
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByIDCallKey{}), should.Equal, 1)
+			},
+		},
+		{
+			Name: "Update MAC profile of device",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ids.ApplicationIdentifiers): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							},
+						},
+					},
+				})
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				defer test.MustIncrementContextCounter(ctx, setByIDCallKey{}, 1)
+				a := assertions.New(test.MustTFromContext(ctx))
+
+				dev, sets, err := f(&ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					SupportsJoin:         true,
+					MACSettings: &ttnpb.MACSettings{
+						ADRMargin:     15,
+						ClassBTimeout: time.Minute,
+						ClassCTimeout: 10 * time.Second,
+					},
+				})
+				a.So(err, should.BeNil)
+				a.So(sets, should.HaveSameElementsDeep, []string{
+					"mac_settings.adr_margin",
+					"mac_settings.class_c_timeout",
+					"mac_settings.profile_id",
+					"mac_settings.status_count_periodicity",
+					"uses_32_bit_f_cnt",
+				})
+				a.So(dev, should.Resemble, &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					Uses32BitFCnt:        true,
+					MACSettings: &ttnpb.MACSettings{
+						ADRMargin:              10,
+						ClassCTimeout:          20 * time.Second,
+						ProfileID:              "test",
+						StatusCountPeriodicity: 5,
+					},
+				})
+				return &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					SupportsJoin:         true,
+					Uses32BitFCnt:        true,
+					MACSettings: &ttnpb.MACSettings{
+						ADRMargin:              10,
+						ClassBTimeout:          time.Minute,
+						ClassCTimeout:          20 * time.Second,
+						ProfileID:              "test",
+						StatusCountPeriodicity: 5,
+					},
+				}, nil
+			},
+			Request: &ttnpb.SetEndDeviceRequest{
+				Device: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					MACSettings: &ttnpb.MACSettings{
+						ProfileID: "test",
+					},
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"mac_settings.profile_id",
+					},
+				},
+			},
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				SupportsJoin:         true,
+				Uses32BitFCnt:        true,
+				MACSettings: &ttnpb.MACSettings{
+					ADRMargin:              10,
+					ClassBTimeout:          time.Minute,
+					ClassCTimeout:          20 * time.Second,
+					ProfileID:              "test",
+					StatusCountPeriodicity: 5,
+				},
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByIDCallKey{}), should.Equal, 1)
+			},
+		},
+		{
+			Name: "Unknown MAC profile",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ids.ApplicationIdentifiers): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							},
+						},
+					},
+				})
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				defer test.MustIncrementContextCounter(ctx, setByIDCallKey{}, 1)
+				_, _, err := f(nil)
+				return nil, err
+			},
+			Request: &ttnpb.SetEndDeviceRequest{
+				Device: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					SupportsJoin:         true,
+					MACSettings: &ttnpb.MACSettings{
+						ProfileID: "unknown",
+					},
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"frequency_plan_id",
+						"lorawan_phy_version",
+						"lorawan_version",
+						"mac_settings.profile_id",
+						"mac_settings.use_adr",
+						"resets_f_cnt",
+						"resets_join_nonces",
+						"supports_class_b",
+						"supports_class_c",
+						"supports_join",
+						"uses_32_bit_f_cnt",
+					},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				if !assertions.New(t).So(errors.IsNotFound(err), should.BeTrue) {
+					t.Errorf("Received error: %s", err)
+					return false
+				}
+				return true
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByIDCallKey{}), should.Equal, 1)
+			},
+		},
+		{
+			Name: "Unknown ADR algorithm",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ids.ApplicationIdentifiers): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							},
+						},
+					},
+				})
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Fatal("SetByIDFunc must not be called")
+				panic("Unreachable")
+			},
+			Request: &ttnpb.SetEndDeviceRequest{
+				Device: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					MACSettings: &ttnpb.MACSettings{
+						ADRAlgorithm: "unknown",
+					},
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"mac_settings.adr_algorithm",
+					},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				if !assertions.New(t).So(errors.IsNotFound(err), should.BeTrue) {
+					t.Errorf("Received error: %s", err)
+					return false
+				}
+				return true
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByIDCallKey{}), should.Equal, 0)
+			},
+		},
+		{
+			Name: "Set stats",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ids.ApplicationIdentifiers): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							},
+						},
+					},
+				})
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Fatal("SetByIDFunc must not be called")
+				panic("Unreachable")
+			},
+			Request: &ttnpb.SetEndDeviceRequest{
+				Device: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					Stats: &ttnpb.EndDeviceStats{
+						UplinkCount: 42,
+					},
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"stats.uplink_count",
+					},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				if !assertions.New(t).So(errors.IsInvalidArgument(err), should.BeTrue) {
+					t.Errorf("Received error: %s", err)
+					return false
+				}
+				return true
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByIDCallKey{}), should.Equal, 0)
+			},
+		},
+
+		{
+			Name: "Create ABP device with DevAddr outside of prefixes",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ids.ApplicationIdentifiers): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							},
+						},
+					},
+				})
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				defer test.MustIncrementContextCounter(ctx, setByIDCallKey{}, 1)
+				_, _, err := f(nil)
+				return nil, err
+			},
+			Request: &ttnpb.SetEndDeviceRequest{
+				Device: ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					Session: &ttnpb.Session{
+						DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04},
+					},
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{
+						"frequency_plan_id",
+						"lorawan_phy_version",
+						"lorawan_version",
+						"mac_settings.use_adr",
+						"resets_f_cnt",
+						"resets_join_nonces",
+						"session.dev_addr",
+						"supports_class_b",
+						"supports_class_c",
+						"supports_join",
+						"uses_32_bit_f_cnt",
+					},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				if !assertions.New(t).So(errors.IsInvalidArgument(err), should.BeTrue) {
+					t.Errorf("Received error: %s", err)
+					return false
+				}
+				return true
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByIDCallKey{}), should.Equal, 1)
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
@@ -360,6 +909,17 @@ func TestDeviceRegistrySet(t *testing.T) {
 					DeduplicationWindow: 42,
 					CooldownWindow:      42,
 					DownlinkTasks:       &MockDownlinkTaskQueue{},
+					NetID:               "000013",
+					DevAddrPrefixes:     []string{"26010000/16"},
+					MACProfiles: []MACProfile{
+						{
+							Name:                   "test",
+							ADRMargin:              func(v uint32) *uint32 { return &v }(10),
+							Supports32BitFCnt:      func(v bool) *bool { return &v }(true),
+							ClassCTimeout:          20 * time.Second,
+							StatusCountPeriodicity: func(v uint32) *uint32 { return &v }(5),
+						},
+					},
 				})).(*NetworkServer)
 
 			ns.AddContextFiller(tc.ContextFunc)
@@ -392,6 +952,8 @@ func TestDeviceRegistrySet(t *testing.T) {
 	}
 }
 
+var errRegistryDeviceNotFound = errors.DefineNotFound("registry_device_not_found", "device not found in Entity Registry")
+
 func TestDeviceRegistryDelete(t *testing.T) {
 	type setByIDCallKey struct{}
 
@@ -407,6 +969,8 @@ func TestDeviceRegistryDelete(t *testing.T) {
 		ContextFunc      func(context.Context) context.Context
 		SetByIDFunc      func(context.Context, ttnpb.ApplicationIdentifiers, string, []string, func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error)
 		Request          *ttnpb.EndDeviceIdentifiers
+		ClusterAuth      bool
+		RegistryGetFunc  func(context.Context, *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error)
 		ErrorAssertion   func(*testing.T, error) bool
 		ContextAssertion func(context.Context) bool
 	}{
@@ -509,12 +1073,94 @@ func TestDeviceRegistryDelete(t *testing.T) {
 				return a.So(test.MustCounterFromContext(ctx, setByIDCallKey{}), should.Equal, 1)
 			},
 		},
+
+		{
+			Name: "Cluster call",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return ctx
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				defer test.MustIncrementContextCounter(ctx, setByIDCallKey{}, 1)
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(appID, should.Resemble, ids.ApplicationIdentifiers)
+				a.So(devID, should.Equal, DeviceID)
+
+				dev, _, err := f(&ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+				})
+				a.So(err, should.BeNil)
+				a.So(dev, should.BeNil)
+				return nil, nil
+			},
+			Request:     deepcopy.Copy(&ids).(*ttnpb.EndDeviceIdentifiers),
+			ClusterAuth: true,
+			RegistryGetFunc: func(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+				return nil, errRegistryDeviceNotFound
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByIDCallKey{}), should.Equal, 1)
+			},
+		},
+
+		{
+			Name: "Cluster call/registered device",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return ctx
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustIncrementContextCounter(ctx, setByIDCallKey{}, 1)
+				return nil, errors.New("SetByID must not be called")
+			},
+			Request:     deepcopy.Copy(&ids).(*ttnpb.EndDeviceIdentifiers),
+			ClusterAuth: true,
+			RegistryGetFunc: func(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+				return &ttnpb.EndDevice{EndDeviceIdentifiers: req.EndDeviceIdentifiers}, nil
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				if !assertions.New(t).So(errors.IsFailedPrecondition(err), should.BeTrue) {
+					t.Errorf("Received error: %s", err)
+					return false
+				}
+				return true
+			},
+			ContextAssertion: func(ctx context.Context) bool {
+				a := assertions.New(test.MustTFromContext(ctx))
+				return a.So(test.MustCounterFromContext(ctx, setByIDCallKey{}), should.Equal, 0)
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
 
+			er := test.Must(test.NewGRPCServerPeer(test.Context(), MockEndDeviceRegistryServer{
+				GetFunc: tc.RegistryGetFunc,
+			}, ttnpb.RegisterEndDeviceRegistryServer)).(cluster.Peer)
+
 			ns := test.Must(New(
-				component.MustNew(test.GetLogger(t), &component.Config{}),
+				component.MustNew(test.GetLogger(t),
+					&component.Config{},
+					component.WithClusterNew(func(context.Context, *config.ServiceBase, ...rpcserver.Registerer) (cluster.Cluster, error) {
+						return &test.MockCluster{
+							AuthFunc: func() grpc.CallOption {
+								return grpc.PerRPCCredentials(rpcmetadata.MD{
+									AuthType:      clusterauth.AuthType,
+									AuthValue:     "00",
+									AllowInsecure: true,
+								})
+							},
+							GetPeerFunc: func(ctx context.Context, role ttnpb.PeerInfo_Role, ids ttnpb.Identifiers) cluster.Peer {
+								if role != ttnpb.PeerInfo_ENTITY_REGISTRY {
+									return nil
+								}
+								return er
+							},
+							WithVerifiedSourceFunc: func(ctx context.Context) context.Context {
+								return clusterauth.NewContext(ctx, nil)
+							},
+						}, nil
+					}),
+				),
 				&Config{
 					Devices: &MockDeviceRegistry{
 						SetByIDFunc: tc.SetByIDFunc,
@@ -541,7 +1187,11 @@ func TestDeviceRegistryDelete(t *testing.T) {
 
 			req := deepcopy.Copy(tc.Request).(*ttnpb.EndDeviceIdentifiers)
 
-			res, err := ttnpb.NewNsEndDeviceRegistryClient(ns.LoopbackConn()).Delete(test.Context(), req)
+			var opts []grpc.CallOption
+			if tc.ClusterAuth {
+				opts = append(opts, ns.WithClusterAuth())
+			}
+			res, err := ttnpb.NewNsEndDeviceRegistryClient(ns.LoopbackConn()).Delete(test.Context(), req, opts...)
 			if tc.ErrorAssertion != nil {
 				a.So(tc.ErrorAssertion(t, err), should.BeTrue)
 				a.So(res, should.BeNil)
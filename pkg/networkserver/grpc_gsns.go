@@ -28,11 +28,9 @@ import (
 	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
-	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/log"
-	"go.thethings.network/lorawan-stack/pkg/random"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/unique"
@@ -105,6 +103,7 @@ func (ns *NetworkServer) matchDevice(ctx context.Context, up *ttnpb.UplinkMessag
 		[]string{
 			"frequency_plan_id",
 			"lorawan_phy_version",
+			"mac_settings.confirmed_downlink_attempts",
 			"mac_state",
 			"pending_session",
 			"recent_downlinks",
@@ -363,7 +362,8 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 
 	logger.Debug("Matched device")
 
-	if matched.MACState != nil && matched.MACState.PendingApplicationDownlink != nil {
+	if matched.MACState != nil && matched.MACState.PendingApplicationDownlink != nil && (pld.Ack || ns.confirmedDownlinkAttempts(matched) == 0) {
+		// NOTE: Unacknowledged downlinks are retransmitted by the Network Server if confirmedDownlinkAttempts is not 0.
 		asUp := &ttnpb.ApplicationUp{
 			EndDeviceIdentifiers: matched.EndDeviceIdentifiers,
 			CorrelationIDs:       matched.MACState.PendingApplicationDownlink.CorrelationIDs,
@@ -439,7 +439,9 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	registerMergeMetadata(ctx, &matched.EndDeviceIdentifiers, up)
 
 	var handleErr bool
-	stored, err := ns.devices.SetByID(ctx, matched.EndDeviceIdentifiers.ApplicationIdentifiers, matched.EndDeviceIdentifiers.DeviceID,
+	var failedDown *ttnpb.ApplicationDownlink
+	evtCtx, evts := newEventBufferContext(ctx)
+	stored, err := SetDeviceWithRetry(ctx, ns.devices, ns.deviceRegistryRetry, matched.EndDeviceIdentifiers.ApplicationIdentifiers, matched.EndDeviceIdentifiers.DeviceID,
 		[]string{
 			"default_mac_parameters",
 			"downlink_margin",
@@ -450,9 +452,11 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 			"mac_settings",
 			"mac_state",
 			"pending_session",
+			"queued_application_downlinks",
 			"recent_uplinks",
 			"resets_f_cnt",
 			"session",
+			"stats",
 			"supports_join",
 		},
 		func(stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
@@ -462,7 +466,14 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 				return nil, nil, errOutdatedData
 			}
 
+			// The transaction may be retried, so the events are published after it is committed
+			// and the MAC commands are consumed from a copy.
+			ctx := evtCtx
+			evts.Reset()
+			cmds := cmds
+
 			var paths []string
+			failedDown = nil
 
 			storedSes := stored.Session
 			if ses != matched.Session {
@@ -550,9 +561,15 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 			}
 			paths = append(paths, "recent_uplinks")
 
+			profile := ns.deviceMACProfile(ctx, stored)
+
 			if stored.MACState != nil {
+				if stored.MACState.PendingApplicationDownlink != nil && !pld.Ack && ns.confirmedDownlinkAttempts(stored) > 0 {
+					failedDown = ns.handleUnacknowledgedApplicationDownlink(ctx, stored)
+					paths = append(paths, "queued_application_downlinks")
+				}
 				stored.MACState.PendingApplicationDownlink = nil
-			} else if err := resetMACState(stored, ns.FrequencyPlans); err != nil {
+			} else if err := resetMACState(stored, ns.FrequencyPlans, profile); err != nil {
 				handleErr = true
 				return nil, nil, err
 			}
@@ -566,13 +583,13 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 				cmd, cmds = cmds[0], cmds[1:]
 				switch cmd.CID {
 				case ttnpb.CID_RESET:
-					err = handleResetInd(ctx, stored, cmd.GetResetInd(), ns.FrequencyPlans)
+					err = handleResetInd(ctx, stored, cmd.GetResetInd(), ns.FrequencyPlans, profile)
 				case ttnpb.CID_LINK_CHECK:
 					err = handleLinkCheckReq(ctx, stored, up)
 				case ttnpb.CID_LINK_ADR:
 					pld := cmd.GetLinkADRAns()
 					dupCount := 0
-					if stored.MACState.LoRaWANVersion == ttnpb.MAC_V1_0_2 {
+					if stored.MACState.LoRaWANVersion.DuplicatesLinkADRAns() {
 						for _, dup := range cmds {
 							if dup.CID != ttnpb.CID_LINK_ADR {
 								break
@@ -595,6 +612,9 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 					err = handleRxParamSetupAns(ctx, stored, cmd.GetRxParamSetupAns())
 				case ttnpb.CID_DEV_STATUS:
 					err = handleDevStatusAns(ctx, stored, cmd.GetDevStatusAns(), ses.LastFCntUp, up.ReceivedAt)
+					if err == nil {
+						updateDevStatusStats(stored)
+					}
 					paths = append(paths,
 						"battery_percentage",
 						"downlink_margin",
@@ -658,6 +678,9 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 			stored.MACState.RxWindowsAvailable = true
 			stored.MACState.PendingJoinRequest = nil
 
+			updateUplinkStats(stored, up)
+			paths = append(paths, "stats")
+
 			paths = append(paths, "recent_adr_uplinks")
 			if !pld.FHDR.ADR {
 				stored.RecentADRUplinks = nil
@@ -669,26 +692,39 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 				stored.RecentADRUplinks = append(stored.RecentADRUplinks[:0], stored.RecentADRUplinks[len(stored.RecentADRUplinks)-recentUplinkCount:]...)
 			}
 
-			if err := adaptDataRate(stored, ns.FrequencyPlans); err != nil {
+			alg, err := ns.adrAlgorithm(stored, profile)
+			if err != nil {
 				handleErr = true
 				return nil, nil, err
 			}
+			adapted, err := adaptDataRate(stored, ns.FrequencyPlans, profile, alg)
+			if err != nil {
+				handleErr = true
+				return nil, nil, err
+			}
+			if adapted {
+				updateADRStats(stored, time.Now().UTC())
+			}
 			return stored, paths, nil
 		})
 	if err != nil && !handleErr {
 		logger.WithError(err).Error("Failed to update device in registry")
-		// TODO: Retry transaction. (https://github.com/TheThingsNetwork/lorawan-stack/issues/33)
 		registerDropDataUplink(ctx, &matched.EndDeviceIdentifiers, up, err)
 	}
 	if err != nil {
 		registerDropDataUplink(ctx, &matched.EndDeviceIdentifiers, up, err)
 		return err
 	}
+	evts.Publish()
 	matched = stored
 
 	asCtx, cancel := context.WithTimeout(ctx, appQueueUpdateTimeout)
 	defer cancel()
 
+	if failedDown != nil {
+		ns.sendApplicationDownlinkNack(asCtx, matched.EndDeviceIdentifiers, failedDown)
+	}
+
 	ok, err := ns.handleASUplink(asCtx, matched.EndDeviceIdentifiers.ApplicationIdentifiers, &ttnpb.ApplicationUp{
 		EndDeviceIdentifiers: matched.EndDeviceIdentifiers,
 		CorrelationIDs:       up.CorrelationIDs,
@@ -711,18 +747,6 @@ func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	return ns.downlinkTasks.Add(ctx, matched.EndDeviceIdentifiers, time.Now().UTC())
 }
 
-// newDevAddr generates a DevAddr for specified EndDevice.
-func (ns *NetworkServer) newDevAddr(context.Context, *ttnpb.EndDevice) types.DevAddr {
-	nwkAddr := make([]byte, types.NwkAddrLength(ns.NetID))
-	random.Read(nwkAddr)
-	nwkAddr[0] &= 0xff >> (8 - types.NwkAddrBits(ns.NetID)%8)
-	devAddr, err := types.NewDevAddr(ns.NetID, nwkAddr)
-	if err != nil {
-		panic(errors.New("failed to create new DevAddr").WithCause(err))
-	}
-	return devAddr
-}
-
 func (ns *NetworkServer) handleJoin(ctx context.Context, up *ttnpb.UplinkMessage, acc *metadataAccumulator) (err error) {
 	pld := up.Payload.GetJoinRequestPayload()
 
@@ -737,8 +761,10 @@ func (ns *NetworkServer) handleJoin(ctx context.Context, up *ttnpb.UplinkMessage
 			"frequency_plan_id",
 			"lorawan_phy_version",
 			"lorawan_version",
+			"mac_settings",
 			"mac_state",
 			"session",
+			"supports_join",
 		},
 	)
 	if err != nil {
@@ -757,13 +783,10 @@ func (ns *NetworkServer) handleJoin(ctx context.Context, up *ttnpb.UplinkMessage
 	ctx = log.NewContext(ctx, logger)
 
 	devAddr := ns.newDevAddr(ctx, dev)
-	for dev.Session != nil && devAddr.Equal(dev.Session.DevAddr) {
-		devAddr = ns.newDevAddr(ctx, dev)
-	}
 	logger = logger.WithField("dev_addr", devAddr)
 	ctx = log.NewContext(ctx, logger)
 
-	if err := resetMACState(dev, ns.FrequencyPlans); err != nil {
+	if err := resetMACState(dev, ns.FrequencyPlans, ns.deviceMACProfile(ctx, dev)); err != nil {
 		logger.WithError(err).Error("Failed to reset device's MAC state")
 		return err
 	}
@@ -816,23 +839,26 @@ func (ns *NetworkServer) handleJoin(ctx context.Context, up *ttnpb.UplinkMessage
 
 	var invalidatedQueue []*ttnpb.ApplicationDownlink
 	var resetErr bool
-	dev, err = ns.devices.SetByID(ctx, dev.EndDeviceIdentifiers.ApplicationIdentifiers, dev.EndDeviceIdentifiers.DeviceID,
+	dev, err = SetDeviceWithRetry(ctx, ns.devices, ns.deviceRegistryRetry, dev.EndDeviceIdentifiers.ApplicationIdentifiers, dev.EndDeviceIdentifiers.DeviceID,
 		[]string{
 			"default_mac_parameters",
 			"frequency_plan_id",
 			"lorawan_phy_version",
 			"lorawan_version",
+			"mac_settings",
 			"mac_state",
 			"pending_session",
 			"queued_application_downlinks",
 			"recent_uplinks",
+			"stats",
 			"supports_class_b",
 			"supports_class_c",
+			"supports_join",
 		},
 		func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			var paths []string
 
-			if err := resetMACState(dev, ns.Component.FrequencyPlans); err != nil {
+			if err := resetMACState(dev, ns.Component.FrequencyPlans, ns.deviceMACProfile(ctx, dev)); err != nil {
 				resetErr = true
 				return nil, nil, err
 			}
@@ -868,6 +894,9 @@ func (ns *NetworkServer) handleJoin(ctx context.Context, up *ttnpb.UplinkMessage
 			}
 			paths = append(paths, "recent_uplinks")
 
+			updateUplinkStats(dev, up)
+			paths = append(paths, "stats")
+
 			invalidatedQueue = dev.QueuedApplicationDownlinks
 			dev.QueuedApplicationDownlinks = nil
 			paths = append(paths, "queued_application_downlinks")
@@ -876,7 +905,6 @@ func (ns *NetworkServer) handleJoin(ctx context.Context, up *ttnpb.UplinkMessage
 		})
 	if err != nil && !resetErr {
 		logger.WithError(err).Error("Failed to update device in registry")
-		// TODO: Retry transaction. (https://github.com/TheThingsNetwork/lorawan-stack/issues/33)
 	}
 	if err != nil {
 		return err
@@ -927,15 +955,18 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	if err := clusterauth.Authorized(ctx); err != nil {
 		return nil, err
 	}
+	up.ReceivedAt = time.Now().UTC()
+	return ns.handleUplinkMessage(ctx, up)
+}
 
+// handleUplinkMessage handles the uplink message received by a gateway of this network, or forwarded by a roaming peer.
+func (ns *NetworkServer) handleUplinkMessage(ctx context.Context, up *ttnpb.UplinkMessage) (*pbtypes.Empty, error) {
 	ctx = events.ContextWithCorrelationID(ctx, append(
 		up.CorrelationIDs,
 		fmt.Sprintf("ns:uplink:%s", events.NewCorrelationID()),
 	)...)
 	up.CorrelationIDs = events.CorrelationIDsFromContext(ctx)
 
-	up.ReceivedAt = time.Now().UTC()
-
 	logger := log.FromContext(ctx)
 
 	if up.Payload.Major != ttnpb.Major_LORAWAN_R1 {
@@ -969,6 +1000,10 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	up = deepcopy.Copy(up).(*ttnpb.UplinkMessage)
 	switch up.Payload.MType {
 	case ttnpb.MType_CONFIRMED_UP, ttnpb.MType_UNCONFIRMED_UP:
+		if peer := ns.roamingPeerByDevAddr(up.Payload.GetMACPayload().DevAddr); peer != nil {
+			logger.Debug("Forwarding data uplink to roaming peer...")
+			return ttnpb.Empty, ns.forwardUplink(ctx, peer, up, acc)
+		}
 		logger.Debug("Handling data uplink...")
 		return ttnpb.Empty, ns.handleUplink(ctx, up, acc)
 	case ttnpb.MType_JOIN_REQUEST:
@@ -982,3 +1017,239 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 		return ttnpb.Empty, nil
 	}
 }
+
+// ReportTxAcknowledgment is called by the Gateway Server when a gateway fails to transmit a downlink message scheduled by
+// the Network Server. If the transmission failed, the downlink state of the device is rolled back and the downlink is
+// rescheduled, preferring other gateways.
+func (ns *NetworkServer) ReportTxAcknowledgment(ctx context.Context, ack *ttnpb.GatewayTxAcknowledgment) (*pbtypes.Empty, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+
+	down := ack.TxAck.DownlinkMessage
+	if down == nil || down.EndDeviceIDs == nil {
+		return nil, errNoDownlinkMessage
+	}
+	if ack.TxAck.Result == ttnpb.TxAcknowledgment_SUCCESS {
+		return ttnpb.Empty, nil
+	}
+	if down.EndDeviceIDs.DeviceID == "" {
+		// Downlink messages scheduled on behalf of roaming peers are not stored, so there is no state to roll back.
+		ns.txFailures.Add(ack.GatewayIdentifiers, time.Now())
+		return ttnpb.Empty, nil
+	}
+
+	devID := *down.EndDeviceIDs
+	ctx = events.ContextWithCorrelationID(ctx, ack.TxAck.CorrelationIDs...)
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"device_uid", unique.ID(ctx, devID),
+		"gateway_uid", unique.ID(ctx, ack.GatewayIdentifiers),
+		"result", ack.TxAck.Result,
+	))
+	ctx = log.NewContext(ctx, logger)
+	events.Publish(evtFailDownlink(ctx, devID, ack.TxAck.Result))
+	ns.txFailures.Add(ack.GatewayIdentifiers, time.Now())
+
+	var rolledBack bool
+	_, err := SetDeviceWithRetry(ctx, ns.devices, ns.deviceRegistryRetry, devID.ApplicationIdentifiers, devID.DeviceID,
+		[]string{
+			"frequency_plan_id",
+			"lorawan_phy_version",
+			"mac_state",
+			"pending_session",
+			"queued_application_downlinks",
+			"recent_downlinks",
+			"recent_uplinks",
+			"session",
+		},
+		func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, errDeviceNotFound
+			}
+			if dev.MACState == nil {
+				return nil, nil, errUnknownMACState
+			}
+			// Only the most recent downlink is rolled back, as the state of older downlinks is superseded.
+			n := len(dev.RecentDownlinks)
+			if n == 0 || !bytes.Equal(dev.RecentDownlinks[n-1].RawPayload, down.RawPayload) {
+				logger.Debug("Failed downlink is not the most recent downlink, skip rollback")
+				return dev, nil, nil
+			}
+
+			var msg ttnpb.Message
+			if err := lorawan.UnmarshalMessage(down.RawPayload, &msg); err != nil {
+				return nil, nil, errDecodePayload.WithCause(err)
+			}
+			dev.RecentDownlinks = dev.RecentDownlinks[:n-1]
+
+			rx1Delay := time.Duration(dev.MACState.CurrentParameters.Rx1Delay) * time.Second
+			if rx1Delay == 0 {
+				rx1Delay = time.Second // RX_DELAY_0 is valid, and 1 second.
+			}
+			rx2Delay := rx1Delay + time.Second
+
+			switch msg.MType {
+			case ttnpb.MType_JOIN_ACCEPT:
+				if dev.MACState.PendingJoinRequest == nil || dev.PendingSession == nil {
+					break
+				}
+				_, band, err := getDeviceBandVersion(dev, ns.FrequencyPlans)
+				if err != nil {
+					return nil, nil, errUnknownBand.WithCause(err)
+				}
+				rx2Delay = band.JoinAcceptDelay2
+				dev.MACState.QueuedJoinAccept = &ttnpb.MACState_JoinAccept{
+					Payload: down.RawPayload,
+					Request: *dev.MACState.PendingJoinRequest,
+					Keys:    dev.PendingSession.SessionKeys,
+				}
+				dev.MACState.PendingJoinRequest = nil
+				dev.PendingSession = nil
+
+			case ttnpb.MType_UNCONFIRMED_DOWN, ttnpb.MType_CONFIRMED_DOWN:
+				pld := msg.GetMACPayload()
+				if pld == nil || dev.Session == nil {
+					break
+				}
+				// The network frame counter is used for MAC-only downlinks and by LoRaWAN 1.0.x devices. LoRaWAN 1.1
+				// application downlinks use the application frame counter, which is reused by queueing the
+				// application downlink again.
+				if pld.FPort == 0 || dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+					if pld.FCnt > 0 && dev.Session.LastNFCntDown == pld.FCnt {
+						dev.Session.LastNFCntDown--
+					}
+				}
+				cmds, err := downlinkMACCommands(dev, pld)
+				if err != nil {
+					return nil, nil, err
+				}
+				restoreMACCommands(dev.MACState, cmds)
+				if pld.FPort > 0 {
+					confirmed := msg.MType == ttnpb.MType_CONFIRMED_DOWN
+					appDown := &ttnpb.ApplicationDownlink{
+						SessionKeyID: dev.Session.SessionKeyID,
+						FPort:        pld.FPort,
+						FCnt:         pld.FCnt,
+						FRMPayload:   pld.FRMPayload,
+						Confirmed:    confirmed,
+					}
+					if pending := dev.MACState.PendingApplicationDownlink; confirmed && pending != nil && pending.FCnt == pld.FCnt {
+						appDown = pending
+						dev.MACState.PendingApplicationDownlink = nil
+						if dev.MACState.PendingApplicationDownlinkAttempts > 0 {
+							// The failed transmission does not count as an attempt.
+							dev.MACState.PendingApplicationDownlinkAttempts--
+						}
+					}
+					dev.QueuedApplicationDownlinks = append([]*ttnpb.ApplicationDownlink{appDown}, dev.QueuedApplicationDownlinks...)
+				}
+				dev.MACState.LastConfirmedDownlinkAt = nil
+			}
+
+			if n := len(dev.RecentUplinks); n > 0 {
+				up := dev.RecentUplinks[n-1]
+				// Prefer other gateways when retrying the downlink.
+				for _, md := range up.RxMetadata {
+					if md.GatewayIdentifiers.GatewayID == ack.GatewayID && md.DownlinkPathConstraint == ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE {
+						md.DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_PREFER_OTHER
+					}
+				}
+				if dev.MACState.DeviceClass == ttnpb.CLASS_A && time.Now().Before(up.ReceivedAt.Add(rx2Delay)) {
+					dev.MACState.RxWindowsAvailable = true
+				}
+			}
+			rolledBack = true
+			return dev, []string{
+				"mac_state",
+				"pending_session",
+				"queued_application_downlinks",
+				"recent_downlinks",
+				"recent_uplinks",
+				"session",
+			}, nil
+		})
+	if err != nil {
+		logger.WithError(err).Warn("Failed to roll back failed downlink")
+		return nil, err
+	}
+	if !rolledBack {
+		return ttnpb.Empty, nil
+	}
+	logger.Debug("Rolled back failed downlink, retry")
+	return ttnpb.Empty, ns.downlinkTasks.Add(ctx, devID, time.Now())
+}
+
+// downlinkMACCommands returns the MAC commands sent in the given downlink MAC payload.
+func downlinkMACCommands(dev *ttnpb.EndDevice, pld *ttnpb.MACPayload) ([]*ttnpb.MACCommand, error) {
+	mac := pld.FOpts
+	if len(mac) == 0 && pld.FPort == 0 {
+		mac = pld.FRMPayload
+	}
+	if len(mac) == 0 {
+		return nil, nil
+	}
+	if len(pld.FOpts) == 0 || dev.MACState.LoRaWANVersion.EncryptFOpts() {
+		if dev.Session.NwkSEncKey == nil || len(dev.Session.NwkSEncKey.Key) == 0 {
+			return nil, errUnknownNwkSEncKey
+		}
+
+		var key types.AES128Key
+		if dev.Session.NwkSEncKey.KEKLabel != "" {
+			// TODO: https://github.com/TheThingsNetwork/lorawan-stack/issues/5
+			panic("unsupported")
+		}
+		copy(key[:], dev.Session.NwkSEncKey.Key[:])
+
+		var err error
+		mac, err = crypto.DecryptDownlink(key, pld.DevAddr, pld.FCnt, mac)
+		if err != nil {
+			return nil, errDecrypt.WithCause(err)
+		}
+	}
+
+	var cmds []*ttnpb.MACCommand
+	for r := bytes.NewReader(mac); r.Len() > 0; {
+		cmd := &ttnpb.MACCommand{}
+		if err := lorawan.DefaultMACCommands.ReadDownlink(r, cmd); err != nil {
+			return nil, errDecodePayload.WithCause(err)
+		}
+		cmds = append(cmds, cmd)
+	}
+	return cmds, nil
+}
+
+// restoreMACCommands restores the MAC state to before the given MAC commands were sent to the device.
+// The requests are no longer pending, and the responses are queued again.
+func restoreMACCommands(macState *ttnpb.MACState, cmds []*ttnpb.MACCommand) {
+	sent := func(cmd *ttnpb.MACCommand) bool {
+		for _, other := range cmds {
+			if cmd.Equal(other) {
+				return true
+			}
+		}
+		return false
+	}
+	pending := macState.PendingRequests[:0]
+	for _, req := range macState.PendingRequests {
+		if !sent(req) {
+			pending = append(pending, req)
+		}
+	}
+	macState.PendingRequests = pending
+
+	queued := func(cmd *ttnpb.MACCommand) bool {
+		for _, other := range macState.QueuedResponses {
+			if cmd.CID == other.CID {
+				return true
+			}
+		}
+		return false
+	}
+	var responses []*ttnpb.MACCommand
+	for _, cmd := range cmds {
+		if desc, ok := lorawan.DefaultMACCommands[cmd.CID]; ok && desc.InitiatedByDevice && !queued(cmd) {
+			responses = append(responses, cmd)
+		}
+	}
+	macState.QueuedResponses = append(responses, macState.QueuedResponses...)
+}
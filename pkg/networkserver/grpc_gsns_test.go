@@ -1193,7 +1193,7 @@ func handleUplinkTest() func(t *testing.T) {
 					pb.CreatedAt = ret.CreatedAt
 					pb.UpdatedAt = ret.UpdatedAt
 					if pb.MACState == nil {
-						err := ResetMACState(pb, ns.FrequencyPlans)
+						err := ResetMACState(pb, ns.FrequencyPlans, nil)
 						if !a.So(err, should.BeNil) {
 							t.FailNow()
 						}
@@ -1670,7 +1670,7 @@ func handleJoinTest() func(t *testing.T) {
 				pb.UpdatedAt = ret.UpdatedAt
 				a.So(ret, should.Resemble, pb)
 
-				err = ResetMACState(ret, ns.FrequencyPlans)
+				err = ResetMACState(ret, ns.FrequencyPlans, nil)
 				if !a.So(err, should.BeNil) {
 					t.Fatalf("Failed to reset MAC state: %s", err)
 				}
@@ -1969,3 +1969,357 @@ func TestHandleUplink(t *testing.T) {
 	t.Run("Join", handleJoinTest())
 	t.Run("Rejoin", handleRejoinTest())
 }
+
+func TestReportTxAcknowledgment(t *testing.T) {
+	authorizedCtx := clusterauth.NewContext(test.Context(), nil)
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: ApplicationID,
+		},
+		DeviceID: DeviceID,
+		DevAddr:  &DevAddr,
+	}
+	gtwIDs := ttnpb.GatewayIdentifiers{
+		GatewayID: "test-gtw",
+	}
+
+	makeDownlink := func(mType ttnpb.MType, fCnt, fPort uint32, frmPayload []byte) *ttnpb.DownlinkMessage {
+		b, err := lorawan.MarshalMessage(ttnpb.Message{
+			MHDR: ttnpb.MHDR{
+				MType: mType,
+				Major: ttnpb.Major_LORAWAN_R1,
+			},
+			Payload: &ttnpb.Message_MACPayload{
+				MACPayload: &ttnpb.MACPayload{
+					FHDR: ttnpb.FHDR{
+						DevAddr: DevAddr,
+						FCnt:    fCnt,
+					},
+					FPort:      fPort,
+					FRMPayload: frmPayload,
+				},
+			},
+		})
+		if err != nil {
+			panic(err)
+		}
+		return &ttnpb.DownlinkMessage{
+			RawPayload:     append(b, 0x01, 0x02, 0x03, 0x04),
+			EndDeviceIDs:   &ids,
+			CorrelationIDs: []string{"ns:downlink:test"},
+		}
+	}
+	linkCheckAns := &ttnpb.MACCommand_LinkCheckAns{
+		Margin:       20,
+		GatewayCount: 2,
+	}
+	macPayload := func() []byte {
+		b := test.Must(lorawan.DefaultMACCommands.AppendDownlink(nil, *linkCheckAns.MACCommand())).([]byte)
+		b = test.Must(lorawan.DefaultMACCommands.AppendDownlink(b, *ttnpb.CID_DEV_STATUS.MACCommand())).([]byte)
+		return test.Must(crypto.EncryptDownlink(NwkSEncKey, DevAddr, 42, b)).([]byte)
+	}()
+	joinAccept := &ttnpb.DownlinkMessage{
+		RawPayload:   append([]byte{0x20}, bytes.Repeat([]byte{0x42}, 16)...),
+		EndDeviceIDs: &ids,
+	}
+	makeDevice := func(downs ...*ttnpb.DownlinkMessage) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ids,
+			FrequencyPlanID:      test.EUFrequencyPlanID,
+			LoRaWANPHYVersion:    ttnpb.PHY_V1_0_2_REV_B,
+			MACState: &ttnpb.MACState{
+				DeviceClass:             ttnpb.CLASS_C,
+				LoRaWANVersion:          ttnpb.MAC_V1_0_2,
+				LastConfirmedDownlinkAt: TimePtr(time.Now()),
+			},
+			Session: &ttnpb.Session{
+				DevAddr:       DevAddr,
+				LastNFCntDown: 42,
+			},
+			RecentUplinks: []*ttnpb.UplinkMessage{
+				{
+					RxMetadata: []*ttnpb.RxMetadata{
+						{
+							GatewayIdentifiers: gtwIDs,
+						},
+						{
+							GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+								GatewayID: "other-gtw",
+							},
+						},
+					},
+				},
+			},
+			RecentDownlinks: downs,
+		}
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Device         *ttnpb.EndDevice
+		Ack            *ttnpb.GatewayTxAcknowledgment
+		ExpectedDevice func(*ttnpb.EndDevice) *ttnpb.EndDevice
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "NoDownlinkMessage",
+			Ack: &ttnpb.GatewayTxAcknowledgment{
+				GatewayIdentifiers: gtwIDs,
+				TxAck: ttnpb.TxAcknowledgment{
+					Result: ttnpb.TxAcknowledgment_TOO_LATE,
+				},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:   "Success",
+			Device: makeDevice(makeDownlink(ttnpb.MType_UNCONFIRMED_DOWN, 42, 1, []byte{0x42})),
+			Ack: &ttnpb.GatewayTxAcknowledgment{
+				GatewayIdentifiers: gtwIDs,
+				TxAck: ttnpb.TxAcknowledgment{
+					Result:          ttnpb.TxAcknowledgment_SUCCESS,
+					DownlinkMessage: makeDownlink(ttnpb.MType_UNCONFIRMED_DOWN, 42, 1, []byte{0x42}),
+				},
+			},
+		},
+		{
+			Name: "NotMostRecent",
+			Device: makeDevice(
+				makeDownlink(ttnpb.MType_UNCONFIRMED_DOWN, 41, 1, []byte{0x41}),
+				makeDownlink(ttnpb.MType_UNCONFIRMED_DOWN, 42, 1, []byte{0x42}),
+			),
+			Ack: &ttnpb.GatewayTxAcknowledgment{
+				GatewayIdentifiers: gtwIDs,
+				TxAck: ttnpb.TxAcknowledgment{
+					Result:          ttnpb.TxAcknowledgment_COLLISION_PACKET,
+					DownlinkMessage: makeDownlink(ttnpb.MType_UNCONFIRMED_DOWN, 41, 1, []byte{0x41}),
+				},
+			},
+		},
+		{
+			Name: "ApplicationDownlink",
+			Device: makeDevice(
+				makeDownlink(ttnpb.MType_UNCONFIRMED_DOWN, 41, 1, []byte{0x41}),
+				makeDownlink(ttnpb.MType_UNCONFIRMED_DOWN, 42, 1, []byte{0x42}),
+			),
+			Ack: &ttnpb.GatewayTxAcknowledgment{
+				GatewayIdentifiers: gtwIDs,
+				TxAck: ttnpb.TxAcknowledgment{
+					Result:          ttnpb.TxAcknowledgment_TOO_LATE,
+					DownlinkMessage: makeDownlink(ttnpb.MType_UNCONFIRMED_DOWN, 42, 1, []byte{0x42}),
+				},
+			},
+			ExpectedDevice: func(dev *ttnpb.EndDevice) *ttnpb.EndDevice {
+				dev.RecentDownlinks = dev.RecentDownlinks[:1]
+				dev.Session.LastNFCntDown = 41
+				dev.MACState.LastConfirmedDownlinkAt = nil
+				dev.QueuedApplicationDownlinks = []*ttnpb.ApplicationDownlink{
+					{
+						FPort:      1,
+						FCnt:       42,
+						FRMPayload: []byte{0x42},
+					},
+				}
+				dev.RecentUplinks[0].RxMetadata[0].DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_PREFER_OTHER
+				return dev
+			},
+		},
+		{
+			Name: "ConfirmedApplicationDownlink",
+			Device: func() *ttnpb.EndDevice {
+				dev := makeDevice(makeDownlink(ttnpb.MType_CONFIRMED_DOWN, 42, 2, []byte{0x42}))
+				dev.MACState.PendingApplicationDownlink = &ttnpb.ApplicationDownlink{
+					FPort:          2,
+					FCnt:           42,
+					FRMPayload:     []byte{0x42},
+					Confirmed:      true,
+					CorrelationIDs: []string{"as:downlink:test"},
+				}
+				return dev
+			}(),
+			Ack: &ttnpb.GatewayTxAcknowledgment{
+				GatewayIdentifiers: gtwIDs,
+				TxAck: ttnpb.TxAcknowledgment{
+					Result:          ttnpb.TxAcknowledgment_COLLISION_PACKET,
+					DownlinkMessage: makeDownlink(ttnpb.MType_CONFIRMED_DOWN, 42, 2, []byte{0x42}),
+				},
+			},
+			ExpectedDevice: func(dev *ttnpb.EndDevice) *ttnpb.EndDevice {
+				dev.RecentDownlinks = dev.RecentDownlinks[:0]
+				dev.Session.LastNFCntDown = 41
+				dev.MACState.LastConfirmedDownlinkAt = nil
+				dev.QueuedApplicationDownlinks = []*ttnpb.ApplicationDownlink{
+					dev.MACState.PendingApplicationDownlink,
+				}
+				dev.MACState.PendingApplicationDownlink = nil
+				dev.RecentUplinks[0].RxMetadata[0].DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_PREFER_OTHER
+				return dev
+			},
+		},
+		{
+			Name: "MACDownlink",
+			Device: func() *ttnpb.EndDevice {
+				dev := makeDevice(makeDownlink(ttnpb.MType_UNCONFIRMED_DOWN, 42, 0, macPayload))
+				dev.Session.NwkSEncKey = &ttnpb.KeyEnvelope{
+					Key: NwkSEncKey[:],
+				}
+				dev.MACState.PendingRequests = []*ttnpb.MACCommand{
+					ttnpb.CID_DEV_STATUS.MACCommand(),
+				}
+				return dev
+			}(),
+			Ack: &ttnpb.GatewayTxAcknowledgment{
+				GatewayIdentifiers: gtwIDs,
+				TxAck: ttnpb.TxAcknowledgment{
+					Result:          ttnpb.TxAcknowledgment_TOO_LATE,
+					DownlinkMessage: makeDownlink(ttnpb.MType_UNCONFIRMED_DOWN, 42, 0, macPayload),
+				},
+			},
+			ExpectedDevice: func(dev *ttnpb.EndDevice) *ttnpb.EndDevice {
+				dev.RecentDownlinks = dev.RecentDownlinks[:0]
+				dev.Session.LastNFCntDown = 41
+				dev.MACState.LastConfirmedDownlinkAt = nil
+				dev.MACState.PendingRequests = []*ttnpb.MACCommand{}
+				dev.MACState.QueuedResponses = []*ttnpb.MACCommand{
+					linkCheckAns.MACCommand(),
+				}
+				dev.RecentUplinks[0].RxMetadata[0].DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_PREFER_OTHER
+				return dev
+			},
+		},
+		{
+			Name: "LoRaWAN1.1ApplicationDownlink",
+			Device: func() *ttnpb.EndDevice {
+				dev := makeDevice(makeDownlink(ttnpb.MType_UNCONFIRMED_DOWN, 42, 1, []byte{0x42}))
+				dev.MACState.LoRaWANVersion = ttnpb.MAC_V1_1
+				return dev
+			}(),
+			Ack: &ttnpb.GatewayTxAcknowledgment{
+				GatewayIdentifiers: gtwIDs,
+				TxAck: ttnpb.TxAcknowledgment{
+					Result:          ttnpb.TxAcknowledgment_TOO_LATE,
+					DownlinkMessage: makeDownlink(ttnpb.MType_UNCONFIRMED_DOWN, 42, 1, []byte{0x42}),
+				},
+			},
+			ExpectedDevice: func(dev *ttnpb.EndDevice) *ttnpb.EndDevice {
+				// The application frame counter is reused by the queued application downlink; the network frame
+				// counter is not affected.
+				dev.RecentDownlinks = dev.RecentDownlinks[:0]
+				dev.MACState.LastConfirmedDownlinkAt = nil
+				dev.QueuedApplicationDownlinks = []*ttnpb.ApplicationDownlink{
+					{
+						FPort:      1,
+						FCnt:       42,
+						FRMPayload: []byte{0x42},
+					},
+				}
+				dev.RecentUplinks[0].RxMetadata[0].DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_PREFER_OTHER
+				return dev
+			},
+		},
+		{
+			Name: "JoinAccept",
+			Device: func() *ttnpb.EndDevice {
+				dev := makeDevice(joinAccept)
+				dev.MACState.DeviceClass = ttnpb.CLASS_A
+				dev.MACState.PendingJoinRequest = &ttnpb.JoinRequest{
+					DevAddr: DevAddr,
+				}
+				dev.PendingSession = &ttnpb.Session{
+					DevAddr: DevAddr,
+					SessionKeys: ttnpb.SessionKeys{
+						SessionKeyID: []byte{0x01, 0x02},
+					},
+				}
+				dev.RecentUplinks[0].ReceivedAt = time.Now()
+				return dev
+			}(),
+			Ack: &ttnpb.GatewayTxAcknowledgment{
+				GatewayIdentifiers: gtwIDs,
+				TxAck: ttnpb.TxAcknowledgment{
+					Result:          ttnpb.TxAcknowledgment_COLLISION_PACKET,
+					DownlinkMessage: joinAccept,
+				},
+			},
+			ExpectedDevice: func(dev *ttnpb.EndDevice) *ttnpb.EndDevice {
+				dev.RecentDownlinks = dev.RecentDownlinks[:0]
+				dev.MACState.QueuedJoinAccept = &ttnpb.MACState_JoinAccept{
+					Payload: joinAccept.RawPayload,
+					Request: *dev.MACState.PendingJoinRequest,
+					Keys:    dev.PendingSession.SessionKeys,
+				}
+				dev.MACState.PendingJoinRequest = nil
+				dev.MACState.RxWindowsAvailable = true
+				dev.PendingSession = nil
+				dev.RecentUplinks[0].RxMetadata[0].DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_PREFER_OTHER
+				return dev
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var stored *ttnpb.EndDevice
+			var setCalls int
+			addCh := make(chan ttnpb.EndDeviceIdentifiers, 1)
+			ns := test.Must(New(
+				component.MustNew(test.GetLogger(t), &component.Config{}),
+				&Config{
+					Devices: &MockDeviceRegistry{
+						SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+							setCalls++
+							a.So(appID, should.Resemble, ids.ApplicationIdentifiers)
+							a.So(devID, should.Equal, ids.DeviceID)
+							dev, _, err := f(CopyEndDevice(tc.Device))
+							if err != nil {
+								return nil, err
+							}
+							stored = dev
+							return dev, nil
+						},
+					},
+					DeduplicationWindow: 42,
+					CooldownWindow:      42,
+					DownlinkTasks: &MockDownlinkTaskQueue{
+						AddFunc: func(ctx context.Context, devID ttnpb.EndDeviceIdentifiers, t time.Time) error {
+							addCh <- devID
+							return nil
+						},
+					},
+				},
+			)).(*NetworkServer)
+			ns.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+			test.Must(nil, ns.Start())
+			defer ns.Close()
+
+			_, err := ns.ReportTxAcknowledgment(authorizedCtx, tc.Ack)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			if tc.Ack.TxAck.Result == ttnpb.TxAcknowledgment_SUCCESS {
+				a.So(setCalls, should.Equal, 0)
+				a.So(addCh, should.BeEmpty)
+				return
+			}
+			a.So(setCalls, should.Equal, 1)
+			if tc.ExpectedDevice == nil {
+				a.So(stored, should.Resemble, tc.Device)
+				a.So(addCh, should.BeEmpty)
+				return
+			}
+			a.So(stored, should.Resemble, tc.ExpectedDevice(CopyEndDevice(tc.Device)))
+			select {
+			case devID := <-addCh:
+				a.So(devID, should.Resemble, ids)
+			default:
+				t.Fatal("Expected downlink task to be added")
+			}
+		})
+	}
+}
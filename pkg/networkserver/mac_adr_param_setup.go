@@ -18,7 +18,6 @@ import (
 	"context"
 
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -43,7 +42,7 @@ func enqueueADRParamSetupReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownL
 			ADRAckLimitExponent: lorawan.Uint32ToADRAckLimitExponent(dev.MACState.DesiredParameters.ADRAckLimit),
 			ADRAckDelayExponent: lorawan.Uint32ToADRAckDelayExponent(dev.MACState.DesiredParameters.ADRAckDelay),
 		}
-		events.Publish(evtEnqueueADRParamSetupRequest(ctx, dev.EndDeviceIdentifiers, req))
+		publishEvents(ctx, evtEnqueueADRParamSetupRequest(ctx, dev.EndDeviceIdentifiers, req))
 		return []*ttnpb.MACCommand{req.MACCommand()}, 1, true
 
 	}, dev.MACState.PendingRequests...)
@@ -51,7 +50,7 @@ func enqueueADRParamSetupReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownL
 }
 
 func handleADRParamSetupAns(ctx context.Context, dev *ttnpb.EndDevice) (err error) {
-	events.Publish(evtReceiveADRParamSetupAnswer(ctx, dev.EndDeviceIdentifiers, nil))
+	publishEvents(ctx, evtReceiveADRParamSetupAnswer(ctx, dev.EndDeviceIdentifiers, nil))
 
 	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_ADR_PARAM_SETUP, func(cmd *ttnpb.MACCommand) error {
 		req := cmd.GetADRParamSetupReq()
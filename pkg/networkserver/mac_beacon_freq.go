@@ -17,7 +17,6 @@ package networkserver
 import (
 	"context"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -41,7 +40,7 @@ func enqueueBeaconFreqReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen,
 		pld := &ttnpb.MACCommand_BeaconFreqReq{
 			Frequency: dev.MACState.DesiredParameters.BeaconFrequency,
 		}
-		events.Publish(evtEnqueueBeaconFreqRequest(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtEnqueueBeaconFreqRequest(ctx, dev.EndDeviceIdentifiers, pld))
 		return []*ttnpb.MACCommand{pld.MACCommand()}, 1, true
 
 	}, dev.MACState.PendingRequests...)
@@ -54,9 +53,9 @@ func handleBeaconFreqAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.M
 	}
 
 	if !pld.FrequencyAck {
-		events.Publish(evtReceiveBeaconFreqReject(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtReceiveBeaconFreqReject(ctx, dev.EndDeviceIdentifiers, pld))
 	} else {
-		events.Publish(evtReceiveBeaconFreqAccept(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtReceiveBeaconFreqAccept(ctx, dev.EndDeviceIdentifiers, pld))
 	}
 
 	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_BEACON_FREQ, func(cmd *ttnpb.MACCommand) error {
@@ -18,7 +18,6 @@ import (
 	"context"
 	"time"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -40,7 +39,7 @@ func enqueueDevStatusReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen,
 			return nil, 0, false
 		}
 
-		events.Publish(evtEnqueueDevStatusRequest(ctx, dev.EndDeviceIdentifiers, nil))
+		publishEvents(ctx, evtEnqueueDevStatusRequest(ctx, dev.EndDeviceIdentifiers, nil))
 		return []*ttnpb.MACCommand{ttnpb.CID_DEV_STATUS.MACCommand()}, 1, true
 
 	}, dev.MACState.PendingRequests...)
@@ -52,7 +51,7 @@ func handleDevStatusAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MA
 		return errNoPayload
 	}
 
-	events.Publish(evtReceiveDevStatusAnswer(ctx, dev.EndDeviceIdentifiers, pld))
+	publishEvents(ctx, evtReceiveDevStatusAnswer(ctx, dev.EndDeviceIdentifiers, pld))
 
 	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_DEV_STATUS, func(*ttnpb.MACCommand) error {
 		switch pld.Battery {
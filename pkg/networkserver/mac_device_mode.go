@@ -17,7 +17,6 @@ package networkserver
 import (
 	"context"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -31,7 +30,7 @@ func handleDeviceModeInd(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.M
 		return errNoPayload
 	}
 
-	events.Publish(evtReceiveDeviceModeIndication(ctx, dev.EndDeviceIdentifiers, pld))
+	publishEvents(ctx, evtReceiveDeviceModeIndication(ctx, dev.EndDeviceIdentifiers, pld))
 
 	dev.MACState.DeviceClass = pld.Class
 	conf := &ttnpb.MACCommand_DeviceModeConf{
@@ -39,6 +38,6 @@ func handleDeviceModeInd(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.M
 	}
 	dev.MACState.QueuedResponses = append(dev.MACState.QueuedResponses, conf.MACCommand())
 
-	events.Publish(evtEnqueueDeviceModeConfirmation(ctx, dev.EndDeviceIdentifiers, conf))
+	publishEvents(ctx, evtEnqueueDeviceModeConfirmation(ctx, dev.EndDeviceIdentifiers, conf))
 	return nil
 }
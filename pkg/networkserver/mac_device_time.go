@@ -19,7 +19,6 @@ import (
 	"sort"
 	"time"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -29,7 +28,7 @@ var (
 )
 
 func handleDeviceTimeReq(ctx context.Context, dev *ttnpb.EndDevice, msg *ttnpb.UplinkMessage) error {
-	events.Publish(evtReceiveDeviceTimeRequest(ctx, dev.EndDeviceIdentifiers, nil))
+	publishEvents(ctx, evtReceiveDeviceTimeRequest(ctx, dev.EndDeviceIdentifiers, nil))
 
 	ts := make([]time.Time, 0, len(msg.RxMetadata))
 	for _, md := range msg.RxMetadata {
@@ -55,6 +54,6 @@ func handleDeviceTimeReq(ctx context.Context, dev *ttnpb.EndDevice, msg *ttnpb.U
 	}
 	dev.MACState.QueuedResponses = append(dev.MACState.QueuedResponses, ans.MACCommand())
 
-	events.Publish(evtEnqueueDeviceTimeAnswer(ctx, dev.EndDeviceIdentifiers, ans))
+	publishEvents(ctx, evtEnqueueDeviceTimeAnswer(ctx, dev.EndDeviceIdentifiers, ans))
 	return nil
 }
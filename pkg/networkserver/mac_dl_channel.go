@@ -17,7 +17,6 @@ package networkserver
 import (
 	"context"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -47,7 +46,7 @@ func enqueueDLChannelReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen,
 			}
 			cmds = append(cmds, pld.MACCommand())
 
-			events.Publish(evtEnqueueDLChannelRequest(ctx, dev.EndDeviceIdentifiers, pld))
+			publishEvents(ctx, evtEnqueueDLChannelRequest(ctx, dev.EndDeviceIdentifiers, pld))
 		}
 		return cmds, uint16(len(cmds)), true
 
@@ -61,9 +60,9 @@ func handleDLChannelAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MA
 	}
 
 	if !pld.ChannelIndexAck || !pld.FrequencyAck {
-		events.Publish(evtReceiveDLChannelReject(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtReceiveDLChannelReject(ctx, dev.EndDeviceIdentifiers, pld))
 	} else {
-		events.Publish(evtReceiveDLChannelAccept(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtReceiveDLChannelAccept(ctx, dev.EndDeviceIdentifiers, pld))
 	}
 
 	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_DL_CHANNEL, func(cmd *ttnpb.MACCommand) error {
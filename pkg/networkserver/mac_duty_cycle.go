@@ -17,7 +17,6 @@ package networkserver
 import (
 	"context"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -39,7 +38,7 @@ func enqueueDutyCycleReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen,
 		pld := &ttnpb.MACCommand_DutyCycleReq{
 			MaxDutyCycle: dev.MACState.DesiredParameters.MaxDutyCycle,
 		}
-		events.Publish(evtEnqueueDutyCycleRequest(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtEnqueueDutyCycleRequest(ctx, dev.EndDeviceIdentifiers, pld))
 		return []*ttnpb.MACCommand{pld.MACCommand()}, 1, true
 
 	}, dev.MACState.PendingRequests...)
@@ -47,7 +46,7 @@ func enqueueDutyCycleReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen,
 }
 
 func handleDutyCycleAns(ctx context.Context, dev *ttnpb.EndDevice) (err error) {
-	events.Publish(evtReceiveDutyCycleAnswer(ctx, dev.EndDeviceIdentifiers, nil))
+	publishEvents(ctx, evtReceiveDutyCycleAnswer(ctx, dev.EndDeviceIdentifiers, nil))
 
 	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_DUTY_CYCLE, func(cmd *ttnpb.MACCommand) error {
 		req := cmd.GetDutyCycleReq()
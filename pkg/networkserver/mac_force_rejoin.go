@@ -17,7 +17,6 @@ package networkserver
 import (
 	"context"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -28,7 +27,7 @@ var (
 func enqueueForceRejoinReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16) (uint16, uint16, bool) {
 	var pld *ttnpb.MACCommand_ForceRejoinReq
 	if pld != nil {
-		events.Publish(evtEnqueueForceRejoinRequest(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtEnqueueForceRejoinRequest(ctx, dev.EndDeviceIdentifiers, pld))
 	}
 	return maxDownLen, maxUpLen, true
 }
@@ -18,7 +18,6 @@ import (
 	"context"
 	"math"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -86,7 +85,7 @@ func enqueueLinkADRReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, ma
 				ChannelMask:        m.Mask[:],
 			}
 			cmds = append(cmds, pld.MACCommand())
-			events.Publish(evtEnqueueLinkADRRequest(ctx, dev.EndDeviceIdentifiers, pld))
+			publishEvents(ctx, evtEnqueueLinkADRRequest(ctx, dev.EndDeviceIdentifiers, pld))
 		}
 		return cmds, uplinksNeeded, true
 
@@ -100,9 +99,9 @@ func handleLinkADRAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACC
 	}
 
 	if !pld.ChannelMaskAck || !pld.DataRateIndexAck || !pld.TxPowerIndexAck {
-		events.Publish(evtReceiveLinkADRReject(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtReceiveLinkADRReject(ctx, dev.EndDeviceIdentifiers, pld))
 	} else {
-		events.Publish(evtReceiveLinkADRAccept(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtReceiveLinkADRAccept(ctx, dev.EndDeviceIdentifiers, pld))
 	}
 
 	_, band, err := getDeviceBandVersion(dev, fps)
@@ -115,14 +114,14 @@ func handleLinkADRAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACC
 		handler = handleMACResponse
 	}
 
-	if dev.MACState.LoRaWANVersion != ttnpb.MAC_V1_0_2 && dupCount != 0 {
+	if !dev.MACState.LoRaWANVersion.DuplicatesLinkADRAns() && dupCount != 0 {
 		return errInvalidPayload
 	}
 
 	var n uint
 	var req *ttnpb.MACCommand_LinkADRReq
 	dev.MACState.PendingRequests, err = handler(ttnpb.CID_LINK_ADR, func(cmd *ttnpb.MACCommand) error {
-		if dev.MACState.LoRaWANVersion == ttnpb.MAC_V1_0_2 && n > dupCount+1 {
+		if dev.MACState.LoRaWANVersion.DuplicatesLinkADRAns() && n > dupCount+1 {
 			return errInvalidPayload
 		}
 		n++
@@ -354,6 +354,102 @@ func TestHandleLinkADRAns(t *testing.T) {
 					})
 			},
 		},
+		{
+			Name:     "1.0.3/2 requests/all ack",
+			DupCount: 1,
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion: ttnpb.MAC_V1_0_3,
+					CurrentParameters: ttnpb.MACParameters{
+						Channels: []*ttnpb.MACParameters_Channel{
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							nil,
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{
+						(&ttnpb.MACCommand_LinkADRReq{
+							DataRateIndex: ttnpb.DATA_RATE_5,
+							TxPowerIndex:  42,
+							ChannelMask: []bool{
+								true, true, true, false,
+								true, true, true, true,
+								true, true, true, true,
+								true, true, false, false,
+							},
+						}).MACCommand(),
+						(&ttnpb.MACCommand_LinkADRReq{
+							DataRateIndex: ttnpb.DATA_RATE_10,
+							TxPowerIndex:  43,
+							ChannelMask: []bool{
+								false, true, true, false,
+								true, true, true, true,
+								true, true, true, true,
+								true, true, false, false,
+							},
+						}).MACCommand(),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion: ttnpb.MAC_V1_0_3,
+					CurrentParameters: ttnpb.MACParameters{
+						ADRDataRateIndex: ttnpb.DATA_RATE_10,
+						ADRTxPowerIndex:  43,
+						Channels: []*ttnpb.MACParameters_Channel{
+							{EnableUplink: false},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							nil,
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: true},
+							{EnableUplink: false},
+						},
+					},
+					PendingRequests: []*ttnpb.MACCommand{},
+				},
+			},
+			Payload: &ttnpb.MACCommand_LinkADRAns{
+				ChannelMaskAck:   true,
+				DataRateIndexAck: true,
+				TxPowerIndexAck:  true,
+			},
+			AssertEvents: func(t *testing.T, evs ...events.Event) bool {
+				a := assertions.New(t)
+				return a.So(evs, should.HaveLength, 1) &&
+					a.So(evs[0].Name(), should.Equal, "ns.mac.link_adr.answer.accept") &&
+					a.So(evs[0].Data(), should.Resemble, &ttnpb.MACCommand_LinkADRAns{
+						ChannelMaskAck:   true,
+						DataRateIndexAck: true,
+						TxPowerIndexAck:  true,
+					})
+			},
+		},
 		{
 			Name:     "1.0/2 requests/all ack",
 			DupCount: 0,
@@ -480,3 +576,97 @@ func TestHandleLinkADRAns(t *testing.T) {
 		})
 	}
 }
+
+func TestEnqueueLinkADRReq(t *testing.T) {
+	for _, tc := range []struct {
+		Name             string
+		MACVersion       ttnpb.MACVersion
+		PHYVersion       ttnpb.PHYVersion
+		ExpectedRequests []*ttnpb.MACCommand_LinkADRReq
+	}{
+		{
+			Name:       "1.0.2/US915 FSB 2",
+			MACVersion: ttnpb.MAC_V1_0_2,
+			PHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+			ExpectedRequests: []*ttnpb.MACCommand_LinkADRReq{
+				{
+					ChannelMaskControl: 7,
+					ChannelMask: []bool{
+						false, true, false, false, false, false, false, false,
+						false, false, false, false, false, false, false, false,
+					},
+				},
+				{
+					ChannelMaskControl: 0,
+					ChannelMask: []bool{
+						false, false, false, false, false, false, false, false,
+						true, true, true, true, true, true, true, true,
+					},
+				},
+			},
+		},
+		{
+			Name:       "1.0.3/US915 FSB 2",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			PHYVersion: ttnpb.PHY_V1_0_3_REV_A,
+			ExpectedRequests: []*ttnpb.MACCommand_LinkADRReq{
+				{
+					ChannelMaskControl: 5,
+					ChannelMask: []bool{
+						false, true, false, false, false, false, false, false,
+						false, false, false, false, false, false, false, false,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			fps := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+			dev := &ttnpb.EndDevice{
+				FrequencyPlanID:   test.USFrequencyPlanID,
+				LoRaWANVersion:    tc.MACVersion,
+				LoRaWANPHYVersion: tc.PHYVersion,
+			}
+			if !a.So(resetMACState(dev, fps, nil), should.BeNil) {
+				t.FailNow()
+			}
+			// Devices, which joined without a CFList, use all channels of the band.
+			for _, ch := range dev.MACState.CurrentParameters.Channels {
+				a.So(ch.EnableUplink, should.BeTrue)
+			}
+
+			var err error
+			var ok bool
+			evs := collectEvents(func() {
+				_, _, ok, err = enqueueLinkADRReq(test.Context(), dev, 51, 51, fps)
+			})
+			if !a.So(err, should.BeNil) || !a.So(ok, should.BeTrue) {
+				t.FailNow()
+			}
+			a.So(evs, should.HaveLength, len(tc.ExpectedRequests))
+
+			expected := make([]*ttnpb.MACCommand, 0, len(tc.ExpectedRequests))
+			for _, req := range tc.ExpectedRequests {
+				req.DataRateIndex = dev.MACState.DesiredParameters.ADRDataRateIndex
+				req.NbTrans = dev.MACState.DesiredParameters.ADRNbTrans
+				req.TxPowerIndex = dev.MACState.DesiredParameters.ADRTxPowerIndex
+				expected = append(expected, req.MACCommand())
+			}
+			a.So(dev.MACState.PendingRequests, should.Resemble, expected)
+
+			err = handleLinkADRAns(test.Context(), dev, &ttnpb.MACCommand_LinkADRAns{
+				ChannelMaskAck:   true,
+				DataRateIndexAck: true,
+				TxPowerIndexAck:  true,
+			}, uint(len(tc.ExpectedRequests)-1), fps)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			for i, ch := range dev.MACState.CurrentParameters.Channels {
+				a.So(ch.EnableUplink, should.Equal, dev.MACState.DesiredParameters.Channels[i].EnableUplink)
+			}
+		})
+	}
+}
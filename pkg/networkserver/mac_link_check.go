@@ -17,7 +17,6 @@ package networkserver
 import (
 	"context"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 )
@@ -28,7 +27,7 @@ var (
 )
 
 func handleLinkCheckReq(ctx context.Context, dev *ttnpb.EndDevice, msg *ttnpb.UplinkMessage) error {
-	events.Publish(evtReceiveLinkCheckRequest(ctx, dev.EndDeviceIdentifiers, nil))
+	publishEvents(ctx, evtReceiveLinkCheckRequest(ctx, dev.EndDeviceIdentifiers, nil))
 
 	var floor float32
 	if dr, ok := msg.Settings.DataRate.Modulation.(*ttnpb.DataRate_LoRa); ok {
@@ -55,6 +54,6 @@ func handleLinkCheckReq(ctx context.Context, dev *ttnpb.EndDevice, msg *ttnpb.Up
 	}
 	dev.MACState.QueuedResponses = append(dev.MACState.QueuedResponses, ans.MACCommand())
 
-	events.Publish(evtEnqueueLinkCheckAnswer(ctx, dev.EndDeviceIdentifiers, ans))
+	publishEvents(ctx, evtEnqueueLinkCheckAnswer(ctx, dev.EndDeviceIdentifiers, ans))
 	return nil
 }
@@ -17,7 +17,6 @@ package networkserver
 import (
 	"context"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -52,7 +51,7 @@ func enqueueNewChannelReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen,
 			}
 			cmds = append(cmds, pld.MACCommand())
 
-			events.Publish(evtEnqueueNewChannelRequest(ctx, dev.EndDeviceIdentifiers, pld))
+			publishEvents(ctx, evtEnqueueNewChannelRequest(ctx, dev.EndDeviceIdentifiers, pld))
 		}
 		return cmds, uint16(len(cmds)), true
 
@@ -66,9 +65,9 @@ func handleNewChannelAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.M
 	}
 
 	if !pld.DataRateAck || !pld.FrequencyAck {
-		events.Publish(evtReceiveNewChannelReject(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtReceiveNewChannelReject(ctx, dev.EndDeviceIdentifiers, pld))
 	} else {
-		events.Publish(evtReceiveNewChannelAccept(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtReceiveNewChannelAccept(ctx, dev.EndDeviceIdentifiers, pld))
 	}
 
 	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_NEW_CHANNEL, func(cmd *ttnpb.MACCommand) error {
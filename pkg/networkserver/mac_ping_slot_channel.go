@@ -17,7 +17,6 @@ package networkserver
 import (
 	"context"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -41,7 +40,7 @@ func enqueuePingSlotChannelReq(ctx context.Context, dev *ttnpb.EndDevice, maxDow
 			Frequency:     dev.MACState.DesiredParameters.PingSlotFrequency,
 			DataRateIndex: dev.MACState.DesiredParameters.PingSlotDataRateIndex,
 		}
-		events.Publish(evtEnqueuePingSlotChannelRequest(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtEnqueuePingSlotChannelRequest(ctx, dev.EndDeviceIdentifiers, pld))
 		return []*ttnpb.MACCommand{pld.MACCommand()}, 1, true
 
 	}, dev.MACState.PendingRequests...)
@@ -53,7 +52,7 @@ func handlePingSlotChannelAns(ctx context.Context, dev *ttnpb.EndDevice, pld *tt
 		return errNoPayload
 	}
 
-	events.Publish(evtReceivePingSlotChannelAnswer(ctx, dev.EndDeviceIdentifiers, pld))
+	publishEvents(ctx, evtReceivePingSlotChannelAnswer(ctx, dev.EndDeviceIdentifiers, pld))
 
 	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_PING_SLOT_CHANNEL, func(cmd *ttnpb.MACCommand) error {
 		req := cmd.GetPingSlotChannelReq()
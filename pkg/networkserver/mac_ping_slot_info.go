@@ -17,7 +17,6 @@ package networkserver
 import (
 	"context"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -31,11 +30,11 @@ func handlePingSlotInfoReq(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb
 		return errNoPayload
 	}
 
-	events.Publish(evtReceivePingSlotInfoRequest(ctx, dev.EndDeviceIdentifiers, pld))
+	publishEvents(ctx, evtReceivePingSlotInfoRequest(ctx, dev.EndDeviceIdentifiers, pld))
 
 	dev.MACState.PingSlotPeriodicity = pld.Period
 	dev.MACState.QueuedResponses = append(dev.MACState.QueuedResponses, ttnpb.CID_PING_SLOT_INFO.MACCommand())
 
-	events.Publish(evtEnqueuePingSlotInfoAnswer(ctx, dev.EndDeviceIdentifiers, nil))
+	publishEvents(ctx, evtEnqueuePingSlotInfoAnswer(ctx, dev.EndDeviceIdentifiers, nil))
 	return nil
 }
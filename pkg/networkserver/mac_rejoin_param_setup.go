@@ -17,7 +17,6 @@ package networkserver
 import (
 	"context"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -41,7 +40,7 @@ func enqueueRejoinParamSetupReq(ctx context.Context, dev *ttnpb.EndDevice, maxDo
 			MaxTimeExponent:  dev.MACState.DesiredParameters.RejoinTimePeriodicity,
 			MaxCountExponent: dev.MACState.DesiredParameters.RejoinCountPeriodicity,
 		}
-		events.Publish(evtEnqueueRejoinParamSetupRequest(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtEnqueueRejoinParamSetupRequest(ctx, dev.EndDeviceIdentifiers, pld))
 		return []*ttnpb.MACCommand{pld.MACCommand()}, 1, true
 
 	}, dev.MACState.PendingRequests...)
@@ -53,7 +52,7 @@ func handleRejoinParamSetupAns(ctx context.Context, dev *ttnpb.EndDevice, pld *t
 		return errNoPayload
 	}
 
-	events.Publish(evtReceiveRejoinParamSetupAnswer(ctx, dev.EndDeviceIdentifiers, pld))
+	publishEvents(ctx, evtReceiveRejoinParamSetupAnswer(ctx, dev.EndDeviceIdentifiers, pld))
 
 	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_REJOIN_PARAM_SETUP, func(cmd *ttnpb.MACCommand) error {
 		req := cmd.GetRejoinParamSetupReq()
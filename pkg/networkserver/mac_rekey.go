@@ -17,7 +17,6 @@ package networkserver
 import (
 	"context"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -31,7 +30,7 @@ func handleRekeyInd(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCom
 		return errNoPayload
 	}
 
-	events.Publish(evtReceiveRekeyIndication(ctx, dev.EndDeviceIdentifiers, pld))
+	publishEvents(ctx, evtReceiveRekeyIndication(ctx, dev.EndDeviceIdentifiers, pld))
 
 	if !dev.SupportsJoin {
 		return nil
@@ -45,6 +44,6 @@ func handleRekeyInd(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCom
 	}
 	dev.MACState.QueuedResponses = append(dev.MACState.QueuedResponses, conf.MACCommand())
 
-	events.Publish(evtEnqueueRekeyConfirmation(ctx, dev.EndDeviceIdentifiers, conf))
+	publishEvents(ctx, evtEnqueueRekeyConfirmation(ctx, dev.EndDeviceIdentifiers, conf))
 	return nil
 }
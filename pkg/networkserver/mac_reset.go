@@ -17,7 +17,6 @@ package networkserver
 import (
 	"context"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -27,18 +26,18 @@ var (
 	evtEnqueueResetConfirmation = defineEnqueueMACConfirmationEvent("reset", "device reset")()
 )
 
-func handleResetInd(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCommand_ResetInd, fps *frequencyplans.Store) error {
+func handleResetInd(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCommand_ResetInd, fps *frequencyplans.Store, profile *MACProfile) error {
 	if pld == nil {
 		return errNoPayload
 	}
 
-	events.Publish(evtReceiveResetIndication(ctx, dev.EndDeviceIdentifiers, pld))
+	publishEvents(ctx, evtReceiveResetIndication(ctx, dev.EndDeviceIdentifiers, pld))
 
 	if dev.SupportsJoin {
 		return nil
 	}
 
-	if err := resetMACState(dev, fps); err != nil {
+	if err := resetMACState(dev, fps, profile); err != nil {
 		return err
 	}
 	dev.MACState.LoRaWANVersion = ttnpb.MAC_V1_1
@@ -47,6 +46,6 @@ func handleResetInd(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb.MACCom
 	}
 	dev.MACState.QueuedResponses = append(dev.MACState.QueuedResponses, conf.MACCommand())
 
-	events.Publish(evtEnqueueResetConfirmation(ctx, dev.EndDeviceIdentifiers, conf))
+	publishEvents(ctx, evtEnqueueResetConfirmation(ctx, dev.EndDeviceIdentifiers, conf))
 	return nil
 }
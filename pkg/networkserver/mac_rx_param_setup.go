@@ -17,7 +17,6 @@ package networkserver
 import (
 	"context"
 
-	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -44,7 +43,7 @@ func enqueueRxParamSetupReq(ctx context.Context, dev *ttnpb.EndDevice, maxDownLe
 			Rx2DataRateIndex:  dev.MACState.DesiredParameters.Rx2DataRateIndex,
 			Rx1DataRateOffset: dev.MACState.DesiredParameters.Rx1DataRateOffset,
 		}
-		events.Publish(evtEnqueueRxParamSetupRequest(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtEnqueueRxParamSetupRequest(ctx, dev.EndDeviceIdentifiers, pld))
 		return []*ttnpb.MACCommand{pld.MACCommand()}, 1, true
 
 	}, dev.MACState.PendingRequests...)
@@ -57,9 +56,9 @@ func handleRxParamSetupAns(ctx context.Context, dev *ttnpb.EndDevice, pld *ttnpb
 	}
 
 	if !pld.Rx1DataRateOffsetAck || !pld.Rx2DataRateIndexAck || !pld.Rx2FrequencyAck {
-		events.Publish(evtReceiveRxParamSetupReject(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtReceiveRxParamSetupReject(ctx, dev.EndDeviceIdentifiers, pld))
 	} else {
-		events.Publish(evtReceiveRxParamSetupAccept(ctx, dev.EndDeviceIdentifiers, pld))
+		publishEvents(ctx, evtReceiveRxParamSetupAccept(ctx, dev.EndDeviceIdentifiers, pld))
 	}
 
 	dev.MACState.PendingRequests, err = handleMACResponse(ttnpb.CID_RX_PARAM_SETUP, func(cmd *ttnpb.MACCommand) error {
@@ -26,6 +26,8 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/retry"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
@@ -41,6 +43,15 @@ const (
 	fOptsCapacity = 15
 )
 
+const (
+	// DefaultADRMargin is the default ADR margin in dB, used if not configured.
+	DefaultADRMargin = 15
+	// DefaultClassBTimeout is the default class B timeout, used if not configured.
+	DefaultClassBTimeout = time.Minute
+	// DefaultClassCTimeout is the default class C timeout, used if not configured.
+	DefaultClassCTimeout = 10 * time.Second
+)
+
 func timePtr(t time.Time) *time.Time {
 	return &t
 }
@@ -109,7 +120,8 @@ type DownlinkPriorities struct {
 type NetworkServer struct {
 	*component.Component
 
-	devices DeviceRegistry
+	devices             DeviceRegistry
+	deviceRegistryRetry retry.Config
 
 	NetID types.NetID
 
@@ -125,6 +137,19 @@ type NetworkServer struct {
 	downlinkTasks      DownlinkTaskQueue
 	downlinkPriorities DownlinkPriorities
 
+	defaultMACSettings MACSettingConfig
+	macProfiles        map[string]*MACProfile
+	adrAlgorithms      map[string]ADRAlgorithm
+
+	downlinkGatewaySelectionStrategy string
+	downlinkGatewaySelectors         map[string]DownlinkGatewaySelector
+	txFailures                       *txFailureTracker
+
+	devAddrPrefixes            []types.DevAddrPrefix
+	applicationDevAddrPrefixes map[string][]types.DevAddrPrefix
+
+	roaming *passiveRoaming
+
 	deduplicationDone WindowEndFunc
 	collectionDone    WindowEndFunc
 
@@ -188,23 +213,96 @@ func WithMACHandler(cid ttnpb.MACCommandIdentifier, fn MACHandler) Option {
 	}
 }
 
+// WithADRAlgorithm registers an ADRAlgorithm by name, overriding any ADRAlgorithm previously registered by that name.
+// End devices use the ADRAlgorithm if it is referenced by their MAC settings, their MAC profile or the Network Server's default MAC settings.
+func WithADRAlgorithm(name string, alg ADRAlgorithm) Option {
+	return func(ns *NetworkServer) {
+		ns.adrAlgorithms[name] = alg
+	}
+}
+
+// WithDownlinkGatewaySelector registers a DownlinkGatewaySelector by name, overriding any DownlinkGatewaySelector
+// previously registered by that name. The Network Server uses the DownlinkGatewaySelector if it is referenced by the
+// downlink gateway selection strategy in the configuration.
+func WithDownlinkGatewaySelector(name string, sel DownlinkGatewaySelector) Option {
+	return func(ns *NetworkServer) {
+		ns.downlinkGatewaySelectors[name] = sel
+	}
+}
+
 // New returns new NetworkServer.
 func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer, error) {
 	downlinkPriorities, err := conf.DownlinkPriorities.Parse()
 	if err != nil {
 		return nil, err
 	}
+	macProfiles := make(map[string]*MACProfile, len(conf.MACProfiles))
+	for i, p := range conf.MACProfiles {
+		if err := p.Validate(); err != nil {
+			return nil, errInvalidConfiguration.WithCause(err)
+		}
+		if _, ok := macProfiles[p.Name]; ok {
+			return nil, errInvalidConfiguration.WithCause(errInvalidMACProfile.WithAttributes("name", p.Name).WithCause(errors.New("name is not unique")))
+		}
+		macProfiles[p.Name] = &conf.MACProfiles[i]
+	}
+	var netID types.NetID
+	if conf.NetID != "" {
+		if err := netID.UnmarshalText([]byte(conf.NetID)); err != nil {
+			return nil, errInvalidConfiguration.WithCause(err)
+		}
+	}
+	devAddrPrefixes, err := parseDevAddrPrefixes(netID, conf.DevAddrPrefixes)
+	if err != nil {
+		return nil, errInvalidConfiguration.WithCause(err)
+	}
+	applicationDevAddrPrefixes := make(map[string][]types.DevAddrPrefix, len(conf.ApplicationDevAddrPrefixes))
+	for appID, prefixes := range conf.ApplicationDevAddrPrefixes {
+		applicationDevAddrPrefixes[appID], err = parseDevAddrPrefixes(netID, prefixes)
+		if err != nil {
+			return nil, errInvalidConfiguration.WithCause(err)
+		}
+	}
+	roaming, err := newPassiveRoaming(conf.PassiveRoaming)
+	if err != nil {
+		return nil, errInvalidConfiguration.WithCause(err)
+	}
+	defaultMACSettings := conf.DefaultMACSettings
+	if defaultMACSettings.ADRMargin == 0 {
+		defaultMACSettings.ADRMargin = DefaultADRMargin
+	}
+	if defaultMACSettings.ClassBTimeout == 0 {
+		defaultMACSettings.ClassBTimeout = DefaultClassBTimeout
+	}
+	if defaultMACSettings.ClassCTimeout == 0 {
+		defaultMACSettings.ClassCTimeout = DefaultClassCTimeout
+	}
+	txFailureWindow := conf.DownlinkGatewaySelection.TxFailureWindow
+	if txFailureWindow == 0 {
+		txFailureWindow = DefaultTxFailureWindow
+	}
 	ns := &NetworkServer{
-		Component:               c,
-		devices:                 conf.Devices,
-		downlinkTasks:           conf.DownlinkTasks,
-		downlinkPriorities:      downlinkPriorities,
-		applicationServersMu:    &sync.RWMutex{},
-		applicationServers:      make(map[string]*applicationUpStream),
-		metadataAccumulators:    &sync.Map{},
-		metadataAccumulatorPool: &sync.Pool{},
-		hashPool:                &sync.Pool{},
-		macHandlers:             &sync.Map{},
+		Component:                        c,
+		devices:                          conf.Devices,
+		deviceRegistryRetry:              conf.DeviceRegistryRetry.WithDefaults(),
+		NetID:                            netID,
+		downlinkTasks:                    conf.DownlinkTasks,
+		downlinkPriorities:               downlinkPriorities,
+		defaultMACSettings:               defaultMACSettings,
+		macProfiles:                      macProfiles,
+		adrAlgorithms:                    defaultADRAlgorithms(),
+		downlinkGatewaySelectionStrategy: conf.DownlinkGatewaySelection.Strategy,
+		downlinkGatewaySelectors:         defaultDownlinkGatewaySelectors(conf.DownlinkGatewaySelection),
+		txFailures:                       newTxFailureTracker(txFailureWindow),
+		devAddrPrefixes:                  devAddrPrefixes,
+		applicationDevAddrPrefixes:       applicationDevAddrPrefixes,
+		roaming:                          roaming,
+		applicationServersMu:             &sync.RWMutex{},
+		applicationServers:               make(map[string]*applicationUpStream),
+		metadataAccumulators:             &sync.Map{},
+		metadataAccumulatorPool:          &sync.Pool{},
+		hashPool:                         &sync.Pool{},
+		macHandlers:                      &sync.Map{},
 	}
 	ns.hashPool.New = func() interface{} {
 		return fnv.New64a()
@@ -226,6 +324,25 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		return nil, errInvalidConfiguration.WithCause(errors.New("CooldownWindow is zero and WithCollectionDoneFunc not specified"))
 	}
 
+	if ns.defaultMACSettings.ADRAlgorithm == "" {
+		ns.defaultMACSettings.ADRAlgorithm = MarginADRAlgorithm
+	}
+	if _, ok := ns.adrAlgorithms[ns.defaultMACSettings.ADRAlgorithm]; !ok {
+		return nil, errInvalidConfiguration.WithCause(errADRAlgorithmNotFound.WithAttributes("name", ns.defaultMACSettings.ADRAlgorithm))
+	}
+	for _, p := range ns.macProfiles {
+		if _, ok := ns.adrAlgorithms[p.ADRAlgorithm]; p.ADRAlgorithm != "" && !ok {
+			return nil, errInvalidConfiguration.WithCause(errADRAlgorithmNotFound.WithAttributes("name", p.ADRAlgorithm))
+		}
+	}
+
+	if ns.downlinkGatewaySelectionStrategy == "" {
+		ns.downlinkGatewaySelectionStrategy = SignalDownlinkGatewaySelection
+	}
+	if _, ok := ns.downlinkGatewaySelectors[ns.downlinkGatewaySelectionStrategy]; !ok {
+		return nil, errInvalidConfiguration.WithCause(errDownlinkGatewaySelectorNotFound.WithAttributes("name", ns.downlinkGatewaySelectionStrategy))
+	}
+
 	if ns.downlinkTasks == nil {
 		return nil, errInvalidConfiguration.WithCause(errors.New("DownlinkTasks is not specified"))
 	}
@@ -265,6 +382,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.GsNs", cluster.HookName, c.ClusterAuthUnaryHook())
 	hooks.RegisterStreamHook("/ttn.lorawan.v3.AsNs", cluster.HookName, c.ClusterAuthStreamHook())
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsNs", cluster.HookName, c.ClusterAuthUnaryHook())
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsEndDeviceRegistry", cluster.HookName, c.ClusterAuthUnaryHook())
 
 	ns.RegisterTask("process_downlink", func(ctx context.Context) error {
 		for {
@@ -279,11 +397,59 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 			}
 		}
 	}, component.TaskRestartOnFailure)
+	if conf.DeviceReconciliationInterval > 0 {
+		ns.RegisterTask("reconcile_devices", ns.reconcileDevicesTask(conf.DeviceReconciliationInterval), component.TaskRestartOnFailure)
+	}
 
 	c.RegisterGRPC(ns)
+	if ns.roaming != nil {
+		c.RegisterWeb(ns)
+	}
 	return ns, nil
 }
 
+// macProfile returns the MAC profile referenced by dev, or nil if dev does not reference one.
+func (ns *NetworkServer) macProfile(dev *ttnpb.EndDevice) (*MACProfile, error) {
+	name := dev.GetMACSettings().GetProfileID()
+	if name == "" {
+		return nil, nil
+	}
+	p, ok := ns.macProfiles[name]
+	if !ok {
+		return nil, errMACProfileNotFound.WithAttributes("name", name)
+	}
+	return p, nil
+}
+
+// deviceMACProfile returns the MAC profile referenced by dev, or nil if dev does not reference one.
+// If the MAC profile does not exist, for example because it was removed from the configuration, a warning is logged
+// and nil is returned, so that the band and Network Server defaults apply.
+func (ns *NetworkServer) deviceMACProfile(ctx context.Context, dev *ttnpb.EndDevice) *MACProfile {
+	p, err := ns.macProfile(dev)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get MAC profile of device, falling back to defaults")
+		return nil
+	}
+	return p
+}
+
+// adrAlgorithm returns the ADRAlgorithm referenced by the MAC settings of dev, by profile,
+// or the default ADRAlgorithm, in that order.
+func (ns *NetworkServer) adrAlgorithm(dev *ttnpb.EndDevice, profile *MACProfile) (ADRAlgorithm, error) {
+	name := ns.defaultMACSettings.ADRAlgorithm
+	switch {
+	case dev.GetMACSettings().GetADRAlgorithm() != "":
+		name = dev.MACSettings.ADRAlgorithm
+	case profile != nil && profile.ADRAlgorithm != "":
+		name = profile.ADRAlgorithm
+	}
+	alg, ok := ns.adrAlgorithms[name]
+	if !ok {
+		return nil, errADRAlgorithmNotFound.WithAttributes("name", name)
+	}
+	return alg, nil
+}
+
 // RegisterServices registers services provided by ns at s.
 func (ns *NetworkServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterGsNsServer(s, ns)
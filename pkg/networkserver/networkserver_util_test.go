@@ -19,6 +19,7 @@ import (
 	"errors"
 	"time"
 
+	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/mohae/deepcopy"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
@@ -97,6 +98,7 @@ type MockDeviceRegistry struct {
 	GetByEUIFunc    func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error)
 	GetByIDFunc     func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, error)
 	RangeByAddrFunc func(devAddr types.DevAddr, paths []string, f func(*ttnpb.EndDevice) bool) error
+	RangeFunc       func(ctx context.Context, paths []string, f func(*ttnpb.EndDevice) bool) error
 	SetByIDFunc     func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error)
 }
 
@@ -124,6 +126,14 @@ func (r MockDeviceRegistry) RangeByAddr(devAddr types.DevAddr, paths []string, f
 	return r.RangeByAddrFunc(devAddr, paths, f)
 }
 
+// Range calls RangeFunc if set and returns error otherwise.
+func (r MockDeviceRegistry) Range(ctx context.Context, paths []string, f func(*ttnpb.EndDevice) bool) error {
+	if r.RangeFunc == nil {
+		return errors.New("Range not set")
+	}
+	return r.RangeFunc(ctx, paths, f)
+}
+
 // SetByID calls SetByIDFunc if set and returns nil, error otherwise.
 func (r MockDeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
 	if r.SetByIDFunc == nil {
@@ -131,3 +141,42 @@ func (r MockDeviceRegistry) SetByID(ctx context.Context, appID ttnpb.Application
 	}
 	return r.SetByIDFunc(ctx, appID, devID, paths, f)
 }
+
+var _ ttnpb.EndDeviceRegistryServer = MockEndDeviceRegistryServer{}
+
+// MockEndDeviceRegistryServer is a mock ttnpb.EndDeviceRegistryServer used for testing.
+type MockEndDeviceRegistryServer struct {
+	GetFunc  func(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error)
+	ListFunc func(ctx context.Context, req *ttnpb.ListEndDevicesRequest) (*ttnpb.EndDevices, error)
+}
+
+// Create returns nil, error.
+func (m MockEndDeviceRegistryServer) Create(ctx context.Context, req *ttnpb.CreateEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	return nil, errors.New("Create not implemented")
+}
+
+// Get calls GetFunc if set and returns nil, error otherwise.
+func (m MockEndDeviceRegistryServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	if m.GetFunc == nil {
+		return nil, errors.New("Get not set")
+	}
+	return m.GetFunc(ctx, req)
+}
+
+// List calls ListFunc if set and returns nil, error otherwise.
+func (m MockEndDeviceRegistryServer) List(ctx context.Context, req *ttnpb.ListEndDevicesRequest) (*ttnpb.EndDevices, error) {
+	if m.ListFunc == nil {
+		return nil, errors.New("List not set")
+	}
+	return m.ListFunc(ctx, req)
+}
+
+// Update returns nil, error.
+func (m MockEndDeviceRegistryServer) Update(ctx context.Context, req *ttnpb.UpdateEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	return nil, errors.New("Update not implemented")
+}
+
+// Delete returns nil, error.
+func (m MockEndDeviceRegistryServer) Delete(ctx context.Context, req *ttnpb.EndDeviceIdentifiers) (*pbtypes.Empty, error) {
+	return nil, errors.New("Delete not implemented")
+}
@@ -77,6 +77,11 @@ var (
 	evtDropRejoinRequest    = events.Define("ns.up.rejoin.drop", "drop rejoin-request")
 	evtForwardRejoinRequest = events.Define("ns.up.rejoin.forward", "forward rejoin-request")
 
+	evtFailDownlink = events.Define("ns.down.tx.fail", "fail to transmit downlink message")
+
+	evtRetransmitDownlink    = events.Define("ns.down.data.retransmit", "retransmit unacknowledged confirmed downlink message")
+	evtFailConfirmedDownlink = events.Define("ns.down.data.ack_timeout", "confirmed downlink message not acknowledged")
+
 	evtEnqueueProprietaryMACAnswer  = defineEnqueueMACAnswerEvent("proprietary", "proprietary MAC command")
 	evtEnqueueProprietaryMACRequest = defineEnqueueMACRequestEvent("proprietary", "proprietary MAC command")
 	evtReceiveProprietaryMAC        = events.Define("ns.mac.proprietary.receive", "proprietary MAC command received")
@@ -241,3 +246,43 @@ func registerDropRejoinRequest(ctx context.Context, devIDs *ttnpb.EndDeviceIdent
 		nsMetrics.uplinkDropped.WithLabelValues(ctx, uplinkMTypeLabel(msg), unknown).Inc()
 	}
 }
+
+type eventBufferKeyType struct{}
+
+var eventBufferKey eventBufferKeyType
+
+// eventBuffer holds the events published within a device registry transaction, so that the events are only published
+// once the transaction is committed. As transactions may be retried, the buffer is reset on every attempt.
+type eventBuffer struct {
+	evts []events.Event
+}
+
+// newEventBufferContext returns a derived context of ctx, in which publishEvents appends events to the returned buffer.
+func newEventBufferContext(ctx context.Context) (context.Context, *eventBuffer) {
+	buf := &eventBuffer{}
+	return context.WithValue(ctx, eventBufferKey, buf), buf
+}
+
+// Reset discards the buffered events.
+func (b *eventBuffer) Reset() {
+	b.evts = b.evts[:0]
+}
+
+// Publish publishes the buffered events and resets the buffer.
+func (b *eventBuffer) Publish() {
+	for _, evt := range b.evts {
+		events.Publish(evt)
+	}
+	b.Reset()
+}
+
+// publishEvents publishes evts, or appends them to the event buffer if ctx was derived by newEventBufferContext.
+func publishEvents(ctx context.Context, evts ...events.Event) {
+	if buf, ok := ctx.Value(eventBufferKey).(*eventBuffer); ok {
+		buf.evts = append(buf.evts, evts...)
+		return
+	}
+	for _, evt := range evts {
+		events.Publish(evt)
+	}
+}
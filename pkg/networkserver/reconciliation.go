@@ -0,0 +1,147 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// listRegisteredDevices lists the IDs of the devices of the application in the Entity Registry.
+// The listing is only returned if it is complete, so that devices are not considered orphaned
+// because they are missing from a partial listing.
+func (ns *NetworkServer) listRegisteredDevices(ctx context.Context, cl ttnpb.EndDeviceRegistryClient, appID ttnpb.ApplicationIdentifiers) (map[string]bool, error) {
+	var header metadata.MD
+	res, err := cl.List(ctx, &ttnpb.ListEndDevicesRequest{
+		ApplicationIdentifiers: appID,
+		FieldMask:              pbtypes.FieldMask{Paths: []string{"ids"}},
+	}, ns.WithClusterAuth(), grpc.Header(&header))
+	if err != nil {
+		return nil, err
+	}
+	if totalHeader := header.Get("x-total-count"); len(totalHeader) > 0 {
+		total, err := strconv.ParseUint(totalHeader[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		if uint64(len(res.EndDevices)) != total {
+			return nil, errIncompleteListing.WithAttributes("listed", len(res.EndDevices), "total", total)
+		}
+	}
+	registered := make(map[string]bool, len(res.EndDevices))
+	for _, dev := range res.EndDevices {
+		registered[dev.DeviceID] = true
+	}
+	return registered, nil
+}
+
+// requireDeletedFromEntityRegistry returns an error if the device still exists in the Entity Registry.
+func (ns *NetworkServer) requireDeletedFromEntityRegistry(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error {
+	er := ns.GetPeer(ctx, ttnpb.PeerInfo_ENTITY_REGISTRY, nil)
+	if er == nil {
+		return errEntityRegistryNotFound
+	}
+	_, err := ttnpb.NewEndDeviceRegistryClient(er.Conn()).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+		FieldMask:            pbtypes.FieldMask{Paths: []string{"ids"}},
+	}, ns.WithClusterAuth())
+	if err == nil {
+		return errDeviceRegistered.WithAttributes("device_uid", unique.ID(ctx, ids))
+	}
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// deleteOrphanedDevices deletes the devices in the registry that no longer exist in the Entity Registry.
+// Devices are normally deleted by the Identity Server when they are deleted from the Entity Registry,
+// but that may fail, for example when the Network Server is unavailable.
+// Devices are only considered orphaned if they are missing from a complete listing of the devices of the
+// application, and they are only deleted after the Entity Registry confirms that they do not exist.
+func (ns *NetworkServer) deleteOrphanedDevices(ctx context.Context) error {
+	er := ns.GetPeer(ctx, ttnpb.PeerInfo_ENTITY_REGISTRY, nil)
+	if er == nil {
+		return errEntityRegistryNotFound
+	}
+	logger := log.FromContext(ctx)
+
+	devIDsByApp := make(map[ttnpb.ApplicationIdentifiers][]string)
+	err := ns.devices.Range(ctx, []string{"ids"}, func(dev *ttnpb.EndDevice) bool {
+		devIDsByApp[dev.ApplicationIdentifiers] = append(devIDsByApp[dev.ApplicationIdentifiers], dev.DeviceID)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	cl := ttnpb.NewEndDeviceRegistryClient(er.Conn())
+	for appID, devIDs := range devIDsByApp {
+		logger := logger.WithField("application_uid", unique.ID(ctx, appID))
+		registered, err := ns.listRegisteredDevices(ctx, cl, appID)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to list devices in Entity Registry")
+			continue
+		}
+		var orphaned []string
+		for _, devID := range devIDs {
+			if !registered[devID] {
+				orphaned = append(orphaned, devID)
+			}
+		}
+		if len(orphaned) == 0 {
+			continue
+		}
+		logger.WithField("count", len(orphaned)).Info("Found orphaned devices")
+		for _, devID := range orphaned {
+			ids := ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: appID, DeviceID: devID}
+			logger := logger.WithField("device_uid", unique.ID(ctx, ids))
+			if err := ns.requireDeletedFromEntityRegistry(ctx, ids); err != nil {
+				logger.WithError(err).Warn("Failed to confirm that orphaned device is deleted from Entity Registry")
+				continue
+			}
+			if err := DeleteDevice(ctx, ns.devices, appID, devID); err != nil {
+				logger.WithError(err).Warn("Failed to delete orphaned device")
+				continue
+			}
+			logger.Info("Deleted orphaned device")
+		}
+	}
+	return nil
+}
+
+func (ns *NetworkServer) reconcileDevicesTask(interval time.Duration) func(context.Context) error {
+	return func(ctx context.Context) error {
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+			if err := ns.deleteOrphanedDevices(ctx); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to delete orphaned devices")
+			}
+		}
+	}
+}
@@ -16,6 +16,7 @@ package redis
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis"
@@ -30,6 +31,7 @@ import (
 const (
 	addrKey = "addr"
 	euiKey  = "eui"
+	uidKey  = "uid"
 )
 
 var (
@@ -95,6 +97,32 @@ func (r *DeviceRegistry) RangeByAddr(addr types.DevAddr, paths []string, f func(
 	})
 }
 
+// Init adds the devices that were stored before the index of all devices was introduced to that index.
+func (r *DeviceRegistry) Init() error {
+	prefix := r.Redis.Key("")
+	return ttnredis.AddKeysToSet(r.Redis, r.Redis.Key(uidKey), r.Redis.Key("*"), func(k string) (string, bool) {
+		uid := strings.TrimPrefix(k, prefix)
+		if uid == uidKey || strings.HasPrefix(uid, ttnredis.Key(addrKey, "")) || strings.HasPrefix(uid, ttnredis.Key(euiKey, "")) {
+			return "", false
+		}
+		return uid, true
+	})
+}
+
+// Range ranges over all devices.
+func (r *DeviceRegistry) Range(ctx context.Context, paths []string, f func(*ttnpb.EndDevice) bool) error {
+	return ttnredis.FindProtos(r.Redis, r.Redis.Key(uidKey), r.Redis.Key).Range(func() (proto.Message, func() (bool, error)) {
+		pb := &ttnpb.EndDevice{}
+		return pb, func() (bool, error) {
+			pb, err := applyDeviceFieldMask(nil, pb, paths...)
+			if err != nil {
+				return false, err
+			}
+			return f(pb), nil
+		}
+	})
+}
+
 func getDevAddrsAndIDs(pb *ttnpb.EndDevice) (addrs struct{ current, fallback *types.DevAddr }, ids ttnpb.EndDeviceIdentifiers) {
 	if pb == nil {
 		return
@@ -171,6 +199,7 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 		if pb == nil {
 			f = func(p redis.Pipeliner) error {
 				p.Del(k)
+				p.SRem(r.Redis.Key(uidKey), uid)
 				if oldIDs.JoinEUI != nil && oldIDs.DevEUI != nil {
 					p.Del(r.Redis.Key(euiKey, oldIDs.JoinEUI.String(), oldIDs.DevEUI.String()))
 				}
@@ -230,6 +259,7 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID ttnpb.ApplicationIde
 				if _, err := ttnredis.SetProto(p, k, stored, 0); err != nil {
 					return err
 				}
+				p.SAdd(r.Redis.Key(uidKey), uid)
 
 				if oldAddrs.fallback != nil && !equalAddr(oldAddrs.fallback, newAddrs.fallback) && !equalAddr(oldAddrs.fallback, newAddrs.current) {
 					p.SRem(r.Redis.Key(addrKey, oldAddrs.fallback.String()), uid)
@@ -17,6 +17,7 @@ package networkserver
 import (
 	"context"
 
+	"go.thethings.network/lorawan-stack/pkg/retry"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
 )
@@ -26,6 +27,7 @@ type DeviceRegistry interface {
 	GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error)
 	GetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, error)
 	RangeByAddr(devAddr types.DevAddr, paths []string, f func(*ttnpb.EndDevice) bool) error
+	Range(ctx context.Context, paths []string, f func(*ttnpb.EndDevice) bool) error
 	SetByID(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error)
 }
 
@@ -48,3 +50,15 @@ func CreateDevice(ctx context.Context, r DeviceRegistry, dev *ttnpb.EndDevice) (
 	}
 	return dev, nil
 }
+
+// SetDeviceWithRetry calls r.SetByID and retries the transaction according to conf if it is aborted due to
+// a concurrent modification of the device. As f may be called multiple times, it must not have side effects
+// other than modifying the device it is called with.
+func SetDeviceWithRetry(ctx context.Context, r DeviceRegistry, conf retry.Config, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+	var dev *ttnpb.EndDevice
+	err := retry.Transaction(ctx, "ns_device_registry", conf, func() (err error) {
+		dev, err = r.SetByID(ctx, appID, devID, paths, f)
+		return err
+	})
+	return dev, err
+}
@@ -15,6 +15,7 @@
 package networkserver_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -23,8 +24,11 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	. "go.thethings.network/lorawan-stack/pkg/networkserver"
 	"go.thethings.network/lorawan-stack/pkg/networkserver/redis"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/retry"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 )
@@ -168,6 +172,14 @@ func handleRegistryTest(t *testing.T, reg DeviceRegistry) {
 	a.So(err, should.BeNil)
 	a.So(rets, should.HaveSameElementsDiff, []*ttnpb.EndDevice{pb, pbOther})
 
+	rets = nil
+	err = reg.Range(ctx, ttnpb.EndDeviceFieldPathsTopLevel, func(dev *ttnpb.EndDevice) bool {
+		rets = append(rets, dev)
+		return true
+	})
+	a.So(err, should.BeNil)
+	a.So(rets, should.HaveSameElementsDiff, []*ttnpb.EndDevice{pb, pbOther})
+
 	err = DeleteDevice(ctx, reg, pb.EndDeviceIdentifiers.ApplicationIdentifiers, pb.EndDeviceIdentifiers.DeviceID)
 	if !a.So(err, should.BeNil) {
 		t.FailNow()
@@ -233,6 +245,14 @@ func handleRegistryTest(t *testing.T, reg DeviceRegistry) {
 	})
 	a.So(err, should.BeNil)
 	a.So(rets, should.BeNil)
+
+	rets = nil
+	err = reg.Range(ctx, ttnpb.EndDeviceFieldPathsTopLevel, func(dev *ttnpb.EndDevice) bool {
+		rets = append(rets, dev)
+		return true
+	})
+	a.So(err, should.BeNil)
+	a.So(rets, should.BeNil)
 }
 
 func TestRegistries(t *testing.T) {
@@ -280,3 +300,127 @@ func TestRegistries(t *testing.T) {
 		}
 	}
 }
+
+func TestRedisDeviceRegistryInit(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "networkserver_test")
+	defer flush()
+	defer cl.Close()
+
+	reg := &redis.DeviceRegistry{Redis: cl}
+
+	indexed := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			JoinEUI:                &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			DevEUI:                 &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               "test-dev",
+		},
+		Session: &ttnpb.Session{
+			DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0xff},
+		},
+	}
+	_, err := CreateDevice(ctx, reg, indexed)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// Devices stored before the index of all devices was introduced are not in the index.
+	legacy := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               "test-legacy-dev",
+		},
+	}
+	_, err = ttnredis.SetProto(cl, cl.Key(unique.ID(ctx, legacy.EndDeviceIdentifiers)), legacy, 0)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	rangeIDs := func() (ids []ttnpb.EndDeviceIdentifiers) {
+		err := reg.Range(ctx, []string{"ids"}, func(dev *ttnpb.EndDevice) bool {
+			ids = append(ids, dev.EndDeviceIdentifiers)
+			return true
+		})
+		a.So(err, should.BeNil)
+		return ids
+	}
+	a.So(rangeIDs(), should.Resemble, []ttnpb.EndDeviceIdentifiers{indexed.EndDeviceIdentifiers})
+
+	for i := 0; i < 2; i++ {
+		a.So(reg.Init(), should.BeNil)
+		a.So(rangeIDs(), should.HaveSameElementsDeep, []ttnpb.EndDeviceIdentifiers{indexed.EndDeviceIdentifiers, legacy.EndDeviceIdentifiers})
+	}
+}
+
+func TestSetDeviceWithRetry(t *testing.T) {
+	errConflict := errors.DefineAborted("test_conflict", "conflict")
+
+	for _, tc := range []struct {
+		Name           string
+		Errors         []error
+		ErrorAssertion func(error) bool
+		Calls          int
+	}{
+		{
+			Name:   "No conflict",
+			Errors: []error{nil},
+			Calls:  1,
+		},
+		{
+			Name:   "Conflict",
+			Errors: []error{errConflict, errConflict, nil},
+			Calls:  3,
+		},
+		{
+			Name:           "Too many conflicts",
+			Errors:         []error{errConflict, errConflict, errConflict},
+			ErrorAssertion: errors.IsAborted,
+			Calls:          3,
+		},
+		{
+			Name:           "Not found",
+			Errors:         []error{errors.DefineNotFound("test_not_found", "not found")},
+			ErrorAssertion: errors.IsNotFound,
+			Calls:          1,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var calls int
+			reg := &MockDeviceRegistry{
+				SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+					a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"})
+					a.So(devID, should.Equal, "test-dev")
+					a.So(paths, should.Resemble, []string{"session"})
+					err := tc.Errors[calls]
+					calls++
+					if err != nil {
+						return nil, err
+					}
+					dev, _, err := f(&ttnpb.EndDevice{})
+					return dev, err
+				},
+			}
+
+			dev, err := SetDeviceWithRetry(test.Context(), reg, retry.Config{MaxAttempts: 3, Backoff: time.Millisecond},
+				ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}, "test-dev", []string{"session"},
+				func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+					dev.Session = &ttnpb.Session{}
+					return dev, []string{"session"}, nil
+				})
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				a.So(dev, should.BeNil)
+			} else {
+				a.So(err, should.BeNil)
+				a.So(dev, should.Resemble, &ttnpb.EndDevice{Session: &ttnpb.Session{}})
+			}
+			a.So(calls, should.Equal, tc.Calls)
+		})
+	}
+}
@@ -0,0 +1,628 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"net/http"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/labstack/echo"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/web"
+)
+
+// DefaultPassiveRoamingTimeout is the default timeout of requests to roaming peers.
+const DefaultPassiveRoamingTimeout = 5 * time.Second
+
+// The passive roaming messages are a subset of the XmitDataReq and XmitDataAns messages defined by the
+// LoRaWAN Backend Interfaces specification. The passive roaming start procedure (PRStartReq and PRStartAns)
+// and the other roaming procedures are not implemented, so roaming peers must be configured to exchange
+// XmitDataReq messages without a prior PRStartReq, and only with statically configured peers.
+const (
+	roamingProtocolVersion = "1.0"
+
+	roamingXmitDataReq = "XmitDataReq"
+	roamingXmitDataAns = "XmitDataAns"
+
+	roamingResultSuccess        = "Success"
+	roamingResultUnknownDevAddr = "UnknownDevAddr"
+	roamingResultXmitFailed     = "XmitFailed"
+	roamingResultOther          = "Other"
+
+	// roamingNetIDField is the field in the advanced metadata of uplink messages forwarded by a roaming peer,
+	// which contains the NetID of the roaming peer.
+	roamingNetIDField = "roaming_net_id"
+)
+
+// rfRegionBands maps the RFRegion values of the LoRaWAN Backend Interfaces specification to band IDs.
+var rfRegionBands = map[string]string{
+	"EU868":        band.EU_863_870,
+	"US902":        band.US_902_928,
+	"China779":     band.CN_779_787,
+	"EU433":        band.EU_433,
+	"Australia915": band.AU_915_928,
+	"China470":     band.CN_470_510,
+	"AS923":        band.AS_923,
+	"AS923-2":      band.AS_923_2,
+	"AS923-3":      band.AS_923_3,
+	"AS923-4":      band.AS_923_4,
+	"KR920":        band.KR_920_923,
+	"India865":     band.IN_865_867,
+	"RU864":        band.RU_864_870,
+}
+
+// roamingClassModes maps the ClassMode values of the LoRaWAN Backend Interfaces specification to classes.
+var roamingClassModes = map[string]ttnpb.Class{
+	"A": ttnpb.CLASS_A,
+	"B": ttnpb.CLASS_B,
+	"C": ttnpb.CLASS_C,
+}
+
+type roamingHexBytes []byte
+
+// MarshalText implements encoding.TextMarshaler.
+func (b roamingHexBytes) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(b)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *roamingHexBytes) UnmarshalText(data []byte) error {
+	buf, err := hex.DecodeString(string(data))
+	if err != nil {
+		return err
+	}
+	*b = buf
+	return nil
+}
+
+type roamingGWInfo struct {
+	ID        string
+	RSSI      float32         `json:",omitempty"`
+	SNR       float32         `json:",omitempty"`
+	ULToken   roamingHexBytes `json:",omitempty"`
+	DLAllowed bool            `json:",omitempty"`
+}
+
+type roamingULMetaData struct {
+	DevAddr  *types.DevAddr
+	DataRate uint32
+	ULFreq   float64
+	RFRegion string
+	RecvTime time.Time
+	GWCnt    int
+	GWInfo   []roamingGWInfo
+}
+
+type roamingDLMetaData struct {
+	DevAddr        *types.DevAddr `json:",omitempty"`
+	DLFreq1        float64
+	DLFreq2        float64
+	DataRate1      uint32
+	DataRate2      uint32
+	RXDelay1       uint32
+	ClassMode      string
+	HiPriorityFlag bool
+	GWInfo         []roamingGWInfo
+}
+
+type roamingResult struct {
+	ResultCode  string
+	Description string `json:",omitempty"`
+}
+
+type roamingMessage struct {
+	ProtocolVersion string
+	SenderID        string
+	ReceiverID      string
+	TransactionID   uint32
+	MessageType     string
+	PHYPayload      roamingHexBytes    `json:",omitempty"`
+	ULMetaData      *roamingULMetaData `json:",omitempty"`
+	DLMetaData      *roamingDLMetaData `json:",omitempty"`
+	Result          *roamingResult     `json:",omitempty"`
+}
+
+func frequencyToMHz(frequency uint64) float64 {
+	return float64(frequency) / 1e6
+}
+
+func frequencyFromMHz(mhz float64) uint64 {
+	return uint64(math.Round(mhz * 1e6))
+}
+
+type roamingPeer struct {
+	netID         types.NetID
+	prefix        types.DevAddrPrefix
+	url           string
+	authorization string
+}
+
+// passiveRoaming holds the passive roaming configuration of the Network Server.
+type passiveRoaming struct {
+	band     band.Band
+	rfRegion string
+	client   *http.Client
+	peers    []*roamingPeer
+}
+
+// newPassiveRoaming returns the passive roaming configuration, or nil if no roaming peers are configured.
+func newPassiveRoaming(conf PassiveRoamingConfig) (*passiveRoaming, error) {
+	if len(conf.Peers) == 0 {
+		return nil, nil
+	}
+	b, err := band.GetByID(conf.BandID)
+	if err != nil {
+		return nil, err
+	}
+	var rfRegion string
+	for region, id := range rfRegionBands {
+		if id == b.ID {
+			rfRegion = region
+			break
+		}
+	}
+	if rfRegion == "" {
+		return nil, errUnknownRFRegion.WithAttributes("rf_region", b.ID)
+	}
+	timeout := conf.Timeout
+	if timeout == 0 {
+		timeout = DefaultPassiveRoamingTimeout
+	}
+	peers := make([]*roamingPeer, 0, len(conf.Peers))
+	for _, p := range conf.Peers {
+		var netID types.NetID
+		if err := netID.UnmarshalText([]byte(p.NetID)); err != nil {
+			return nil, errInvalidRoamingPeer.WithAttributes("net_id", p.NetID).WithCause(err)
+		}
+		if p.URL == "" {
+			return nil, errInvalidRoamingPeer.WithAttributes("net_id", p.NetID).WithCause(errors.New("URL is empty"))
+		}
+		if p.Authorization == "" {
+			return nil, errInvalidRoamingPeer.WithAttributes("net_id", p.NetID).WithCause(errors.New("authorization is empty"))
+		}
+		prefix, err := netIDPrefix(netID)
+		if err != nil {
+			return nil, errInvalidRoamingPeer.WithAttributes("net_id", p.NetID).WithCause(err)
+		}
+		for _, other := range peers {
+			if other.netID.Equal(netID) {
+				return nil, errInvalidRoamingPeer.WithAttributes("net_id", p.NetID).WithCause(errors.New("NetID is not unique"))
+			}
+		}
+		peers = append(peers, &roamingPeer{
+			netID:         netID,
+			prefix:        prefix,
+			url:           p.URL,
+			authorization: p.Authorization,
+		})
+	}
+	return &passiveRoaming{
+		band:     b,
+		rfRegion: rfRegion,
+		client:   &http.Client{Timeout: timeout},
+		peers:    peers,
+	}, nil
+}
+
+// roamingPeerByNetID returns the roaming peer identified by netID, or nil if no such roaming peer is configured.
+func (ns *NetworkServer) roamingPeerByNetID(netID types.NetID) *roamingPeer {
+	if ns.roaming == nil {
+		return nil
+	}
+	for _, p := range ns.roaming.peers {
+		if p.netID.Equal(netID) {
+			return p
+		}
+	}
+	return nil
+}
+
+// roamingPeerByDevAddr returns the roaming peer, to which uplink messages from devAddr are forwarded,
+// or nil if devAddr belongs to this network or no roaming peer is configured for it.
+func (ns *NetworkServer) roamingPeerByDevAddr(devAddr types.DevAddr) *roamingPeer {
+	if ns.roaming == nil || ns.ownsNetworkDevAddr(devAddr) {
+		return nil
+	}
+	for _, p := range ns.roaming.peers {
+		if devAddr.HasPrefix(p.prefix) {
+			return p
+		}
+	}
+	return nil
+}
+
+// ownsNetworkDevAddr returns whether devAddr is within the DevAddr prefix of the NetID
+// or any of the configured DevAddr prefixes of ns.
+func (ns *NetworkServer) ownsNetworkDevAddr(devAddr types.DevAddr) bool {
+	if prefix, err := netIDPrefix(ns.NetID); err == nil && devAddr.HasPrefix(prefix) {
+		return true
+	}
+	for _, prefix := range ns.devAddrPrefixes {
+		if devAddr.HasPrefix(prefix) {
+			return true
+		}
+	}
+	for _, prefixes := range ns.applicationDevAddrPrefixes {
+		for _, prefix := range prefixes {
+			if devAddr.HasPrefix(prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// roamingNetID returns the NetID of the roaming peer, which forwarded the uplink message received with md,
+// or nil if the uplink message was received by a gateway of this network.
+func roamingNetID(md *ttnpb.RxMetadata) *types.NetID {
+	v, ok := md.Advanced.GetFields()[roamingNetIDField]
+	if !ok {
+		return nil
+	}
+	var netID types.NetID
+	if err := netID.UnmarshalText([]byte(v.GetStringValue())); err != nil {
+		return nil
+	}
+	return &netID
+}
+
+// roamingRequest sends req to the roaming peer and returns the answer.
+// roamingRequest returns an error if the roaming peer does not answer with a successful result.
+func (ns *NetworkServer) roamingRequest(ctx context.Context, peer *roamingPeer, req *roamingMessage) (*roamingMessage, error) {
+	req.ProtocolVersion = roamingProtocolVersion
+	req.SenderID = ns.NetID.String()
+	req.ReceiverID = peer.netID.String()
+	req.TransactionID = uint32(random.Intn(math.MaxInt32))
+	req.MessageType = roamingXmitDataReq
+
+	b, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequest(http.MethodPost, peer.url, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	httpReq = httpReq.WithContext(ctx)
+	httpReq.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	httpReq.Header.Set(echo.HeaderAuthorization, peer.authorization)
+
+	res, err := ns.roaming.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, errRoamingRequest.WithAttributes(
+			"net_id", peer.netID,
+			"code", res.StatusCode,
+		)
+	}
+	ans := &roamingMessage{}
+	if err := json.NewDecoder(res.Body).Decode(ans); err != nil {
+		return nil, errInvalidRoamingMessage.WithCause(err)
+	}
+	if ans.Result == nil {
+		return nil, errInvalidRoamingMessage.WithCause(errors.New("no result"))
+	}
+	if ans.Result.ResultCode != roamingResultSuccess {
+		return nil, errRoamingResult.WithAttributes(
+			"net_id", peer.netID,
+			"result_code", ans.Result.ResultCode,
+			"description", ans.Result.Description,
+		)
+	}
+	return ans, nil
+}
+
+// forwardUplink forwards the data uplink message up, with the metadata accumulated during deduplication,
+// to the roaming peer. forwardUplink is called by the Network Server in the serving role.
+func (ns *NetworkServer) forwardUplink(ctx context.Context, peer *roamingPeer, up *ttnpb.UplinkMessage, acc *metadataAccumulator) error {
+	logger := log.FromContext(ctx).WithField("net_id", peer.netID)
+
+	drIdx := -1
+	for i, dr := range ns.roaming.band.DataRates {
+		if dr.Rate.Equal(up.Settings.DataRate) {
+			drIdx = i
+			break
+		}
+	}
+	if drIdx < 0 {
+		err := errDataRateNotFound.WithAttributes("data_rate", up.Settings.DataRate)
+		registerDropDataUplink(ctx, nil, up, err)
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ns.deduplicationDone(ctx, up):
+	}
+
+	mds := acc.Accumulated()
+	gwInfo := make([]roamingGWInfo, 0, len(mds))
+	for _, md := range mds {
+		gwInfo = append(gwInfo, roamingGWInfo{
+			ID:        md.GatewayID,
+			RSSI:      md.RSSI,
+			SNR:       md.SNR,
+			ULToken:   md.UplinkToken,
+			DLAllowed: len(md.UplinkToken) > 0 && md.DownlinkPathConstraint != ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER,
+		})
+	}
+	devAddr := up.Payload.GetMACPayload().DevAddr
+	logger.WithField("metadata_count", len(gwInfo)).Debug("Forward data uplink to roaming peer...")
+	if _, err := ns.roamingRequest(ctx, peer, &roamingMessage{
+		PHYPayload: up.RawPayload,
+		ULMetaData: &roamingULMetaData{
+			DevAddr:  &devAddr,
+			DataRate: uint32(drIdx),
+			ULFreq:   frequencyToMHz(up.Settings.Frequency),
+			RFRegion: ns.roaming.rfRegion,
+			RecvTime: up.ReceivedAt,
+			GWCnt:    len(gwInfo),
+			GWInfo:   gwInfo,
+		},
+	}); err != nil {
+		logger.WithError(err).Warn("Failed to forward data uplink to roaming peer")
+		registerDropDataUplink(ctx, nil, up, err)
+		return err
+	}
+	return nil
+}
+
+// scheduleRoamingDownlink sends the downlink message down to the roaming peer, which schedules it on its gateways
+// using paths. scheduleRoamingDownlink is called by the Network Server in the home role.
+func (ns *NetworkServer) scheduleRoamingDownlink(ctx context.Context, peer *roamingPeer, down *ttnpb.DownlinkMessage, paths []downlinkPath) error {
+	req := down.GetRequest()
+	gwInfo := make([]roamingGWInfo, 0, len(paths))
+	for _, path := range paths {
+		gwInfo = append(gwInfo, roamingGWInfo{
+			ID:      path.GatewayID,
+			ULToken: path.GetUplinkToken(),
+		})
+	}
+	var classMode string
+	for mode, class := range roamingClassModes {
+		if class == req.Class {
+			classMode = mode
+			break
+		}
+	}
+	_, err := ns.roamingRequest(ctx, peer, &roamingMessage{
+		PHYPayload: down.RawPayload,
+		DLMetaData: &roamingDLMetaData{
+			DevAddr:        down.EndDeviceIDs.DevAddr,
+			DLFreq1:        frequencyToMHz(req.Rx1Frequency),
+			DLFreq2:        frequencyToMHz(req.Rx2Frequency),
+			DataRate1:      uint32(req.Rx1DataRateIndex),
+			DataRate2:      uint32(req.Rx2DataRateIndex),
+			RXDelay1:       uint32(req.Rx1Delay),
+			ClassMode:      classMode,
+			HiPriorityFlag: req.Priority >= ttnpb.TxSchedulePriority_HIGH,
+			GWInfo:         gwInfo,
+		},
+	})
+	return err
+}
+
+// RegisterRoutes registers the passive roaming endpoint, to which roaming peers send XmitDataReq messages.
+func (ns *NetworkServer) RegisterRoutes(server *web.Server) {
+	group := server.Group(ttnpb.HTTPAPIPrefix + "/ns")
+	group.POST("/roaming", ns.handleRoaming)
+}
+
+func (ns *NetworkServer) handleRoaming(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req := &roamingMessage{}
+	if err := json.NewDecoder(c.Request().Body).Decode(req); err != nil {
+		return errInvalidRoamingMessage.WithCause(err)
+	}
+	var senderID, receiverID types.NetID
+	if err := senderID.UnmarshalText([]byte(req.SenderID)); err != nil {
+		return errInvalidRoamingMessage.WithCause(err)
+	}
+	if err := receiverID.UnmarshalText([]byte(req.ReceiverID)); err != nil {
+		return errInvalidRoamingMessage.WithCause(err)
+	}
+	peer := ns.roamingPeerByNetID(senderID)
+	if peer == nil {
+		return errRoamingPeerNotFound.WithAttributes("net_id", senderID)
+	}
+	if subtle.ConstantTimeCompare([]byte(c.Request().Header.Get(echo.HeaderAuthorization)), []byte(peer.authorization)) != 1 {
+		return errRoamingUnauthenticated.WithAttributes("net_id", senderID)
+	}
+	if !receiverID.Equal(ns.NetID) || req.MessageType != roamingXmitDataReq {
+		return errInvalidRoamingMessage
+	}
+
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"net_id", senderID,
+		"transaction_id", req.TransactionID,
+	))
+	ctx = log.NewContext(ctx, logger)
+
+	var err error
+	switch {
+	case req.ULMetaData != nil:
+		logger.Debug("Handle data uplink from roaming peer...")
+		err = ns.handleRoamingUplink(ctx, peer, req)
+	case req.DLMetaData != nil:
+		logger.Debug("Handle downlink from roaming peer...")
+		err = ns.handleRoamingDownlink(ctx, req)
+	default:
+		return errInvalidRoamingMessage.WithCause(errors.New("no metadata"))
+	}
+
+	ans := &roamingMessage{
+		ProtocolVersion: roamingProtocolVersion,
+		SenderID:        ns.NetID.String(),
+		ReceiverID:      req.SenderID,
+		TransactionID:   req.TransactionID,
+		MessageType:     roamingXmitDataAns,
+		Result: &roamingResult{
+			ResultCode: roamingResultSuccess,
+		},
+	}
+	if err != nil {
+		logger.WithError(err).Debug("Failed to handle message from roaming peer")
+		ans.Result = &roamingResult{
+			ResultCode:  roamingResultCode(err),
+			Description: err.Error(),
+		}
+	}
+	return c.JSON(http.StatusOK, ans)
+}
+
+func roamingResultCode(err error) string {
+	switch {
+	case errors.Resemble(err, errDevAddrNotOwned), errors.Resemble(err, errDeviceNotFound):
+		return roamingResultUnknownDevAddr
+	case errors.Resemble(err, errSchedule), errors.Resemble(err, errNoPath):
+		return roamingResultXmitFailed
+	default:
+		return roamingResultOther
+	}
+}
+
+// handleRoamingUplink handles the data uplink message in req, forwarded by the roaming peer, in the home role.
+// The uplink message is deduplicated with the uplink messages received by the gateways of this network.
+func (ns *NetworkServer) handleRoamingUplink(ctx context.Context, peer *roamingPeer, req *roamingMessage) error {
+	ul := req.ULMetaData
+
+	pld := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(req.PHYPayload, pld); err != nil {
+		return errDecodePayload.WithCause(err)
+	}
+	macPld := pld.GetMACPayload()
+	if macPld == nil {
+		return errInvalidPayload
+	}
+	// Uplink messages of DevAddrs of other networks are not handled, so that they are never forwarded again.
+	if !ns.ownsNetworkDevAddr(macPld.DevAddr) {
+		return errDevAddrNotOwned.WithAttributes("dev_addr", macPld.DevAddr)
+	}
+
+	bandID, ok := rfRegionBands[ul.RFRegion]
+	if !ok {
+		return errUnknownRFRegion.WithAttributes("rf_region", ul.RFRegion)
+	}
+	b, err := band.GetByID(bandID)
+	if err != nil {
+		return errUnknownBand.WithCause(err)
+	}
+	if ul.DataRate >= uint32(len(b.DataRates)) || b.DataRates[ul.DataRate].Rate.GetModulation() == nil {
+		return errInvalidDataRate
+	}
+
+	advanced := &pbtypes.Struct{
+		Fields: map[string]*pbtypes.Value{
+			roamingNetIDField: {
+				Kind: &pbtypes.Value_StringValue{StringValue: peer.netID.String()},
+			},
+		},
+	}
+	mds := make([]*ttnpb.RxMetadata, 0, len(ul.GWInfo))
+	for _, gw := range ul.GWInfo {
+		md := &ttnpb.RxMetadata{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+				GatewayID: gw.ID,
+			},
+			RSSI:        gw.RSSI,
+			SNR:         gw.SNR,
+			UplinkToken: gw.ULToken,
+			Advanced:    advanced,
+		}
+		if !gw.DLAllowed {
+			md.DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER
+		}
+		mds = append(mds, md)
+	}
+
+	// The uplink message is received at the time it was received by the roaming peer, so that the receive windows
+	// are computed correctly. Receive times in the future are not trusted.
+	receivedAt := time.Now().UTC()
+	if !ul.RecvTime.IsZero() && ul.RecvTime.Before(receivedAt) {
+		receivedAt = ul.RecvTime.UTC()
+	}
+	_, err = ns.handleUplinkMessage(ctx, &ttnpb.UplinkMessage{
+		RawPayload: req.PHYPayload,
+		Payload:    pld,
+		Settings: ttnpb.TxSettings{
+			DataRate:      b.DataRates[ul.DataRate].Rate,
+			DataRateIndex: ttnpb.DataRateIndex(ul.DataRate),
+			Frequency:     frequencyFromMHz(ul.ULFreq),
+		},
+		RxMetadata: mds,
+		ReceivedAt: receivedAt,
+	})
+	return err
+}
+
+// handleRoamingDownlink schedules the downlink message in req, sent by the roaming peer, on the gateways of this
+// network in the serving role.
+func (ns *NetworkServer) handleRoamingDownlink(ctx context.Context, req *roamingMessage) error {
+	dl := req.DLMetaData
+
+	class, ok := roamingClassModes[dl.ClassMode]
+	if !ok {
+		return errInvalidRoamingMessage.WithCause(errors.New("unknown class mode"))
+	}
+	paths := make([]downlinkPath, 0, len(dl.GWInfo))
+	for _, gw := range dl.GWInfo {
+		if len(gw.ULToken) == 0 {
+			continue
+		}
+		paths = append(paths, downlinkPath{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+				GatewayID: gw.ID,
+			},
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: gw.ULToken,
+				},
+			},
+		})
+	}
+	priority := ttnpb.TxSchedulePriority_NORMAL
+	if dl.HiPriorityFlag {
+		priority = ttnpb.TxSchedulePriority_HIGH
+	}
+	// Downlink messages of roaming peers are not stored, so only the DevAddr identifies the end device.
+	_, err := ns.scheduleDownlinkByPaths(ctx, &ttnpb.TxRequest{
+		Class:            class,
+		Rx1Delay:         ttnpb.RxDelay(dl.RXDelay1),
+		Rx1DataRateIndex: ttnpb.DataRateIndex(dl.DataRate1),
+		Rx1Frequency:     frequencyFromMHz(dl.DLFreq1),
+		Rx2DataRateIndex: ttnpb.DataRateIndex(dl.DataRate2),
+		Rx2Frequency:     frequencyFromMHz(dl.DLFreq2),
+		Priority:         priority,
+	}, ttnpb.EndDeviceIdentifiers{DevAddr: dl.DevAddr}, req.PHYPayload, paths...)
+	return err
+}
@@ -0,0 +1,349 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/labstack/echo"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestNewPassiveRoaming(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		Config         PassiveRoamingConfig
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "NoPeers",
+		},
+		{
+			Name: "Valid",
+			Config: PassiveRoamingConfig{
+				BandID: band.EU_863_870,
+				Peers: []PassiveRoamingPeer{
+					{NetID: "000001", URL: "http://localhost/roaming", Authorization: "secret"},
+				},
+			},
+		},
+		{
+			Name: "UnknownBand",
+			Config: PassiveRoamingConfig{
+				BandID: "unknown",
+				Peers: []PassiveRoamingPeer{
+					{NetID: "000001", URL: "http://localhost/roaming", Authorization: "secret"},
+				},
+			},
+			ErrorAssertion: errors.IsNotFound,
+		},
+		{
+			Name: "InvalidNetID",
+			Config: PassiveRoamingConfig{
+				BandID: band.EU_863_870,
+				Peers: []PassiveRoamingPeer{
+					{NetID: "0001", URL: "http://localhost/roaming", Authorization: "secret"},
+				},
+			},
+			ErrorAssertion: func(err error) bool { return errors.Resemble(err, errInvalidRoamingPeer) },
+		},
+		{
+			Name: "NoURL",
+			Config: PassiveRoamingConfig{
+				BandID: band.EU_863_870,
+				Peers: []PassiveRoamingPeer{
+					{NetID: "000001", Authorization: "secret"},
+				},
+			},
+			ErrorAssertion: func(err error) bool { return errors.Resemble(err, errInvalidRoamingPeer) },
+		},
+		{
+			Name: "NoAuthorization",
+			Config: PassiveRoamingConfig{
+				BandID: band.EU_863_870,
+				Peers: []PassiveRoamingPeer{
+					{NetID: "000001", URL: "http://localhost/roaming"},
+				},
+			},
+			ErrorAssertion: func(err error) bool { return errors.Resemble(err, errInvalidRoamingPeer) },
+		},
+		{
+			Name: "DuplicateNetID",
+			Config: PassiveRoamingConfig{
+				BandID: band.EU_863_870,
+				Peers: []PassiveRoamingPeer{
+					{NetID: "000001", URL: "http://localhost/roaming", Authorization: "secret"},
+					{NetID: "000001", URL: "http://localhost/other", Authorization: "secret"},
+				},
+			},
+			ErrorAssertion: func(err error) bool { return errors.Resemble(err, errInvalidRoamingPeer) },
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			roaming, err := newPassiveRoaming(tc.Config)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			if len(tc.Config.Peers) == 0 {
+				a.So(roaming, should.BeNil)
+				return
+			}
+			a.So(roaming.peers, should.HaveLength, len(tc.Config.Peers))
+			a.So(roaming.rfRegion, should.Equal, "EU868")
+			a.So(roaming.client.Timeout, should.Equal, DefaultPassiveRoamingTimeout)
+		})
+	}
+}
+
+func newRoamingTestNetworkServer(t *testing.T, url string) *NetworkServer {
+	roaming, err := newPassiveRoaming(PassiveRoamingConfig{
+		BandID: band.EU_863_870,
+		Peers: []PassiveRoamingPeer{
+			{NetID: "000001", URL: url, Authorization: "secret"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to configure passive roaming: %s", err)
+	}
+	return &NetworkServer{
+		NetID:   types.NetID{0x00, 0x00, 0x13},
+		roaming: roaming,
+	}
+}
+
+func TestRoamingPeerByDevAddr(t *testing.T) {
+	a := assertions.New(t)
+
+	ns := newRoamingTestNetworkServer(t, "http://localhost/roaming")
+	ns.devAddrPrefixes = []types.DevAddrPrefix{
+		{DevAddr: types.DevAddr{0x02, 0xff, 0x00, 0x00}, Length: 16},
+	}
+
+	a.So(ns.roamingPeerByDevAddr(types.DevAddr{0x26, 0x01, 0x02, 0x03}), should.BeNil)
+	a.So(ns.roamingPeerByDevAddr(types.DevAddr{0x02, 0xff, 0x02, 0x03}), should.BeNil)
+	a.So(ns.roamingPeerByDevAddr(types.DevAddr{0x04, 0x01, 0x02, 0x03}), should.BeNil)
+	if peer := ns.roamingPeerByDevAddr(types.DevAddr{0x02, 0x01, 0x02, 0x03}); a.So(peer, should.NotBeNil) {
+		a.So(peer.netID, should.Equal, types.NetID{0x00, 0x00, 0x01})
+	}
+}
+
+func TestScheduleRoamingDownlink(t *testing.T) {
+	a := assertions.New(t)
+
+	reqCh := make(chan *roamingMessage, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(echo.HeaderAuthorization) != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		req := &roamingMessage{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		reqCh <- req
+		json.NewEncoder(w).Encode(&roamingMessage{
+			ProtocolVersion: roamingProtocolVersion,
+			SenderID:        req.ReceiverID,
+			ReceiverID:      req.SenderID,
+			TransactionID:   req.TransactionID,
+			MessageType:     roamingXmitDataAns,
+			Result: &roamingResult{
+				ResultCode: roamingResultSuccess,
+			},
+		})
+	}))
+	defer srv.Close()
+
+	ns := newRoamingTestNetworkServer(t, srv.URL)
+	devAddr := types.DevAddr{0x26, 0x01, 0x02, 0x03}
+
+	md := &ttnpb.RxMetadata{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "roaming-gtw"},
+		UplinkToken:        []byte{0x01, 0x02},
+		Advanced: &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				roamingNetIDField: {Kind: &pbtypes.Value_StringValue{StringValue: "000001"}},
+			},
+		},
+	}
+	paths := []downlinkPath{
+		{
+			GatewayIdentifiers: md.GatewayIdentifiers,
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: md.UplinkToken},
+			},
+			roamingNetID: roamingNetID(md),
+		},
+	}
+	a.So(paths[0].roamingNetID, should.Resemble, &types.NetID{0x00, 0x00, 0x01})
+
+	down, err := ns.scheduleDownlinkByPaths(context.Background(), &ttnpb.TxRequest{
+		Class:            ttnpb.CLASS_A,
+		Rx1Delay:         ttnpb.RX_DELAY_1,
+		Rx1DataRateIndex: ttnpb.DATA_RATE_5,
+		Rx1Frequency:     868100000,
+		Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+		Rx2Frequency:     869525000,
+		Priority:         ttnpb.TxSchedulePriority_HIGHEST,
+	}, ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+		DeviceID:               "test-dev",
+		DevAddr:                &devAddr,
+	}, []byte{0x60, 0x01, 0x02}, paths...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(down.RawPayload, should.Resemble, []byte{0x60, 0x01, 0x02})
+
+	select {
+	case req := <-reqCh:
+		a.So(req.SenderID, should.Equal, "000013")
+		a.So(req.ReceiverID, should.Equal, "000001")
+		a.So(req.MessageType, should.Equal, roamingXmitDataReq)
+		a.So([]byte(req.PHYPayload), should.Resemble, []byte{0x60, 0x01, 0x02})
+		a.So(req.DLMetaData, should.Resemble, &roamingDLMetaData{
+			DevAddr:        &devAddr,
+			DLFreq1:        868.1,
+			DLFreq2:        869.525,
+			DataRate1:      5,
+			DataRate2:      0,
+			RXDelay1:       1,
+			ClassMode:      "A",
+			HiPriorityFlag: true,
+			GWInfo: []roamingGWInfo{
+				{ID: "roaming-gtw", ULToken: []byte{0x01, 0x02}},
+			},
+		})
+	case <-time.After(Timeout):
+		t.Fatal("Timed out while waiting for XmitDataReq")
+	}
+}
+
+func TestHandleRoaming(t *testing.T) {
+	ns := newRoamingTestNetworkServer(t, "http://localhost/roaming")
+	uplink := func(devAddr types.DevAddr) *roamingMessage {
+		return &roamingMessage{
+			ProtocolVersion: roamingProtocolVersion,
+			SenderID:        "000001",
+			ReceiverID:      "000013",
+			TransactionID:   42,
+			MessageType:     roamingXmitDataReq,
+			PHYPayload:      []byte{0x40, devAddr[3], devAddr[2], devAddr[1], devAddr[0], 0x00, 0x01, 0x00, 0x01, 0x02, 0x03, 0x04},
+			ULMetaData: &roamingULMetaData{
+				DevAddr:  &devAddr,
+				DataRate: 5,
+				ULFreq:   868.1,
+				RFRegion: "EU868",
+				GWCnt:    1,
+				GWInfo: []roamingGWInfo{
+					{ID: "roaming-gtw", RSSI: -42, SNR: 5, ULToken: []byte{0x01}, DLAllowed: true},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Authorization  string
+		Request        *roamingMessage
+		ErrorAssertion func(error) bool
+		ResultCode     string
+	}{
+		{
+			Name:           "Unauthenticated",
+			Authorization:  "wrong",
+			Request:        uplink(types.DevAddr{0x26, 0x01, 0x02, 0x03}),
+			ErrorAssertion: func(err error) bool { return errors.Resemble(err, errRoamingUnauthenticated) },
+		},
+		{
+			Name:           "NoAuthorization",
+			Request:        uplink(types.DevAddr{0x26, 0x01, 0x02, 0x03}),
+			ErrorAssertion: func(err error) bool { return errors.Resemble(err, errRoamingUnauthenticated) },
+		},
+		{
+			Name:          "UnknownSender",
+			Authorization: "secret",
+			Request: func() *roamingMessage {
+				msg := uplink(types.DevAddr{0x26, 0x01, 0x02, 0x03})
+				msg.SenderID = "000002"
+				return msg
+			}(),
+			ErrorAssertion: func(err error) bool { return errors.Resemble(err, errRoamingPeerNotFound) },
+		},
+		{
+			Name:          "WrongReceiver",
+			Authorization: "secret",
+			Request: func() *roamingMessage {
+				msg := uplink(types.DevAddr{0x26, 0x01, 0x02, 0x03})
+				msg.ReceiverID = "000002"
+				return msg
+			}(),
+			ErrorAssertion: func(err error) bool { return errors.Resemble(err, errInvalidRoamingMessage) },
+		},
+		{
+			Name:          "ForeignDevAddr",
+			Authorization: "secret",
+			Request:       uplink(types.DevAddr{0x02, 0x01, 0x02, 0x03}),
+			ResultCode:    roamingResultUnknownDevAddr,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			b, err := json.Marshal(tc.Request)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(b))
+			req.Header.Set(echo.HeaderAuthorization, tc.Authorization)
+			rec := httptest.NewRecorder()
+
+			err = ns.handleRoaming(echo.New().NewContext(req, rec))
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			ans := &roamingMessage{}
+			if !a.So(json.NewDecoder(rec.Body).Decode(ans), should.BeNil) {
+				t.FailNow()
+			}
+			a.So(ans.MessageType, should.Equal, roamingXmitDataAns)
+			a.So(ans.TransactionID, should.Equal, tc.Request.TransactionID)
+			a.So(ans.SenderID, should.Equal, "000013")
+			a.So(ans.ReceiverID, should.Equal, "000001")
+			a.So(ans.Result.ResultCode, should.Equal, tc.ResultCode)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// uplinkPacketErrorRate returns the fraction of uplinks lost in the current frame counter sequence of ups.
+// The sequence starts after the last join-request or frame counter reset in ups.
+func uplinkPacketErrorRate(ups []*ttnpb.UplinkMessage) float32 {
+	start := len(ups)
+	for i := len(ups) - 1; i >= 0; i-- {
+		pld := ups[i].Payload.GetMACPayload()
+		if pld == nil {
+			break
+		}
+		if i < len(ups)-1 && pld.FHDR.FCnt > ups[i+1].Payload.GetMACPayload().FHDR.FCnt {
+			break
+		}
+		start = i
+	}
+	return adrLossRate(ups[start:])
+}
+
+// uplinkSignalAverages returns the average of the best SNR and RSSI of each uplink in ups.
+func uplinkSignalAverages(ups []*ttnpb.UplinkMessage) (snr, rssi float32) {
+	var n int
+	for _, up := range ups {
+		if len(up.RxMetadata) == 0 {
+			continue
+		}
+		maxSNR, maxRSSI := up.RxMetadata[0].SNR, up.RxMetadata[0].RSSI
+		for _, md := range up.RxMetadata[1:] {
+			if md.SNR > maxSNR {
+				maxSNR = md.SNR
+			}
+			if md.RSSI > maxRSSI {
+				maxRSSI = md.RSSI
+			}
+		}
+		snr += maxSNR
+		rssi += maxRSSI
+		n++
+	}
+	if n == 0 {
+		return 0, 0
+	}
+	return snr / float32(n), rssi / float32(n)
+}
+
+// updateUplinkStats updates the traffic statistics of dev after reception of up.
+// up must already be appended to dev.RecentUplinks.
+func updateUplinkStats(dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage) {
+	if dev.Stats == nil {
+		dev.Stats = &ttnpb.EndDeviceStats{}
+	}
+	receivedAt := up.ReceivedAt
+	dev.Stats.LastUplinkReceivedAt = &receivedAt
+	dev.Stats.UplinkCount++
+	dev.Stats.PacketErrorRate = uplinkPacketErrorRate(dev.RecentUplinks)
+	dev.Stats.AverageSNR, dev.Stats.AverageRSSI = uplinkSignalAverages(dev.RecentUplinks)
+}
+
+// updateDevStatusStats records the device status of dev, as received in the last DevStatus MAC command.
+func updateDevStatusStats(dev *ttnpb.EndDevice) {
+	if dev.Stats == nil {
+		dev.Stats = &ttnpb.EndDeviceStats{}
+	}
+	dev.Stats.LastDevStatusReceivedAt = dev.LastDevStatusReceivedAt
+	dev.Stats.BatteryPercentage = dev.BatteryPercentage
+	dev.Stats.DownlinkMargin = dev.DownlinkMargin
+}
+
+// updateADRStats records the desired ADR parameters of dev as the last ADR decision made at t.
+func updateADRStats(dev *ttnpb.EndDevice, t time.Time) {
+	if dev.Stats == nil {
+		dev.Stats = &ttnpb.EndDeviceStats{}
+	}
+	dev.Stats.LastADRDecidedAt = &t
+	dev.Stats.LastADRDataRateIndex = dev.MACState.DesiredParameters.ADRDataRateIndex
+	dev.Stats.LastADRTxPowerIndex = dev.MACState.DesiredParameters.ADRTxPowerIndex
+	dev.Stats.LastADRNbTrans = dev.MACState.DesiredParameters.ADRNbTrans
+}
+
+// appendRecentDownlink appends down to the recent downlinks of dev scheduled at t and updates the traffic statistics of dev.
+func appendRecentDownlink(dev *ttnpb.EndDevice, down *ttnpb.DownlinkMessage, t time.Time) {
+	dev.RecentDownlinks = append(dev.RecentDownlinks, down)
+	if len(dev.RecentDownlinks) > recentDownlinkCount {
+		dev.RecentDownlinks = append(dev.RecentDownlinks[:0], dev.RecentDownlinks[len(dev.RecentDownlinks)-recentDownlinkCount:]...)
+	}
+	if dev.Stats == nil {
+		dev.Stats = &ttnpb.EndDeviceStats{}
+	}
+	dev.Stats.LastDownlinkScheduledAt = &t
+	dev.Stats.DownlinkCount++
+}
@@ -0,0 +1,133 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestUplinkPacketErrorRate(t *testing.T) {
+	joinRequest := &ttnpb.UplinkMessage{
+		Payload: &ttnpb.Message{
+			MHDR: ttnpb.MHDR{
+				MType: ttnpb.MType_JOIN_REQUEST,
+			},
+			Payload: &ttnpb.Message_JoinRequestPayload{
+				JoinRequestPayload: &ttnpb.JoinRequestPayload{},
+			},
+		},
+	}
+	uplinks := func(fCnts ...uint32) []*ttnpb.UplinkMessage {
+		m := make([]adrMatrixRow, 0, len(fCnts))
+		for _, fCnt := range fCnts {
+			m = append(m, adrMatrixRow{FCnt: fCnt, MaxSNR: -5, GtwDiversity: 1})
+		}
+		return adrMatrixToUplinks(m)
+	}
+
+	for _, tc := range []struct {
+		Name            string
+		Uplinks         []*ttnpb.UplinkMessage
+		PacketErrorRate float32
+	}{
+		{
+			Name:            "no uplinks",
+			PacketErrorRate: 0,
+		},
+		{
+			Name:            "no loss",
+			Uplinks:         uplinks(1, 2, 2, 3),
+			PacketErrorRate: 0,
+		},
+		{
+			Name:            "gaps",
+			Uplinks:         uplinks(1, 3, 5, 7),
+			PacketErrorRate: 3.0 / 7.0,
+		},
+		{
+			Name:            "FCnt reset",
+			Uplinks:         uplinks(10, 15, 20, 1, 2, 4),
+			PacketErrorRate: 1.0 / 4.0,
+		},
+		{
+			Name:            "after join-request",
+			Uplinks:         append(append(uplinks(1, 5), joinRequest), uplinks(0, 1, 2)...),
+			PacketErrorRate: 0,
+		},
+		{
+			Name:            "join-request only",
+			Uplinks:         []*ttnpb.UplinkMessage{joinRequest},
+			PacketErrorRate: 0,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			assertions.New(t).So(uplinkPacketErrorRate(tc.Uplinks), should.AlmostEqual, tc.PacketErrorRate, 0.0001)
+		})
+	}
+}
+
+func TestUpdateStats(t *testing.T) {
+	a := assertions.New(t)
+
+	receivedAt := time.Unix(42, 0).UTC()
+	lastDevStatusAt := time.Unix(21, 0).UTC()
+	dev := &ttnpb.EndDevice{
+		LastDevStatusReceivedAt: &lastDevStatusAt,
+		BatteryPercentage:       0.5,
+		DownlinkMargin:          7,
+		RecentUplinks: []*ttnpb.UplinkMessage{
+			{
+				RxMetadata: []*ttnpb.RxMetadata{
+					{SNR: -2, RSSI: -110},
+					{SNR: 4, RSSI: -100},
+				},
+			},
+			{
+				RxMetadata: []*ttnpb.RxMetadata{
+					{SNR: 2, RSSI: -90},
+				},
+				ReceivedAt: receivedAt,
+			},
+		},
+		Stats: &ttnpb.EndDeviceStats{
+			UplinkCount:   41,
+			DownlinkCount: 3,
+		},
+	}
+	updateUplinkStats(dev, dev.RecentUplinks[1])
+	a.So(dev.Stats, should.Resemble, &ttnpb.EndDeviceStats{
+		LastUplinkReceivedAt: &receivedAt,
+		UplinkCount:          42,
+		DownlinkCount:        3,
+		AverageSNR:           3,
+		AverageRSSI:          -95,
+	})
+
+	updateDevStatusStats(dev)
+	a.So(dev.Stats.LastDevStatusReceivedAt, should.Resemble, &lastDevStatusAt)
+	a.So(dev.Stats.BatteryPercentage, should.Equal, 0.5)
+	a.So(dev.Stats.DownlinkMargin, should.Equal, 7)
+
+	scheduledAt := time.Unix(43, 0).UTC()
+	appendRecentDownlink(dev, &ttnpb.DownlinkMessage{}, scheduledAt)
+	a.So(dev.RecentDownlinks, should.HaveLength, 1)
+	a.So(dev.Stats.DownlinkCount, should.Equal, 4)
+	a.So(dev.Stats.LastDownlinkScheduledAt, should.Resemble, &scheduledAt)
+}
@@ -61,7 +61,9 @@ func searchUplinkChannel(freq uint64, dev *ttnpb.EndDevice) (uint8, error) {
 	return 0, errUplinkChannelNotFound.WithAttributes("frequency", freq)
 }
 
-func resetMACState(dev *ttnpb.EndDevice, fps *frequencyplans.Store) error {
+// resetMACState resets the MAC state of dev to the defaults of its band and frequency plan.
+// If profile is not nil, the parameters defined by the MAC profile take precedence over these defaults.
+func resetMACState(dev *ttnpb.EndDevice, fps *frequencyplans.Store, profile *MACProfile) error {
 	fp, band, err := getDeviceBandVersion(dev, fps)
 	if err != nil {
 		return err
@@ -117,6 +119,36 @@ func resetMACState(dev *ttnpb.EndDevice, fps *frequencyplans.Store) error {
 		dev.MACState.CurrentParameters.Channels[i].DownlinkFrequency = downCh.Frequency
 	}
 
+	if profile != nil && !dev.SupportsJoin {
+	outerPreset:
+		for _, freq := range profile.FactoryPresetFrequencies {
+			if len(dev.MACState.CurrentParameters.Channels) >= int(band.MaxUplinkChannels) {
+				break
+			}
+			for _, ch := range dev.MACState.CurrentParameters.Channels {
+				if ch.UplinkFrequency == freq {
+					continue outerPreset
+				}
+			}
+			ch := &ttnpb.MACParameters_Channel{
+				UplinkFrequency: freq,
+				EnableUplink:    true,
+			}
+			if len(band.UplinkChannels) > 0 {
+				ch.MinDataRateIndex = band.UplinkChannels[0].MinDataRate
+				ch.MaxDataRateIndex = band.UplinkChannels[0].MaxDataRate
+			}
+			for _, upCh := range fp.UplinkChannels {
+				if upCh.Frequency == freq {
+					ch.MinDataRateIndex = ttnpb.DataRateIndex(upCh.MinDataRate)
+					ch.MaxDataRateIndex = ttnpb.DataRateIndex(upCh.MaxDataRate)
+					break
+				}
+			}
+			dev.MACState.CurrentParameters.Channels = append(dev.MACState.CurrentParameters.Channels, ch)
+		}
+	}
+
 	for _, ch := range dev.MACState.CurrentParameters.Channels {
 		chCopy := *ch
 		chCopy.EnableUplink = false
@@ -142,6 +174,16 @@ outerUp:
 		})
 	}
 
+	if fp.LoRaStandardChannel != nil {
+		// The LoRa standard channel, e.g. the 500 kHz channel of a US915 sub-band, is received by the gateways as well.
+		for _, ch := range dev.MACState.DesiredParameters.Channels {
+			if ch.UplinkFrequency == fp.LoRaStandardChannel.Frequency {
+				ch.EnableUplink = true
+				break
+			}
+		}
+	}
+
 outerDown:
 	for _, downCh := range fp.DownlinkChannels {
 		for _, ch := range dev.MACState.DesiredParameters.Channels {
@@ -178,9 +220,48 @@ outerDown:
 		dev.MACState.DesiredParameters.MaxEIRP = float32(math.Min(float64(dev.MACState.CurrentParameters.MaxEIRP), float64(*fp.MaxEIRP)))
 	}
 
+	if profile != nil {
+		applyMACProfile(dev.MACState, profile)
+	}
+
 	if dev.DefaultMACParameters != nil {
 		dev.MACState.CurrentParameters = deepcopy.Copy(*dev.DefaultMACParameters).(ttnpb.MACParameters)
 	}
 
 	return nil
 }
+
+// applyMACProfile applies the desired parameters defined by profile p to macState.
+func applyMACProfile(macState *ttnpb.MACState, p *MACProfile) {
+	if p.Rx1Delay != nil {
+		macState.DesiredParameters.Rx1Delay = ttnpb.RxDelay(*p.Rx1Delay)
+	}
+	if p.Rx2DataRateIndex != nil {
+		macState.DesiredParameters.Rx2DataRateIndex = ttnpb.DataRateIndex(*p.Rx2DataRateIndex)
+	}
+	if p.Rx2Frequency != 0 {
+		macState.DesiredParameters.Rx2Frequency = p.Rx2Frequency
+	}
+	if dc, ok := p.AggregatedDutyCycle(); ok {
+		macState.DesiredParameters.MaxDutyCycle = dc
+	}
+	if p.PingSlotDataRateIndex != nil {
+		macState.DesiredParameters.PingSlotDataRateIndex = ttnpb.DataRateIndex(*p.PingSlotDataRateIndex)
+	}
+	if p.PingSlotFrequency != 0 {
+		macState.DesiredParameters.PingSlotFrequency = p.PingSlotFrequency
+	}
+	if period, ok := p.PingSlotPeriod(); ok {
+		macState.PingSlotPeriodicity = period
+	}
+	if len(p.DesiredEnabledChannels) > 0 {
+		for _, ch := range macState.DesiredParameters.Channels {
+			ch.EnableUplink = false
+		}
+		for _, i := range p.DesiredEnabledChannels {
+			if i < uint(len(macState.DesiredParameters.Channels)) {
+				macState.DesiredParameters.Channels[i].EnableUplink = true
+			}
+		}
+	}
+}
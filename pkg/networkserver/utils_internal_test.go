@@ -20,6 +20,7 @@ import (
 	"github.com/mohae/deepcopy"
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -32,6 +33,7 @@ func TestResetMACState(t *testing.T) {
 		Device             *ttnpb.EndDevice
 		DeviceDiff         func(*ttnpb.EndDevice)
 		FrequencyPlanStore *frequencyplans.Store
+		MACProfile         *MACProfile
 		ErrorAssertion     func(*testing.T, error) bool
 	}{
 		{
@@ -267,7 +269,8 @@ func TestResetMACState(t *testing.T) {
 										904700000,
 										904900000,
 										905100000,
-										905300000:
+										905300000,
+										904600000:
 										continue
 									}
 									ch.EnableUplink = false
@@ -280,13 +283,49 @@ func TestResetMACState(t *testing.T) {
 			}(),
 			FrequencyPlanStore: frequencyplans.NewStore(test.FrequencyPlansFetcher),
 		},
+		{
+			Name: "1.0.2/EU868/ABP/MAC profile",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANVersion:    ttnpb.MAC_V1_0_2,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+			},
+			MACProfile: &MACProfile{
+				Name:                     "test",
+				Rx1Delay:                 func(v uint32) *uint32 { return &v }(5),
+				Rx2DataRateIndex:         func(v uint32) *uint32 { return &v }(3),
+				Rx2Frequency:             869000000,
+				FactoryPresetFrequencies: []uint64{868100000, 867100000},
+				DesiredEnabledChannels:   []uint{0, 1, 2, 3},
+				MaxDutyCycle:             4,
+				PingSlotPeriodicity:      8,
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				test.Must(nil, ResetMACState(dev, frequencyplans.NewStore(test.FrequencyPlansFetcher), nil))
+				dev.MACState.PingSlotPeriodicity = ttnpb.PING_EVERY_8S
+				dev.MACState.CurrentParameters.Channels = append(dev.MACState.CurrentParameters.Channels, &ttnpb.MACParameters_Channel{
+					UplinkFrequency:  867100000,
+					MinDataRateIndex: ttnpb.DATA_RATE_0,
+					MaxDataRateIndex: ttnpb.DATA_RATE_5,
+					EnableUplink:     true,
+				})
+				dev.MACState.DesiredParameters.Rx1Delay = ttnpb.RX_DELAY_5
+				dev.MACState.DesiredParameters.Rx2DataRateIndex = ttnpb.DATA_RATE_3
+				dev.MACState.DesiredParameters.Rx2Frequency = 869000000
+				dev.MACState.DesiredParameters.MaxDutyCycle = ttnpb.DUTY_CYCLE_4
+				for i, ch := range dev.MACState.DesiredParameters.Channels {
+					ch.EnableUplink = i < 4
+				}
+			},
+			FrequencyPlanStore: frequencyplans.NewStore(test.FrequencyPlansFetcher),
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
 
 			pb := CopyEndDevice(tc.Device)
 
-			err := resetMACState(pb, tc.FrequencyPlanStore)
+			err := resetMACState(pb, tc.FrequencyPlanStore, tc.MACProfile)
 			if tc.ErrorAssertion != nil {
 				a.So(tc.ErrorAssertion(t, err), should.BeTrue)
 			} else {
@@ -301,3 +340,128 @@ func TestResetMACState(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceMACProfile(t *testing.T) {
+	profile := &MACProfile{Name: "test"}
+	ns := &NetworkServer{
+		macProfiles: map[string]*MACProfile{
+			"test": profile,
+		},
+	}
+	for _, tc := range []struct {
+		Name       string
+		Device     *ttnpb.EndDevice
+		MACProfile *MACProfile
+	}{
+		{
+			Name:   "No MAC profile",
+			Device: &ttnpb.EndDevice{},
+		},
+		{
+			Name: "Existing MAC profile",
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{ProfileID: "test"},
+			},
+			MACProfile: profile,
+		},
+		{
+			Name: "Removed MAC profile",
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{ProfileID: "removed"},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			assertions.New(t).So(ns.deviceMACProfile(test.Context(), tc.Device), should.Equal, tc.MACProfile)
+		})
+	}
+}
+
+type namedADRAlgorithm string
+
+func (namedADRAlgorithm) AdaptDataRate(*ttnpb.EndDevice, band.Band, ADRBounds) error { return nil }
+
+func TestADRAlgorithm(t *testing.T) {
+	ns := &NetworkServer{
+		adrAlgorithms: map[string]ADRAlgorithm{
+			"default": namedADRAlgorithm("default"),
+			"profile": namedADRAlgorithm("profile"),
+			"device":  namedADRAlgorithm("device"),
+		},
+		defaultMACSettings: MACSettingConfig{
+			ADRAlgorithm: "default",
+		},
+	}
+	for _, tc := range []struct {
+		Name       string
+		Device     *ttnpb.EndDevice
+		MACProfile *MACProfile
+		Algorithm  ADRAlgorithm
+		Error      bool
+	}{
+		{
+			Name:      "Default",
+			Device:    &ttnpb.EndDevice{},
+			Algorithm: namedADRAlgorithm("default"),
+		},
+		{
+			Name:       "MAC profile",
+			Device:     &ttnpb.EndDevice{},
+			MACProfile: &MACProfile{ADRAlgorithm: "profile"},
+			Algorithm:  namedADRAlgorithm("profile"),
+		},
+		{
+			Name: "Device over MAC profile",
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{ADRAlgorithm: "device"},
+			},
+			MACProfile: &MACProfile{ADRAlgorithm: "profile"},
+			Algorithm:  namedADRAlgorithm("device"),
+		},
+		{
+			Name: "Unknown",
+			Device: &ttnpb.EndDevice{
+				MACSettings: &ttnpb.MACSettings{ADRAlgorithm: "unknown"},
+			},
+			Error: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			alg, err := ns.adrAlgorithm(tc.Device, tc.MACProfile)
+			if tc.Error {
+				a.So(errors.IsNotFound(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(alg, should.Equal, tc.Algorithm)
+		})
+	}
+}
+
+func TestEventBuffer(t *testing.T) {
+	a := assertions.New(t)
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+		DeviceID:               "test-dev",
+	}
+
+	a.So(collectEvents(func() {
+		publishEvents(test.Context(), evtReceiveLinkCheckRequest(test.Context(), ids, nil))
+	}), should.HaveLength, 1)
+
+	ctx, buf := newEventBufferContext(test.Context())
+	a.So(collectEvents(func() {
+		publishEvents(ctx, evtReceiveLinkCheckRequest(ctx, ids, nil))
+		buf.Reset()
+		publishEvents(ctx, evtReceiveLinkCheckRequest(ctx, ids, nil), evtEnqueueLinkCheckAnswer(ctx, ids, nil))
+	}), should.BeEmpty)
+
+	evs := collectEvents(buf.Publish)
+	if a.So(evs, should.HaveLength, 2) {
+		a.So(evs[0].Name(), should.Equal, "ns.mac.link_check.request")
+		a.So(evs[1].Name(), should.Equal, "ns.mac.link_check.answer")
+	}
+	a.So(collectEvents(buf.Publish), should.BeEmpty)
+}
@@ -0,0 +1,66 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func grpcMetadata(res Result) metadata.MD {
+	md := metadata.Pairs(
+		"x-rate-limit-limit", strconv.FormatUint(uint64(res.Limit), 10),
+		"x-rate-limit-available", strconv.FormatUint(uint64(res.Remaining), 10),
+		"x-rate-limit-reset", seconds(res.ResetAfter),
+	)
+	if res.RetryAfter > 0 {
+		md.Set("x-rate-limit-retry", seconds(res.RetryAfter))
+	}
+	return md
+}
+
+// UnaryServerInterceptor returns a gRPC unary server interceptor that rate limits
+// calls by the method and the caller.
+func UnaryServerInterceptor(limiter Interface) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		limit, res := limiter.RateLimit(ctx, grpcMethodResource(ctx, info.FullMethod))
+		if res.Limit > 0 {
+			grpc.SetHeader(ctx, grpcMetadata(res)) // nolint:gas
+		}
+		if limit {
+			return nil, errRateLimited(res)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a gRPC stream server interceptor that rate limits
+// accepting streams by the method and the caller. Messages on accepted streams are not rate limited.
+func StreamServerInterceptor(limiter Interface) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := stream.Context()
+		limit, res := limiter.RateLimit(ctx, grpcStreamAcceptResource(ctx, info.FullMethod))
+		if res.Limit > 0 {
+			stream.SetHeader(grpcMetadata(res)) // nolint:gas
+		}
+		if limit {
+			return errRateLimited(res)
+		}
+		return handler(srv, stream)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	. "go.thethings.network/lorawan-stack/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	a := assertions.New(t)
+
+	limiter, err := New(config.RateLimiting{
+		Profiles: []config.RateLimitingProfile{
+			{
+				Name:         "test",
+				MaxPerMin:    1,
+				Associations: []string{"grpc:method:/ttn.lorawan.v3.Test/Limited"},
+			},
+		},
+	}, config.Redis{})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	intercept := UnaryServerInterceptor(limiter)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "response", nil
+	}
+	call := func(method, authorization, addr string) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 1234}})
+		if authorization != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+authorization))
+		}
+		_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	a.So(call("/ttn.lorawan.v3.Test/Limited", "", "10.0.0.1"), should.BeNil)
+	a.So(errors.IsResourceExhausted(call("/ttn.lorawan.v3.Test/Limited", "", "10.0.0.1")), should.BeTrue)
+
+	// Other callers are limited separately.
+	a.So(call("/ttn.lorawan.v3.Test/Limited", "", "10.0.0.2"), should.BeNil)
+
+	// Auth tokens are not validated before rate limiting, so they do not identify the caller.
+	a.So(errors.IsResourceExhausted(call("/ttn.lorawan.v3.Test/Limited", "NNSXS.KEYID1.SECRET", "10.0.0.1")), should.BeTrue)
+	a.So(errors.IsResourceExhausted(call("/ttn.lorawan.v3.Test/Limited", "NNSXS.KEYID2.SECRET", "10.0.0.1")), should.BeTrue)
+	a.So(call("/ttn.lorawan.v3.Test/Limited", "NNSXS.KEYID1.SECRET", "10.0.0.3"), should.BeNil)
+
+	// Other methods are not limited.
+	for i := 0; i < 5; i++ {
+		a.So(call("/ttn.lorawan.v3.Test/Unlimited", "", "10.0.0.1"), should.BeNil)
+	}
+}
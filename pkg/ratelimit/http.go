@@ -0,0 +1,51 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"strconv"
+
+	"github.com/labstack/echo"
+)
+
+// EchoMiddleware returns an Echo middleware that rate limits requests by the route and the caller.
+// The classes of the requests are "<class>:<route>" and "<class>".
+// Requests for the given skip routes are not rate limited; this is used for routes that are rate limited
+// elsewhere, such as the gRPC gateway, of which the calls are rate limited by the gRPC interceptors.
+func EchoMiddleware(limiter Interface, class string, skipRoutes ...string) echo.MiddlewareFunc {
+	skip := make(map[string]bool, len(skipRoutes))
+	for _, route := range skipRoutes {
+		skip[route] = true
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if skip[c.Path()] {
+				return next(c)
+			}
+			limit, res := limiter.RateLimit(c.Request().Context(), httpRequestResource(c, class))
+			if res.Limit > 0 {
+				h := c.Response().Header()
+				h.Set("X-Rate-Limit-Limit", strconv.FormatUint(uint64(res.Limit), 10))
+				h.Set("X-Rate-Limit-Available", strconv.FormatUint(uint64(res.Remaining), 10))
+				h.Set("X-Rate-Limit-Reset", seconds(res.ResetAfter))
+			}
+			if limit {
+				c.Response().Header().Set("Retry-After", seconds(res.RetryAfter))
+				return errRateLimited(res)
+			}
+			return next(c)
+		}
+	}
+}
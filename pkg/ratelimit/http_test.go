@@ -0,0 +1,91 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	. "go.thethings.network/lorawan-stack/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestEchoMiddleware(t *testing.T) {
+	a := assertions.New(t)
+
+	limiter, err := New(config.RateLimiting{
+		Profiles: []config.RateLimitingProfile{
+			{
+				Name:         "test",
+				MaxPerMin:    1,
+				MaxBurst:     2,
+				Associations: []string{"http:/limited", "http:/skipped"},
+			},
+		},
+	}, config.Redis{})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	e := echo.New()
+	handler := EchoMiddleware(limiter, "http", "/skipped")(func(c echo.Context) error {
+		return c.String(http.StatusOK, "OK!")
+	})
+
+	serve := func(path, remoteAddr string, forwardedFor ...string) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = remoteAddr
+		for _, v := range forwardedFor {
+			req.Header.Add(echo.HeaderXForwardedFor, v)
+		}
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath(path)
+		return rec, handler(c)
+	}
+
+	for i := 0; i < 2; i++ {
+		rec, err := serve("/limited", "10.0.0.1:1234")
+		a.So(err, should.BeNil)
+		a.So(rec.Code, should.Equal, http.StatusOK)
+		a.So(rec.Header().Get("X-Rate-Limit-Limit"), should.Equal, "2")
+	}
+	rec, err := serve("/limited", "10.0.0.1:1234")
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+	a.So(rec.Header().Get("X-Rate-Limit-Available"), should.Equal, "0")
+	a.So(rec.Header().Get("Retry-After"), should.Equal, "60")
+
+	// The X-Forwarded-For header is set by the client, so it does not identify the caller.
+	_, err = serve("/limited", "10.0.0.1:1234", "10.0.0.3")
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+
+	rec, err = serve("/limited", "10.0.0.2:1234")
+	a.So(err, should.BeNil)
+	a.So(rec.Code, should.Equal, http.StatusOK)
+
+	rec, err = serve("/other", "10.0.0.1:1234")
+	a.So(err, should.BeNil)
+	a.So(rec.Header().Get("X-Rate-Limit-Limit"), should.BeEmpty)
+
+	// Skipped routes are rate limited elsewhere.
+	rec, err = serve("/skipped", "10.0.0.1:1234")
+	a.So(err, should.BeNil)
+	a.So(rec.Header().Get("X-Rate-Limit-Limit"), should.BeEmpty)
+}
@@ -0,0 +1,63 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// used to mock time
+var now = time.Now
+
+type memoryStore struct {
+	maxSize uint
+
+	mu   sync.Mutex
+	tats map[string]time.Time
+}
+
+// NewMemoryStore returns a Store that keeps the token buckets in memory.
+// If more than maxSize buckets are kept, the buckets that are full are removed.
+// If maxSize is zero, the number of buckets is not limited.
+func NewMemoryStore(maxSize uint) Store {
+	return &memoryStore{
+		maxSize: maxSize,
+		tats:    make(map[string]time.Time),
+	}
+}
+
+// Take implements Store.
+func (s *memoryStore) Take(_ context.Context, key string, rate Rate) (bool, Result, error) {
+	now := now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ok, tat, res := take(rate, now, s.tats[key])
+	if !ok {
+		return false, res, nil
+	}
+	if s.maxSize > 0 && uint(len(s.tats)) >= s.maxSize {
+		for k, tat := range s.tats {
+			if !tat.After(now) {
+				delete(s.tats, k)
+			}
+		}
+	}
+	s.tats[key] = tat
+	return true, res, nil
+}
@@ -0,0 +1,67 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestMemoryStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := context.Background()
+
+	start := time.Unix(1000, 0)
+	current := start
+	now = func() time.Time { return current }
+	defer func() { now = time.Now }()
+
+	s := NewMemoryStore(2).(*memoryStore)
+	rate := Rate{PerMinute: 60, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		ok, _, err := s.Take(ctx, "a", rate)
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeTrue)
+	}
+	ok, res, err := s.Take(ctx, "a", rate)
+	a.So(err, should.BeNil)
+	a.So(ok, should.BeFalse)
+	a.So(res.RetryAfter, should.Equal, time.Second)
+	a.So(res.ResetAfter, should.Equal, 2*time.Second)
+
+	// A token is added every second.
+	current = current.Add(time.Second)
+	ok, res, err = s.Take(ctx, "a", rate)
+	a.So(err, should.BeNil)
+	a.So(ok, should.BeTrue)
+	a.So(res.Remaining, should.Equal, uint(0))
+
+	ok, _, err = s.Take(ctx, "b", rate)
+	a.So(err, should.BeNil)
+	a.So(ok, should.BeTrue)
+	a.So(s.tats, should.HaveLength, 2)
+
+	// Full buckets are removed when the store is full.
+	current = current.Add(time.Minute)
+	ok, _, err = s.Take(ctx, "c", rate)
+	a.So(err, should.BeNil)
+	a.So(ok, should.BeTrue)
+	a.So(s.tats, should.HaveLength, 1)
+}
@@ -0,0 +1,221 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ratelimit implements rate limiting of gRPC and HTTP requests.
+//
+// Requests are mapped to a Resource, which has a key that identifies the caller
+// (by remote IP) and the method, and a list of classes. The Identity
+// Server additionally limits the requests of each user with UserResource. The first
+// class for which a profile is configured determines the rate limit that applies.
+// Rate limits are enforced with token buckets, which are kept in memory or shared
+// through Redis.
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/redis"
+)
+
+var (
+	errRateLimitExceeded = errors.DefineResourceExhausted("rate_limit_exceeded", "rate limit exceeded, retry after `{retry_after}`", "retry_after")
+	errUnknownProvider   = errors.DefineInvalidArgument("unknown_provider", "unknown rate limiting provider `{provider}`")
+	errNoProfileName     = errors.DefineInvalidArgument("no_profile_name", "no rate limiting profile name")
+	errDuplicateClass    = errors.DefineInvalidArgument("duplicate_class", "class `{class}` associated with multiple rate limiting profiles")
+)
+
+// Rate is the rate of a token bucket.
+type Rate struct {
+	// PerMinute is the number of tokens that are added to the bucket per minute.
+	PerMinute uint
+	// Burst is the capacity of the bucket. If zero, the capacity is 1.
+	Burst uint
+}
+
+func (r Rate) interval() time.Duration {
+	return time.Minute / time.Duration(r.PerMinute)
+}
+
+func (r Rate) capacity() uint {
+	if r.Burst == 0 {
+		return 1
+	}
+	return r.Burst
+}
+
+func (r Rate) tolerance() time.Duration {
+	return r.interval() * time.Duration(r.capacity())
+}
+
+// Result is the result of taking a token from a bucket.
+type Result struct {
+	// Limit is the capacity of the bucket.
+	Limit uint
+	// Remaining is the number of tokens that remain in the bucket.
+	Remaining uint
+	// RetryAfter is the time after which a token is available, if none was available.
+	RetryAfter time.Duration
+	// ResetAfter is the time after which the bucket is full again.
+	ResetAfter time.Duration
+}
+
+// take computes the result of taking a token from the bucket of the given rate, at the
+// given time and with the given theoretical arrival time (TAT) of the next token.
+// It returns whether a token was taken and the new TAT, which only changes if a token was taken.
+// This implements the Generic Cell Rate Algorithm (GCRA), which is equivalent to a token bucket.
+func take(rate Rate, now, tat time.Time) (bool, time.Time, Result) {
+	if tat.Before(now) {
+		tat = now
+	}
+	interval, tolerance := rate.interval(), rate.tolerance()
+	newTAT := tat.Add(interval)
+	res := Result{
+		Limit: rate.capacity(),
+	}
+	if allowAt := newTAT.Add(-tolerance); now.Before(allowAt) {
+		res.RetryAfter = allowAt.Sub(now)
+		res.ResetAfter = tat.Sub(now)
+		return false, tat, res
+	}
+	res.Remaining = uint((tolerance - newTAT.Sub(now)) / interval)
+	res.ResetAfter = newTAT.Sub(now)
+	return true, newTAT, res
+}
+
+// Store is a store of token buckets.
+type Store interface {
+	// Take takes a token from the bucket with the given key and rate.
+	// It returns whether a token was available.
+	Take(ctx context.Context, key string, rate Rate) (bool, Result, error)
+}
+
+// Resource is the subject of rate limiting.
+type Resource interface {
+	// Key identifies the resource.
+	Key() string
+	// Classes returns the rate limiting classes of the resource, from most specific to least specific.
+	Classes() []string
+}
+
+// Interface can be used to rate limit access to a Resource.
+type Interface interface {
+	// RateLimit takes a token for the resource. It returns whether the resource must be limited.
+	RateLimit(ctx context.Context, resource Resource) (limit bool, result Result)
+}
+
+type profile struct {
+	name string
+	rate Rate
+}
+
+type limiter struct {
+	store    Store
+	profiles map[string]profile
+}
+
+// NewLimiter returns a new rate limiter that uses the given store and profiles.
+func NewLimiter(store Store, profiles ...config.RateLimitingProfile) (Interface, error) {
+	l := &limiter{
+		store:    store,
+		profiles: make(map[string]profile),
+	}
+	for _, p := range profiles {
+		if p.Name == "" {
+			return nil, errNoProfileName
+		}
+		for _, class := range p.Associations {
+			if _, ok := l.profiles[class]; ok {
+				return nil, errDuplicateClass.WithAttributes("class", class)
+			}
+			l.profiles[class] = profile{
+				name: p.Name,
+				rate: Rate{PerMinute: p.MaxPerMin, Burst: p.MaxBurst},
+			}
+		}
+	}
+	return l, nil
+}
+
+// New returns a new rate limiter based on the configuration.
+// The given Redis configuration is used if the Redis provider is configured without its own Redis configuration.
+func New(conf config.RateLimiting, redisConf config.Redis) (Interface, error) {
+	var store Store
+	switch conf.Provider {
+	case "", "memory":
+		store = NewMemoryStore(conf.Memory.MaxSize)
+	case "redis":
+		if !conf.Redis.IsZero() {
+			redisConf = conf.Redis
+		}
+		store = NewRedisStore(redis.New(&redis.Config{
+			Redis:     redisConf,
+			Namespace: []string{"ratelimit"},
+		}))
+	default:
+		return nil, errUnknownProvider.WithAttributes("provider", conf.Provider)
+	}
+	return NewLimiter(store, conf.Profiles...)
+}
+
+// RateLimit implements Interface.
+// Resources without a profile for any of their classes, or with a profile without a rate,
+// are never limited. If the store fails, the resource is not limited.
+func (l *limiter) RateLimit(ctx context.Context, resource Resource) (bool, Result) {
+	for _, class := range resource.Classes() {
+		p, ok := l.profiles[class]
+		if !ok {
+			continue
+		}
+		if p.rate.PerMinute == 0 {
+			return false, Result{}
+		}
+		ok, res, err := l.store.Take(ctx, p.name+":"+resource.Key(), p.rate)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).WithField("class", class).Warn("Failed to take rate limiting token")
+			return false, Result{}
+		}
+		return !ok, res
+	}
+	return false, Result{}
+}
+
+// Require takes a token for the resource, and returns an error if the resource must be limited.
+func Require(ctx context.Context, limiter Interface, resource Resource) error {
+	if limit, res := limiter.RateLimit(ctx, resource); limit {
+		return errRateLimited(res)
+	}
+	return nil
+}
+
+// noop is a rate limiter that never limits.
+type noop struct{}
+
+func (noop) RateLimit(context.Context, Resource) (bool, Result) { return false, Result{} }
+
+// NoopRateLimiter is a rate limiter that never limits.
+var NoopRateLimiter Interface = noop{}
+
+// seconds formats the duration as a number of seconds, rounded up.
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int((d + time.Second - 1) / time.Second))
+}
+
+func errRateLimited(res Result) error {
+	return errRateLimitExceeded.WithAttributes("retry_after", res.RetryAfter.String())
+}
@@ -0,0 +1,141 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	. "go.thethings.network/lorawan-stack/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+type resource struct {
+	key     string
+	classes []string
+}
+
+func (r resource) Key() string       { return r.key }
+func (r resource) Classes() []string { return r.classes }
+
+func TestNewLimiter(t *testing.T) {
+	a := assertions.New(t)
+
+	_, err := NewLimiter(NewMemoryStore(0), config.RateLimitingProfile{
+		MaxPerMin:    1,
+		Associations: []string{"test"},
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = NewLimiter(NewMemoryStore(0), config.RateLimitingProfile{
+		Name:         "a",
+		MaxPerMin:    1,
+		Associations: []string{"test"},
+	}, config.RateLimitingProfile{
+		Name:         "b",
+		MaxPerMin:    1,
+		Associations: []string{"test"},
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = New(config.RateLimiting{Provider: "unknown"}, config.Redis{})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestRateLimit(t *testing.T) {
+	a := assertions.New(t)
+	ctx := context.Background()
+
+	limiter, err := New(config.RateLimiting{
+		Provider: "memory",
+		Profiles: []config.RateLimitingProfile{
+			{
+				Name:         "specific",
+				MaxPerMin:    1,
+				MaxBurst:     3,
+				Associations: []string{"method:specific"},
+			},
+			{
+				Name:         "default",
+				MaxPerMin:    1,
+				Associations: []string{"method"},
+			},
+			{
+				Name:         "unlimited",
+				Associations: []string{"method:unlimited"},
+			},
+		},
+	}, config.Redis{})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The most specific class applies.
+	for i := 0; i < 3; i++ {
+		limit, res := limiter.RateLimit(ctx, resource{key: "specific:caller", classes: []string{"method:specific", "method"}})
+		a.So(limit, should.BeFalse)
+		a.So(res.Limit, should.Equal, uint(3))
+		a.So(res.Remaining, should.Equal, uint(2-i))
+	}
+	limit, res := limiter.RateLimit(ctx, resource{key: "specific:caller", classes: []string{"method:specific", "method"}})
+	a.So(limit, should.BeTrue)
+	a.So(res.Remaining, should.Equal, uint(0))
+	a.So(res.RetryAfter, should.BeGreaterThan, 0)
+
+	// Other keys have their own bucket.
+	limit, _ = limiter.RateLimit(ctx, resource{key: "specific:other", classes: []string{"method:specific", "method"}})
+	a.So(limit, should.BeFalse)
+
+	// The least specific class applies if there is no profile for the more specific class.
+	limit, res = limiter.RateLimit(ctx, resource{key: "other:caller", classes: []string{"method:other", "method"}})
+	a.So(limit, should.BeFalse)
+	a.So(res.Limit, should.Equal, uint(1))
+	limit, _ = limiter.RateLimit(ctx, resource{key: "other:caller", classes: []string{"method:other", "method"}})
+	a.So(limit, should.BeTrue)
+
+	// Profiles without a rate and resources without a profile are not limited.
+	for i := 0; i < 10; i++ {
+		limit, _ = limiter.RateLimit(ctx, resource{key: "unlimited:caller", classes: []string{"method:unlimited", "method"}})
+		a.So(limit, should.BeFalse)
+		limit, _ = limiter.RateLimit(ctx, resource{key: "unknown:caller", classes: []string{"unknown"}})
+		a.So(limit, should.BeFalse)
+	}
+}
+
+func TestRequireUser(t *testing.T) {
+	a := assertions.New(t)
+	ctx := context.Background()
+
+	limiter, err := New(config.RateLimiting{
+		Profiles: []config.RateLimitingProfile{
+			{
+				Name:         "user",
+				MaxPerMin:    1,
+				Associations: []string{"user"},
+			},
+		},
+	}, config.Redis{})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	a.So(Require(ctx, limiter, UserResource("foo")), should.BeNil)
+	a.So(errors.IsResourceExhausted(Require(ctx, limiter, UserResource("foo"))), should.BeTrue)
+	a.So(Require(ctx, limiter, UserResource("bar")), should.BeNil)
+	a.So(Require(ctx, NoopRateLimiter, UserResource("foo")), should.BeNil)
+}
@@ -0,0 +1,65 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+)
+
+// takeScript atomically updates the theoretical arrival time (TAT) of the bucket in KEYS[1].
+// ARGV[1] is the current time, ARGV[2] the emission interval and ARGV[3] the tolerance of the bucket,
+// all in microseconds. It returns whether a token was taken and the TAT before taking the token.
+var takeScript = redis.NewScript(`local now = tonumber(ARGV[1])
+local tat = tonumber(redis.call('get', KEYS[1]) or now)
+if tat < now then
+	tat = now
+end
+local new_tat = tat + tonumber(ARGV[2])
+if now < new_tat - tonumber(ARGV[3]) then
+	return {0, tat}
+end
+redis.call('set', KEYS[1], new_tat, 'px', math.ceil((new_tat - now) / 1000))
+return {1, tat}`)
+
+type redisStore struct {
+	*ttnredis.Client
+}
+
+// NewRedisStore returns a Store that keeps the token buckets in Redis.
+// This allows sharing the rate limits between multiple instances.
+func NewRedisStore(cl *ttnredis.Client) Store {
+	return &redisStore{Client: cl}
+}
+
+// Take implements Store.
+func (s *redisStore) Take(ctx context.Context, key string, rate Rate) (bool, Result, error) {
+	now := now()
+	vs, err := takeScript.Run(s.WithContext(ctx), []string{s.Key(key)},
+		now.UnixNano()/int64(time.Microsecond),
+		int64(rate.interval()/time.Microsecond),
+		int64(rate.tolerance()/time.Microsecond),
+	).Result()
+	if err != nil {
+		return false, Result{}, ttnredis.ConvertError(err)
+	}
+	res := vs.([]interface{})
+	tat := time.Unix(0, res[1].(int64)*int64(time.Microsecond))
+	_, _, result := take(rate, now, tat)
+	return res[0].(int64) == 1, result, nil
+}
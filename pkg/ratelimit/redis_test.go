@@ -0,0 +1,53 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestRedisStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := context.Background()
+
+	cl, flush := test.NewRedis(t, "ratelimit_test")
+	defer flush()
+	defer cl.Close()
+
+	s := NewRedisStore(cl)
+	rate := Rate{PerMinute: 1, Burst: 2}
+
+	for i := 0; i < 2; i++ {
+		ok, res, err := s.Take(ctx, "a", rate)
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeTrue)
+		a.So(res.Limit, should.Equal, uint(2))
+		a.So(res.Remaining, should.Equal, uint(1-i))
+	}
+	ok, res, err := s.Take(ctx, "a", rate)
+	a.So(err, should.BeNil)
+	a.So(ok, should.BeFalse)
+	a.So(res.RetryAfter, should.BeGreaterThan, 0)
+
+	ok, _, err = s.Take(ctx, "b", rate)
+	a.So(err, should.BeNil)
+	a.So(ok, should.BeTrue)
+}
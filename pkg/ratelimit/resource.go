@@ -0,0 +1,84 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/labstack/echo"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+)
+
+type resource struct {
+	key     string
+	classes []string
+}
+
+func (r *resource) Key() string       { return r.key }
+func (r *resource) Classes() []string { return r.classes }
+
+// callerKey identifies the caller by its remote IP.
+// The auth token is not used, as it is not validated before the request is rate limited; a caller
+// could otherwise get a new bucket for every request by sending a different token ID. Authenticated
+// requests are additionally limited per user after validation, see UserResource.
+func callerKey(remoteIP string) string {
+	return "ip:" + remoteIP
+}
+
+// grpcMethodResource returns the resource for the unary gRPC call in the context.
+// The classes are "grpc:method:<method>" and "grpc:method".
+func grpcMethodResource(ctx context.Context, fullMethod string) Resource {
+	caller := callerKey(rpcmetadata.RemoteIP(ctx))
+	return &resource{
+		key:     "grpc:method:" + fullMethod + ":" + caller,
+		classes: []string{"grpc:method:" + fullMethod, "grpc:method"},
+	}
+}
+
+// grpcStreamAcceptResource returns the resource for accepting the gRPC stream in the context.
+// The classes are "grpc:stream:accept:<method>" and "grpc:stream:accept".
+func grpcStreamAcceptResource(ctx context.Context, fullMethod string) Resource {
+	caller := callerKey(rpcmetadata.RemoteIP(ctx))
+	return &resource{
+		key:     "grpc:stream:accept:" + fullMethod + ":" + caller,
+		classes: []string{"grpc:stream:accept:" + fullMethod, "grpc:stream:accept"},
+	}
+}
+
+// httpRequestResource returns the resource for the HTTP request.
+// The classes are "<class>:<route>" and "<class>", where route is the registered path of the request.
+// The remote IP is the one set in the request context by the web server, which only trusts the
+// X-Forwarded-For header of trusted proxies. Without it, this is the address of the peer.
+func httpRequestResource(c echo.Context, class string) Resource {
+	req := c.Request()
+	remoteIP := rpcmetadata.RemoteIP(req.Context())
+	if remoteIP == "" {
+		remoteIP = rpcmetadata.TrustedProxies(nil).RemoteIP(req.RemoteAddr)
+	}
+	route := class + ":" + c.Path()
+	return &resource{
+		key:     route + ":" + callerKey(remoteIP),
+		classes: []string{route, class},
+	}
+}
+
+// UserResource returns the resource for the requests of the user, over all its API keys and access tokens.
+// The class is "user".
+func UserResource(userID string) Resource {
+	return &resource{
+		key:     "user:" + userID,
+		classes: []string{"user"},
+	}
+}
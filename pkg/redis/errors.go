@@ -22,6 +22,7 @@ import (
 var (
 	errNotFound            = errors.DefineNotFound("not_found", "entity not found")
 	errStore               = errors.Define("store", "store error")
+	errTransactionFailed   = errors.DefineAborted("transaction_failed", "transaction failed due to concurrent modification")
 	errInvalidKeyValueType = errors.DefineInvalidArgument("value_type", "invalid value type for key `{key}`")
 )
 
@@ -32,6 +33,8 @@ func ConvertError(err error) error {
 		return nil
 	case redis.Nil:
 		return errNotFound
+	case redis.TxFailedErr:
+		return errTransactionFailed
 	default:
 		return errStore.WithCause(err)
 	}
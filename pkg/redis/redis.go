@@ -95,6 +95,17 @@ func (cl *Client) Key(ks ...string) string {
 	return Key(append([]string{cl.namespace}, ks...)...)
 }
 
+// Watch calls fn in a transaction, which fails if any of the keys are modified before the transaction is executed.
+// If the transaction fails due to a concurrent modification of the keys, an Aborted error is returned, so that the
+// caller may retry.
+func (cl *Client) Watch(fn func(*redis.Tx) error, keys ...string) error {
+	err := cl.Client.Watch(fn, keys...)
+	if err == redis.TxFailedErr {
+		return ConvertError(err)
+	}
+	return err
+}
+
 // ProtoCmd is a command, which can unmarshal its result into a protocol buffer.
 type ProtoCmd struct {
 	result func() (string, error)
@@ -183,6 +194,37 @@ func FindProtos(r redis.Cmdable, k string, keyCmd func(...string) string) *Proto
 	}
 }
 
+// scanCount is the number of keys requested per SCAN iteration.
+const scanCount = 1000
+
+// AddKeysToSet adds the IDs of the keys matching pattern to the set stored under k.
+// id returns the ID of a matching key, and false if the key must not be added.
+// AddKeysToSet is used to populate sets that index entities stored before the set was introduced.
+func AddKeysToSet(r redis.Cmdable, k, pattern string, id func(string) (string, bool)) error {
+	var cursor uint64
+	for {
+		ks, next, err := r.Scan(cursor, pattern, scanCount).Result()
+		if err != nil {
+			return ConvertError(err)
+		}
+		ids := make([]interface{}, 0, len(ks))
+		for _, mk := range ks {
+			if s, ok := id(mk); ok {
+				ids = append(ids, s)
+			}
+		}
+		if len(ids) > 0 {
+			if err := r.SAdd(k, ids...).Err(); err != nil {
+				return ConvertError(err)
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
+
 const (
 	payloadKey = "payload"
 	startAtKey = "start_at"
@@ -0,0 +1,73 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/pkg/metrics"
+)
+
+const (
+	subsystem   = "transaction"
+	transaction = "transaction"
+)
+
+var retryMetrics = &transactionMetrics{
+	retries: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "retries_total",
+			Help:      "Total number of retries of transactions aborted due to concurrent modifications",
+		},
+		[]string{transaction},
+	),
+	giveUps: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "aborted_total",
+			Help:      "Total number of transactions aborted after the maximum number of attempts",
+		},
+		[]string{transaction},
+	),
+}
+
+func init() {
+	metrics.MustRegister(retryMetrics)
+}
+
+type transactionMetrics struct {
+	retries *metrics.ContextualCounterVec
+	giveUps *metrics.ContextualCounterVec
+}
+
+func (m transactionMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.retries.Describe(ch)
+	m.giveUps.Describe(ch)
+}
+
+func (m transactionMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.retries.Collect(ch)
+	m.giveUps.Collect(ch)
+}
+
+func registerRetry(ctx context.Context, name string) {
+	retryMetrics.retries.WithLabelValues(ctx, name).Inc()
+}
+
+func registerGiveUp(ctx context.Context, name string) {
+	retryMetrics.giveUps.WithLabelValues(ctx, name).Inc()
+}
@@ -0,0 +1,80 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package retry retries optimistic transactions, which are aborted due to concurrent modifications.
+package retry
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/random"
+)
+
+// Config represents the configuration of transaction retries.
+type Config struct {
+	MaxAttempts int           `name:"max-attempts" description:"Maximum number of attempts of a transaction that is aborted due to a concurrent modification"`
+	Backoff     time.Duration `name:"backoff" description:"Delay before the first retry, which increases linearly with the number of attempts"`
+}
+
+// DefaultConfig is the default configuration of transaction retries.
+var DefaultConfig = Config{
+	MaxAttempts: 5,
+	Backoff:     10 * time.Millisecond,
+}
+
+// WithDefaults returns c with the unset values set to the values of DefaultConfig.
+func (c Config) WithDefaults() Config {
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = DefaultConfig.MaxAttempts
+	}
+	if c.Backoff <= 0 {
+		c.Backoff = DefaultConfig.Backoff
+	}
+	return c
+}
+
+// jitter is the maximum fraction of the backoff, which is randomly added to or subtracted from it.
+const jitter = 0.5
+
+// Transaction calls f until it succeeds or returns an error that is not Aborted, at most c.MaxAttempts times.
+// Registries return Aborted errors if a transaction fails due to a concurrent modification, which means that f
+// must read the state it modifies within the transaction, so that it can be called again.
+// Before each retry, Transaction waits the backoff of c times the number of attempts, with random jitter.
+// The name identifies the transaction in metrics.
+func Transaction(ctx context.Context, name string, c Config, f func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil || !errors.IsAborted(err) {
+			return err
+		}
+		if attempt >= c.MaxAttempts {
+			registerGiveUp(ctx, name)
+			return err
+		}
+		registerRetry(ctx, name)
+
+		if c.Backoff <= 0 {
+			continue
+		}
+		timer := time.NewTimer(random.Jitter(time.Duration(attempt)*c.Backoff, jitter))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
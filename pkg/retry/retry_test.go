@@ -0,0 +1,107 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	. "go.thethings.network/lorawan-stack/pkg/retry"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var (
+	errConflict = errors.DefineAborted("conflict", "conflict")
+	errInvalid  = errors.DefineInvalidArgument("invalid", "invalid")
+)
+
+func TestTransaction(t *testing.T) {
+	conf := Config{
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Errors         []error
+		ErrorAssertion func(error) bool
+		Attempts       int
+	}{
+		{
+			Name:     "Success",
+			Errors:   []error{nil},
+			Attempts: 1,
+		},
+		{
+			Name:     "Success after conflicts",
+			Errors:   []error{errConflict, errConflict, nil},
+			Attempts: 3,
+		},
+		{
+			Name:           "Other error",
+			Errors:         []error{errConflict, errInvalid},
+			ErrorAssertion: errors.IsInvalidArgument,
+			Attempts:       2,
+		},
+		{
+			Name:           "Too many conflicts",
+			Errors:         []error{errConflict, errConflict, errConflict, nil},
+			ErrorAssertion: errors.IsAborted,
+			Attempts:       3,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var attempts int
+			err := Transaction(test.Context(), "test", conf, func() error {
+				err := tc.Errors[attempts]
+				attempts++
+				return err
+			})
+			if tc.ErrorAssertion == nil {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+			}
+			a.So(attempts, should.Equal, tc.Attempts)
+		})
+	}
+
+	t.Run("Context done", func(t *testing.T) {
+		a := assertions.New(t)
+
+		ctx, cancel := context.WithCancel(test.Context())
+		var attempts int
+		err := Transaction(ctx, "test", Config{MaxAttempts: 3, Backoff: time.Hour}, func() error {
+			attempts++
+			cancel()
+			return errConflict
+		})
+		a.So(err, should.Equal, context.Canceled)
+		a.So(attempts, should.Equal, 1)
+	})
+}
+
+func TestConfigWithDefaults(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(Config{}.WithDefaults(), should.Resemble, DefaultConfig)
+	a.So(Config{MaxAttempts: 2}.WithDefaults(), should.Resemble, Config{MaxAttempts: 2, Backoff: DefaultConfig.Backoff})
+}
@@ -44,6 +44,9 @@ type MD struct {
 
 	// URI is the URI the request is directed to.
 	URI string
+
+	// ForwardedFor is the IP address of the original caller of a forwarded request.
+	ForwardedFor string
 }
 
 // RequireTransportSecurity returns true if authentication is configured
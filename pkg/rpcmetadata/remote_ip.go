@@ -0,0 +1,117 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcmetadata
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// TrustedProxies are the IP ranges of reverse proxies of which the X-Forwarded-For values are trusted.
+// Callers from the loopback interface, such as the gRPC gateway of the HTTP API, are always trusted.
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses the CIDRs of trusted proxies.
+func ParseTrustedProxies(cidrs ...string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		proxies = append(proxies, ipNet)
+	}
+	return proxies, nil
+}
+
+func (p TrustedProxies) trusts(ip net.IP) bool {
+	if ip.IsLoopback() {
+		return true
+	}
+	for _, ipNet := range p {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoteIP returns the IP address of the caller, given the address of the peer and the X-Forwarded-For values.
+// If the peer is a trusted proxy, this is the right-most forwarded address that is not a trusted proxy.
+// Addresses left of that are set by the caller itself, and are therefore never used.
+func (p TrustedProxies) RemoteIP(peerAddr string, forwardedFor ...string) string {
+	host, _, err := net.SplitHostPort(peerAddr)
+	if err != nil {
+		host = peerAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !p.trusts(ip) {
+		return host
+	}
+	var forwarded []string
+	for _, v := range forwardedFor {
+		forwarded = append(forwarded, strings.Split(v, ",")...)
+	}
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		fwdIP := net.ParseIP(strings.TrimSpace(forwarded[i]))
+		if fwdIP == nil {
+			break
+		}
+		host = fwdIP.String()
+		if !p.trusts(fwdIP) {
+			break
+		}
+	}
+	return host
+}
+
+type remoteIPKeyType struct{}
+
+var remoteIPKey remoteIPKeyType
+
+// NewContextWithRemoteIP returns a derived context with the IP address of the caller.
+func NewContextWithRemoteIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, remoteIPKey, ip)
+}
+
+// RemoteIP returns the IP address of the caller in the incoming context ctx.
+// If the address is not set with NewContextWithRemoteIP, this is the address of the gRPC peer.
+func RemoteIP(ctx context.Context) string {
+	if ip, ok := ctx.Value(remoteIPKey).(string); ok {
+		return ip
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	return TrustedProxies(nil).RemoteIP(p.Addr.String())
+}
+
+// RemoteIPFiller returns a context filler that sets the IP address of the caller in the incoming context,
+// trusting the X-Forwarded-For metadata set by the given proxies.
+func RemoteIPFiller(proxies TrustedProxies) func(context.Context) context.Context {
+	return func(ctx context.Context) context.Context {
+		p, ok := peer.FromContext(ctx)
+		if !ok {
+			return ctx
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		return NewContextWithRemoteIP(ctx, proxies.RemoteIP(p.Addr.String(), md.Get("x-forwarded-for")...))
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcmetadata_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestTrustedProxiesRemoteIP(t *testing.T) {
+	proxies, err := ParseTrustedProxies("10.0.0.0/24")
+	if err != nil {
+		t.Fatalf("Failed to parse trusted proxies: %s", err)
+	}
+	for _, tc := range []struct {
+		Name         string
+		PeerAddr     string
+		ForwardedFor []string
+		RemoteIP     string
+	}{
+		{
+			Name:     "Untrusted peer",
+			PeerAddr: "192.0.2.1:1234",
+			RemoteIP: "192.0.2.1",
+		},
+		{
+			Name:         "Untrusted peer/forwarded",
+			PeerAddr:     "192.0.2.1:1234",
+			ForwardedFor: []string{"198.51.100.1"},
+			RemoteIP:     "192.0.2.1",
+		},
+		{
+			Name:     "Trusted peer/not forwarded",
+			PeerAddr: "10.0.0.1:1234",
+			RemoteIP: "10.0.0.1",
+		},
+		{
+			Name:         "Trusted peer/forwarded",
+			PeerAddr:     "10.0.0.1:1234",
+			ForwardedFor: []string{"192.0.2.1"},
+			RemoteIP:     "192.0.2.1",
+		},
+		{
+			Name:         "Trusted peer/spoofed",
+			PeerAddr:     "10.0.0.1:1234",
+			ForwardedFor: []string{"198.51.100.1, 192.0.2.1"},
+			RemoteIP:     "192.0.2.1",
+		},
+		{
+			Name:         "Trusted peer/trusted hops",
+			PeerAddr:     "10.0.0.1:1234",
+			ForwardedFor: []string{"198.51.100.1, 192.0.2.1", "10.0.0.2"},
+			RemoteIP:     "192.0.2.1",
+		},
+		{
+			Name:         "Loopback peer/forwarded",
+			PeerAddr:     "127.0.0.1:1234",
+			ForwardedFor: []string{"192.0.2.1, 10.0.0.2"},
+			RemoteIP:     "192.0.2.1",
+		},
+		{
+			Name:         "Trusted peer/invalid",
+			PeerAddr:     "10.0.0.1:1234",
+			ForwardedFor: []string{"192.0.2.1, invalid"},
+			RemoteIP:     "10.0.0.1",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(proxies.RemoteIP(tc.PeerAddr, tc.ForwardedFor...), should.Equal, tc.RemoteIP)
+		})
+	}
+}
+
+func TestRemoteIP(t *testing.T) {
+	a := assertions.New(t)
+
+	withPeer := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+	}
+	forwarded := metadata.Pairs("x-forwarded-for", "10.0.0.2, 192.0.2.1")
+
+	a.So(RemoteIP(context.Background()), should.BeEmpty)
+	a.So(RemoteIP(withPeer("10.0.0.1")), should.Equal, "10.0.0.1")
+	a.So(RemoteIP(metadata.NewIncomingContext(withPeer("127.0.0.1"), forwarded)), should.Equal, "127.0.0.1")
+	a.So(RemoteIP(NewContextWithRemoteIP(withPeer("127.0.0.1"), "192.0.2.1")), should.Equal, "192.0.2.1")
+
+	fill := RemoteIPFiller(nil)
+	a.So(RemoteIP(fill(metadata.NewIncomingContext(withPeer("10.0.0.1"), forwarded))), should.Equal, "10.0.0.1")
+	a.So(RemoteIP(fill(metadata.NewIncomingContext(withPeer("127.0.0.1"), forwarded))), should.Equal, "192.0.2.1")
+}
@@ -26,20 +26,27 @@ func (m MD) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]s
 	if m.AuthType == "" || m.AuthValue == "" {
 		return nil, nil
 	}
-	return map[string]string{
+	md := map[string]string{
 		"id":            m.ID,
 		"authorization": m.AuthType + " " + m.AuthValue,
-	}, nil
+	}
+	if m.ForwardedFor != "" {
+		md["x-forwarded-for"] = m.ForwardedFor
+	}
+	return md, nil
 }
 
 var errUnauthenticated = errors.DefineUnauthenticated("unauthenticated", "the context is not authenticated")
 
 // WithForwardedAuth returns a grpc.CallOption with authentication from the incoming context ctx.
+// The IP address of the caller is forwarded as X-Forwarded-For, so that the receiving server can
+// apply IP restrictions of the credentials if it trusts this server as proxy.
 func WithForwardedAuth(ctx context.Context, allowInsecure bool) (grpc.CallOption, error) {
 	md := FromIncomingContext(ctx)
 	if md.AuthType == "" || md.AuthValue == "" {
 		return nil, errUnauthenticated
 	}
 	md.AllowInsecure = allowInsecure
+	md.ForwardedFor = RemoteIP(ctx)
 	return grpc.PerRPCCredentials(md), nil
 }
@@ -73,6 +73,16 @@ func TestRequestMetadata(t *testing.T) {
 			"id":            "some-id",
 			"authorization": "Key foo",
 		})
+
+		callOpt, err = WithForwardedAuth(NewContextWithRemoteIP(ctx, "192.0.2.1"), true)
+		a.So(err, should.BeNil)
+		requestMD, err = callOpt.(grpc.PerRPCCredsCallOption).Creds.GetRequestMetadata(ctx)
+		a.So(err, should.BeNil)
+		a.So(requestMD, should.Resemble, map[string]string{
+			"id":              "some-id",
+			"authorization":   "Key foo",
+			"x-forwarded-for": "192.0.2.1",
+		})
 	}
 
 	{
@@ -33,6 +33,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/fillcontext"
 	"go.thethings.network/lorawan-stack/pkg/jsonpb"
 	"go.thethings.network/lorawan-stack/pkg/metrics"
+	"go.thethings.network/lorawan-stack/pkg/ratelimit"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware"
 	rpcfillcontext "go.thethings.network/lorawan-stack/pkg/rpcmiddleware/fillcontext"
@@ -57,6 +58,7 @@ type options struct {
 	unaryInterceptors  []grpc.UnaryServerInterceptor
 	serverOptions      []grpc.ServerOption
 	sentry             *raven.Client
+	rateLimiter        ratelimit.Interface
 }
 
 // Option for the gRPC server
@@ -104,6 +106,13 @@ func WithSentry(sentry *raven.Client) Option {
 	}
 }
 
+// WithRateLimiter sets the rate limiter
+func WithRateLimiter(limiter ratelimit.Interface) Option {
+	return func(o *options) {
+		o.rateLimiter = limiter
+	}
+}
+
 // ErrRPCRecovered is returned when a panic is caught from an RPC.
 var ErrRPCRecovered = errors.DefineInternal("rpc_recovered", "Internal Server Error")
 
@@ -111,9 +120,11 @@ var ErrRPCRecovered = errors.DefineInternal("rpc_recovered", "Internal Server Er
 // The given context is used in some of the middlewares, the given server options are passed to gRPC
 //
 // Currently the following middlewares are included: tag extraction, metrics,
-// logging, sending errors to Sentry, validation, errors, panic recovery
+// logging, sending errors to Sentry, rate limiting, validation, errors, panic recovery
 func New(ctx context.Context, opts ...Option) *Server {
-	options := new(options)
+	options := &options{
+		rateLimiter: ratelimit.NoopRateLimiter,
+	}
 	for _, opt := range opts {
 		opt(options)
 	}
@@ -136,6 +147,7 @@ func New(ctx context.Context, opts ...Option) *Server {
 		metrics.StreamServerInterceptor,
 		sentry.StreamServerInterceptor(options.sentry),
 		errors.StreamServerInterceptor(),
+		ratelimit.StreamServerInterceptor(options.rateLimiter),
 		validator.StreamServerInterceptor(),
 		hooks.StreamServerInterceptor(),
 	}
@@ -149,6 +161,7 @@ func New(ctx context.Context, opts ...Option) *Server {
 		metrics.UnaryServerInterceptor,
 		sentry.UnaryServerInterceptor(options.sentry),
 		errors.UnaryServerInterceptor(),
+		ratelimit.UnaryServerInterceptor(options.rateLimiter),
 		validator.UnaryServerInterceptor(),
 		hooks.UnaryServerInterceptor(),
 	}
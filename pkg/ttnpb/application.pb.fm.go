@@ -17,6 +17,7 @@ var ApplicationFieldPathsNested = []string{
 	"ids",
 	"ids.application_id",
 	"name",
+	"state",
 	"updated_at",
 }
 
@@ -27,6 +28,7 @@ var ApplicationFieldPathsTopLevel = []string{
 	"description",
 	"ids",
 	"name",
+	"state",
 	"updated_at",
 }
 
@@ -109,6 +111,16 @@ func (dst *Application) SetFields(src *Application, paths ...string) error {
 			} else {
 				dst.ContactInfo = nil
 			}
+		case "state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.State = src.State
+			} else {
+				var zero State
+				dst.State = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -298,6 +310,7 @@ var CreateApplicationRequestFieldPathsNested = []string{
 	"application.ids",
 	"application.ids.application_id",
 	"application.name",
+	"application.state",
 	"application.updated_at",
 	"collaborator",
 	"collaborator.ids",
@@ -369,6 +382,7 @@ var UpdateApplicationRequestFieldPathsNested = []string{
 	"application.ids",
 	"application.ids.application_id",
 	"application.name",
+	"application.state",
 	"application.updated_at",
 	"field_mask",
 }
@@ -418,14 +432,18 @@ func (dst *UpdateApplicationRequest) SetFields(src *UpdateApplicationRequest, pa
 }
 
 var CreateApplicationAPIKeyRequestFieldPathsNested = []string{
+	"allowed_ips",
 	"application_ids",
 	"application_ids.application_id",
+	"expires_at",
 	"name",
 	"rights",
 }
 
 var CreateApplicationAPIKeyRequestFieldPathsTopLevel = []string{
+	"allowed_ips",
 	"application_ids",
+	"expires_at",
 	"name",
 	"rights",
 }
@@ -470,6 +488,24 @@ func (dst *CreateApplicationAPIKeyRequest) SetFields(src *CreateApplicationAPIKe
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "allowed_ips":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allowed_ips' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowedIPs = src.AllowedIPs
+			} else {
+				dst.AllowedIPs = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -480,17 +516,22 @@ func (dst *CreateApplicationAPIKeyRequest) SetFields(src *CreateApplicationAPIKe
 
 var UpdateApplicationAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_ips",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
+	"api_key.last_used_at",
 	"api_key.name",
 	"api_key.rights",
 	"application_ids",
 	"application_ids.application_id",
+	"field_mask",
 }
 
 var UpdateApplicationAPIKeyRequestFieldPathsTopLevel = []string{
 	"api_key",
 	"application_ids",
+	"field_mask",
 }
 
 func (dst *UpdateApplicationAPIKeyRequest) SetFields(src *UpdateApplicationAPIKeyRequest, paths ...string) error {
@@ -532,6 +573,16 @@ func (dst *UpdateApplicationAPIKeyRequest) SetFields(src *UpdateApplicationAPIKe
 					dst.APIKey = zero
 				}
 			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero github_com_gogo_protobuf_types.FieldMask
+				dst.FieldMask = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
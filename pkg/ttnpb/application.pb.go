@@ -43,8 +43,11 @@ type Application struct {
 	Description            string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	Attributes             map[string]string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	ContactInfo            []*ContactInfo    `protobuf:"bytes,7,rep,name=contact_info,json=contactInfo,proto3" json:"contact_info,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}          `json:"-"`
-	XXX_sizecache          int32             `json:"-"`
+	// The reviewing state of the application.
+	// This field can only be modified by admins.
+	State                State    `protobuf:"varint,8,opt,name=state,proto3,enum=ttn.lorawan.v3.State" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Application) Reset()      { *m = Application{} }
@@ -121,6 +124,13 @@ func (m *Application) GetContactInfo() []*ContactInfo {
 	return nil
 }
 
+func (m *Application) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return STATE_REQUESTED
+}
+
 type Applications struct {
 	Applications         []*Application `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -388,10 +398,12 @@ func (m *UpdateApplicationRequest) GetFieldMask() types.FieldMask {
 
 type CreateApplicationAPIKeyRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
-	Name                   string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights                 []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	Name                   string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights                 []Right    `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	ExpiresAt              *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	AllowedIPs             []string   `protobuf:"bytes,5,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}   `json:"-"`
+	XXX_sizecache          int32      `json:"-"`
 }
 
 func (m *CreateApplicationAPIKeyRequest) Reset()      { *m = CreateApplicationAPIKeyRequest{} }
@@ -440,11 +452,26 @@ func (m *CreateApplicationAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateApplicationAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateApplicationAPIKeyRequest) GetAllowedIPs() []string {
+	if m != nil {
+		return m.AllowedIPs
+	}
+	return nil
+}
+
 type UpdateApplicationAPIKeyRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	APIKey                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	FieldMask              types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
+	XXX_sizecache          int32           `json:"-"`
 }
 
 func (m *UpdateApplicationAPIKeyRequest) Reset()      { *m = UpdateApplicationAPIKeyRequest{} }
@@ -479,6 +506,13 @@ func (m *UpdateApplicationAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateApplicationAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateApplicationAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type SetApplicationCollaboratorRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	Collaborator           Collaborator `protobuf:"bytes,2,opt,name=collaborator,proto3" json:"collaborator"`
@@ -597,6 +631,9 @@ func (this *Application) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.State != that1.State {
+		return false
+	}
 	return true
 }
 func (this *Applications) Equal(that interface{}) bool {
@@ -778,6 +815,21 @@ func (this *CreateApplicationAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedIPs) != len(that1.AllowedIPs) {
+		return false
+	}
+	for i := range this.AllowedIPs {
+		if this.AllowedIPs[i] != that1.AllowedIPs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateApplicationAPIKeyRequest) Equal(that interface{}) bool {
@@ -805,6 +857,9 @@ func (this *UpdateApplicationAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *SetApplicationCollaboratorRequest) Equal(that interface{}) bool {
@@ -914,6 +969,11 @@ func (m *Application) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.State != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(m.State))
+	}
 	return i, nil
 }
 
@@ -1147,6 +1207,31 @@ func (m *CreateApplicationAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintApplication(dAtA, i, uint64(j13))
 		i += copy(dAtA[i:], dAtA14[:j13])
 	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n22, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if len(m.AllowedIPs) > 0 {
+		for _, s := range m.AllowedIPs {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -1181,6 +1266,14 @@ func (m *UpdateApplicationAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n16
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.FieldMask.Size()))
+	n23, err := m.FieldMask.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n23
 	return i, nil
 }
 
@@ -1251,6 +1344,7 @@ func NewPopulatedApplication(r randyApplication, easy bool) *Application {
 			this.ContactInfo[i] = NewPopulatedContactInfo(r, easy)
 		}
 	}
+	this.State = State([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1328,6 +1422,14 @@ func NewPopulatedCreateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 	for i := 0; i < v15; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
+	if r.Intn(10) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v23 := r.Intn(10)
+	this.AllowedIPs = make([]string, v23)
+	for i := 0; i < v23; i++ {
+		this.AllowedIPs[i] = randStringApplication(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1339,6 +1441,8 @@ func NewPopulatedUpdateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 	this.ApplicationIdentifiers = *v16
 	v17 := NewPopulatedAPIKey(r, easy)
 	this.APIKey = *v17
+	v24 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v24
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1461,6 +1565,9 @@ func (m *Application) Size() (n int) {
 			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
+	if m.State != 0 {
+		n += 1 + sovApplication(uint64(m.State))
+	}
 	return n
 }
 
@@ -1562,6 +1669,16 @@ func (m *CreateApplicationAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovApplication(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.AllowedIPs) > 0 {
+		for _, s := range m.AllowedIPs {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1575,6 +1692,8 @@ func (m *UpdateApplicationAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovApplication(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovApplication(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplication(uint64(l))
 	return n
 }
 
@@ -1626,6 +1745,7 @@ func (this *Application) String() string {
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`Attributes:` + mapStringForAttributes + `,`,
 		`ContactInfo:` + strings.Replace(fmt.Sprintf("%v", this.ContactInfo), "ContactInfo", "ContactInfo", 1) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1695,6 +1815,8 @@ func (this *CreateApplicationAPIKeyRequest) String() string {
 		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationIdentifiers.String(), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedIPs:` + fmt.Sprintf("%v", this.AllowedIPs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1706,6 +1828,7 @@ func (this *UpdateApplicationAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateApplicationAPIKeyRequest{`,
 		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationIdentifiers.String(), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(this.APIKey.String(), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2055,6 +2178,25 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (State(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -2821,6 +2963,68 @@ func (m *CreateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedIPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedIPs = append(m.AllowedIPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -2931,6 +3135,36 @@ func (m *UpdateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -3175,60 +3409,65 @@ func init() {
 }
 
 var fileDescriptor_application_87323d8f274374f4 = []byte{
-	// 874 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x3d, 0x6c, 0x1c, 0x45,
-	0x14, 0x9e, 0xf1, 0x39, 0x0e, 0x37, 0xe7, 0x38, 0x68, 0x45, 0x60, 0x65, 0xa2, 0xe7, 0xe3, 0x40,
-	0xc8, 0x12, 0x78, 0x4f, 0x72, 0x1a, 0x40, 0x02, 0x74, 0xb6, 0x48, 0x64, 0x19, 0x64, 0x58, 0x88,
-	0x10, 0x34, 0xa7, 0xb9, 0xbd, 0xb9, 0xf5, 0xe8, 0xf6, 0x76, 0x96, 0xd9, 0xb9, 0x44, 0x47, 0x95,
-	0x32, 0x65, 0x4a, 0x4a, 0x84, 0x28, 0x52, 0x46, 0x42, 0x42, 0x29, 0x10, 0x4a, 0x85, 0x5c, 0xba,
-	0x4c, 0x43, 0xc8, 0xed, 0x36, 0x69, 0x90, 0x52, 0xa6, 0x44, 0x3b, 0xbb, 0x9b, 0x9b, 0xfb, 0xc1,
-	0x12, 0x58, 0x71, 0x37, 0x6f, 0xe6, 0x7b, 0xdf, 0x7e, 0xef, 0x57, 0x4b, 0xde, 0x0c, 0x84, 0xa4,
-	0x37, 0x69, 0xb8, 0x15, 0x2b, 0xea, 0xf5, 0x9b, 0x34, 0xe2, 0x4d, 0x1a, 0x45, 0x01, 0xf7, 0xa8,
-	0xe2, 0x22, 0x74, 0x22, 0x29, 0x94, 0xb0, 0xd6, 0x94, 0x0a, 0x9d, 0x02, 0xe8, 0xdc, 0xb8, 0xb2,
-	0xbe, 0xe5, 0x73, 0x75, 0x38, 0xec, 0x38, 0x9e, 0x18, 0x34, 0x7d, 0xe1, 0x8b, 0xa6, 0x86, 0x75,
-	0x86, 0x3d, 0x6d, 0x69, 0x43, 0x9f, 0x72, 0xf7, 0xf5, 0xcb, 0xbe, 0x10, 0x7e, 0xc0, 0x72, 0xf2,
-	0x30, 0x14, 0x4a, 0x73, 0xc7, 0xc5, 0x6b, 0xbd, 0x78, 0x7d, 0xce, 0xd1, 0xe3, 0x2c, 0xe8, 0xb6,
-	0x07, 0x34, 0xee, 0x17, 0x88, 0x8d, 0x59, 0x84, 0xe2, 0x03, 0x16, 0x2b, 0x3a, 0x88, 0x0a, 0xc0,
-	0x5b, 0xf3, 0x41, 0x78, 0x22, 0x54, 0xd4, 0x53, 0x6d, 0x1e, 0xf6, 0x4a, 0x19, 0x0b, 0x42, 0xe5,
-	0x5d, 0x16, 0x2a, 0xde, 0xe3, 0x4c, 0x96, 0x6a, 0x60, 0x1e, 0x24, 0xb9, 0x7f, 0xa8, 0x8a, 0xf7,
-	0xc6, 0x9f, 0x15, 0x52, 0x6b, 0x4d, 0x12, 0x64, 0xed, 0x90, 0x0a, 0xef, 0xc6, 0x36, 0xae, 0xe3,
-	0xcd, 0xda, 0xf6, 0xdb, 0xce, 0x74, 0xa2, 0x1c, 0x03, 0xb9, 0x37, 0xf9, 0xd4, 0xce, 0x4b, 0x47,
-	0x8f, 0x36, 0xd0, 0xf1, 0xa3, 0x0d, 0xec, 0x66, 0xce, 0xd6, 0x2e, 0x21, 0x9e, 0x64, 0x54, 0xb1,
-	0x6e, 0x9b, 0x2a, 0x7b, 0x49, 0x53, 0xad, 0x3b, 0x79, 0xd0, 0x4e, 0x19, 0xb4, 0xf3, 0x55, 0x19,
-	0x74, 0xee, 0x7e, 0xe7, 0xaf, 0x0d, 0xec, 0x56, 0x0b, 0xbf, 0x96, 0xca, 0x48, 0x86, 0x51, 0xb7,
-	0x24, 0xa9, 0xfc, 0x17, 0x92, 0xc2, 0xaf, 0xa5, 0x2c, 0x8b, 0x2c, 0x87, 0x74, 0xc0, 0xec, 0xe5,
-	0x3a, 0xde, 0xac, 0xba, 0xfa, 0x6c, 0xd5, 0x49, 0xad, 0xcb, 0x62, 0x4f, 0xf2, 0x28, 0x0b, 0xc3,
-	0x3e, 0xa7, 0x9f, 0xcc, 0x2b, 0x6b, 0x9f, 0x10, 0xaa, 0x94, 0xe4, 0x9d, 0xa1, 0x62, 0xb1, 0xbd,
-	0x52, 0xaf, 0x6c, 0xd6, 0xb6, 0xdf, 0x39, 0x21, 0x15, 0x4e, 0xeb, 0x39, 0xfa, 0x93, 0x50, 0xc9,
-	0x91, 0x6b, 0xb8, 0x5b, 0x1f, 0x91, 0x55, 0xb3, 0x76, 0xf6, 0x79, 0x4d, 0xf7, 0xfa, 0x2c, 0xdd,
-	0x6e, 0x8e, 0xd9, 0x0b, 0x7b, 0xc2, 0xad, 0x79, 0x13, 0x63, 0xfd, 0x43, 0x72, 0x71, 0x86, 0xde,
-	0x7a, 0x99, 0x54, 0xfa, 0x6c, 0xa4, 0x6b, 0x54, 0x75, 0xb3, 0xa3, 0xf5, 0x0a, 0x39, 0x77, 0x83,
-	0x06, 0x43, 0xa6, 0x93, 0x5d, 0x75, 0x73, 0xe3, 0x83, 0xa5, 0xf7, 0x70, 0xe3, 0x80, 0xac, 0x1a,
-	0x4a, 0x63, 0xeb, 0x63, 0xb2, 0x6a, 0xcc, 0x43, 0x56, 0xe8, 0x85, 0x72, 0x0c, 0x1f, 0x77, 0xca,
-	0xa1, 0xf1, 0x0b, 0x26, 0x97, 0xae, 0x31, 0x65, 0x02, 0xd8, 0x77, 0x43, 0x16, 0x2b, 0xeb, 0x1b,
-	0x72, 0xd1, 0x40, 0xb6, 0x4f, 0xd3, 0x46, 0x6b, 0xd4, 0x44, 0x64, 0xaa, 0xc9, 0x64, 0x8a, 0xfe,
-	0xb5, 0xa3, 0xae, 0x66, 0x90, 0xcf, 0x68, 0xdc, 0xdf, 0x59, 0xce, 0x98, 0xdc, 0x6a, 0xaf, 0xbc,
-	0x68, 0xfc, 0x8d, 0xc9, 0x6b, 0x9f, 0xf2, 0xd8, 0x94, 0x1d, 0x97, 0xba, 0xbf, 0xc8, 0x2a, 0x14,
-	0x04, 0xb4, 0x23, 0x24, 0x55, 0x42, 0x16, 0xa2, 0xb7, 0x66, 0x45, 0x1f, 0x48, 0x9f, 0x86, 0xfc,
-	0x7b, 0xed, 0x7b, 0x20, 0xaf, 0xc7, 0x4c, 0x1a, 0xda, 0xdd, 0x29, 0x8a, 0x53, 0xeb, 0xcd, 0x0a,
-	0x2a, 0x64, 0x97, 0x49, 0xdd, 0xf8, 0x55, 0x37, 0x37, 0xb2, 0xdb, 0x80, 0x0f, 0xb8, 0xd2, 0xfd,
-	0x7c, 0xc1, 0xcd, 0x8d, 0xac, 0xc9, 0x23, 0xea, 0x33, 0xdd, 0xc9, 0x17, 0x5c, 0x7d, 0x6e, 0xfc,
-	0x86, 0x89, 0xbd, 0xab, 0x67, 0x69, 0x41, 0xa1, 0xae, 0x91, 0x9a, 0x91, 0xdf, 0x22, 0xde, 0x93,
-	0x5a, 0xc0, 0xa8, 0x8c, 0xe9, 0x69, 0x7d, 0x3d, 0x93, 0xb9, 0xa5, 0xff, 0x91, 0xb9, 0x22, 0xf6,
-	0x29, 0xa2, 0xc6, 0xcf, 0x98, 0xd8, 0xd7, 0xf5, 0x14, 0xbf, 0x48, 0xf9, 0xa7, 0xee, 0xaa, 0xdf,
-	0x31, 0x81, 0xb9, 0x2c, 0xb7, 0x3e, 0xdf, 0xdb, 0x67, 0xa3, 0x33, 0x18, 0x8a, 0x72, 0xb9, 0x2d,
-	0x19, 0xcb, 0x6d, 0x8b, 0xac, 0xe4, 0xeb, 0xdd, 0xae, 0xd4, 0x2b, 0x9b, 0x6b, 0xdb, 0x97, 0x66,
-	0xbf, 0xe2, 0x66, 0xaf, 0x6e, 0x01, 0x6a, 0xfc, 0x8a, 0x09, 0xcc, 0xe5, 0xf9, 0xcc, 0x02, 0x78,
-	0x9f, 0x9c, 0xa7, 0x11, 0x6f, 0x67, 0xbb, 0x2c, 0x4f, 0xfe, 0xab, 0x73, 0x94, 0x5a, 0x8a, 0x41,
-	0xb1, 0x42, 0x23, 0xbe, 0xcf, 0x46, 0x8d, 0x3f, 0x30, 0x79, 0xe3, 0xcb, 0xa9, 0x2d, 0xb4, 0x6b,
-	0xf4, 0xcf, 0x19, 0x68, 0xbf, 0xba, 0xb0, 0xf5, 0x2f, 0xcf, 0xaf, 0xf5, 0x09, 0x66, 0x51, 0xa7,
-	0xef, 0xfc, 0x84, 0x8f, 0xc6, 0x80, 0x8f, 0xc7, 0x80, 0x1f, 0x8e, 0x01, 0x3d, 0x1e, 0x03, 0x7a,
-	0x32, 0x06, 0xf4, 0x74, 0x0c, 0xe8, 0xd9, 0x18, 0xf0, 0xad, 0x04, 0xf0, 0xed, 0x04, 0xd0, 0xdd,
-	0x04, 0xf0, 0xbd, 0x04, 0xd0, 0xfd, 0x04, 0xd0, 0x83, 0x04, 0xd0, 0x51, 0x02, 0xf8, 0x38, 0x01,
-	0xfc, 0x30, 0x01, 0xf4, 0x38, 0x01, 0xfc, 0x24, 0x01, 0xf4, 0x34, 0x01, 0xfc, 0x2c, 0x01, 0x74,
-	0x2b, 0x05, 0x74, 0x3b, 0x05, 0x7c, 0x27, 0x05, 0xf4, 0x43, 0x0a, 0xf8, 0xc7, 0x14, 0xd0, 0xdd,
-	0x14, 0xd0, 0xbd, 0x14, 0xf0, 0xfd, 0x14, 0xf0, 0x83, 0x14, 0xf0, 0xb7, 0xef, 0xfa, 0xc2, 0x51,
-	0x87, 0x4c, 0x1d, 0xf2, 0xd0, 0x8f, 0x9d, 0x90, 0xa9, 0x9b, 0x42, 0xf6, 0x9b, 0xd3, 0xff, 0x0a,
-	0x51, 0xdf, 0x6f, 0x2a, 0x15, 0x46, 0x9d, 0xce, 0x8a, 0x1e, 0x86, 0x2b, 0xff, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0xcc, 0xd3, 0x2a, 0x54, 0x5d, 0x09, 0x00, 0x00,
+	// 956 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x3d, 0x8c, 0x1b, 0x45,
+	0x14, 0xde, 0xb1, 0x7d, 0x97, 0x78, 0x7c, 0xb9, 0xa0, 0x15, 0x81, 0xd5, 0x11, 0xc6, 0xc6, 0x20,
+	0x64, 0x29, 0xdc, 0x5a, 0xba, 0x34, 0x80, 0x04, 0xc8, 0x3e, 0x91, 0xc8, 0x3a, 0xd0, 0x85, 0x0d,
+	0x11, 0x82, 0xc6, 0x1a, 0xdb, 0xe3, 0xbd, 0x91, 0xd7, 0x3b, 0xcb, 0xcc, 0x38, 0x87, 0xa9, 0x52,
+	0x46, 0x54, 0x29, 0x29, 0x11, 0xa2, 0x48, 0x79, 0x12, 0x4d, 0x0a, 0x8a, 0x54, 0xe8, 0xca, 0x2b,
+	0x53, 0x1d, 0xf1, 0x6e, 0x93, 0x06, 0x29, 0x1d, 0x29, 0xd1, 0xce, 0xee, 0xc6, 0xe3, 0x1f, 0x4e,
+	0x3a, 0x4e, 0xb9, 0x6e, 0x66, 0xde, 0xf7, 0x3e, 0x7d, 0xef, 0x77, 0x17, 0xbe, 0xeb, 0x31, 0x8e,
+	0xf7, 0xb1, 0xbf, 0x29, 0x24, 0xee, 0x0e, 0xea, 0x38, 0xa0, 0x75, 0x1c, 0x04, 0x1e, 0xed, 0x62,
+	0x49, 0x99, 0x6f, 0x07, 0x9c, 0x49, 0x66, 0xae, 0x4b, 0xe9, 0xdb, 0x29, 0xd0, 0xbe, 0x7b, 0x7d,
+	0x63, 0xd3, 0xa5, 0x72, 0x6f, 0xd4, 0xb1, 0xbb, 0x6c, 0x58, 0x77, 0x99, 0xcb, 0xea, 0x0a, 0xd6,
+	0x19, 0xf5, 0xd5, 0x4d, 0x5d, 0xd4, 0x29, 0x71, 0xdf, 0xb8, 0xea, 0x32, 0xe6, 0x7a, 0x24, 0x21,
+	0xf7, 0x7d, 0x26, 0x15, 0xb7, 0x48, 0xad, 0x95, 0xd4, 0xfa, 0x92, 0xa3, 0x4f, 0x89, 0xd7, 0x6b,
+	0x0f, 0xb1, 0x18, 0xa4, 0x88, 0xf2, 0x3c, 0x42, 0xd2, 0x21, 0x11, 0x12, 0x0f, 0x83, 0x14, 0xf0,
+	0xde, 0x62, 0x10, 0x5d, 0xe6, 0x4b, 0xdc, 0x95, 0x6d, 0xea, 0xf7, 0x33, 0x19, 0x4b, 0x42, 0xa5,
+	0x3d, 0xe2, 0x4b, 0xda, 0xa7, 0x84, 0x67, 0x6a, 0xd0, 0x22, 0x88, 0x53, 0x77, 0x4f, 0x66, 0xf6,
+	0xb7, 0x17, 0xed, 0xc4, 0x1f, 0x0d, 0x53, 0x73, 0xf5, 0xa7, 0x02, 0x2c, 0x35, 0xa6, 0xf9, 0x33,
+	0x9b, 0x30, 0x4f, 0x7b, 0xc2, 0x02, 0x15, 0x50, 0x2b, 0x6d, 0xbd, 0x6f, 0xcf, 0xe6, 0xd1, 0xd6,
+	0x90, 0xad, 0xa9, 0x92, 0xe6, 0xc5, 0xc3, 0xe3, 0xb2, 0x71, 0x74, 0x5c, 0x06, 0x4e, 0xec, 0x6c,
+	0x6e, 0x43, 0xd8, 0xe5, 0x04, 0x4b, 0xd2, 0x6b, 0x63, 0x69, 0xe5, 0x14, 0xd5, 0x86, 0x9d, 0xe4,
+	0xc4, 0xce, 0x72, 0x62, 0x7f, 0x9d, 0xe5, 0x24, 0x71, 0x7f, 0xf0, 0x57, 0x19, 0x38, 0xc5, 0xd4,
+	0xaf, 0x21, 0x63, 0x92, 0x51, 0xd0, 0xcb, 0x48, 0xf2, 0xa7, 0x21, 0x49, 0xfd, 0x1a, 0xd2, 0x34,
+	0x61, 0xc1, 0xc7, 0x43, 0x62, 0x15, 0x2a, 0xa0, 0x56, 0x74, 0xd4, 0xd9, 0xac, 0xc0, 0x52, 0x8f,
+	0x88, 0x2e, 0xa7, 0x41, 0x1c, 0x86, 0xb5, 0xa2, 0x4c, 0xfa, 0x93, 0xb9, 0x03, 0x21, 0x96, 0x92,
+	0xd3, 0xce, 0x48, 0x12, 0x61, 0xad, 0x56, 0xf2, 0xb5, 0xd2, 0xd6, 0xb5, 0x13, 0x52, 0x61, 0x37,
+	0x5e, 0xa2, 0x3f, 0xf7, 0x25, 0x1f, 0x3b, 0x9a, 0xbb, 0xf9, 0x29, 0x5c, 0xd3, 0x4b, 0x6b, 0x5d,
+	0x50, 0x74, 0x6f, 0xcd, 0xd3, 0x6d, 0x27, 0x98, 0x96, 0xdf, 0x67, 0x4e, 0xa9, 0x3b, 0xbd, 0x98,
+	0xd7, 0xe0, 0x8a, 0x90, 0x58, 0x12, 0xeb, 0x62, 0x05, 0xd4, 0xd6, 0xb7, 0xae, 0xcc, 0x3b, 0xde,
+	0x8e, 0x8d, 0x4e, 0x82, 0xd9, 0xf8, 0x04, 0x5e, 0x9e, 0xd3, 0x62, 0xbe, 0x06, 0xf3, 0x03, 0x32,
+	0x56, 0x05, 0x2d, 0x3a, 0xf1, 0xd1, 0x7c, 0x1d, 0xae, 0xdc, 0xc5, 0xde, 0x88, 0xa8, 0xca, 0x14,
+	0x9d, 0xe4, 0xf2, 0x71, 0xee, 0x43, 0x50, 0xdd, 0x85, 0x6b, 0x5a, 0x58, 0xc2, 0xfc, 0x0c, 0xae,
+	0x69, 0xb3, 0x15, 0x77, 0xc5, 0x52, 0xed, 0x9a, 0x8f, 0x33, 0xe3, 0x50, 0xfd, 0x1d, 0xc0, 0x2b,
+	0x37, 0x89, 0xd4, 0x01, 0xe4, 0xfb, 0x11, 0x11, 0xd2, 0xfc, 0x16, 0x5e, 0xd6, 0x90, 0xed, 0xb3,
+	0xf4, 0xdc, 0x3a, 0xd6, 0x11, 0xb1, 0x6a, 0x38, 0x9d, 0xc8, 0xff, 0x6c, 0xbf, 0x1b, 0x31, 0xe4,
+	0x4b, 0x2c, 0x06, 0xcd, 0x42, 0xcc, 0xe4, 0x14, 0xfb, 0xd9, 0x43, 0xf5, 0x6f, 0x00, 0xdf, 0xfc,
+	0x82, 0x0a, 0x5d, 0xb6, 0xc8, 0x74, 0x7f, 0x15, 0x97, 0xd3, 0xf3, 0x70, 0x87, 0x71, 0x2c, 0x19,
+	0x4f, 0x45, 0x6f, 0xce, 0x8b, 0xde, 0xe5, 0x2e, 0xf6, 0xe9, 0x8f, 0xca, 0x77, 0x97, 0xdf, 0x11,
+	0x84, 0x6b, 0xda, 0x9d, 0x19, 0x8a, 0x33, 0xeb, 0x8d, 0x0b, 0xca, 0x78, 0x8f, 0x70, 0x35, 0x25,
+	0x45, 0x27, 0xb9, 0xc4, 0xaf, 0x1e, 0x1d, 0x52, 0xa9, 0x9a, 0xff, 0x92, 0x93, 0x5c, 0xe2, 0x89,
+	0x08, 0xb0, 0x4b, 0x54, 0xdb, 0x5f, 0x72, 0xd4, 0xb9, 0xfa, 0x07, 0x80, 0xd6, 0xb6, 0x1a, 0xbc,
+	0x25, 0x85, 0xba, 0x09, 0x4b, 0x5a, 0x7e, 0xd3, 0x78, 0x4f, 0x6a, 0x01, 0xad, 0x32, 0xba, 0xa7,
+	0xf9, 0xcd, 0x5c, 0xe6, 0x72, 0xff, 0x23, 0x73, 0x69, 0xec, 0x33, 0x44, 0xd5, 0xdf, 0x00, 0xb4,
+	0xee, 0xa8, 0x91, 0x7f, 0x95, 0xf2, 0xcf, 0xdc, 0x55, 0x07, 0x39, 0x88, 0x16, 0xb2, 0xdc, 0xb8,
+	0xd5, 0xda, 0x21, 0xe3, 0x73, 0x18, 0x8a, 0x6c, 0x13, 0xe6, 0xb4, 0x4d, 0xb8, 0x09, 0x57, 0x93,
+	0x4f, 0x85, 0x95, 0xaf, 0xe4, 0x97, 0xed, 0x16, 0x27, 0xb6, 0x3a, 0x29, 0x28, 0xce, 0x00, 0xf9,
+	0x21, 0xa0, 0x9c, 0x88, 0x36, 0x4e, 0xba, 0xea, 0xe4, 0x8d, 0x5c, 0x48, 0xb6, 0x71, 0xea, 0xd3,
+	0x90, 0x66, 0x1d, 0x96, 0xb0, 0xe7, 0xb1, 0x7d, 0xd2, 0x6b, 0xd3, 0x40, 0x58, 0x2b, 0x95, 0x7c,
+	0xad, 0xd8, 0x5c, 0x0f, 0x8f, 0xcb, 0xb0, 0x91, 0x3c, 0xb7, 0x6e, 0x09, 0x07, 0xa6, 0x90, 0x56,
+	0x20, 0xaa, 0xff, 0x00, 0x88, 0x16, 0x2a, 0x7b, 0x6e, 0x29, 0xfb, 0x08, 0x5e, 0xc0, 0x01, 0x6d,
+	0xc7, 0xdb, 0x33, 0x29, 0xf7, 0x1b, 0x0b, 0x94, 0x4a, 0x8a, 0x46, 0xb1, 0x8a, 0x03, 0xba, 0x43,
+	0xc6, 0x73, 0xcd, 0x92, 0x3f, 0x7d, 0xb3, 0xfc, 0x09, 0xe0, 0x3b, 0xb7, 0x67, 0x16, 0xe7, 0xb6,
+	0xd6, 0xf2, 0xe7, 0x10, 0xfc, 0x8d, 0xa5, 0xd3, 0x7a, 0x75, 0xf1, 0xb3, 0x35, 0xc5, 0x2c, 0x1b,
+	0xce, 0xe6, 0xaf, 0xe0, 0x70, 0x82, 0xc0, 0xd1, 0x04, 0x81, 0x27, 0x13, 0x64, 0x3c, 0x9d, 0x20,
+	0xe3, 0xd9, 0x04, 0x19, 0xcf, 0x27, 0xc8, 0x78, 0x31, 0x41, 0xe0, 0x5e, 0x88, 0xc0, 0xfd, 0x10,
+	0x19, 0x0f, 0x43, 0x04, 0x0e, 0x42, 0x64, 0x3c, 0x0a, 0x91, 0xf1, 0x38, 0x44, 0xc6, 0x61, 0x88,
+	0xc0, 0x51, 0x88, 0xc0, 0x93, 0x10, 0x19, 0x4f, 0x43, 0x04, 0x9e, 0x85, 0xc8, 0x78, 0x1e, 0x22,
+	0xf0, 0x22, 0x44, 0xc6, 0xbd, 0x08, 0x19, 0xf7, 0x23, 0x04, 0x1e, 0x44, 0xc8, 0xf8, 0x39, 0x42,
+	0xe0, 0x97, 0x08, 0x19, 0x0f, 0x23, 0x64, 0x1c, 0x44, 0x08, 0x3c, 0x8a, 0x10, 0x78, 0x1c, 0x21,
+	0xf0, 0xdd, 0x07, 0x2e, 0xb3, 0xe5, 0x1e, 0x91, 0x7b, 0xd4, 0x77, 0x85, 0xed, 0x13, 0xb9, 0xcf,
+	0xf8, 0xa0, 0x3e, 0xfb, 0x2b, 0x14, 0x0c, 0xdc, 0xba, 0x94, 0x7e, 0xd0, 0xe9, 0xac, 0xaa, 0x92,
+	0x5c, 0xff, 0x77, 0x00, 0x56, 0xaf, 0x0d, 0x78, 0x5c, 0x0a, 0x00, 0x00,
 }
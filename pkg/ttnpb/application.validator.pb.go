@@ -101,6 +101,9 @@ func (this *UpdateApplicationAPIKeyRequest) Validate() error {
 	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.APIKey)); err != nil {
 		return github_com_mwitkow_go_proto_validators.FieldError("APIKey", err)
 	}
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.FieldMask)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("FieldMask", err)
+	}
 	return nil
 }
 func (this *SetApplicationCollaboratorRequest) Validate() error {
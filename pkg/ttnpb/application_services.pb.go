@@ -52,6 +52,11 @@ type ApplicationRegistryClient interface {
 	List(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*Applications, error)
 	Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*Application, error)
 	Delete(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Restore a deleted application. A deleted application can be restored until it is purged.
+	Restore(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Purge the application. This permanently deletes the application and releases its IDs.
+	// This is only available to admins.
+	Purge(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type applicationRegistryClient struct {
@@ -107,6 +112,24 @@ func (c *applicationRegistryClient) Delete(ctx context.Context, in *ApplicationI
 	return out, nil
 }
 
+func (c *applicationRegistryClient) Restore(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationRegistry/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationRegistryClient) Purge(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationRegistry/Purge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationRegistryServer is the server API for ApplicationRegistry service.
 type ApplicationRegistryServer interface {
 	// Create a new application. This also sets the given organization or user as
@@ -120,6 +143,11 @@ type ApplicationRegistryServer interface {
 	List(context.Context, *ListApplicationsRequest) (*Applications, error)
 	Update(context.Context, *UpdateApplicationRequest) (*Application, error)
 	Delete(context.Context, *ApplicationIdentifiers) (*types.Empty, error)
+	// Restore a deleted application. A deleted application can be restored until it is purged.
+	Restore(context.Context, *ApplicationIdentifiers) (*types.Empty, error)
+	// Purge the application. This permanently deletes the application and releases its IDs.
+	// This is only available to admins.
+	Purge(context.Context, *ApplicationIdentifiers) (*types.Empty, error)
 }
 
 func RegisterApplicationRegistryServer(s *grpc.Server, srv ApplicationRegistryServer) {
@@ -216,6 +244,42 @@ func _ApplicationRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationRegistry_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationRegistryServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationRegistry/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationRegistryServer).Restore(ctx, req.(*ApplicationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationRegistry_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationRegistryServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationRegistry/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationRegistryServer).Purge(ctx, req.(*ApplicationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.ApplicationRegistry",
 	HandlerType: (*ApplicationRegistryServer)(nil),
@@ -240,6 +304,14 @@ var _ApplicationRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _ApplicationRegistry_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _ApplicationRegistry_Purge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/application_services.proto",
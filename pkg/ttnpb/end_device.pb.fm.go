@@ -886,18 +886,24 @@ func (dst *EndDeviceVersion) SetFields(src *EndDeviceVersion, paths ...string) e
 }
 
 var MACSettingsFieldPathsNested = []string{
+	"adr_algorithm",
 	"adr_margin",
 	"class_b_timeout",
 	"class_c_timeout",
+	"confirmed_downlink_attempts",
+	"profile_id",
 	"status_count_periodicity",
 	"status_time_periodicity",
 	"use_adr",
 }
 
 var MACSettingsFieldPathsTopLevel = []string{
+	"adr_algorithm",
 	"adr_margin",
 	"class_b_timeout",
 	"class_c_timeout",
+	"confirmed_downlink_attempts",
+	"profile_id",
 	"status_count_periodicity",
 	"status_time_periodicity",
 	"use_adr",
@@ -966,6 +972,36 @@ func (dst *MACSettings) SetFields(src *MACSettings, paths ...string) error {
 				var zero uint32
 				dst.StatusCountPeriodicity = zero
 			}
+		case "profile_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'profile_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ProfileID = src.ProfileID
+			} else {
+				var zero string
+				dst.ProfileID = zero
+			}
+		case "confirmed_downlink_attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'confirmed_downlink_attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ConfirmedDownlinkAttempts = src.ConfirmedDownlinkAttempts
+			} else {
+				var zero uint32
+				dst.ConfirmedDownlinkAttempts = zero
+			}
+		case "adr_algorithm":
+			if len(subs) > 0 {
+				return fmt.Errorf("'adr_algorithm' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ADRAlgorithm = src.ADRAlgorithm
+			} else {
+				var zero string
+				dst.ADRAlgorithm = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1031,6 +1067,7 @@ var MACStateFieldPathsNested = []string{
 	"pending_application_downlink.frm_payload",
 	"pending_application_downlink.priority",
 	"pending_application_downlink.session_key_id",
+	"pending_application_downlink_attempts",
 	"pending_join_request",
 	"pending_join_request.cf_list",
 	"pending_join_request.cf_list.ch_masks",
@@ -1179,6 +1216,7 @@ var MACStateFieldPathsTopLevel = []string{
 	"last_dev_status_f_cnt_up",
 	"lorawan_version",
 	"pending_application_downlink",
+	"pending_application_downlink_attempts",
 	"pending_join_request",
 	"pending_requests",
 	"ping_slot_periodicity",
@@ -1366,6 +1404,16 @@ func (dst *MACState) SetFields(src *MACState, paths ...string) error {
 				var zero bool
 				dst.RxWindowsAvailable = zero
 			}
+		case "pending_application_downlink_attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'pending_application_downlink_attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PendingApplicationDownlinkAttempts = src.PendingApplicationDownlinkAttempts
+			} else {
+				var zero uint32
+				dst.PendingApplicationDownlinkAttempts = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1564,9 +1612,12 @@ var EndDeviceFieldPathsNested = []string{
 	"lorawan_phy_version",
 	"lorawan_version",
 	"mac_settings",
+	"mac_settings.adr_algorithm",
 	"mac_settings.adr_margin",
 	"mac_settings.class_b_timeout",
 	"mac_settings.class_c_timeout",
+	"mac_settings.confirmed_downlink_attempts",
+	"mac_settings.profile_id",
 	"mac_settings.status_count_periodicity",
 	"mac_settings.status_time_periodicity",
 	"mac_settings.use_adr",
@@ -1627,6 +1678,7 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.pending_application_downlink.frm_payload",
 	"mac_state.pending_application_downlink.priority",
 	"mac_state.pending_application_downlink.session_key_id",
+	"mac_state.pending_application_downlink_attempts",
 	"mac_state.pending_join_request",
 	"mac_state.pending_join_request.cf_list",
 	"mac_state.pending_join_request.cf_list.ch_masks",
@@ -1830,6 +1882,21 @@ var EndDeviceFieldPathsNested = []string{
 	"session.last_f_cnt_up",
 	"session.last_n_f_cnt_down",
 	"session.started_at",
+	"stats",
+	"stats.average_rssi",
+	"stats.average_snr",
+	"stats.battery_percentage",
+	"stats.downlink_count",
+	"stats.downlink_margin",
+	"stats.last_adr_data_rate_index",
+	"stats.last_adr_decided_at",
+	"stats.last_adr_nb_trans",
+	"stats.last_adr_tx_power_index",
+	"stats.last_dev_status_received_at",
+	"stats.last_downlink_scheduled_at",
+	"stats.last_uplink_received_at",
+	"stats.packet_error_rate",
+	"stats.uplink_count",
 	"supports_class_b",
 	"supports_class_c",
 	"supports_join",
@@ -1883,6 +1950,7 @@ var EndDeviceFieldPathsTopLevel = []string{
 	"root_keys",
 	"service_profile_id",
 	"session",
+	"stats",
 	"supports_class_b",
 	"supports_class_c",
 	"supports_join",
@@ -2441,6 +2509,27 @@ func (dst *EndDevice) SetFields(src *EndDevice, paths ...string) error {
 			} else {
 				dst.ProvisioningData = nil
 			}
+		case "stats":
+			if len(subs) > 0 {
+				newDst := dst.Stats
+				if newDst == nil {
+					newDst = &EndDeviceStats{}
+					dst.Stats = newDst
+				}
+				var newSrc *EndDeviceStats
+				if src != nil {
+					newSrc = src.Stats
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Stats = src.Stats
+				} else {
+					dst.Stats = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -2528,9 +2617,12 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
+	"end_device.mac_settings.confirmed_downlink_attempts",
+	"end_device.mac_settings.profile_id",
 	"end_device.mac_settings.status_count_periodicity",
 	"end_device.mac_settings.status_time_periodicity",
 	"end_device.mac_settings.use_adr",
@@ -2591,6 +2683,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
+	"end_device.mac_state.pending_application_downlink_attempts",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
 	"end_device.mac_state.pending_join_request.cf_list.ch_masks",
@@ -2794,6 +2887,21 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.session.last_f_cnt_up",
 	"end_device.session.last_n_f_cnt_down",
 	"end_device.session.started_at",
+	"end_device.stats",
+	"end_device.stats.average_rssi",
+	"end_device.stats.average_snr",
+	"end_device.stats.battery_percentage",
+	"end_device.stats.downlink_count",
+	"end_device.stats.downlink_margin",
+	"end_device.stats.last_adr_data_rate_index",
+	"end_device.stats.last_adr_decided_at",
+	"end_device.stats.last_adr_nb_trans",
+	"end_device.stats.last_adr_tx_power_index",
+	"end_device.stats.last_dev_status_received_at",
+	"end_device.stats.last_downlink_scheduled_at",
+	"end_device.stats.last_uplink_received_at",
+	"end_device.stats.packet_error_rate",
+	"end_device.stats.uplink_count",
 	"end_device.supports_class_b",
 	"end_device.supports_class_c",
 	"end_device.supports_join",
@@ -2891,9 +2999,12 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
+	"end_device.mac_settings.confirmed_downlink_attempts",
+	"end_device.mac_settings.profile_id",
 	"end_device.mac_settings.status_count_periodicity",
 	"end_device.mac_settings.status_time_periodicity",
 	"end_device.mac_settings.use_adr",
@@ -2954,6 +3065,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
+	"end_device.mac_state.pending_application_downlink_attempts",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
 	"end_device.mac_state.pending_join_request.cf_list.ch_masks",
@@ -3157,6 +3269,21 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.session.last_f_cnt_up",
 	"end_device.session.last_n_f_cnt_down",
 	"end_device.session.started_at",
+	"end_device.stats",
+	"end_device.stats.average_rssi",
+	"end_device.stats.average_snr",
+	"end_device.stats.battery_percentage",
+	"end_device.stats.downlink_count",
+	"end_device.stats.downlink_margin",
+	"end_device.stats.last_adr_data_rate_index",
+	"end_device.stats.last_adr_decided_at",
+	"end_device.stats.last_adr_nb_trans",
+	"end_device.stats.last_adr_tx_power_index",
+	"end_device.stats.last_dev_status_received_at",
+	"end_device.stats.last_downlink_scheduled_at",
+	"end_device.stats.last_uplink_received_at",
+	"end_device.stats.packet_error_rate",
+	"end_device.stats.uplink_count",
 	"end_device.supports_class_b",
 	"end_device.supports_class_c",
 	"end_device.supports_join",
@@ -3407,9 +3534,12 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"device.lorawan_phy_version",
 	"device.lorawan_version",
 	"device.mac_settings",
+	"device.mac_settings.adr_algorithm",
 	"device.mac_settings.adr_margin",
 	"device.mac_settings.class_b_timeout",
 	"device.mac_settings.class_c_timeout",
+	"device.mac_settings.confirmed_downlink_attempts",
+	"device.mac_settings.profile_id",
 	"device.mac_settings.status_count_periodicity",
 	"device.mac_settings.status_time_periodicity",
 	"device.mac_settings.use_adr",
@@ -3470,6 +3600,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"device.mac_state.pending_application_downlink.frm_payload",
 	"device.mac_state.pending_application_downlink.priority",
 	"device.mac_state.pending_application_downlink.session_key_id",
+	"device.mac_state.pending_application_downlink_attempts",
 	"device.mac_state.pending_join_request",
 	"device.mac_state.pending_join_request.cf_list",
 	"device.mac_state.pending_join_request.cf_list.ch_masks",
@@ -3673,6 +3804,21 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"device.session.last_f_cnt_up",
 	"device.session.last_n_f_cnt_down",
 	"device.session.started_at",
+	"device.stats",
+	"device.stats.average_rssi",
+	"device.stats.average_snr",
+	"device.stats.battery_percentage",
+	"device.stats.downlink_count",
+	"device.stats.downlink_margin",
+	"device.stats.last_adr_data_rate_index",
+	"device.stats.last_adr_decided_at",
+	"device.stats.last_adr_nb_trans",
+	"device.stats.last_adr_tx_power_index",
+	"device.stats.last_dev_status_received_at",
+	"device.stats.last_downlink_scheduled_at",
+	"device.stats.last_uplink_received_at",
+	"device.stats.packet_error_rate",
+	"device.stats.uplink_count",
 	"device.supports_class_b",
 	"device.supports_class_c",
 	"device.supports_join",
@@ -3730,3 +3876,239 @@ func (dst *SetEndDeviceRequest) SetFields(src *SetEndDeviceRequest, paths ...str
 	}
 	return nil
 }
+
+var EndDeviceStatsFieldPathsNested = []string{
+	"average_rssi",
+	"average_snr",
+	"battery_percentage",
+	"downlink_count",
+	"downlink_margin",
+	"last_adr_data_rate_index",
+	"last_adr_decided_at",
+	"last_adr_nb_trans",
+	"last_adr_tx_power_index",
+	"last_dev_status_received_at",
+	"last_downlink_scheduled_at",
+	"last_uplink_received_at",
+	"packet_error_rate",
+	"uplink_count",
+}
+
+var EndDeviceStatsFieldPathsTopLevel = []string{
+	"average_rssi",
+	"average_snr",
+	"battery_percentage",
+	"downlink_count",
+	"downlink_margin",
+	"last_adr_data_rate_index",
+	"last_adr_decided_at",
+	"last_adr_nb_trans",
+	"last_adr_tx_power_index",
+	"last_dev_status_received_at",
+	"last_downlink_scheduled_at",
+	"last_uplink_received_at",
+	"packet_error_rate",
+	"uplink_count",
+}
+
+func (dst *EndDeviceStats) SetFields(src *EndDeviceStats, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "last_uplink_received_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_uplink_received_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastUplinkReceivedAt = src.LastUplinkReceivedAt
+			} else {
+				dst.LastUplinkReceivedAt = nil
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint64
+				dst.UplinkCount = zero
+			}
+		case "last_downlink_scheduled_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_downlink_scheduled_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastDownlinkScheduledAt = src.LastDownlinkScheduledAt
+			} else {
+				dst.LastDownlinkScheduledAt = nil
+			}
+		case "downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkCount = src.DownlinkCount
+			} else {
+				var zero uint64
+				dst.DownlinkCount = zero
+			}
+		case "packet_error_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'packet_error_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PacketErrorRate = src.PacketErrorRate
+			} else {
+				var zero float32
+				dst.PacketErrorRate = zero
+			}
+		case "average_snr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'average_snr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AverageSNR = src.AverageSNR
+			} else {
+				var zero float32
+				dst.AverageSNR = zero
+			}
+		case "average_rssi":
+			if len(subs) > 0 {
+				return fmt.Errorf("'average_rssi' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AverageRSSI = src.AverageRSSI
+			} else {
+				var zero float32
+				dst.AverageRSSI = zero
+			}
+		case "last_adr_decided_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_adr_decided_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastADRDecidedAt = src.LastADRDecidedAt
+			} else {
+				dst.LastADRDecidedAt = nil
+			}
+		case "last_adr_data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_adr_data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastADRDataRateIndex = src.LastADRDataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.LastADRDataRateIndex = zero
+			}
+		case "last_adr_tx_power_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_adr_tx_power_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastADRTxPowerIndex = src.LastADRTxPowerIndex
+			} else {
+				var zero uint32
+				dst.LastADRTxPowerIndex = zero
+			}
+		case "last_adr_nb_trans":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_adr_nb_trans' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastADRNbTrans = src.LastADRNbTrans
+			} else {
+				var zero uint32
+				dst.LastADRNbTrans = zero
+			}
+		case "last_dev_status_received_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_dev_status_received_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastDevStatusReceivedAt = src.LastDevStatusReceivedAt
+			} else {
+				dst.LastDevStatusReceivedAt = nil
+			}
+		case "battery_percentage":
+			if len(subs) > 0 {
+				return fmt.Errorf("'battery_percentage' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BatteryPercentage = src.BatteryPercentage
+			} else {
+				var zero float32
+				dst.BatteryPercentage = zero
+			}
+		case "downlink_margin":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_margin' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkMargin = src.DownlinkMargin
+			} else {
+				var zero int32
+				dst.DownlinkMargin = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var GetEndDeviceStatsRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"field_mask",
+}
+
+var GetEndDeviceStatsRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"field_mask",
+}
+
+func (dst *GetEndDeviceStatsRequest) SetFields(src *GetEndDeviceStatsRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				newDst := &dst.EndDeviceIdentifiers
+				var newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero github_com_gogo_protobuf_types.FieldMask
+				dst.FieldMask = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -822,9 +822,17 @@ type MACSettings struct {
 	// The interval after which a DevStatusReq MACCommand shall be sent.
 	StatusTimePeriodicity time.Duration `protobuf:"bytes,5,opt,name=status_time_periodicity,json=statusTimePeriodicity,proto3,stdduration" json:"status_time_periodicity"`
 	// Number of uplink messages after which a DevStatusReq MACCommand shall be sent.
-	StatusCountPeriodicity uint32   `protobuf:"varint,6,opt,name=status_count_periodicity,json=statusCountPeriodicity,proto3" json:"status_count_periodicity,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	StatusCountPeriodicity uint32 `protobuf:"varint,6,opt,name=status_count_periodicity,json=statusCountPeriodicity,proto3" json:"status_count_periodicity,omitempty"`
+	// Name of the MAC profile configured in the Network Server, which provides defaults for the MAC settings and parameters of the device.
+	ProfileID string `protobuf:"bytes,7,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	// Maximum number of transmissions of a confirmed application downlink, after which a nack is sent to the Application Server.
+	// If 0, the Network Server default is used.
+	ConfirmedDownlinkAttempts uint32 `protobuf:"varint,8,opt,name=confirmed_downlink_attempts,json=confirmedDownlinkAttempts,proto3" json:"confirmed_downlink_attempts,omitempty"`
+	// Name of the ADR algorithm (margin, loss-aware).
+	// If empty, the algorithm of the MAC profile or the Network Server default is used.
+	ADRAlgorithm         string   `protobuf:"bytes,9,opt,name=adr_algorithm,json=adrAlgorithm,proto3" json:"adr_algorithm,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MACSettings) Reset()      { *m = MACSettings{} }
@@ -901,6 +909,27 @@ func (m *MACSettings) GetStatusCountPeriodicity() uint32 {
 	return 0
 }
 
+func (m *MACSettings) GetProfileID() string {
+	if m != nil {
+		return m.ProfileID
+	}
+	return ""
+}
+
+func (m *MACSettings) GetConfirmedDownlinkAttempts() uint32 {
+	if m != nil {
+		return m.ConfirmedDownlinkAttempts
+	}
+	return 0
+}
+
+func (m *MACSettings) GetADRAlgorithm() string {
+	if m != nil {
+		return m.ADRAlgorithm
+	}
+	return ""
+}
+
 // MACState represents the state of MAC layer of the device.
 // MACState is reset on each join for OTAA or ResetInd for ABP devices.
 // This is used internally by the Network Server and is read only.
@@ -939,9 +968,11 @@ type MACState struct {
 	// Whether or not Rx windows are expected to be open.
 	// Set to true every time an uplink is received.
 	// Set to false every time a successful downlink scheduling attempt is made.
-	RxWindowsAvailable   bool     `protobuf:"varint,13,opt,name=rx_windows_available,json=rxWindowsAvailable,proto3" json:"rx_windows_available,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RxWindowsAvailable bool `protobuf:"varint,13,opt,name=rx_windows_available,json=rxWindowsAvailable,proto3" json:"rx_windows_available,omitempty"`
+	// Number of transmissions of the pending application downlink.
+	PendingApplicationDownlinkAttempts uint32   `protobuf:"varint,14,opt,name=pending_application_downlink_attempts,json=pendingApplicationDownlinkAttempts,proto3" json:"pending_application_downlink_attempts,omitempty"`
+	XXX_NoUnkeyedLiteral               struct{} `json:"-"`
+	XXX_sizecache                      int32    `json:"-"`
 }
 
 func (m *MACState) Reset()      { *m = MACState{} }
@@ -1067,6 +1098,13 @@ func (m *MACState) GetRxWindowsAvailable() bool {
 	return false
 }
 
+func (m *MACState) GetPendingApplicationDownlinkAttempts() uint32 {
+	if m != nil {
+		return m.PendingApplicationDownlinkAttempts
+	}
+	return 0
+}
+
 type MACState_JoinAccept struct {
 	// Payload of the join-accept received from Join Server.
 	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
@@ -1270,9 +1308,11 @@ type EndDevice struct {
 	// ID of the provisioner. Stored in Join Server.
 	ProvisionerID string `protobuf:"bytes,45,opt,name=provisioner_id,json=provisionerId,proto3" json:"provisioner_id,omitempty"`
 	// Vendor-specific provisioning data. Stored in Join Server.
-	ProvisioningData     *types.Struct `protobuf:"bytes,46,opt,name=provisioning_data,json=provisioningData,proto3" json:"provisioning_data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	ProvisioningData *types.Struct `protobuf:"bytes,46,opt,name=provisioning_data,json=provisioningData,proto3" json:"provisioning_data,omitempty"`
+	// Traffic statistics of the device. Stored in Network Server.
+	Stats                *EndDeviceStats `protobuf:"bytes,47,opt,name=stats,json=stats" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *EndDevice) Reset()      { *m = EndDevice{} }
@@ -1615,6 +1655,13 @@ func (m *EndDevice) GetProvisioningData() *types.Struct {
 	return nil
 }
 
+func (m *EndDevice) GetStats() *EndDeviceStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
 type EndDevices struct {
 	EndDevices           []*EndDevice `protobuf:"bytes,1,rep,name=end_devices,json=endDevices,proto3" json:"end_devices,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -1917,6 +1964,217 @@ func (m *SetEndDeviceRequest) GetFieldMask() types.FieldMask {
 	return types.FieldMask{}
 }
 
+// EndDeviceStats contains traffic statistics of an end device, maintained by the Network Server.
+type EndDeviceStats struct {
+	// Time when the last uplink message was received.
+	LastUplinkReceivedAt *time.Time `protobuf:"bytes,1,opt,name=last_uplink_received_at,json=lastUplinkReceivedAt,stdtime" json:"last_uplink_received_at,omitempty"`
+	// Number of uplink messages received.
+	UplinkCount uint64 `protobuf:"varint,2,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Time when the last downlink message was scheduled.
+	LastDownlinkScheduledAt *time.Time `protobuf:"bytes,3,opt,name=last_downlink_scheduled_at,json=lastDownlinkScheduledAt,stdtime" json:"last_downlink_scheduled_at,omitempty"`
+	// Number of downlink messages scheduled.
+	DownlinkCount uint64 `protobuf:"varint,4,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
+	// Fraction of uplink messages lost, derived from the FCnt gaps in the recent uplink messages.
+	PacketErrorRate float32 `protobuf:"fixed32,5,opt,name=packet_error_rate,json=packetErrorRate,proto3" json:"packet_error_rate,omitempty"`
+	// Average of the best signal-to-noise ratio (dB) of the recent uplink messages.
+	AverageSNR float32 `protobuf:"fixed32,6,opt,name=average_snr,json=averageSnr,proto3" json:"average_snr,omitempty"`
+	// Average of the best received signal strength indicator (dBm) of the recent uplink messages.
+	AverageRSSI float32 `protobuf:"fixed32,7,opt,name=average_rssi,json=averageRssi,proto3" json:"average_rssi,omitempty"`
+	// Time when the last ADR decision was made.
+	LastADRDecidedAt *time.Time `protobuf:"bytes,8,opt,name=last_adr_decided_at,json=lastAdrDecidedAt,stdtime" json:"last_adr_decided_at,omitempty"`
+	// Data rate index of the last ADR decision.
+	LastADRDataRateIndex DataRateIndex `protobuf:"varint,9,opt,name=last_adr_data_rate_index,json=lastAdrDataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"last_adr_data_rate_index,omitempty"`
+	// Tx power index of the last ADR decision.
+	LastADRTxPowerIndex uint32 `protobuf:"varint,10,opt,name=last_adr_tx_power_index,json=lastAdrTxPowerIndex,proto3" json:"last_adr_tx_power_index,omitempty"`
+	// Number of retransmissions of the last ADR decision.
+	LastADRNbTrans uint32 `protobuf:"varint,11,opt,name=last_adr_nb_trans,json=lastAdrNbTrans,proto3" json:"last_adr_nb_trans,omitempty"`
+	// Time when the last DevStatus MAC command was received.
+	LastDevStatusReceivedAt *time.Time `protobuf:"bytes,12,opt,name=last_dev_status_received_at,json=lastDevStatusReceivedAt,stdtime" json:"last_dev_status_received_at,omitempty"`
+	// Battery percentage of the device, as received in the last DevStatus MAC command.
+	BatteryPercentage float32 `protobuf:"fixed32,13,opt,name=battery_percentage,json=batteryPercentage,proto3" json:"battery_percentage,omitempty"`
+	// Demodulation signal-to-noise ratio (dB), as received in the last DevStatus MAC command.
+	DownlinkMargin       int32    `protobuf:"varint,14,opt,name=downlink_margin,json=downlinkMargin,proto3" json:"downlink_margin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EndDeviceStats) Reset()      { *m = EndDeviceStats{} }
+func (*EndDeviceStats) ProtoMessage() {}
+func (*EndDeviceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{15}
+}
+func (m *EndDeviceStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *EndDeviceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceStats.Merge(dst, src)
+}
+func (m *EndDeviceStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceStats proto.InternalMessageInfo
+
+func (m *EndDeviceStats) GetLastUplinkReceivedAt() *time.Time {
+	if m != nil {
+		return m.LastUplinkReceivedAt
+	}
+	return nil
+}
+
+func (m *EndDeviceStats) GetUplinkCount() uint64 {
+	if m != nil {
+		return m.UplinkCount
+	}
+	return 0
+}
+
+func (m *EndDeviceStats) GetLastDownlinkScheduledAt() *time.Time {
+	if m != nil {
+		return m.LastDownlinkScheduledAt
+	}
+	return nil
+}
+
+func (m *EndDeviceStats) GetDownlinkCount() uint64 {
+	if m != nil {
+		return m.DownlinkCount
+	}
+	return 0
+}
+
+func (m *EndDeviceStats) GetPacketErrorRate() float32 {
+	if m != nil {
+		return m.PacketErrorRate
+	}
+	return 0
+}
+
+func (m *EndDeviceStats) GetAverageSNR() float32 {
+	if m != nil {
+		return m.AverageSNR
+	}
+	return 0
+}
+
+func (m *EndDeviceStats) GetAverageRSSI() float32 {
+	if m != nil {
+		return m.AverageRSSI
+	}
+	return 0
+}
+
+func (m *EndDeviceStats) GetLastADRDecidedAt() *time.Time {
+	if m != nil {
+		return m.LastADRDecidedAt
+	}
+	return nil
+}
+
+func (m *EndDeviceStats) GetLastADRDataRateIndex() DataRateIndex {
+	if m != nil {
+		return m.LastADRDataRateIndex
+	}
+	return DATA_RATE_0
+}
+
+func (m *EndDeviceStats) GetLastADRTxPowerIndex() uint32 {
+	if m != nil {
+		return m.LastADRTxPowerIndex
+	}
+	return 0
+}
+
+func (m *EndDeviceStats) GetLastADRNbTrans() uint32 {
+	if m != nil {
+		return m.LastADRNbTrans
+	}
+	return 0
+}
+
+func (m *EndDeviceStats) GetLastDevStatusReceivedAt() *time.Time {
+	if m != nil {
+		return m.LastDevStatusReceivedAt
+	}
+	return nil
+}
+
+func (m *EndDeviceStats) GetBatteryPercentage() float32 {
+	if m != nil {
+		return m.BatteryPercentage
+	}
+	return 0
+}
+
+func (m *EndDeviceStats) GetDownlinkMargin() int32 {
+	if m != nil {
+		return m.DownlinkMargin
+	}
+	return 0
+}
+
+type GetEndDeviceStatsRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// The paths are relative to EndDeviceStats. If no paths are specified, all statistics are returned.
+	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetEndDeviceStatsRequest) Reset()      { *m = GetEndDeviceStatsRequest{} }
+func (*GetEndDeviceStatsRequest) ProtoMessage() {}
+func (*GetEndDeviceStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_end_device_f8ea6acb7b9cd33a, []int{16}
+}
+func (m *GetEndDeviceStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEndDeviceStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEndDeviceStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetEndDeviceStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEndDeviceStatsRequest.Merge(dst, src)
+}
+func (m *GetEndDeviceStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEndDeviceStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEndDeviceStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEndDeviceStatsRequest proto.InternalMessageInfo
+
+func (m *GetEndDeviceStatsRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 func init() {
 	proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
 	golang_proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
@@ -1958,6 +2216,10 @@ func init() {
 	golang_proto.RegisterType((*SetEndDeviceRequest)(nil), "ttn.lorawan.v3.SetEndDeviceRequest")
 	proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
+	proto.RegisterType((*EndDeviceStats)(nil), "ttn.lorawan.v3.EndDeviceStats")
+	golang_proto.RegisterType((*EndDeviceStats)(nil), "ttn.lorawan.v3.EndDeviceStats")
+	proto.RegisterType((*GetEndDeviceStatsRequest)(nil), "ttn.lorawan.v3.GetEndDeviceStatsRequest")
+	golang_proto.RegisterType((*GetEndDeviceStatsRequest)(nil), "ttn.lorawan.v3.GetEndDeviceStatsRequest")
 }
 func (x PowerState) String() string {
 	s, ok := PowerState_name[int32(x)]
@@ -2336,6 +2598,15 @@ func (this *MACSettings) Equal(that interface{}) bool {
 	if this.StatusCountPeriodicity != that1.StatusCountPeriodicity {
 		return false
 	}
+	if this.ProfileID != that1.ProfileID {
+		return false
+	}
+	if this.ConfirmedDownlinkAttempts != that1.ConfirmedDownlinkAttempts {
+		return false
+	}
+	if this.ADRAlgorithm != that1.ADRAlgorithm {
+		return false
+	}
 	return true
 }
 func (this *MACState) Equal(that interface{}) bool {
@@ -2410,6 +2681,9 @@ func (this *MACState) Equal(that interface{}) bool {
 	if this.RxWindowsAvailable != that1.RxWindowsAvailable {
 		return false
 	}
+	if this.PendingApplicationDownlinkAttempts != that1.PendingApplicationDownlinkAttempts {
+		return false
+	}
 	return true
 }
 func (this *MACState_JoinAccept) Equal(that interface{}) bool {
@@ -2642,6 +2916,9 @@ func (this *EndDevice) Equal(that interface{}) bool {
 	if !this.ProvisioningData.Equal(that1.ProvisioningData) {
 		return false
 	}
+	if !this.Stats.Equal(that1.Stats) {
+		return false
+	}
 	return true
 }
 func (this *EndDevices) Equal(that interface{}) bool {
@@ -2814,6 +3091,113 @@ func (this *SetEndDeviceRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *EndDeviceStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceStats)
+	if !ok {
+		that2, ok := that.(EndDeviceStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.LastUplinkReceivedAt == nil {
+		if this.LastUplinkReceivedAt != nil {
+			return false
+		}
+	} else if !this.LastUplinkReceivedAt.Equal(*that1.LastUplinkReceivedAt) {
+		return false
+	}
+	if this.UplinkCount != that1.UplinkCount {
+		return false
+	}
+	if that1.LastDownlinkScheduledAt == nil {
+		if this.LastDownlinkScheduledAt != nil {
+			return false
+		}
+	} else if !this.LastDownlinkScheduledAt.Equal(*that1.LastDownlinkScheduledAt) {
+		return false
+	}
+	if this.DownlinkCount != that1.DownlinkCount {
+		return false
+	}
+	if this.PacketErrorRate != that1.PacketErrorRate {
+		return false
+	}
+	if this.AverageSNR != that1.AverageSNR {
+		return false
+	}
+	if this.AverageRSSI != that1.AverageRSSI {
+		return false
+	}
+	if that1.LastADRDecidedAt == nil {
+		if this.LastADRDecidedAt != nil {
+			return false
+		}
+	} else if !this.LastADRDecidedAt.Equal(*that1.LastADRDecidedAt) {
+		return false
+	}
+	if this.LastADRDataRateIndex != that1.LastADRDataRateIndex {
+		return false
+	}
+	if this.LastADRTxPowerIndex != that1.LastADRTxPowerIndex {
+		return false
+	}
+	if this.LastADRNbTrans != that1.LastADRNbTrans {
+		return false
+	}
+	if that1.LastDevStatusReceivedAt == nil {
+		if this.LastDevStatusReceivedAt != nil {
+			return false
+		}
+	} else if !this.LastDevStatusReceivedAt.Equal(*that1.LastDevStatusReceivedAt) {
+		return false
+	}
+	if this.BatteryPercentage != that1.BatteryPercentage {
+		return false
+	}
+	if this.DownlinkMargin != that1.DownlinkMargin {
+		return false
+	}
+	return true
+}
+
+func (this *GetEndDeviceStatsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetEndDeviceStatsRequest)
+	if !ok {
+		that2, ok := that.(GetEndDeviceStatsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
 func (m *Session) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3396,10 +3780,27 @@ func (m *MACSettings) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.StatusCountPeriodicity))
 	}
-	return i, nil
-}
-
-func (m *MACState) Marshal() (dAtA []byte, err error) {
+	if len(m.ProfileID) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ProfileID)))
+		i += copy(dAtA[i:], m.ProfileID)
+	}
+	if m.ConfirmedDownlinkAttempts != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.ConfirmedDownlinkAttempts))
+	}
+	if len(m.ADRAlgorithm) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ADRAlgorithm)))
+		i += copy(dAtA[i:], m.ADRAlgorithm)
+	}
+	return i, nil
+}
+
+func (m *MACState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3524,6 +3925,11 @@ func (m *MACState) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.PendingApplicationDownlinkAttempts != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.PendingApplicationDownlinkAttempts))
+	}
 	return i, nil
 }
 
@@ -4052,6 +4458,18 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n34
 	}
+	if m.Stats != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Stats.Size()))
+		n115, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n115
+	}
 	return i, nil
 }
 
@@ -4263,6 +4681,152 @@ func (m *SetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *EndDeviceStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.LastUplinkReceivedAt != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUplinkReceivedAt)))
+		n101, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUplinkReceivedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n101
+	}
+	if m.UplinkCount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.UplinkCount))
+	}
+	if m.LastDownlinkScheduledAt != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkScheduledAt)))
+		n103, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkScheduledAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n103
+	}
+	if m.DownlinkCount != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DownlinkCount))
+	}
+	if m.PacketErrorRate != 0 {
+		dAtA[i] = 0x2d
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.PacketErrorRate))))
+		i += 4
+	}
+	if m.AverageSNR != 0 {
+		dAtA[i] = 0x35
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.AverageSNR))))
+		i += 4
+	}
+	if m.AverageRSSI != 0 {
+		dAtA[i] = 0x3d
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.AverageRSSI))))
+		i += 4
+	}
+	if m.LastADRDecidedAt != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastADRDecidedAt)))
+		n108, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastADRDecidedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n108
+	}
+	if m.LastADRDataRateIndex != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastADRDataRateIndex))
+	}
+	if m.LastADRTxPowerIndex != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastADRTxPowerIndex))
+	}
+	if m.LastADRNbTrans != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastADRNbTrans))
+	}
+	if m.LastDevStatusReceivedAt != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt)))
+		n112, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n112
+	}
+	if m.BatteryPercentage != 0 {
+		dAtA[i] = 0x6d
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.BatteryPercentage))))
+		i += 4
+	}
+	if m.DownlinkMargin != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DownlinkMargin))
+	}
+	return i, nil
+}
+
+func (m *GetEndDeviceStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEndDeviceStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDeviceIdentifiers.Size()))
+	n116, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n116
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintEndDevice(dAtA, i, uint64(m.FieldMask.Size()))
+	n117, err := m.FieldMask.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n117
+	return i, nil
+}
+
 func encodeVarintEndDevice(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -4336,6 +4900,9 @@ func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
 	v7 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
 	this.StatusTimePeriodicity = *v7
 	this.StatusCountPeriodicity = r.Uint32()
+	this.ProfileID = randStringEndDevice(r)
+	this.ConfirmedDownlinkAttempts = r.Uint32()
+	this.ADRAlgorithm = randStringEndDevice(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4427,6 +4994,61 @@ func NewPopulatedSetEndDeviceRequest(r randyEndDevice, easy bool) *SetEndDeviceR
 	return this
 }
 
+func NewPopulatedEndDeviceStats(r randyEndDevice, easy bool) *EndDeviceStats {
+	this := &EndDeviceStats{}
+	if r.Intn(10) != 0 {
+		this.LastUplinkReceivedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.UplinkCount = uint64(uint64(r.Uint32()))
+	if r.Intn(10) != 0 {
+		this.LastDownlinkScheduledAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.DownlinkCount = uint64(uint64(r.Uint32()))
+	this.PacketErrorRate = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.PacketErrorRate *= -1
+	}
+	this.AverageSNR = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.AverageSNR *= -1
+	}
+	this.AverageRSSI = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.AverageRSSI *= -1
+	}
+	if r.Intn(10) != 0 {
+		this.LastADRDecidedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.LastADRDataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.LastADRTxPowerIndex = r.Uint32()
+	this.LastADRNbTrans = r.Uint32()
+	if r.Intn(10) != 0 {
+		this.LastDevStatusReceivedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.BatteryPercentage = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.BatteryPercentage *= -1
+	}
+	this.DownlinkMargin = int32(r.Int31())
+	if r.Intn(2) == 0 {
+		this.DownlinkMargin *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetEndDeviceStatsRequest(r randyEndDevice, easy bool) *GetEndDeviceStatsRequest {
+	this := &GetEndDeviceStatsRequest{}
+	v15 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v15
+	v16 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v16
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyEndDevice interface {
 	Float32() float32
 	Float64() float64
@@ -4770,6 +5392,17 @@ func (m *MACSettings) Size() (n int) {
 	if m.StatusCountPeriodicity != 0 {
 		n += 1 + sovEndDevice(uint64(m.StatusCountPeriodicity))
 	}
+	l = len(m.ProfileID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.ConfirmedDownlinkAttempts != 0 {
+		n += 1 + sovEndDevice(uint64(m.ConfirmedDownlinkAttempts))
+	}
+	l = len(m.ADRAlgorithm)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -4826,6 +5459,9 @@ func (m *MACState) Size() (n int) {
 	if m.RxWindowsAvailable {
 		n += 2
 	}
+	if m.PendingApplicationDownlinkAttempts != 0 {
+		n += 1 + sovEndDevice(uint64(m.PendingApplicationDownlinkAttempts))
+	}
 	return n
 }
 
@@ -5037,6 +5673,10 @@ func (m *EndDevice) Size() (n int) {
 		l = m.ProvisioningData.Size()
 		n += 2 + l + sovEndDevice(uint64(l))
 	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -5128,6 +5768,74 @@ func (m *SetEndDeviceRequest) Size() (n int) {
 	return n
 }
 
+func (m *EndDeviceStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastUplinkReceivedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUplinkReceivedAt)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.UplinkCount != 0 {
+		n += 1 + sovEndDevice(uint64(m.UplinkCount))
+	}
+	if m.LastDownlinkScheduledAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkScheduledAt)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.DownlinkCount != 0 {
+		n += 1 + sovEndDevice(uint64(m.DownlinkCount))
+	}
+	if m.PacketErrorRate != 0 {
+		n += 5
+	}
+	if m.AverageSNR != 0 {
+		n += 5
+	}
+	if m.AverageRSSI != 0 {
+		n += 5
+	}
+	if m.LastADRDecidedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastADRDecidedAt)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.LastADRDataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.LastADRDataRateIndex))
+	}
+	if m.LastADRTxPowerIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.LastADRTxPowerIndex))
+	}
+	if m.LastADRNbTrans != 0 {
+		n += 1 + sovEndDevice(uint64(m.LastADRNbTrans))
+	}
+	if m.LastDevStatusReceivedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	if m.BatteryPercentage != 0 {
+		n += 5
+	}
+	if m.DownlinkMargin != 0 {
+		n += 1 + sovEndDevice(uint64(m.DownlinkMargin))
+	}
+	return n
+}
+
+func (m *GetEndDeviceStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovEndDevice(uint64(l))
+	return n
+}
+
 func sovEndDevice(x uint64) (n int) {
 	for {
 		n++
@@ -5272,6 +5980,9 @@ func (this *MACSettings) String() string {
 		`ClassCTimeout:` + strings.Replace(strings.Replace(this.ClassCTimeout.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
 		`StatusTimePeriodicity:` + strings.Replace(strings.Replace(this.StatusTimePeriodicity.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
 		`StatusCountPeriodicity:` + fmt.Sprintf("%v", this.StatusCountPeriodicity) + `,`,
+		`ProfileID:` + fmt.Sprintf("%v", this.ProfileID) + `,`,
+		`ConfirmedDownlinkAttempts:` + fmt.Sprintf("%v", this.ConfirmedDownlinkAttempts) + `,`,
+		`ADRAlgorithm:` + fmt.Sprintf("%v", this.ADRAlgorithm) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5294,6 +6005,7 @@ func (this *MACState) String() string {
 		`QueuedJoinAccept:` + strings.Replace(fmt.Sprintf("%v", this.QueuedJoinAccept), "MACState_JoinAccept", "MACState_JoinAccept", 1) + `,`,
 		`PendingJoinRequest:` + strings.Replace(fmt.Sprintf("%v", this.PendingJoinRequest), "JoinRequest", "JoinRequest", 1) + `,`,
 		`RxWindowsAvailable:` + fmt.Sprintf("%v", this.RxWindowsAvailable) + `,`,
+		`PendingApplicationDownlinkAttempts:` + fmt.Sprintf("%v", this.PendingApplicationDownlinkAttempts) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5381,6 +6093,7 @@ func (this *EndDevice) String() string {
 		`Formatters:` + strings.Replace(fmt.Sprintf("%v", this.Formatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1) + `,`,
 		`ProvisionerID:` + fmt.Sprintf("%v", this.ProvisionerID) + `,`,
 		`ProvisioningData:` + strings.Replace(fmt.Sprintf("%v", this.ProvisioningData), "Struct", "types.Struct", 1) + `,`,
+		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "EndDeviceStats", "EndDeviceStats", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5452,6 +6165,41 @@ func (this *SetEndDeviceRequest) String() string {
 	}, "")
 	return s
 }
+func (this *EndDeviceStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceStats{`,
+		`LastUplinkReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastUplinkReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`UplinkCount:` + fmt.Sprintf("%v", this.UplinkCount) + `,`,
+		`LastDownlinkScheduledAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDownlinkScheduledAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
+		`PacketErrorRate:` + fmt.Sprintf("%v", this.PacketErrorRate) + `,`,
+		`AverageSNR:` + fmt.Sprintf("%v", this.AverageSNR) + `,`,
+		`AverageRSSI:` + fmt.Sprintf("%v", this.AverageRSSI) + `,`,
+		`LastADRDecidedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastADRDecidedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastADRDataRateIndex:` + fmt.Sprintf("%v", this.LastADRDataRateIndex) + `,`,
+		`LastADRTxPowerIndex:` + fmt.Sprintf("%v", this.LastADRTxPowerIndex) + `,`,
+		`LastADRNbTrans:` + fmt.Sprintf("%v", this.LastADRNbTrans) + `,`,
+		`LastDevStatusReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDevStatusReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`BatteryPercentage:` + fmt.Sprintf("%v", this.BatteryPercentage) + `,`,
+		`DownlinkMargin:` + fmt.Sprintf("%v", this.DownlinkMargin) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *GetEndDeviceStatsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetEndDeviceStatsRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(this.EndDeviceIdentifiers.String(), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringEndDevice(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -7282,17 +8030,94 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileID", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfileID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedDownlinkAttempts", wireType)
+			}
+			m.ConfirmedDownlinkAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmedDownlinkAttempts |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAlgorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ADRAlgorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -7682,6 +8507,25 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RxWindowsAvailable = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingApplicationDownlinkAttempts", wireType)
+			}
+			m.PendingApplicationDownlinkAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingApplicationDownlinkAttempts |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -9316,6 +10160,39 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &EndDeviceStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -10005,6 +10882,457 @@ func (m *SetEndDeviceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EndDeviceStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUplinkReceivedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUplinkReceivedAt == nil {
+				m.LastUplinkReceivedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUplinkReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkCount", wireType)
+			}
+			m.UplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDownlinkScheduledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastDownlinkScheduledAt == nil {
+				m.LastDownlinkScheduledAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDownlinkScheduledAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
+			}
+			m.DownlinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketErrorRate", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.PacketErrorRate = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageSNR", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.AverageSNR = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageRSSI", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.AverageRSSI = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastADRDecidedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastADRDecidedAt == nil {
+				m.LastADRDecidedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastADRDecidedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastADRDataRateIndex", wireType)
+			}
+			m.LastADRDataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastADRDataRateIndex |= (DataRateIndex(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastADRTxPowerIndex", wireType)
+			}
+			m.LastADRTxPowerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastADRTxPowerIndex |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastADRNbTrans", wireType)
+			}
+			m.LastADRNbTrans = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastADRNbTrans |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDevStatusReceivedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastDevStatusReceivedAt == nil {
+				m.LastDevStatusReceivedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDevStatusReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatteryPercentage", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.BatteryPercentage = float32(math.Float32frombits(v))
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMargin", wireType)
+			}
+			m.DownlinkMargin = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkMargin |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GetEndDeviceStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEndDeviceStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEndDeviceStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEndDevice(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10118,220 +11446,244 @@ func init() {
 }
 
 var fileDescriptor_end_device_f8ea6acb7b9cd33a = []byte{
-	// 3434 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4d, 0x6c, 0x1c, 0xc7,
-	0x95, 0x9e, 0x1e, 0x52, 0xe4, 0x4c, 0x0d, 0xe7, 0xaf, 0x48, 0x49, 0x6d, 0xda, 0xee, 0xa1, 0x29,
-	0xd9, 0xa1, 0x1d, 0x71, 0x28, 0x51, 0xf6, 0xc6, 0x51, 0xb2, 0x90, 0x67, 0x38, 0x54, 0x4c, 0x9b,
-	0xa2, 0xb8, 0x25, 0xc9, 0x5a, 0xc7, 0x91, 0x1b, 0xc5, 0xe9, 0xe2, 0xb0, 0xcd, 0x99, 0xee, 0x4e,
-	0x55, 0x0d, 0x39, 0xdc, 0x1f, 0x20, 0x97, 0x05, 0x72, 0x4b, 0x16, 0xd8, 0x05, 0x72, 0x59, 0x20,
-	0x58, 0xec, 0x02, 0xc1, 0x62, 0x0f, 0x39, 0xfa, 0x98, 0xa3, 0x8f, 0x3e, 0x06, 0x39, 0x8c, 0xa2,
-	0xe1, 0x25, 0xc7, 0x00, 0x7b, 0xc9, 0x71, 0x51, 0x3f, 0xfd, 0x33, 0x3f, 0x94, 0x49, 0x7b, 0xbd,
-	0x17, 0xa2, 0xfb, 0xbd, 0xef, 0x7d, 0xfd, 0xaa, 0x5e, 0xd5, 0xab, 0xf7, 0x6a, 0x08, 0x96, 0xdb,
-	0x3e, 0xc5, 0xc7, 0xd8, 0x5b, 0x65, 0x1c, 0x37, 0x0f, 0xd7, 0x70, 0xe0, 0xae, 0x11, 0xcf, 0xb1,
-	0x1d, 0x72, 0xe4, 0x36, 0x49, 0x35, 0xa0, 0x3e, 0xf7, 0x61, 0x81, 0x73, 0xaf, 0xaa, 0x71, 0xd5,
-	0xa3, 0xdb, 0x8b, 0xab, 0x2d, 0x97, 0x1f, 0x74, 0xf7, 0xaa, 0x4d, 0xbf, 0xb3, 0xd6, 0xf2, 0x5b,
-	0xfe, 0x9a, 0x84, 0xed, 0x75, 0xf7, 0xe5, 0x9b, 0x7c, 0x91, 0x4f, 0xca, 0x7c, 0xf1, 0xaf, 0x12,
-	0xf0, 0xce, 0xb1, 0xcb, 0x0f, 0xfd, 0xe3, 0xb5, 0x96, 0xbf, 0x2a, 0x95, 0xab, 0x47, 0xb8, 0xed,
-	0x3a, 0x98, 0xfb, 0x94, 0xad, 0x45, 0x8f, 0xda, 0xee, 0x95, 0x96, 0xef, 0xb7, 0xda, 0x44, 0xfa,
-	0x84, 0x3d, 0xcf, 0xe7, 0x98, 0xbb, 0xbe, 0xc7, 0xb4, 0xd6, 0xd2, 0xda, 0xe8, 0xdb, 0x4e, 0x97,
-	0x4a, 0x80, 0xd6, 0x2f, 0x8d, 0xea, 0xf7, 0x5d, 0xd2, 0x76, 0xec, 0x0e, 0x66, 0x87, 0x23, 0xfc,
-	0x11, 0x82, 0x71, 0xda, 0x6d, 0x72, 0xad, 0xad, 0x8c, 0x6a, 0xb9, 0xdb, 0x21, 0x8c, 0xe3, 0x4e,
-	0xa0, 0x01, 0xd7, 0xc6, 0x67, 0xce, 0x75, 0x88, 0xc7, 0xdd, 0x7d, 0x97, 0xd0, 0xd0, 0xcb, 0x57,
-	0xc6, 0x41, 0x9f, 0xf9, 0xae, 0x77, 0xb6, 0xf6, 0x90, 0x9c, 0x84, 0xb6, 0x95, 0x71, 0x6d, 0x18,
-	0x04, 0x3d, 0xc4, 0x71, 0x40, 0x87, 0x30, 0x86, 0x5b, 0x84, 0xbd, 0x08, 0xc1, 0xb1, 0x83, 0x39,
-	0x56, 0x88, 0xe5, 0x5f, 0x4c, 0x81, 0xd9, 0x87, 0x84, 0x31, 0xd7, 0xf7, 0xe0, 0x13, 0x90, 0x71,
-	0xc8, 0x91, 0x8d, 0x1d, 0x87, 0x9a, 0xe9, 0x25, 0x63, 0x65, 0xae, 0xfe, 0xc3, 0x2f, 0xfa, 0x95,
-	0xd4, 0x1f, 0xfa, 0x95, 0xb7, 0x5b, 0x7e, 0x95, 0x1f, 0x10, 0x7e, 0xe0, 0x7a, 0x2d, 0x56, 0xf5,
-	0x08, 0x3f, 0xf6, 0xe9, 0xe1, 0xda, 0x30, 0x79, 0x70, 0xd8, 0x5a, 0xe3, 0x27, 0x01, 0x61, 0xd5,
-	0x06, 0x39, 0xaa, 0x39, 0x0e, 0x45, 0xb3, 0x8e, 0x7a, 0x80, 0xdf, 0x07, 0xd3, 0x62, 0x5c, 0xe6,
-	0xd4, 0x92, 0xb1, 0x92, 0x5b, 0x7f, 0xb9, 0x3a, 0xbc, 0x9e, 0xaa, 0xfa, 0xfb, 0x1f, 0x92, 0x13,
-	0x56, 0xcf, 0x88, 0x2f, 0x7e, 0xd9, 0xaf, 0x18, 0x48, 0x9a, 0xc0, 0xd7, 0x40, 0xbe, 0x8d, 0x19,
-	0xb7, 0xf7, 0xed, 0xa6, 0xc7, 0xed, 0x6e, 0x60, 0x4e, 0x2f, 0x19, 0x2b, 0x79, 0x04, 0x84, 0xf0,
-	0xde, 0x86, 0xc7, 0x1f, 0x07, 0x70, 0x05, 0x94, 0x25, 0xc4, 0xd3, 0x20, 0xc7, 0x3f, 0xf6, 0xcc,
-	0x4b, 0x12, 0x26, 0x6d, 0x77, 0x04, 0xae, 0xe1, 0x1f, 0x7b, 0x11, 0x12, 0x27, 0x91, 0x33, 0x31,
-	0xb2, 0x16, 0x21, 0xab, 0x60, 0x41, 0x22, 0x9b, 0xbe, 0xb7, 0x9f, 0x04, 0xcf, 0x4a, 0x70, 0x49,
-	0xe8, 0x36, 0x7c, 0x6f, 0x3f, 0xc2, 0x6f, 0x00, 0xc0, 0x38, 0xa6, 0x9c, 0x38, 0x36, 0xe6, 0x66,
-	0x46, 0x8e, 0x73, 0xb1, 0xaa, 0x96, 0x50, 0x35, 0x5c, 0x42, 0xd5, 0x47, 0xe1, 0x12, 0x52, 0xc3,
-	0xfc, 0xe5, 0xb3, 0x8a, 0x81, 0xb2, 0xda, 0xae, 0xc6, 0x3f, 0x98, 0xce, 0x18, 0xa5, 0xf4, 0xf2,
-	0xb3, 0x1c, 0xc8, 0xdf, 0xaf, 0x6d, 0xec, 0x62, 0x8a, 0x3b, 0x84, 0x13, 0xca, 0xe0, 0x1b, 0x20,
-	0xd3, 0xc1, 0x3d, 0x9b, 0xb8, 0x34, 0x30, 0x8d, 0x25, 0x63, 0x25, 0x5d, 0xcf, 0x0d, 0xfa, 0x95,
-	0xd9, 0xfb, 0xb8, 0xb7, 0xb9, 0x85, 0x76, 0xd1, 0x6c, 0x07, 0xf7, 0x36, 0x5d, 0x1a, 0xc0, 0xb7,
-	0x40, 0xb9, 0x1b, 0xb4, 0x5d, 0xef, 0xd0, 0x76, 0x8e, 0x49, 0xbb, 0x6d, 0x8b, 0x15, 0x2b, 0x03,
-	0x99, 0x41, 0x45, 0xa5, 0x68, 0x08, 0xb9, 0xf0, 0x02, 0x56, 0xc1, 0xbc, 0x18, 0xd0, 0x28, 0x7a,
-	0x4a, 0xa2, 0xcb, 0xa1, 0x2a, 0xc6, 0xef, 0x81, 0x79, 0xec, 0x50, 0x5b, 0xac, 0x1c, 0x9b, 0x62,
-	0x4e, 0x6c, 0xd7, 0x73, 0x48, 0x4f, 0x46, 0xa3, 0xb0, 0xfe, 0xea, 0x68, 0x44, 0x1b, 0x98, 0x63,
-	0x84, 0x39, 0xd9, 0x12, 0xa0, 0xfa, 0xc2, 0xa0, 0x5f, 0x29, 0xd5, 0x1a, 0x68, 0x48, 0x8a, 0x4a,
-	0xd8, 0xa1, 0x43, 0x12, 0xf8, 0x1e, 0x80, 0xe2, 0x1b, 0xbc, 0x67, 0x07, 0xfe, 0x31, 0xa1, 0xfa,
-	0x13, 0x32, 0x92, 0xf5, 0xf9, 0x41, 0xbf, 0x52, 0xac, 0x35, 0xd0, 0xa3, 0xde, 0xae, 0xd0, 0x29,
-	0x8a, 0x22, 0x76, 0x68, 0x52, 0x00, 0x6f, 0x82, 0x39, 0xc1, 0xe0, 0xed, 0xd9, 0x9c, 0x62, 0x8f,
-	0xa9, 0xd8, 0xd6, 0x0b, 0x83, 0x7e, 0x05, 0xd4, 0x1a, 0x68, 0x67, 0xef, 0x91, 0x90, 0x22, 0x80,
-	0x1d, 0xaa, 0x9f, 0xe1, 0x6d, 0x90, 0x17, 0x16, 0xb8, 0x79, 0x68, 0xb7, 0xdd, 0x8e, 0xcb, 0x55,
-	0x84, 0xeb, 0xc5, 0x41, 0xbf, 0x92, 0xab, 0x35, 0x50, 0xad, 0x79, 0xb8, 0x2d, 0xc4, 0x28, 0x87,
-	0x1d, 0x1a, 0xbe, 0x24, 0x8d, 0x1c, 0xd2, 0xc6, 0x27, 0x32, 0xe0, 0x43, 0x46, 0x0d, 0x21, 0x0e,
-	0x8d, 0xe4, 0x0b, 0x7c, 0x1b, 0x64, 0x69, 0xef, 0x96, 0x36, 0xc8, 0xca, 0x79, 0xbb, 0x3a, 0x3a,
-	0x6f, 0xa8, 0xa7, 0x0c, 0x33, 0xb4, 0x77, 0x4b, 0x59, 0xad, 0x81, 0x05, 0x69, 0x15, 0xcd, 0xbb,
-	0xbf, 0xbf, 0xcf, 0x08, 0x37, 0x81, 0x5c, 0x88, 0x65, 0x81, 0xd3, 0x73, 0xf8, 0x40, 0x2a, 0xe0,
-	0x36, 0x98, 0xa7, 0xbd, 0xf5, 0xb1, 0x40, 0xe5, 0xce, 0x11, 0x28, 0x54, 0xa2, 0xbd, 0xf5, 0xe1,
-	0x90, 0x5c, 0x03, 0x79, 0xc1, 0xb6, 0x4f, 0xc9, 0x4f, 0xbb, 0xc4, 0x6b, 0x9e, 0x98, 0x73, 0x4b,
-	0xc6, 0xca, 0x34, 0x9a, 0xa3, 0xbd, 0xf5, 0x7b, 0xa1, 0x0c, 0xfe, 0x18, 0x5c, 0xa5, 0x44, 0xa4,
-	0x35, 0xb9, 0x86, 0xec, 0x80, 0x50, 0xd7, 0x77, 0xdc, 0xa6, 0xcb, 0x4f, 0xcc, 0xbc, 0xfc, 0xec,
-	0xf2, 0xd8, 0x38, 0x25, 0x5c, 0x2c, 0xac, 0xcd, 0x5e, 0xe0, 0x7b, 0xc4, 0xe3, 0xe8, 0x32, 0x8d,
-	0x64, 0xbb, 0x31, 0x01, 0x7c, 0x0a, 0x4c, 0xcd, 0xdd, 0xf4, 0xbb, 0x1e, 0x1f, 0x22, 0x2f, 0x48,
-	0xf2, 0x6b, 0x93, 0xc9, 0x37, 0x04, 0x3c, 0x62, 0xbf, 0x42, 0x63, 0x61, 0x92, 0x7e, 0x0b, 0x14,
-	0xc4, 0xd6, 0x72, 0xba, 0xfc, 0xc4, 0x6e, 0x9e, 0x34, 0xdb, 0xc4, 0x2c, 0x4e, 0x26, 0xad, 0xb5,
-	0x5a, 0x94, 0xb4, 0x30, 0x27, 0x4e, 0xa3, 0xcb, 0x4f, 0x36, 0x04, 0x14, 0xcd, 0x75, 0x70, 0x2f,
-	0x7a, 0x83, 0x35, 0x90, 0x69, 0x1e, 0x60, 0xcf, 0x23, 0x6d, 0x66, 0x96, 0x96, 0xa6, 0x56, 0x72,
-	0xeb, 0xaf, 0x8f, 0x92, 0x0c, 0x6d, 0xeb, 0xea, 0x86, 0x42, 0xa3, 0xc8, 0x4c, 0x6c, 0xca, 0xc0,
-	0xf5, 0x5a, 0x36, 0x6b, 0xfb, 0x3c, 0x31, 0xe7, 0x65, 0x39, 0xe7, 0x65, 0xa1, 0x7a, 0xd8, 0xf6,
-	0x79, 0x3c, 0xf1, 0x4f, 0xc0, 0x4b, 0x31, 0x7e, 0x34, 0xe2, 0xf0, 0x3c, 0x11, 0xbf, 0x1c, 0x92,
-	0x0e, 0x87, 0xfd, 0x4d, 0x50, 0xda, 0x23, 0xb8, 0xe9, 0x7b, 0x09, 0x2f, 0xe6, 0xa5, 0x17, 0x45,
-	0x25, 0x8f, 0x7c, 0x58, 0xfc, 0xaf, 0x34, 0x98, 0xd5, 0x23, 0x11, 0x66, 0x3a, 0x01, 0xc5, 0x66,
-	0x86, 0x32, 0x53, 0xf2, 0xd8, 0xf5, 0x55, 0x00, 0xa3, 0xfc, 0x13, 0x83, 0xd3, 0x6a, 0xa4, 0xa1,
-	0x26, 0x86, 0x6f, 0x83, 0xf9, 0x8e, 0xeb, 0x8d, 0x8d, 0x71, 0xea, 0x5c, 0xab, 0xba, 0xe3, 0x7a,
-	0xc3, 0xc3, 0x13, 0x6c, 0x22, 0xea, 0x5f, 0x23, 0x99, 0xa1, 0x92, 0x08, 0xfa, 0xe8, 0x1e, 0x21,
-	0x1e, 0xde, 0x6b, 0x13, 0x5b, 0x0d, 0x52, 0x66, 0xac, 0x0c, 0x9a, 0x53, 0xc2, 0xc7, 0x52, 0x76,
-	0x67, 0xfa, 0xf3, 0x5f, 0x57, 0x52, 0xea, 0xef, 0x72, 0x07, 0x14, 0x36, 0x3d, 0xa7, 0x21, 0x4b,
-	0xac, 0x3a, 0xc5, 0x9e, 0x03, 0xaf, 0x80, 0xb4, 0xeb, 0xc8, 0xa9, 0xca, 0xd6, 0x67, 0x06, 0xfd,
-	0x4a, 0x7a, 0xab, 0x81, 0xd2, 0xae, 0x03, 0x21, 0x98, 0xf6, 0xb0, 0x4e, 0xe2, 0x59, 0x24, 0x9f,
-	0xe1, 0x4b, 0x60, 0xaa, 0x4b, 0xdb, 0x72, 0xe8, 0xd9, 0xfa, 0xec, 0xa0, 0x5f, 0x99, 0x7a, 0x8c,
-	0xb6, 0x91, 0x90, 0xc1, 0x05, 0x70, 0xa9, 0xed, 0xb7, 0x7c, 0x66, 0x4e, 0x2f, 0x4d, 0xad, 0x64,
-	0x91, 0x7a, 0x59, 0x76, 0x12, 0x9f, 0xbb, 0xef, 0x3b, 0xa4, 0x2d, 0x0e, 0x94, 0x3d, 0xf1, 0x5d,
-	0x3b, 0xfa, 0xa8, 0x3c, 0x50, 0xa4, 0x2f, 0x5b, 0x0d, 0x34, 0x2b, 0x95, 0x5b, 0xa1, 0x5b, 0xe9,
-	0x33, 0xdd, 0x9a, 0x8a, 0xdd, 0x5a, 0xfe, 0xd7, 0x34, 0x78, 0x39, 0xfa, 0xcc, 0x47, 0x84, 0x8a,
-	0x13, 0x7d, 0x2b, 0xae, 0x87, 0xe0, 0x83, 0xb1, 0x6f, 0xbe, 0x9d, 0xf8, 0xe6, 0xe0, 0x59, 0xe5,
-	0x75, 0xf0, 0xda, 0xa7, 0x9f, 0xe0, 0xd5, 0xbf, 0xbb, 0xb9, 0xfa, 0xfd, 0xa7, 0x2b, 0x77, 0xef,
-	0x7c, 0xb2, 0xfa, 0xf4, 0x6e, 0xf8, 0xfa, 0xe6, 0xdf, 0xaf, 0xdf, 0xf8, 0xc7, 0xeb, 0xff, 0xf0,
-	0xe9, 0xf5, 0xde, 0xeb, 0xb1, 0x73, 0x0f, 0x40, 0xa6, 0x23, 0x46, 0x63, 0x47, 0x2e, 0x4a, 0x42,
-	0x39, 0xc2, 0x0b, 0x11, 0x4a, 0x96, 0x2d, 0x47, 0xac, 0xde, 0x03, 0x4c, 0x9d, 0x63, 0x4c, 0x89,
-	0x7d, 0xa4, 0x06, 0xa0, 0x47, 0x58, 0x0c, 0xe5, 0x7a, 0x5c, 0x02, 0xba, 0xef, 0xd2, 0xce, 0x10,
-	0x74, 0x5a, 0x41, 0x43, 0xb9, 0x86, 0x2e, 0xff, 0xcb, 0x2c, 0x28, 0x8d, 0xce, 0x0b, 0xfc, 0x11,
-	0x98, 0x72, 0x1d, 0x26, 0xe7, 0x21, 0xb7, 0xfe, 0xdd, 0xd1, 0x05, 0xf7, 0x82, 0x69, 0x4c, 0xd4,
-	0x47, 0x82, 0x01, 0x3e, 0x01, 0x45, 0x6d, 0x18, 0xf9, 0x91, 0x96, 0xab, 0x78, 0x71, 0x42, 0xee,
-	0xd1, 0x74, 0x75, 0x38, 0xe8, 0x57, 0x0a, 0xdb, 0x3e, 0xc2, 0x4f, 0x6a, 0x3b, 0x5a, 0x86, 0x0a,
-	0x1a, 0x1a, 0x7a, 0x88, 0xc1, 0x7c, 0x48, 0x1c, 0x1c, 0x9c, 0x0c, 0xcd, 0xc7, 0x04, 0xf2, 0xdd,
-	0xf7, 0x3f, 0x0e, 0xc9, 0x2f, 0x0f, 0xfa, 0x95, 0xb2, 0x26, 0x8f, 0xc5, 0xa8, 0xac, 0xd1, 0xbb,
-	0x07, 0x27, 0xe1, 0x27, 0xee, 0x82, 0x72, 0xb4, 0xf3, 0xed, 0xa0, 0x8d, 0x3d, 0x11, 0x49, 0x39,
-	0x8b, 0xea, 0xb4, 0x8f, 0x76, 0xff, 0x6e, 0x1b, 0x7b, 0x5b, 0x0d, 0x54, 0xdc, 0x1f, 0x12, 0x88,
-	0xe5, 0x39, 0x13, 0x1c, 0xf8, 0xdc, 0x67, 0xe6, 0x25, 0xb9, 0xde, 0xf5, 0x1b, 0x5c, 0x01, 0x25,
-	0xd6, 0x0d, 0x02, 0x9f, 0x72, 0x66, 0x37, 0xdb, 0x98, 0x31, 0x7b, 0x4f, 0x56, 0x02, 0x19, 0x54,
-	0x08, 0xe5, 0x1b, 0x42, 0x5c, 0x9f, 0x80, 0x6c, 0xca, 0x02, 0x60, 0x14, 0xb9, 0x01, 0x3b, 0xe0,
-	0x8a, 0x43, 0xf6, 0x71, 0xb7, 0xcd, 0xed, 0x0e, 0x6e, 0xda, 0x41, 0x94, 0xc6, 0x75, 0xb1, 0xf7,
-	0xea, 0x0b, 0x73, 0x7d, 0xdd, 0x1c, 0xf4, 0x2b, 0x0b, 0x0d, 0x45, 0x30, 0xa4, 0x41, 0x0b, 0x9a,
-	0xf6, 0x3e, 0x6e, 0x26, 0x4a, 0xbe, 0x6b, 0x20, 0x2f, 0xf2, 0x5d, 0x9c, 0x19, 0xb3, 0xea, 0xdc,
-	0xed, 0xb8, 0x71, 0xea, 0x95, 0x20, 0xdc, 0x4b, 0x80, 0x80, 0x06, 0xe1, 0x5e, 0x0c, 0x5a, 0x02,
-	0x73, 0x94, 0x30, 0xc2, 0x99, 0x2a, 0x63, 0x65, 0x21, 0x90, 0x41, 0x40, 0xc9, 0x44, 0xfd, 0x0a,
-	0x7f, 0x00, 0xca, 0x5d, 0x46, 0x98, 0x7d, 0x7b, 0xdd, 0xde, 0x73, 0x75, 0xa5, 0x2d, 0xcf, 0xf9,
-	0x4c, 0xbd, 0x3c, 0xe8, 0x57, 0xf2, 0x8f, 0x19, 0x61, 0xb7, 0xd7, 0xeb, 0xae, 0xac, 0xb7, 0x51,
-	0xbe, 0x9b, 0x7c, 0x15, 0x3e, 0x44, 0x33, 0x28, 0x4e, 0x58, 0x79, 0xe2, 0x67, 0xd0, 0x5c, 0x28,
-	0xfc, 0xc0, 0x77, 0x3d, 0x78, 0x03, 0x40, 0xed, 0x83, 0x3c, 0xc9, 0x3d, 0xdf, 0x6b, 0x12, 0x26,
-	0x8f, 0xef, 0x0c, 0x2a, 0x29, 0x8d, 0xc0, 0xed, 0x48, 0x39, 0x7c, 0x0a, 0x60, 0x38, 0xd5, 0xfb,
-	0x3e, 0xed, 0x60, 0x2e, 0xa7, 0xb9, 0x28, 0xa7, 0x79, 0x65, 0x6c, 0x9a, 0x55, 0xc3, 0xb3, 0x8b,
-	0x4f, 0xda, 0x3e, 0x76, 0xee, 0x45, 0xf8, 0xfa, 0xb4, 0xd8, 0x28, 0xa8, 0xac, 0x99, 0x62, 0x85,
-	0xce, 0xc1, 0xff, 0x3c, 0x05, 0x72, 0xf7, 0x6b, 0x1b, 0x0f, 0x09, 0xe7, 0xa2, 0xa7, 0x81, 0xd7,
-	0xc0, 0x6c, 0x97, 0x11, 0x1b, 0x3b, 0x54, 0xee, 0xca, 0x4c, 0x1d, 0x0c, 0xfa, 0x95, 0x99, 0xc7,
-	0x8c, 0xd4, 0x1a, 0x08, 0xcd, 0x74, 0x19, 0xa9, 0x39, 0x14, 0xde, 0x00, 0xa2, 0x74, 0xb4, 0x3b,
-	0x98, 0xb6, 0x5c, 0xb5, 0xd1, 0xf2, 0xf5, 0xfc, 0xa0, 0x5f, 0xc9, 0xd6, 0x1a, 0xe8, 0xbe, 0x14,
-	0xa2, 0x2c, 0x76, 0xa8, 0x7a, 0x84, 0x1f, 0x82, 0xa2, 0x5e, 0x7d, 0xb2, 0x2e, 0xf2, 0xbb, 0x5c,
-	0x37, 0x40, 0x2f, 0x8d, 0x35, 0x06, 0x0d, 0xdd, 0xbb, 0xaa, 0xed, 0xfd, 0x2b, 0xd1, 0x17, 0xe4,
-	0xa5, 0x6d, 0xfd, 0x91, 0xb2, 0x8c, 0xc9, 0x9a, 0x11, 0xd9, 0xf4, 0x45, 0xc9, 0x36, 0x42, 0xb2,
-	0x4f, 0xc0, 0x55, 0xc6, 0x31, 0xef, 0xb2, 0xf1, 0x82, 0xed, 0xd2, 0xf9, 0x49, 0x2f, 0x2b, 0x8e,
-	0xd1, 0x8a, 0xed, 0x5d, 0x60, 0x6a, 0xf2, 0xf1, 0x8a, 0x4d, 0xf5, 0x5a, 0x57, 0x94, 0x7e, 0xb4,
-	0x18, 0x5b, 0xfe, 0xcf, 0x2c, 0xc8, 0x88, 0x98, 0x70, 0xcc, 0x09, 0x44, 0x00, 0x36, 0xbb, 0x94,
-	0x12, 0xc1, 0x10, 0x6f, 0x36, 0xe3, 0x3c, 0x9b, 0x4d, 0x87, 0x5e, 0x9b, 0x27, 0x76, 0x15, 0x12,
-	0x2b, 0x8b, 0xb9, 0x94, 0x38, 0x49, 0xce, 0xf4, 0x05, 0x38, 0xb5, 0x79, 0x82, 0xf3, 0x5d, 0x30,
-	0xa7, 0x2e, 0x4b, 0x54, 0x02, 0xd1, 0x19, 0xf2, 0xf2, 0x28, 0x9b, 0x4c, 0x23, 0x28, 0xa7, 0xa0,
-	0xf2, 0x65, 0x52, 0xee, 0x9e, 0xfe, 0x3f, 0xc9, 0xdd, 0x4f, 0xc1, 0x62, 0xd4, 0xbc, 0xba, 0xb4,
-	0x43, 0x1c, 0x3b, 0x2a, 0xb5, 0x30, 0xd7, 0x11, 0x7e, 0x51, 0x73, 0x3a, 0x2d, 0x1b, 0xd3, 0xab,
-	0x61, 0x93, 0x2b, 0x29, 0x1a, 0x9a, 0xa1, 0xc6, 0xe1, 0x3b, 0xc0, 0x94, 0xf4, 0x0e, 0x39, 0xb2,
-	0x75, 0xa4, 0xa3, 0xee, 0x5c, 0x05, 0x78, 0x5e, 0xe8, 0x1b, 0xe4, 0xe8, 0xa1, 0xd4, 0xea, 0x36,
-	0x1d, 0x81, 0xcb, 0x71, 0xb1, 0x9a, 0x5c, 0x14, 0xb3, 0x72, 0xd0, 0xd6, 0xd8, 0x99, 0xa2, 0x2b,
-	0x53, 0xb5, 0x42, 0xd0, 0x7c, 0x30, 0xf4, 0xae, 0xd6, 0x1a, 0x01, 0xaf, 0x04, 0xc4, 0x73, 0x04,
-	0x2d, 0x0e, 0x82, 0xb6, 0xdb, 0x94, 0x6b, 0x34, 0x1a, 0xae, 0xce, 0xcd, 0xe3, 0xc5, 0x7c, 0x8c,
-	0x0d, 0xc7, 0x85, 0x16, 0x35, 0xd1, 0x04, 0x1d, 0xdc, 0x04, 0xa5, 0x9f, 0x76, 0x49, 0x97, 0x38,
-	0x36, 0x25, 0x2c, 0xf0, 0x3d, 0x46, 0x98, 0x99, 0x95, 0x25, 0xfe, 0xa4, 0x50, 0x6d, 0xf8, 0x9d,
-	0x0e, 0xf6, 0x1c, 0x54, 0x54, 0x36, 0x28, 0x34, 0x11, 0x34, 0xa1, 0xb7, 0x32, 0x3d, 0x33, 0xce,
-	0x4c, 0xf0, 0xd5, 0x34, 0xda, 0x06, 0x69, 0x13, 0xf8, 0x37, 0x00, 0x6a, 0x6f, 0x64, 0x36, 0xc5,
-	0xcd, 0x26, 0x09, 0x54, 0x5e, 0x9f, 0x30, 0xd4, 0x70, 0x3f, 0x55, 0x45, 0x82, 0xad, 0x49, 0x28,
-	0xd2, 0x83, 0x89, 0x25, 0xf0, 0x3e, 0x58, 0x08, 0x3d, 0x93, 0x9c, 0xda, 0x3d, 0x79, 0x0a, 0x4c,
-	0xb8, 0xb0, 0x11, 0x96, 0xda, 0x1d, 0x04, 0xb5, 0x61, 0x42, 0x06, 0x6f, 0x8a, 0xa6, 0xd5, 0x3e,
-	0x76, 0x3d, 0xc7, 0x3f, 0x66, 0x36, 0x3e, 0xc2, 0x6e, 0x5b, 0x94, 0xc2, 0xfa, 0x6c, 0x80, 0xb4,
-	0xf7, 0x44, 0xa9, 0x6a, 0xa1, 0x66, 0xf1, 0x3f, 0x0c, 0x00, 0x12, 0xfe, 0x2c, 0x83, 0xd9, 0x40,
-	0x65, 0x74, 0xb9, 0xe3, 0xe7, 0xea, 0x99, 0xc1, 0xb3, 0xca, 0x74, 0x90, 0xeb, 0xbd, 0x8a, 0x42,
-	0x05, 0xfc, 0x01, 0x98, 0x0d, 0xdd, 0x4c, 0x7f, 0xa5, 0x9b, 0x7a, 0xff, 0x86, 0x16, 0xf0, 0x9d,
-	0xf3, 0xdf, 0x48, 0x29, 0x4b, 0x09, 0xd7, 0x67, 0xc7, 0x3f, 0x99, 0x20, 0x1b, 0xd5, 0x68, 0xf0,
-	0xbd, 0x64, 0x2d, 0x77, 0xfd, 0xcc, 0x5a, 0xee, 0x05, 0x45, 0xdc, 0x06, 0x00, 0x4d, 0x4a, 0xb0,
-	0xbe, 0x3c, 0x4a, 0x5f, 0xe4, 0xf2, 0x48, 0xdb, 0xd5, 0xb8, 0x20, 0xe9, 0x06, 0x4e, 0x48, 0x32,
-	0x75, 0x11, 0x12, 0x6d, 0x57, 0xe3, 0x51, 0x61, 0x3f, 0x9d, 0xe8, 0x37, 0x96, 0x40, 0xce, 0x21,
-	0xac, 0x49, 0xdd, 0x40, 0xec, 0x09, 0x99, 0x3e, 0xb2, 0x28, 0x29, 0x82, 0x5b, 0x00, 0x60, 0xce,
-	0xa9, 0xbb, 0xd7, 0xe5, 0x84, 0x99, 0x33, 0x72, 0x45, 0xbf, 0x79, 0xe6, 0x44, 0x54, 0x6b, 0x11,
-	0x76, 0xd3, 0xe3, 0xf4, 0x04, 0x25, 0x8c, 0xe1, 0x4f, 0x40, 0x4e, 0xe7, 0x42, 0x5b, 0x4c, 0xea,
-	0xec, 0xc5, 0x0b, 0x64, 0x79, 0xd9, 0x13, 0xca, 0x1b, 0x0c, 0x81, 0xa3, 0x10, 0xc3, 0x60, 0x1d,
-	0x40, 0x46, 0xa8, 0x4c, 0xd6, 0x01, 0xf5, 0xf7, 0xdd, 0x36, 0x11, 0x25, 0x67, 0x46, 0x96, 0x9c,
-	0xf2, 0x92, 0xea, 0xa1, 0xd2, 0xee, 0x2a, 0xe5, 0x56, 0x03, 0x95, 0xd8, 0xb0, 0xc4, 0x81, 0x6f,
-	0x83, 0x2b, 0xfa, 0xfe, 0xd3, 0x16, 0x3a, 0x42, 0xe5, 0x7d, 0x29, 0x61, 0x4c, 0x96, 0x68, 0x59,
-	0xb4, 0xa0, 0xb5, 0x0f, 0xa5, 0xb2, 0xa6, 0x74, 0xf0, 0x87, 0x60, 0x31, 0x99, 0xa0, 0x46, 0x2c,
-	0x81, 0xb4, 0x34, 0x13, 0x88, 0x61, 0xeb, 0x2a, 0x98, 0x97, 0xdb, 0x72, 0xc4, 0x2c, 0x27, 0xcd,
-	0xca, 0x42, 0x35, 0x8c, 0xbf, 0x07, 0xb2, 0x6d, 0x5f, 0x11, 0x31, 0x73, 0x4e, 0xc6, 0x63, 0xe5,
-	0xec, 0x78, 0x6c, 0x87, 0x50, 0x15, 0x8e, 0xd8, 0x74, 0x62, 0x21, 0x9d, 0x3f, 0x77, 0x21, 0x5d,
-	0x98, 0x58, 0x48, 0x4f, 0x38, 0xf5, 0x8a, 0xdf, 0x66, 0xc7, 0x52, 0xfa, 0xb6, 0x3b, 0x96, 0xf2,
-	0x05, 0x3a, 0x96, 0xb3, 0xbb, 0x08, 0xf8, 0xff, 0xd2, 0x45, 0xcc, 0x9f, 0xa7, 0x8b, 0x58, 0x38,
-	0x47, 0x17, 0x71, 0xf9, 0x7c, 0x5d, 0xc4, 0x95, 0xaf, 0xdb, 0x45, 0x5c, 0x3d, 0x77, 0x17, 0x61,
-	0x9e, 0xd1, 0x45, 0xbc, 0x03, 0xb2, 0xd4, 0xf7, 0xb9, 0x2d, 0xd3, 0xfc, 0x4b, 0x72, 0x76, 0xcd,
-	0xb1, 0x9b, 0x42, 0xdf, 0xe7, 0x22, 0xc7, 0xa3, 0x0c, 0xd5, 0x4f, 0xf0, 0x23, 0x30, 0xe3, 0x11,
-	0x2e, 0xe2, 0xba, 0x28, 0x0f, 0x9e, 0xbb, 0x7f, 0xe8, 0x57, 0xd6, 0x2f, 0xf4, 0xeb, 0xc7, 0x0e,
-	0xe1, 0x5b, 0x8d, 0x41, 0xbf, 0x72, 0x49, 0x3e, 0xa0, 0x4b, 0x1e, 0xe1, 0xf2, 0xb6, 0x62, 0x4e,
-	0x44, 0x9c, 0xe9, 0x7e, 0xc3, 0x7c, 0x79, 0xf2, 0xc1, 0x93, 0x68, 0x49, 0xd4, 0x75, 0x72, 0x42,
-	0x80, 0x72, 0x1d, 0xdc, 0x8c, 0x1a, 0x96, 0x0d, 0x90, 0x95, 0x84, 0xe2, 0x70, 0x37, 0x5f, 0x99,
-	0x3c, 0xbe, 0xf0, 0xf0, 0xaf, 0xcf, 0x0d, 0xfa, 0x95, 0xa8, 0xb4, 0x46, 0x19, 0xc1, 0x23, 0x8b,
-	0xec, 0x5b, 0x60, 0x96, 0xa9, 0xa3, 0xce, 0x7c, 0x55, 0x52, 0x5c, 0x3d, 0xe3, 0x24, 0x44, 0x21,
-	0x0e, 0xbe, 0x07, 0xc2, 0x82, 0xc4, 0x0e, 0x4d, 0xad, 0x17, 0x9b, 0x16, 0x34, 0x3e, 0xfc, 0x99,
-	0xe9, 0x3a, 0x28, 0x44, 0xf5, 0xa3, 0x0c, 0xa2, 0x59, 0x91, 0x55, 0xe3, 0x9c, 0xae, 0x1a, 0x65,
-	0x00, 0xe1, 0x1b, 0xa0, 0xd8, 0x65, 0xc4, 0x89, 0x51, 0xcc, 0x5c, 0x5a, 0x9a, 0x5a, 0xc9, 0xcb,
-	0xa5, 0xe3, 0x84, 0x30, 0x26, 0x70, 0x92, 0x2d, 0x5e, 0x13, 0xe6, 0x6b, 0xf1, 0x2f, 0x3a, 0xd1,
-	0x82, 0x80, 0xdf, 0xd3, 0x38, 0xfa, 0x99, 0xee, 0x4b, 0x6e, 0x9a, 0xcb, 0xb2, 0x81, 0x2b, 0x0d,
-	0xfa, 0x95, 0xb9, 0x6d, 0xcc, 0x38, 0xfa, 0x40, 0x76, 0x24, 0x37, 0x95, 0x23, 0xe8, 0x33, 0xf5,
-	0x36, 0x6e, 0x78, 0xcb, 0xbc, 0x36, 0xd1, 0xf0, 0xd6, 0x90, 0xe1, 0x2d, 0xf8, 0x29, 0x78, 0x79,
-	0xb4, 0x4e, 0xa6, 0xa4, 0x49, 0xdc, 0x23, 0x75, 0x44, 0x5f, 0xbf, 0x48, 0x1d, 0x1e, 0x15, 0xd3,
-	0x48, 0x33, 0xd4, 0xc4, 0x8e, 0xcb, 0xa9, 0xdf, 0x49, 0xd4, 0x1a, 0x78, 0xfd, 0x8c, 0x44, 0x27,
-	0x20, 0x2a, 0xee, 0x20, 0x88, 0x9e, 0xe1, 0x2a, 0x80, 0x7b, 0xb2, 0x21, 0x3e, 0x11, 0xb5, 0x78,
-	0x93, 0x78, 0x1c, 0xb7, 0x88, 0xf9, 0xc6, 0x92, 0xb1, 0x92, 0x46, 0x65, 0xad, 0xd9, 0x8d, 0x14,
-	0xf0, 0x3b, 0xa0, 0x18, 0xf5, 0x10, 0xba, 0xfd, 0xfd, 0xce, 0x92, 0xb1, 0x72, 0x09, 0x15, 0x42,
-	0xb1, 0x6e, 0x7a, 0xb1, 0xd8, 0xa4, 0xc2, 0x4a, 0xb4, 0xd2, 0xfa, 0x42, 0x94, 0x99, 0x2b, 0xf2,
-	0x0c, 0x1a, 0xcb, 0x6e, 0xea, 0x6e, 0x54, 0xb7, 0xf0, 0xea, 0x04, 0x46, 0xd2, 0xb8, 0xd6, 0x40,
-	0x4a, 0xc7, 0xc4, 0xce, 0x96, 0x12, 0x87, 0x6a, 0x09, 0x6c, 0x80, 0x82, 0xfe, 0x44, 0x48, 0xff,
-	0xe6, 0x39, 0xe8, 0x51, 0x5e, 0x19, 0x85, 0x2c, 0x1f, 0x00, 0xcd, 0x1c, 0x75, 0x0b, 0xcc, 0x7c,
-	0x4b, 0xf2, 0x54, 0xc6, 0x2e, 0x80, 0xc3, 0x21, 0x6a, 0xa6, 0xa2, 0x32, 0x0c, 0xc5, 0x4c, 0xb4,
-	0x21, 0xba, 0x22, 0x9f, 0xd4, 0x85, 0x30, 0xf3, 0xbb, 0x92, 0xf7, 0x7c, 0x6d, 0x88, 0x22, 0x9a,
-	0xa0, 0x62, 0xf0, 0x7d, 0x00, 0x12, 0x17, 0x22, 0x37, 0x2e, 0x76, 0x21, 0x82, 0x12, 0xb6, 0x10,
-	0x83, 0x42, 0x40, 0xfd, 0x23, 0x57, 0xec, 0x47, 0x42, 0x45, 0xb6, 0x5b, 0x95, 0xa7, 0xd8, 0x1d,
-	0x91, 0xa9, 0x77, 0x63, 0xcd, 0x45, 0xee, 0x51, 0xf3, 0x09, 0xc6, 0x2d, 0x07, 0x36, 0x40, 0x39,
-	0x12, 0x88, 0x64, 0xe1, 0x60, 0x8e, 0xcd, 0xaa, 0xce, 0x14, 0xa3, 0x6b, 0xfe, 0xa1, 0xfc, 0xe5,
-	0x1d, 0x95, 0x92, 0x16, 0x0d, 0xcc, 0xf1, 0xe2, 0x5f, 0x83, 0xe2, 0x48, 0xb9, 0x08, 0x4b, 0x60,
-	0xea, 0x90, 0xa8, 0xdf, 0x15, 0xb2, 0x48, 0x3c, 0xc2, 0x05, 0x70, 0xe9, 0x08, 0xb7, 0xbb, 0xe1,
-	0x35, 0xb9, 0x7a, 0xb9, 0x93, 0x7e, 0xd7, 0x58, 0xfc, 0x08, 0x14, 0x86, 0xab, 0x9b, 0x09, 0xd6,
-	0xd5, 0xa4, 0xf5, 0x84, 0x24, 0x1a, 0x12, 0x24, 0x78, 0x75, 0x1f, 0xf0, 0x3e, 0x00, 0x51, 0x15,
-	0xc5, 0xe0, 0x1d, 0x90, 0x8b, 0xff, 0x73, 0x42, 0xf4, 0x03, 0x53, 0xf2, 0x22, 0xe5, 0xac, 0xb2,
-	0x0b, 0x01, 0x12, 0xd9, 0x2e, 0xff, 0x04, 0x5c, 0xd9, 0x90, 0x95, 0x7c, 0xac, 0xd6, 0x8d, 0x4a,
-	0x1d, 0x80, 0x98, 0x55, 0x37, 0x19, 0x67, 0x93, 0x26, 0x3a, 0x8b, 0x6c, 0x44, 0xbf, 0xfc, 0x6f,
-	0x06, 0xb8, 0xf2, 0x58, 0xd6, 0xf8, 0xdf, 0x06, 0x3d, 0xbc, 0x0b, 0x40, 0xfc, 0xbf, 0x15, 0x67,
-	0xb6, 0x2f, 0xf7, 0x04, 0xe4, 0x3e, 0x66, 0x87, 0xba, 0xa1, 0xca, 0xee, 0x87, 0x82, 0xe5, 0xff,
-	0x36, 0xc0, 0xfc, 0x8f, 0x08, 0x1f, 0x73, 0xee, 0x11, 0x28, 0xc4, 0xce, 0xd9, 0x5f, 0xbf, 0xc9,
-	0x9a, 0x23, 0xb1, 0x9e, 0x7d, 0x73, 0x77, 0xff, 0xc7, 0x00, 0x97, 0xb7, 0x5d, 0x16, 0xfb, 0xcb,
-	0x42, 0x87, 0x3f, 0x06, 0xc5, 0x64, 0x02, 0x88, 0x3d, 0x7e, 0xe3, 0x05, 0x5b, 0x7f, 0xb2, 0xcf,
-	0x05, 0x9c, 0x44, 0x7c, 0x73, 0xaf, 0xc5, 0x26, 0xf1, 0xa9, 0x43, 0xa8, 0xfe, 0x49, 0x43, 0xbd,
-	0xc8, 0x5f, 0x8c, 0xe4, 0xcf, 0xde, 0xea, 0xdf, 0x2a, 0xd4, 0x8b, 0x68, 0x03, 0x03, 0x71, 0x1c,
-	0xa8, 0x7f, 0xa2, 0x90, 0xcf, 0xcb, 0xbf, 0x30, 0xc0, 0xfc, 0xc3, 0x09, 0x41, 0xfa, 0x1e, 0x98,
-	0x39, 0xef, 0xea, 0x51, 0x3e, 0x69, 0xf8, 0x37, 0x1e, 0xd1, 0x5b, 0xf7, 0x00, 0x88, 0x0f, 0x37,
-	0x58, 0x06, 0xf9, 0xdd, 0x07, 0x4f, 0x36, 0x91, 0xfd, 0x78, 0xe7, 0xc3, 0x9d, 0x07, 0x4f, 0x76,
-	0x4a, 0xa9, 0x58, 0x54, 0xaf, 0x3d, 0x7a, 0xb4, 0x89, 0x3e, 0x2e, 0x19, 0x10, 0x82, 0x82, 0x12,
-	0x6d, 0xfe, 0xed, 0xa3, 0x4d, 0xb4, 0x53, 0xdb, 0x2e, 0xa5, 0xeb, 0xff, 0x6e, 0x7c, 0xf1, 0xdc,
-	0x32, 0xbe, 0x7c, 0x6e, 0x19, 0xbf, 0x7f, 0x6e, 0xa5, 0xfe, 0xf8, 0xdc, 0x4a, 0xfd, 0xe9, 0xb9,
-	0x95, 0xfa, 0xf3, 0x73, 0x2b, 0xf5, 0x97, 0xe7, 0x96, 0xf1, 0xb3, 0x81, 0x65, 0xfc, 0x7c, 0x60,
-	0xa5, 0x7e, 0x33, 0xb0, 0x8c, 0xdf, 0x0e, 0xac, 0xd4, 0xe7, 0x03, 0x2b, 0xf5, 0xbb, 0x81, 0x95,
-	0xfa, 0x62, 0x60, 0x19, 0x5f, 0x0e, 0x2c, 0xe3, 0xf7, 0x03, 0x2b, 0xf5, 0xc7, 0x81, 0x65, 0xfc,
-	0x69, 0x60, 0xa5, 0xfe, 0x3c, 0xb0, 0x8c, 0xbf, 0x0c, 0xac, 0xd4, 0xcf, 0x4e, 0xad, 0xd4, 0xcf,
-	0x4f, 0x2d, 0xe3, 0x97, 0xa7, 0x56, 0xea, 0x57, 0xa7, 0x96, 0xf1, 0xeb, 0x53, 0x2b, 0xf5, 0x9b,
-	0x53, 0x2b, 0xf5, 0xdb, 0x53, 0xcb, 0xf8, 0xfc, 0xd4, 0x32, 0x7e, 0x77, 0x6a, 0x19, 0x3f, 0xbe,
-	0x71, 0xde, 0xaa, 0x92, 0x7b, 0xc1, 0xde, 0xde, 0x8c, 0x9c, 0x91, 0xdb, 0xff, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x1f, 0x91, 0x75, 0x03, 0xb0, 0x25, 0x00, 0x00,
+	// 3824 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7a, 0x4b, 0x70, 0x1b, 0xc7,
+	0x99, 0x3f, 0x01, 0x52, 0x22, 0xf0, 0x01, 0x04, 0xc0, 0x26, 0x25, 0x8d, 0x69, 0x1b, 0xa0, 0x29,
+	0xc9, 0xa1, 0x1d, 0x09, 0x94, 0x28, 0xf9, 0x1f, 0x47, 0x49, 0xfe, 0x32, 0x40, 0x50, 0x31, 0x6d,
+	0x89, 0x62, 0x9a, 0x92, 0xb5, 0x8e, 0x23, 0x4f, 0x35, 0x31, 0x4d, 0x70, 0x4c, 0x60, 0x06, 0xe9,
+	0x6e, 0x90, 0xe0, 0x3e, 0xaa, 0x72, 0xcc, 0x2d, 0x39, 0xec, 0x56, 0xe5, 0xb2, 0x55, 0xa9, 0xad,
+	0x3d, 0xa4, 0xb6, 0xf6, 0x90, 0xbd, 0x6c, 0xb9, 0x6a, 0x0f, 0x9b, 0xa3, 0x8f, 0x3e, 0xa6, 0x72,
+	0x80, 0x23, 0xe8, 0x92, 0x63, 0xaa, 0xf6, 0xe2, 0xe3, 0x56, 0x3f, 0xe6, 0x81, 0x07, 0x65, 0x30,
+	0x5e, 0x6f, 0xed, 0x05, 0x85, 0xf9, 0xbe, 0xdf, 0xf7, 0x9b, 0x7e, 0x7e, 0x8f, 0x9e, 0x86, 0x95,
+	0xa6, 0xcf, 0xc8, 0x31, 0xf1, 0xae, 0x73, 0x41, 0xea, 0x87, 0x6b, 0xa4, 0xed, 0xae, 0x51, 0xcf,
+	0xb1, 0x1d, 0x7a, 0xe4, 0xd6, 0x69, 0xb9, 0xcd, 0x7c, 0xe1, 0xa3, 0x9c, 0x10, 0x5e, 0xd9, 0xe0,
+	0xca, 0x47, 0xb7, 0x96, 0xae, 0x37, 0x5c, 0x71, 0xd0, 0xd9, 0x2b, 0xd7, 0xfd, 0xd6, 0x5a, 0xc3,
+	0x6f, 0xf8, 0x6b, 0x0a, 0xb6, 0xd7, 0xd9, 0x57, 0x4f, 0xea, 0x41, 0xfd, 0xd3, 0xe6, 0x4b, 0xff,
+	0x2f, 0x06, 0x6f, 0x1d, 0xbb, 0xe2, 0xd0, 0x3f, 0x5e, 0x6b, 0xf8, 0xd7, 0x95, 0xf2, 0xfa, 0x11,
+	0x69, 0xba, 0x0e, 0x11, 0x3e, 0xe3, 0x6b, 0xe1, 0x5f, 0x63, 0xf7, 0x4a, 0xc3, 0xf7, 0x1b, 0x4d,
+	0xaa, 0xda, 0x44, 0x3c, 0xcf, 0x17, 0x44, 0xb8, 0xbe, 0xc7, 0x8d, 0xb6, 0x68, 0xb4, 0xe1, 0xbb,
+	0x9d, 0x0e, 0x53, 0x00, 0xa3, 0x5f, 0x1e, 0xd6, 0xef, 0xbb, 0xb4, 0xe9, 0xd8, 0x2d, 0xc2, 0x0f,
+	0x87, 0xf8, 0x43, 0x04, 0x17, 0xac, 0x53, 0x17, 0x46, 0x5b, 0x1a, 0xd6, 0x0a, 0xb7, 0x45, 0xb9,
+	0x20, 0xad, 0xb6, 0x01, 0x5c, 0x1e, 0x1d, 0x39, 0xd7, 0xa1, 0x9e, 0x70, 0xf7, 0x5d, 0xca, 0x82,
+	0x56, 0xbe, 0x32, 0x0a, 0xfa, 0xc4, 0x77, 0xbd, 0xd3, 0xb5, 0x87, 0xf4, 0x24, 0xb0, 0x2d, 0x8d,
+	0x6a, 0x83, 0x49, 0x30, 0x5d, 0x1c, 0x05, 0xb4, 0x28, 0xe7, 0xa4, 0x41, 0xf9, 0x8b, 0x10, 0x82,
+	0x38, 0x44, 0x10, 0x8d, 0x58, 0xf9, 0xc5, 0x34, 0xcc, 0xee, 0x52, 0xce, 0x5d, 0xdf, 0x43, 0x4f,
+	0x20, 0xe5, 0xd0, 0x23, 0x9b, 0x38, 0x0e, 0xb3, 0x92, 0xcb, 0x89, 0xd5, 0x6c, 0xf5, 0xfb, 0x9f,
+	0xf5, 0x4a, 0x53, 0x7f, 0xe8, 0x95, 0x6e, 0x37, 0xfc, 0xb2, 0x38, 0xa0, 0xe2, 0xc0, 0xf5, 0x1a,
+	0xbc, 0xec, 0x51, 0x71, 0xec, 0xb3, 0xc3, 0xb5, 0x41, 0xf2, 0xf6, 0x61, 0x63, 0x4d, 0x9c, 0xb4,
+	0x29, 0x2f, 0xd7, 0xe8, 0x51, 0xc5, 0x71, 0x18, 0x9e, 0x75, 0xf4, 0x1f, 0xf4, 0x5d, 0x98, 0x91,
+	0xfd, 0xb2, 0xa6, 0x97, 0x13, 0xab, 0x99, 0xf5, 0x97, 0xcb, 0x83, 0xeb, 0xa9, 0x6c, 0xde, 0xff,
+	0x3e, 0x3d, 0xe1, 0xd5, 0x94, 0x7c, 0xe3, 0xe7, 0xbd, 0x52, 0x02, 0x2b, 0x13, 0xf4, 0x1a, 0xcc,
+	0x35, 0x09, 0x17, 0xf6, 0xbe, 0x5d, 0xf7, 0x84, 0xdd, 0x69, 0x5b, 0x33, 0xcb, 0x89, 0xd5, 0x39,
+	0x0c, 0x52, 0x78, 0x6f, 0xc3, 0x13, 0x8f, 0xdb, 0x68, 0x15, 0xe6, 0x15, 0xc4, 0x33, 0x20, 0xc7,
+	0x3f, 0xf6, 0xac, 0x73, 0x0a, 0xa6, 0x6c, 0xb7, 0x25, 0xae, 0xe6, 0x1f, 0x7b, 0x21, 0x92, 0xc4,
+	0x91, 0xe7, 0x23, 0x64, 0x25, 0x44, 0x96, 0x61, 0x51, 0x21, 0xeb, 0xbe, 0xb7, 0x1f, 0x07, 0xcf,
+	0x2a, 0x70, 0x41, 0xea, 0x36, 0x7c, 0x6f, 0x3f, 0xc4, 0x6f, 0x00, 0x70, 0x41, 0x98, 0xa0, 0x8e,
+	0x4d, 0x84, 0x95, 0x52, 0xfd, 0x5c, 0x2a, 0xeb, 0x25, 0x54, 0x0e, 0x96, 0x50, 0xf9, 0x51, 0xb0,
+	0x84, 0x74, 0x37, 0x7f, 0xf9, 0x45, 0x29, 0x81, 0xd3, 0xc6, 0xae, 0x22, 0xde, 0x9b, 0x49, 0x25,
+	0x0a, 0xc9, 0x95, 0x2f, 0x32, 0x30, 0xf7, 0xa0, 0xb2, 0xb1, 0x43, 0x18, 0x69, 0x51, 0x41, 0x19,
+	0x47, 0xaf, 0x43, 0xaa, 0x45, 0xba, 0x36, 0x75, 0x59, 0xdb, 0x4a, 0x2c, 0x27, 0x56, 0x93, 0xd5,
+	0x4c, 0xbf, 0x57, 0x9a, 0x7d, 0x40, 0xba, 0x9b, 0x5b, 0x78, 0x07, 0xcf, 0xb6, 0x48, 0x77, 0xd3,
+	0x65, 0x6d, 0xf4, 0x26, 0xcc, 0x77, 0xda, 0x4d, 0xd7, 0x3b, 0xb4, 0x9d, 0x63, 0xda, 0x6c, 0xda,
+	0x72, 0xc5, 0xaa, 0x89, 0x4c, 0xe1, 0xbc, 0x56, 0xd4, 0xa4, 0x5c, 0xb6, 0x02, 0x95, 0x61, 0x41,
+	0x76, 0x68, 0x18, 0x3d, 0xad, 0xd0, 0xf3, 0x81, 0x2a, 0xc2, 0xef, 0xc1, 0x02, 0x71, 0x98, 0x2d,
+	0x57, 0x8e, 0xcd, 0x88, 0xa0, 0xb6, 0xeb, 0x39, 0xb4, 0xab, 0x66, 0x23, 0xb7, 0xfe, 0xea, 0xf0,
+	0x8c, 0xd6, 0x88, 0x20, 0x98, 0x08, 0xba, 0x25, 0x41, 0xd5, 0xc5, 0x7e, 0xaf, 0x54, 0xa8, 0xd4,
+	0xf0, 0x80, 0x14, 0x17, 0x88, 0xc3, 0x06, 0x24, 0xe8, 0x1d, 0x40, 0xf2, 0x1d, 0xa2, 0x6b, 0xb7,
+	0xfd, 0x63, 0xca, 0xcc, 0x2b, 0xd4, 0x4c, 0x56, 0x17, 0xfa, 0xbd, 0x52, 0xbe, 0x52, 0xc3, 0x8f,
+	0xba, 0x3b, 0x52, 0xa7, 0x29, 0xf2, 0xc4, 0x61, 0x71, 0x01, 0xba, 0x01, 0x59, 0xc9, 0xe0, 0xed,
+	0xd9, 0x82, 0x11, 0x8f, 0xeb, 0xb9, 0xad, 0xe6, 0xfa, 0xbd, 0x12, 0x54, 0x6a, 0x78, 0x7b, 0xef,
+	0x91, 0x94, 0x62, 0x20, 0x0e, 0x33, 0xff, 0xd1, 0x2d, 0x98, 0x93, 0x16, 0xa4, 0x7e, 0x68, 0x37,
+	0xdd, 0x96, 0x2b, 0xf4, 0x0c, 0x57, 0xf3, 0xfd, 0x5e, 0x29, 0x53, 0xa9, 0xe1, 0x4a, 0xfd, 0xf0,
+	0xbe, 0x14, 0xe3, 0x0c, 0x71, 0x58, 0xf0, 0x10, 0x37, 0x72, 0x68, 0x93, 0x9c, 0x58, 0xa9, 0x61,
+	0xa3, 0x9a, 0x14, 0x07, 0x46, 0xea, 0x01, 0xdd, 0x86, 0x34, 0xeb, 0xde, 0x34, 0x06, 0x69, 0x35,
+	0x6e, 0x97, 0x86, 0xc7, 0x0d, 0x77, 0xb5, 0x61, 0x8a, 0x75, 0x6f, 0x6a, 0xab, 0x35, 0x58, 0x54,
+	0x56, 0xe1, 0xb8, 0xfb, 0xfb, 0xfb, 0x9c, 0x0a, 0x0b, 0xd4, 0x42, 0x9c, 0x97, 0x38, 0x33, 0x86,
+	0x0f, 0x95, 0x02, 0xdd, 0x87, 0x05, 0xd6, 0x5d, 0x1f, 0x99, 0xa8, 0xcc, 0x04, 0x13, 0x85, 0x0b,
+	0xac, 0xbb, 0x3e, 0x38, 0x25, 0x97, 0x61, 0x4e, 0xb2, 0xed, 0x33, 0xfa, 0xd3, 0x0e, 0xf5, 0xea,
+	0x27, 0x56, 0x76, 0x39, 0xb1, 0x3a, 0x83, 0xb3, 0xac, 0xbb, 0x7e, 0x2f, 0x90, 0xa1, 0x1f, 0xc3,
+	0x25, 0x46, 0xa5, 0x5b, 0x53, 0x6b, 0xc8, 0x6e, 0x53, 0xe6, 0xfa, 0x8e, 0x5b, 0x77, 0xc5, 0x89,
+	0x35, 0xa7, 0x5e, 0xbb, 0x32, 0xd2, 0x4f, 0x05, 0x97, 0x0b, 0x6b, 0xb3, 0xdb, 0xf6, 0x3d, 0xea,
+	0x09, 0x7c, 0x81, 0x85, 0xb2, 0x9d, 0x88, 0x00, 0x3d, 0x05, 0xcb, 0x70, 0xd7, 0xfd, 0x8e, 0x27,
+	0x06, 0xc8, 0x73, 0x8a, 0xfc, 0xf2, 0x78, 0xf2, 0x0d, 0x09, 0x0f, 0xd9, 0x2f, 0xb2, 0x48, 0x18,
+	0xa7, 0xdf, 0x82, 0x9c, 0xdc, 0x5a, 0x4e, 0x47, 0x9c, 0xd8, 0xf5, 0x93, 0x7a, 0x93, 0x5a, 0xf9,
+	0xf1, 0xa4, 0x95, 0x46, 0x83, 0xd1, 0x06, 0x11, 0xd4, 0xa9, 0x75, 0xc4, 0xc9, 0x86, 0x84, 0xe2,
+	0x6c, 0x8b, 0x74, 0xc3, 0x27, 0x54, 0x81, 0x54, 0xfd, 0x80, 0x78, 0x1e, 0x6d, 0x72, 0xab, 0xb0,
+	0x3c, 0xbd, 0x9a, 0x59, 0xbf, 0x3a, 0x4c, 0x32, 0xb0, 0xad, 0xcb, 0x1b, 0x1a, 0x8d, 0x43, 0x33,
+	0xb9, 0x29, 0xdb, 0xae, 0xd7, 0xb0, 0x79, 0xd3, 0x17, 0xb1, 0x31, 0x9f, 0x57, 0x63, 0x3e, 0x2f,
+	0x55, 0xbb, 0x4d, 0x5f, 0x44, 0x03, 0xff, 0x04, 0x5e, 0x8a, 0xf0, 0xc3, 0x33, 0x8e, 0x26, 0x99,
+	0xf1, 0x0b, 0x01, 0xe9, 0xe0, 0xb4, 0xbf, 0x01, 0x85, 0x3d, 0x4a, 0xea, 0xbe, 0x17, 0x6b, 0xc5,
+	0x82, 0x6a, 0x45, 0x5e, 0xcb, 0xc3, 0x36, 0x2c, 0xfd, 0x4b, 0x12, 0x66, 0x4d, 0x4f, 0xa4, 0x99,
+	0x71, 0x40, 0x91, 0x59, 0x42, 0x9b, 0x69, 0x79, 0xd4, 0xf4, 0xeb, 0x80, 0x42, 0xff, 0x13, 0x81,
+	0x93, 0xba, 0xa7, 0x81, 0x26, 0x82, 0xdf, 0x87, 0x85, 0x96, 0xeb, 0x8d, 0xf4, 0x71, 0x7a, 0xa2,
+	0x55, 0xdd, 0x72, 0xbd, 0xc1, 0xee, 0x49, 0x36, 0xd2, 0x1d, 0x61, 0x9b, 0x99, 0x8c, 0x8d, 0x74,
+	0x47, 0xf6, 0x08, 0xf5, 0xc8, 0x5e, 0x93, 0xda, 0xba, 0x93, 0xca, 0x63, 0xa5, 0x70, 0x56, 0x0b,
+	0x1f, 0x2b, 0xd9, 0x9d, 0x99, 0x4f, 0x7f, 0x5d, 0x9a, 0xd2, 0xbf, 0x2b, 0x2d, 0xc8, 0x6d, 0x7a,
+	0x4e, 0x4d, 0xa5, 0x58, 0x55, 0x46, 0x3c, 0x07, 0x5d, 0x84, 0xa4, 0xeb, 0xa8, 0xa1, 0x4a, 0x57,
+	0xcf, 0xf7, 0x7b, 0xa5, 0xe4, 0x56, 0x0d, 0x27, 0x5d, 0x07, 0x21, 0x98, 0xf1, 0x88, 0x71, 0xe2,
+	0x69, 0xac, 0xfe, 0xa3, 0x97, 0x60, 0xba, 0xc3, 0x9a, 0xaa, 0xeb, 0xe9, 0xea, 0x6c, 0xbf, 0x57,
+	0x9a, 0x7e, 0x8c, 0xef, 0x63, 0x29, 0x43, 0x8b, 0x70, 0xae, 0xe9, 0x37, 0x7c, 0x6e, 0xcd, 0x2c,
+	0x4f, 0xaf, 0xa6, 0xb1, 0x7e, 0x58, 0x71, 0x62, 0xaf, 0x7b, 0xe0, 0x3b, 0xb4, 0x29, 0x03, 0xca,
+	0x9e, 0x7c, 0xaf, 0x1d, 0xbe, 0x54, 0x05, 0x14, 0xd5, 0x96, 0xad, 0x1a, 0x9e, 0x55, 0xca, 0xad,
+	0xa0, 0x59, 0xc9, 0x53, 0x9b, 0x35, 0x1d, 0x35, 0x6b, 0xe5, 0x1f, 0x92, 0xf0, 0x72, 0xf8, 0x9a,
+	0x0f, 0x28, 0x93, 0x11, 0x7d, 0x2b, 0xca, 0x87, 0xd0, 0xc3, 0x91, 0x77, 0xde, 0x8e, 0xbd, 0xb3,
+	0xff, 0x45, 0xe9, 0x2a, 0xbc, 0xf6, 0xf1, 0x47, 0xe4, 0xfa, 0x5f, 0xdf, 0xb8, 0xfe, 0xdd, 0xa7,
+	0xab, 0x77, 0xef, 0x7c, 0x74, 0xfd, 0xe9, 0xdd, 0xe0, 0xf1, 0x8d, 0xbf, 0x59, 0xbf, 0xf6, 0x77,
+	0x57, 0xfe, 0xf6, 0xe3, 0x2b, 0xdd, 0xab, 0x51, 0xe3, 0x1e, 0x42, 0xaa, 0x25, 0x7b, 0x63, 0x87,
+	0x4d, 0x54, 0x84, 0xaa, 0x87, 0x67, 0x22, 0x54, 0x2c, 0x5b, 0x8e, 0x5c, 0xbd, 0x07, 0x84, 0x39,
+	0xc7, 0x84, 0x51, 0xfb, 0x48, 0x77, 0xc0, 0xf4, 0x30, 0x1f, 0xc8, 0x4d, 0xbf, 0x24, 0x74, 0xdf,
+	0x65, 0xad, 0x01, 0xe8, 0x8c, 0x86, 0x06, 0x72, 0x03, 0x5d, 0xf9, 0xfb, 0x59, 0x28, 0x0c, 0x8f,
+	0x0b, 0xfa, 0x21, 0x4c, 0xbb, 0x0e, 0x57, 0xe3, 0x90, 0x59, 0xff, 0xf6, 0xf0, 0x82, 0x7b, 0xc1,
+	0x30, 0xc6, 0xf2, 0x23, 0xc9, 0x80, 0x9e, 0x40, 0xde, 0x18, 0x86, 0xed, 0x48, 0xaa, 0x55, 0xbc,
+	0x34, 0xc6, 0xf7, 0x18, 0xba, 0x2a, 0xea, 0xf7, 0x4a, 0xb9, 0xfb, 0x3e, 0x26, 0x4f, 0x2a, 0xdb,
+	0x46, 0x86, 0x73, 0x06, 0x1a, 0xb4, 0x90, 0xc0, 0x42, 0x40, 0xdc, 0x3e, 0x38, 0x19, 0x18, 0x8f,
+	0x31, 0xe4, 0x3b, 0xef, 0x7e, 0x18, 0x90, 0x5f, 0xe8, 0xf7, 0x4a, 0xf3, 0x86, 0x3c, 0x12, 0xe3,
+	0x79, 0x83, 0xde, 0x39, 0x38, 0x09, 0x5e, 0x71, 0x17, 0xe6, 0xc3, 0x9d, 0x6f, 0xb7, 0x9b, 0xc4,
+	0x93, 0x33, 0xa9, 0x46, 0x51, 0x47, 0xfb, 0x70, 0xf7, 0xef, 0x34, 0x89, 0xb7, 0x55, 0xc3, 0xf9,
+	0xfd, 0x01, 0x81, 0x5c, 0x9e, 0xe7, 0xdb, 0x07, 0xbe, 0xf0, 0xb9, 0x75, 0x4e, 0xad, 0x77, 0xf3,
+	0x84, 0x56, 0xa1, 0xc0, 0x3b, 0xed, 0xb6, 0xcf, 0x04, 0xb7, 0xeb, 0x4d, 0xc2, 0xb9, 0xbd, 0xa7,
+	0x32, 0x81, 0x14, 0xce, 0x05, 0xf2, 0x0d, 0x29, 0xae, 0x8e, 0x41, 0xd6, 0xad, 0xd9, 0x31, 0xc8,
+	0x0d, 0xd4, 0x82, 0x8b, 0x0e, 0xdd, 0x27, 0x9d, 0xa6, 0xb0, 0x5b, 0xa4, 0x6e, 0xb7, 0x43, 0x37,
+	0x6e, 0x92, 0xbd, 0x57, 0x5f, 0xe8, 0xeb, 0xab, 0x56, 0xbf, 0x57, 0x5a, 0xac, 0x69, 0x82, 0x01,
+	0x0d, 0x5e, 0x34, 0xb4, 0x0f, 0x48, 0x3d, 0x92, 0x4a, 0x9f, 0x22, 0xfd, 0x5d, 0xe4, 0x19, 0xd3,
+	0x3a, 0xee, 0xb6, 0xdc, 0xc8, 0xf5, 0x2a, 0x10, 0xe9, 0xc6, 0x40, 0x60, 0x40, 0xa4, 0x1b, 0x81,
+	0x96, 0x21, 0xcb, 0x28, 0xa7, 0x82, 0xeb, 0x34, 0x56, 0x25, 0x02, 0x29, 0x0c, 0x5a, 0x26, 0xf3,
+	0x57, 0xf4, 0x3d, 0x98, 0xef, 0x70, 0xca, 0xed, 0x5b, 0xeb, 0xf6, 0x9e, 0x6b, 0x32, 0x6d, 0x15,
+	0xe7, 0x53, 0xd5, 0xf9, 0x7e, 0xaf, 0x34, 0xf7, 0x98, 0x53, 0x7e, 0x6b, 0xbd, 0xea, 0xaa, 0x7c,
+	0x1b, 0xcf, 0x75, 0xe2, 0x8f, 0xb2, 0x0d, 0xe1, 0x08, 0xca, 0x08, 0xab, 0x22, 0x7e, 0x0a, 0x67,
+	0x03, 0xe1, 0x7b, 0xbe, 0xeb, 0xa1, 0x6b, 0x80, 0x4c, 0x1b, 0x24, 0xc4, 0xf6, 0x7c, 0xaf, 0x4e,
+	0xb9, 0x0a, 0xdf, 0x29, 0x5c, 0xd0, 0x1a, 0x89, 0xdb, 0x56, 0x72, 0xf4, 0x14, 0x50, 0x30, 0xd4,
+	0xfb, 0x3e, 0x6b, 0x11, 0xa1, 0x86, 0x39, 0xaf, 0x86, 0x79, 0x75, 0x64, 0x98, 0x75, 0xc1, 0xb3,
+	0x43, 0x4e, 0x9a, 0x3e, 0x71, 0xee, 0x85, 0xf8, 0xea, 0x8c, 0xdc, 0x28, 0x78, 0xde, 0x30, 0x45,
+	0x0a, 0xe3, 0x83, 0xff, 0x7d, 0x06, 0x32, 0x0f, 0x2a, 0x1b, 0xbb, 0x54, 0x08, 0x59, 0xd3, 0xa0,
+	0xcb, 0x30, 0xdb, 0xe1, 0xd4, 0x26, 0x0e, 0x53, 0xbb, 0x32, 0x55, 0x85, 0x7e, 0xaf, 0x74, 0xfe,
+	0x31, 0xa7, 0x95, 0x1a, 0xc6, 0xe7, 0x3b, 0x9c, 0x56, 0x1c, 0x86, 0xae, 0x81, 0x4c, 0x1d, 0xed,
+	0x16, 0x61, 0x0d, 0x57, 0x6f, 0xb4, 0xb9, 0xea, 0x5c, 0xbf, 0x57, 0x4a, 0x57, 0x6a, 0xf8, 0x81,
+	0x12, 0xe2, 0x34, 0x71, 0x98, 0xfe, 0x8b, 0xde, 0x87, 0xbc, 0x59, 0x7d, 0x2a, 0x2f, 0xf2, 0x3b,
+	0xc2, 0x14, 0x40, 0x2f, 0x8d, 0x14, 0x06, 0x35, 0x53, 0xbb, 0xea, 0xed, 0xfd, 0x2b, 0x59, 0x17,
+	0xcc, 0x29, 0xdb, 0xea, 0x23, 0x6d, 0x19, 0x91, 0xd5, 0x43, 0xb2, 0x99, 0xb3, 0x92, 0x6d, 0x04,
+	0x64, 0x1f, 0xc1, 0x25, 0x2e, 0x88, 0xe8, 0xf0, 0xd1, 0x84, 0xed, 0xdc, 0xe4, 0xa4, 0x17, 0x34,
+	0xc7, 0x70, 0xc6, 0xf6, 0x36, 0x58, 0x86, 0x7c, 0x34, 0x63, 0xd3, 0xb5, 0xd6, 0x45, 0xad, 0x1f,
+	0x49, 0xc6, 0xae, 0x01, 0xb4, 0x99, 0xbf, 0xef, 0x36, 0xa9, 0xf4, 0x04, 0xb3, 0xca, 0x13, 0xa8,
+	0xe1, 0xdd, 0xd1, 0xd2, 0xad, 0x1a, 0x4e, 0x1b, 0xc0, 0x96, 0x83, 0xfe, 0x3f, 0xbc, 0x2c, 0xab,
+	0x33, 0x97, 0xb5, 0xa8, 0x63, 0x87, 0xb9, 0x84, 0x9c, 0xe4, 0x56, 0x5b, 0xe8, 0x6d, 0x39, 0x87,
+	0x5f, 0x0a, 0x21, 0x35, 0x83, 0xa8, 0x18, 0x00, 0x7a, 0xcb, 0x24, 0xf1, 0xcd, 0x86, 0xcf, 0x5c,
+	0x71, 0xd0, 0x52, 0x5b, 0x2c, 0x5d, 0x2d, 0xf4, 0x7b, 0xa5, 0xac, 0x4c, 0xe2, 0x03, 0x39, 0x96,
+	0x25, 0x45, 0xf8, 0xb4, 0xf2, 0x65, 0x1a, 0x52, 0x72, 0xe1, 0x08, 0x22, 0x28, 0xc2, 0x80, 0xea,
+	0x1d, 0xc6, 0xa8, 0xec, 0x66, 0xe4, 0x11, 0x12, 0x93, 0x78, 0x04, 0xb3, 0x3e, 0x8d, 0x79, 0xa4,
+	0x90, 0x9c, 0x0e, 0xe5, 0x2e, 0xa3, 0x4e, 0x9c, 0x33, 0x79, 0x06, 0x4e, 0x63, 0x1e, 0xe3, 0x7c,
+	0x1b, 0xb2, 0xfa, 0x44, 0x47, 0x7b, 0x39, 0xe3, 0xc6, 0x2f, 0x0c, 0xb3, 0x29, 0x5f, 0x87, 0x33,
+	0x1a, 0xaa, 0x1e, 0xc6, 0x05, 0x98, 0x99, 0xff, 0x91, 0x00, 0xf3, 0x14, 0x96, 0xc2, 0x0a, 0x7b,
+	0x64, 0x0e, 0xad, 0x73, 0x5f, 0x59, 0x41, 0xcf, 0xa8, 0xea, 0xf9, 0x52, 0x50, 0x89, 0x0f, 0xcd,
+	0x31, 0x7a, 0x0b, 0x2c, 0x45, 0x2f, 0x0f, 0x34, 0xcc, 0x72, 0x0c, 0x8f, 0x10, 0xf4, 0x2a, 0x5c,
+	0x90, 0xfa, 0x1a, 0x3d, 0xda, 0x55, 0x5a, 0x73, 0x96, 0x80, 0xe1, 0x42, 0x94, 0x51, 0xc7, 0x57,
+	0xee, 0xac, 0xea, 0x74, 0x71, 0x24, 0xf0, 0x99, 0xf4, 0x59, 0x2f, 0x63, 0xbc, 0xd0, 0x1e, 0x78,
+	0xd6, 0xcb, 0x9a, 0xc2, 0x2b, 0x6d, 0xea, 0x39, 0x92, 0x96, 0xb4, 0xdb, 0x4d, 0xb7, 0xae, 0x36,
+	0x52, 0xd8, 0x5d, 0x13, 0x40, 0x46, 0x2b, 0x8e, 0x08, 0x1b, 0xf4, 0x0b, 0x2f, 0x19, 0xa2, 0x31,
+	0x3a, 0xb4, 0x09, 0x85, 0x9f, 0x76, 0x68, 0x87, 0x3a, 0x36, 0xa3, 0xbc, 0xed, 0x7b, 0x9c, 0x72,
+	0x2b, 0xad, 0xea, 0x90, 0x71, 0x53, 0xb5, 0xe1, 0xb7, 0x5a, 0xc4, 0x73, 0x70, 0x5e, 0xdb, 0xe0,
+	0xc0, 0x44, 0xd2, 0x04, 0xad, 0x55, 0x31, 0x84, 0x0b, 0x6e, 0xc1, 0x57, 0xd3, 0x18, 0x1b, 0x6c,
+	0x4c, 0xd0, 0x8f, 0x00, 0x99, 0xd6, 0x28, 0x97, 0x4f, 0xea, 0x75, 0xda, 0xd6, 0xc1, 0x67, 0x4c,
+	0x57, 0x83, 0xfd, 0x54, 0x96, 0x51, 0xa0, 0xa2, 0xa0, 0xd8, 0x74, 0x26, 0x92, 0xa0, 0x07, 0xb0,
+	0x18, 0xb4, 0x4c, 0x71, 0x9a, 0xe6, 0x59, 0xd9, 0xf1, 0xa7, 0x4a, 0xd2, 0xd2, 0x34, 0x07, 0x23,
+	0x63, 0x18, 0x93, 0xa1, 0x1b, 0xb2, 0xb2, 0xb6, 0x8f, 0x5d, 0xcf, 0xf1, 0x8f, 0xb9, 0x4d, 0x8e,
+	0x88, 0xdb, 0x94, 0xf9, 0xba, 0x09, 0x60, 0x88, 0x75, 0x9f, 0x68, 0x55, 0x25, 0xd0, 0xa0, 0x1f,
+	0xc1, 0xd5, 0x17, 0x4d, 0x64, 0xe4, 0x7b, 0x72, 0x6a, 0x81, 0xad, 0x9c, 0x3e, 0x59, 0x81, 0x13,
+	0x5a, 0xfa, 0xe7, 0x04, 0x40, 0xac, 0x8b, 0x2b, 0x30, 0xdb, 0xd6, 0x91, 0x4c, 0x39, 0x91, 0x6c,
+	0x35, 0xd5, 0xff, 0xa2, 0x34, 0xd3, 0xce, 0x74, 0x5f, 0xc5, 0x81, 0x02, 0x7d, 0x0f, 0x66, 0x83,
+	0x9e, 0x27, 0xbf, 0xb2, 0xe7, 0xc6, 0x25, 0x04, 0x16, 0xe8, 0xad, 0xc9, 0x4f, 0xe2, 0xb4, 0xa5,
+	0x82, 0x9b, 0x98, 0xf9, 0x1f, 0x16, 0xa4, 0xc3, 0xdc, 0x14, 0xbd, 0x13, 0xcf, 0x61, 0xaf, 0x9c,
+	0x9a, 0xc3, 0xbe, 0x20, 0x79, 0xdd, 0x00, 0xa8, 0x33, 0x4a, 0xcc, 0xa1, 0x59, 0xf2, 0x2c, 0x87,
+	0x66, 0xc6, 0xae, 0x22, 0x24, 0x49, 0xa7, 0xed, 0x04, 0x24, 0xd3, 0x67, 0x21, 0x31, 0x76, 0x15,
+	0x11, 0x16, 0x34, 0x33, 0xb1, 0x3a, 0x6b, 0x19, 0x32, 0x0e, 0xe5, 0x75, 0xe6, 0xb6, 0xe5, 0xcc,
+	0x29, 0x8f, 0x94, 0xc6, 0x71, 0x11, 0xda, 0x02, 0x20, 0x42, 0x30, 0x77, 0xaf, 0x23, 0xa8, 0x3c,
+	0x6b, 0x92, 0x9b, 0xe4, 0x8d, 0x53, 0x07, 0xa2, 0x5c, 0x09, 0xb1, 0x9b, 0x9e, 0x60, 0x27, 0x38,
+	0x66, 0x8c, 0x7e, 0x02, 0x19, 0xe3, 0x5e, 0x6d, 0x39, 0xa8, 0xb3, 0x67, 0x2f, 0x0c, 0xd4, 0x21,
+	0x57, 0x20, 0xaf, 0x71, 0x0c, 0x47, 0x01, 0x86, 0xa3, 0x2a, 0x20, 0x4e, 0x99, 0x34, 0xb4, 0x63,
+	0x01, 0x36, 0xa5, 0xe2, 0x9d, 0x3a, 0x9c, 0xdb, 0xd5, 0xda, 0x28, 0xce, 0x16, 0xf8, 0xa0, 0xc4,
+	0x41, 0xb7, 0xe1, 0xa2, 0x39, 0xf7, 0xb5, 0xa5, 0x8e, 0x32, 0x75, 0x4e, 0x4c, 0x39, 0xd7, 0x71,
+	0x13, 0x2f, 0x1a, 0xed, 0xae, 0x52, 0x56, 0xb4, 0x0e, 0x7d, 0x1f, 0x96, 0xe2, 0x5b, 0x65, 0xc8,
+	0x12, 0x94, 0xa5, 0x15, 0x43, 0x0c, 0x5a, 0x97, 0x61, 0x41, 0xed, 0xf4, 0x21, 0xb3, 0x8c, 0x32,
+	0x9b, 0x97, 0xaa, 0x41, 0xfc, 0x3d, 0x48, 0x37, 0x7d, 0x4d, 0xc4, 0xad, 0xec, 0xf2, 0xf4, 0xb8,
+	0x84, 0x31, 0x9a, 0x8f, 0xfb, 0x01, 0x54, 0x4f, 0x47, 0x64, 0x3a, 0xb6, 0x80, 0x98, 0x9b, 0xb8,
+	0x80, 0xc8, 0x8d, 0x2d, 0x20, 0xc6, 0x04, 0xd2, 0xfc, 0x37, 0x59, 0xa9, 0x15, 0xbe, 0xe9, 0x4a,
+	0x6d, 0xfe, 0x0c, 0x95, 0xda, 0xe9, 0xd5, 0x13, 0xfa, 0x5f, 0xa9, 0x9e, 0x16, 0x26, 0xa9, 0x9e,
+	0x16, 0x27, 0xa8, 0x9e, 0x2e, 0x4c, 0x56, 0x3d, 0x5d, 0xfc, 0x4b, 0xab, 0xa7, 0x4b, 0x13, 0x57,
+	0x4f, 0xd6, 0x29, 0xd5, 0xd3, 0x5b, 0x90, 0x66, 0xbe, 0x2f, 0x6c, 0xe5, 0xe6, 0x5f, 0x52, 0xa3,
+	0x6b, 0x8d, 0x9c, 0x90, 0xfa, 0xbe, 0x90, 0x3e, 0x1e, 0xa7, 0x98, 0xf9, 0x87, 0x3e, 0x80, 0xf3,
+	0x1e, 0x15, 0x72, 0x5e, 0x97, 0x54, 0xe0, 0xb9, 0xfb, 0x87, 0x5e, 0x69, 0xfd, 0x4c, 0x5f, 0x7d,
+	0xb6, 0xa9, 0xd8, 0xaa, 0xf5, 0x7b, 0xa5, 0x73, 0xea, 0x0f, 0x3e, 0xe7, 0x51, 0xa1, 0x4e, 0x69,
+	0xb2, 0x72, 0xc6, 0xb9, 0xa9, 0xb3, 0xac, 0x97, 0xc7, 0x07, 0x9e, 0x58, 0x29, 0xa6, 0x8f, 0xd1,
+	0x63, 0x02, 0x9c, 0x69, 0x91, 0x7a, 0xf0, 0x80, 0x36, 0x20, 0xad, 0x08, 0x05, 0x11, 0xd4, 0x7a,
+	0x65, 0x7c, 0xff, 0x82, 0x7c, 0xa2, 0x9a, 0xed, 0xf7, 0x4a, 0x61, 0xb6, 0x8e, 0x53, 0x92, 0x47,
+	0xfe, 0x43, 0x37, 0x61, 0x96, 0xeb, 0x50, 0x67, 0xbd, 0xaa, 0x28, 0x2e, 0x9d, 0x12, 0x09, 0x71,
+	0x80, 0x43, 0xef, 0x40, 0x90, 0xe3, 0xd8, 0x81, 0x69, 0xf1, 0xc5, 0xa6, 0x39, 0x83, 0x37, 0xcf,
+	0xe8, 0x0a, 0xe4, 0xc2, 0x94, 0x54, 0x4d, 0xa2, 0x55, 0x52, 0x79, 0x42, 0xd6, 0x24, 0xa2, 0x6a,
+	0x02, 0xd1, 0xeb, 0x90, 0xef, 0x70, 0xea, 0x44, 0x28, 0x6e, 0x2d, 0x2f, 0x4f, 0xcb, 0x2f, 0x54,
+	0x52, 0x1c, 0xc0, 0xe4, 0x47, 0xa1, 0xbc, 0x62, 0x8b, 0xd6, 0x84, 0xf5, 0x5a, 0xf4, 0x25, 0x2b,
+	0x5c, 0x10, 0xe8, 0x3b, 0x06, 0xc7, 0x3e, 0x31, 0xf5, 0xd8, 0x0d, 0x6b, 0x45, 0x15, 0xae, 0xaa,
+	0xd0, 0xb9, 0x4f, 0xb8, 0xc0, 0xef, 0xa9, 0x4a, 0xec, 0x86, 0x6e, 0x08, 0xfe, 0x44, 0x3f, 0x8d,
+	0x1a, 0xde, 0xb4, 0x2e, 0x8f, 0x35, 0xbc, 0x39, 0x60, 0x78, 0x13, 0x7d, 0x0c, 0x2f, 0x0f, 0xa7,
+	0xde, 0x8c, 0xd6, 0xa9, 0x7b, 0xa4, 0x43, 0xf4, 0x95, 0xb3, 0xa4, 0xf6, 0x61, 0x7e, 0x8e, 0x0d,
+	0x43, 0x45, 0xee, 0xb8, 0x8c, 0xfe, 0x3e, 0xa4, 0xd7, 0xc0, 0xd5, 0x53, 0x1c, 0x9d, 0x84, 0xe8,
+	0x79, 0x87, 0x76, 0xf8, 0x5f, 0x9e, 0x3b, 0xef, 0xa9, 0x83, 0x80, 0x13, 0x99, 0xde, 0xd7, 0xa9,
+	0x27, 0x48, 0x83, 0x5a, 0xaf, 0xcb, 0xaf, 0x6a, 0x78, 0xde, 0x68, 0x76, 0x42, 0x05, 0xfa, 0x16,
+	0xe4, 0xc3, 0xf4, 0xce, 0x94, 0xfd, 0xdf, 0x5a, 0x4e, 0xac, 0x9e, 0xc3, 0xb9, 0x40, 0x6c, 0x8a,
+	0x7d, 0x22, 0x37, 0xa9, 0xb4, 0x92, 0x47, 0x08, 0xe6, 0x20, 0x98, 0x5b, 0xab, 0xcb, 0xd3, 0xe3,
+	0xbc, 0x9b, 0x3e, 0x13, 0x36, 0x47, 0x17, 0x3a, 0x02, 0x63, 0x65, 0x5c, 0xa9, 0x61, 0xad, 0xe3,
+	0x72, 0x67, 0x2b, 0x89, 0xc3, 0x8c, 0x04, 0xd5, 0x20, 0x67, 0x5e, 0x11, 0xd0, 0xbf, 0x31, 0x01,
+	0x3d, 0x9e, 0xd3, 0x46, 0x01, 0xcb, 0x7b, 0x60, 0x98, 0xc3, 0xbc, 0x95, 0x5b, 0x6f, 0x2a, 0x9e,
+	0xd2, 0xc8, 0xc1, 0x77, 0xd0, 0x45, 0xc3, 0x94, 0xd7, 0x86, 0x81, 0x98, 0xcb, 0xca, 0xc6, 0x24,
+	0xf9, 0xe3, 0xf2, 0x61, 0x6e, 0x7d, 0x7b, 0x79, 0x7a, 0x5c, 0xba, 0x3f, 0xb6, 0xb2, 0xd1, 0x44,
+	0x63, 0x54, 0x1c, 0xbd, 0x0b, 0x10, 0x3b, 0x08, 0xba, 0x76, 0xb6, 0x83, 0x20, 0x1c, 0xb3, 0x45,
+	0x04, 0x72, 0x6d, 0xe6, 0x1f, 0xb9, 0x72, 0x3f, 0xca, 0x0f, 0x8c, 0x8e, 0x75, 0x5d, 0x45, 0xb1,
+	0x3b, 0xd2, 0x53, 0xef, 0x44, 0x9a, 0xb3, 0x9c, 0x1f, 0xcf, 0xc5, 0x18, 0xb7, 0x1c, 0x54, 0x83,
+	0xf9, 0x50, 0x20, 0x9d, 0x85, 0x43, 0x04, 0xb1, 0xca, 0xc6, 0x53, 0x0c, 0xaf, 0xf9, 0x5d, 0x75,
+	0xe3, 0x00, 0x17, 0xe2, 0x16, 0xf2, 0xe3, 0x02, 0xba, 0x0d, 0xe7, 0xe4, 0xea, 0xe6, 0xd6, 0x9a,
+	0xb2, 0x2c, 0x9e, 0x9a, 0xc5, 0xc8, 0x55, 0xcd, 0xb1, 0x06, 0x2f, 0xfd, 0x00, 0xf2, 0x43, 0x49,
+	0x26, 0x2a, 0xc0, 0xf4, 0x21, 0xd5, 0x5f, 0x61, 0xd2, 0x58, 0xfe, 0x95, 0x1f, 0x09, 0x8e, 0x48,
+	0xb3, 0x13, 0x7c, 0x54, 0xd0, 0x0f, 0x77, 0x92, 0x6f, 0x27, 0x96, 0x3e, 0x80, 0xdc, 0x60, 0x4e,
+	0x34, 0xc6, 0xba, 0x1c, 0xb7, 0x1e, 0xe3, 0x7a, 0x03, 0x82, 0x18, 0xaf, 0xa9, 0x1e, 0xde, 0x05,
+	0x08, 0x5b, 0xcd, 0xd1, 0x1d, 0xc8, 0x44, 0xf7, 0x4c, 0x64, 0x15, 0x31, 0xad, 0x8e, 0x9d, 0x4e,
+	0xeb, 0x26, 0x06, 0x1a, 0xda, 0xae, 0xfc, 0x04, 0x2e, 0x6e, 0xa8, 0xfc, 0x3f, 0x52, 0x9b, 0xf2,
+	0xa6, 0x0a, 0x10, 0xb1, 0x9a, 0xd2, 0xe4, 0x74, 0xd2, 0x58, 0x3d, 0x92, 0x0e, 0xe9, 0x57, 0xfe,
+	0x31, 0x01, 0x17, 0x1f, 0xab, 0xca, 0xe0, 0x9b, 0xa0, 0x47, 0x77, 0x01, 0xa2, 0x9b, 0x28, 0xa7,
+	0x16, 0x3d, 0xf7, 0x24, 0xe4, 0x01, 0xe1, 0x87, 0xa6, 0x0c, 0x4b, 0xef, 0x07, 0x82, 0x95, 0x7f,
+	0x4d, 0xc0, 0xc2, 0x0f, 0xa9, 0x18, 0x69, 0xdc, 0x23, 0xc8, 0x45, 0x8d, 0xb3, 0xff, 0xf2, 0xd2,
+	0x2c, 0x4b, 0x23, 0x3d, 0xff, 0xfa, 0xcd, 0xfd, 0xaf, 0x04, 0x5c, 0xb8, 0xef, 0xf2, 0xa8, 0xbd,
+	0x3c, 0x68, 0xf0, 0x87, 0x90, 0x8f, 0xbb, 0x8d, 0xa8, 0xc5, 0xaf, 0xbf, 0xc0, 0x61, 0x8c, 0x6f,
+	0x73, 0x8e, 0xc4, 0x11, 0x5f, 0xbf, 0xd5, 0x72, 0x93, 0xf8, 0xcc, 0xa1, 0xcc, 0x7c, 0x00, 0xd2,
+	0x0f, 0x52, 0xaa, 0x2f, 0x09, 0xe8, 0x4b, 0x28, 0xfa, 0x41, 0x16, 0x8f, 0x6d, 0x19, 0x44, 0xf4,
+	0x95, 0x13, 0xf5, 0x7f, 0xe5, 0x17, 0x09, 0x58, 0xd8, 0x1d, 0x33, 0x49, 0xdf, 0x81, 0xf3, 0x93,
+	0xae, 0x1e, 0xdd, 0x26, 0x03, 0xff, 0xfa, 0xf3, 0xf0, 0x9f, 0xb3, 0x90, 0x1b, 0xf4, 0x1a, 0xe8,
+	0x09, 0xa8, 0x18, 0x6b, 0xc2, 0xc9, 0x40, 0x90, 0x4e, 0x4c, 0x18, 0xa4, 0xd5, 0x2d, 0x19, 0x1d,
+	0x5a, 0x62, 0x11, 0xfa, 0x35, 0xc8, 0x1a, 0x4e, 0x95, 0x39, 0x98, 0xcf, 0xba, 0x19, 0x2d, 0x53,
+	0x59, 0x42, 0x78, 0xfc, 0x17, 0x46, 0x57, 0x5e, 0x3f, 0xa0, 0x4e, 0xa7, 0x39, 0x69, 0x19, 0x1f,
+	0xcf, 0x11, 0x0c, 0xc5, 0x6e, 0xc0, 0x50, 0x11, 0xe8, 0x2a, 0x84, 0x01, 0xda, 0xb4, 0x61, 0x46,
+	0xb5, 0x61, 0x2e, 0x90, 0xea, 0x56, 0xbc, 0x09, 0xf3, 0x6d, 0x52, 0x3f, 0xa4, 0xc2, 0xa6, 0x8c,
+	0xf9, 0x4c, 0x7d, 0x0c, 0x56, 0xf3, 0x98, 0xc4, 0x79, 0xad, 0xd8, 0x94, 0x72, 0x2c, 0x33, 0x87,
+	0x35, 0xc8, 0x90, 0x23, 0xca, 0x48, 0x83, 0xda, 0xdc, 0x63, 0xea, 0x10, 0x31, 0x69, 0xae, 0x96,
+	0x68, 0xf1, 0xee, 0x36, 0xc6, 0x60, 0x20, 0xbb, 0x1e, 0x43, 0xeb, 0x90, 0x0d, 0x0c, 0x18, 0xe7,
+	0xae, 0x2a, 0xea, 0x93, 0xe6, 0x92, 0x88, 0x96, 0xe3, 0xdd, 0xdd, 0x2d, 0x1c, 0xb0, 0x62, 0xce,
+	0x5d, 0x54, 0x07, 0x75, 0x2c, 0xa9, 0x92, 0x08, 0x87, 0xd6, 0x5d, 0x67, 0xd2, 0x0b, 0x45, 0xb2,
+	0x44, 0x2a, 0xc8, 0xa4, 0x4c, 0xde, 0xb3, 0xd1, 0x86, 0x15, 0xa1, 0xc6, 0x48, 0x5d, 0x56, 0xaa,
+	0x38, 0x2c, 0x94, 0x22, 0x1f, 0xac, 0xe8, 0x25, 0x43, 0xdf, 0xc0, 0xd3, 0x93, 0x5c, 0xe8, 0x51,
+	0xf5, 0x58, 0xf0, 0xb2, 0xb8, 0x06, 0x2f, 0x06, 0x2f, 0x8b, 0x4b, 0xd1, 0x03, 0xb8, 0x14, 0xbe,
+	0x70, 0xe8, 0x76, 0x8f, 0xba, 0xc7, 0x52, 0xbd, 0xd4, 0xef, 0x95, 0x16, 0x0c, 0xe1, 0xc0, 0x0d,
+	0x9f, 0x05, 0xc3, 0x17, 0x17, 0xa2, 0x1f, 0x04, 0xd7, 0xb8, 0xe2, 0x57, 0x7d, 0x32, 0x8a, 0x48,
+	0x17, 0xcc, 0x9a, 0x28, 0xb8, 0xee, 0x93, 0x33, 0x1c, 0xe6, 0xf9, 0xab, 0xf2, 0xd3, 0xec, 0xd7,
+	0xcd, 0x4f, 0xc7, 0xa7, 0x98, 0x73, 0x67, 0x48, 0x31, 0x73, 0xe3, 0x52, 0xcc, 0x95, 0x7f, 0x4b,
+	0x80, 0x15, 0x77, 0xfc, 0x3a, 0xf4, 0xff, 0x9f, 0xf6, 0xfe, 0x6f, 0xde, 0x03, 0x88, 0x12, 0x71,
+	0x34, 0x0f, 0x73, 0x3b, 0x0f, 0x9f, 0x6c, 0x62, 0xfb, 0xf1, 0xf6, 0xfb, 0xdb, 0x0f, 0x9f, 0x6c,
+	0x17, 0xa6, 0x22, 0x51, 0xb5, 0xf2, 0xe8, 0xd1, 0x26, 0xfe, 0xb0, 0x90, 0x40, 0x08, 0x72, 0x5a,
+	0xb4, 0xf9, 0x57, 0x8f, 0x36, 0xf1, 0x76, 0xe5, 0x7e, 0x21, 0x59, 0xfd, 0xa7, 0xc4, 0x67, 0xcf,
+	0x8a, 0x89, 0xcf, 0x9f, 0x15, 0x13, 0xbf, 0x7f, 0x56, 0x9c, 0xfa, 0xe3, 0xb3, 0xe2, 0xd4, 0x9f,
+	0x9e, 0x15, 0xa7, 0xfe, 0xfc, 0xac, 0x38, 0xf5, 0xe5, 0xb3, 0x62, 0xe2, 0x67, 0xfd, 0x62, 0xe2,
+	0xe7, 0xfd, 0xe2, 0xd4, 0x6f, 0xfa, 0xc5, 0xc4, 0x6f, 0xfb, 0xc5, 0xa9, 0x4f, 0xfb, 0xc5, 0xa9,
+	0xdf, 0xf5, 0x8b, 0x53, 0x9f, 0xf5, 0x8b, 0x89, 0xcf, 0xfb, 0xc5, 0xc4, 0xef, 0xfb, 0xc5, 0xa9,
+	0x3f, 0xf6, 0x8b, 0x89, 0x3f, 0xf5, 0x8b, 0x53, 0x7f, 0xee, 0x17, 0x13, 0x5f, 0xf6, 0x8b, 0x53,
+	0x3f, 0x7b, 0x5e, 0x9c, 0xfa, 0xf9, 0xf3, 0x62, 0xe2, 0x97, 0xcf, 0x8b, 0x53, 0xbf, 0x7a, 0x5e,
+	0x4c, 0xfc, 0xfa, 0x79, 0x71, 0xea, 0x37, 0xcf, 0x8b, 0x53, 0xbf, 0x7d, 0x5e, 0x4c, 0x7c, 0xfa,
+	0xbc, 0x98, 0xf8, 0xdd, 0xf3, 0x62, 0xe2, 0xc7, 0xd7, 0x26, 0xad, 0x80, 0x85, 0xd7, 0xde, 0xdb,
+	0x3b, 0xaf, 0x46, 0xe4, 0xd6, 0x7f, 0x0f, 0x00, 0x6b, 0x3f, 0xa3, 0x41, 0x54, 0x2b, 0x00, 0x00,
 }
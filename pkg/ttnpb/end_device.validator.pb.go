@@ -254,6 +254,11 @@ func (this *EndDevice) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("ProvisioningData", err)
 		}
 	}
+	if this.Stats != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Stats); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Stats", err)
+		}
+	}
 	return nil
 }
 func (this *EndDevices) Validate() error {
@@ -308,3 +313,35 @@ func (this *SetEndDeviceRequest) Validate() error {
 	}
 	return nil
 }
+func (this *EndDeviceStats) Validate() error {
+	if this.LastUplinkReceivedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LastUplinkReceivedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LastUplinkReceivedAt", err)
+		}
+	}
+	if this.LastDownlinkScheduledAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LastDownlinkScheduledAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LastDownlinkScheduledAt", err)
+		}
+	}
+	if this.LastADRDecidedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LastADRDecidedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LastADRDecidedAt", err)
+		}
+	}
+	if this.LastDevStatusReceivedAt != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LastDevStatusReceivedAt); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LastDevStatusReceivedAt", err)
+		}
+	}
+	return nil
+}
+func (this *GetEndDeviceStatsRequest) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.EndDeviceIdentifiers)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("EndDeviceIdentifiers", err)
+	}
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.FieldMask)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("FieldMask", err)
+	}
+	return nil
+}
@@ -429,6 +429,8 @@ var GatewayFieldPathsNested = []string{
 	"name",
 	"schedule_downlink_late",
 	"status_public",
+	"udp_allowed_ips",
+	"udp_token",
 	"update_channel",
 	"updated_at",
 	"version_ids",
@@ -454,6 +456,8 @@ var GatewayFieldPathsTopLevel = []string{
 	"name",
 	"schedule_downlink_late",
 	"status_public",
+	"udp_allowed_ips",
+	"udp_token",
 	"update_channel",
 	"updated_at",
 	"version_ids",
@@ -655,6 +659,25 @@ func (dst *Gateway) SetFields(src *Gateway, paths ...string) error {
 				var zero DownlinkPathConstraint
 				dst.DownlinkPathConstraint = zero
 			}
+		case "udp_allowed_ips":
+			if len(subs) > 0 {
+				return fmt.Errorf("'udp_allowed_ips' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UDPAllowedIPs = src.UDPAllowedIPs
+			} else {
+				dst.UDPAllowedIPs = nil
+			}
+		case "udp_token":
+			if len(subs) > 0 {
+				return fmt.Errorf("'udp_token' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UDPToken = src.UDPToken
+			} else {
+				var zero string
+				dst.UDPToken = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -891,6 +914,8 @@ var CreateGatewayRequestFieldPathsNested = []string{
 	"gateway.name",
 	"gateway.schedule_downlink_late",
 	"gateway.status_public",
+	"gateway.udp_allowed_ips",
+	"gateway.udp_token",
 	"gateway.update_channel",
 	"gateway.updated_at",
 	"gateway.version_ids",
@@ -972,6 +997,8 @@ var UpdateGatewayRequestFieldPathsNested = []string{
 	"gateway.name",
 	"gateway.schedule_downlink_late",
 	"gateway.status_public",
+	"gateway.udp_allowed_ips",
+	"gateway.udp_token",
 	"gateway.update_channel",
 	"gateway.updated_at",
 	"gateway.version_ids",
@@ -1026,6 +1053,8 @@ func (dst *UpdateGatewayRequest) SetFields(src *UpdateGatewayRequest, paths ...s
 }
 
 var CreateGatewayAPIKeyRequestFieldPathsNested = []string{
+	"allowed_ips",
+	"expires_at",
 	"gateway_ids",
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
@@ -1034,6 +1063,8 @@ var CreateGatewayAPIKeyRequestFieldPathsNested = []string{
 }
 
 var CreateGatewayAPIKeyRequestFieldPathsTopLevel = []string{
+	"allowed_ips",
+	"expires_at",
 	"gateway_ids",
 	"name",
 	"rights",
@@ -1079,6 +1110,24 @@ func (dst *CreateGatewayAPIKeyRequest) SetFields(src *CreateGatewayAPIKeyRequest
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "allowed_ips":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allowed_ips' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowedIPs = src.AllowedIPs
+			} else {
+				dst.AllowedIPs = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1089,10 +1138,14 @@ func (dst *CreateGatewayAPIKeyRequest) SetFields(src *CreateGatewayAPIKeyRequest
 
 var UpdateGatewayAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_ips",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
+	"api_key.last_used_at",
 	"api_key.name",
 	"api_key.rights",
+	"field_mask",
 	"gateway_ids",
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
@@ -1100,6 +1153,7 @@ var UpdateGatewayAPIKeyRequestFieldPathsNested = []string{
 
 var UpdateGatewayAPIKeyRequestFieldPathsTopLevel = []string{
 	"api_key",
+	"field_mask",
 	"gateway_ids",
 }
 
@@ -1142,6 +1196,16 @@ func (dst *UpdateGatewayAPIKeyRequest) SetFields(src *UpdateGatewayAPIKeyRequest
 					dst.APIKey = zero
 				}
 			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero github_com_gogo_protobuf_types.FieldMask
+				dst.FieldMask = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1381,6 +1445,8 @@ func (dst *GatewayStatus) SetFields(src *GatewayStatus, paths ...string) error {
 var GatewayConnectionStatsFieldPathsNested = []string{
 	"connected_at",
 	"downlink_count",
+	"frequency_plan_id",
+	"gateway_server_instance",
 	"last_downlink_received_at",
 	"last_status",
 	"last_status.advanced",
@@ -1393,17 +1459,21 @@ var GatewayConnectionStatsFieldPathsNested = []string{
 	"last_status_received_at",
 	"last_uplink_received_at",
 	"protocol",
+	"sub_bands",
 	"uplink_count",
 }
 
 var GatewayConnectionStatsFieldPathsTopLevel = []string{
 	"connected_at",
 	"downlink_count",
+	"frequency_plan_id",
+	"gateway_server_instance",
 	"last_downlink_received_at",
 	"last_status",
 	"last_status_received_at",
 	"last_uplink_received_at",
 	"protocol",
+	"sub_bands",
 	"uplink_count",
 }
 
@@ -1497,6 +1567,100 @@ func (dst *GatewayConnectionStats) SetFields(src *GatewayConnectionStats, paths
 				var zero uint64
 				dst.DownlinkCount = zero
 			}
+		case "sub_bands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sub_bands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SubBands = src.SubBands
+			} else {
+				dst.SubBands = nil
+			}
+		case "frequency_plan_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency_plan_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FrequencyPlanID = src.FrequencyPlanID
+			} else {
+				var zero string
+				dst.FrequencyPlanID = zero
+			}
+		case "gateway_server_instance":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateway_server_instance' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GatewayServerInstance = src.GatewayServerInstance
+			} else {
+				var zero string
+				dst.GatewayServerInstance = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var GatewayConnectionStats_SubBandFieldPathsNested = []string{
+	"downlink_utilization",
+	"downlink_utilization_limit",
+	"max_frequency",
+	"min_frequency",
+}
+
+var GatewayConnectionStats_SubBandFieldPathsTopLevel = []string{
+	"downlink_utilization",
+	"downlink_utilization_limit",
+	"max_frequency",
+	"min_frequency",
+}
+
+func (dst *GatewayConnectionStats_SubBand) SetFields(src *GatewayConnectionStats_SubBand, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "min_frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinFrequency = src.MinFrequency
+			} else {
+				var zero uint64
+				dst.MinFrequency = zero
+			}
+		case "max_frequency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_frequency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxFrequency = src.MaxFrequency
+			} else {
+				var zero uint64
+				dst.MaxFrequency = zero
+			}
+		case "downlink_utilization_limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_utilization_limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkUtilizationLimit = src.DownlinkUtilizationLimit
+			} else {
+				var zero float32
+				dst.DownlinkUtilizationLimit = zero
+			}
+		case "downlink_utilization":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_utilization' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkUtilization = src.DownlinkUtilization
+			} else {
+				var zero float32
+				dst.DownlinkUtilization = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
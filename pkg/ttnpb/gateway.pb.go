@@ -472,8 +472,15 @@ type Gateway struct {
 	// duty cycle only in controlled research and development environments.
 	EnforceDutyCycle       bool                   `protobuf:"varint,17,opt,name=enforce_duty_cycle,json=enforceDutyCycle,proto3" json:"enforce_duty_cycle,omitempty"`
 	DownlinkPathConstraint DownlinkPathConstraint `protobuf:"varint,18,opt,name=downlink_path_constraint,json=downlinkPathConstraint,proto3,enum=ttn.lorawan.v3.DownlinkPathConstraint" json:"downlink_path_constraint,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}               `json:"-"`
-	XXX_sizecache          int32                  `json:"-"`
+	// Source IP addresses or CIDR ranges from which the gateway is allowed to send Semtech UDP packet forwarder traffic.
+	// If empty, traffic is allowed from any address.
+	UDPAllowedIPs []string `protobuf:"bytes,19,rep,name=udp_allowed_ips,json=udpAllowedIps,proto3" json:"udp_allowed_ips,omitempty"`
+	// Shared secret that the gateway sends as "token" in the "stat" object of the Semtech UDP packet forwarder protocol.
+	// If set, traffic is only accepted from the address from which the gateway sent a status message with this token.
+	// The token is stored hashed; reading the field returns the hash.
+	UDPToken             string   `protobuf:"bytes,20,opt,name=udp_token,json=udpToken,proto3" json:"udp_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Gateway) Reset()      { *m = Gateway{} }
@@ -620,6 +627,20 @@ func (m *Gateway) GetDownlinkPathConstraint() DownlinkPathConstraint {
 	return DOWNLINK_PATH_CONSTRAINT_NONE
 }
 
+func (m *Gateway) GetUDPAllowedIPs() []string {
+	if m != nil {
+		return m.UDPAllowedIPs
+	}
+	return nil
+}
+
+func (m *Gateway) GetUDPToken() string {
+	if m != nil {
+		return m.UDPToken
+	}
+	return ""
+}
+
 type Gateways struct {
 	Gateways             []*Gateway `protobuf:"bytes,1,rep,name=gateways,proto3" json:"gateways,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -925,10 +946,12 @@ func (m *UpdateGatewayRequest) GetFieldMask() types.FieldMask {
 
 type CreateGatewayAPIKeyRequest struct {
 	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights               []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name                 string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights               []Right    `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	ExpiresAt            *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	AllowedIPs           []string   `protobuf:"bytes,5,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *CreateGatewayAPIKeyRequest) Reset()      { *m = CreateGatewayAPIKeyRequest{} }
@@ -977,11 +1000,26 @@ func (m *CreateGatewayAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateGatewayAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateGatewayAPIKeyRequest) GetAllowedIPs() []string {
+	if m != nil {
+		return m.AllowedIPs
+	}
+	return nil
+}
+
 type UpdateGatewayAPIKeyRequest struct {
 	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
 	APIKey               `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateGatewayAPIKeyRequest) Reset()      { *m = UpdateGatewayAPIKeyRequest{} }
@@ -1016,6 +1054,13 @@ func (m *UpdateGatewayAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateGatewayAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateGatewayAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type SetGatewayCollaboratorRequest struct {
 	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
 	Collaborator         Collaborator `protobuf:"bytes,2,opt,name=collaborator,proto3" json:"collaborator"`
@@ -1251,8 +1296,14 @@ type GatewayConnectionStats struct {
 	UplinkCount            uint64         `protobuf:"varint,6,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
 	LastDownlinkReceivedAt *time.Time     `protobuf:"bytes,7,opt,name=last_downlink_received_at,json=lastDownlinkReceivedAt,proto3,stdtime" json:"last_downlink_received_at,omitempty"`
 	DownlinkCount          uint64         `protobuf:"varint,8,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}       `json:"-"`
-	XXX_sizecache          int32          `json:"-"`
+	// Duty-cycle utilization per sub-band.
+	SubBands []*GatewayConnectionStats_SubBand `protobuf:"bytes,9,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
+	// Frequency plan ID of the gateway.
+	FrequencyPlanID string `protobuf:"bytes,10,opt,name=frequency_plan_id,json=frequencyPlanId,proto3" json:"frequency_plan_id,omitempty"`
+	// Name of the Gateway Server instance that the gateway is connected to.
+	GatewayServerInstance string   `protobuf:"bytes,11,opt,name=gateway_server_instance,json=gatewayServerInstance,proto3" json:"gateway_server_instance,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *GatewayConnectionStats) Reset()      { *m = GatewayConnectionStats{} }
@@ -1343,6 +1394,98 @@ func (m *GatewayConnectionStats) GetDownlinkCount() uint64 {
 	return 0
 }
 
+func (m *GatewayConnectionStats) GetSubBands() []*GatewayConnectionStats_SubBand {
+	if m != nil {
+		return m.SubBands
+	}
+	return nil
+}
+
+func (m *GatewayConnectionStats) GetFrequencyPlanID() string {
+	if m != nil {
+		return m.FrequencyPlanID
+	}
+	return ""
+}
+
+func (m *GatewayConnectionStats) GetGatewayServerInstance() string {
+	if m != nil {
+		return m.GatewayServerInstance
+	}
+	return ""
+}
+
+type GatewayConnectionStats_SubBand struct {
+	MinFrequency uint64 `protobuf:"varint,1,opt,name=min_frequency,json=minFrequency,proto3" json:"min_frequency,omitempty"`
+	MaxFrequency uint64 `protobuf:"varint,2,opt,name=max_frequency,json=maxFrequency,proto3" json:"max_frequency,omitempty"`
+	// Duty-cycle limit of the sub-band as a fraction of time.
+	DownlinkUtilizationLimit float32 `protobuf:"fixed32,3,opt,name=downlink_utilization_limit,json=downlinkUtilizationLimit,proto3" json:"downlink_utilization_limit,omitempty"`
+	// Utilization of the sub-band in the duty-cycle window, as a fraction of the duty-cycle limit.
+	DownlinkUtilization  float32  `protobuf:"fixed32,4,opt,name=downlink_utilization,json=downlinkUtilization,proto3" json:"downlink_utilization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayConnectionStats_SubBand) Reset()      { *m = GatewayConnectionStats_SubBand{} }
+func (*GatewayConnectionStats_SubBand) ProtoMessage() {}
+func (*GatewayConnectionStats_SubBand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gateway_66b2730d52432872, []int{17, 0}
+}
+func (m *GatewayConnectionStats_SubBand) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayConnectionStats_SubBand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayConnectionStats_SubBand.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GatewayConnectionStats_SubBand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayConnectionStats_SubBand.Merge(dst, src)
+}
+func (m *GatewayConnectionStats_SubBand) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayConnectionStats_SubBand) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayConnectionStats_SubBand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayConnectionStats_SubBand proto.InternalMessageInfo
+
+func (m *GatewayConnectionStats_SubBand) GetMinFrequency() uint64 {
+	if m != nil {
+		return m.MinFrequency
+	}
+	return 0
+}
+
+func (m *GatewayConnectionStats_SubBand) GetMaxFrequency() uint64 {
+	if m != nil {
+		return m.MaxFrequency
+	}
+	return 0
+}
+
+func (m *GatewayConnectionStats_SubBand) GetDownlinkUtilizationLimit() float32 {
+	if m != nil {
+		return m.DownlinkUtilizationLimit
+	}
+	return 0
+}
+
+func (m *GatewayConnectionStats_SubBand) GetDownlinkUtilization() float32 {
+	if m != nil {
+		return m.DownlinkUtilization
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GatewayBrand)(nil), "ttn.lorawan.v3.GatewayBrand")
 	golang_proto.RegisterType((*GatewayBrand)(nil), "ttn.lorawan.v3.GatewayBrand")
@@ -1390,6 +1533,8 @@ func init() {
 	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.GatewayStatus.VersionsEntry")
 	proto.RegisterType((*GatewayConnectionStats)(nil), "ttn.lorawan.v3.GatewayConnectionStats")
 	golang_proto.RegisterType((*GatewayConnectionStats)(nil), "ttn.lorawan.v3.GatewayConnectionStats")
+	proto.RegisterType((*GatewayConnectionStats_SubBand)(nil), "ttn.lorawan.v3.GatewayConnectionStats.SubBand")
+	golang_proto.RegisterType((*GatewayConnectionStats_SubBand)(nil), "ttn.lorawan.v3.GatewayConnectionStats.SubBand")
 }
 func (this *GatewayBrand) Equal(that interface{}) bool {
 	if that == nil {
@@ -1689,6 +1834,17 @@ func (this *Gateway) Equal(that interface{}) bool {
 	if this.DownlinkPathConstraint != that1.DownlinkPathConstraint {
 		return false
 	}
+	if len(this.UDPAllowedIPs) != len(that1.UDPAllowedIPs) {
+		return false
+	}
+	for i := range this.UDPAllowedIPs {
+		if this.UDPAllowedIPs[i] != that1.UDPAllowedIPs[i] {
+			return false
+		}
+	}
+	if this.UDPToken != that1.UDPToken {
+		return false
+	}
 	return true
 }
 func (this *Gateways) Equal(that interface{}) bool {
@@ -1894,6 +2050,21 @@ func (this *CreateGatewayAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedIPs) != len(that1.AllowedIPs) {
+		return false
+	}
+	for i := range this.AllowedIPs {
+		if this.AllowedIPs[i] != that1.AllowedIPs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateGatewayAPIKeyRequest) Equal(that interface{}) bool {
@@ -1921,6 +2092,9 @@ func (this *UpdateGatewayAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *SetGatewayCollaboratorRequest) Equal(that interface{}) bool {
@@ -2106,6 +2280,53 @@ func (this *GatewayConnectionStats) Equal(that interface{}) bool {
 	if this.DownlinkCount != that1.DownlinkCount {
 		return false
 	}
+	if len(this.SubBands) != len(that1.SubBands) {
+		return false
+	}
+	for i := range this.SubBands {
+		if !this.SubBands[i].Equal(that1.SubBands[i]) {
+			return false
+		}
+	}
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
+		return false
+	}
+	if this.GatewayServerInstance != that1.GatewayServerInstance {
+		return false
+	}
+	return true
+}
+func (this *GatewayConnectionStats_SubBand) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayConnectionStats_SubBand)
+	if !ok {
+		that2, ok := that.(GatewayConnectionStats_SubBand)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MinFrequency != that1.MinFrequency {
+		return false
+	}
+	if this.MaxFrequency != that1.MaxFrequency {
+		return false
+	}
+	if this.DownlinkUtilizationLimit != that1.DownlinkUtilizationLimit {
+		return false
+	}
+	if this.DownlinkUtilization != that1.DownlinkUtilization {
+		return false
+	}
 	return true
 }
 func (m *GatewayBrand) Marshal() (dAtA []byte, err error) {
@@ -2562,6 +2783,31 @@ func (m *Gateway) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintGateway(dAtA, i, uint64(m.DownlinkPathConstraint))
 	}
+	if len(m.UDPAllowedIPs) > 0 {
+		for _, s := range m.UDPAllowedIPs {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.UDPToken) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.UDPToken)))
+		i += copy(dAtA[i:], m.UDPToken)
+	}
 	return i, nil
 }
 
@@ -2821,6 +3067,31 @@ func (m *CreateGatewayAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintGateway(dAtA, i, uint64(j17))
 		i += copy(dAtA[i:], dAtA18[:j17])
 	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n36, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if len(m.AllowedIPs) > 0 {
+		for _, s := range m.AllowedIPs {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -2855,6 +3126,14 @@ func (m *UpdateGatewayAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n20
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGateway(dAtA, i, uint64(m.FieldMask.Size()))
+	n37, err := m.FieldMask.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n37
 	return i, nil
 }
 
@@ -3129,6 +3408,70 @@ func (m *GatewayConnectionStats) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintGateway(dAtA, i, m.DownlinkCount)
 	}
+	if len(m.SubBands) > 0 {
+		for _, msg := range m.SubBands {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintGateway(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.FrequencyPlanID) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.FrequencyPlanID)))
+		i += copy(dAtA[i:], m.FrequencyPlanID)
+	}
+	if len(m.GatewayServerInstance) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(len(m.GatewayServerInstance)))
+		i += copy(dAtA[i:], m.GatewayServerInstance)
+	}
+	return i, nil
+}
+
+func (m *GatewayConnectionStats_SubBand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayConnectionStats_SubBand) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MinFrequency != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(m.MinFrequency))
+	}
+	if m.MaxFrequency != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(m.MaxFrequency))
+	}
+	if m.DownlinkUtilizationLimit != 0 {
+		dAtA[i] = 0x1d
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.DownlinkUtilizationLimit))))
+		i += 4
+	}
+	if m.DownlinkUtilization != 0 {
+		dAtA[i] = 0x25
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.DownlinkUtilization))))
+		i += 4
+	}
 	return i, nil
 }
 
@@ -3247,6 +3590,12 @@ func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
 	this.ScheduleDownlinkLate = bool(r.Intn(2) == 0)
 	this.EnforceDutyCycle = bool(r.Intn(2) == 0)
 	this.DownlinkPathConstraint = DownlinkPathConstraint([]int32{0, 1, 2}[r.Intn(3)])
+	v39 := r.Intn(10)
+	this.UDPAllowedIPs = make([]string, v39)
+	for i := 0; i < v39; i++ {
+		this.UDPAllowedIPs[i] = randStringGateway(r)
+	}
+	this.UDPToken = randStringGateway(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3333,6 +3682,14 @@ func NewPopulatedCreateGatewayAPIKeyRequest(r randyGateway, easy bool) *CreateGa
 	for i := 0; i < v20; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
+	if r.Intn(10) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v37 := r.Intn(10)
+	this.AllowedIPs = make([]string, v37)
+	for i := 0; i < v37; i++ {
+		this.AllowedIPs[i] = randStringGateway(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3344,6 +3701,8 @@ func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGa
 	this.GatewayIdentifiers = *v21
 	v22 := NewPopulatedAPIKey(r, easy)
 	this.APIKey = *v22
+	v40 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v40
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3444,14 +3803,39 @@ func NewPopulatedGatewayConnectionStats(r randyGateway, easy bool) *GatewayConne
 		this.LastDownlinkReceivedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	}
 	this.DownlinkCount = uint64(r.Uint32())
+	if r.Intn(10) != 0 {
+		v38 := r.Intn(5)
+		this.SubBands = make([]*GatewayConnectionStats_SubBand, v38)
+		for i := 0; i < v38; i++ {
+			this.SubBands[i] = NewPopulatedGatewayConnectionStats_SubBand(r, easy)
+		}
+	}
+	this.FrequencyPlanID = randStringGateway(r)
+	this.GatewayServerInstance = randStringGateway(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
 }
-
-type randyGateway interface {
-	Float32() float32
-	Float64() float64
+func NewPopulatedGatewayConnectionStats_SubBand(r randyGateway, easy bool) *GatewayConnectionStats_SubBand {
+	this := &GatewayConnectionStats_SubBand{}
+	this.MinFrequency = uint64(uint64(r.Uint32()))
+	this.MaxFrequency = uint64(uint64(r.Uint32()))
+	this.DownlinkUtilizationLimit = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.DownlinkUtilizationLimit *= -1
+	}
+	this.DownlinkUtilization = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.DownlinkUtilization *= -1
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyGateway interface {
+	Float32() float32
+	Float64() float64
 	Int63() int64
 	Int31() int32
 	Uint32() uint32
@@ -3736,6 +4120,16 @@ func (m *Gateway) Size() (n int) {
 	if m.DownlinkPathConstraint != 0 {
 		n += 2 + sovGateway(uint64(m.DownlinkPathConstraint))
 	}
+	if len(m.UDPAllowedIPs) > 0 {
+		for _, s := range m.UDPAllowedIPs {
+			l = len(s)
+			n += 2 + l + sovGateway(uint64(l))
+		}
+	}
+	l = len(m.UDPToken)
+	if l > 0 {
+		n += 2 + l + sovGateway(uint64(l))
+	}
 	return n
 }
 
@@ -3848,6 +4242,16 @@ func (m *CreateGatewayAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovGateway(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if len(m.AllowedIPs) > 0 {
+		for _, s := range m.AllowedIPs {
+			l = len(s)
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3861,6 +4265,8 @@ func (m *UpdateGatewayAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovGateway(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovGateway(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovGateway(uint64(l))
 	return n
 }
 
@@ -3980,6 +4386,40 @@ func (m *GatewayConnectionStats) Size() (n int) {
 	if m.DownlinkCount != 0 {
 		n += 1 + sovGateway(m.DownlinkCount)
 	}
+	if len(m.SubBands) > 0 {
+		for _, e := range m.SubBands {
+			l = e.Size()
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
+	l = len(m.FrequencyPlanID)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	l = len(m.GatewayServerInstance)
+	if l > 0 {
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	return n
+}
+func (m *GatewayConnectionStats_SubBand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinFrequency != 0 {
+		n += 1 + sovGateway(uint64(m.MinFrequency))
+	}
+	if m.MaxFrequency != 0 {
+		n += 1 + sovGateway(uint64(m.MaxFrequency))
+	}
+	if m.DownlinkUtilizationLimit != 0 {
+		n += 5
+	}
+	if m.DownlinkUtilization != 0 {
+		n += 5
+	}
 	return n
 }
 
@@ -4106,6 +4546,8 @@ func (this *Gateway) String() string {
 		`ScheduleDownlinkLate:` + fmt.Sprintf("%v", this.ScheduleDownlinkLate) + `,`,
 		`EnforceDutyCycle:` + fmt.Sprintf("%v", this.EnforceDutyCycle) + `,`,
 		`DownlinkPathConstraint:` + fmt.Sprintf("%v", this.DownlinkPathConstraint) + `,`,
+		`UDPAllowedIPs:` + fmt.Sprintf("%v", this.UDPAllowedIPs) + `,`,
+		`UDPToken:` + fmt.Sprintf("%v", this.UDPToken) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4185,6 +4627,8 @@ func (this *CreateGatewayAPIKeyRequest) String() string {
 		`GatewayIdentifiers:` + strings.Replace(strings.Replace(this.GatewayIdentifiers.String(), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedIPs:` + fmt.Sprintf("%v", this.AllowedIPs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4196,6 +4640,7 @@ func (this *UpdateGatewayAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateGatewayAPIKeyRequest{`,
 		`GatewayIdentifiers:` + strings.Replace(strings.Replace(this.GatewayIdentifiers.String(), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(this.APIKey.String(), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4282,6 +4727,22 @@ func (this *GatewayConnectionStats) String() string {
 		`UplinkCount:` + fmt.Sprintf("%v", this.UplinkCount) + `,`,
 		`LastDownlinkReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDownlinkReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
+		`SubBands:` + strings.Replace(fmt.Sprintf("%v", this.SubBands), "GatewayConnectionStats_SubBand", "GatewayConnectionStats_SubBand", 1) + `,`,
+		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
+		`GatewayServerInstance:` + fmt.Sprintf("%v", this.GatewayServerInstance) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayConnectionStats_SubBand) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayConnectionStats_SubBand{`,
+		`MinFrequency:` + fmt.Sprintf("%v", this.MinFrequency) + `,`,
+		`MaxFrequency:` + fmt.Sprintf("%v", this.MaxFrequency) + `,`,
+		`DownlinkUtilizationLimit:` + fmt.Sprintf("%v", this.DownlinkUtilizationLimit) + `,`,
+		`DownlinkUtilization:` + fmt.Sprintf("%v", this.DownlinkUtilization) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5784,6 +6245,64 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UDPAllowedIPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UDPAllowedIPs = append(m.UDPAllowedIPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UDPToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UDPToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -6630,6 +7149,68 @@ func (m *CreateGatewayAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedIPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedIPs = append(m.AllowedIPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -6740,6 +7321,36 @@ func (m *UpdateGatewayAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -7762,6 +8373,205 @@ func (m *GatewayConnectionStats) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubBands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubBands = append(m.SubBands, &GatewayConnectionStats_SubBand{})
+			if err := m.SubBands[len(m.SubBands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayServerInstance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayServerInstance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGateway(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayConnectionStats_SubBand) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGateway
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayConnectionStats_SubBand: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayConnectionStats_SubBand: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFrequency", wireType)
+			}
+			m.MinFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFrequency |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFrequency", wireType)
+			}
+			m.MaxFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFrequency |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkUtilizationLimit", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.DownlinkUtilizationLimit = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkUtilization", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.DownlinkUtilization = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -7896,130 +8706,143 @@ func init() {
 }
 
 var fileDescriptor_gateway_66b2730d52432872 = []byte{
-	// 1990 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x92, 0xb4, 0x48, 0x3e, 0x4a, 0x94, 0x3c, 0x51, 0x95, 0x0d, 0x63, 0x2f, 0x15, 0xa6,
-	0x71, 0x94, 0xd6, 0xa6, 0x00, 0xd9, 0x28, 0x52, 0x07, 0xb5, 0x4b, 0xfd, 0xd8, 0x20, 0x62, 0xd7,
-	0xea, 0xca, 0xaa, 0x81, 0xf6, 0xb0, 0x1d, 0xee, 0x0e, 0xc9, 0x81, 0x96, 0xbb, 0xdb, 0xdd, 0x59,
-	0xc9, 0xec, 0x29, 0x87, 0x1e, 0x72, 0x0c, 0x8a, 0x1e, 0x7a, 0x0c, 0x5a, 0xa0, 0xf0, 0xa1, 0x28,
-	0x72, 0x2a, 0x7c, 0xe8, 0x21, 0xbd, 0xf9, 0x54, 0xf8, 0x18, 0xf4, 0xa0, 0x46, 0xd4, 0x25, 0x3d,
-	0x35, 0xe8, 0x29, 0xc7, 0x62, 0x66, 0x67, 0x97, 0x4b, 0xca, 0x54, 0x64, 0xbb, 0xbe, 0x71, 0xde,
-	0xfb, 0xde, 0x7b, 0xdf, 0xbc, 0x79, 0xfb, 0xe6, 0x0d, 0xa1, 0x66, 0xbb, 0x3e, 0x3e, 0xc0, 0xce,
-	0x95, 0x80, 0x61, 0x73, 0x6f, 0x15, 0x7b, 0x74, 0xb5, 0x8b, 0x19, 0x39, 0xc0, 0x83, 0x86, 0xe7,
-	0xbb, 0xcc, 0x45, 0x15, 0xc6, 0x9c, 0x86, 0x04, 0x35, 0xf6, 0xaf, 0x56, 0xaf, 0x74, 0x29, 0xeb,
-	0x85, 0xed, 0x86, 0xe9, 0xf6, 0x57, 0xbb, 0x6e, 0xd7, 0x5d, 0x15, 0xb0, 0x76, 0xd8, 0x11, 0x2b,
-	0xb1, 0x10, 0xbf, 0x22, 0xf3, 0xea, 0x72, 0xd7, 0x75, 0xbb, 0x36, 0x19, 0xa1, 0x3a, 0x94, 0xd8,
-	0x96, 0xd1, 0xc7, 0xc1, 0x9e, 0x44, 0x5c, 0x98, 0x44, 0x04, 0xcc, 0x0f, 0x4d, 0x26, 0xb5, 0xb5,
-	0x49, 0x2d, 0xa3, 0x7d, 0x12, 0x30, 0xdc, 0xf7, 0x24, 0xe0, 0xbb, 0x27, 0x37, 0x60, 0xba, 0x0e,
-	0xc3, 0x26, 0x33, 0xa8, 0xd3, 0x89, 0x69, 0x5c, 0x3c, 0x89, 0x22, 0x4e, 0xd8, 0x0f, 0xa4, 0xfa,
-	0xed, 0x93, 0x6a, 0x6a, 0x11, 0x87, 0xd1, 0x0e, 0x25, 0x7e, 0x0c, 0x5a, 0x3e, 0x09, 0xea, 0x13,
-	0x86, 0x2d, 0xcc, 0xb0, 0x44, 0x68, 0x27, 0x11, 0x3e, 0xed, 0xf6, 0x98, 0xf4, 0x50, 0xdf, 0x83,
-	0xd9, 0xdb, 0x51, 0x72, 0xd7, 0x7d, 0xec, 0x58, 0x68, 0x09, 0xb2, 0xd4, 0x52, 0x95, 0x65, 0x65,
-	0xa5, 0xb4, 0x3e, 0x33, 0x3c, 0xac, 0x65, 0x5b, 0x9b, 0x7a, 0x96, 0x5a, 0x08, 0x41, 0xde, 0xc1,
-	0x7d, 0xa2, 0x66, 0xb9, 0x46, 0x17, 0xbf, 0xd1, 0x1b, 0x90, 0x0b, 0x7d, 0x5b, 0xcd, 0x09, 0x70,
-	0x61, 0x78, 0x58, 0xcb, 0xed, 0xea, 0x77, 0x74, 0x2e, 0x43, 0x8b, 0x70, 0xce, 0x76, 0xbb, 0x6e,
-	0xa0, 0xe6, 0x97, 0x73, 0x2b, 0x25, 0x3d, 0x5a, 0xd4, 0xdb, 0x49, 0xb0, 0xbb, 0xae, 0x45, 0x6c,
-	0x74, 0x09, 0x8a, 0x6d, 0x1e, 0xd5, 0x48, 0x42, 0x96, 0x87, 0x87, 0xb5, 0x82, 0x60, 0xd2, 0xda,
-	0xd4, 0x0b, 0x42, 0xd9, 0x8a, 0x49, 0x65, 0xa7, 0x92, 0xca, 0x8d, 0x48, 0xd5, 0xff, 0xae, 0xc0,
-	0x1b, 0x32, 0xc8, 0xcf, 0x88, 0x1f, 0x50, 0xd7, 0x69, 0x8d, 0xd2, 0x76, 0xe6, 0x88, 0x97, 0xa0,
-	0xd8, 0xe7, 0x14, 0x8d, 0x24, 0xae, 0xc0, 0x09, 0xda, 0x1c, 0x27, 0x94, 0x2d, 0x0b, 0xbd, 0x07,
-	0x0b, 0x3d, 0xec, 0x5b, 0x07, 0xd8, 0x27, 0xc6, 0x7e, 0x14, 0x4e, 0xb2, 0x99, 0x8f, 0xe5, 0x92,
-	0x05, 0x87, 0x76, 0xa8, 0xdf, 0x1f, 0x83, 0xe6, 0x23, 0x68, 0x2c, 0x97, 0xd0, 0xfa, 0x7f, 0xb3,
-	0x49, 0xa2, 0x74, 0x6c, 0x51, 0x17, 0x2d, 0xc1, 0x0c, 0x71, 0x70, 0xdb, 0x26, 0x82, 0x74, 0x51,
-	0x97, 0x2b, 0xf4, 0x26, 0x94, 0xcc, 0x1e, 0xf5, 0x0c, 0x36, 0xf0, 0xe2, 0xa3, 0x29, 0x72, 0xc1,
-	0xfd, 0x81, 0x47, 0xd0, 0x05, 0x28, 0x75, 0x7c, 0xf2, 0xab, 0x90, 0x38, 0xe6, 0x40, 0x90, 0xca,
-	0xeb, 0x23, 0x01, 0x5a, 0x85, 0xb2, 0x1f, 0x04, 0xd4, 0x70, 0x3b, 0x9d, 0x80, 0x30, 0xc1, 0x24,
-	0xbb, 0x5e, 0x19, 0x1e, 0xd6, 0x40, 0xdf, 0xd9, 0x69, 0xdd, 0x13, 0x52, 0x1d, 0x38, 0x24, 0xfa,
-	0x8d, 0x1e, 0xc0, 0x02, 0x7b, 0x68, 0x98, 0xae, 0xd3, 0xa1, 0xdd, 0xd0, 0xc7, 0x8c, 0xf3, 0x3f,
-	0xb7, 0xac, 0xac, 0x94, 0xd7, 0x2e, 0x37, 0xc6, 0x3f, 0xc8, 0x46, 0x9a, 0x7b, 0xe3, 0xfe, 0xc3,
-	0x8d, 0xb4, 0x8d, 0x3e, 0xcf, 0xc6, 0x05, 0xd5, 0xdf, 0x28, 0x30, 0x3f, 0x01, 0x42, 0x6f, 0xc3,
-	0x5c, 0x9f, 0x3a, 0xc6, 0x88, 0xbf, 0x22, 0xf8, 0xcf, 0xf6, 0xa9, 0x73, 0x2b, 0xd9, 0x02, 0x07,
-	0xe1, 0x87, 0x29, 0x50, 0x56, 0x82, 0xf0, 0xc3, 0x11, 0xe8, 0x5d, 0x98, 0x77, 0x5c, 0x66, 0xf6,
-	0x8c, 0xc9, 0x5c, 0x54, 0x84, 0x38, 0x01, 0xd6, 0xff, 0xa1, 0x40, 0x65, 0xbc, 0x70, 0xd0, 0x16,
-	0xe4, 0xa8, 0x15, 0x88, 0xd8, 0xe5, 0xb5, 0xf7, 0xa6, 0xec, 0xf2, 0x64, 0x95, 0xad, 0x17, 0x9f,
-	0x1c, 0xd6, 0x32, 0x4f, 0x0f, 0x6b, 0x8a, 0xce, 0xed, 0xf9, 0xe9, 0x79, 0x3d, 0x97, 0xb9, 0x81,
-	0x9a, 0x15, 0x5f, 0x83, 0x5c, 0xa1, 0x6b, 0x30, 0xe3, 0xf3, 0x14, 0x05, 0x6a, 0x6e, 0x39, 0xb7,
-	0x52, 0x5e, 0xbb, 0x70, 0x5a, 0x1e, 0x75, 0x89, 0x45, 0x6f, 0xc1, 0xac, 0x69, 0xbb, 0xe6, 0x9e,
-	0x11, 0xb8, 0xa1, 0x6f, 0x12, 0xb5, 0xb0, 0xac, 0xac, 0xcc, 0xe9, 0x65, 0x21, 0xdb, 0x11, 0xa2,
-	0xeb, 0xf9, 0xc7, 0x9f, 0xd6, 0x32, 0xf5, 0xbf, 0x15, 0xa1, 0x20, 0x3d, 0xa0, 0x1b, 0xe9, 0x9d,
-	0xd4, 0xa7, 0xc4, 0x39, 0x65, 0x0b, 0x1b, 0x00, 0xa6, 0x4f, 0x30, 0x23, 0x96, 0x81, 0x99, 0xc8,
-	0x73, 0x79, 0xad, 0xda, 0x88, 0x1a, 0x61, 0x23, 0x6e, 0x84, 0x8d, 0xfb, 0x71, 0x23, 0x8c, 0xcc,
-	0x3f, 0xf9, 0x57, 0x4d, 0xd1, 0x4b, 0xd2, 0xae, 0xc9, 0xb8, 0x93, 0xd0, 0xb3, 0x62, 0x27, 0xb9,
-	0xe7, 0x71, 0x22, 0xed, 0x9a, 0x2c, 0xf9, 0xe6, 0xf3, 0xa9, 0x46, 0xb4, 0x0c, 0x65, 0x8b, 0x04,
-	0xa6, 0x4f, 0xbd, 0xa4, 0x2a, 0x4b, 0x7a, 0x5a, 0x84, 0x6e, 0x03, 0x60, 0xc6, 0x7c, 0xda, 0x0e,
-	0x19, 0x09, 0xd4, 0x19, 0x91, 0xee, 0x77, 0xa7, 0xa4, 0xa1, 0xd1, 0x4c, 0x90, 0x5b, 0x0e, 0xf3,
-	0x07, 0x7a, 0xca, 0x14, 0xdd, 0x80, 0xd9, 0x74, 0x2f, 0x57, 0x0b, 0xc2, 0xd5, 0x9b, 0x93, 0xae,
-	0x36, 0x22, 0x4c, 0xcb, 0xe9, 0xb8, 0x7a, 0xd9, 0x1c, 0x2d, 0xd0, 0x7d, 0x28, 0xcb, 0x8f, 0xdf,
-	0xe0, 0x07, 0x52, 0x7c, 0xf1, 0xd2, 0x82, 0xfd, 0x58, 0xcb, 0x2b, 0x69, 0x49, 0x5e, 0x91, 0x46,
-	0x40, 0xfc, 0x7d, 0xe2, 0x1b, 0xd8, 0xb2, 0x7c, 0x12, 0x04, 0x6a, 0x49, 0xe4, 0x62, 0x51, 0x6a,
-	0x77, 0x84, 0xb2, 0x19, 0xe9, 0x50, 0x0d, 0xca, 0x38, 0x64, 0xae, 0x11, 0x25, 0x57, 0x05, 0xd1,
-	0x5a, 0x80, 0x8b, 0x76, 0x85, 0x04, 0xbd, 0x03, 0x95, 0x48, 0x67, 0x98, 0x3d, 0xec, 0x38, 0xc4,
-	0x56, 0xcb, 0xc2, 0xdd, 0x5c, 0x24, 0xdd, 0x88, 0x84, 0xe8, 0x26, 0x9c, 0x4f, 0x3e, 0x2e, 0xc3,
-	0xb3, 0x31, 0xdf, 0x9a, 0x3a, 0x2b, 0xba, 0xe6, 0x6b, 0xc3, 0xc3, 0xda, 0x7c, 0xf2, 0x8d, 0x6d,
-	0xdb, 0xd8, 0x69, 0x6d, 0xea, 0xf3, 0x9d, 0x31, 0x81, 0x85, 0x7e, 0x0c, 0x45, 0xec, 0x30, 0xe2,
-	0x38, 0x38, 0x50, 0xe7, 0x44, 0x42, 0xb5, 0x29, 0x19, 0x69, 0x46, 0xb0, 0xf5, 0x3c, 0x4f, 0x83,
-	0x9e, 0x58, 0xf1, 0x56, 0x10, 0x30, 0xcc, 0xc2, 0xc0, 0xf0, 0xc2, 0xb6, 0x4d, 0x4d, 0xb5, 0x22,
-	0x36, 0x33, 0x1b, 0x09, 0xb7, 0x85, 0x8c, 0xb7, 0x02, 0xdb, 0x35, 0x45, 0x83, 0x89, 0x61, 0xf3,
-	0x02, 0x56, 0x89, 0xc5, 0x12, 0x78, 0x0d, 0x96, 0x02, 0xb3, 0x47, 0xac, 0xd0, 0x26, 0x86, 0xe5,
-	0x1e, 0x38, 0x36, 0x75, 0xf6, 0x0c, 0x9b, 0xe7, 0x68, 0x41, 0xe0, 0x17, 0x63, 0xed, 0xa6, 0x54,
-	0xde, 0xe1, 0xd9, 0xba, 0x0c, 0x88, 0x38, 0x1d, 0xd7, 0x37, 0x89, 0x61, 0x85, 0x6c, 0x60, 0x98,
-	0x03, 0xd3, 0x26, 0xea, 0x79, 0x61, 0xb1, 0x20, 0x35, 0x9b, 0x21, 0x1b, 0x6c, 0x70, 0x39, 0xfa,
-	0x25, 0xa8, 0x89, 0x6b, 0x0f, 0xb3, 0x1e, 0xef, 0xac, 0x01, 0xf3, 0x31, 0x75, 0x98, 0x8a, 0x96,
-	0x95, 0x95, 0xca, 0xda, 0xa5, 0xc9, 0x1c, 0xc4, 0xd1, 0xb6, 0x31, 0xeb, 0x6d, 0x24, 0x68, 0x7d,
-	0xc9, 0x7a, 0xa6, 0xbc, 0xfa, 0x23, 0x98, 0x9f, 0xa8, 0x64, 0xb4, 0x00, 0xb9, 0x3d, 0x12, 0x35,
-	0xd3, 0x92, 0xce, 0x7f, 0xf2, 0x8b, 0x7a, 0x1f, 0xdb, 0x61, 0x7c, 0x7b, 0x44, 0x8b, 0xeb, 0xd9,
-	0xf7, 0x95, 0xfa, 0x4d, 0x28, 0xca, 0xa4, 0x07, 0xe8, 0x2a, 0x14, 0x65, 0x05, 0xf1, 0x1e, 0xc2,
-	0x0f, 0xe8, 0xf5, 0x69, 0xbd, 0x2a, 0x01, 0xd6, 0xff, 0xa8, 0xc0, 0xf9, 0xdb, 0x84, 0xc5, 0x0a,
-	0x7e, 0xe4, 0x01, 0x43, 0x77, 0xa1, 0x1c, 0x97, 0xea, 0x8b, 0x76, 0x24, 0xe8, 0xc6, 0xda, 0x00,
-	0xdd, 0x04, 0x18, 0x8d, 0x6f, 0x53, 0x1b, 0xd3, 0x2d, 0x0e, 0xb9, 0x8b, 0x83, 0x3d, 0x59, 0x38,
-	0xa5, 0x4e, 0x2c, 0xa8, 0x0f, 0xa0, 0x3e, 0x22, 0x99, 0x8a, 0x77, 0xcb, 0xf5, 0xb7, 0x76, 0x5b,
-	0x31, 0xeb, 0x1d, 0xc8, 0x91, 0x90, 0x0a, 0xb6, 0xb3, 0xeb, 0x4d, 0xee, 0xe3, 0x9f, 0x87, 0xb5,
-	0xb5, 0xae, 0xdb, 0x60, 0x3d, 0xc2, 0x7a, 0xd4, 0xe9, 0x06, 0x0d, 0x87, 0xb0, 0x03, 0xd7, 0xdf,
-	0x5b, 0x1d, 0x1f, 0xb8, 0xbc, 0xbd, 0xee, 0x2a, 0xbf, 0x9d, 0x83, 0xc6, 0xd6, 0x6e, 0xeb, 0x07,
-	0xd7, 0xf8, 0x90, 0xc4, 0xdd, 0x72, 0x6f, 0xf5, 0x7f, 0x2b, 0xf0, 0xda, 0x1d, 0x1a, 0xc4, 0xc1,
-	0x83, 0x38, 0xd8, 0x4f, 0x79, 0x8f, 0xb1, 0x6d, 0xdc, 0x76, 0x7d, 0xcc, 0x5c, 0x5f, 0xe6, 0xe8,
-	0xca, 0x64, 0x8e, 0xee, 0xf9, 0x5d, 0xec, 0xd0, 0x5f, 0x8b, 0xc2, 0xbd, 0xe7, 0xef, 0x06, 0xc4,
-	0x4f, 0xd1, 0xd7, 0xc7, 0x5c, 0xbc, 0x74, 0x9a, 0x78, 0x9d, 0xb8, 0xbe, 0x45, 0x7c, 0x39, 0xdd,
-	0x44, 0x0b, 0x31, 0xe6, 0xd1, 0x3e, 0x8d, 0xc6, 0x87, 0x39, 0x3d, 0x5a, 0xf0, 0x16, 0xed, 0xe1,
-	0x2e, 0x11, 0x7d, 0x78, 0x4e, 0x17, 0xbf, 0xeb, 0x7f, 0x56, 0x60, 0x71, 0x43, 0xdc, 0x04, 0x13,
-	0xf5, 0xf0, 0x01, 0x14, 0xe4, 0x71, 0xca, 0x7d, 0x4e, 0xab, 0xac, 0x54, 0x01, 0xc4, 0x16, 0xe8,
-	0xc1, 0x44, 0xa6, 0xb2, 0x2f, 0x90, 0x29, 0xb9, 0xd7, 0x31, 0x47, 0xf5, 0xdf, 0x29, 0xb0, 0x18,
-	0x35, 0xc1, 0xff, 0x27, 0xdd, 0x97, 0x2e, 0xd6, 0xbf, 0x28, 0x50, 0x1d, 0xcb, 0x62, 0x73, 0xbb,
-	0xf5, 0x21, 0x79, 0x55, 0xdf, 0xd6, 0xb3, 0x66, 0xfe, 0x2b, 0x30, 0x13, 0xbd, 0x1f, 0xc4, 0xcc,
-	0x52, 0x59, 0xfb, 0xce, 0xa4, 0x77, 0x9d, 0x6b, 0x75, 0x09, 0xaa, 0xff, 0x49, 0x81, 0xea, 0x58,
-	0x1e, 0x5f, 0x29, 0xe1, 0x1f, 0x42, 0x01, 0x7b, 0xd4, 0xe0, 0x2d, 0x2e, 0x4a, 0xee, 0xd2, 0xa4,
-	0xab, 0x28, 0x7c, 0xca, 0x7c, 0x06, 0x7b, 0xf4, 0x43, 0x32, 0xa8, 0xff, 0x55, 0x81, 0x8b, 0x3b,
-	0x49, 0x1f, 0xd8, 0x48, 0xd5, 0xc2, 0x2b, 0xe2, 0x7a, 0xeb, 0x99, 0xa5, 0x7b, 0xe1, 0xe4, 0x20,
-	0x31, 0xc2, 0x3c, 0xb3, 0x52, 0xff, 0x33, 0x1a, 0x5b, 0xe5, 0xe5, 0xc8, 0xcf, 0xad, 0x8b, 0xa9,
-	0x23, 0x28, 0x66, 0x75, 0xf1, 0x1b, 0x5d, 0x87, 0x62, 0x7c, 0xc9, 0xc9, 0x50, 0xea, 0x64, 0xa8,
-	0x3b, 0x52, 0x1f, 0x5f, 0xae, 0x31, 0x1e, 0xfd, 0x64, 0x6c, 0x78, 0x8a, 0x66, 0xd5, 0xc6, 0xe9,
-	0x17, 0xf4, 0x69, 0x33, 0xd4, 0xcb, 0x5e, 0x4c, 0x8f, 0xf2, 0x30, 0x27, 0xa3, 0xed, 0x88, 0xeb,
-	0x1d, 0xbd, 0x0f, 0x79, 0xfe, 0x06, 0x97, 0x67, 0x72, 0xb6, 0x91, 0x52, 0x58, 0xa0, 0x26, 0x94,
-	0xda, 0xae, 0xcb, 0x0c, 0x61, 0xfe, 0x3c, 0x63, 0x6d, 0x91, 0x9b, 0x71, 0x05, 0xba, 0x0d, 0x45,
-	0x39, 0x89, 0xc5, 0xb9, 0xf9, 0xfe, 0x94, 0xdc, 0x44, 0x6c, 0x1b, 0x72, 0xaa, 0x93, 0x89, 0x49,
-	0x8c, 0xd1, 0x16, 0x9c, 0x97, 0xf3, 0x8c, 0x11, 0xa7, 0x3e, 0x7a, 0x3f, 0x9f, 0x72, 0x56, 0xfa,
-	0x82, 0x34, 0x89, 0x05, 0x81, 0x78, 0x2c, 0x7b, 0xea, 0x39, 0xfe, 0xd2, 0x90, 0x8f, 0xe5, 0x6d,
-	0x3d, 0x4b, 0x3d, 0xb4, 0x09, 0x85, 0x3e, 0x61, 0x3e, 0x35, 0xe3, 0xf9, 0xf7, 0x7b, 0xa7, 0xd3,
-	0xbc, 0x1b, 0x81, 0x23, 0x96, 0xb1, 0x29, 0x9f, 0x04, 0xb0, 0xb5, 0x8f, 0x1d, 0x93, 0x58, 0xaa,
-	0x29, 0x1b, 0xe0, 0x64, 0xbe, 0x76, 0xc4, 0xbf, 0x25, 0x7a, 0x02, 0xac, 0x7e, 0x00, 0x73, 0x63,
-	0x9b, 0x7e, 0x9e, 0xe3, 0xae, 0x5e, 0x87, 0xd9, 0x34, 0x95, 0x6f, 0xb3, 0xcd, 0xa6, 0x4b, 0xe5,
-	0xb7, 0x79, 0x58, 0x4a, 0x3e, 0x69, 0xc7, 0x21, 0x26, 0x4f, 0x11, 0xdf, 0x1f, 0x7f, 0xd1, 0xf0,
-	0x41, 0x9e, 0x8b, 0xa2, 0xe7, 0xc8, 0xb7, 0xd7, 0x4e, 0x5e, 0x1c, 0x7c, 0x39, 0xb1, 0x6a, 0x32,
-	0x54, 0x85, 0xa2, 0x00, 0x9a, 0xae, 0x1d, 0x3f, 0xbf, 0xe3, 0x35, 0x7a, 0x00, 0xaf, 0xdb, 0x38,
-	0x60, 0x86, 0x9c, 0x4b, 0x7d, 0x62, 0x12, 0xba, 0x7f, 0xd6, 0xa7, 0x4f, 0x14, 0x6b, 0x91, 0x3b,
-	0x88, 0x8e, 0x43, 0x97, 0xe6, 0x4d, 0x86, 0x6e, 0x40, 0x39, 0xe5, 0x58, 0x5c, 0xbd, 0xe5, 0xb5,
-	0x8b, 0xa7, 0x1e, 0xa6, 0x0e, 0x23, 0x4f, 0x09, 0xb1, 0xd0, 0x13, 0xc3, 0x67, 0x9a, 0xd8, 0xb9,
-	0xe7, 0x21, 0xb6, 0x2b, 0xec, 0x53, 0xc4, 0xde, 0x82, 0x59, 0xe9, 0xd3, 0x74, 0x43, 0x87, 0xa9,
-	0x33, 0xe2, 0x9d, 0x5d, 0x8e, 0x64, 0x1b, 0x5c, 0x84, 0x7e, 0x01, 0x6f, 0x88, 0xd8, 0xc9, 0xe8,
-	0x9b, 0x8e, 0x5e, 0x38, 0x63, 0xf4, 0x25, 0xee, 0x22, 0x1e, 0x86, 0x53, 0xf1, 0xdf, 0x81, 0x4a,
-	0xe2, 0x37, 0x62, 0x50, 0x14, 0x0c, 0xe6, 0x62, 0xa9, 0xe0, 0xb0, 0xfe, 0x07, 0xe5, 0xc9, 0x91,
-	0xa6, 0x3c, 0x3d, 0xd2, 0x94, 0x2f, 0x8e, 0xb4, 0xcc, 0x97, 0x47, 0x5a, 0xe6, 0xab, 0x23, 0x2d,
-	0xf3, 0xf5, 0x91, 0x96, 0xf9, 0xe6, 0x48, 0x53, 0x3e, 0x1a, 0x6a, 0xca, 0xc7, 0x43, 0x2d, 0xf3,
-	0x68, 0xa8, 0x29, 0x9f, 0x0d, 0xb5, 0xcc, 0xe3, 0xa1, 0x96, 0xf9, 0x7c, 0xa8, 0x65, 0x9e, 0x0c,
-	0x35, 0xe5, 0xe9, 0x50, 0x53, 0xbe, 0x18, 0x6a, 0x99, 0x2f, 0x87, 0x9a, 0xf2, 0xd5, 0x50, 0xcb,
-	0x7c, 0x3d, 0xd4, 0x94, 0x6f, 0x86, 0x5a, 0xe6, 0xa3, 0x63, 0x2d, 0xf3, 0xf1, 0xb1, 0xa6, 0x7c,
-	0x72, 0xac, 0x65, 0x7e, 0x7f, 0xac, 0x29, 0x9f, 0x1e, 0x6b, 0x99, 0x47, 0xc7, 0x5a, 0xe6, 0xb3,
-	0x63, 0x4d, 0x79, 0x7c, 0xac, 0x29, 0x9f, 0x1f, 0x6b, 0xca, 0xcf, 0x2f, 0x9f, 0x75, 0x5a, 0x64,
-	0x8e, 0xd7, 0x6e, 0xcf, 0x88, 0xdd, 0x5f, 0xfd, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x15,
-	0x53, 0xe6, 0x0d, 0x15, 0x00, 0x00,
+	// 2195 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x3d, 0x70, 0x1b, 0xc7,
+	0xf5, 0xc7, 0x01, 0x14, 0x01, 0x3c, 0x00, 0x24, 0xb5, 0xa2, 0xa9, 0x13, 0x2c, 0x1d, 0x68, 0xf8,
+	0x6f, 0x99, 0xfa, 0x47, 0x02, 0x27, 0x94, 0xc6, 0x63, 0xcb, 0x89, 0x14, 0x90, 0x94, 0x34, 0x18,
+	0x49, 0x11, 0x73, 0x14, 0xa3, 0x99, 0xa4, 0xb8, 0x2c, 0xee, 0x16, 0xc0, 0x0d, 0x0f, 0x77, 0x97,
+	0xbb, 0x3d, 0x52, 0x4c, 0xe5, 0x22, 0x85, 0x4b, 0x17, 0x29, 0xd2, 0x45, 0xe3, 0x34, 0x2a, 0x52,
+	0xb8, 0xca, 0xa8, 0x74, 0x66, 0x52, 0xa8, 0xca, 0xa8, 0xf4, 0xa4, 0x60, 0x4c, 0xb0, 0x88, 0x53,
+	0xc5, 0x93, 0xca, 0x65, 0x66, 0x3f, 0xee, 0x70, 0x00, 0x3f, 0x22, 0x4a, 0x56, 0x77, 0xfb, 0xde,
+	0xef, 0x7d, 0xec, 0xdb, 0xb7, 0xef, 0xde, 0x5b, 0xa8, 0x39, 0x5e, 0x80, 0xb7, 0xb1, 0x7b, 0x25,
+	0xa4, 0xd8, 0xdc, 0x5c, 0xc4, 0xbe, 0xbd, 0xd8, 0xc5, 0x94, 0x6c, 0xe3, 0x9d, 0x86, 0x1f, 0x78,
+	0xd4, 0x43, 0x53, 0x94, 0xba, 0x0d, 0x09, 0x6a, 0x6c, 0x5d, 0xad, 0x5e, 0xe9, 0xda, 0xb4, 0x17,
+	0xb5, 0x1b, 0xa6, 0xd7, 0x5f, 0xec, 0x7a, 0x5d, 0x6f, 0x91, 0xc3, 0xda, 0x51, 0x87, 0xaf, 0xf8,
+	0x82, 0x7f, 0x09, 0xf1, 0xea, 0x7c, 0xd7, 0xf3, 0xba, 0x0e, 0x19, 0xa2, 0x3a, 0x36, 0x71, 0x2c,
+	0xa3, 0x8f, 0xc3, 0x4d, 0x89, 0x38, 0x3f, 0x8e, 0x08, 0x69, 0x10, 0x99, 0x54, 0x72, 0x6b, 0xe3,
+	0x5c, 0x6a, 0xf7, 0x49, 0x48, 0x71, 0xdf, 0x97, 0x80, 0xff, 0x3b, 0xb8, 0x01, 0xd3, 0x73, 0x29,
+	0x36, 0xa9, 0x61, 0xbb, 0x9d, 0xd8, 0x8d, 0x0b, 0x07, 0x51, 0xc4, 0x8d, 0xfa, 0xa1, 0x64, 0xbf,
+	0x7b, 0x90, 0x6d, 0x5b, 0xc4, 0xa5, 0x76, 0xc7, 0x26, 0x41, 0x0c, 0x9a, 0x3f, 0x08, 0xea, 0x13,
+	0x8a, 0x2d, 0x4c, 0xb1, 0x44, 0x68, 0x07, 0x11, 0x81, 0xdd, 0xed, 0x51, 0xa9, 0xa1, 0xbe, 0x09,
+	0xe5, 0x3b, 0x22, 0xb8, 0xcb, 0x01, 0x76, 0x2d, 0x34, 0x07, 0x59, 0xdb, 0x52, 0x95, 0x79, 0x65,
+	0xa1, 0xb8, 0x3c, 0x39, 0xd8, 0xad, 0x65, 0x5b, 0xab, 0x7a, 0xd6, 0xb6, 0x10, 0x82, 0x09, 0x17,
+	0xf7, 0x89, 0x9a, 0x65, 0x1c, 0x9d, 0x7f, 0xa3, 0x73, 0x90, 0x8b, 0x02, 0x47, 0xcd, 0x71, 0x70,
+	0x7e, 0xb0, 0x5b, 0xcb, 0x6d, 0xe8, 0xf7, 0x74, 0x46, 0x43, 0xb3, 0x70, 0xca, 0xf1, 0xba, 0x5e,
+	0xa8, 0x4e, 0xcc, 0xe7, 0x16, 0x8a, 0xba, 0x58, 0xd4, 0xdb, 0x89, 0xb1, 0xfb, 0x9e, 0x45, 0x1c,
+	0x74, 0x11, 0x0a, 0x6d, 0x66, 0xd5, 0x48, 0x4c, 0x96, 0x06, 0xbb, 0xb5, 0x3c, 0xf7, 0xa4, 0xb5,
+	0xaa, 0xe7, 0x39, 0xb3, 0x15, 0x3b, 0x95, 0x3d, 0xd2, 0xa9, 0xdc, 0xd0, 0xa9, 0xfa, 0x5f, 0x14,
+	0x38, 0x27, 0x8d, 0xfc, 0x9c, 0x04, 0xa1, 0xed, 0xb9, 0xad, 0x61, 0xd8, 0x5e, 0xda, 0xe2, 0x45,
+	0x28, 0xf4, 0x99, 0x8b, 0x46, 0x62, 0x97, 0xe3, 0xb8, 0xdb, 0x0c, 0xc7, 0x99, 0x2d, 0x0b, 0x5d,
+	0x82, 0x99, 0x1e, 0x0e, 0xac, 0x6d, 0x1c, 0x10, 0x63, 0x4b, 0x98, 0x93, 0xde, 0x4c, 0xc7, 0x74,
+	0xe9, 0x05, 0x83, 0x76, 0xec, 0xa0, 0x3f, 0x02, 0x9d, 0x10, 0xd0, 0x98, 0x2e, 0xa1, 0xf5, 0xff,
+	0x64, 0x93, 0x40, 0xe9, 0xd8, 0xb2, 0x3d, 0x34, 0x07, 0x93, 0xc4, 0xc5, 0x6d, 0x87, 0x70, 0xa7,
+	0x0b, 0xba, 0x5c, 0xa1, 0xb7, 0xa1, 0x68, 0xf6, 0x6c, 0xdf, 0xa0, 0x3b, 0x7e, 0x7c, 0x34, 0x05,
+	0x46, 0x78, 0xb8, 0xe3, 0x13, 0x74, 0x1e, 0x8a, 0x9d, 0x80, 0xfc, 0x3a, 0x22, 0xae, 0xb9, 0xc3,
+	0x9d, 0x9a, 0xd0, 0x87, 0x04, 0xb4, 0x08, 0xa5, 0x20, 0x0c, 0x6d, 0xc3, 0xeb, 0x74, 0x42, 0x42,
+	0xb9, 0x27, 0xd9, 0xe5, 0xa9, 0xc1, 0x6e, 0x0d, 0xf4, 0xf5, 0xf5, 0xd6, 0x03, 0x4e, 0xd5, 0x81,
+	0x41, 0xc4, 0x37, 0x7a, 0x04, 0x33, 0xf4, 0xb1, 0x61, 0x7a, 0x6e, 0xc7, 0xee, 0x46, 0x01, 0xa6,
+	0xcc, 0xff, 0x53, 0xf3, 0xca, 0x42, 0x69, 0xe9, 0x72, 0x63, 0xf4, 0x42, 0x36, 0xd2, 0xbe, 0x37,
+	0x1e, 0x3e, 0x5e, 0x49, 0xcb, 0xe8, 0xd3, 0x74, 0x94, 0x50, 0xfd, 0xad, 0x02, 0xd3, 0x63, 0x20,
+	0xf4, 0x2e, 0x54, 0xfa, 0xb6, 0x6b, 0x0c, 0xfd, 0x57, 0xb8, 0xff, 0xe5, 0xbe, 0xed, 0xde, 0x4e,
+	0xb6, 0xc0, 0x40, 0xf8, 0x71, 0x0a, 0x94, 0x95, 0x20, 0xfc, 0x78, 0x08, 0x7a, 0x1f, 0xa6, 0x5d,
+	0x8f, 0x9a, 0x3d, 0x63, 0x3c, 0x16, 0x53, 0x9c, 0x9c, 0x00, 0xeb, 0x7f, 0x53, 0x60, 0x6a, 0x34,
+	0x71, 0xd0, 0x2d, 0xc8, 0xd9, 0x56, 0xc8, 0x6d, 0x97, 0x96, 0x2e, 0x1d, 0xb1, 0xcb, 0x83, 0x59,
+	0xb6, 0x5c, 0x78, 0xbe, 0x5b, 0xcb, 0xbc, 0xd8, 0xad, 0x29, 0x3a, 0x93, 0x67, 0xa7, 0xe7, 0xf7,
+	0x3c, 0xea, 0x85, 0x6a, 0x96, 0xdf, 0x06, 0xb9, 0x42, 0xd7, 0x60, 0x32, 0x60, 0x21, 0x0a, 0xd5,
+	0xdc, 0x7c, 0x6e, 0xa1, 0xb4, 0x74, 0xfe, 0xb8, 0x38, 0xea, 0x12, 0x8b, 0xde, 0x81, 0xb2, 0xe9,
+	0x78, 0xe6, 0xa6, 0x11, 0x7a, 0x51, 0x60, 0x12, 0x35, 0x3f, 0xaf, 0x2c, 0x54, 0xf4, 0x12, 0xa7,
+	0xad, 0x73, 0xd2, 0xf5, 0x89, 0x67, 0x4f, 0x6a, 0x99, 0xfa, 0xe7, 0x45, 0xc8, 0x4b, 0x0d, 0xe8,
+	0x46, 0x7a, 0x27, 0xf5, 0x23, 0xec, 0x1c, 0xb3, 0x85, 0x15, 0x00, 0x33, 0x20, 0x98, 0x12, 0xcb,
+	0xc0, 0x94, 0xc7, 0xb9, 0xb4, 0x54, 0x6d, 0x88, 0x42, 0xd8, 0x88, 0x0b, 0x61, 0xe3, 0x61, 0x5c,
+	0x08, 0x85, 0xf8, 0x67, 0xff, 0xa8, 0x29, 0x7a, 0x51, 0xca, 0x35, 0x29, 0x53, 0x12, 0xf9, 0x56,
+	0xac, 0x24, 0x77, 0x12, 0x25, 0x52, 0xae, 0x49, 0x93, 0x3b, 0x3f, 0x91, 0x2a, 0x44, 0xf3, 0x50,
+	0xb2, 0x48, 0x68, 0x06, 0xb6, 0x9f, 0x64, 0x65, 0x51, 0x4f, 0x93, 0xd0, 0x1d, 0x00, 0x4c, 0x69,
+	0x60, 0xb7, 0x23, 0x4a, 0x42, 0x75, 0x92, 0x87, 0xfb, 0xfd, 0x23, 0xc2, 0xd0, 0x68, 0x26, 0xc8,
+	0x5b, 0x2e, 0x0d, 0x76, 0xf4, 0x94, 0x28, 0xba, 0x01, 0xe5, 0x74, 0x2d, 0x57, 0xf3, 0x5c, 0xd5,
+	0xdb, 0xe3, 0xaa, 0x56, 0x04, 0xa6, 0xe5, 0x76, 0x3c, 0xbd, 0x64, 0x0e, 0x17, 0xe8, 0x21, 0x94,
+	0xe4, 0xe5, 0x37, 0xd8, 0x81, 0x14, 0x5e, 0x3d, 0xb5, 0x60, 0x2b, 0xe6, 0xb2, 0x4c, 0x9a, 0x93,
+	0xbf, 0x48, 0x23, 0x24, 0xc1, 0x16, 0x09, 0x0c, 0x6c, 0x59, 0x01, 0x09, 0x43, 0xb5, 0xc8, 0x63,
+	0x31, 0x2b, 0xb9, 0xeb, 0x9c, 0xd9, 0x14, 0x3c, 0x54, 0x83, 0x12, 0x8e, 0xa8, 0x67, 0x88, 0xe0,
+	0xaa, 0xc0, 0x4b, 0x0b, 0x30, 0xd2, 0x06, 0xa7, 0xa0, 0xf7, 0x60, 0x4a, 0xf0, 0x0c, 0xb3, 0x87,
+	0x5d, 0x97, 0x38, 0x6a, 0x89, 0xab, 0xab, 0x08, 0xea, 0x8a, 0x20, 0xa2, 0x9b, 0x70, 0x3a, 0xb9,
+	0x5c, 0x86, 0xef, 0x60, 0xb6, 0x35, 0xb5, 0xcc, 0xab, 0xe6, 0x99, 0xc1, 0x6e, 0x6d, 0x3a, 0xb9,
+	0x63, 0x6b, 0x0e, 0x76, 0x5b, 0xab, 0xfa, 0x74, 0x67, 0x84, 0x60, 0xa1, 0x9f, 0x40, 0x01, 0xbb,
+	0x94, 0xb8, 0x2e, 0x0e, 0xd5, 0x0a, 0x0f, 0xa8, 0x76, 0x44, 0x44, 0x9a, 0x02, 0xb6, 0x3c, 0xc1,
+	0xc2, 0xa0, 0x27, 0x52, 0xac, 0x14, 0x84, 0x14, 0xd3, 0x28, 0x34, 0xfc, 0xa8, 0xed, 0xd8, 0xa6,
+	0x3a, 0xc5, 0x37, 0x53, 0x16, 0xc4, 0x35, 0x4e, 0x63, 0xa5, 0xc0, 0xf1, 0x4c, 0x5e, 0x60, 0x62,
+	0xd8, 0x34, 0x87, 0x4d, 0xc5, 0x64, 0x09, 0xbc, 0x06, 0x73, 0xa1, 0xd9, 0x23, 0x56, 0xe4, 0x10,
+	0xc3, 0xf2, 0xb6, 0x5d, 0xc7, 0x76, 0x37, 0x0d, 0x87, 0xc5, 0x68, 0x86, 0xe3, 0x67, 0x63, 0xee,
+	0xaa, 0x64, 0xde, 0x63, 0xd1, 0xba, 0x0c, 0x88, 0xb8, 0x1d, 0x2f, 0x30, 0x89, 0x61, 0x45, 0x74,
+	0xc7, 0x30, 0x77, 0x4c, 0x87, 0xa8, 0xa7, 0xb9, 0xc4, 0x8c, 0xe4, 0xac, 0x46, 0x74, 0x67, 0x85,
+	0xd1, 0xd1, 0xaf, 0x40, 0x4d, 0x54, 0xfb, 0x98, 0xf6, 0x58, 0x65, 0x0d, 0x69, 0x80, 0x6d, 0x97,
+	0xaa, 0x68, 0x5e, 0x59, 0x98, 0x5a, 0xba, 0x38, 0x1e, 0x83, 0xd8, 0xda, 0x1a, 0xa6, 0xbd, 0x95,
+	0x04, 0xad, 0xcf, 0x59, 0x87, 0xd2, 0xd1, 0x47, 0x30, 0x1d, 0x59, 0xbe, 0x81, 0x1d, 0xc7, 0xdb,
+	0x26, 0x96, 0x61, 0xfb, 0xa1, 0x7a, 0x86, 0xd5, 0x9f, 0xe5, 0xd3, 0x83, 0xdd, 0x5a, 0x65, 0x63,
+	0x75, 0xad, 0x29, 0x38, 0xad, 0xb5, 0x50, 0xaf, 0x44, 0x96, 0x1f, 0x2f, 0xfd, 0x10, 0x5d, 0x82,
+	0x22, 0x13, 0xa5, 0xde, 0x26, 0x71, 0xd5, 0x59, 0x7e, 0x92, 0xe5, 0xc1, 0x6e, 0xad, 0xb0, 0xb1,
+	0xba, 0xf6, 0x90, 0xd1, 0xf4, 0x42, 0x64, 0xf9, 0xfc, 0xab, 0xfa, 0x63, 0x98, 0x1e, 0xbb, 0x2f,
+	0x68, 0x06, 0x72, 0x9b, 0x44, 0x94, 0xec, 0xa2, 0xce, 0x3e, 0x59, 0x3b, 0xb0, 0x85, 0x9d, 0x28,
+	0xfe, 0x47, 0x89, 0xc5, 0xf5, 0xec, 0x87, 0x4a, 0xfd, 0x26, 0x14, 0xe4, 0xd1, 0x86, 0xe8, 0x2a,
+	0x14, 0x64, 0x9e, 0xb2, 0x4a, 0xc5, 0xd2, 0xe0, 0xec, 0x51, 0x15, 0x31, 0x01, 0xd6, 0xff, 0xa8,
+	0xc0, 0xe9, 0x3b, 0x84, 0xc6, 0x0c, 0x96, 0x58, 0x21, 0x45, 0xf7, 0xa1, 0x14, 0x5f, 0x88, 0x57,
+	0xad, 0x7b, 0xd0, 0x8d, 0xb9, 0x21, 0xba, 0x09, 0x30, 0x6c, 0x12, 0x8f, 0x2c, 0x7f, 0xb7, 0x19,
+	0xe4, 0x3e, 0x0e, 0x37, 0x65, 0x7a, 0x16, 0x3b, 0x31, 0xa1, 0xbe, 0x03, 0xf5, 0xa1, 0x93, 0x29,
+	0x7b, 0xb7, 0xbd, 0xe0, 0xd6, 0x46, 0x2b, 0xf6, 0x7a, 0x1d, 0x72, 0x24, 0xb2, 0xb9, 0xb7, 0xe5,
+	0xe5, 0x26, 0xd3, 0xf1, 0xf7, 0xdd, 0xda, 0x52, 0xd7, 0x6b, 0xd0, 0x1e, 0xa1, 0x3d, 0xdb, 0xed,
+	0x86, 0x0d, 0x97, 0xd0, 0x6d, 0x2f, 0xd8, 0x5c, 0x1c, 0x6d, 0xeb, 0xfc, 0xcd, 0xee, 0x22, 0xeb,
+	0x01, 0xc2, 0xc6, 0xad, 0x8d, 0xd6, 0x07, 0xd7, 0x58, 0x2b, 0xc6, 0xd4, 0x32, 0x6d, 0xf5, 0x7f,
+	0x29, 0x70, 0xe6, 0x9e, 0x1d, 0xc6, 0xc6, 0xc3, 0xd8, 0xd8, 0xcf, 0x58, 0x25, 0x73, 0x1c, 0xdc,
+	0xf6, 0x02, 0x4c, 0xbd, 0x40, 0xc6, 0xe8, 0xca, 0x78, 0x8c, 0x1e, 0x04, 0x5d, 0xec, 0xda, 0xbf,
+	0xe1, 0xd7, 0xe3, 0x41, 0xb0, 0x11, 0x92, 0x20, 0xe5, 0xbe, 0x3e, 0xa2, 0xe2, 0xb5, 0xc3, 0xc4,
+	0xf2, 0xc4, 0x0b, 0x2c, 0x12, 0xc8, 0x1e, 0x4a, 0x2c, 0x18, 0xd5, 0xb1, 0xfb, 0xb6, 0x68, 0x52,
+	0x2a, 0xba, 0x58, 0xb0, 0x1f, 0x81, 0x8f, 0xbb, 0x84, 0x57, 0xfb, 0x8a, 0xce, 0xbf, 0xeb, 0x7f,
+	0x52, 0x60, 0x76, 0x85, 0xff, 0x6f, 0xc6, 0xf2, 0xe1, 0x63, 0xc8, 0xcb, 0xe3, 0x94, 0xfb, 0x3c,
+	0x2a, 0xb3, 0x52, 0x09, 0x10, 0x4b, 0xa0, 0x47, 0x63, 0x91, 0xca, 0xbe, 0x42, 0xa4, 0xe4, 0x5e,
+	0x47, 0x14, 0xd5, 0x7f, 0xa7, 0xc0, 0xac, 0x28, 0xb5, 0xdf, 0xa7, 0xbb, 0xaf, 0x9d, 0xac, 0x4f,
+	0xb2, 0x50, 0x1d, 0x89, 0x62, 0x73, 0xad, 0x75, 0x97, 0xbc, 0xa9, 0xbb, 0x75, 0xd8, 0x64, 0x71,
+	0x05, 0x26, 0xc5, 0x94, 0xc2, 0x3b, 0xa3, 0xa9, 0xa5, 0xb7, 0xc6, 0xb5, 0xeb, 0x8c, 0xab, 0x4b,
+	0x10, 0xdb, 0x31, 0x79, 0xec, 0xdb, 0x01, 0x09, 0x0d, 0x2c, 0xb2, 0xe4, 0xf8, 0xc6, 0x62, 0x42,
+	0x34, 0x15, 0x52, 0xa6, 0x49, 0x59, 0x33, 0x9c, 0x2e, 0x93, 0xa7, 0x78, 0x99, 0xe4, 0xcd, 0x70,
+	0xaa, 0x46, 0x02, 0x4e, 0x0a, 0x64, 0xfd, 0x9f, 0x0a, 0x54, 0x47, 0x4e, 0xee, 0x8d, 0x86, 0xe8,
+	0x23, 0xc8, 0x63, 0xdf, 0x36, 0x58, 0x51, 0x15, 0xc7, 0x39, 0x37, 0xae, 0x4a, 0x98, 0x4f, 0x89,
+	0x4f, 0x62, 0xdf, 0xbe, 0x4b, 0xc6, 0x93, 0x21, 0x77, 0xf2, 0x64, 0xf8, 0xb3, 0x02, 0x17, 0xd6,
+	0x93, 0xd2, 0xb5, 0x92, 0x4a, 0xdf, 0x37, 0xb4, 0xd9, 0xdb, 0x87, 0xde, 0xb6, 0xf3, 0x07, 0x3b,
+	0xac, 0x21, 0xe6, 0xd0, 0xcb, 0xf5, 0xef, 0x61, 0x3f, 0x2f, 0xbb, 0x06, 0x96, 0x6a, 0x5d, 0x6c,
+	0xbb, 0xdc, 0xc5, 0xac, 0xce, 0xbf, 0xd1, 0x75, 0x28, 0xc4, 0x7f, 0x7f, 0x69, 0x4a, 0x1d, 0x37,
+	0x75, 0x4f, 0xf2, 0xe3, 0xae, 0x23, 0xc6, 0xa3, 0x9f, 0x8e, 0x74, 0x95, 0xa2, 0x89, 0x6f, 0x1c,
+	0xdf, 0xb9, 0x1c, 0xd7, 0x5c, 0xbe, 0xee, 0xbf, 0xf4, 0xe9, 0x04, 0x54, 0xa4, 0xb5, 0x75, 0xde,
+	0xf7, 0xa0, 0x0f, 0x61, 0x82, 0x3d, 0x4e, 0xa8, 0xca, 0x11, 0xe7, 0x7e, 0x58, 0xaf, 0xcd, 0x25,
+	0x50, 0x13, 0x8a, 0x6d, 0xcf, 0xa3, 0x06, 0x17, 0x3f, 0x49, 0xbf, 0x5f, 0x60, 0x62, 0x8c, 0x81,
+	0xee, 0x40, 0x41, 0xb6, 0xa8, 0x71, 0x6c, 0x7e, 0x70, 0x44, 0x6c, 0x84, 0xb7, 0x0d, 0xd9, 0xee,
+	0xca, 0xc0, 0x24, 0xc2, 0xe8, 0x16, 0x9c, 0x96, 0x8d, 0x9e, 0x11, 0x87, 0x5e, 0x3c, 0x2c, 0x1c,
+	0x73, 0x56, 0xfa, 0x8c, 0x14, 0x89, 0x09, 0x21, 0x7f, 0x45, 0xf0, 0xe5, 0xdd, 0x16, 0xaf, 0x08,
+	0x6b, 0x7a, 0xd6, 0xf6, 0xd1, 0x2a, 0xe4, 0xfb, 0x84, 0x06, 0xb6, 0x19, 0x0f, 0x06, 0xff, 0x7f,
+	0xbc, 0x9b, 0xf7, 0x05, 0x58, 0x78, 0x19, 0x8b, 0xb2, 0xe6, 0x05, 0x5b, 0x5b, 0xd8, 0x35, 0x89,
+	0xa5, 0x9a, 0xb2, 0x66, 0x8f, 0xc7, 0x6b, 0x9d, 0x3f, 0x23, 0xe9, 0x09, 0xb0, 0xfa, 0x31, 0x54,
+	0x46, 0x36, 0x7d, 0x92, 0xe3, 0xae, 0x5e, 0x87, 0x72, 0xda, 0x95, 0xff, 0x25, 0x9b, 0x4d, 0xa7,
+	0xca, 0x1f, 0xf2, 0x30, 0x97, 0x5c, 0x69, 0xd7, 0x25, 0x26, 0x0b, 0x11, 0xdb, 0x1f, 0x1b, 0xf5,
+	0xca, 0xa6, 0x20, 0x89, 0x39, 0x4d, 0x79, 0xc9, 0x72, 0x5a, 0x4a, 0xa4, 0x9a, 0x14, 0x55, 0xa1,
+	0xc0, 0x81, 0xa6, 0xe7, 0xc4, 0xef, 0x12, 0xf1, 0x1a, 0x3d, 0x82, 0xb3, 0x0e, 0x0e, 0xa9, 0x21,
+	0x1b, 0xf6, 0x80, 0x98, 0xc4, 0xde, 0x7a, 0xd9, 0x99, 0x50, 0xd8, 0x9a, 0x65, 0x0a, 0xc4, 0x71,
+	0xe8, 0x52, 0xbc, 0x49, 0xd1, 0x0d, 0x28, 0xa5, 0x14, 0xcb, 0xff, 0xc0, 0x85, 0x63, 0x0f, 0x53,
+	0x87, 0xa1, 0xa6, 0xc4, 0xb1, 0xc8, 0xe7, 0x5d, 0x79, 0xda, 0xb1, 0x53, 0x27, 0x71, 0x6c, 0x83,
+	0xcb, 0xa7, 0x1c, 0x7b, 0x07, 0xca, 0x52, 0xa7, 0xe9, 0x45, 0x2e, 0x55, 0x27, 0xf9, 0x03, 0x44,
+	0x49, 0xd0, 0x56, 0x18, 0x09, 0xfd, 0x12, 0xce, 0x71, 0xdb, 0xc9, 0x4c, 0x90, 0xb6, 0x9e, 0x7f,
+	0x49, 0xeb, 0x73, 0x4c, 0x45, 0x3c, 0x25, 0xa4, 0xec, 0xbf, 0x07, 0x53, 0x89, 0x5e, 0xe1, 0x41,
+	0x81, 0x7b, 0x50, 0x89, 0xa9, 0xc2, 0x87, 0xbb, 0x50, 0x0c, 0xa3, 0xb6, 0xd1, 0xc6, 0xae, 0xc5,
+	0x06, 0xc7, 0xe3, 0xaa, 0xd9, 0x58, 0xd2, 0x34, 0xd6, 0xa3, 0xf6, 0x32, 0x76, 0x2d, 0xbd, 0x10,
+	0x8a, 0x8f, 0xf0, 0xf0, 0xa1, 0x10, 0x4e, 0x30, 0x14, 0x7e, 0x00, 0x67, 0xc7, 0x66, 0x5a, 0xdb,
+	0x0d, 0x29, 0xbb, 0x37, 0x72, 0x0a, 0x7d, 0x6b, 0x64, 0xa8, 0x6d, 0x49, 0x66, 0xf5, 0xaf, 0x0a,
+	0xe4, 0xa5, 0x3b, 0xdf, 0xe3, 0x33, 0xd2, 0x8f, 0xa0, 0x9a, 0x84, 0x30, 0xa2, 0xb6, 0x23, 0xfb,
+	0x3c, 0x43, 0x34, 0xa6, 0x39, 0x7e, 0xbf, 0x92, 0x81, 0x6e, 0x63, 0x08, 0xb8, 0xc7, 0xf8, 0xe8,
+	0x87, 0x30, 0x7b, 0x98, 0xb4, 0x78, 0x75, 0xd3, 0xcf, 0x1c, 0x22, 0xb7, 0xfc, 0xb9, 0xf2, 0x7c,
+	0x4f, 0x53, 0x5e, 0xec, 0x69, 0xca, 0x57, 0x7b, 0x5a, 0xe6, 0xeb, 0x3d, 0x2d, 0xf3, 0xcd, 0x9e,
+	0x96, 0xf9, 0x76, 0x4f, 0xcb, 0x7c, 0xb7, 0xa7, 0x29, 0x9f, 0x0c, 0x34, 0xe5, 0xd3, 0x81, 0x96,
+	0x79, 0x3a, 0xd0, 0x94, 0x2f, 0x06, 0x5a, 0xe6, 0xd9, 0x40, 0xcb, 0x7c, 0x39, 0xd0, 0x32, 0xcf,
+	0x07, 0x9a, 0xf2, 0x62, 0xa0, 0x29, 0x5f, 0x0d, 0xb4, 0xcc, 0xd7, 0x03, 0x4d, 0xf9, 0x66, 0xa0,
+	0x65, 0xbe, 0x1d, 0x68, 0xca, 0x77, 0x03, 0x2d, 0xf3, 0xc9, 0xbe, 0x96, 0xf9, 0x74, 0x5f, 0x53,
+	0x3e, 0xdb, 0xd7, 0x32, 0xbf, 0xdf, 0xd7, 0x94, 0x27, 0xfb, 0x5a, 0xe6, 0xe9, 0xbe, 0x96, 0xf9,
+	0x62, 0x5f, 0x53, 0x9e, 0xed, 0x6b, 0xca, 0x97, 0xfb, 0x9a, 0xf2, 0x8b, 0xcb, 0x2f, 0x3b, 0x6d,
+	0x50, 0xd7, 0x6f, 0xb7, 0x27, 0x79, 0x2a, 0x5e, 0xfd, 0xef, 0x00, 0xb4, 0x2b, 0x3d, 0x62, 0xb3,
+	0x17, 0x00, 0x00,
 }
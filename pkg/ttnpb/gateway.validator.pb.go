@@ -145,6 +145,9 @@ func (this *UpdateGatewayAPIKeyRequest) Validate() error {
 	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.APIKey)); err != nil {
 		return github_com_mwitkow_go_proto_validators.FieldError("APIKey", err)
 	}
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.FieldMask)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("FieldMask", err)
+	}
 	return nil
 }
 func (this *SetGatewayCollaboratorRequest) Validate() error {
@@ -212,5 +215,15 @@ func (this *GatewayConnectionStats) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("LastDownlinkReceivedAt", err)
 		}
 	}
+	for _, item := range this.SubBands {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("SubBands", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *GatewayConnectionStats_SubBand) Validate() error {
 	return nil
 }
@@ -136,6 +136,11 @@ type GatewayRegistryClient interface {
 	List(ctx context.Context, in *ListGatewaysRequest, opts ...grpc.CallOption) (*Gateways, error)
 	Update(ctx context.Context, in *UpdateGatewayRequest, opts ...grpc.CallOption) (*Gateway, error)
 	Delete(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Restore a deleted gateway. A deleted gateway can be restored until it is purged.
+	Restore(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Purge the gateway. This permanently deletes the gateway and releases its IDs.
+	// This is only available to admins.
+	Purge(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type gatewayRegistryClient struct {
@@ -200,6 +205,24 @@ func (c *gatewayRegistryClient) Delete(ctx context.Context, in *GatewayIdentifie
 	return out, nil
 }
 
+func (c *gatewayRegistryClient) Restore(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GatewayRegistry/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayRegistryClient) Purge(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GatewayRegistry/Purge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GatewayRegistryServer is the server API for GatewayRegistry service.
 type GatewayRegistryServer interface {
 	// Create a new gateway. This also sets the given organization or user as
@@ -214,6 +237,11 @@ type GatewayRegistryServer interface {
 	List(context.Context, *ListGatewaysRequest) (*Gateways, error)
 	Update(context.Context, *UpdateGatewayRequest) (*Gateway, error)
 	Delete(context.Context, *GatewayIdentifiers) (*types.Empty, error)
+	// Restore a deleted gateway. A deleted gateway can be restored until it is purged.
+	Restore(context.Context, *GatewayIdentifiers) (*types.Empty, error)
+	// Purge the gateway. This permanently deletes the gateway and releases its IDs.
+	// This is only available to admins.
+	Purge(context.Context, *GatewayIdentifiers) (*types.Empty, error)
 }
 
 func RegisterGatewayRegistryServer(s *grpc.Server, srv GatewayRegistryServer) {
@@ -328,6 +356,42 @@ func _GatewayRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayRegistry_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayRegistryServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GatewayRegistry/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayRegistryServer).Restore(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayRegistry_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayRegistryServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GatewayRegistry/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayRegistryServer).Purge(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GatewayRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.GatewayRegistry",
 	HandlerType: (*GatewayRegistryServer)(nil),
@@ -356,6 +420,14 @@ var _GatewayRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _GatewayRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _GatewayRegistry_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _GatewayRegistry_Purge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/gateway_services.proto",
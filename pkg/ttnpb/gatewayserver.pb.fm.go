@@ -18,6 +18,91 @@ var GatewayUpFieldPathsNested = []string{
 	"gateway_status.versions",
 	"tx_acknowledgment",
 	"tx_acknowledgment.correlation_ids",
+	"tx_acknowledgment.downlink_message",
+	"tx_acknowledgment.downlink_message.correlation_ids",
+	"tx_acknowledgment.downlink_message.end_device_ids",
+	"tx_acknowledgment.downlink_message.end_device_ids.application_ids",
+	"tx_acknowledgment.downlink_message.end_device_ids.application_ids.application_id",
+	"tx_acknowledgment.downlink_message.end_device_ids.dev_addr",
+	"tx_acknowledgment.downlink_message.end_device_ids.dev_eui",
+	"tx_acknowledgment.downlink_message.end_device_ids.device_id",
+	"tx_acknowledgment.downlink_message.end_device_ids.join_eui",
+	"tx_acknowledgment.downlink_message.payload",
+	"tx_acknowledgment.downlink_message.payload.Payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.net_id",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.join_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_port",
+	"tx_acknowledgment.downlink_message.payload.Payload.mac_payload.frm_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"tx_acknowledgment.downlink_message.payload.m_hdr",
+	"tx_acknowledgment.downlink_message.payload.m_hdr.m_type",
+	"tx_acknowledgment.downlink_message.payload.m_hdr.major",
+	"tx_acknowledgment.downlink_message.payload.mic",
+	"tx_acknowledgment.downlink_message.raw_payload",
+	"tx_acknowledgment.downlink_message.settings",
+	"tx_acknowledgment.downlink_message.settings.request",
+	"tx_acknowledgment.downlink_message.settings.request.absolute_time",
+	"tx_acknowledgment.downlink_message.settings.request.advanced",
+	"tx_acknowledgment.downlink_message.settings.request.class",
+	"tx_acknowledgment.downlink_message.settings.request.downlink_paths",
+	"tx_acknowledgment.downlink_message.settings.request.priority",
+	"tx_acknowledgment.downlink_message.settings.request.rx1_data_rate_index",
+	"tx_acknowledgment.downlink_message.settings.request.rx1_delay",
+	"tx_acknowledgment.downlink_message.settings.request.rx1_frequency",
+	"tx_acknowledgment.downlink_message.settings.request.rx2_data_rate_index",
+	"tx_acknowledgment.downlink_message.settings.request.rx2_frequency",
+	"tx_acknowledgment.downlink_message.settings.scheduled",
+	"tx_acknowledgment.downlink_message.settings.scheduled.antenna_index",
+	"tx_acknowledgment.downlink_message.settings.scheduled.coding_rate",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate_index",
+	"tx_acknowledgment.downlink_message.settings.scheduled.device_channel_index",
+	"tx_acknowledgment.downlink_message.settings.scheduled.enable_crc",
+	"tx_acknowledgment.downlink_message.settings.scheduled.frequency",
+	"tx_acknowledgment.downlink_message.settings.scheduled.gateway_channel_index",
+	"tx_acknowledgment.downlink_message.settings.scheduled.invert_polarization",
+	"tx_acknowledgment.downlink_message.settings.scheduled.time",
+	"tx_acknowledgment.downlink_message.settings.scheduled.timestamp",
+	"tx_acknowledgment.downlink_message.settings.scheduled.tx_power",
 	"tx_acknowledgment.result",
 	"uplink_messages",
 }
@@ -158,6 +243,7 @@ var GatewayDownFieldPathsNested = []string{
 	"downlink_message.settings.request.rx2_data_rate_index",
 	"downlink_message.settings.request.rx2_frequency",
 	"downlink_message.settings.scheduled",
+	"downlink_message.settings.scheduled.antenna_index",
 	"downlink_message.settings.scheduled.coding_rate",
 	"downlink_message.settings.scheduled.data_rate",
 	"downlink_message.settings.scheduled.data_rate.modulation",
@@ -241,3 +327,357 @@ func (dst *ScheduleDownlinkResponse) SetFields(src *ScheduleDownlinkResponse, pa
 	}
 	return nil
 }
+
+var ListConnectedGatewaysRequestFieldPathsNested = []string{
+	"frequency_plan_id",
+	"limit",
+	"page",
+	"protocol",
+}
+
+var ListConnectedGatewaysRequestFieldPathsTopLevel = []string{
+	"frequency_plan_id",
+	"limit",
+	"page",
+	"protocol",
+}
+
+func (dst *ListConnectedGatewaysRequest) SetFields(src *ListConnectedGatewaysRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "frequency_plan_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency_plan_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FrequencyPlanID = src.FrequencyPlanID
+			} else {
+				var zero string
+				dst.FrequencyPlanID = zero
+			}
+		case "protocol":
+			if len(subs) > 0 {
+				return fmt.Errorf("'protocol' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Protocol = src.Protocol
+			} else {
+				var zero string
+				dst.Protocol = zero
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ConnectedGatewayFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"stats",
+	"stats.connected_at",
+	"stats.downlink_count",
+	"stats.frequency_plan_id",
+	"stats.gateway_server_instance",
+	"stats.last_downlink_received_at",
+	"stats.last_status",
+	"stats.last_status.advanced",
+	"stats.last_status.antenna_locations",
+	"stats.last_status.boot_time",
+	"stats.last_status.ip",
+	"stats.last_status.metrics",
+	"stats.last_status.time",
+	"stats.last_status.versions",
+	"stats.last_status_received_at",
+	"stats.last_uplink_received_at",
+	"stats.protocol",
+	"stats.sub_bands",
+	"stats.uplink_count",
+}
+
+var ConnectedGatewayFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"stats",
+}
+
+func (dst *ConnectedGateway) SetFields(src *ConnectedGateway, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				newDst := &dst.GatewayIdentifiers
+				var newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "stats":
+			if len(subs) > 0 {
+				newDst := &dst.Stats
+				var newSrc *GatewayConnectionStats
+				if src != nil {
+					newSrc = &src.Stats
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Stats = src.Stats
+				} else {
+					var zero GatewayConnectionStats
+					dst.Stats = zero
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ConnectedGatewaysFieldPathsNested = []string{
+	"gateways",
+}
+
+var ConnectedGatewaysFieldPathsTopLevel = []string{
+	"gateways",
+}
+
+func (dst *ConnectedGateways) SetFields(src *ConnectedGateways, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "gateways":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateways' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Gateways = src.Gateways
+			} else {
+				dst.Gateways = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var GetGatewayStatusHistoryRequestFieldPathsNested = []string{
+	"from",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"interval",
+	"to",
+}
+
+var GetGatewayStatusHistoryRequestFieldPathsTopLevel = []string{
+	"from",
+	"gateway_ids",
+	"interval",
+	"to",
+}
+
+func (dst *GetGatewayStatusHistoryRequest) SetFields(src *GetGatewayStatusHistoryRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				newDst := &dst.GatewayIdentifiers
+				var newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "from":
+			if len(subs) > 0 {
+				return fmt.Errorf("'from' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.From = src.From
+			} else {
+				dst.From = nil
+			}
+		case "to":
+			if len(subs) > 0 {
+				return fmt.Errorf("'to' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.To = src.To
+			} else {
+				dst.To = nil
+			}
+		case "interval":
+			if len(subs) > 0 {
+				return fmt.Errorf("'interval' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Interval = src.Interval
+			} else {
+				var zero time.Duration
+				dst.Interval = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var GatewayStatusHistoryFieldPathsNested = []string{
+	"samples",
+}
+
+var GatewayStatusHistoryFieldPathsTopLevel = []string{
+	"samples",
+}
+
+func (dst *GatewayStatusHistory) SetFields(src *GatewayStatusHistory, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "samples":
+			if len(subs) > 0 {
+				return fmt.Errorf("'samples' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Samples = src.Samples
+			} else {
+				dst.Samples = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var GatewayStatusHistory_SampleFieldPathsNested = []string{
+	"downlink_count",
+	"status",
+	"status.advanced",
+	"status.antenna_locations",
+	"status.boot_time",
+	"status.ip",
+	"status.metrics",
+	"status.time",
+	"status.versions",
+	"time",
+	"uplink_count",
+}
+
+var GatewayStatusHistory_SampleFieldPathsTopLevel = []string{
+	"downlink_count",
+	"status",
+	"time",
+	"uplink_count",
+}
+
+func (dst *GatewayStatusHistory_Sample) SetFields(src *GatewayStatusHistory_Sample, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				var zero time.Time
+				dst.Time = zero
+			}
+		case "status":
+			if len(subs) > 0 {
+				newDst := dst.Status
+				if newDst == nil {
+					newDst = &GatewayStatus{}
+					dst.Status = newDst
+				}
+				var newSrc *GatewayStatus
+				if src != nil {
+					newSrc = src.Status
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Status = src.Status
+				} else {
+					dst.Status = nil
+				}
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint64
+				dst.UplinkCount = zero
+			}
+		case "downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkCount = src.DownlinkCount
+			} else {
+				var zero uint64
+				dst.DownlinkCount = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -193,6 +193,353 @@ func (m *ScheduleDownlinkResponse) GetDelay() time.Duration {
 	return 0
 }
 
+type ListConnectedGatewaysRequest struct {
+	// If set, only gateways with this frequency plan ID are listed.
+	FrequencyPlanID string `protobuf:"bytes,1,opt,name=frequency_plan_id,json=frequencyPlanId,proto3" json:"frequency_plan_id,omitempty"`
+	// If set, only gateways connected with this protocol are listed.
+	Protocol string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// Limit the number of results per page.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page                 uint32   `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListConnectedGatewaysRequest) Reset()      { *m = ListConnectedGatewaysRequest{} }
+func (*ListConnectedGatewaysRequest) ProtoMessage() {}
+func (*ListConnectedGatewaysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gatewayserver_9e6408bf5e13ce4c, []int{3}
+}
+func (m *ListConnectedGatewaysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListConnectedGatewaysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListConnectedGatewaysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ListConnectedGatewaysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListConnectedGatewaysRequest.Merge(dst, src)
+}
+func (m *ListConnectedGatewaysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListConnectedGatewaysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListConnectedGatewaysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListConnectedGatewaysRequest proto.InternalMessageInfo
+
+func (m *ListConnectedGatewaysRequest) GetFrequencyPlanID() string {
+	if m != nil {
+		return m.FrequencyPlanID
+	}
+	return ""
+}
+
+func (m *ListConnectedGatewaysRequest) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *ListConnectedGatewaysRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListConnectedGatewaysRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+type ConnectedGateway struct {
+	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	Stats                GatewayConnectionStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ConnectedGateway) Reset()      { *m = ConnectedGateway{} }
+func (*ConnectedGateway) ProtoMessage() {}
+func (*ConnectedGateway) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gatewayserver_9e6408bf5e13ce4c, []int{4}
+}
+func (m *ConnectedGateway) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectedGateway) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectedGateway.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ConnectedGateway) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectedGateway.Merge(dst, src)
+}
+func (m *ConnectedGateway) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectedGateway) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectedGateway.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectedGateway proto.InternalMessageInfo
+
+func (m *ConnectedGateway) GetStats() GatewayConnectionStats {
+	if m != nil {
+		return m.Stats
+	}
+	return GatewayConnectionStats{}
+}
+
+type ConnectedGateways struct {
+	Gateways             []*ConnectedGateway `protobuf:"bytes,1,rep,name=gateways,proto3" json:"gateways,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ConnectedGateways) Reset()      { *m = ConnectedGateways{} }
+func (*ConnectedGateways) ProtoMessage() {}
+func (*ConnectedGateways) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gatewayserver_9e6408bf5e13ce4c, []int{5}
+}
+func (m *ConnectedGateways) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectedGateways) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectedGateways.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ConnectedGateways) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectedGateways.Merge(dst, src)
+}
+func (m *ConnectedGateways) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectedGateways) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectedGateways.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectedGateways proto.InternalMessageInfo
+
+func (m *ConnectedGateways) GetGateways() []*ConnectedGateway {
+	if m != nil {
+		return m.Gateways
+	}
+	return nil
+}
+
+type GetGatewayStatusHistoryRequest struct {
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	// Start of the time range. If not set, the history is returned from the start of the retention period.
+	From *time.Time `protobuf:"bytes,2,opt,name=from,proto3,stdtime" json:"from,omitempty"`
+	// End of the time range. If not set, the history is returned until now.
+	To *time.Time `protobuf:"bytes,3,opt,name=to,proto3,stdtime" json:"to,omitempty"`
+	// Interval to downsample the history to. If not set, the samples are returned as recorded.
+	Interval             time.Duration `protobuf:"bytes,4,opt,name=interval,proto3,stdduration" json:"interval"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetGatewayStatusHistoryRequest) Reset()      { *m = GetGatewayStatusHistoryRequest{} }
+func (*GetGatewayStatusHistoryRequest) ProtoMessage() {}
+func (*GetGatewayStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gatewayserver_9e6408bf5e13ce4c, []int{6}
+}
+func (m *GetGatewayStatusHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetGatewayStatusHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetGatewayStatusHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GetGatewayStatusHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGatewayStatusHistoryRequest.Merge(dst, src)
+}
+func (m *GetGatewayStatusHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetGatewayStatusHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGatewayStatusHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGatewayStatusHistoryRequest proto.InternalMessageInfo
+
+func (m *GetGatewayStatusHistoryRequest) GetFrom() *time.Time {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *GetGatewayStatusHistoryRequest) GetTo() *time.Time {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *GetGatewayStatusHistoryRequest) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+type GatewayStatusHistory struct {
+	Samples              []*GatewayStatusHistory_Sample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *GatewayStatusHistory) Reset()      { *m = GatewayStatusHistory{} }
+func (*GatewayStatusHistory) ProtoMessage() {}
+func (*GatewayStatusHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gatewayserver_9e6408bf5e13ce4c, []int{7}
+}
+func (m *GatewayStatusHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayStatusHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayStatusHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GatewayStatusHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayStatusHistory.Merge(dst, src)
+}
+func (m *GatewayStatusHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayStatusHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayStatusHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayStatusHistory proto.InternalMessageInfo
+
+func (m *GatewayStatusHistory) GetSamples() []*GatewayStatusHistory_Sample {
+	if m != nil {
+		return m.Samples
+	}
+	return nil
+}
+
+type GatewayStatusHistory_Sample struct {
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// Gateway status received at the time of the sample, if any.
+	// If the history is downsampled, this is the last status in the interval, with the metrics averaged over the interval.
+	Status *GatewayStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Number of uplink messages received since the previous sample.
+	UplinkCount uint64 `protobuf:"varint,3,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Number of downlink messages sent since the previous sample.
+	DownlinkCount        uint64   `protobuf:"varint,4,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayStatusHistory_Sample) Reset()      { *m = GatewayStatusHistory_Sample{} }
+func (*GatewayStatusHistory_Sample) ProtoMessage() {}
+func (*GatewayStatusHistory_Sample) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gatewayserver_9e6408bf5e13ce4c, []int{7, 0}
+}
+func (m *GatewayStatusHistory_Sample) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayStatusHistory_Sample) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayStatusHistory_Sample.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GatewayStatusHistory_Sample) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayStatusHistory_Sample.Merge(dst, src)
+}
+func (m *GatewayStatusHistory_Sample) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayStatusHistory_Sample) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayStatusHistory_Sample.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayStatusHistory_Sample proto.InternalMessageInfo
+
+func (m *GatewayStatusHistory_Sample) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *GatewayStatusHistory_Sample) GetStatus() *GatewayStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GatewayStatusHistory_Sample) GetUplinkCount() uint64 {
+	if m != nil {
+		return m.UplinkCount
+	}
+	return 0
+}
+
+func (m *GatewayStatusHistory_Sample) GetDownlinkCount() uint64 {
+	if m != nil {
+		return m.DownlinkCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
 	golang_proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
@@ -200,6 +547,18 @@ func init() {
 	golang_proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
 	proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
 	golang_proto.RegisterType((*ScheduleDownlinkResponse)(nil), "ttn.lorawan.v3.ScheduleDownlinkResponse")
+	proto.RegisterType((*ListConnectedGatewaysRequest)(nil), "ttn.lorawan.v3.ListConnectedGatewaysRequest")
+	golang_proto.RegisterType((*ListConnectedGatewaysRequest)(nil), "ttn.lorawan.v3.ListConnectedGatewaysRequest")
+	proto.RegisterType((*ConnectedGateway)(nil), "ttn.lorawan.v3.ConnectedGateway")
+	golang_proto.RegisterType((*ConnectedGateway)(nil), "ttn.lorawan.v3.ConnectedGateway")
+	proto.RegisterType((*ConnectedGateways)(nil), "ttn.lorawan.v3.ConnectedGateways")
+	golang_proto.RegisterType((*ConnectedGateways)(nil), "ttn.lorawan.v3.ConnectedGateways")
+	proto.RegisterType((*GetGatewayStatusHistoryRequest)(nil), "ttn.lorawan.v3.GetGatewayStatusHistoryRequest")
+	golang_proto.RegisterType((*GetGatewayStatusHistoryRequest)(nil), "ttn.lorawan.v3.GetGatewayStatusHistoryRequest")
+	proto.RegisterType((*GatewayStatusHistory)(nil), "ttn.lorawan.v3.GatewayStatusHistory")
+	golang_proto.RegisterType((*GatewayStatusHistory)(nil), "ttn.lorawan.v3.GatewayStatusHistory")
+	proto.RegisterType((*GatewayStatusHistory_Sample)(nil), "ttn.lorawan.v3.GatewayStatusHistory.Sample")
+	golang_proto.RegisterType((*GatewayStatusHistory_Sample)(nil), "ttn.lorawan.v3.GatewayStatusHistory.Sample")
 }
 func (this *GatewayUp) Equal(that interface{}) bool {
 	if that == nil {
@@ -284,27 +643,219 @@ func (this *ScheduleDownlinkResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ListConnectedGatewaysRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// GtwGsClient is the client API for GtwGs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GtwGsClient interface {
-	// Link the gateway to the Gateway Server.
-	LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error)
-	// GetConcentratorConfig associated to the gateway.
-	GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error)
+	that1, ok := that.(*ListConnectedGatewaysRequest)
+	if !ok {
+		that2, ok := that.(ListConnectedGatewaysRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
+		return false
+	}
+	if this.Protocol != that1.Protocol {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Page != that1.Page {
+		return false
+	}
+	return true
 }
+func (this *ConnectedGateway) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-type gtwGsClient struct {
-	cc *grpc.ClientConn
+	that1, ok := that.(*ConnectedGateway)
+	if !ok {
+		that2, ok := that.(ConnectedGateway)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if !this.Stats.Equal(&that1.Stats) {
+		return false
+	}
+	return true
+}
+func (this *ConnectedGateways) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConnectedGateways)
+	if !ok {
+		that2, ok := that.(ConnectedGateways)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Gateways) != len(that1.Gateways) {
+		return false
+	}
+	for i := range this.Gateways {
+		if !this.Gateways[i].Equal(that1.Gateways[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GetGatewayStatusHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetGatewayStatusHistoryRequest)
+	if !ok {
+		that2, ok := that.(GetGatewayStatusHistoryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if that1.From == nil {
+		if this.From != nil {
+			return false
+		}
+	} else if !this.From.Equal(*that1.From) {
+		return false
+	}
+	if that1.To == nil {
+		if this.To != nil {
+			return false
+		}
+	} else if !this.To.Equal(*that1.To) {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	return true
+}
+func (this *GatewayStatusHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayStatusHistory)
+	if !ok {
+		that2, ok := that.(GatewayStatusHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Samples) != len(that1.Samples) {
+		return false
+	}
+	for i := range this.Samples {
+		if !this.Samples[i].Equal(that1.Samples[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GatewayStatusHistory_Sample) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayStatusHistory_Sample)
+	if !ok {
+		that2, ok := that.(GatewayStatusHistory_Sample)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.Status.Equal(that1.Status) {
+		return false
+	}
+	if this.UplinkCount != that1.UplinkCount {
+		return false
+	}
+	if this.DownlinkCount != that1.DownlinkCount {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GtwGsClient is the client API for GtwGs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GtwGsClient interface {
+	// Link the gateway to the Gateway Server.
+	LinkGateway(ctx context.Context, opts ...grpc.CallOption) (GtwGs_LinkGatewayClient, error)
+	// GetConcentratorConfig associated to the gateway.
+	GetConcentratorConfig(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ConcentratorConfig, error)
+}
+
+type gtwGsClient struct {
+	cc *grpc.ClientConn
 }
 
 func NewGtwGsClient(cc *grpc.ClientConn) GtwGsClient {
@@ -504,6 +1055,10 @@ type GsClient interface {
 	// Get statistics about the current gateway connection to the Gateway Server.
 	// This is not persisted between reconnects.
 	GetGatewayConnectionStats(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayConnectionStats, error)
+	// List the gateways that are connected to the Gateway Server cluster.
+	ListConnectedGateways(ctx context.Context, in *ListConnectedGatewaysRequest, opts ...grpc.CallOption) (*ConnectedGateways, error)
+	// Get the status and traffic history of the gateway.
+	GetGatewayStatusHistory(ctx context.Context, in *GetGatewayStatusHistoryRequest, opts ...grpc.CallOption) (*GatewayStatusHistory, error)
 }
 
 type gsClient struct {
@@ -523,11 +1078,33 @@ func (c *gsClient) GetGatewayConnectionStats(ctx context.Context, in *GatewayIde
 	return out, nil
 }
 
+func (c *gsClient) ListConnectedGateways(ctx context.Context, in *ListConnectedGatewaysRequest, opts ...grpc.CallOption) (*ConnectedGateways, error) {
+	out := new(ConnectedGateways)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/ListConnectedGateways", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) GetGatewayStatusHistory(ctx context.Context, in *GetGatewayStatusHistoryRequest, opts ...grpc.CallOption) (*GatewayStatusHistory, error) {
+	out := new(GatewayStatusHistory)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayStatusHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GsServer is the server API for Gs service.
 type GsServer interface {
 	// Get statistics about the current gateway connection to the Gateway Server.
 	// This is not persisted between reconnects.
 	GetGatewayConnectionStats(context.Context, *GatewayIdentifiers) (*GatewayConnectionStats, error)
+	// List the gateways that are connected to the Gateway Server cluster.
+	ListConnectedGateways(context.Context, *ListConnectedGatewaysRequest) (*ConnectedGateways, error)
+	// Get the status and traffic history of the gateway.
+	GetGatewayStatusHistory(context.Context, *GetGatewayStatusHistoryRequest) (*GatewayStatusHistory, error)
 }
 
 func RegisterGsServer(s *grpc.Server, srv GsServer) {
@@ -552,6 +1129,42 @@ func _Gs_GetGatewayConnectionStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gs_ListConnectedGateways_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectedGatewaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).ListConnectedGateways(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/ListConnectedGateways",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).ListConnectedGateways(ctx, req.(*ListConnectedGatewaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_GetGatewayStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).GetGatewayStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayStatusHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).GetGatewayStatusHistory(ctx, req.(*GetGatewayStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Gs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.Gs",
 	HandlerType: (*GsServer)(nil),
@@ -560,6 +1173,14 @@ var _Gs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetGatewayConnectionStats",
 			Handler:    _Gs_GetGatewayConnectionStats_Handler,
 		},
+		{
+			MethodName: "ListConnectedGateways",
+			Handler:    _Gs_ListConnectedGateways_Handler,
+		},
+		{
+			MethodName: "GetGatewayStatusHistory",
+			Handler:    _Gs_GetGatewayStatusHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/gatewayserver.proto",
@@ -669,71 +1290,380 @@ func (m *ScheduleDownlinkResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintGatewayserver(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ListConnectedGatewaysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func NewPopulatedGatewayUp(r randyGatewayserver, easy bool) *GatewayUp {
-	this := &GatewayUp{}
-	if r.Intn(10) != 0 {
-		v1 := r.Intn(5)
-		this.UplinkMessages = make([]*UplinkMessage, v1)
-		for i := 0; i < v1; i++ {
-			this.UplinkMessages[i] = NewPopulatedUplinkMessage(r, easy)
-		}
+
+func (m *ListConnectedGatewaysRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.FrequencyPlanID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.FrequencyPlanID)))
+		i += copy(dAtA[i:], m.FrequencyPlanID)
 	}
-	if r.Intn(10) != 0 {
-		this.GatewayStatus = NewPopulatedGatewayStatus(r, easy)
+	if len(m.Protocol) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGatewayserver(dAtA, i, uint64(len(m.Protocol)))
+		i += copy(dAtA[i:], m.Protocol)
 	}
-	if r.Intn(10) != 0 {
-		this.TxAcknowledgment = NewPopulatedTxAcknowledgment(r, easy)
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Limit))
 	}
-	if !easy && r.Intn(10) != 0 {
+	if m.Page != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Page))
 	}
-	return this
+	return i, nil
 }
 
-func NewPopulatedGatewayDown(r randyGatewayserver, easy bool) *GatewayDown {
-	this := &GatewayDown{}
-	if r.Intn(10) != 0 {
-		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
-	}
-	if !easy && r.Intn(10) != 0 {
+func (m *ConnectedGateway) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return dAtA[:n], nil
 }
 
-func NewPopulatedScheduleDownlinkResponse(r randyGatewayserver, easy bool) *ScheduleDownlinkResponse {
-	this := &ScheduleDownlinkResponse{}
-	v2 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Delay = *v2
-	if !easy && r.Intn(10) != 0 {
+func (m *ConnectedGateway) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGatewayserver(dAtA, i, uint64(m.GatewayIdentifiers.Size()))
+	n5, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	return this
-}
-
-type randyGatewayserver interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+	i += n5
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGatewayserver(dAtA, i, uint64(m.Stats.Size()))
+	n6, err := m.Stats.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n6
+	return i, nil
 }
 
-func randUTF8RuneGatewayserver(r randyGatewayserver) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+func (m *ConnectedGateways) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return rune(ru + 61)
+	return dAtA[:n], nil
+}
+
+func (m *ConnectedGateways) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Gateways) > 0 {
+		for _, msg := range m.Gateways {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGatewayserver(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GetGatewayStatusHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGatewayStatusHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGatewayserver(dAtA, i, uint64(m.GatewayIdentifiers.Size()))
+	n10, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n10
+	if m.From != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGatewayserver(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.From)))
+		n11, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.From, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.To != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGatewayserver(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.To)))
+		n12, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.To, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGatewayserver(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)))
+	n13, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n13
+	return i, nil
+}
+
+func (m *GatewayStatusHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayStatusHistory) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Samples) > 0 {
+		for _, msg := range m.Samples {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGatewayserver(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GatewayStatusHistory_Sample) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayStatusHistory_Sample) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGatewayserver(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)))
+	n17, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n17
+	if m.Status != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.Status.Size()))
+		n18, err := m.Status.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.UplinkCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.UplinkCount))
+	}
+	if m.DownlinkCount != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintGatewayserver(dAtA, i, uint64(m.DownlinkCount))
+	}
+	return i, nil
+}
+
+func encodeVarintGatewayserver(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedGatewayUp(r randyGatewayserver, easy bool) *GatewayUp {
+	this := &GatewayUp{}
+	if r.Intn(10) != 0 {
+		v1 := r.Intn(5)
+		this.UplinkMessages = make([]*UplinkMessage, v1)
+		for i := 0; i < v1; i++ {
+			this.UplinkMessages[i] = NewPopulatedUplinkMessage(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		this.GatewayStatus = NewPopulatedGatewayStatus(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.TxAcknowledgment = NewPopulatedTxAcknowledgment(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGatewayDown(r randyGatewayserver, easy bool) *GatewayDown {
+	this := &GatewayDown{}
+	if r.Intn(10) != 0 {
+		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedScheduleDownlinkResponse(r randyGatewayserver, easy bool) *ScheduleDownlinkResponse {
+	this := &ScheduleDownlinkResponse{}
+	v2 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Delay = *v2
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+func NewPopulatedListConnectedGatewaysRequest(r randyGatewayserver, easy bool) *ListConnectedGatewaysRequest {
+	this := &ListConnectedGatewaysRequest{}
+	this.FrequencyPlanID = randStringGatewayserver(r)
+	this.Protocol = randStringGatewayserver(r)
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+func NewPopulatedConnectedGateway(r randyGatewayserver, easy bool) *ConnectedGateway {
+	this := &ConnectedGateway{}
+	v7 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v7
+	v8 := NewPopulatedGatewayConnectionStats(r, easy)
+	this.Stats = *v8
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+func NewPopulatedConnectedGateways(r randyGatewayserver, easy bool) *ConnectedGateways {
+	this := &ConnectedGateways{}
+	if r.Intn(10) != 0 {
+		v9 := r.Intn(5)
+		this.Gateways = make([]*ConnectedGateway, v9)
+		for i := 0; i < v9; i++ {
+			this.Gateways[i] = NewPopulatedConnectedGateway(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+func NewPopulatedGetGatewayStatusHistoryRequest(r randyGatewayserver, easy bool) *GetGatewayStatusHistoryRequest {
+	this := &GetGatewayStatusHistoryRequest{}
+	v14 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v14
+	if r.Intn(10) != 0 {
+		this.From = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.To = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v15 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Interval = *v15
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+func NewPopulatedGatewayStatusHistory(r randyGatewayserver, easy bool) *GatewayStatusHistory {
+	this := &GatewayStatusHistory{}
+	if r.Intn(10) != 0 {
+		v16 := r.Intn(5)
+		this.Samples = make([]*GatewayStatusHistory_Sample, v16)
+		for i := 0; i < v16; i++ {
+			this.Samples[i] = NewPopulatedGatewayStatusHistory_Sample(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+func NewPopulatedGatewayStatusHistory_Sample(r randyGatewayserver, easy bool) *GatewayStatusHistory_Sample {
+	this := &GatewayStatusHistory_Sample{}
+	v19 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v19
+	if r.Intn(10) != 0 {
+		this.Status = NewPopulatedGatewayStatus(r, easy)
+	}
+	this.UplinkCount = uint64(uint64(r.Uint32()))
+	this.DownlinkCount = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyGatewayserver interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneGatewayserver(r randyGatewayserver) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
 }
 func randStringGatewayserver(r randyGatewayserver) string {
 	v3 := r.Intn(100)
@@ -822,74 +1752,893 @@ func (m *GatewayDown) Size() (n int) {
 		l = m.DownlinkMessage.Size()
 		n += 1 + l + sovGatewayserver(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *ScheduleDownlinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+func (m *ListConnectedGatewaysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FrequencyPlanID)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = len(m.Protocol)
+	if l > 0 {
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovGatewayserver(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovGatewayserver(uint64(m.Page))
+	}
+	return n
+}
+func (m *ConnectedGateway) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	l = m.Stats.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+func (m *ConnectedGateways) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Gateways) > 0 {
+		for _, e := range m.Gateways {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+func (m *GetGatewayStatusHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.From != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.From)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.To != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.To)
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	return n
+}
+func (m *GatewayStatusHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Samples) > 0 {
+		for _, e := range m.Samples {
+			l = e.Size()
+			n += 1 + l + sovGatewayserver(uint64(l))
+		}
+	}
+	return n
+}
+func (m *GatewayStatusHistory_Sample) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGatewayserver(uint64(l))
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovGatewayserver(uint64(l))
+	}
+	if m.UplinkCount != 0 {
+		n += 1 + sovGatewayserver(uint64(m.UplinkCount))
+	}
+	if m.DownlinkCount != 0 {
+		n += 1 + sovGatewayserver(uint64(m.DownlinkCount))
+	}
+	return n
+}
+
+func sovGatewayserver(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozGatewayserver(x uint64) (n int) {
+	return sovGatewayserver((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *GatewayUp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayUp{`,
+		`UplinkMessages:` + strings.Replace(fmt.Sprintf("%v", this.UplinkMessages), "UplinkMessage", "UplinkMessage", 1) + `,`,
+		`GatewayStatus:` + strings.Replace(fmt.Sprintf("%v", this.GatewayStatus), "GatewayStatus", "GatewayStatus", 1) + `,`,
+		`TxAcknowledgment:` + strings.Replace(fmt.Sprintf("%v", this.TxAcknowledgment), "TxAcknowledgment", "TxAcknowledgment", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayDown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayDown{`,
+		`DownlinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkMessage), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduleDownlinkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduleDownlinkResponse{`,
+		`Delay:` + strings.Replace(strings.Replace(this.Delay.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListConnectedGatewaysRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListConnectedGatewaysRequest{`,
+		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
+		`Protocol:` + fmt.Sprintf("%v", this.Protocol) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConnectedGateway) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConnectedGateway{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(this.GatewayIdentifiers.String(), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Stats:` + strings.Replace(strings.Replace(this.Stats.String(), "GatewayConnectionStats", "GatewayConnectionStats", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConnectedGateways) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConnectedGateways{`,
+		`Gateways:` + strings.Replace(fmt.Sprintf("%v", this.Gateways), "ConnectedGateway", "ConnectedGateway", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetGatewayStatusHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetGatewayStatusHistoryRequest{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(this.GatewayIdentifiers.String(), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`From:` + strings.Replace(fmt.Sprintf("%v", this.From), "Timestamp", "types.Timestamp", 1) + `,`,
+		`To:` + strings.Replace(fmt.Sprintf("%v", this.To), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Interval:` + strings.Replace(strings.Replace(this.Interval.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayStatusHistory) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayStatusHistory{`,
+		`Samples:` + strings.Replace(fmt.Sprintf("%v", this.Samples), "GatewayStatusHistory_Sample", "GatewayStatusHistory_Sample", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayStatusHistory_Sample) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayStatusHistory_Sample{`,
+		`Time:` + strings.Replace(strings.Replace(this.Time.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(fmt.Sprintf("%v", this.Status), "GatewayStatus", "GatewayStatus", 1) + `,`,
+		`UplinkCount:` + fmt.Sprintf("%v", this.UplinkCount) + `,`,
+		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGatewayserver(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GatewayUp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayUp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayUp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UplinkMessages = append(m.UplinkMessages, &UplinkMessage{})
+			if err := m.UplinkMessages[len(m.UplinkMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GatewayStatus == nil {
+				m.GatewayStatus = &GatewayStatus{}
+			}
+			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxAcknowledgment == nil {
+				m.TxAcknowledgment = &TxAcknowledgment{}
+			}
+			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayDown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayDown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayDown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkMessage == nil {
+				m.DownlinkMessage = &DownlinkMessage{}
+			}
+			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleDownlinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListConnectedGatewaysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListConnectedGatewaysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListConnectedGatewaysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Protocol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectedGateway) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectedGateway: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectedGateway: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ScheduleDownlinkResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
-	n += 1 + l + sovGatewayserver(uint64(l))
-	return n
+	return nil
 }
-
-func sovGatewayserver(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *ConnectedGateways) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGatewayserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectedGateways: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectedGateways: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gateways", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gateways = append(m.Gateways, &ConnectedGateway{})
+			if err := m.Gateways[len(m.Gateways)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGatewayserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-func sozGatewayserver(x uint64) (n int) {
-	return sovGatewayserver((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *GatewayUp) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GatewayUp{`,
-		`UplinkMessages:` + strings.Replace(fmt.Sprintf("%v", this.UplinkMessages), "UplinkMessage", "UplinkMessage", 1) + `,`,
-		`GatewayStatus:` + strings.Replace(fmt.Sprintf("%v", this.GatewayStatus), "GatewayStatus", "GatewayStatus", 1) + `,`,
-		`TxAcknowledgment:` + strings.Replace(fmt.Sprintf("%v", this.TxAcknowledgment), "TxAcknowledgment", "TxAcknowledgment", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GatewayDown) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GatewayDown{`,
-		`DownlinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkMessage), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ScheduleDownlinkResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ScheduleDownlinkResponse{`,
-		`Delay:` + strings.Replace(strings.Replace(this.Delay.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGatewayserver(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *GatewayUp) Unmarshal(dAtA []byte) error {
+func (m *GetGatewayStatusHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -912,15 +2661,15 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayUp: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetGatewayStatusHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayUp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetGatewayStatusHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -944,14 +2693,13 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UplinkMessages = append(m.UplinkMessages, &UplinkMessage{})
-			if err := m.UplinkMessages[len(m.UplinkMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GatewayStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -975,16 +2723,16 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GatewayStatus == nil {
-				m.GatewayStatus = &GatewayStatus{}
+			if m.From == nil {
+				m.From = new(time.Time)
 			}
-			if err := m.GatewayStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.From, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxAcknowledgment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1008,10 +2756,40 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxAcknowledgment == nil {
-				m.TxAcknowledgment = &TxAcknowledgment{}
+			if m.To == nil {
+				m.To = new(time.Time)
 			}
-			if err := m.TxAcknowledgment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.To, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1036,7 +2814,7 @@ func (m *GatewayUp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GatewayDown) Unmarshal(dAtA []byte) error {
+func (m *GatewayStatusHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1059,15 +2837,15 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GatewayDown: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayStatusHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GatewayDown: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayStatusHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1091,10 +2869,8 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkMessage == nil {
-				m.DownlinkMessage = &DownlinkMessage{}
-			}
-			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Samples = append(m.Samples, &GatewayStatusHistory_Sample{})
+			if err := m.Samples[len(m.Samples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1119,7 +2895,7 @@ func (m *GatewayDown) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
+func (m *GatewayStatusHistory_Sample) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1142,15 +2918,15 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScheduleDownlinkResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayStatusHistory_Sample: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScheduleDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayStatusHistory_Sample: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1174,10 +2950,81 @@ func (m *ScheduleDownlinkResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGatewayserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &GatewayStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkCount", wireType)
+			}
+			m.UplinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinkCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
+			}
+			m.DownlinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGatewayserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkCount |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGatewayserver(dAtA[iNdEx:])
@@ -1312,46 +3159,69 @@ func init() {
 }
 
 var fileDescriptor_gatewayserver_9e6408bf5e13ce4c = []byte{
-	// 643 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x31, 0x4c, 0xdb, 0x4c,
-	0x14, 0xc7, 0xef, 0xe0, 0xe3, 0xd3, 0xf7, 0x1d, 0xfa, 0x80, 0xef, 0xa4, 0x56, 0x21, 0xa8, 0x2f,
-	0x51, 0xaa, 0x56, 0x0c, 0xc5, 0xa9, 0xc2, 0xd4, 0xb1, 0x90, 0xd6, 0xa2, 0x2a, 0x1d, 0x42, 0x33,
-	0xb4, 0x52, 0x85, 0x9c, 0xf8, 0xb8, 0x58, 0x71, 0xee, 0x2c, 0xdf, 0x19, 0xc3, 0xc6, 0xc8, 0xd8,
-	0x91, 0xb1, 0xea, 0x52, 0x46, 0x46, 0x46, 0x46, 0x46, 0x46, 0xa6, 0x16, 0xdb, 0x0b, 0x23, 0x23,
-	0x63, 0x15, 0xc7, 0x11, 0xc4, 0x69, 0xda, 0xcd, 0xef, 0xde, 0xef, 0xfe, 0xef, 0xbd, 0xbf, 0xdf,
-	0x91, 0x27, 0xae, 0xf4, 0xad, 0xd0, 0x12, 0x2b, 0x4a, 0x5b, 0xed, 0x6e, 0xd5, 0xf2, 0x9c, 0x2a,
-	0xb7, 0x34, 0x0b, 0xad, 0x7d, 0xc5, 0xfc, 0x5d, 0xe6, 0x1b, 0x9e, 0x2f, 0xb5, 0xa4, 0x73, 0x5a,
-	0x0b, 0x23, 0x43, 0x8d, 0xdd, 0xd5, 0xe2, 0x0a, 0x77, 0x74, 0x27, 0x68, 0x19, 0x6d, 0xd9, 0xab,
-	0x72, 0xc9, 0x65, 0x35, 0xc5, 0x5a, 0xc1, 0x4e, 0x1a, 0xa5, 0x41, 0xfa, 0x35, 0xb8, 0x5e, 0x04,
-	0x2e, 0x25, 0x77, 0xd9, 0x1d, 0x65, 0x07, 0xbe, 0xa5, 0x1d, 0x29, 0xb2, 0xfc, 0x52, 0x3e, 0xcf,
-	0x7a, 0x9e, 0xde, 0xcf, 0x92, 0xa5, 0x89, 0x2d, 0x66, 0xc0, 0xe3, 0x71, 0xc0, 0xb1, 0x99, 0xd0,
-	0xce, 0x8e, 0xc3, 0x7c, 0x95, 0x41, 0xe5, 0x71, 0xa8, 0xc7, 0x94, 0xb2, 0x38, 0xfb, 0x0d, 0xe1,
-	0x33, 0xee, 0x48, 0x61, 0xb9, 0x03, 0xa2, 0x72, 0x8d, 0xc9, 0xbf, 0xe6, 0xa0, 0x74, 0xd3, 0xa3,
-	0xaf, 0xc9, 0x7c, 0xe0, 0xb9, 0x8e, 0xe8, 0x6e, 0x0f, 0x85, 0x0a, 0xb8, 0x3c, 0xbd, 0x3c, 0x5b,
-	0x7b, 0x64, 0x8c, 0xba, 0x65, 0x34, 0x53, 0x6c, 0x73, 0x40, 0x35, 0xe6, 0x82, 0xfb, 0xa1, 0xa2,
-	0x75, 0x32, 0x97, 0xcd, 0xb3, 0xad, 0xb4, 0xa5, 0x03, 0x55, 0x98, 0x2a, 0xe3, 0x5f, 0xc9, 0x64,
-	0xa5, 0xb7, 0x52, 0xa8, 0xf1, 0x1f, 0xbf, 0x1f, 0xd2, 0x4d, 0xf2, 0xbf, 0xde, 0xdb, 0xb6, 0xda,
-	0x5d, 0x21, 0x43, 0x97, 0xd9, 0xbc, 0xc7, 0x84, 0x2e, 0x4c, 0xa7, 0x42, 0xe5, 0xbc, 0xd0, 0xfb,
-	0xbd, 0x97, 0x23, 0x5c, 0x63, 0x41, 0xe7, 0x4e, 0x2a, 0x1f, 0xc8, 0x6c, 0x56, 0xae, 0x2e, 0x43,
-	0x41, 0xdf, 0x90, 0x05, 0x5b, 0x86, 0xe2, 0xfe, 0xb4, 0x05, 0x9c, 0x8a, 0x97, 0xf2, 0xe2, 0xf5,
-	0x8c, 0x1b, 0x8e, 0x3b, 0x6f, 0x8f, 0x1e, 0x54, 0x9a, 0xa4, 0xb0, 0xd5, 0xee, 0x30, 0x3b, 0x70,
-	0xd9, 0x90, 0x6d, 0x30, 0xe5, 0x49, 0xa1, 0x18, 0x7d, 0x41, 0x66, 0x6c, 0xe6, 0x5a, 0xfb, 0x99,
-	0xf8, 0xa2, 0x31, 0x58, 0x0c, 0x63, 0xb8, 0x18, 0x46, 0x3d, 0x5b, 0x9c, 0xb5, 0x7f, 0xce, 0xbf,
-	0x97, 0xd0, 0xd1, 0x8f, 0x12, 0x6e, 0x0c, 0x6e, 0xd4, 0xbe, 0x61, 0x32, 0x63, 0xea, 0xd0, 0x54,
-	0x74, 0x83, 0xcc, 0xbe, 0x75, 0x44, 0x37, 0xeb, 0x9f, 0x2e, 0x4e, 0xf0, 0xb1, 0xe9, 0x15, 0x97,
-	0x26, 0xa4, 0xfa, 0x7d, 0x2d, 0xe3, 0xe7, 0x98, 0x6e, 0x91, 0x07, 0x26, 0xd3, 0xeb, 0x52, 0xb4,
-	0x99, 0xd0, 0xbe, 0xa5, 0xa5, 0xbf, 0x2e, 0xc5, 0x8e, 0xc3, 0xe9, 0xc3, 0xb1, 0xce, 0x5e, 0xf5,
-	0x57, 0xb6, 0x58, 0xc9, 0x2b, 0x8e, 0xdf, 0xad, 0x31, 0xf2, 0xd7, 0x3b, 0x65, 0x2a, 0xfa, 0x89,
-	0x2c, 0xe4, 0x8d, 0xa0, 0x7f, 0xb2, 0xb3, 0xb8, 0x9c, 0x07, 0x26, 0x79, 0x59, 0xeb, 0x91, 0x29,
-	0x53, 0x51, 0x4e, 0x16, 0x4d, 0xa6, 0xb3, 0xb9, 0xd6, 0xa5, 0x10, 0xac, 0xdd, 0xf7, 0xaf, 0xbf,
-	0x35, 0x8a, 0x56, 0x26, 0xcc, 0xbf, 0x71, 0xf7, 0x7c, 0x8a, 0x4f, 0x27, 0x30, 0x39, 0xad, 0xb5,
-	0xaf, 0xf8, 0x3c, 0x02, 0x7c, 0x11, 0x01, 0xbe, 0x8c, 0x00, 0x5d, 0x45, 0x80, 0xae, 0x23, 0x40,
-	0x37, 0x11, 0xa0, 0xdb, 0x08, 0xf0, 0x41, 0x0c, 0xf8, 0x30, 0x06, 0x74, 0x1c, 0x03, 0x3e, 0x89,
-	0x01, 0x9d, 0xc6, 0x80, 0xce, 0x62, 0x40, 0xe7, 0x31, 0xe0, 0x8b, 0x18, 0xf0, 0x65, 0x0c, 0xe8,
-	0x2a, 0x06, 0x7c, 0x1d, 0x03, 0xba, 0x89, 0x01, 0xdf, 0xc6, 0x80, 0x0e, 0x12, 0x40, 0x87, 0x09,
-	0xe0, 0xcf, 0x09, 0xa0, 0xa3, 0x04, 0xf0, 0x97, 0x04, 0xd0, 0x71, 0x02, 0xe8, 0x24, 0x01, 0x7c,
-	0x9a, 0x00, 0x3e, 0x4b, 0x00, 0x7f, 0x7c, 0xc6, 0xa5, 0xa1, 0x3b, 0x4c, 0x77, 0x1c, 0xc1, 0x95,
-	0x21, 0x98, 0x0e, 0xa5, 0xdf, 0xad, 0x8e, 0x3e, 0x65, 0xaf, 0xcb, 0xab, 0x5a, 0x0b, 0xaf, 0xd5,
-	0xfa, 0x3b, 0xfd, 0x5d, 0xab, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xed, 0xf4, 0x48, 0xbd, 0xf7,
-	0x04, 0x00, 0x00,
+	// 1020 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xde, 0x71, 0x36, 0x21, 0x99, 0x90, 0xbf, 0xa1, 0x05, 0x67, 0x0b, 0xe3, 0xd4, 0x50, 0x14,
+	0x89, 0x76, 0x5d, 0xa5, 0x20, 0x81, 0x84, 0x54, 0xe1, 0xa4, 0x35, 0x41, 0x0d, 0x82, 0x4d, 0x73,
+	0x00, 0x09, 0x45, 0x1b, 0xef, 0x78, 0x3d, 0xf2, 0x7a, 0x66, 0xd9, 0x19, 0xc7, 0xf5, 0xad, 0xc7,
+	0x1e, 0x7b, 0xcc, 0x11, 0x81, 0x10, 0x3d, 0xf6, 0xd8, 0x63, 0x25, 0x2e, 0x39, 0xe6, 0xd8, 0x53,
+	0xa8, 0xd7, 0x97, 0x1c, 0x7b, 0xec, 0x11, 0xed, 0xee, 0xac, 0x13, 0xaf, 0xe3, 0xc4, 0x48, 0xdc,
+	0x76, 0xde, 0x7c, 0xef, 0xcd, 0x37, 0x6f, 0xbe, 0xf7, 0xd9, 0xf0, 0x86, 0xc7, 0x03, 0xbb, 0x6d,
+	0xb3, 0x5b, 0x42, 0xda, 0xd5, 0x46, 0xc9, 0xf6, 0x69, 0xc9, 0xb5, 0x25, 0x69, 0xdb, 0x1d, 0x41,
+	0x82, 0x7d, 0x12, 0x98, 0x7e, 0xc0, 0x25, 0x47, 0xf3, 0x52, 0x32, 0x53, 0x41, 0xcd, 0xfd, 0x3b,
+	0xc6, 0x2d, 0x97, 0xca, 0x7a, 0x6b, 0xcf, 0xac, 0xf2, 0x66, 0xc9, 0xe5, 0x2e, 0x2f, 0xc5, 0xb0,
+	0xbd, 0x56, 0x2d, 0x5e, 0xc5, 0x8b, 0xf8, 0x2b, 0x49, 0x37, 0xb0, 0xcb, 0xb9, 0xeb, 0x91, 0x53,
+	0x94, 0xd3, 0x0a, 0x6c, 0x49, 0x39, 0x53, 0xfb, 0xd7, 0xb2, 0xfb, 0xa4, 0xe9, 0xcb, 0x8e, 0xda,
+	0x2c, 0x8c, 0xa4, 0xa8, 0x00, 0x1f, 0x0f, 0x03, 0xa8, 0x43, 0x98, 0xa4, 0x35, 0x4a, 0x02, 0xa1,
+	0x40, 0x2b, 0xc3, 0xa0, 0x26, 0x11, 0xc2, 0x76, 0xc9, 0x05, 0x88, 0x80, 0xb8, 0x94, 0x33, 0xdb,
+	0x4b, 0x99, 0x64, 0x69, 0x4a, 0xda, 0x24, 0x42, 0xda, 0x4d, 0x3f, 0x01, 0x14, 0x4f, 0x00, 0x9c,
+	0xa9, 0x24, 0xdc, 0x76, 0x7c, 0x74, 0x1f, 0x2e, 0xb4, 0x7c, 0x8f, 0xb2, 0xc6, 0x6e, 0x7a, 0x52,
+	0x1e, 0xac, 0x4c, 0xac, 0xce, 0xae, 0x7d, 0x64, 0x0e, 0xb6, 0xd3, 0xdc, 0x89, 0x61, 0x5b, 0x09,
+	0xca, 0x9a, 0x6f, 0x9d, 0x5d, 0x0a, 0xb4, 0x01, 0xe7, 0xd5, 0x85, 0x77, 0x85, 0xb4, 0x65, 0x4b,
+	0xe4, 0x73, 0x2b, 0xe0, 0xbc, 0x32, 0xea, 0xe8, 0xed, 0x18, 0x64, 0xcd, 0xb9, 0x67, 0x97, 0x68,
+	0x0b, 0x2e, 0xc9, 0x47, 0xbb, 0x76, 0xb5, 0xc1, 0x78, 0xdb, 0x23, 0x8e, 0xdb, 0x24, 0x4c, 0xe6,
+	0x27, 0xe2, 0x42, 0x2b, 0xd9, 0x42, 0x0f, 0x1f, 0x7d, 0x33, 0x80, 0xb3, 0x16, 0x65, 0x26, 0x52,
+	0xfc, 0x09, 0xce, 0xaa, 0xe3, 0x36, 0x78, 0x9b, 0xa1, 0xef, 0xe0, 0xa2, 0xc3, 0xdb, 0xec, 0xec,
+	0x6d, 0xf3, 0x20, 0x2e, 0x5e, 0xc8, 0x16, 0xdf, 0x50, 0xb8, 0xf4, 0xba, 0x0b, 0xce, 0x60, 0xa0,
+	0xb8, 0x03, 0xf3, 0xdb, 0xd5, 0x3a, 0x71, 0x5a, 0x1e, 0x49, 0xb1, 0x16, 0x11, 0x3e, 0x67, 0x82,
+	0xa0, 0xaf, 0xe0, 0xa4, 0x43, 0x3c, 0xbb, 0xa3, 0x8a, 0x2f, 0x9b, 0xc9, 0x93, 0x98, 0xe9, 0x93,
+	0x98, 0x1b, 0x4a, 0x59, 0xe5, 0xe9, 0xc3, 0xe3, 0x82, 0x76, 0xf0, 0x4f, 0x01, 0x58, 0x49, 0x46,
+	0xf1, 0x4f, 0x00, 0x3f, 0x7c, 0x40, 0x85, 0x5c, 0xe7, 0x8c, 0x91, 0xaa, 0x24, 0x8e, 0xe2, 0x2f,
+	0x2c, 0xf2, 0x6b, 0x8b, 0x08, 0x89, 0xee, 0xc2, 0xa5, 0x5a, 0x10, 0x7d, 0xb3, 0x6a, 0x67, 0xd7,
+	0xf7, 0x6c, 0xb6, 0x4b, 0x9d, 0xf8, 0x9c, 0x99, 0xf2, 0x7b, 0xe1, 0x71, 0x61, 0xe1, 0x7e, 0xba,
+	0xf9, 0x83, 0x67, 0xb3, 0xcd, 0x0d, 0x6b, 0xa1, 0x36, 0x10, 0x70, 0x90, 0x01, 0xa7, 0x63, 0x1e,
+	0x55, 0xee, 0xc5, 0x4f, 0x34, 0x63, 0xf5, 0xd7, 0xe8, 0x0a, 0x9c, 0xf4, 0x68, 0x93, 0x26, 0x2d,
+	0x9f, 0xb3, 0x92, 0x05, 0x42, 0x50, 0xf7, 0xa3, 0x56, 0xe9, 0x71, 0x30, 0xfe, 0x8e, 0x78, 0x2e,
+	0x66, 0x39, 0xa2, 0x2d, 0x38, 0x9b, 0x6a, 0x80, 0x3a, 0x42, 0xdd, 0xbe, 0x38, 0x42, 0x00, 0x9b,
+	0xa7, 0xea, 0x4f, 0xda, 0x70, 0x74, 0x5c, 0x00, 0x16, 0x74, 0xd3, 0x5d, 0x81, 0xca, 0x70, 0x32,
+	0x92, 0x52, 0xaa, 0xa4, 0x4f, 0x47, 0x14, 0x52, 0x34, 0x28, 0x67, 0x91, 0x88, 0x44, 0x59, 0x8f,
+	0x8a, 0x59, 0x49, 0x6a, 0xf1, 0x47, 0xb8, 0x34, 0xd4, 0x4a, 0xf4, 0x35, 0x9c, 0x4e, 0xfd, 0x43,
+	0x89, 0x7d, 0x48, 0x5c, 0xd9, 0x24, 0xab, 0x9f, 0x51, 0x3c, 0xc8, 0x41, 0x5c, 0x21, 0x72, 0x40,
+	0xc7, 0xdf, 0x52, 0x21, 0x79, 0xd0, 0x49, 0x1f, 0xe9, 0x7f, 0x6e, 0xc4, 0xe7, 0x50, 0xaf, 0x05,
+	0xbc, 0xa9, 0xfa, 0x60, 0x0c, 0xc9, 0xe9, 0x61, 0x3a, 0xe1, 0x65, 0xfd, 0x69, 0xa4, 0xa5, 0x18,
+	0x8d, 0x6e, 0xc3, 0x9c, 0xe4, 0xf9, 0x89, 0x31, 0x73, 0x72, 0x92, 0xa3, 0xbb, 0x70, 0x9a, 0x32,
+	0x49, 0x82, 0x7d, 0xdb, 0xcb, 0xeb, 0xe3, 0x4b, 0xb7, 0x9f, 0x54, 0xfc, 0x23, 0x07, 0xaf, 0x9c,
+	0xd7, 0x17, 0x74, 0x0f, 0xbe, 0x23, 0xec, 0xa6, 0xef, 0xf5, 0xdd, 0xe5, 0xb3, 0x0b, 0x6d, 0x41,
+	0xa5, 0x99, 0xdb, 0x71, 0x8e, 0x95, 0xe6, 0x1a, 0x7f, 0x03, 0x38, 0x95, 0xc4, 0xd0, 0x97, 0x50,
+	0x8f, 0x8c, 0x2d, 0x0f, 0x2e, 0xbd, 0x5f, 0x4c, 0x34, 0xe9, 0x4b, 0x94, 0x81, 0xbe, 0x80, 0x53,
+	0xff, 0xc5, 0xa1, 0x14, 0x18, 0x5d, 0x87, 0xef, 0x2a, 0xa3, 0xac, 0xf2, 0x96, 0x72, 0x25, 0xdd,
+	0x9a, 0x4d, 0x62, 0xeb, 0x51, 0x08, 0xdd, 0x80, 0xf3, 0x7d, 0x7f, 0x49, 0x40, 0x7a, 0x0c, 0x9a,
+	0x4b, 0xa3, 0x31, 0x6c, 0xed, 0x2f, 0x00, 0x27, 0x2b, 0xb2, 0x5d, 0x11, 0x68, 0x13, 0xce, 0x3e,
+	0xa0, 0xac, 0x91, 0xce, 0xcf, 0xf2, 0x08, 0x26, 0x3b, 0xbe, 0x71, 0x6d, 0xc4, 0x56, 0xe4, 0x3d,
+	0xab, 0xe0, 0x36, 0x40, 0xdb, 0xf0, 0x6a, 0x85, 0x44, 0xb6, 0x51, 0x25, 0x4c, 0x06, 0xb6, 0xe4,
+	0xc1, 0x3a, 0x67, 0x35, 0xea, 0xa2, 0xf7, 0x87, 0x5a, 0x73, 0x2f, 0xfa, 0xdd, 0x32, 0x8a, 0xe7,
+	0x48, 0x3e, 0x93, 0xbb, 0x46, 0xa0, 0xfe, 0xbd, 0xa8, 0x08, 0xf4, 0x0b, 0x5c, 0xcc, 0x9a, 0x1d,
+	0xba, 0xcc, 0x32, 0x8d, 0xd5, 0x2c, 0x60, 0x94, 0x5f, 0xae, 0x1d, 0xe6, 0x60, 0xae, 0x22, 0x90,
+	0x0b, 0x97, 0x4f, 0xe7, 0x2a, 0x33, 0xd5, 0x68, 0x8c, 0xe9, 0x31, 0xc6, 0x74, 0x08, 0x54, 0x87,
+	0x57, 0xcf, 0xf5, 0x58, 0x74, 0x33, 0x5b, 0xe0, 0x22, 0x2b, 0x36, 0xae, 0x5f, 0x66, 0x1a, 0x02,
+	0x71, 0xf8, 0xc1, 0x08, 0xab, 0x40, 0xe6, 0x10, 0xd9, 0x0b, 0x3d, 0xc5, 0xf8, 0x64, 0x9c, 0x89,
+	0x29, 0xff, 0x0e, 0x0e, 0xbb, 0x18, 0x1c, 0x75, 0x31, 0x78, 0xd5, 0xc5, 0xda, 0xeb, 0x2e, 0xd6,
+	0x4e, 0xba, 0x58, 0x7b, 0xd3, 0xc5, 0xda, 0xdb, 0x2e, 0x06, 0x8f, 0x43, 0x0c, 0x9e, 0x84, 0x58,
+	0x7b, 0x16, 0x62, 0xf0, 0x3c, 0xc4, 0xda, 0x8b, 0x10, 0x6b, 0x2f, 0x43, 0xac, 0x1d, 0x86, 0x18,
+	0x1c, 0x85, 0x18, 0xbc, 0x0a, 0xb1, 0xf6, 0x3a, 0xc4, 0xe0, 0x24, 0xc4, 0xda, 0x9b, 0x10, 0x83,
+	0xb7, 0x21, 0xd6, 0x1e, 0xf7, 0xb0, 0xf6, 0xa4, 0x87, 0xc1, 0xd3, 0x1e, 0xd6, 0x0e, 0x7a, 0x18,
+	0xfc, 0xd6, 0xc3, 0xda, 0xb3, 0x1e, 0xd6, 0x9e, 0xf7, 0x30, 0x78, 0xd1, 0xc3, 0xe0, 0x65, 0x0f,
+	0x83, 0x9f, 0x6f, 0xba, 0xdc, 0x94, 0x75, 0x22, 0xeb, 0x94, 0xb9, 0xc2, 0x64, 0x44, 0xb6, 0x79,
+	0xd0, 0x28, 0x0d, 0xfe, 0x57, 0xf1, 0x1b, 0x6e, 0x49, 0x4a, 0xe6, 0xef, 0xed, 0x4d, 0xc5, 0x52,
+	0xbc, 0xf3, 0xef, 0x00, 0x19, 0xe7, 0xcd, 0xf7, 0xd8, 0x09, 0x00, 0x00,
 }
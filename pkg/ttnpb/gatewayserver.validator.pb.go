@@ -53,3 +53,65 @@ func (this *ScheduleDownlinkResponse) Validate() error {
 	}
 	return nil
 }
+func (this *ListConnectedGatewaysRequest) Validate() error {
+	return nil
+}
+func (this *ConnectedGateway) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.GatewayIdentifiers)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("GatewayIdentifiers", err)
+	}
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.Stats)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("Stats", err)
+	}
+	return nil
+}
+func (this *ConnectedGateways) Validate() error {
+	for _, item := range this.Gateways {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Gateways", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *GetGatewayStatusHistoryRequest) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.GatewayIdentifiers)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("GatewayIdentifiers", err)
+	}
+	if this.From != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.From); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("From", err)
+		}
+	}
+	if this.To != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.To); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("To", err)
+		}
+	}
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.Interval)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("Interval", err)
+	}
+	return nil
+}
+func (this *GatewayStatusHistory) Validate() error {
+	for _, item := range this.Samples {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Samples", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *GatewayStatusHistory_Sample) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.Time)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("Time", err)
+	}
+	if this.Status != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Status); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Status", err)
+		}
+	}
+	return nil
+}
@@ -2,14 +2,21 @@
 
 package ttnpb
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+)
 
 var AuthInfoResponseFieldPathsNested = []string{
 	"access_method",
 	"access_method.api_key",
 	"access_method.api_key.api_key",
+	"access_method.api_key.api_key.allowed_ips",
+	"access_method.api_key.api_key.expires_at",
 	"access_method.api_key.api_key.id",
 	"access_method.api_key.api_key.key",
+	"access_method.api_key.api_key.last_used_at",
 	"access_method.api_key.api_key.name",
 	"access_method.api_key.api_key.rights",
 	"access_method.api_key.entity_ids",
@@ -157,8 +164,11 @@ func (dst *AuthInfoResponse) SetFields(src *AuthInfoResponse, paths ...string) e
 
 var AuthInfoResponse_APIKeyAccessFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_ips",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
+	"api_key.last_used_at",
 	"api_key.name",
 	"api_key.rights",
 	"entity_ids",
@@ -235,3 +245,225 @@ func (dst *AuthInfoResponse_APIKeyAccess) SetFields(src *AuthInfoResponse_APIKey
 	}
 	return nil
 }
+
+var ListEntitiesByStateRequestFieldPathsNested = []string{
+	"field_mask",
+	"limit",
+	"page",
+	"state",
+}
+
+var ListEntitiesByStateRequestFieldPathsTopLevel = []string{
+	"field_mask",
+	"limit",
+	"page",
+	"state",
+}
+
+func (dst *ListEntitiesByStateRequest) SetFields(src *ListEntitiesByStateRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.State = src.State
+			} else {
+				var zero State
+				dst.State = zero
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero github_com_gogo_protobuf_types.FieldMask
+				dst.FieldMask = zero
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ReviewEntitiesRequestFieldPathsNested = []string{
+	"entity_ids",
+	"reason",
+	"state",
+}
+
+var ReviewEntitiesRequestFieldPathsTopLevel = []string{
+	"entity_ids",
+	"reason",
+	"state",
+}
+
+func (dst *ReviewEntitiesRequest) SetFields(src *ReviewEntitiesRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "entity_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'entity_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EntityIDs = src.EntityIDs
+			} else {
+				dst.EntityIDs = nil
+			}
+		case "state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.State = src.State
+			} else {
+				var zero State
+				dst.State = zero
+			}
+		case "reason":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reason' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Reason = src.Reason
+			} else {
+				var zero string
+				dst.Reason = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ReviewRecordFieldPathsNested = []string{
+	"reason",
+	"reviewed_at",
+	"reviewer_ids",
+	"reviewer_ids.email",
+	"reviewer_ids.user_id",
+	"state",
+}
+
+var ReviewRecordFieldPathsTopLevel = []string{
+	"reason",
+	"reviewed_at",
+	"reviewer_ids",
+	"state",
+}
+
+func (dst *ReviewRecord) SetFields(src *ReviewRecord, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.State = src.State
+			} else {
+				var zero State
+				dst.State = zero
+			}
+		case "reason":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reason' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Reason = src.Reason
+			} else {
+				var zero string
+				dst.Reason = zero
+			}
+		case "reviewer_ids":
+			if len(subs) > 0 {
+				newDst := dst.ReviewerIDs
+				if newDst == nil {
+					newDst = &UserIdentifiers{}
+					dst.ReviewerIDs = newDst
+				}
+				var newSrc *UserIdentifiers
+				if src != nil {
+					newSrc = src.ReviewerIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ReviewerIDs = src.ReviewerIDs
+				} else {
+					dst.ReviewerIDs = nil
+				}
+			}
+		case "reviewed_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reviewed_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ReviewedAt = src.ReviewedAt
+			} else {
+				dst.ReviewedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+var ReviewRecordsFieldPathsNested = []string{
+	"records",
+}
+
+var ReviewRecordsFieldPathsTopLevel = []string{
+	"records",
+}
+
+func (dst *ReviewRecords) SetFields(src *ReviewRecords, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "records":
+			if len(subs) > 0 {
+				return fmt.Errorf("'records' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Records = src.Records
+			} else {
+				dst.Records = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
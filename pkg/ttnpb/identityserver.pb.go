@@ -11,12 +11,16 @@ import _ "github.com/gogo/protobuf/gogoproto"
 import types "github.com/gogo/protobuf/types"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 
+import time "time"
+
 import (
 	context "context"
 
 	grpc "google.golang.org/grpc"
 )
 
+import github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+
 import strings "strings"
 import reflect "reflect"
 
@@ -27,6 +31,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -241,11 +246,272 @@ func (m *AuthInfoResponse_APIKeyAccess) GetEntityIDs() EntityIdentifiers {
 	return EntityIdentifiers{}
 }
 
+type ListEntitiesByStateRequest struct {
+	// List the entities in this reviewing state.
+	State     State           `protobuf:"varint,1,opt,name=state,proto3,enum=ttn.lorawan.v3.State" json:"state,omitempty"`
+	FieldMask types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	// Limit the number of results per page.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Page number for pagination. 0 is interpreted as 1.
+	Page                 uint32   `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListEntitiesByStateRequest) Reset()      { *m = ListEntitiesByStateRequest{} }
+func (*ListEntitiesByStateRequest) ProtoMessage() {}
+func (*ListEntitiesByStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_identityserver_f7dc84ee4809122e, []int{1}
+}
+func (m *ListEntitiesByStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListEntitiesByStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListEntitiesByStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ListEntitiesByStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntitiesByStateRequest.Merge(dst, src)
+}
+func (m *ListEntitiesByStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListEntitiesByStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntitiesByStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntitiesByStateRequest proto.InternalMessageInfo
+
+func (m *ListEntitiesByStateRequest) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return STATE_REQUESTED
+}
+
+func (m *ListEntitiesByStateRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
+func (m *ListEntitiesByStateRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListEntitiesByStateRequest) GetPage() uint32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+type ReviewEntitiesRequest struct {
+	// The applications, organizations and users to review.
+	EntityIDs []*EntityIdentifiers `protobuf:"bytes,1,rep,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	// The new reviewing state of the entities.
+	State State `protobuf:"varint,2,opt,name=state,proto3,enum=ttn.lorawan.v3.State" json:"state,omitempty"`
+	// The reason for the decision. This is recorded in the review history
+	// and included in the notification of the decision.
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReviewEntitiesRequest) Reset()      { *m = ReviewEntitiesRequest{} }
+func (*ReviewEntitiesRequest) ProtoMessage() {}
+func (*ReviewEntitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_identityserver_f7dc84ee4809122e, []int{2}
+}
+func (m *ReviewEntitiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReviewEntitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReviewEntitiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ReviewEntitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewEntitiesRequest.Merge(dst, src)
+}
+func (m *ReviewEntitiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReviewEntitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewEntitiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviewEntitiesRequest proto.InternalMessageInfo
+
+func (m *ReviewEntitiesRequest) GetEntityIDs() []*EntityIdentifiers {
+	if m != nil {
+		return m.EntityIDs
+	}
+	return nil
+}
+
+func (m *ReviewEntitiesRequest) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return STATE_REQUESTED
+}
+
+func (m *ReviewEntitiesRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// ReviewRecord is a decision in the review history of an entity.
+type ReviewRecord struct {
+	State  State  `protobuf:"varint,1,opt,name=state,proto3,enum=ttn.lorawan.v3.State" json:"state,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The admin that made the decision.
+	ReviewerIDs          *UserIdentifiers `protobuf:"bytes,3,opt,name=reviewer_ids,json=reviewerIds,proto3" json:"reviewer_ids,omitempty"`
+	ReviewedAt           *time.Time       `protobuf:"bytes,4,opt,name=reviewed_at,json=reviewedAt,proto3,stdtime" json:"reviewed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReviewRecord) Reset()      { *m = ReviewRecord{} }
+func (*ReviewRecord) ProtoMessage() {}
+func (*ReviewRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_identityserver_f7dc84ee4809122e, []int{3}
+}
+func (m *ReviewRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReviewRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReviewRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ReviewRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewRecord.Merge(dst, src)
+}
+func (m *ReviewRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReviewRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviewRecord proto.InternalMessageInfo
+
+func (m *ReviewRecord) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return STATE_REQUESTED
+}
+
+func (m *ReviewRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ReviewRecord) GetReviewerIDs() *UserIdentifiers {
+	if m != nil {
+		return m.ReviewerIDs
+	}
+	return nil
+}
+
+func (m *ReviewRecord) GetReviewedAt() *time.Time {
+	if m != nil {
+		return m.ReviewedAt
+	}
+	return nil
+}
+
+type ReviewRecords struct {
+	Records              []*ReviewRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReviewRecords) Reset()      { *m = ReviewRecords{} }
+func (*ReviewRecords) ProtoMessage() {}
+func (*ReviewRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_identityserver_f7dc84ee4809122e, []int{4}
+}
+func (m *ReviewRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReviewRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReviewRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ReviewRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviewRecords.Merge(dst, src)
+}
+func (m *ReviewRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReviewRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviewRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviewRecords proto.InternalMessageInfo
+
+func (m *ReviewRecords) GetRecords() []*ReviewRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AuthInfoResponse)(nil), "ttn.lorawan.v3.AuthInfoResponse")
 	golang_proto.RegisterType((*AuthInfoResponse)(nil), "ttn.lorawan.v3.AuthInfoResponse")
 	proto.RegisterType((*AuthInfoResponse_APIKeyAccess)(nil), "ttn.lorawan.v3.AuthInfoResponse.APIKeyAccess")
 	golang_proto.RegisterType((*AuthInfoResponse_APIKeyAccess)(nil), "ttn.lorawan.v3.AuthInfoResponse.APIKeyAccess")
+	proto.RegisterType((*ListEntitiesByStateRequest)(nil), "ttn.lorawan.v3.ListEntitiesByStateRequest")
+	golang_proto.RegisterType((*ListEntitiesByStateRequest)(nil), "ttn.lorawan.v3.ListEntitiesByStateRequest")
+	proto.RegisterType((*ReviewEntitiesRequest)(nil), "ttn.lorawan.v3.ReviewEntitiesRequest")
+	golang_proto.RegisterType((*ReviewEntitiesRequest)(nil), "ttn.lorawan.v3.ReviewEntitiesRequest")
+	proto.RegisterType((*ReviewRecord)(nil), "ttn.lorawan.v3.ReviewRecord")
+	golang_proto.RegisterType((*ReviewRecord)(nil), "ttn.lorawan.v3.ReviewRecord")
+	proto.RegisterType((*ReviewRecords)(nil), "ttn.lorawan.v3.ReviewRecords")
+	golang_proto.RegisterType((*ReviewRecords)(nil), "ttn.lorawan.v3.ReviewRecords")
 }
 func (this *AuthInfoResponse) Equal(that interface{}) bool {
 	if that == nil {
@@ -355,6 +621,140 @@ func (this *AuthInfoResponse_APIKeyAccess) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ListEntitiesByStateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListEntitiesByStateRequest)
+	if !ok {
+		that2, ok := that.(ListEntitiesByStateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.Page != that1.Page {
+		return false
+	}
+	return true
+}
+func (this *ReviewEntitiesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReviewEntitiesRequest)
+	if !ok {
+		that2, ok := that.(ReviewEntitiesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.EntityIDs) != len(that1.EntityIDs) {
+		return false
+	}
+	for i := range this.EntityIDs {
+		if !this.EntityIDs[i].Equal(that1.EntityIDs[i]) {
+			return false
+		}
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *ReviewRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReviewRecord)
+	if !ok {
+		that2, ok := that.(ReviewRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if !this.ReviewerIDs.Equal(that1.ReviewerIDs) {
+		return false
+	}
+	if that1.ReviewedAt == nil {
+		if this.ReviewedAt != nil {
+			return false
+		}
+	} else if !this.ReviewedAt.Equal(*that1.ReviewedAt) {
+		return false
+	}
+	return true
+}
+func (this *ReviewRecords) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReviewRecords)
+	if !ok {
+		that2, ok := that.(ReviewRecords)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Records) != len(that1.Records) {
+		return false
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(that1.Records[i]) {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -430,10 +830,218 @@ var _EntityAccess_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/identityserver.proto",
 }
 
-func (m *AuthInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+// EntityReviewRegistryClient is the client API for EntityReviewRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EntityReviewRegistryClient interface {
+	// List the users in the given reviewing state.
+	ListUsers(ctx context.Context, in *ListEntitiesByStateRequest, opts ...grpc.CallOption) (*Users, error)
+	// List the applications in the given reviewing state.
+	ListApplications(ctx context.Context, in *ListEntitiesByStateRequest, opts ...grpc.CallOption) (*Applications, error)
+	// List the organizations in the given reviewing state.
+	ListOrganizations(ctx context.Context, in *ListEntitiesByStateRequest, opts ...grpc.CallOption) (*Organizations, error)
+	// Review sets the reviewing state of the given entities, records the decision
+	// in their review history and notifies them of the decision.
+	Review(ctx context.Context, in *ReviewEntitiesRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// GetReviewHistory returns the review history of the given entity.
+	GetReviewHistory(ctx context.Context, in *EntityIdentifiers, opts ...grpc.CallOption) (*ReviewRecords, error)
+}
+
+type entityReviewRegistryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEntityReviewRegistryClient(cc *grpc.ClientConn) EntityReviewRegistryClient {
+	return &entityReviewRegistryClient{cc}
+}
+
+func (c *entityReviewRegistryClient) ListUsers(ctx context.Context, in *ListEntitiesByStateRequest, opts ...grpc.CallOption) (*Users, error) {
+	out := new(Users)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.EntityReviewRegistry/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityReviewRegistryClient) ListApplications(ctx context.Context, in *ListEntitiesByStateRequest, opts ...grpc.CallOption) (*Applications, error) {
+	out := new(Applications)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.EntityReviewRegistry/ListApplications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityReviewRegistryClient) ListOrganizations(ctx context.Context, in *ListEntitiesByStateRequest, opts ...grpc.CallOption) (*Organizations, error) {
+	out := new(Organizations)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.EntityReviewRegistry/ListOrganizations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityReviewRegistryClient) Review(ctx context.Context, in *ReviewEntitiesRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.EntityReviewRegistry/Review", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityReviewRegistryClient) GetReviewHistory(ctx context.Context, in *EntityIdentifiers, opts ...grpc.CallOption) (*ReviewRecords, error) {
+	out := new(ReviewRecords)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.EntityReviewRegistry/GetReviewHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EntityReviewRegistryServer is the server API for EntityReviewRegistry service.
+type EntityReviewRegistryServer interface {
+	// List the users in the given reviewing state.
+	ListUsers(context.Context, *ListEntitiesByStateRequest) (*Users, error)
+	// List the applications in the given reviewing state.
+	ListApplications(context.Context, *ListEntitiesByStateRequest) (*Applications, error)
+	// List the organizations in the given reviewing state.
+	ListOrganizations(context.Context, *ListEntitiesByStateRequest) (*Organizations, error)
+	// Review sets the reviewing state of the given entities, records the decision
+	// in their review history and notifies them of the decision.
+	Review(context.Context, *ReviewEntitiesRequest) (*types.Empty, error)
+	// GetReviewHistory returns the review history of the given entity.
+	GetReviewHistory(context.Context, *EntityIdentifiers) (*ReviewRecords, error)
+}
+
+func RegisterEntityReviewRegistryServer(s *grpc.Server, srv EntityReviewRegistryServer) {
+	s.RegisterService(&_EntityReviewRegistry_serviceDesc, srv)
+}
+
+func _EntityReviewRegistry_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntitiesByStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityReviewRegistryServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.EntityReviewRegistry/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityReviewRegistryServer).ListUsers(ctx, req.(*ListEntitiesByStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityReviewRegistry_ListApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntitiesByStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityReviewRegistryServer).ListApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.EntityReviewRegistry/ListApplications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityReviewRegistryServer).ListApplications(ctx, req.(*ListEntitiesByStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityReviewRegistry_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntitiesByStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityReviewRegistryServer).ListOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.EntityReviewRegistry/ListOrganizations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityReviewRegistryServer).ListOrganizations(ctx, req.(*ListEntitiesByStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityReviewRegistry_Review_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityReviewRegistryServer).Review(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.EntityReviewRegistry/Review",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityReviewRegistryServer).Review(ctx, req.(*ReviewEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityReviewRegistry_GetReviewHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityReviewRegistryServer).GetReviewHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.EntityReviewRegistry/GetReviewHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityReviewRegistryServer).GetReviewHistory(ctx, req.(*EntityIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EntityReviewRegistry_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.EntityReviewRegistry",
+	HandlerType: (*EntityReviewRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUsers",
+			Handler:    _EntityReviewRegistry_ListUsers_Handler,
+		},
+		{
+			MethodName: "ListApplications",
+			Handler:    _EntityReviewRegistry_ListApplications_Handler,
+		},
+		{
+			MethodName: "ListOrganizations",
+			Handler:    _EntityReviewRegistry_ListOrganizations_Handler,
+		},
+		{
+			MethodName: "Review",
+			Handler:    _EntityReviewRegistry_Review_Handler,
+		},
+		{
+			MethodName: "GetReviewHistory",
+			Handler:    _EntityReviewRegistry_GetReviewHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/identityserver.proto",
+}
+
+func (m *AuthInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
 	if err != nil {
 		return nil, err
 	}
@@ -527,6 +1135,167 @@ func (m *AuthInfoResponse_APIKeyAccess) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ListEntitiesByStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListEntitiesByStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(m.State))
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintIdentityserver(dAtA, i, uint64(m.FieldMask.Size()))
+	n7, err := m.FieldMask.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(m.Page))
+	}
+	return i, nil
+}
+
+func (m *ReviewEntitiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReviewEntitiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.EntityIDs) > 0 {
+		for _, msg := range m.EntityIDs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintIdentityserver(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(m.State))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	return i, nil
+}
+
+func (m *ReviewRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReviewRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(m.State))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if m.ReviewerIDs != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(m.ReviewerIDs.Size()))
+		n10, err := m.ReviewerIDs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.ReviewedAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintIdentityserver(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReviewedAt)))
+		n11, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReviewedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+
+func (m *ReviewRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReviewRecords) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, msg := range m.Records {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintIdentityserver(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func encodeVarintIdentityserver(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -573,6 +1342,59 @@ func NewPopulatedAuthInfoResponse_APIKeyAccess(r randyIdentityserver, easy bool)
 	}
 	return this
 }
+func NewPopulatedListEntitiesByStateRequest(r randyIdentityserver, easy bool) *ListEntitiesByStateRequest {
+	this := &ListEntitiesByStateRequest{}
+	this.State = State([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	v8 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v8
+	this.Limit = r.Uint32()
+	this.Page = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+func NewPopulatedReviewEntitiesRequest(r randyIdentityserver, easy bool) *ReviewEntitiesRequest {
+	this := &ReviewEntitiesRequest{}
+	if r.Intn(10) != 0 {
+		v9 := r.Intn(5)
+		this.EntityIDs = make([]*EntityIdentifiers, v9)
+		for i := 0; i < v9; i++ {
+			this.EntityIDs[i] = NewPopulatedEntityIdentifiers(r, easy)
+		}
+	}
+	this.State = State([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.Reason = randStringIdentityserver(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+func NewPopulatedReviewRecord(r randyIdentityserver, easy bool) *ReviewRecord {
+	this := &ReviewRecord{}
+	this.State = State([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.Reason = randStringIdentityserver(r)
+	if r.Intn(10) != 0 {
+		this.ReviewerIDs = NewPopulatedUserIdentifiers(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.ReviewedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+func NewPopulatedReviewRecords(r randyIdentityserver, easy bool) *ReviewRecords {
+	this := &ReviewRecords{}
+	if r.Intn(10) != 0 {
+		v12 := r.Intn(5)
+		this.Records = make([]*ReviewRecord, v12)
+		for i := 0; i < v12; i++ {
+			this.Records[i] = NewPopulatedReviewRecord(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
 
 type randyIdentityserver interface {
 	Float32() float32
@@ -698,13 +1520,90 @@ func (m *AuthInfoResponse_APIKeyAccess) Size() (n int) {
 	n += 1 + l + sovIdentityserver(uint64(l))
 	return n
 }
-
-func sovIdentityserver(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *ListEntitiesByStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovIdentityserver(uint64(m.State))
+	}
+	l = m.FieldMask.Size()
+	n += 1 + l + sovIdentityserver(uint64(l))
+	if m.Limit != 0 {
+		n += 1 + sovIdentityserver(uint64(m.Limit))
+	}
+	if m.Page != 0 {
+		n += 1 + sovIdentityserver(uint64(m.Page))
+	}
+	return n
+}
+func (m *ReviewEntitiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EntityIDs) > 0 {
+		for _, e := range m.EntityIDs {
+			l = e.Size()
+			n += 1 + l + sovIdentityserver(uint64(l))
+		}
+	}
+	if m.State != 0 {
+		n += 1 + sovIdentityserver(uint64(m.State))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovIdentityserver(uint64(l))
+	}
+	return n
+}
+func (m *ReviewRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovIdentityserver(uint64(m.State))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovIdentityserver(uint64(l))
+	}
+	if m.ReviewerIDs != nil {
+		l = m.ReviewerIDs.Size()
+		n += 1 + l + sovIdentityserver(uint64(l))
+	}
+	if m.ReviewedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReviewedAt)
+		n += 1 + l + sovIdentityserver(uint64(l))
+	}
+	return n
+}
+func (m *ReviewRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovIdentityserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovIdentityserver(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
 		}
 	}
 	return n
@@ -754,6 +1653,54 @@ func (this *AuthInfoResponse_APIKeyAccess) String() string {
 	}, "")
 	return s
 }
+func (this *ListEntitiesByStateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListEntitiesByStateRequest{`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "types.FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReviewEntitiesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReviewEntitiesRequest{`,
+		`EntityIDs:` + strings.Replace(fmt.Sprintf("%v", this.EntityIDs), "EntityIdentifiers", "EntityIdentifiers", 1) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReviewRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReviewRecord{`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`ReviewerIDs:` + strings.Replace(fmt.Sprintf("%v", this.ReviewerIDs), "UserIdentifiers", "UserIdentifiers", 1) + `,`,
+		`ReviewedAt:` + strings.Replace(fmt.Sprintf("%v", this.ReviewedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReviewRecords) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReviewRecords{`,
+		`Records:` + strings.Replace(fmt.Sprintf("%v", this.Records), "ReviewRecord", "ReviewRecord", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringIdentityserver(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1019,6 +1966,517 @@ func (m *AuthInfoResponse_APIKeyAccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ListEntitiesByStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentityserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListEntitiesByStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListEntitiesByStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (State(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentityserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReviewEntitiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentityserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReviewEntitiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReviewEntitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntityIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntityIDs = append(m.EntityIDs, &EntityIdentifiers{})
+			if err := m.EntityIDs[len(m.EntityIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (State(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentityserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReviewRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentityserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReviewRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReviewRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (State(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewerIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReviewerIDs == nil {
+				m.ReviewerIDs = &UserIdentifiers{}
+			}
+			if err := m.ReviewerIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReviewedAt == nil {
+				m.ReviewedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ReviewedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentityserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReviewRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIdentityserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReviewRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReviewRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIdentityserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &ReviewRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIdentityserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthIdentityserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipIdentityserver(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1132,42 +2590,71 @@ func init() {
 }
 
 var fileDescriptor_identityserver_f7dc84ee4809122e = []byte{
-	// 581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x3f, 0x48, 0x1c, 0x4f,
-	0x14, 0x9e, 0x51, 0xf0, 0xa7, 0xf3, 0x33, 0xf1, 0xb2, 0x04, 0x91, 0x4b, 0xf2, 0xce, 0x18, 0x08,
-	0x29, 0xe2, 0x2c, 0x68, 0x95, 0xf2, 0x96, 0x08, 0x8a, 0x45, 0xc2, 0xc6, 0x22, 0xa4, 0x39, 0xf6,
-	0xce, 0xb9, 0xdd, 0xe1, 0x74, 0x66, 0xd9, 0x99, 0x53, 0xae, 0x93, 0x54, 0x96, 0x81, 0x34, 0xa9,
-	0x42, 0x48, 0x65, 0x69, 0x69, 0x69, 0x13, 0xb0, 0x14, 0xd2, 0x58, 0x1d, 0xee, 0x6c, 0x0a, 0x4b,
-	0x4b, 0xcb, 0x70, 0xb3, 0x7b, 0x17, 0xdd, 0x23, 0xa4, 0x9b, 0x79, 0xdf, 0xf7, 0xbe, 0xf7, 0xef,
-	0x23, 0xcf, 0x77, 0x64, 0x12, 0xec, 0x07, 0x62, 0x59, 0xe9, 0xa0, 0xd5, 0x71, 0x83, 0x98, 0xbb,
-	0x7c, 0x9b, 0x09, 0xcd, 0x75, 0x4f, 0xb1, 0x64, 0x8f, 0x25, 0x34, 0x4e, 0xa4, 0x96, 0xce, 0x7d,
-	0xad, 0x05, 0x2d, 0xb8, 0x74, 0x6f, 0xb5, 0xba, 0x1c, 0x72, 0x1d, 0x75, 0x9b, 0xb4, 0x25, 0x77,
-	0xdd, 0x50, 0x86, 0xd2, 0xb5, 0xb4, 0x66, 0xb7, 0x6d, 0x7f, 0xf6, 0x63, 0x5f, 0x79, 0x7a, 0xf5,
-	0x71, 0x28, 0x65, 0xb8, 0xc3, 0xac, 0x7e, 0x20, 0x84, 0xd4, 0x81, 0xe6, 0x52, 0xa8, 0x02, 0x7d,
-	0x54, 0xa0, 0x23, 0x0d, 0xb6, 0x1b, 0xeb, 0x5e, 0x01, 0x3e, 0xfb, 0x5b, 0x87, 0x6d, 0xce, 0x92,
-	0xa1, 0xc2, 0x93, 0x71, 0x92, 0x0c, 0xba, 0x3a, 0x2a, 0x60, 0x18, 0x87, 0x13, 0x1e, 0x46, 0xba,
-	0x48, 0x5f, 0xfa, 0x31, 0x49, 0x2a, 0xf5, 0xae, 0x8e, 0x36, 0x44, 0x5b, 0xfa, 0x4c, 0xc5, 0x52,
-	0x28, 0xe6, 0x6c, 0x91, 0xff, 0x82, 0x98, 0x37, 0x3a, 0xac, 0xb7, 0x80, 0x17, 0xf1, 0x8b, 0xff,
-	0x57, 0x96, 0xe9, 0xdd, 0x25, 0xd0, 0x72, 0x0a, 0xad, 0xbf, 0xdd, 0xd8, 0x64, 0xbd, 0x7a, 0xab,
-	0xc5, 0x94, 0xf2, 0x88, 0xe9, 0xd7, 0xa6, 0xf2, 0xc8, 0x3a, 0xf2, 0xa7, 0x82, 0x98, 0x6f, 0xb2,
-	0x9e, 0xd3, 0x26, 0x8e, 0xed, 0xac, 0x11, 0x58, 0x56, 0x43, 0xcb, 0x0e, 0x13, 0x0b, 0x13, 0xb6,
-	0xc0, 0x62, 0xb9, 0xc0, 0x9b, 0x41, 0x85, 0x5c, 0x6e, 0x6b, 0xc0, 0xf3, 0x1e, 0x9a, 0x7e, 0xad,
-	0x52, 0x8e, 0xae, 0x23, 0xbf, 0x62, 0x35, 0x6f, 0xc5, 0x9c, 0x3a, 0xa9, 0x74, 0x05, 0xdf, 0x63,
-	0x89, 0x0a, 0x76, 0x1a, 0xf9, 0xb0, 0x0b, 0x93, 0xb6, 0xca, 0x7c, 0xb9, 0x8a, 0x6f, 0x51, 0x7f,
-	0x6e, 0xc4, 0xcf, 0x03, 0xd5, 0xaf, 0x98, 0xcc, 0xde, 0x9e, 0xc8, 0x79, 0x55, 0xde, 0xc8, 0x98,
-	0x54, 0x4e, 0xf7, 0xa6, 0xcf, 0xfa, 0x35, 0x74, 0xde, 0xaf, 0xe1, 0xd1, 0xd8, 0xef, 0x08, 0xc9,
-	0x5d, 0xd5, 0xe0, 0xdb, 0xaa, 0x18, 0xf7, 0x69, 0x39, 0x7b, 0xcd, 0x32, 0x36, 0xfe, 0x5c, 0xd7,
-	0x7b, 0x30, 0x10, 0x32, 0xfd, 0xda, 0x4c, 0x01, 0xbd, 0x56, 0xfe, 0x0c, 0x2b, 0x58, 0xca, 0x9b,
-	0x23, 0xf7, 0x8a, 0x2d, 0xee, 0x32, 0x1d, 0xc9, 0xed, 0x95, 0x88, 0xcc, 0xe6, 0xc4, 0xa2, 0xe1,
-	0xf7, 0x64, 0x7a, 0x78, 0x23, 0x67, 0x9e, 0xe6, 0x2e, 0xa3, 0x43, 0x97, 0xd1, 0xb5, 0x81, 0xcb,
-	0xaa, 0x8b, 0xff, 0xba, 0xea, 0x92, 0xf3, 0xf1, 0xe7, 0xaf, 0xcf, 0x13, 0xb3, 0x0e, 0x71, 0xed,
-	0xe1, 0xb8, 0x68, 0x4b, 0xef, 0x3b, 0x3e, 0x4b, 0x01, 0x9f, 0xa7, 0x80, 0x2f, 0x52, 0x40, 0x97,
-	0x29, 0xa0, 0xab, 0x14, 0xd0, 0x75, 0x0a, 0xe8, 0x26, 0x05, 0x7c, 0x60, 0x00, 0x1f, 0x1a, 0x40,
-	0x47, 0x06, 0xf0, 0xb1, 0x01, 0x74, 0x62, 0x00, 0x9d, 0x1a, 0x40, 0x67, 0x06, 0xf0, 0xb9, 0x01,
-	0x7c, 0x61, 0x00, 0x5d, 0x1a, 0xc0, 0x57, 0x06, 0xd0, 0xb5, 0x01, 0x7c, 0x63, 0x00, 0x1d, 0x64,
-	0x80, 0x0e, 0x33, 0xc0, 0x9f, 0x32, 0x40, 0x5f, 0x32, 0xc0, 0xdf, 0x32, 0x40, 0x47, 0x19, 0xa0,
-	0xe3, 0x0c, 0xf0, 0x49, 0x06, 0xf8, 0x34, 0x03, 0xfc, 0xe1, 0x65, 0x28, 0xa9, 0x8e, 0x98, 0x8e,
-	0xb8, 0x08, 0x15, 0x15, 0x4c, 0xef, 0xcb, 0xa4, 0xe3, 0xde, 0x75, 0x77, 0xdc, 0x09, 0x5d, 0xad,
-	0x45, 0xdc, 0x6c, 0x4e, 0xd9, 0x51, 0x57, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xea, 0xef, 0xf4,
-	0xeb, 0xe5, 0x03, 0x00, 0x00,
+	// 1044 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x68, 0x1b, 0xc7,
+	0x17, 0xde, 0xb1, 0x15, 0x3b, 0x1e, 0xdb, 0xb1, 0x32, 0xd8, 0xfe, 0x19, 0xfd, 0xec, 0x91, 0xba,
+	0xfd, 0x43, 0x71, 0xeb, 0x15, 0x38, 0x50, 0x68, 0x2e, 0x45, 0xa2, 0x69, 0x6c, 0xd2, 0xe2, 0xb2,
+	0x76, 0xa1, 0xb4, 0x07, 0x31, 0x96, 0x46, 0xab, 0x41, 0xd2, 0xce, 0x76, 0x67, 0x64, 0xa3, 0x40,
+	0x21, 0xf4, 0x94, 0x63, 0xa0, 0x97, 0x9e, 0x4a, 0xe9, 0x29, 0x97, 0x42, 0x0e, 0x3d, 0xe4, 0x98,
+	0x4b, 0xa9, 0x8f, 0x86, 0x5e, 0x72, 0x52, 0xa3, 0x55, 0x0f, 0x81, 0x5e, 0x72, 0xcc, 0xb1, 0xec,
+	0xcc, 0xae, 0xb5, 0xda, 0x8d, 0x48, 0x7c, 0xdb, 0x99, 0xf7, 0xcd, 0xf7, 0xbd, 0xf7, 0xbd, 0x99,
+	0xb7, 0xf0, 0xbd, 0x0e, 0xf7, 0xc9, 0x29, 0x71, 0x77, 0x84, 0x24, 0xf5, 0x76, 0x99, 0x78, 0xac,
+	0xcc, 0x1a, 0xd4, 0x95, 0x4c, 0xf6, 0x05, 0xf5, 0x4f, 0xa8, 0x6f, 0x79, 0x3e, 0x97, 0x1c, 0x5d,
+	0x93, 0xd2, 0xb5, 0x22, 0xac, 0x75, 0x72, 0xa3, 0xb0, 0xe3, 0x30, 0xd9, 0xea, 0x1d, 0x5b, 0x75,
+	0xde, 0x2d, 0x3b, 0xdc, 0xe1, 0x65, 0x05, 0x3b, 0xee, 0x35, 0xd5, 0x4a, 0x2d, 0xd4, 0x97, 0x3e,
+	0x5e, 0xd8, 0x74, 0x38, 0x77, 0x3a, 0x54, 0xf1, 0x13, 0xd7, 0xe5, 0x92, 0x48, 0xc6, 0x5d, 0x11,
+	0x45, 0xff, 0x1f, 0x45, 0x2f, 0x38, 0x68, 0xd7, 0x93, 0xfd, 0x28, 0xf8, 0xf6, 0xb4, 0x0c, 0x9b,
+	0x8c, 0xfa, 0x31, 0xc3, 0x56, 0x16, 0xc4, 0x49, 0x4f, 0xb6, 0xa2, 0x30, 0xce, 0x86, 0x7d, 0xe6,
+	0xb4, 0x64, 0x7c, 0xbc, 0x94, 0x4e, 0xa0, 0xc9, 0x68, 0xa7, 0x51, 0xeb, 0x12, 0xd1, 0x8e, 0x10,
+	0xc5, 0x34, 0x42, 0xb2, 0x2e, 0x15, 0x92, 0x74, 0xbd, 0xe9, 0x69, 0x12, 0xcf, 0xeb, 0xb0, 0xba,
+	0xaa, 0x74, 0x7a, 0x9a, 0xd4, 0xed, 0x75, 0xe3, 0x34, 0xde, 0xc9, 0x86, 0xb9, 0xef, 0x10, 0x97,
+	0xdd, 0x4d, 0x92, 0x6c, 0x66, 0x51, 0x3d, 0x11, 0x37, 0xca, 0xfc, 0x63, 0x16, 0xe6, 0x2b, 0x3d,
+	0xd9, 0xda, 0x77, 0x9b, 0xdc, 0xa6, 0xc2, 0xe3, 0xae, 0xa0, 0xe8, 0x08, 0xce, 0x13, 0x8f, 0xd5,
+	0xda, 0xb4, 0xbf, 0x01, 0x4a, 0xe0, 0xfd, 0xc5, 0xdd, 0x1d, 0x6b, 0xb2, 0x9f, 0x56, 0xfa, 0x88,
+	0x55, 0xf9, 0x72, 0xff, 0x0e, 0xed, 0x57, 0xea, 0x75, 0x2a, 0x44, 0x15, 0x06, 0x83, 0xe2, 0x9c,
+	0xde, 0xd9, 0x33, 0xec, 0x39, 0xe2, 0xb1, 0x3b, 0xb4, 0x8f, 0x9a, 0x10, 0x29, 0x93, 0x6b, 0x44,
+	0xa1, 0x6a, 0x92, 0xb7, 0xa9, 0xbb, 0x31, 0xa3, 0x04, 0x4a, 0x69, 0x81, 0x83, 0x50, 0x41, 0xd3,
+	0x1d, 0x85, 0xb8, 0xea, 0x6a, 0x30, 0x28, 0xe6, 0xd3, 0xbb, 0x7b, 0x86, 0x9d, 0xe7, 0x64, 0x72,
+	0x0f, 0x55, 0x60, 0xbe, 0xe7, 0xb2, 0x13, 0xea, 0x0b, 0xd2, 0xa9, 0xe9, 0xbe, 0x6d, 0xcc, 0x2a,
+	0x95, 0xf5, 0xb4, 0x8a, 0xad, 0xa2, 0xf6, 0xca, 0x05, 0x5e, 0x6f, 0x14, 0x7e, 0x06, 0x70, 0x29,
+	0x59, 0x11, 0xfa, 0x38, 0xed, 0x48, 0x86, 0x4a, 0xc3, 0xab, 0x57, 0xcf, 0x06, 0x45, 0xe3, 0x7c,
+	0x50, 0x04, 0x17, 0x65, 0x1f, 0x42, 0xa8, 0x1f, 0x48, 0x8d, 0x35, 0x44, 0x54, 0xee, 0x5b, 0xe9,
+	0xd3, 0xb7, 0x14, 0x62, 0x7f, 0x7c, 0x51, 0xab, 0xd7, 0x43, 0xa2, 0x60, 0x50, 0x5c, 0x88, 0x42,
+	0x9f, 0x0a, 0x7b, 0x81, 0x46, 0x28, 0x51, 0x5d, 0x81, 0xcb, 0x91, 0x8b, 0x5d, 0x2a, 0x5b, 0xbc,
+	0x61, 0xfe, 0x0e, 0x60, 0xe1, 0x73, 0x26, 0xa4, 0x42, 0x33, 0x2a, 0xaa, 0xfd, 0x43, 0x49, 0x24,
+	0xb5, 0xe9, 0x77, 0x3d, 0x2a, 0x24, 0xfa, 0x00, 0x5e, 0x11, 0xe1, 0x5a, 0x65, 0x7f, 0x6d, 0x77,
+	0x2d, 0xad, 0xaf, 0xc1, 0x1a, 0x83, 0x3e, 0x81, 0x70, 0x7c, 0xa1, 0xa3, 0x8c, 0x0b, 0x96, 0xbe,
+	0xd1, 0x56, 0x7c, 0xa3, 0xad, 0xcf, 0x42, 0xc8, 0x17, 0x44, 0xb4, 0xab, 0xb9, 0x30, 0x55, 0x7b,
+	0xa1, 0x19, 0x6f, 0xa0, 0x55, 0x78, 0xa5, 0xc3, 0xba, 0x4c, 0x2a, 0xdb, 0x97, 0x6d, 0xbd, 0x40,
+	0x08, 0xe6, 0x3c, 0xe2, 0xd0, 0x8d, 0x9c, 0xda, 0x54, 0xdf, 0xe6, 0x6f, 0x00, 0xae, 0xd9, 0xf4,
+	0x84, 0xd1, 0xd3, 0x38, 0xf1, 0x38, 0xe3, 0x83, 0x09, 0xdb, 0x40, 0x69, 0xf6, 0xcd, 0x6c, 0x5b,
+	0x9e, 0x66, 0xd9, 0xd8, 0x82, 0x99, 0x37, 0xb0, 0x60, 0x1d, 0xce, 0xf9, 0x94, 0x08, 0xee, 0xaa,
+	0x12, 0x16, 0xec, 0x68, 0x65, 0xfe, 0x0b, 0xe0, 0x92, 0xce, 0xd7, 0xa6, 0x75, 0xee, 0x37, 0x2e,
+	0x67, 0xec, 0x98, 0x75, 0x26, 0xc9, 0x8a, 0x0e, 0xe1, 0x92, 0xaf, 0x48, 0xa9, 0xaf, 0xaa, 0xd5,
+	0xb7, 0xb5, 0x98, 0xe6, 0xfa, 0x4a, 0x50, 0x3f, 0x59, 0xeb, 0x4a, 0x30, 0x28, 0x2e, 0xda, 0xd1,
+	0xc1, 0xb0, 0xda, 0xc5, 0x98, 0x25, 0xac, 0xb7, 0x02, 0xe3, 0x65, 0xa3, 0x46, 0xe4, 0x46, 0x6e,
+	0x4a, 0x1b, 0x8f, 0xe2, 0xc1, 0x54, 0xcd, 0x3d, 0xf8, 0xbb, 0x08, 0x6c, 0x18, 0x1f, 0xaa, 0x48,
+	0xf3, 0x36, 0x5c, 0x4e, 0x16, 0x2b, 0xd0, 0x47, 0x70, 0xde, 0xd7, 0x9f, 0x51, 0x47, 0x36, 0x33,
+	0x2f, 0x2a, 0x81, 0xb7, 0x63, 0xf0, 0x6e, 0x0b, 0x2e, 0xe9, 0x9e, 0x44, 0xcf, 0xe9, 0x6b, 0x78,
+	0x35, 0x9e, 0x20, 0x68, 0x3d, 0x93, 0xd2, 0xad, 0x70, 0x9c, 0x17, 0x4a, 0xaf, 0x9b, 0x39, 0x26,
+	0xfa, 0xe1, 0xaf, 0x7f, 0x7e, 0x9c, 0x59, 0x42, 0xb0, 0xac, 0xc6, 0x0a, 0x73, 0x9b, 0x7c, 0xf7,
+	0xcf, 0x1c, 0x5c, 0xd5, 0x52, 0x71, 0x26, 0x0e, 0x13, 0xd2, 0xef, 0x23, 0x0a, 0x17, 0xc2, 0xf7,
+	0x11, 0x7a, 0x28, 0xd0, 0x76, 0x9a, 0x7b, 0xfa, 0xd3, 0x29, 0xac, 0xbd, 0xaa, 0x0d, 0xc2, 0x5c,
+	0x53, 0xe2, 0x2b, 0x68, 0xb9, 0xac, 0x0d, 0x53, 0x43, 0x55, 0xa0, 0xbb, 0x30, 0x1f, 0x72, 0x55,
+	0xc6, 0xb3, 0xfc, 0x72, 0x6a, 0x19, 0x43, 0x93, 0x4c, 0xe6, 0xa6, 0x12, 0x5d, 0x47, 0xab, 0xb1,
+	0x28, 0x49, 0xea, 0x7c, 0x0f, 0xaf, 0x87, 0xcc, 0x07, 0x89, 0x7f, 0xc0, 0xe5, 0xc4, 0xb7, 0x32,
+	0x53, 0x38, 0x49, 0x65, 0x6e, 0x29, 0xf5, 0xff, 0xa1, 0xb5, 0x58, 0x9d, 0x4f, 0x28, 0x7d, 0x0b,
+	0xe7, 0xb4, 0xe7, 0xe8, 0xdd, 0x57, 0xdf, 0x8a, 0xd4, 0x13, 0x2f, 0x4c, 0xe9, 0x7c, 0xdc, 0x57,
+	0x73, 0x3e, 0xd2, 0xb9, 0x09, 0xb6, 0x91, 0x07, 0xf3, 0xb7, 0xa9, 0xd4, 0x3c, 0x7b, 0x4c, 0x48,
+	0xee, 0xf7, 0xd1, 0xeb, 0xc7, 0x41, 0xb6, 0xa2, 0x89, 0xfb, 0x6c, 0x16, 0x94, 0xd2, 0xaa, 0xb9,
+	0x12, 0x57, 0xd4, 0xd2, 0xd4, 0x37, 0xc1, 0x76, 0xf5, 0x57, 0x70, 0x36, 0xc4, 0xe0, 0x7c, 0x88,
+	0xc1, 0xd3, 0x21, 0x36, 0x9e, 0x0d, 0xb1, 0xf1, 0x7c, 0x88, 0x8d, 0x17, 0x43, 0x6c, 0xbc, 0x1c,
+	0x62, 0x70, 0x2f, 0xc0, 0xe0, 0x7e, 0x80, 0x8d, 0x87, 0x01, 0x06, 0x8f, 0x02, 0x6c, 0x3c, 0x0e,
+	0xb0, 0xf1, 0x24, 0xc0, 0xc6, 0x59, 0x80, 0xc1, 0x79, 0x80, 0xc1, 0xd3, 0x00, 0x1b, 0xcf, 0x02,
+	0x0c, 0x9e, 0x07, 0xd8, 0x78, 0x11, 0x60, 0xf0, 0x32, 0xc0, 0xc6, 0xbd, 0x11, 0x36, 0xee, 0x8f,
+	0x30, 0x78, 0x30, 0xc2, 0xc6, 0x4f, 0x23, 0x0c, 0x7e, 0x19, 0x61, 0xe3, 0xe1, 0x08, 0x1b, 0x8f,
+	0x46, 0x18, 0x3c, 0x1e, 0x61, 0xf0, 0x64, 0x84, 0xc1, 0x37, 0x1f, 0x3a, 0xdc, 0x92, 0x2d, 0x2a,
+	0x5b, 0xcc, 0x75, 0x84, 0xe5, 0x52, 0x79, 0xca, 0xfd, 0x76, 0x79, 0xf2, 0x1f, 0xee, 0xb5, 0x9d,
+	0xb2, 0x94, 0xae, 0x77, 0x7c, 0x3c, 0xa7, 0xac, 0xbb, 0xf1, 0xdf, 0x00, 0x70, 0x4a, 0x89, 0x68,
+	0x98, 0x09, 0x00, 0x00,
 }
@@ -9,6 +9,8 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 import _ "github.com/golang/protobuf/ptypes/empty"
+import _ "google.golang.org/genproto/protobuf/field_mask"
+import _ "github.com/golang/protobuf/ptypes/timestamp"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 
 import time "time"
@@ -50,3 +52,37 @@ func (this *AuthInfoResponse_APIKeyAccess) Validate() error {
 	}
 	return nil
 }
+func (this *ListEntitiesByStateRequest) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.FieldMask)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("FieldMask", err)
+	}
+	return nil
+}
+func (this *ReviewEntitiesRequest) Validate() error {
+	for _, item := range this.EntityIDs {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("EntityIDs", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ReviewRecord) Validate() error {
+	if this.ReviewerIDs != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ReviewerIDs); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ReviewerIDs", err)
+		}
+	}
+	return nil
+}
+func (this *ReviewRecords) Validate() error {
+	for _, item := range this.Records {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Records", err)
+			}
+		}
+	}
+	return nil
+}
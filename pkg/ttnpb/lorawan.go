@@ -47,6 +47,10 @@ func (v MACVersion) String() string {
 		return "1.0.1"
 	case MAC_V1_0_2:
 		return "1.0.2"
+	case MAC_V1_0_3:
+		return "1.0.3"
+	case MAC_V1_0_4:
+		return "1.0.4"
 	case MAC_V1_1:
 		return "1.1.0"
 	}
@@ -67,8 +71,10 @@ func (v *MACVersion) UnmarshalText(b []byte) error {
 		*v = MAC_V1_0_1
 	case MAC_V1_0_2.String():
 		*v = MAC_V1_0_2
-	case MAC_V1_0_2.String():
-		*v = MAC_V1_0_2
+	case MAC_V1_0_3.String():
+		*v = MAC_V1_0_3
+	case MAC_V1_0_4.String():
+		*v = MAC_V1_0_4
 	case MAC_V1_1.String():
 		*v = MAC_V1_1
 	case MAC_UNKNOWN.String():
@@ -94,7 +100,7 @@ func (v MACVersion) Compare(o MACVersion) int {
 // EncryptFOpts panics, if v.Validate() returns non-nil error.
 func (v MACVersion) EncryptFOpts() bool {
 	switch v {
-	case MAC_V1_0, MAC_V1_0_1, MAC_V1_0_2:
+	case MAC_V1_0, MAC_V1_0_1, MAC_V1_0_2, MAC_V1_0_3, MAC_V1_0_4:
 		return false
 	case MAC_V1_1:
 		return true
@@ -106,10 +112,22 @@ func (v MACVersion) EncryptFOpts() bool {
 // HasMaxFCntGap panics, if v.Validate() returns non-nil error.
 func (v MACVersion) HasMaxFCntGap() bool {
 	switch v {
-	case MAC_V1_0, MAC_V1_0_1, MAC_V1_0_2:
+	case MAC_V1_0, MAC_V1_0_1, MAC_V1_0_2, MAC_V1_0_3:
 		return true
-	case MAC_V1_1:
+	case MAC_V1_0_4, MAC_V1_1:
+		return false
+	}
+	panic(v.Validate())
+}
+
+// DuplicatesLinkADRAns reports whether devices of v respond to each LinkADRReq in a block with an identical LinkADRAns.
+// DuplicatesLinkADRAns panics, if v.Validate() returns non-nil error.
+func (v MACVersion) DuplicatesLinkADRAns() bool {
+	switch v {
+	case MAC_V1_0, MAC_V1_0_1, MAC_V1_1:
 		return false
+	case MAC_V1_0_2, MAC_V1_0_3, MAC_V1_0_4:
+		return true
 	}
 	panic(v.Validate())
 }
@@ -129,6 +147,12 @@ func (v PHYVersion) String() string {
 		return "1.1.0-a"
 	case PHY_V1_1_REV_B:
 		return "1.1.0-b"
+	case PHY_V1_0_3_REV_A:
+		return "1.0.3-a"
+	case RP002_V1_0_0:
+		return "RP002-1.0.0"
+	case RP002_V1_0_1:
+		return "RP002-1.0.1"
 	}
 	return "unknown"
 }
@@ -153,6 +177,12 @@ func (v *PHYVersion) UnmarshalText(b []byte) error {
 		*v = PHY_V1_1_REV_A
 	case PHY_V1_1_REV_B.String():
 		*v = PHY_V1_1_REV_B
+	case PHY_V1_0_3_REV_A.String():
+		*v = PHY_V1_0_3_REV_A
+	case RP002_V1_0_0.String():
+		*v = RP002_V1_0_0
+	case RP002_V1_0_1.String():
+		*v = RP002_V1_0_1
 	case PHY_UNKNOWN.String():
 		*v = PHY_UNKNOWN
 	default:
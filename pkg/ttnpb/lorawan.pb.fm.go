@@ -1018,6 +1018,7 @@ func (dst *DataRate) SetFields(src *DataRate, paths ...string) error {
 }
 
 var TxSettingsFieldPathsNested = []string{
+	"antenna_index",
 	"coding_rate",
 	"data_rate",
 	"data_rate.modulation",
@@ -1038,6 +1039,7 @@ var TxSettingsFieldPathsNested = []string{
 }
 
 var TxSettingsFieldPathsTopLevel = []string{
+	"antenna_index",
 	"coding_rate",
 	"data_rate",
 	"data_rate_index",
@@ -1171,6 +1173,16 @@ func (dst *TxSettings) SetFields(src *TxSettings, paths ...string) error {
 			} else {
 				dst.Time = nil
 			}
+		case "antenna_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'antenna_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AntennaIndex = src.AntennaIndex
+			} else {
+				var zero uint32
+				dst.AntennaIndex = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -101,6 +101,8 @@ const (
 	MAC_V1_0_1  MACVersion = 2
 	MAC_V1_0_2  MACVersion = 3
 	MAC_V1_1    MACVersion = 4
+	MAC_V1_0_3  MACVersion = 5
+	MAC_V1_0_4  MACVersion = 6
 )
 
 var MACVersion_name = map[int32]string{
@@ -109,6 +111,8 @@ var MACVersion_name = map[int32]string{
 	2: "MAC_V1_0_1",
 	3: "MAC_V1_0_2",
 	4: "MAC_V1_1",
+	5: "MAC_V1_0_3",
+	6: "MAC_V1_0_4",
 }
 var MACVersion_value = map[string]int32{
 	"MAC_UNKNOWN": 0,
@@ -116,6 +120,8 @@ var MACVersion_value = map[string]int32{
 	"MAC_V1_0_1":  2,
 	"MAC_V1_0_2":  3,
 	"MAC_V1_1":    4,
+	"MAC_V1_0_3":  5,
+	"MAC_V1_0_4":  6,
 }
 
 func (MACVersion) EnumDescriptor() ([]byte, []int) {
@@ -132,6 +138,9 @@ const (
 	PHY_V1_0_2_REV_B PHYVersion = 4
 	PHY_V1_1_REV_A   PHYVersion = 5
 	PHY_V1_1_REV_B   PHYVersion = 6
+	PHY_V1_0_3_REV_A PHYVersion = 7
+	RP002_V1_0_0     PHYVersion = 8
+	RP002_V1_0_1     PHYVersion = 9
 )
 
 var PHYVersion_name = map[int32]string{
@@ -142,6 +151,9 @@ var PHYVersion_name = map[int32]string{
 	4: "PHY_V1_0_2_REV_B",
 	5: "PHY_V1_1_REV_A",
 	6: "PHY_V1_1_REV_B",
+	7: "PHY_V1_0_3_REV_A",
+	8: "RP002_V1_0_0",
+	9: "RP002_V1_0_1",
 }
 var PHYVersion_value = map[string]int32{
 	"PHY_UNKNOWN":      0,
@@ -151,6 +163,9 @@ var PHYVersion_value = map[string]int32{
 	"PHY_V1_0_2_REV_B": 4,
 	"PHY_V1_1_REV_A":   5,
 	"PHY_V1_1_REV_B":   6,
+	"PHY_V1_0_3_REV_A": 7,
+	"RP002_V1_0_0":     8,
+	"RP002_V1_0_1":     9,
 }
 
 func (PHYVersion) EnumDescriptor() ([]byte, []int) {
@@ -2018,9 +2033,12 @@ type TxSettings struct {
 	Timestamp uint32 `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	// Time of the gateway when the uplink message was received, or when the downlink message should be transmitted.
 	// For downlink, this requires the gateway to have GPS time synchronization.
-	Time                 *time.Time `protobuf:"bytes,11,opt,name=time,proto3,stdtime" json:"time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Time *time.Time `protobuf:"bytes,11,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+	// Index of the gateway antenna on which the downlink message must be transmitted.
+	// Set by Gateway Server, only on downlink.
+	AntennaIndex         uint32   `protobuf:"varint,12,opt,name=antenna_index,json=antennaIndex,proto3" json:"antenna_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *TxSettings) Reset()      { *m = TxSettings{} }
@@ -2132,6 +2150,13 @@ func (m *TxSettings) GetTime() *time.Time {
 	return nil
 }
 
+func (m *TxSettings) GetAntennaIndex() uint32 {
+	if m != nil {
+		return m.AntennaIndex
+	}
+	return 0
+}
+
 type GatewayAntennaIdentifiers struct {
 	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
 	AntennaIndex         uint32   `protobuf:"varint,2,opt,name=antenna_index,json=antennaIndex,proto3" json:"antenna_index,omitempty"`
@@ -6041,6 +6066,9 @@ func (this *TxSettings) Equal(that interface{}) bool {
 	} else if !this.Time.Equal(*that1.Time) {
 		return false
 	}
+	if this.AntennaIndex != that1.AntennaIndex {
+		return false
+	}
 	return true
 }
 func (this *GatewayAntennaIdentifiers) Equal(that interface{}) bool {
@@ -8553,6 +8581,11 @@ func (m *TxSettings) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n28
 	}
+	if m.AntennaIndex != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintLorawan(dAtA, i, uint64(m.AntennaIndex))
+	}
 	return i, nil
 }
 
@@ -11222,6 +11255,9 @@ func (m *TxSettings) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
 		n += 1 + l + sovLorawan(uint64(l))
 	}
+	if m.AntennaIndex != 0 {
+		n += 1 + sovLorawan(uint64(m.AntennaIndex))
+	}
 	return n
 }
 
@@ -12412,6 +12448,7 @@ func (this *TxSettings) String() string {
 		`EnableCRC:` + fmt.Sprintf("%v", this.EnableCRC) + `,`,
 		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
 		`Time:` + strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AntennaIndex:` + fmt.Sprintf("%v", this.AntennaIndex) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15378,6 +15415,25 @@ func (m *TxSettings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntennaIndex", wireType)
+			}
+			m.AntennaIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AntennaIndex |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
@@ -19838,330 +19894,330 @@ func init() {
 }
 
 var fileDescriptor_lorawan_76222860124625e1 = []byte{
-	// 5196 bytes of a gzipped FileDescriptorProto
+	// 5198 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7b, 0x4d, 0x6c, 0x23, 0xc9,
-	0x75, 0x3f, 0x5b, 0x24, 0x45, 0xea, 0x91, 0x14, 0x7b, 0x4a, 0x9a, 0x19, 0x0d, 0xbd, 0x4b, 0xad,
-	0x35, 0xfb, 0xc7, 0x7f, 0x3c, 0xf6, 0x68, 0x24, 0x4a, 0xa3, 0xd5, 0x7a, 0xfd, 0xc5, 0x2f, 0xad,
-	0xb8, 0x23, 0x91, 0x72, 0x53, 0x9a, 0xd9, 0x31, 0x6c, 0x74, 0x5a, 0xec, 0xa6, 0xc4, 0x11, 0xd9,
-	0xcd, 0x6d, 0xb6, 0x46, 0x52, 0x0e, 0xc1, 0x02, 0xb9, 0x2c, 0x90, 0x43, 0x8c, 0x00, 0x06, 0x62,
-	0xe4, 0x60, 0x23, 0xc9, 0xc1, 0x40, 0x10, 0xc4, 0x40, 0x10, 0x64, 0x8f, 0x0e, 0x90, 0x83, 0x91,
-	0xd3, 0x06, 0xb9, 0x18, 0x0e, 0x22, 0x7b, 0x38, 0x17, 0xe7, 0x92, 0xf8, 0xb8, 0xc7, 0xe0, 0x55,
-	0x55, 0xb3, 0xab, 0xbb, 0x39, 0x92, 0x66, 0x77, 0x7d, 0x52, 0xd7, 0xaf, 0x5f, 0xbd, 0x7a, 0xf5,
-	0x3e, 0xeb, 0x55, 0x53, 0x30, 0xdf, 0xb5, 0x6c, 0xed, 0x44, 0x33, 0xef, 0x0d, 0x1c, 0xad, 0x75,
-	0x74, 0x5f, 0xeb, 0x77, 0xee, 0x73, 0x64, 0xb1, 0x6f, 0x5b, 0x8e, 0x45, 0xa6, 0x1d, 0xc7, 0x5c,
-	0x74, 0xa1, 0x67, 0x2b, 0xb9, 0x7b, 0x07, 0x1d, 0xe7, 0xf0, 0x78, 0x7f, 0xb1, 0x65, 0xf5, 0xee,
-	0x1f, 0x58, 0x07, 0xd6, 0x7d, 0x4a, 0xb6, 0x7f, 0xdc, 0xa6, 0x23, 0x3a, 0xa0, 0x4f, 0x6c, 0x7a,
-	0x6e, 0x4d, 0x20, 0xef, 0x9d, 0x74, 0x9c, 0x23, 0xeb, 0xe4, 0xfe, 0x81, 0x75, 0x8f, 0xbe, 0xbc,
-	0xf7, 0x4c, 0xeb, 0x76, 0x74, 0xcd, 0xb1, 0xec, 0xc1, 0xfd, 0xd1, 0x23, 0x9f, 0xf7, 0xda, 0x81,
-	0x65, 0x1d, 0x74, 0x0d, 0x8f, 0xfb, 0xc0, 0xb1, 0x8f, 0x5b, 0x0e, 0x7f, 0x3b, 0x1f, 0x7c, 0xeb,
-	0x74, 0x7a, 0xc6, 0xc0, 0xd1, 0x7a, 0x7d, 0x4e, 0x70, 0x3b, 0xbc, 0xad, 0x8e, 0x6e, 0x98, 0x4e,
-	0xa7, 0xdd, 0x31, 0xec, 0x01, 0x23, 0x5a, 0xf8, 0xb7, 0x28, 0x24, 0xb6, 0x8d, 0xc1, 0x40, 0x3b,
-	0x30, 0xc8, 0x0a, 0xc4, 0x7b, 0xea, 0xa1, 0x6e, 0xcf, 0x49, 0x6f, 0x48, 0x77, 0x52, 0x85, 0xd9,
-	0x45, 0xff, 0xb6, 0x17, 0xb7, 0x37, 0x2b, 0x4a, 0x29, 0xf9, 0xcb, 0xf3, 0xf9, 0xc8, 0x27, 0xe7,
-	0xf3, 0x92, 0x12, 0xeb, 0x6d, 0xea, 0x36, 0xb9, 0x05, 0xd1, 0x5e, 0xa7, 0x35, 0x37, 0xf1, 0x86,
-	0x74, 0x27, 0x5d, 0x4a, 0x0c, 0xcf, 0xe7, 0xa3, 0xdb, 0xb5, 0xb2, 0x82, 0x18, 0xd9, 0x86, 0x54,
-	0x4f, 0x6b, 0xa9, 0x7d, 0xed, 0xac, 0x6b, 0x69, 0xfa, 0x5c, 0x94, 0x72, 0xcd, 0x85, 0xb8, 0x16,
-	0xcb, 0x3b, 0x8c, 0xa2, 0x34, 0x3d, 0x3c, 0x9f, 0x07, 0x6f, 0xbc, 0x19, 0x51, 0xa0, 0xa7, 0xb5,
-	0xf8, 0x88, 0x3c, 0x82, 0xd9, 0xa7, 0x56, 0xc7, 0x54, 0x6d, 0xe3, 0x83, 0x63, 0x63, 0xe0, 0x8c,
-	0xf8, 0xc6, 0x28, 0xdf, 0x85, 0x20, 0xdf, 0xf7, 0xac, 0x8e, 0xa9, 0x30, 0x52, 0x8f, 0x1f, 0x79,
-	0x1a, 0x42, 0x49, 0x13, 0x66, 0x28, 0x5f, 0xad, 0xd5, 0x32, 0xfa, 0x1e, 0xdb, 0x38, 0x65, 0xfb,
-	0xe5, 0x71, 0x6c, 0x8b, 0x94, 0xd2, 0xe3, 0x7a, 0xed, 0x69, 0x10, 0x24, 0xdf, 0x87, 0x1b, 0xb6,
-	0x31, 0x56, 0xdc, 0x49, 0xca, 0xf7, 0xcd, 0x20, 0x5f, 0xc5, 0x78, 0x3a, 0x4e, 0xe0, 0x59, 0x7b,
-	0x0c, 0xfe, 0xf5, 0xd8, 0xc7, 0x3f, 0x9d, 0x8f, 0x94, 0xa6, 0x20, 0xc1, 0x81, 0xf7, 0x62, 0xc9,
-	0x84, 0x9c, 0x5c, 0xd0, 0x20, 0x86, 0x36, 0x22, 0x5f, 0x83, 0xc9, 0x9e, 0xea, 0x9c, 0xf5, 0x0d,
-	0x6a, 0xc9, 0xe9, 0xc2, 0xf5, 0x90, 0xce, 0x77, 0xcf, 0xfa, 0x86, 0x12, 0xef, 0xe1, 0x1f, 0xf2,
-	0x55, 0x88, 0xf7, 0xb4, 0xa7, 0x96, 0x4d, 0x6d, 0x38, 0x8e, 0x18, 0x5f, 0x2a, 0x8c, 0x66, 0xe1,
-	0xd7, 0x12, 0x08, 0x16, 0x42, 0x97, 0x69, 0x5f, 0xe4, 0x32, 0x1b, 0x01, 0x97, 0x69, 0xa3, 0xcb,
-	0xe4, 0x61, 0xb2, 0xad, 0xf6, 0x2d, 0xdb, 0xa1, 0x2b, 0x66, 0x4a, 0x89, 0xe1, 0x6f, 0xe6, 0xa3,
-	0x73, 0x1f, 0x4e, 0x28, 0xf1, 0xf6, 0x8e, 0x65, 0x3b, 0xe4, 0x3e, 0xa4, 0xda, 0x76, 0xcf, 0xe7,
-	0x37, 0x69, 0xe6, 0x1b, 0x1b, 0xca, 0x36, 0x5f, 0x59, 0x81, 0xb6, 0xdd, 0x73, 0xa5, 0xf8, 0x0e,
-	0x64, 0x75, 0xa3, 0x65, 0xe9, 0x86, 0x1e, 0x70, 0x8a, 0x9b, 0x8b, 0x2c, 0x48, 0x16, 0xdd, 0x20,
-	0x59, 0x6c, 0xd2, 0x10, 0x52, 0xa6, 0x39, 0xbd, 0x4f, 0xa1, 0x0b, 0xff, 0x21, 0x41, 0x0c, 0x25,
-	0x26, 0x8f, 0x21, 0xa9, 0x1b, 0xcf, 0x54, 0x4d, 0xe7, 0x3b, 0x4b, 0x97, 0xbe, 0x81, 0x7b, 0xf8,
-	0xf5, 0xf9, 0xfc, 0xea, 0x81, 0xb5, 0xe8, 0x1c, 0x1a, 0xce, 0x61, 0xc7, 0x3c, 0x18, 0x2c, 0x9a,
-	0x86, 0x73, 0x62, 0xd9, 0x47, 0xf7, 0xfd, 0x91, 0xd6, 0x3f, 0x3a, 0xb8, 0x8f, 0xda, 0x1f, 0x2c,
-	0x56, 0x8c, 0x67, 0x45, 0x5d, 0xb7, 0x95, 0x84, 0xce, 0x1e, 0xc8, 0x1a, 0x6e, 0xbd, 0xe5, 0xd8,
-	0x5d, 0xba, 0xf5, 0x54, 0x58, 0xd9, 0x1b, 0x65, 0xc7, 0xee, 0x0a, 0x1a, 0x8b, 0xb7, 0x11, 0x20,
-	0xaf, 0xa3, 0x9e, 0x5b, 0xa6, 0x43, 0x95, 0x91, 0x29, 0x25, 0x87, 0xbf, 0x99, 0x8f, 0xcd, 0x7d,
-	0xf8, 0x61, 0x4c, 0x89, 0xb5, 0xcb, 0xa6, 0x43, 0xae, 0x23, 0x5b, 0xab, 0xef, 0x0c, 0xe8, 0xbe,
-	0xd3, 0x4a, 0xbc, 0xdd, 0xe8, 0x3b, 0x03, 0xbe, 0xab, 0x1f, 0x4b, 0x10, 0xa7, 0x6c, 0x31, 0x56,
-	0x35, 0xbe, 0xa3, 0x24, 0x8b, 0xd5, 0x62, 0x45, 0x51, 0x10, 0x23, 0xf7, 0x20, 0xa5, 0xe9, 0xb6,
-	0xaa, 0xb5, 0x8e, 0xd0, 0x61, 0xa9, 0x74, 0xc9, 0x52, 0x66, 0x78, 0x3e, 0x3f, 0x55, 0xac, 0x28,
-	0xc5, 0xd6, 0x91, 0x62, 0x7c, 0xa0, 0x4c, 0x69, 0xba, 0xcd, 0x1e, 0x89, 0x0c, 0x51, 0xad, 0x75,
-	0x44, 0xa5, 0x49, 0x2a, 0xf8, 0x48, 0xbe, 0x04, 0x53, 0x6d, 0xb5, 0x6f, 0x98, 0x7a, 0xc7, 0x3c,
-	0xa0, 0x52, 0x24, 0x95, 0x64, 0x7b, 0x87, 0x8d, 0xc9, 0x4d, 0x48, 0xb4, 0xba, 0xda, 0x60, 0xa0,
-	0xee, 0xd3, 0xb0, 0x4a, 0x2a, 0x93, 0x74, 0x58, 0x5a, 0xf8, 0xe7, 0x09, 0x20, 0xe1, 0x40, 0x25,
-	0x7f, 0x04, 0x49, 0x1a, 0x3b, 0xc6, 0x71, 0x87, 0xeb, 0xbf, 0xca, 0xf5, 0x5f, 0x78, 0x25, 0xfd,
-	0x57, 0xf7, 0x6a, 0x6b, 0xab, 0xc3, 0xf3, 0xf9, 0x04, 0xae, 0x51, 0xdd, 0xab, 0x29, 0x09, 0x64,
-	0x5b, 0x3d, 0xee, 0x90, 0x1f, 0x00, 0xda, 0x84, 0x2e, 0xc0, 0x52, 0x57, 0xe5, 0x73, 0x2d, 0x30,
-	0x59, 0x31, 0x9e, 0x21, 0xff, 0x49, 0xdd, 0x78, 0x86, 0xec, 0xbf, 0x07, 0x53, 0xc8, 0xde, 0xb4,
-	0xcc, 0x96, 0xc1, 0x1d, 0xf8, 0x9b, 0x7c, 0x81, 0x07, 0xaf, 0xea, 0x41, 0x75, 0x64, 0xa2, 0xa0,
-	0x43, 0xd2, 0x27, 0x6e, 0xd5, 0x1f, 0x45, 0x61, 0x76, 0x5c, 0xce, 0x20, 0xef, 0x40, 0x8a, 0x67,
-	0x1e, 0x21, 0x03, 0xe4, 0xc6, 0xa7, 0x1b, 0x9a, 0x06, 0xc0, 0x1e, 0x3d, 0x93, 0xef, 0xc1, 0xa4,
-	0x69, 0x38, 0x6a, 0x47, 0xe7, 0x5a, 0x29, 0x7f, 0x26, 0xad, 0xd4, 0x0d, 0xa7, 0x56, 0x19, 0x9e,
-	0xcf, 0xc7, 0xe9, 0x83, 0x12, 0x37, 0x0d, 0xa7, 0xe6, 0x37, 0x6a, 0xf4, 0x0f, 0x6d, 0xd4, 0xd8,
-	0x1f, 0xc0, 0xa8, 0xaf, 0x03, 0x57, 0x15, 0x8d, 0x44, 0x74, 0xe4, 0x8c, 0x32, 0xc5, 0x90, 0xb2,
-	0xe9, 0x70, 0xbb, 0xfc, 0x69, 0x0c, 0xae, 0x85, 0x6a, 0x04, 0x79, 0x0d, 0xa6, 0x0c, 0xb3, 0x65,
-	0x9f, 0xf5, 0x1d, 0x43, 0x67, 0x1e, 0xad, 0x78, 0x00, 0xf9, 0x01, 0x00, 0x65, 0xcb, 0xdc, 0x85,
-	0x69, 0xfe, 0x5b, 0x5c, 0xf4, 0xb5, 0x57, 0x12, 0x1d, 0x57, 0x66, 0xfe, 0x32, 0xf5, 0xd4, 0x7d,
-	0x14, 0x8c, 0x1a, 0xfd, 0xc2, 0x8d, 0x2a, 0x66, 0xca, 0xd8, 0x17, 0x99, 0x29, 0xab, 0x90, 0xd2,
-	0xbb, 0xea, 0xc0, 0x70, 0x1c, 0x9c, 0xcf, 0xab, 0x71, 0xc8, 0x8d, 0x2b, 0x5b, 0x4d, 0x4e, 0x21,
-	0xe4, 0x4c, 0xd0, 0xbb, 0x2e, 0x4a, 0x0a, 0x90, 0xb4, 0x4f, 0x55, 0xdd, 0xe8, 0x6a, 0x67, 0xb4,
-	0xf2, 0x4e, 0x17, 0x6e, 0x86, 0x42, 0xe1, 0xb4, 0x82, 0xaf, 0x95, 0x84, 0xcd, 0x1e, 0xc8, 0x3b,
-	0x90, 0x68, 0xb5, 0xd5, 0x6e, 0x67, 0xe0, 0xcc, 0x25, 0xe8, 0xb2, 0x37, 0x82, 0x53, 0xca, 0x1b,
-	0x5b, 0x9d, 0x81, 0x53, 0x02, 0x74, 0x12, 0xf6, 0xac, 0x4c, 0xb6, 0xda, 0xf8, 0x97, 0x7b, 0xc1,
-	0xdf, 0x4b, 0x00, 0x9e, 0x6c, 0x64, 0x05, 0x32, 0xf6, 0xe9, 0xb2, 0xaa, 0xdb, 0xaa, 0xd5, 0x6e,
-	0x0f, 0x0c, 0x87, 0xba, 0x40, 0xa6, 0x94, 0x1d, 0x9e, 0xcf, 0xa7, 0x94, 0xd3, 0xe5, 0x8a, 0xd2,
-	0xa0, 0xb0, 0x92, 0xb2, 0x4f, 0x97, 0x2b, 0x36, 0x1b, 0x90, 0x6f, 0xc3, 0xa4, 0x7d, 0x5a, 0x50,
-	0x75, 0xb7, 0x30, 0xbf, 0x1e, 0xda, 0xbc, 0xe6, 0x68, 0x8a, 0xe6, 0x18, 0x35, 0x53, 0x37, 0x4e,
-	0x4b, 0x53, 0x68, 0x1b, 0xe5, 0xb4, 0x50, 0x51, 0x94, 0xb8, 0x7d, 0x5a, 0xa8, 0xd8, 0xe4, 0x36,
-	0x24, 0xac, 0xbe, 0xa3, 0x9a, 0xc6, 0x01, 0x4b, 0xd4, 0x4c, 0xde, 0x46, 0xdf, 0xa9, 0x1b, 0x07,
-	0xca, 0xa4, 0x45, 0xff, 0x72, 0x79, 0x7b, 0xc0, 0xf7, 0x41, 0x16, 0x21, 0x76, 0x51, 0xde, 0x60,
-	0x54, 0x34, 0x6f, 0x50, 0x3a, 0x42, 0x20, 0xd6, 0x66, 0x15, 0x23, 0x7a, 0x27, 0xa3, 0xd0, 0x67,
-	0x72, 0x0b, 0x92, 0xad, 0x43, 0xb5, 0xa7, 0x0d, 0x8e, 0x06, 0x73, 0xd1, 0x37, 0xa2, 0x77, 0x92,
-	0x4a, 0xa2, 0x75, 0xb8, 0x8d, 0x43, 0xbe, 0xdc, 0x63, 0x48, 0x6f, 0x59, 0x8a, 0xe6, 0x6e, 0x00,
-	0xc3, 0x63, 0x5f, 0x33, 0xf5, 0x93, 0x8e, 0xee, 0x1c, 0x32, 0xdd, 0x28, 0x1e, 0x40, 0xbe, 0x02,
-	0xf2, 0xa0, 0x6f, 0x1b, 0x1a, 0x96, 0x12, 0xb5, 0xad, 0xb5, 0x1c, 0x7e, 0x56, 0xc9, 0x28, 0xd9,
-	0x11, 0xbe, 0x41, 0xe1, 0x85, 0x3b, 0x90, 0xda, 0x68, 0x3e, 0x1c, 0xf1, 0xbd, 0x05, 0xc9, 0xfd,
-	0x8e, 0xa3, 0xda, 0x9a, 0x63, 0x70, 0xb6, 0x89, 0xfd, 0x8e, 0x83, 0xaf, 0x16, 0x7e, 0x28, 0x41,
-	0x72, 0x44, 0xf7, 0x0d, 0x88, 0xe1, 0x1e, 0xf9, 0x29, 0xe6, 0xb5, 0xe0, 0xa6, 0x45, 0x59, 0x4b,
-	0xc9, 0xe1, 0xf9, 0x7c, 0x0c, 0x91, 0xcd, 0x88, 0x42, 0x67, 0x91, 0x75, 0x88, 0xb6, 0x07, 0x47,
-	0xbc, 0xa2, 0x7f, 0x29, 0x54, 0xd1, 0x3d, 0x79, 0x58, 0xcd, 0xdd, 0x68, 0x3e, 0xdc, 0x8c, 0x28,
-	0x38, 0xa5, 0x94, 0x06, 0xe8, 0x59, 0xfa, 0x71, 0x57, 0x73, 0x3a, 0x96, 0xb9, 0xf0, 0x57, 0x31,
-	0x80, 0xdd, 0xd3, 0x91, 0xd3, 0xbc, 0x03, 0x53, 0xba, 0xe6, 0x68, 0x9e, 0xf4, 0xa9, 0xc2, 0xdc,
-	0xcb, 0x5c, 0xa0, 0x14, 0x43, 0xef, 0x57, 0x92, 0xba, 0xbb, 0xa3, 0x2a, 0x64, 0x47, 0x93, 0xd5,
-	0x0e, 0x3a, 0xc8, 0x95, 0xbc, 0x48, 0xc9, 0xe8, 0xe2, 0x90, 0xcc, 0x43, 0xaa, 0x65, 0x51, 0xbd,
-	0x53, 0x29, 0xd0, 0x8d, 0xa6, 0x14, 0x60, 0x90, 0x6b, 0xb9, 0x36, 0x3d, 0xe1, 0x9a, 0xad, 0x33,
-	0x9a, 0x00, 0x62, 0x8a, 0x07, 0xa0, 0xfe, 0x9d, 0x53, 0xb5, 0x6f, 0x9d, 0x18, 0x36, 0x8d, 0xe0,
-	0xb8, 0x92, 0x70, 0x4e, 0x77, 0x70, 0x48, 0xee, 0xc3, 0x4c, 0xc7, 0x7c, 0x66, 0xd8, 0x8e, 0xda,
-	0xb7, 0xba, 0x9a, 0xdd, 0xf9, 0x63, 0xaa, 0x03, 0x1a, 0xa3, 0x49, 0x85, 0xb0, 0x57, 0x3b, 0xc2,
-	0x1b, 0xf2, 0x0e, 0x5c, 0x3f, 0xd0, 0x1c, 0xe3, 0x44, 0x3b, 0x53, 0x5b, 0x87, 0x9a, 0x69, 0x1a,
-	0x5d, 0xbe, 0xaf, 0x84, 0xff, 0x10, 0x39, 0xc3, 0xa9, 0xca, 0x8c, 0x88, 0xed, 0xe3, 0x6d, 0x98,
-	0xd5, 0x8d, 0x67, 0x9d, 0x96, 0x11, 0x98, 0x9b, 0xf4, 0xcf, 0x25, 0x8c, 0xc8, 0x37, 0xf5, 0x6b,
-	0x00, 0x86, 0xa9, 0xed, 0x77, 0x0d, 0xb5, 0x65, 0xb7, 0xe6, 0xa6, 0xbc, 0x83, 0x51, 0x95, 0xa2,
-	0x65, 0xa5, 0x8c, 0xa9, 0x9c, 0x3e, 0xda, 0x2d, 0xd4, 0xc7, 0xa8, 0x0f, 0x9b, 0x03, 0xe6, 0xc9,
-	0x23, 0x80, 0xac, 0x42, 0x0c, 0x07, 0x73, 0x29, 0x9e, 0xcd, 0x82, 0xa7, 0xd3, 0x5d, 0x97, 0xb2,
-	0x14, 0xfb, 0xe1, 0x6f, 0xf0, 0xbc, 0x8c, 0xd4, 0x3c, 0x66, 0xfe, 0x5c, 0x82, 0x5b, 0xef, 0xb2,
-	0xad, 0x15, 0x4d, 0xc7, 0x30, 0x4d, 0xad, 0xe6, 0x75, 0x73, 0xd8, 0x6b, 0xb9, 0xda, 0xe9, 0xe8,
-	0x03, 0xee, 0x2e, 0xa1, 0x9e, 0x88, 0xcf, 0x17, 0x26, 0x8a, 0x69, 0xf3, 0xc0, 0x7d, 0x3b, 0x20,
-	0xb7, 0x21, 0xa3, 0xb1, 0x45, 0x04, 0xe7, 0xc9, 0x28, 0x69, 0x0e, 0x52, 0xcd, 0x2c, 0xd8, 0x90,
-	0xda, 0xeb, 0x77, 0x3b, 0xe6, 0xd1, 0xae, 0x75, 0x64, 0x98, 0xa4, 0x0a, 0x51, 0x6f, 0xe9, 0xaf,
-	0xbc, 0x64, 0xe9, 0xb0, 0xe8, 0x82, 0x04, 0x38, 0xdf, 0xaf, 0xc1, 0x89, 0x80, 0x06, 0x17, 0xfe,
-	0x04, 0xd2, 0x15, 0xeb, 0xc4, 0xc4, 0x55, 0x77, 0x34, 0xe7, 0x90, 0xdc, 0x86, 0xf4, 0x31, 0x95,
-	0x41, 0x75, 0x50, 0x08, 0x56, 0x5b, 0x37, 0x23, 0x4a, 0xea, 0x58, 0x90, 0xac, 0x08, 0xf1, 0x76,
-	0xe7, 0xd4, 0xd0, 0x79, 0x88, 0x5e, 0x5d, 0xb6, 0xcd, 0x88, 0xc2, 0x66, 0x96, 0x26, 0x21, 0xd6,
-	0xd7, 0x9c, 0xc3, 0x85, 0xff, 0x8c, 0xc1, 0xd4, 0xee, 0x29, 0x3f, 0x72, 0x61, 0xeb, 0x44, 0x0f,
-	0xb2, 0x2f, 0xeb, 0xb3, 0xca, 0xf8, 0x52, 0x61, 0x34, 0xa4, 0x0c, 0xd3, 0x3a, 0x17, 0x5d, 0x45,
-	0x5e, 0x03, 0x9a, 0x33, 0xc7, 0xa4, 0x1b, 0x71, 0x83, 0x4a, 0x46, 0x17, 0x46, 0x03, 0xb2, 0x0a,
-	0x53, 0xb4, 0x92, 0xd0, 0x82, 0x16, 0xbd, 0xb8, 0xa0, 0x25, 0xb1, 0x9a, 0xd0, 0x8a, 0xb6, 0x05,
-	0x33, 0x74, 0x56, 0x20, 0x23, 0xc4, 0xae, 0x92, 0x11, 0x64, 0xe4, 0xe2, 0x4b, 0x0a, 0xb7, 0x59,
-	0x35, 0xf3, 0xe2, 0x3e, 0x4e, 0xe3, 0x3e, 0x6d, 0x9f, 0x2e, 0x6f, 0x8c, 0x42, 0x9f, 0x2e, 0x59,
-	0x08, 0x2d, 0x39, 0x79, 0xc5, 0x25, 0x0b, 0x63, 0x96, 0x2c, 0x08, 0x4b, 0x26, 0xdc, 0x25, 0x0b,
-	0xde, 0x92, 0xdf, 0x82, 0x64, 0xdf, 0xee, 0x58, 0x76, 0xc7, 0x39, 0xa3, 0x81, 0x3d, 0x1d, 0x0e,
-	0x80, 0xdd, 0xd3, 0x66, 0xeb, 0xd0, 0xd0, 0x8f, 0xbb, 0xc6, 0x0e, 0xa7, 0x54, 0x46, 0x73, 0x48,
-	0x15, 0x32, 0xda, 0xfe, 0xc0, 0xea, 0x1e, 0x3b, 0x86, 0x4a, 0xc3, 0x74, 0xea, 0x8a, 0x61, 0x9a,
-	0x76, 0xa7, 0xe1, 0x0b, 0xb2, 0x02, 0x49, 0x4d, 0x7f, 0xa6, 0x99, 0x2d, 0x43, 0x9f, 0x6b, 0x5d,
-	0xdc, 0x86, 0x8e, 0x08, 0x79, 0x8c, 0xff, 0xf7, 0x12, 0xed, 0xae, 0xcb, 0x56, 0xaf, 0xa7, 0x99,
-	0x3a, 0xf9, 0x36, 0x44, 0x5b, 0x1d, 0x9d, 0x3b, 0xd7, 0x9b, 0x63, 0x2e, 0x4e, 0x38, 0xa1, 0xe7,
-	0xb1, 0xac, 0xc2, 0x94, 0x6b, 0x15, 0x05, 0x67, 0x92, 0x2f, 0x43, 0xca, 0xd6, 0x4e, 0x46, 0x4d,
-	0xf1, 0x04, 0x0f, 0x0e, 0xb0, 0xb5, 0x13, 0xf7, 0x64, 0x5a, 0x82, 0x29, 0xdb, 0x18, 0xe0, 0xf1,
-	0xd0, 0x74, 0xaf, 0x68, 0x6e, 0xbf, 0x7c, 0xa5, 0x45, 0x05, 0x69, 0x6b, 0xa6, 0xbe, 0x19, 0x51,
-	0x92, 0x36, 0x7f, 0x26, 0x55, 0x3c, 0x18, 0x23, 0x8f, 0x96, 0x65, 0xb6, 0x79, 0xeb, 0xfd, 0xe6,
-	0x65, 0x4c, 0xca, 0x96, 0xd9, 0xde, 0x8c, 0x28, 0x6c, 0x75, 0x1c, 0x90, 0x06, 0x4c, 0xd3, 0xe0,
-	0x68, 0x1d, 0x1a, 0xad, 0x23, 0x55, 0x33, 0xdd, 0x53, 0xdf, 0xff, 0xbf, 0x80, 0xd5, 0x56, 0xc7,
-	0x3c, 0x2a, 0x23, 0x7d, 0xd1, 0xc4, 0x68, 0x4d, 0x77, 0x85, 0x31, 0x79, 0x02, 0x74, 0xac, 0x62,
-	0x67, 0x8b, 0x67, 0x14, 0x76, 0xf5, 0xf2, 0xff, 0x2e, 0x61, 0x87, 0x3d, 0xb1, 0xf1, 0x01, 0xbb,
-	0x70, 0xf0, 0xc6, 0xa8, 0x36, 0x64, 0x56, 0xd4, 0x6d, 0x6c, 0x80, 0x45, 0xd6, 0x28, 0x69, 0xe2,
-	0xaa, 0xac, 0x8b, 0xe6, 0xc0, 0xc7, 0x9a, 0xc9, 0xed, 0xb2, 0x46, 0xa9, 0x1b, 0x30, 0xad, 0x1f,
-	0x3b, 0x67, 0x6a, 0xeb, 0xac, 0xd5, 0x35, 0xa8, 0xdc, 0xc9, 0x4b, 0xd5, 0x50, 0x39, 0x76, 0xce,
-	0xca, 0x48, 0xcf, 0x24, 0x4d, 0xeb, 0xc2, 0x98, 0x3c, 0x01, 0x62, 0x9f, 0xaa, 0x7d, 0xcd, 0xd6,
-	0x7a, 0x78, 0xa0, 0x3e, 0xee, 0x53, 0xa6, 0xcc, 0xb9, 0xef, 0x5e, 0x64, 0xa6, 0xd3, 0x1d, 0x9c,
-	0xd3, 0xc4, 0x29, 0x8c, 0x6f, 0xd6, 0xf6, 0x43, 0x63, 0x58, 0xa3, 0x32, 0xe0, 0x95, 0x58, 0x33,
-	0x0d, 0xf8, 0x58, 0xbb, 0x6a, 0x30, 0x9e, 0xa9, 0x03, 0x47, 0x73, 0x8e, 0x07, 0x94, 0x6d, 0xea,
-	0x72, 0x35, 0x18, 0xcf, 0x9a, 0x94, 0x9e, 0x7b, 0x83, 0x2e, 0x8c, 0x89, 0x02, 0x59, 0xd3, 0x38,
-	0x19, 0x1d, 0x00, 0x50, 0x07, 0x69, 0xca, 0xf1, 0xce, 0x05, 0x1c, 0xeb, 0xc6, 0x09, 0x3f, 0x0d,
-	0x30, 0x0d, 0x64, 0x4c, 0x11, 0x08, 0xf2, 0x44, 0x29, 0x33, 0xaf, 0xc0, 0x93, 0x89, 0x29, 0xf0,
-	0x44, 0x39, 0x35, 0x98, 0xd6, 0xbb, 0x3e, 0x31, 0xa7, 0x2f, 0xdf, 0xf8, 0x96, 0x27, 0x54, 0x49,
-	0x1e, 0x9e, 0xcf, 0xa7, 0x45, 0x84, 0xaa, 0xa2, 0x2b, 0x88, 0xed, 0x5f, 0x02, 0xa5, 0xce, 0x5e,
-	0x7d, 0x09, 0xf4, 0x60, 0xff, 0x12, 0xae, 0xb6, 0xbb, 0xc2, 0x2e, 0xbe, 0x8f, 0xf9, 0x1f, 0xd3,
-	0x28, 0x1e, 0x1e, 0x3d, 0xaf, 0x93, 0xe9, 0x3a, 0x5f, 0xbd, 0xd0, 0x35, 0x76, 0xe9, 0x24, 0xc1,
-	0xed, 0x64, 0x3b, 0x80, 0xa1, 0xdf, 0x39, 0x61, 0x97, 0xbe, 0x76, 0xa9, 0xdf, 0xed, 0x86, 0x5d,
-	0xda, 0x09, 0xb8, 0x34, 0x4d, 0x88, 0x47, 0xc6, 0x19, 0x4d, 0x88, 0xe4, 0x0a, 0x09, 0xf1, 0xc8,
-	0x38, 0x1b, 0x25, 0x44, 0xf6, 0xcc, 0x12, 0x22, 0xf2, 0xa0, 0x09, 0x71, 0xe6, 0x0a, 0x09, 0xf1,
-	0xc8, 0x38, 0xf3, 0x12, 0x22, 0x1f, 0x10, 0x1b, 0x66, 0x30, 0xbf, 0x04, 0xb7, 0x39, 0x7b, 0xa9,
-	0x0e, 0x8b, 0x15, 0xc5, 0xb7, 0xa9, 0xd2, 0xec, 0xf0, 0x7c, 0x5e, 0x0e, 0xa2, 0xa8, 0x59, 0x4d,
-	0xb7, 0xfd, 0xdb, 0x57, 0x20, 0xcb, 0x4f, 0xca, 0x58, 0x02, 0xa9, 0x6f, 0x5c, 0xbf, 0xd4, 0xa3,
-	0x2b, 0x74, 0x06, 0x56, 0x3f, 0xee, 0xd1, 0xba, 0x08, 0x90, 0x3d, 0x90, 0xdb, 0x96, 0xdd, 0xc2,
-	0x64, 0xe6, 0x5e, 0x89, 0xcf, 0xdd, 0x18, 0x7f, 0x14, 0x13, 0x98, 0x6e, 0xe0, 0x94, 0xd1, 0x15,
-	0xd7, 0x66, 0x44, 0x99, 0x6e, 0xfb, 0x10, 0x62, 0x8c, 0xee, 0xd8, 0x83, 0x1a, 0xba, 0x49, 0x99,
-	0x2f, 0x5e, 0xa8, 0x71, 0x9c, 0x18, 0x54, 0xc7, 0x8c, 0x1d, 0x86, 0x5f, 0xb2, 0x0c, 0x2a, 0x66,
-	0xee, 0x95, 0x97, 0x61, 0xea, 0x09, 0x2d, 0xc3, 0x8a, 0x15, 0xe9, 0xd3, 0x58, 0xe9, 0x5a, 0x58,
-	0x8c, 0xdb, 0x16, 0xdd, 0xc9, 0xad, 0x4b, 0x5d, 0x7a, 0x07, 0xe3, 0xa2, 0x6b, 0x39, 0x35, 0xb3,
-	0x6d, 0x71, 0x97, 0xee, 0xfb, 0x21, 0xb2, 0x0f, 0xd7, 0x3d, 0xd6, 0x62, 0x62, 0xc9, 0x51, 0xee,
-	0xf7, 0xae, 0xc0, 0xdd, 0x97, 0x4c, 0x48, 0x3f, 0x84, 0x8e, 0x5f, 0x03, 0x95, 0xf4, 0xa5, 0x57,
-	0x5d, 0x83, 0xe9, 0x28, 0xb8, 0x06, 0xaa, 0xe8, 0x7d, 0xb8, 0xb6, 0x6f, 0x68, 0x2d, 0xcb, 0x74,
-	0xf3, 0x0a, 0xf2, 0x7f, 0xed, 0x52, 0x0d, 0x95, 0xe8, 0x1c, 0x96, 0x41, 0x78, 0xb1, 0xd9, 0xf7,
-	0x43, 0xe8, 0xf5, 0x9c, 0x33, 0x1e, 0x31, 0xa9, 0x6e, 0x5e, 0xbf, 0xd4, 0xeb, 0x19, 0x5f, 0x3c,
-	0x7f, 0xf2, 0xda, 0xb0, 0x2f, 0x02, 0x41, 0x9e, 0x28, 0x6b, 0xfe, 0x15, 0x78, 0xf2, 0x48, 0xda,
-	0x17, 0x01, 0x21, 0x3a, 0x7b, 0x96, 0x4e, 0xcf, 0xd4, 0x73, 0xf3, 0x57, 0x8c, 0xce, 0x6d, 0x4b,
-	0x37, 0x58, 0x9e, 0xe2, 0xd1, 0xc9, 0x01, 0x8c, 0x4e, 0x91, 0x27, 0x4d, 0x59, 0x6f, 0x5c, 0x1a,
-	0x9d, 0x1e, 0x53, 0x9e, 0xb7, 0xa6, 0x75, 0x1f, 0x92, 0xdb, 0x80, 0xa4, 0x7b, 0x58, 0x24, 0x5f,
-	0x87, 0x4c, 0xaf, 0x63, 0x5a, 0xb6, 0xfa, 0xcc, 0xb0, 0x07, 0xd8, 0xe6, 0xbf, 0xec, 0xbb, 0x14,
-	0x12, 0x29, 0x69, 0x4a, 0xfb, 0x88, 0x91, 0xe6, 0xde, 0x85, 0xa9, 0xd1, 0x79, 0xf1, 0x73, 0x31,
-	0x7a, 0x08, 0x69, 0xf1, 0xb4, 0x48, 0x6e, 0xc0, 0x64, 0x4f, 0xb3, 0x0f, 0x3a, 0x26, 0xbf, 0x1a,
-	0xe2, 0x23, 0xec, 0x35, 0x46, 0x17, 0x0d, 0xd6, 0xb1, 0xe9, 0xb8, 0xbd, 0xaf, 0x7b, 0xaf, 0x80,
-	0x58, 0xee, 0x7f, 0x25, 0x10, 0x0e, 0x87, 0xe3, 0xae, 0x5b, 0xa4, 0xcf, 0x70, 0xdd, 0xf2, 0x26,
-	0x4c, 0xbb, 0xf7, 0x25, 0xfe, 0xbe, 0x9b, 0xdf, 0x9a, 0x30, 0xaa, 0x2f, 0x43, 0xda, 0x0d, 0xb0,
-	0x9e, 0x36, 0x38, 0xe2, 0x57, 0x6c, 0x29, 0x8e, 0x6d, 0x6b, 0x83, 0x23, 0xb2, 0x04, 0xb3, 0x22,
-	0x09, 0x1a, 0xd5, 0xb1, 0xad, 0x2e, 0xbf, 0xb4, 0x26, 0x02, 0x69, 0x99, 0xbd, 0x21, 0xb7, 0x20,
-	0x69, 0xee, 0xab, 0x8e, 0x8d, 0x6e, 0x3a, 0xc9, 0xae, 0xca, 0xcc, 0xfd, 0x5d, 0x1c, 0xbe, 0x17,
-	0x4b, 0xc6, 0xe4, 0x78, 0xee, 0x2f, 0xbc, 0x1d, 0xa3, 0xf6, 0xee, 0x80, 0xec, 0x5b, 0x41, 0x6b,
-	0x1d, 0xb1, 0x0f, 0x4b, 0xca, 0xb4, 0xc0, 0xbd, 0xd8, 0x3a, 0x22, 0xf7, 0x60, 0x26, 0xa0, 0x1b,
-	0x4a, 0x4c, 0x3f, 0x31, 0x29, 0xb2, 0x4f, 0x01, 0x48, 0xfe, 0x55, 0x56, 0xda, 0x3d, 0x1d, 0xa8,
-	0xde, 0x97, 0xa6, 0xac, 0xa8, 0x87, 0x62, 0xeb, 0x28, 0xf7, 0x04, 0xd2, 0xe2, 0xd1, 0x97, 0xd4,
-	0x60, 0xba, 0xa7, 0x9d, 0xaa, 0xde, 0xf9, 0x99, 0x9b, 0x21, 0x54, 0xc1, 0x8b, 0x07, 0x07, 0xb6,
-	0x81, 0x16, 0xd5, 0xbd, 0xf9, 0xe9, 0x9e, 0x76, 0x3a, 0x1a, 0xe5, 0xfe, 0x49, 0x82, 0x6c, 0xe0,
-	0x04, 0xfc, 0xb2, 0xa6, 0x56, 0xfa, 0x6c, 0x4d, 0xed, 0x7d, 0x98, 0xf5, 0x77, 0xe5, 0xfc, 0x72,
-	0x98, 0xd9, 0xfc, 0x9a, 0xd0, 0x77, 0xf3, 0x1b, 0xe1, 0x50, 0x17, 0x1c, 0x0d, 0x77, 0xc1, 0xb9,
-	0xbf, 0x0b, 0xc8, 0x8d, 0xc6, 0x5a, 0x85, 0x9b, 0x63, 0xe4, 0x16, 0x6c, 0x36, 0x13, 0x14, 0x0e,
-	0x2d, 0xb1, 0x06, 0x73, 0xe3, 0xe4, 0x13, 0xac, 0x37, 0x1b, 0x92, 0x11, 0xe7, 0xdd, 0x85, 0x6b,
-	0x3e, 0x31, 0x45, 0x03, 0x8a, 0xa2, 0xa2, 0x01, 0xbf, 0x03, 0x69, 0xf1, 0xd0, 0x4e, 0xe6, 0x20,
-	0xb1, 0xaf, 0x39, 0x8e, 0x61, 0x9f, 0x8d, 0x2e, 0x6c, 0xd9, 0x50, 0x08, 0xd7, 0x09, 0x7a, 0x93,
-	0xc8, 0x47, 0xb9, 0xff, 0x91, 0x20, 0xe3, 0x3b, 0xa5, 0xa3, 0x9a, 0xfc, 0xb7, 0x7c, 0x8c, 0x93,
-	0x1b, 0x34, 0x4c, 0xf9, 0xbe, 0x8b, 0xcb, 0x89, 0xe0, 0xc5, 0xe5, 0x16, 0xcc, 0xf4, 0x3a, 0x66,
-	0xc8, 0xd0, 0xd1, 0x2b, 0x19, 0xba, 0xd7, 0x31, 0xfd, 0x86, 0x46, 0x6e, 0xe8, 0x95, 0x9f, 0xe9,
-	0xfa, 0x05, 0x9d, 0x52, 0x44, 0x72, 0xef, 0x8b, 0xfb, 0x45, 0x9d, 0xdd, 0x86, 0x8c, 0x5f, 0xd7,
-	0xcc, 0xa6, 0xe9, 0xb6, 0xa0, 0x68, 0xb2, 0x00, 0x19, 0x6f, 0x7d, 0xcf, 0x82, 0x29, 0x37, 0xfe,
-	0xd0, 0x18, 0xdf, 0x05, 0x5f, 0xdb, 0xf0, 0x05, 0x28, 0x32, 0xa7, 0x82, 0xaf, 0x4d, 0x40, 0xdf,
-	0xf0, 0xb1, 0x14, 0xe4, 0xcd, 0x8a, 0x6c, 0x51, 0xe4, 0xd0, 0xbe, 0x26, 0xc2, 0xfb, 0xca, 0x15,
-	0x41, 0x0e, 0x76, 0x0c, 0xe4, 0x1e, 0xc4, 0xd9, 0x05, 0x99, 0x74, 0xf1, 0x05, 0x19, 0xa3, 0xca,
-	0xfd, 0x8b, 0x04, 0xd9, 0x40, 0x63, 0x40, 0x14, 0x96, 0x48, 0x8c, 0x8e, 0xdd, 0xf7, 0x05, 0x79,
-	0xf8, 0x0b, 0x14, 0xad, 0x7a, 0xd5, 0x9a, 0xb2, 0xc3, 0x9a, 0xa2, 0x6d, 0xed, 0x14, 0x07, 0xcc,
-	0x78, 0x98, 0x51, 0xaa, 0x1d, 0xbb, 0xcf, 0x34, 0x75, 0x17, 0xae, 0xf1, 0xbb, 0x4a, 0xfd, 0xc4,
-	0xe8, 0x76, 0xd9, 0x1d, 0x13, 0xdb, 0x53, 0x96, 0xbd, 0xa8, 0x20, 0x4e, 0x2f, 0x91, 0x16, 0x61,
-	0x66, 0x74, 0x59, 0x28, 0x50, 0xb3, 0x28, 0xba, 0xe6, 0xbe, 0x1a, 0xd1, 0xb3, 0x6a, 0xcb, 0xbb,
-	0x8f, 0xcf, 0x5d, 0x6d, 0xdd, 0xfe, 0xe3, 0xf3, 0x30, 0xfa, 0x54, 0x82, 0x50, 0xc3, 0x41, 0x3e,
-	0x80, 0x1b, 0xee, 0xaf, 0x0c, 0xba, 0x9d, 0x5e, 0xc7, 0x51, 0x8d, 0xd3, 0xbe, 0x65, 0x1a, 0xa6,
-	0xf3, 0xd2, 0x34, 0x4d, 0x7f, 0x7c, 0xb0, 0x85, 0xb4, 0x55, 0x4e, 0x5a, 0xba, 0x39, 0x3c, 0x9f,
-	0x9f, 0x19, 0xf3, 0x42, 0x99, 0x61, 0xbf, 0x4f, 0xf0, 0x81, 0xe2, 0x92, 0xd4, 0xda, 0xde, 0x92,
-	0x13, 0x17, 0x2d, 0x49, 0x1d, 0x64, 0xdc, 0x92, 0xbe, 0x17, 0xee, 0x92, 0x3e, 0x30, 0x57, 0x83,
-	0x8c, 0xaf, 0x21, 0x22, 0xeb, 0xfc, 0xda, 0x3f, 0x71, 0xe9, 0x7d, 0x22, 0xbd, 0x0b, 0xf7, 0xae,
-	0xfe, 0x73, 0xbf, 0x93, 0x60, 0xda, 0xdf, 0x07, 0x91, 0xf9, 0xf0, 0xf7, 0xfd, 0x8c, 0xef, 0x1b,
-	0xfe, 0x17, 0xf7, 0xe9, 0x07, 0x23, 0xc0, 0x36, 0x1c, 0xbb, 0x63, 0x0c, 0xd8, 0x0f, 0x4f, 0x14,
-	0xe8, 0x69, 0xa7, 0x0a, 0x43, 0xc8, 0x36, 0x64, 0xfb, 0x86, 0xdd, 0xb1, 0x74, 0x4f, 0xa5, 0xb1,
-	0xf1, 0x37, 0x95, 0xbc, 0x0b, 0xa2, 0xc4, 0x23, 0xd5, 0x4d, 0xf7, 0x7d, 0xe3, 0xdc, 0xc7, 0x12,
-	0xcc, 0x8c, 0xe9, 0xca, 0xc8, 0x77, 0x81, 0xa0, 0x1c, 0xf4, 0x28, 0x76, 0xa9, 0xbf, 0x30, 0x06,
-	0xf4, 0x88, 0x36, 0x5a, 0x08, 0x33, 0xa8, 0x0f, 0x21, 0x75, 0xb8, 0x86, 0x2c, 0x69, 0x83, 0x1b,
-	0x70, 0x87, 0x85, 0x97, 0xfc, 0x50, 0xa2, 0xd3, 0x33, 0x46, 0x0c, 0xb3, 0x3d, 0xed, 0x54, 0x04,
-	0x72, 0x9b, 0x61, 0xc9, 0xd1, 0xec, 0xcb, 0x70, 0x3d, 0xb4, 0x8c, 0x90, 0xef, 0x48, 0x80, 0x0d,
-	0x66, 0xb3, 0x1a, 0x64, 0x03, 0xfd, 0x1c, 0x59, 0x83, 0x49, 0xa6, 0x29, 0xbe, 0xe7, 0x7c, 0x50,
-	0x42, 0x77, 0x02, 0xd3, 0xaf, 0xc2, 0xa9, 0x73, 0x67, 0x40, 0xc2, 0xcd, 0x9b, 0x3f, 0x5b, 0x4b,
-	0xc1, 0xb2, 0xf7, 0xc5, 0xb8, 0x4e, 0xee, 0x30, 0xb4, 0xf4, 0x95, 0xcb, 0xd4, 0xab, 0x1d, 0x16,
-	0x73, 0x5b, 0x90, 0x0d, 0x74, 0x77, 0x64, 0x56, 0x4c, 0xfe, 0x19, 0x9e, 0xe3, 0xc3, 0xa5, 0x6c,
-	0x22, 0x5c, 0xca, 0x72, 0xf7, 0x20, 0xe3, 0xeb, 0xe9, 0x2e, 0xd6, 0x56, 0x6e, 0x55, 0x24, 0xbf,
-	0xea, 0x0e, 0x73, 0xdf, 0x70, 0xb3, 0x83, 0xdb, 0x7f, 0xbd, 0xca, 0x47, 0xa4, 0xdc, 0x37, 0x61,
-	0xda, 0xdf, 0x79, 0xbd, 0xd2, 0xf4, 0xd2, 0x14, 0x24, 0xf8, 0xc7, 0x80, 0xbb, 0x3f, 0x91, 0x20,
-	0x4e, 0x7f, 0x07, 0x48, 0x64, 0x48, 0xbf, 0xd7, 0xa8, 0xd5, 0x55, 0xa5, 0xfa, 0xdd, 0xbd, 0x6a,
-	0x73, 0x57, 0x8e, 0x90, 0x2c, 0xa4, 0x28, 0x52, 0x2c, 0x97, 0xab, 0x3b, 0xbb, 0xb2, 0x44, 0x08,
-	0x4c, 0xef, 0xd5, 0xcb, 0x8d, 0xfa, 0x46, 0x4d, 0xd9, 0xae, 0x56, 0xd4, 0xbd, 0x1d, 0x79, 0x82,
-	0xcc, 0x82, 0x2c, 0x62, 0x95, 0xc6, 0xe3, 0xba, 0x1c, 0x45, 0x66, 0x3e, 0xba, 0x18, 0xce, 0x0d,
-	0x50, 0xc5, 0x11, 0x53, 0xaa, 0xbe, 0x45, 0x27, 0x71, 0xd1, 0x1d, 0xa5, 0xb1, 0xa3, 0xd4, 0xaa,
-	0xbb, 0x45, 0xe5, 0x89, 0x9c, 0xb8, 0x7b, 0x13, 0xe2, 0xf4, 0xb7, 0x87, 0x64, 0x1a, 0x60, 0xab,
-	0xa1, 0x14, 0x1f, 0x17, 0xeb, 0xaa, 0xb2, 0x2c, 0x47, 0xee, 0xb6, 0xe8, 0x57, 0x12, 0x5e, 0x69,
-	0x70, 0xde, 0x76, 0xb1, 0xac, 0xee, 0xd5, 0x1f, 0xd6, 0x91, 0x79, 0x84, 0xa4, 0x21, 0x89, 0xc0,
-	0xa3, 0x65, 0x75, 0x49, 0x96, 0x70, 0xb2, 0x3b, 0x52, 0x97, 0xe5, 0x09, 0xdf, 0xb8, 0x20, 0x47,
-	0x05, 0xea, 0x65, 0x39, 0x96, 0x4b, 0x7e, 0xf4, 0x37, 0xf9, 0xc8, 0xcf, 0xff, 0x36, 0x1f, 0xb9,
-	0xfb, 0x63, 0x09, 0x60, 0x67, 0xf3, 0x89, 0xb0, 0xca, 0xce, 0xe6, 0x13, 0xff, 0x2a, 0x08, 0x78,
-	0xab, 0xb8, 0x23, 0xba, 0xca, 0x2c, 0xc8, 0xa3, 0x71, 0x41, 0x55, 0xaa, 0x8f, 0xd4, 0xa2, 0x1c,
-	0x1d, 0x83, 0x96, 0x98, 0x82, 0x38, 0xba, 0xcc, 0x29, 0xe3, 0x21, 0xac, 0x24, 0x4f, 0x0a, 0xb2,
-	0xfd, 0xc3, 0x04, 0x64, 0xfc, 0x47, 0xcc, 0x2c, 0xa4, 0x2a, 0xc5, 0xdd, 0xa2, 0xaa, 0x14, 0x77,
-	0xab, 0xea, 0x12, 0x33, 0xa1, 0x07, 0x2c, 0xcb, 0x92, 0x1f, 0x28, 0xc8, 0x13, 0x7e, 0x60, 0x45,
-	0x8e, 0xfa, 0x81, 0x55, 0x39, 0xe6, 0x07, 0x1e, 0xc8, 0x71, 0x3f, 0xb0, 0xc6, 0x6c, 0xe6, 0x01,
-	0x6f, 0xc9, 0x09, 0x3f, 0xb0, 0x2e, 0x27, 0xfd, 0xc0, 0xdb, 0xf2, 0x14, 0x3a, 0x88, 0x20, 0xd8,
-	0x92, 0x0c, 0x01, 0x64, 0x59, 0x4e, 0x05, 0x90, 0x82, 0x9c, 0x0e, 0x20, 0x2b, 0x72, 0x26, 0x80,
-	0xac, 0xca, 0xd3, 0x01, 0xe4, 0x81, 0x9c, 0x15, 0x34, 0xb6, 0x04, 0xe0, 0xfd, 0xe4, 0x8d, 0xa4,
-	0x20, 0x51, 0x6e, 0xd4, 0x77, 0xab, 0xef, 0xa3, 0xb3, 0xa7, 0x20, 0xd1, 0xac, 0x36, 0x9b, 0xb5,
-	0x46, 0x5d, 0x96, 0x48, 0x12, 0x62, 0x0f, 0xab, 0x4f, 0x9a, 0xf2, 0x04, 0xce, 0xf0, 0x7e, 0xec,
-	0x82, 0xdb, 0xd8, 0xa0, 0xae, 0x5a, 0x2f, 0xd7, 0xaa, 0x4d, 0x39, 0x42, 0xae, 0x41, 0xa6, 0xbc,
-	0x59, 0xac, 0xd7, 0xab, 0x5b, 0xea, 0x76, 0xb1, 0xf9, 0xb0, 0x29, 0x4b, 0x77, 0x57, 0x21, 0x4e,
-	0x83, 0x8d, 0xb2, 0xdf, 0x2a, 0x36, 0x9b, 0x6a, 0x91, 0xb1, 0x67, 0x83, 0x92, 0x2c, 0x79, 0x83,
-	0xb2, 0x3c, 0x91, 0x8b, 0xa1, 0x74, 0x77, 0xfb, 0x40, 0xc2, 0x5f, 0x25, 0x09, 0xc0, 0xe4, 0x56,
-	0xe3, 0x31, 0x8b, 0xc6, 0x04, 0x44, 0xb7, 0x1a, 0x8f, 0x65, 0x09, 0x37, 0x58, 0xaa, 0x6e, 0x35,
-	0x1e, 0xab, 0xf5, 0x86, 0xb2, 0x5d, 0xdc, 0x92, 0x27, 0x90, 0x8c, 0x3f, 0xd3, 0xc8, 0x2b, 0x96,
-	0x1a, 0x8f, 0xaa, 0xee, 0xdb, 0x18, 0x6e, 0x66, 0xb3, 0xf6, 0xee, 0xa6, 0x1c, 0xc7, 0x75, 0xf1,
-	0x89, 0x06, 0xda, 0xdd, 0xff, 0x8a, 0xc2, 0xec, 0xb8, 0x8f, 0x87, 0x24, 0x03, 0x53, 0xe5, 0x5a,
-	0x45, 0x55, 0x36, 0xf6, 0xa8, 0x0b, 0xb9, 0xc3, 0x6a, 0xb3, 0xca, 0x73, 0x00, 0x0e, 0xb7, 0x6a,
-	0xf5, 0x87, 0x6a, 0x79, 0xb3, 0x5a, 0x7e, 0x28, 0x4f, 0xd0, 0x68, 0x77, 0xb1, 0x62, 0x45, 0x91,
-	0xa3, 0x2e, 0x55, 0x65, 0x6f, 0xf7, 0x89, 0x5a, 0x7e, 0x52, 0xde, 0xaa, 0xca, 0x31, 0x72, 0x03,
-	0x08, 0x65, 0xf4, 0xbe, 0xba, 0x53, 0x54, 0x8a, 0xdb, 0x6a, 0xb3, 0xba, 0xbb, 0xb7, 0xc3, 0x9c,
-	0x9c, 0xd2, 0x56, 0x1f, 0xa9, 0xcd, 0xdd, 0xe2, 0xee, 0x5e, 0x53, 0x9e, 0x24, 0x33, 0x90, 0x45,
-	0xac, 0x5e, 0x7d, 0xac, 0x72, 0xfd, 0xca, 0x09, 0x72, 0x13, 0x66, 0x38, 0x83, 0xdd, 0xda, 0x76,
-	0xad, 0xfe, 0x2e, 0xe7, 0x90, 0x74, 0x39, 0xef, 0xfa, 0x39, 0x4f, 0x8d, 0x38, 0x6f, 0x8d, 0x98,
-	0x80, 0xb7, 0x9d, 0x87, 0xd5, 0x27, 0x72, 0xca, 0xe5, 0x59, 0xac, 0x28, 0xbe, 0xb9, 0x69, 0x57,
-	0x82, 0x4a, 0xf5, 0x51, 0xad, 0x5c, 0xc5, 0x05, 0xab, 0x72, 0x06, 0x23, 0x17, 0xc1, 0x8d, 0x86,
-	0x52, 0xae, 0xaa, 0x2c, 0x75, 0xc9, 0xd3, 0x24, 0x07, 0x37, 0x18, 0x4b, 0x9a, 0xca, 0x44, 0x36,
-	0x59, 0x57, 0xb4, 0x1d, 0x2a, 0xee, 0x56, 0x63, 0x57, 0xad, 0xd5, 0x37, 0x1a, 0xb2, 0x4c, 0x6e,
-	0xc1, 0x75, 0x3f, 0xee, 0x4a, 0x78, 0x8d, 0x5c, 0x87, 0x6b, 0xf8, 0xaa, 0x54, 0x2d, 0x96, 0x1b,
-	0x75, 0xbe, 0x55, 0x99, 0xb8, 0x02, 0x71, 0x18, 0xdd, 0x50, 0x9e, 0x09, 0x48, 0xb9, 0xdd, 0xa8,
-	0x54, 0xe5, 0x37, 0xb8, 0x47, 0xfd, 0x6a, 0x02, 0x66, 0xc6, 0xdc, 0x6f, 0xd0, 0xf8, 0x18, 0x99,
-	0x45, 0x5d, 0x96, 0x23, 0x01, 0xa4, 0xc0, 0x5c, 0x4c, 0x40, 0x56, 0x99, 0x89, 0x05, 0x64, 0x5d,
-	0x8e, 0xa2, 0xeb, 0x8b, 0x7c, 0xd6, 0xe4, 0x58, 0x00, 0x5a, 0x29, 0xc8, 0xf1, 0x00, 0xb4, 0xb6,
-	0x2a, 0x4f, 0xa2, 0x55, 0xc4, 0x89, 0x85, 0x75, 0x39, 0x11, 0xc0, 0x0a, 0x0f, 0xd6, 0xe4, 0x64,
-	0x00, 0x7b, 0xb0, 0x5c, 0x90, 0xa7, 0x70, 0xbf, 0xe2, 0xdc, 0xa5, 0xc2, 0xaa, 0x0c, 0x01, 0xb0,
-	0xb0, 0xb4, 0xba, 0x2e, 0xa7, 0x02, 0xe0, 0xea, 0xd2, 0xdb, 0x6b, 0xcc, 0xa8, 0xe2, 0x2e, 0x96,
-	0xdf, 0x2e, 0x30, 0xa3, 0xfa, 0x36, 0xb2, 0xb2, 0x8e, 0x69, 0xc4, 0x8f, 0xae, 0x14, 0xde, 0x5a,
-	0x5b, 0x97, 0xb3, 0x5c, 0xb5, 0xff, 0x28, 0xc1, 0xb4, 0xff, 0xbc, 0x85, 0xfb, 0xa4, 0xb6, 0xac,
-	0x3e, 0xaa, 0x2a, 0x4f, 0xd4, 0x65, 0x9e, 0x1b, 0x04, 0xa8, 0xd0, 0x94, 0xa5, 0x00, 0xb4, 0xda,
-	0x94, 0x27, 0x02, 0xd0, 0x7a, 0x93, 0x05, 0x8f, 0xc8, 0x6b, 0xad, 0xc9, 0xab, 0x83, 0x87, 0xad,
-	0x14, 0x9a, 0xbc, 0x3a, 0x78, 0xd8, 0xda, 0x2a, 0x0f, 0x1c, 0x71, 0x6e, 0x61, 0xbd, 0x29, 0x27,
-	0xb8, 0xd4, 0x7f, 0x16, 0x75, 0x0f, 0xa8, 0xfe, 0x73, 0xf0, 0x0c, 0x64, 0xb9, 0xeb, 0x96, 0x1b,
-	0x7b, 0xf5, 0x5d, 0x34, 0x65, 0x24, 0x04, 0xae, 0xa0, 0x5b, 0x04, 0xc1, 0xb5, 0x55, 0x56, 0xe3,
-	0xfc, 0xd3, 0x0b, 0xeb, 0xac, 0xc6, 0xf9, 0x50, 0x34, 0x69, 0x2c, 0x84, 0xa2, 0x51, 0xe3, 0xe8,
-	0xf0, 0x7e, 0x0e, 0x68, 0xd6, 0xc9, 0x10, 0x4c, 0x0d, 0x9b, 0x08, 0xc1, 0xd4, 0xb4, 0xc9, 0x10,
-	0x4c, 0x8d, 0x3b, 0x85, 0xf1, 0x17, 0xd8, 0x1c, 0x9a, 0x17, 0x42, 0x38, 0x33, 0x70, 0x2a, 0x84,
-	0xaf, 0x3d, 0x78, 0xb0, 0x82, 0x9e, 0x73, 0x13, 0x66, 0xfc, 0x7c, 0x56, 0x96, 0x97, 0xde, 0x42,
-	0xef, 0x09, 0xbe, 0x28, 0xac, 0x15, 0x96, 0x57, 0xd1, 0x81, 0x82, 0x2f, 0x1e, 0x14, 0x56, 0x0b,
-	0xeb, 0x9e, 0x0f, 0x7d, 0x32, 0x01, 0x24, 0xdc, 0x55, 0xa0, 0x3b, 0xf0, 0x59, 0x98, 0x72, 0x68,
-	0x02, 0x0e, 0x40, 0xcb, 0xcc, 0x8f, 0x44, 0xa8, 0xc0, 0xfc, 0x48, 0x84, 0x56, 0x58, 0x84, 0x8a,
-	0xd0, 0x2a, 0x8b, 0x50, 0x11, 0x7a, 0xc0, 0x22, 0x54, 0x84, 0xb0, 0x9e, 0x07, 0x20, 0xac, 0xe8,
-	0x01, 0x08, 0x6b, 0x7a, 0x00, 0x7a, 0x9b, 0x25, 0x5c, 0x9f, 0xa8, 0x58, 0xd7, 0x83, 0x18, 0x56,
-	0xf6, 0x20, 0x86, 0xb5, 0x3d, 0x88, 0x61, 0x75, 0x0f, 0x62, 0xa8, 0xd7, 0x20, 0xf6, 0x60, 0xa4,
-	0xd2, 0x7f, 0x95, 0xdc, 0x1f, 0xc3, 0xfb, 0x9b, 0x4c, 0xc1, 0x6f, 0x77, 0xaa, 0x4a, 0xad, 0x51,
-	0xa1, 0x6a, 0x0d, 0x81, 0xcb, 0x3e, 0x0f, 0xe7, 0x20, 0xaa, 0x36, 0x04, 0xa2, 0x72, 0x43, 0x20,
-	0xaa, 0x37, 0x04, 0xa2, 0x82, 0x43, 0xe0, 0x1a, 0x8b, 0x53, 0x3f, 0xf8, 0xd6, 0x28, 0x4e, 0xff,
-	0x7d, 0x02, 0xc0, 0xbb, 0x4f, 0xa2, 0x19, 0x94, 0xa5, 0x77, 0x1c, 0xaa, 0xeb, 0x72, 0x84, 0x66,
-	0x46, 0x01, 0x5a, 0x5e, 0x62, 0x75, 0xd9, 0x87, 0xa1, 0xe0, 0x41, 0x6c, 0x85, 0x25, 0x17, 0x1f,
-	0xb6, 0xca, 0x92, 0x8b, 0x0f, 0x5b, 0x63, 0xc9, 0xc5, 0x87, 0xad, 0xf3, 0xcc, 0x2d, 0x60, 0x85,
-	0x25, 0x9e, 0xb9, 0x45, 0x6c, 0x99, 0x67, 0x6e, 0x11, 0x5b, 0x65, 0xae, 0xe1, 0xc3, 0xd6, 0x98,
-	0x6b, 0xf8, 0xb0, 0xb7, 0x98, 0x6b, 0xf8, 0xb0, 0xb7, 0x99, 0x6b, 0x88, 0xd8, 0xca, 0x12, 0x73,
-	0x0d, 0x1f, 0xb6, 0xc2, 0x5c, 0xc3, 0x87, 0xad, 0x8d, 0x5c, 0xe3, 0xa3, 0x28, 0x8c, 0xbb, 0x2c,
-	0x42, 0x33, 0x60, 0xe9, 0x2f, 0x96, 0x1f, 0xaa, 0x5b, 0xb5, 0xed, 0xda, 0x2e, 0xad, 0x87, 0x21,
-	0x90, 0xe7, 0x3e, 0x3f, 0xb8, 0xca, 0x3c, 0xc3, 0x0f, 0xf2, 0xd4, 0x17, 0xe0, 0xc9, 0x53, 0x9f,
-	0x1f, 0xa5, 0xe5, 0x31, 0x84, 0xae, 0xf1, 0xcc, 0x17, 0xe0, 0x50, 0xe0, 0x99, 0x2f, 0x20, 0xd7,
-	0x03, 0x9e, 0xf9, 0xfc, 0x30, 0x2b, 0x95, 0x37, 0x80, 0x04, 0x98, 0xb0, 0x6a, 0x19, 0xc2, 0x79,
-	0xc1, 0x0c, 0xe1, 0xbc, 0x66, 0x86, 0x70, 0x5e, 0x36, 0x6f, 0x52, 0x8d, 0xfa, 0xb6, 0xc9, 0x2a,
-	0x67, 0xe8, 0x85, 0xbf, 0x78, 0x7a, 0xa6, 0xf0, 0xdd, 0x97, 0x89, 0xba, 0xac, 0x54, 0xb7, 0x8a,
-	0x4f, 0x82, 0xa6, 0x60, 0x60, 0xc0, 0x14, 0x0c, 0x0c, 0x98, 0x82, 0x81, 0x01, 0x53, 0x70, 0x9e,
-	0x01, 0x53, 0x30, 0x34, 0x68, 0x0a, 0x86, 0x06, 0x4d, 0xc1, 0x39, 0x04, 0x4d, 0xc1, 0xe5, 0x0a,
-	0x9a, 0x82, 0xc1, 0x21, 0x53, 0x70, 0x26, 0x21, 0x53, 0x70, 0x2e, 0x21, 0x53, 0xf0, 0x0d, 0x86,
-	0x4c, 0xc1, 0xf7, 0x18, 0x32, 0x85, 0xbb, 0xcd, 0x90, 0x29, 0xdc, 0x9d, 0x8a, 0xa6, 0xf8, 0xd1,
-	0x04, 0x24, 0xf8, 0x2d, 0x38, 0xb6, 0xae, 0xca, 0xfb, 0x9c, 0x0a, 0xd3, 0xa3, 0x38, 0x5e, 0x66,
-	0xad, 0xed, 0x68, 0x5c, 0x60, 0x0d, 0xf4, 0x68, 0x8c, 0x79, 0x45, 0x1c, 0x63, 0x4e, 0x11, 0xc7,
-	0x98, 0x05, 0xc5, 0x31, 0x26, 0x40, 0x71, 0x8c, 0x05, 0x46, 0x1c, 0x63, 0x75, 0x11, 0xc7, 0x58,
-	0x5a, 0xb2, 0x90, 0xf2, 0xe4, 0xc1, 0xba, 0xe2, 0x03, 0xb0, 0xa8, 0xf8, 0x00, 0xac, 0x28, 0x3e,
-	0x00, 0xcb, 0x89, 0x0f, 0x40, 0xfd, 0xf8, 0x00, 0xaf, 0x90, 0xfc, 0x64, 0x02, 0xe2, 0xf4, 0x36,
-	0x9b, 0xde, 0x2a, 0xd4, 0xea, 0x0d, 0x65, 0xd4, 0x0d, 0xa5, 0x20, 0xc1, 0x00, 0xde, 0x4c, 0x7b,
-	0x6f, 0x79, 0x33, 0xed, 0x01, 0xbc, 0x99, 0xf6, 0x00, 0xde, 0x4c, 0x7b, 0x00, 0x6f, 0xa6, 0x3d,
-	0x80, 0x37, 0xd3, 0x1e, 0xc0, 0x9b, 0x69, 0x0f, 0xe0, 0xcd, 0xb4, 0x07, 0xf0, 0x66, 0xda, 0x03,
-	0xdc, 0x66, 0x5a, 0x40, 0x78, 0x33, 0x2d, 0x20, 0xbc, 0x99, 0x16, 0x10, 0xde, 0x4c, 0x0b, 0x08,
-	0x6f, 0xa6, 0x05, 0x64, 0xa4, 0xa1, 0xd2, 0x5f, 0x4b, 0xbf, 0x7c, 0x9e, 0x97, 0x3e, 0x79, 0x9e,
-	0x97, 0x7e, 0xf5, 0x3c, 0x1f, 0xf9, 0xed, 0xf3, 0x7c, 0xe4, 0x77, 0xcf, 0xf3, 0x91, 0xdf, 0x3f,
-	0xcf, 0x47, 0x3e, 0x7d, 0x9e, 0x97, 0x3e, 0x1c, 0xe6, 0xa5, 0x8f, 0x86, 0xf9, 0xc8, 0xcf, 0x86,
-	0x79, 0xe9, 0xe7, 0xc3, 0x7c, 0xe4, 0xe3, 0x61, 0x3e, 0xf2, 0x8b, 0x61, 0x3e, 0xf2, 0xcb, 0x61,
-	0x5e, 0xfa, 0x64, 0x98, 0x97, 0x7e, 0x35, 0xcc, 0x47, 0x7e, 0x3b, 0xcc, 0x4b, 0xbf, 0x1b, 0xe6,
-	0x23, 0xbf, 0x1f, 0xe6, 0xa5, 0x4f, 0x87, 0xf9, 0xc8, 0x87, 0x2f, 0xf2, 0x91, 0x8f, 0x5e, 0xe4,
-	0xa5, 0x1f, 0xbe, 0xc8, 0x47, 0xfe, 0xf2, 0x45, 0x5e, 0xfa, 0xe9, 0x8b, 0x7c, 0xe4, 0x67, 0x2f,
-	0xf2, 0x91, 0x9f, 0xbf, 0xc8, 0x4b, 0x1f, 0xbf, 0xc8, 0x4b, 0xbf, 0x78, 0x91, 0x97, 0xbe, 0xf7,
-	0xb5, 0xab, 0xfe, 0x37, 0x91, 0x63, 0xf6, 0xf7, 0xf7, 0x27, 0xe9, 0xd5, 0xfa, 0xca, 0xff, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x9a, 0x13, 0x20, 0x07, 0xda, 0x3d, 0x00, 0x00,
+	0x75, 0x3f, 0x9b, 0x1f, 0x22, 0xf5, 0x48, 0x4a, 0x35, 0x25, 0xcd, 0x8c, 0x96, 0xde, 0xa5, 0xd6,
+	0xda, 0xfd, 0xe3, 0x3f, 0x1e, 0x7b, 0x34, 0x12, 0xc5, 0xd1, 0x6a, 0xbd, 0xfe, 0xe2, 0x97, 0x56,
+	0xdc, 0x91, 0x48, 0xb9, 0xa9, 0x99, 0xd9, 0x31, 0x6c, 0x74, 0x5a, 0xec, 0xa6, 0xc4, 0x15, 0xd9,
+	0xcd, 0x6d, 0xb6, 0x46, 0x54, 0x0e, 0xc1, 0x02, 0xbe, 0x2c, 0x90, 0x43, 0x8c, 0x00, 0x06, 0x92,
+	0x93, 0x8d, 0x24, 0x07, 0x03, 0x41, 0x10, 0x03, 0x41, 0x90, 0x3d, 0x3a, 0x40, 0x0e, 0x46, 0x4e,
+	0x1b, 0xf8, 0x62, 0x38, 0x88, 0xec, 0xe1, 0x5c, 0x9c, 0x4b, 0xe2, 0xa3, 0x8f, 0xc1, 0xab, 0xaa,
+	0x66, 0x7f, 0x71, 0x46, 0x1a, 0xef, 0xfa, 0xa4, 0xae, 0x5f, 0xbf, 0x7a, 0xf5, 0xea, 0x7d, 0xd6,
+	0xab, 0xa6, 0x60, 0xb9, 0x67, 0x5a, 0xea, 0x99, 0x6a, 0xdc, 0x19, 0xda, 0x6a, 0xfb, 0xe4, 0xae,
+	0x3a, 0xe8, 0xde, 0x15, 0xc8, 0xea, 0xc0, 0x32, 0x6d, 0x93, 0xce, 0xd9, 0xb6, 0xb1, 0xea, 0x40,
+	0x4f, 0x36, 0x72, 0x77, 0x8e, 0xba, 0xf6, 0xf1, 0xe9, 0xe1, 0x6a, 0xdb, 0xec, 0xdf, 0x3d, 0x32,
+	0x8f, 0xcc, 0xbb, 0x8c, 0xec, 0xf0, 0xb4, 0xc3, 0x46, 0x6c, 0xc0, 0x9e, 0xf8, 0xf4, 0xdc, 0xa6,
+	0x87, 0xbc, 0x7f, 0xd6, 0xb5, 0x4f, 0xcc, 0xb3, 0xbb, 0x47, 0xe6, 0x1d, 0xf6, 0xf2, 0xce, 0x13,
+	0xb5, 0xd7, 0xd5, 0x54, 0xdb, 0xb4, 0x86, 0x77, 0x27, 0x8f, 0x62, 0xde, 0xab, 0x47, 0xa6, 0x79,
+	0xd4, 0xd3, 0x5d, 0xee, 0x43, 0xdb, 0x3a, 0x6d, 0xdb, 0xe2, 0xed, 0x72, 0xf0, 0xad, 0xdd, 0xed,
+	0xeb, 0x43, 0x5b, 0xed, 0x0f, 0x04, 0xc1, 0x1b, 0xe1, 0x6d, 0x75, 0x35, 0xdd, 0xb0, 0xbb, 0x9d,
+	0xae, 0x6e, 0x0d, 0x39, 0xd1, 0xca, 0xbf, 0xc7, 0x20, 0xb9, 0xa7, 0x0f, 0x87, 0xea, 0x91, 0x4e,
+	0x37, 0x20, 0xd1, 0x57, 0x8e, 0x35, 0x6b, 0x49, 0x7a, 0x5d, 0xba, 0x95, 0x2e, 0x2c, 0xae, 0xfa,
+	0xb7, 0xbd, 0xba, 0xb7, 0x53, 0x95, 0xcb, 0xa9, 0x9f, 0x5f, 0x2c, 0x47, 0x3e, 0xbd, 0x58, 0x96,
+	0xe4, 0x78, 0x7f, 0x47, 0xb3, 0xe8, 0x2b, 0x10, 0xeb, 0x77, 0xdb, 0x4b, 0xd1, 0xd7, 0xa5, 0x5b,
+	0x99, 0x72, 0x72, 0x7c, 0xb1, 0x1c, 0xdb, 0xab, 0x57, 0x64, 0xc4, 0xe8, 0x1e, 0xa4, 0xfb, 0x6a,
+	0x5b, 0x19, 0xa8, 0xe7, 0x3d, 0x53, 0xd5, 0x96, 0x62, 0x8c, 0x6b, 0x2e, 0xc4, 0xb5, 0x54, 0xd9,
+	0xe7, 0x14, 0xe5, 0xb9, 0xf1, 0xc5, 0x32, 0xb8, 0xe3, 0x9d, 0x88, 0x0c, 0x7d, 0xb5, 0x2d, 0x46,
+	0xf4, 0x21, 0x2c, 0x7e, 0x60, 0x76, 0x0d, 0xc5, 0xd2, 0x3f, 0x3c, 0xd5, 0x87, 0xf6, 0x84, 0x6f,
+	0x9c, 0xf1, 0x5d, 0x09, 0xf2, 0x7d, 0xcf, 0xec, 0x1a, 0x32, 0x27, 0x75, 0xf9, 0xd1, 0x0f, 0x42,
+	0x28, 0x6d, 0xc1, 0x02, 0xe3, 0xab, 0xb6, 0xdb, 0xfa, 0xc0, 0x65, 0x9b, 0x60, 0x6c, 0xbf, 0x38,
+	0x8d, 0x6d, 0x89, 0x51, 0xba, 0x5c, 0xaf, 0x7d, 0x10, 0x04, 0xe9, 0x77, 0xe1, 0x86, 0xa5, 0x4f,
+	0x15, 0x77, 0x86, 0xf1, 0x7d, 0x33, 0xc8, 0x57, 0xd6, 0x3f, 0x98, 0x26, 0xf0, 0xa2, 0x35, 0x05,
+	0xff, 0x6a, 0xfc, 0x93, 0x1f, 0x2f, 0x47, 0xca, 0xb3, 0x90, 0x14, 0xc0, 0x7b, 0xf1, 0x54, 0x92,
+	0xa4, 0x56, 0x54, 0x88, 0xa3, 0x8d, 0xe8, 0x57, 0x60, 0xa6, 0xaf, 0xd8, 0xe7, 0x03, 0x9d, 0x59,
+	0x72, 0xae, 0x70, 0x3d, 0xa4, 0xf3, 0x83, 0xf3, 0x81, 0x2e, 0x27, 0xfa, 0xf8, 0x87, 0x7e, 0x19,
+	0x12, 0x7d, 0xf5, 0x03, 0xd3, 0x5a, 0x8a, 0x3e, 0x87, 0x18, 0x5f, 0xca, 0x9c, 0x66, 0xe5, 0x57,
+	0x12, 0x78, 0x2c, 0x84, 0x2e, 0xd3, 0x79, 0x91, 0xcb, 0x6c, 0x07, 0x5c, 0xa6, 0x83, 0x2e, 0x93,
+	0x87, 0x99, 0x8e, 0x32, 0x30, 0x2d, 0x9b, 0xad, 0x98, 0x2d, 0x27, 0xc7, 0xbf, 0x5e, 0x8e, 0x2d,
+	0x7d, 0x14, 0x95, 0x13, 0x9d, 0x7d, 0xd3, 0xb2, 0xe9, 0x5d, 0x48, 0x77, 0xac, 0xbe, 0xcf, 0x6f,
+	0x32, 0xdc, 0x37, 0xb6, 0xe5, 0x3d, 0xb1, 0xb2, 0x0c, 0x1d, 0xab, 0xef, 0x48, 0xf1, 0x2d, 0x98,
+	0xd7, 0xf4, 0xb6, 0xa9, 0xe9, 0x5a, 0xc0, 0x29, 0x6e, 0xae, 0xf2, 0x20, 0x59, 0x75, 0x82, 0x64,
+	0xb5, 0xc5, 0x42, 0x48, 0x9e, 0x13, 0xf4, 0x3e, 0x85, 0xae, 0xfc, 0x42, 0x82, 0x38, 0x4a, 0x4c,
+	0x1f, 0x41, 0x4a, 0xd3, 0x9f, 0x28, 0xaa, 0x26, 0x76, 0x96, 0x29, 0x7f, 0x0d, 0xf7, 0xf0, 0xab,
+	0x8b, 0xe5, 0xe2, 0x91, 0xb9, 0x6a, 0x1f, 0xeb, 0xf6, 0x71, 0xd7, 0x38, 0x1a, 0xae, 0x1a, 0xba,
+	0x7d, 0x66, 0x5a, 0x27, 0x77, 0xfd, 0x91, 0x36, 0x38, 0x39, 0xba, 0x8b, 0xda, 0x1f, 0xae, 0x56,
+	0xf5, 0x27, 0x25, 0x4d, 0xb3, 0xe4, 0xa4, 0xc6, 0x1f, 0xe8, 0x26, 0x6e, 0xbd, 0x6d, 0x5b, 0x3d,
+	0xb6, 0xf5, 0x74, 0x58, 0xd9, 0xdb, 0x15, 0xdb, 0xea, 0x79, 0x34, 0x96, 0xe8, 0x20, 0x40, 0x5f,
+	0x43, 0x3d, 0xb7, 0x0d, 0x9b, 0x29, 0x23, 0x5b, 0x4e, 0x8d, 0x7f, 0xbd, 0x1c, 0x5f, 0xfa, 0xe8,
+	0xa3, 0xb8, 0x1c, 0xef, 0x54, 0x0c, 0x9b, 0x5e, 0x47, 0xb6, 0xe6, 0xc0, 0x1e, 0xb2, 0x7d, 0x67,
+	0xe4, 0x44, 0xa7, 0x39, 0xb0, 0x87, 0x62, 0x57, 0x7f, 0x2d, 0x41, 0x82, 0xb1, 0xc5, 0x58, 0x55,
+	0xc5, 0x8e, 0x52, 0x3c, 0x56, 0x4b, 0x55, 0x59, 0x46, 0x8c, 0xde, 0x81, 0xb4, 0xaa, 0x59, 0x8a,
+	0xda, 0x3e, 0x41, 0x87, 0x65, 0xd2, 0xa5, 0xca, 0xd9, 0xf1, 0xc5, 0xf2, 0x6c, 0xa9, 0x2a, 0x97,
+	0xda, 0x27, 0xb2, 0xfe, 0xa1, 0x3c, 0xab, 0x6a, 0x16, 0x7f, 0xa4, 0x04, 0x62, 0x6a, 0xfb, 0x84,
+	0x49, 0x93, 0x92, 0xf1, 0x91, 0x7e, 0x01, 0x66, 0x3b, 0xca, 0x40, 0x37, 0xb4, 0xae, 0x71, 0xc4,
+	0xa4, 0x48, 0xc9, 0xa9, 0xce, 0x3e, 0x1f, 0xd3, 0x9b, 0x90, 0x6c, 0xf7, 0xd4, 0xe1, 0x50, 0x39,
+	0x64, 0x61, 0x95, 0x92, 0x67, 0xd8, 0xb0, 0xbc, 0xf2, 0x2f, 0x51, 0xa0, 0xe1, 0x40, 0xa5, 0x7f,
+	0x02, 0x29, 0x16, 0x3b, 0xfa, 0x69, 0x57, 0xe8, 0xbf, 0x26, 0xf4, 0x5f, 0x78, 0x29, 0xfd, 0xd7,
+	0x1e, 0xd4, 0x37, 0x8b, 0xe3, 0x8b, 0xe5, 0x24, 0xae, 0x51, 0x7b, 0x50, 0x97, 0x93, 0xc8, 0xb6,
+	0x76, 0xda, 0xa5, 0xdf, 0x03, 0xb4, 0x09, 0x5b, 0x80, 0xa7, 0xae, 0xea, 0x67, 0x5a, 0x60, 0xa6,
+	0xaa, 0x3f, 0x41, 0xfe, 0x33, 0x9a, 0xfe, 0x04, 0xd9, 0x7f, 0x07, 0x66, 0x91, 0xbd, 0x61, 0x1a,
+	0x6d, 0x5d, 0x38, 0xf0, 0xd7, 0xc5, 0x02, 0xf7, 0x5e, 0xd6, 0x83, 0x1a, 0xc8, 0x44, 0x4e, 0x69,
+	0xe2, 0x49, 0x58, 0xf5, 0x87, 0x31, 0x58, 0x9c, 0x96, 0x33, 0xe8, 0x3b, 0x90, 0x16, 0x99, 0xc7,
+	0x93, 0x01, 0x72, 0xd3, 0xd3, 0x0d, 0x4b, 0x03, 0x60, 0x4d, 0x9e, 0xe9, 0x77, 0x60, 0xc6, 0xd0,
+	0x6d, 0xa5, 0xab, 0x09, 0xad, 0x54, 0xfe, 0x20, 0xad, 0x34, 0x74, 0xbb, 0x5e, 0x1d, 0x5f, 0x2c,
+	0x27, 0xd8, 0x83, 0x9c, 0x30, 0x74, 0xbb, 0xee, 0x37, 0x6a, 0xec, 0x8f, 0x6d, 0xd4, 0xf8, 0x1f,
+	0xc1, 0xa8, 0xaf, 0x81, 0x50, 0x15, 0x8b, 0x44, 0x74, 0xe4, 0xac, 0x3c, 0xcb, 0x91, 0x8a, 0x61,
+	0x0b, 0xbb, 0x7c, 0x3f, 0x0e, 0xd7, 0x42, 0x35, 0x82, 0xbe, 0x0a, 0xb3, 0xba, 0xd1, 0xb6, 0xce,
+	0x07, 0xb6, 0xae, 0x71, 0x8f, 0x96, 0x5d, 0x80, 0x7e, 0x0f, 0x80, 0xb1, 0xe5, 0xee, 0xc2, 0x35,
+	0xff, 0x0d, 0x21, 0xfa, 0xe6, 0x4b, 0x89, 0x8e, 0x2b, 0x73, 0x7f, 0x99, 0xfd, 0xc0, 0x79, 0xf4,
+	0x18, 0x35, 0xf6, 0xb9, 0x1b, 0xd5, 0x9b, 0x29, 0xe3, 0x9f, 0x67, 0xa6, 0xac, 0x41, 0x5a, 0xeb,
+	0x29, 0x43, 0xdd, 0xb6, 0x71, 0xbe, 0xa8, 0xc6, 0x21, 0x37, 0xae, 0xee, 0xb6, 0x04, 0x85, 0x27,
+	0x67, 0x82, 0xd6, 0x73, 0x50, 0x5a, 0x80, 0x94, 0x35, 0x52, 0x34, 0xbd, 0xa7, 0x9e, 0xb3, 0xca,
+	0x3b, 0x57, 0xb8, 0x19, 0x0a, 0x85, 0x51, 0x15, 0x5f, 0xcb, 0x49, 0x8b, 0x3f, 0xd0, 0x77, 0x20,
+	0xd9, 0xee, 0x28, 0xbd, 0xee, 0xd0, 0x5e, 0x4a, 0xb2, 0x65, 0x6f, 0x04, 0xa7, 0x54, 0xb6, 0x77,
+	0xbb, 0x43, 0xbb, 0x0c, 0xe8, 0x24, 0xfc, 0x59, 0x9e, 0x69, 0x77, 0xf0, 0xaf, 0xf0, 0x82, 0x7f,
+	0x90, 0x00, 0x5c, 0xd9, 0xe8, 0x06, 0x64, 0xad, 0xd1, 0xba, 0xa2, 0x59, 0x8a, 0xd9, 0xe9, 0x0c,
+	0x75, 0x9b, 0xb9, 0x40, 0xb6, 0x3c, 0x3f, 0xbe, 0x58, 0x4e, 0xcb, 0xa3, 0xf5, 0xaa, 0xdc, 0x64,
+	0xb0, 0x9c, 0xb6, 0x46, 0xeb, 0x55, 0x8b, 0x0f, 0xe8, 0x37, 0x61, 0xc6, 0x1a, 0x15, 0x14, 0xcd,
+	0x29, 0xcc, 0xaf, 0x85, 0x36, 0xaf, 0xda, 0xaa, 0xac, 0xda, 0x7a, 0xdd, 0xd0, 0xf4, 0x51, 0x79,
+	0x16, 0x6d, 0x23, 0x8f, 0x0a, 0x55, 0x59, 0x4e, 0x58, 0xa3, 0x42, 0xd5, 0xa2, 0x6f, 0x40, 0xd2,
+	0x1c, 0xd8, 0x8a, 0xa1, 0x1f, 0xf1, 0x44, 0xcd, 0xe5, 0x6d, 0x0e, 0xec, 0x86, 0x7e, 0x24, 0xcf,
+	0x98, 0xec, 0xaf, 0x90, 0xb7, 0x0f, 0x62, 0x1f, 0x74, 0x15, 0xe2, 0x2f, 0xca, 0x1b, 0x9c, 0x8a,
+	0xe5, 0x0d, 0x46, 0x47, 0x29, 0xc4, 0x3b, 0xbc, 0x62, 0xc4, 0x6e, 0x65, 0x65, 0xf6, 0x4c, 0x5f,
+	0x81, 0x54, 0xfb, 0x58, 0xe9, 0xab, 0xc3, 0x93, 0xe1, 0x52, 0xec, 0xf5, 0xd8, 0xad, 0x94, 0x9c,
+	0x6c, 0x1f, 0xef, 0xe1, 0x50, 0x2c, 0xf7, 0x08, 0x32, 0xbb, 0xa6, 0xac, 0x3a, 0x1b, 0xc0, 0xf0,
+	0x38, 0x54, 0x0d, 0xed, 0xac, 0xab, 0xd9, 0xc7, 0x5c, 0x37, 0xb2, 0x0b, 0xd0, 0x2f, 0x01, 0x19,
+	0x0e, 0x2c, 0x5d, 0xc5, 0x52, 0xa2, 0x74, 0xd4, 0xb6, 0x2d, 0xce, 0x2a, 0x59, 0x79, 0x7e, 0x82,
+	0x6f, 0x33, 0x78, 0xe5, 0x16, 0xa4, 0xb7, 0x5b, 0xf7, 0x27, 0x7c, 0x5f, 0x81, 0xd4, 0x61, 0xd7,
+	0x56, 0x2c, 0xd5, 0xd6, 0x05, 0xdb, 0xe4, 0x61, 0xd7, 0xc6, 0x57, 0x2b, 0x3f, 0x90, 0x20, 0x35,
+	0xa1, 0xfb, 0x1a, 0xc4, 0x71, 0x8f, 0xe2, 0x14, 0xf3, 0x6a, 0x70, 0xd3, 0x5e, 0x59, 0xcb, 0xa9,
+	0xf1, 0xc5, 0x72, 0x1c, 0x91, 0x9d, 0x88, 0xcc, 0x66, 0xd1, 0x2d, 0x88, 0x75, 0x86, 0x27, 0xa2,
+	0xa2, 0x7f, 0x21, 0x54, 0xd1, 0x5d, 0x79, 0x78, 0xcd, 0xdd, 0x6e, 0xdd, 0xdf, 0x89, 0xc8, 0x38,
+	0xa5, 0x9c, 0x01, 0xe8, 0x9b, 0xda, 0x69, 0x4f, 0xb5, 0xbb, 0xa6, 0xb1, 0xf2, 0xb3, 0x38, 0xc0,
+	0xc1, 0x68, 0xe2, 0x34, 0xef, 0xc0, 0xac, 0xa6, 0xda, 0xaa, 0x2b, 0x7d, 0xba, 0xb0, 0xf4, 0x3c,
+	0x17, 0x28, 0xc7, 0xd1, 0xfb, 0xe5, 0x94, 0xe6, 0xec, 0xa8, 0x06, 0xf3, 0x93, 0xc9, 0x4a, 0x17,
+	0x1d, 0xe4, 0x4a, 0x5e, 0x24, 0x67, 0x35, 0xef, 0x90, 0x2e, 0x43, 0xba, 0x6d, 0x32, 0xbd, 0x33,
+	0x29, 0xd0, 0x8d, 0x66, 0x65, 0xe0, 0x90, 0x63, 0xb9, 0x0e, 0x3b, 0xe1, 0x1a, 0xed, 0x73, 0x96,
+	0x00, 0xe2, 0xb2, 0x0b, 0xa0, 0xfe, 0xed, 0x91, 0x32, 0x30, 0xcf, 0x74, 0x8b, 0x45, 0x70, 0x42,
+	0x4e, 0xda, 0xa3, 0x7d, 0x1c, 0xd2, 0xbb, 0xb0, 0xd0, 0x35, 0x9e, 0xe8, 0x96, 0xad, 0x0c, 0xcc,
+	0x9e, 0x6a, 0x75, 0xff, 0x94, 0xe9, 0x80, 0xc5, 0x68, 0x4a, 0xa6, 0xfc, 0xd5, 0xbe, 0xe7, 0x0d,
+	0x7d, 0x07, 0xae, 0x1f, 0xa9, 0xb6, 0x7e, 0xa6, 0x9e, 0x2b, 0xed, 0x63, 0xd5, 0x30, 0xf4, 0x9e,
+	0xd8, 0x57, 0xd2, 0x7f, 0x88, 0x5c, 0x10, 0x54, 0x15, 0x4e, 0xc4, 0xf7, 0xf1, 0x36, 0x2c, 0x6a,
+	0xfa, 0x93, 0x6e, 0x5b, 0x0f, 0xcc, 0x4d, 0xf9, 0xe7, 0x52, 0x4e, 0xe4, 0x9b, 0xfa, 0x15, 0x00,
+	0xdd, 0x50, 0x0f, 0x7b, 0xba, 0xd2, 0xb6, 0xda, 0x4b, 0xb3, 0xee, 0xc1, 0xa8, 0xc6, 0xd0, 0x8a,
+	0x5c, 0xc1, 0x54, 0xce, 0x1e, 0xad, 0x36, 0xea, 0x63, 0xd2, 0x87, 0x2d, 0x01, 0xf7, 0xe4, 0x09,
+	0x40, 0x8b, 0x10, 0xc7, 0xc1, 0x52, 0x5a, 0x64, 0xb3, 0xe0, 0xe9, 0xf4, 0xc0, 0xa1, 0x2c, 0xc7,
+	0x7f, 0xf0, 0x6b, 0x3c, 0x2f, 0x23, 0x35, 0x7d, 0x03, 0xb2, 0xaa, 0x61, 0xeb, 0x86, 0xa1, 0x0a,
+	0xa9, 0x33, 0x8c, 0x6f, 0x46, 0x80, 0x4c, 0x4c, 0x11, 0x58, 0x7f, 0x21, 0xc1, 0x2b, 0xef, 0xf2,
+	0xfd, 0x97, 0xc4, 0x5b, 0xb7, 0xe5, 0xc3, 0x86, 0xcc, 0x51, 0x61, 0x57, 0x1b, 0x2e, 0x49, 0xd3,
+	0x1b, 0x27, 0x31, 0xdf, 0x33, 0xd1, 0x9b, 0x5b, 0x8f, 0x9c, 0xb7, 0xc3, 0xb0, 0x5c, 0xd1, 0xb0,
+	0x5c, 0x2b, 0x16, 0xa4, 0x1f, 0x0c, 0x7a, 0x5d, 0xe3, 0xe4, 0xc0, 0x3c, 0xd1, 0x0d, 0x5a, 0x83,
+	0x98, 0xbb, 0xf4, 0x97, 0x9e, 0xb3, 0x74, 0x58, 0x74, 0x8f, 0x04, 0x38, 0xdf, 0xaf, 0xe6, 0x68,
+	0x40, 0xcd, 0x2b, 0x7f, 0x06, 0x99, 0xaa, 0x79, 0x66, 0xe0, 0xaa, 0xfb, 0xaa, 0x7d, 0x4c, 0xdf,
+	0x80, 0xcc, 0x29, 0x93, 0x41, 0xb1, 0x51, 0x08, 0x5e, 0x80, 0x77, 0x22, 0x72, 0xfa, 0xd4, 0x23,
+	0x59, 0x09, 0x12, 0x9d, 0xee, 0x48, 0xd7, 0x96, 0xa2, 0x2f, 0x29, 0xdb, 0x4e, 0x44, 0xe6, 0x33,
+	0xcb, 0x33, 0x10, 0x1f, 0xa8, 0xf6, 0xf1, 0xca, 0x7f, 0xc6, 0x61, 0xf6, 0x60, 0x24, 0xce, 0x65,
+	0xd8, 0x5f, 0xb1, 0xd3, 0xee, 0xf3, 0x9a, 0xb1, 0x0a, 0xbe, 0x94, 0x39, 0x0d, 0xad, 0xc0, 0x9c,
+	0x26, 0x44, 0x57, 0x90, 0xd7, 0x90, 0x25, 0xd6, 0x29, 0x39, 0xc9, 0xbb, 0x41, 0x39, 0xab, 0x79,
+	0x46, 0x43, 0x5a, 0x84, 0x59, 0x56, 0x6e, 0x58, 0xd5, 0x8b, 0xbd, 0xb8, 0xea, 0xa5, 0xb0, 0xe4,
+	0xe0, 0x13, 0xdd, 0x85, 0x05, 0x36, 0x2b, 0x90, 0x36, 0xe2, 0x57, 0x49, 0x1b, 0x04, 0xb9, 0x78,
+	0x11, 0x74, 0x0e, 0xe4, 0xe6, 0x26, 0x87, 0x04, 0x4b, 0x0e, 0x19, 0x6b, 0xb4, 0xbe, 0xed, 0x60,
+	0x7c, 0xc9, 0x42, 0x68, 0xc9, 0x99, 0x2b, 0x2e, 0x59, 0x98, 0xb2, 0x64, 0xc1, 0xb3, 0x64, 0xd2,
+	0x59, 0xb2, 0xe0, 0x2e, 0xf9, 0x0d, 0x48, 0x0d, 0xac, 0xae, 0x69, 0x75, 0xed, 0x73, 0x16, 0xfd,
+	0x73, 0xe1, 0x00, 0x38, 0x18, 0xb5, 0xda, 0xc7, 0xba, 0x76, 0xda, 0xd3, 0xf7, 0x05, 0xa5, 0x3c,
+	0x99, 0x43, 0x6b, 0x90, 0x55, 0x0f, 0x87, 0x66, 0xef, 0xd4, 0xd6, 0x15, 0x16, 0xcb, 0xb3, 0x57,
+	0x8c, 0xe5, 0x8c, 0x33, 0x0d, 0x5f, 0xd0, 0x0d, 0x48, 0xa9, 0xda, 0x13, 0xd5, 0x68, 0xeb, 0xda,
+	0x52, 0xfb, 0xc5, 0xbd, 0xea, 0x84, 0x50, 0xc4, 0xf8, 0x7f, 0xaf, 0xb1, 0x16, 0xbc, 0x62, 0xf6,
+	0xfb, 0xaa, 0xa1, 0xd1, 0x6f, 0x42, 0xac, 0xdd, 0xd5, 0x84, 0x73, 0xbd, 0x39, 0xe5, 0x76, 0x45,
+	0x10, 0xba, 0x1e, 0xcb, 0xcb, 0x50, 0xa5, 0x5e, 0x95, 0x71, 0x26, 0xfd, 0x22, 0xa4, 0x2d, 0xf5,
+	0x6c, 0xd2, 0x39, 0x47, 0x45, 0x70, 0x80, 0xa5, 0x9e, 0x39, 0xc7, 0xd7, 0x32, 0xcc, 0x5a, 0xfa,
+	0x10, 0xcf, 0x90, 0x86, 0x73, 0x8f, 0xf3, 0xc6, 0xf3, 0x57, 0x5a, 0x95, 0x91, 0xb6, 0x6e, 0xe0,
+	0xfd, 0x45, 0xca, 0x12, 0xcf, 0xb4, 0x06, 0xc0, 0x79, 0xb4, 0x4d, 0xa3, 0x23, 0xfa, 0xf3, 0x37,
+	0x2f, 0x63, 0x52, 0x31, 0x8d, 0xce, 0x4e, 0x44, 0x9e, 0xb5, 0x9c, 0x01, 0x6d, 0xc2, 0x1c, 0x0b,
+	0x8e, 0xf6, 0xb1, 0xde, 0x3e, 0x51, 0x54, 0xc3, 0x39, 0x1a, 0xfe, 0xff, 0x17, 0xb0, 0xda, 0xed,
+	0x1a, 0x27, 0x15, 0xa4, 0x2f, 0x19, 0x18, 0xad, 0x99, 0x9e, 0x67, 0x4c, 0x1f, 0x03, 0x1b, 0x2b,
+	0xd8, 0xfe, 0xe2, 0x41, 0x86, 0xdf, 0xcf, 0xfc, 0xbf, 0x4b, 0xd8, 0x61, 0xe3, 0xac, 0x7f, 0xc8,
+	0x6f, 0x25, 0xdc, 0x31, 0xaa, 0x0d, 0x99, 0x95, 0x34, 0x0b, 0xbb, 0x64, 0x2f, 0x6b, 0x94, 0x34,
+	0x79, 0x55, 0xd6, 0x25, 0x63, 0xe8, 0x63, 0xcd, 0xe5, 0x76, 0x58, 0xa3, 0xd4, 0x4d, 0x98, 0xd3,
+	0x4e, 0xed, 0x73, 0xa5, 0x7d, 0xde, 0xee, 0xe9, 0x4c, 0xee, 0xd4, 0xa5, 0x6a, 0xa8, 0x9e, 0xda,
+	0xe7, 0x15, 0xa4, 0xe7, 0x92, 0x66, 0x34, 0xcf, 0x98, 0x3e, 0x06, 0x6a, 0x8d, 0x94, 0x81, 0x6a,
+	0xa9, 0x7d, 0x3c, 0x75, 0x9f, 0x0e, 0x18, 0x53, 0xee, 0xdc, 0xb7, 0x5f, 0x64, 0xa6, 0xd1, 0x3e,
+	0xce, 0x69, 0xe1, 0x14, 0xce, 0x77, 0xde, 0xf2, 0x43, 0x53, 0x58, 0xa3, 0x32, 0xe0, 0xa5, 0x58,
+	0x73, 0x0d, 0xf8, 0x58, 0x3b, 0x6a, 0xd0, 0x9f, 0x28, 0x43, 0x5b, 0xb5, 0x4f, 0x87, 0x8c, 0x6d,
+	0xfa, 0x72, 0x35, 0xe8, 0x4f, 0x5a, 0x8c, 0x5e, 0x78, 0x83, 0xe6, 0x19, 0x53, 0x19, 0xe6, 0x0d,
+	0xfd, 0x6c, 0x72, 0x4a, 0x40, 0x1d, 0x64, 0x18, 0xc7, 0x5b, 0x2f, 0xe0, 0xd8, 0xd0, 0xcf, 0xc4,
+	0x91, 0x81, 0x6b, 0x20, 0x6b, 0x78, 0x81, 0x20, 0x4f, 0x94, 0x32, 0xfb, 0x12, 0x3c, 0xb9, 0x98,
+	0x1e, 0x9e, 0x28, 0xa7, 0x0a, 0x73, 0x5a, 0xcf, 0x27, 0xe6, 0xdc, 0xe5, 0x1b, 0xdf, 0x75, 0x85,
+	0x2a, 0x93, 0xf1, 0xc5, 0x72, 0xc6, 0x8b, 0x30, 0x55, 0xf4, 0x3c, 0x62, 0xfb, 0x97, 0x40, 0xa9,
+	0xe7, 0xaf, 0xbe, 0x04, 0x7a, 0xb0, 0x7f, 0x09, 0x47, 0xdb, 0x3d, 0xcf, 0x2e, 0xbe, 0x8b, 0xf9,
+	0x1f, 0xd3, 0x28, 0x9e, 0x30, 0x5d, 0xaf, 0x23, 0x6c, 0x9d, 0x2f, 0xbf, 0xd0, 0x35, 0x0e, 0xd8,
+	0x24, 0x8f, 0xdb, 0x11, 0x2b, 0x80, 0xa1, 0xdf, 0xd9, 0x61, 0x97, 0xbe, 0x76, 0xa9, 0xdf, 0x1d,
+	0x84, 0x5d, 0xda, 0xf6, 0x43, 0x3c, 0x21, 0x9e, 0xe8, 0xe7, 0x2c, 0x21, 0xd2, 0x2b, 0x24, 0xc4,
+	0x13, 0xfd, 0x7c, 0x92, 0x10, 0xf9, 0x33, 0x4f, 0x88, 0xc8, 0x83, 0x25, 0xc4, 0x85, 0x2b, 0x24,
+	0xc4, 0x13, 0xfd, 0xdc, 0x4d, 0x88, 0x62, 0x40, 0x2d, 0x58, 0xc0, 0xfc, 0x12, 0xdc, 0xe6, 0xe2,
+	0xa5, 0x3a, 0x2c, 0x55, 0x65, 0xdf, 0xa6, 0xca, 0x8b, 0xe3, 0x8b, 0x65, 0x12, 0x44, 0x51, 0xb3,
+	0xaa, 0x66, 0xf9, 0xb7, 0x2f, 0xc3, 0x3c, 0x3f, 0x29, 0xb3, 0x12, 0xc8, 0x7c, 0xe3, 0xfa, 0xa5,
+	0x1e, 0x5d, 0x65, 0x33, 0xb0, 0xfa, 0x09, 0x8f, 0xd6, 0xbc, 0x00, 0x7d, 0x00, 0xa4, 0x63, 0x5a,
+	0x6d, 0x4c, 0x66, 0xce, 0xbd, 0xf9, 0xd2, 0x8d, 0xe9, 0x47, 0x31, 0x0f, 0xd3, 0x6d, 0x9c, 0x32,
+	0xb9, 0x07, 0xdb, 0x89, 0xc8, 0x73, 0x1d, 0x1f, 0x42, 0xf5, 0xc9, 0x45, 0x7c, 0x50, 0x43, 0x37,
+	0x19, 0xf3, 0xd5, 0x17, 0x6a, 0x1c, 0x27, 0x06, 0xd5, 0xb1, 0x60, 0x85, 0xe1, 0xe7, 0x2c, 0x83,
+	0x8a, 0x59, 0x7a, 0xe9, 0x65, 0xb8, 0x7a, 0x42, 0xcb, 0xf0, 0x62, 0x45, 0x07, 0x2c, 0x56, 0x7a,
+	0x26, 0x16, 0xe3, 0x8e, 0xc9, 0x76, 0xf2, 0xca, 0xa5, 0x2e, 0xbd, 0x8f, 0x71, 0xd1, 0x33, 0xed,
+	0xba, 0xd1, 0x31, 0x85, 0x4b, 0x0f, 0xfc, 0x10, 0x3d, 0x84, 0xeb, 0x2e, 0x6b, 0x6f, 0x62, 0xc9,
+	0x31, 0xee, 0x77, 0xae, 0xc0, 0xdd, 0x97, 0x4c, 0xe8, 0x20, 0x84, 0x4e, 0x5f, 0x03, 0x95, 0xf4,
+	0x85, 0x97, 0x5d, 0x83, 0xeb, 0x28, 0xb8, 0x06, 0xaa, 0xe8, 0x7d, 0xb8, 0x76, 0xa8, 0xab, 0x6d,
+	0xd3, 0x70, 0xf2, 0x0a, 0xf2, 0x7f, 0xf5, 0x52, 0x0d, 0x95, 0xd9, 0x1c, 0x9e, 0x41, 0x44, 0xb1,
+	0x39, 0xf4, 0x43, 0xe8, 0xf5, 0x82, 0x33, 0x1e, 0x31, 0x99, 0x6e, 0x5e, 0xbb, 0xd4, 0xeb, 0x39,
+	0x5f, 0x3c, 0x7f, 0x8a, 0xda, 0x70, 0xe8, 0x05, 0x82, 0x3c, 0x51, 0xd6, 0xfc, 0x4b, 0xf0, 0x14,
+	0x91, 0x74, 0xe8, 0x05, 0x3c, 0xd1, 0xd9, 0x37, 0x35, 0x76, 0xa6, 0x5e, 0x5a, 0xbe, 0x62, 0x74,
+	0xee, 0x99, 0x9a, 0xce, 0xf3, 0x54, 0x56, 0xf3, 0x02, 0x18, 0x9d, 0x5e, 0x9e, 0x2c, 0x65, 0xbd,
+	0x7e, 0x69, 0x74, 0xba, 0x4c, 0x45, 0xde, 0x9a, 0xd3, 0x7c, 0x48, 0x6e, 0x1b, 0x52, 0xce, 0x61,
+	0x91, 0x7e, 0x15, 0xb2, 0xfd, 0xae, 0x61, 0x5a, 0xca, 0x13, 0xdd, 0x1a, 0xe2, 0x5d, 0xc0, 0xf3,
+	0x3e, 0x5e, 0x21, 0x91, 0x9c, 0x61, 0xb4, 0x0f, 0x39, 0x69, 0xee, 0x5d, 0x98, 0x9d, 0x9c, 0x17,
+	0x3f, 0x13, 0xa3, 0xfb, 0x90, 0xf1, 0x9e, 0x16, 0xe9, 0x0d, 0x98, 0xe9, 0xab, 0xd6, 0x51, 0xd7,
+	0x10, 0xf7, 0x47, 0x62, 0x84, 0xbd, 0xc6, 0xe4, 0x36, 0xc2, 0x3c, 0x35, 0x6c, 0xa7, 0xf7, 0x15,
+	0x60, 0x05, 0xb1, 0xdc, 0xff, 0x4a, 0xe0, 0x39, 0x1c, 0x4e, 0xbb, 0x93, 0x91, 0xfe, 0x80, 0x3b,
+	0x99, 0x37, 0x61, 0xce, 0xb9, 0x54, 0xf1, 0xf7, 0xdd, 0xe2, 0x6a, 0x85, 0x53, 0x7d, 0x11, 0x32,
+	0x4e, 0x80, 0xe1, 0x45, 0x9c, 0xb8, 0x87, 0x4b, 0x0b, 0x0c, 0x2f, 0xe3, 0xe8, 0x1a, 0x2c, 0x7a,
+	0x49, 0xd0, 0xa8, 0xb6, 0x65, 0xf6, 0xc4, 0xcd, 0x36, 0xf5, 0x90, 0x56, 0xf8, 0x1b, 0xbc, 0xcf,
+	0x31, 0x0e, 0x15, 0xdb, 0x42, 0x37, 0x9d, 0xe1, 0xf7, 0x69, 0xc6, 0xe1, 0x01, 0x0e, 0xdf, 0x8b,
+	0xa7, 0xe2, 0x24, 0x91, 0xfb, 0x4b, 0x77, 0xc7, 0xa8, 0xbd, 0x5b, 0x40, 0x7c, 0x2b, 0xe0, 0x37,
+	0x23, 0xf6, 0xf5, 0x49, 0x9e, 0xf3, 0x70, 0x2f, 0xb5, 0x4f, 0xe8, 0x1d, 0x58, 0x08, 0xe8, 0x86,
+	0x11, 0xb3, 0xef, 0x50, 0x32, 0xf1, 0x29, 0x00, 0xc9, 0xbf, 0xcc, 0x4b, 0xbb, 0xab, 0x03, 0xc5,
+	0xfd, 0x1c, 0x35, 0xef, 0xd5, 0x43, 0xa9, 0x7d, 0x92, 0x7b, 0x0c, 0x19, 0xef, 0xd1, 0x97, 0xd6,
+	0x61, 0xae, 0xaf, 0x8e, 0x14, 0xf7, 0xfc, 0x2c, 0xcc, 0x10, 0xaa, 0xe0, 0xa5, 0xa3, 0x23, 0x4b,
+	0x47, 0x8b, 0x6a, 0xee, 0xfc, 0x4c, 0x5f, 0x1d, 0x4d, 0x46, 0xb9, 0x7f, 0x96, 0x60, 0x3e, 0x70,
+	0x02, 0x7e, 0x5e, 0x53, 0x2b, 0xfd, 0x61, 0x4d, 0xed, 0x5d, 0x58, 0xf4, 0x77, 0xe5, 0xe2, 0x06,
+	0x99, 0xdb, 0xfc, 0x9a, 0xa7, 0xef, 0x16, 0xd7, 0xc6, 0xa1, 0x2e, 0x38, 0x16, 0xee, 0x82, 0x73,
+	0x7f, 0x1f, 0x90, 0x1b, 0x8d, 0x55, 0x84, 0x9b, 0x53, 0xe4, 0xf6, 0xd8, 0x6c, 0x21, 0x28, 0x1c,
+	0x5a, 0x62, 0x13, 0x96, 0xa6, 0xc9, 0xe7, 0xb1, 0xde, 0x62, 0x48, 0x46, 0x9c, 0x77, 0x1b, 0xae,
+	0xf9, 0xc4, 0xf4, 0x1a, 0xd0, 0x2b, 0x2a, 0x1a, 0xf0, 0x5b, 0x90, 0xf1, 0x1e, 0xda, 0xe9, 0x12,
+	0x24, 0x0f, 0x55, 0xdb, 0xd6, 0xad, 0xf3, 0xc9, 0xad, 0x2e, 0x1f, 0x7a, 0xc2, 0x35, 0xca, 0xae,
+	0x1b, 0xc5, 0x28, 0xf7, 0x3f, 0x12, 0x64, 0x7d, 0xa7, 0x74, 0x54, 0x93, 0xff, 0x2a, 0x90, 0x73,
+	0x72, 0x82, 0x86, 0x2b, 0xdf, 0x77, 0xbb, 0x19, 0x0d, 0xde, 0x6e, 0xee, 0xc2, 0x42, 0xbf, 0x6b,
+	0x84, 0x0c, 0x1d, 0xbb, 0x92, 0xa1, 0xfb, 0x5d, 0xc3, 0x6f, 0x68, 0xe4, 0xa6, 0x8e, 0x42, 0xdc,
+	0xae, 0x76, 0xfd, 0x82, 0x4e, 0xe9, 0x45, 0x72, 0xef, 0x7b, 0xf7, 0x8b, 0x3a, 0x7b, 0x03, 0xb2,
+	0x7e, 0x5d, 0x73, 0x9b, 0x66, 0x3a, 0x1e, 0x45, 0xd3, 0x15, 0xc8, 0xba, 0xeb, 0xbb, 0x16, 0x4c,
+	0x3b, 0xf1, 0x87, 0xc6, 0xf8, 0x36, 0xf8, 0xda, 0x86, 0xcf, 0x41, 0x91, 0x39, 0x05, 0x7c, 0x6d,
+	0x02, 0xfa, 0x86, 0x8f, 0xa5, 0x47, 0xde, 0x79, 0x2f, 0x5b, 0x14, 0x39, 0xb4, 0xaf, 0x68, 0x78,
+	0x5f, 0xb9, 0x12, 0x90, 0x60, 0xc7, 0x40, 0xef, 0x40, 0x82, 0x5f, 0x90, 0x49, 0x2f, 0xbe, 0x20,
+	0xe3, 0x54, 0xb9, 0x7f, 0x95, 0x60, 0x3e, 0xd0, 0x18, 0x50, 0x99, 0x27, 0x12, 0xbd, 0x6b, 0x0d,
+	0x7c, 0x41, 0x1e, 0xfe, 0x4c, 0xc5, 0xaa, 0x5e, 0xad, 0x2e, 0xef, 0xf3, 0xa6, 0x68, 0x4f, 0x1d,
+	0xe1, 0x80, 0x1b, 0x0f, 0x33, 0x4a, 0xad, 0x6b, 0x0d, 0xd8, 0x08, 0xf7, 0x2e, 0xee, 0x2a, 0xb5,
+	0x33, 0xbd, 0xd7, 0xe3, 0x77, 0x4c, 0x7c, 0x4f, 0xf3, 0xfc, 0x45, 0x15, 0x71, 0x76, 0x89, 0xb4,
+	0x0a, 0x0b, 0x93, 0xcb, 0x42, 0x0f, 0x35, 0x8f, 0xa2, 0x6b, 0xce, 0xab, 0x09, 0x3d, 0xaf, 0xb6,
+	0xa2, 0xfb, 0xf8, 0xcc, 0xd5, 0xd6, 0xe9, 0x3f, 0x3e, 0x0b, 0xa3, 0xdf, 0x4b, 0x10, 0x6a, 0x38,
+	0xe8, 0x87, 0x70, 0xc3, 0xf9, 0x29, 0x42, 0xaf, 0xdb, 0xef, 0xda, 0x8a, 0x3e, 0x1a, 0x98, 0x86,
+	0x6e, 0xd8, 0xcf, 0x4d, 0xd3, 0xec, 0x17, 0x0a, 0xbb, 0x48, 0x5b, 0x13, 0xa4, 0xe5, 0x9b, 0xe3,
+	0x8b, 0xe5, 0x85, 0x29, 0x2f, 0xe4, 0x05, 0xfe, 0x23, 0x06, 0x1f, 0xe8, 0x5d, 0x92, 0x59, 0xdb,
+	0x5d, 0x32, 0xfa, 0xa2, 0x25, 0x99, 0x83, 0x4c, 0x5b, 0xd2, 0xf7, 0xc2, 0x59, 0xd2, 0x07, 0xe6,
+	0xea, 0x90, 0xf5, 0x35, 0x44, 0x74, 0x4b, 0x7c, 0x1b, 0x48, 0x5e, 0x7a, 0x9f, 0xc8, 0xee, 0xc2,
+	0xdd, 0xef, 0x03, 0xb9, 0xdf, 0x4a, 0x30, 0xe7, 0xef, 0x83, 0xf0, 0xbb, 0x4d, 0xf0, 0x47, 0x00,
+	0x59, 0xdf, 0x87, 0xfe, 0xcf, 0xef, 0xfb, 0x10, 0x46, 0x80, 0xa5, 0xdb, 0x56, 0x57, 0x1f, 0xf2,
+	0x5f, 0xa7, 0xe0, 0x8f, 0xb6, 0x46, 0x32, 0x47, 0xe8, 0x1e, 0xcc, 0x0f, 0x74, 0xab, 0x6b, 0x6a,
+	0xae, 0x4a, 0xe3, 0xd3, 0x6f, 0x2a, 0x45, 0x17, 0xc4, 0x88, 0x27, 0xaa, 0x9b, 0x1b, 0xf8, 0xc6,
+	0xb9, 0x4f, 0x24, 0x58, 0x98, 0xd2, 0x95, 0xd1, 0x6f, 0x03, 0x45, 0x39, 0xd8, 0x51, 0xec, 0x52,
+	0x7f, 0xe1, 0x0c, 0xd8, 0x11, 0x6d, 0xb2, 0x10, 0x66, 0x50, 0x1f, 0x42, 0x1b, 0x70, 0x0d, 0x59,
+	0xb2, 0x06, 0x37, 0xe0, 0x0e, 0x2b, 0xd3, 0x39, 0xa2, 0x85, 0x26, 0x0c, 0xe7, 0xfb, 0xea, 0xc8,
+	0x0b, 0xe4, 0x76, 0xc2, 0x92, 0xa3, 0xd9, 0xd7, 0xe1, 0x7a, 0x68, 0x19, 0x4f, 0xbe, 0xa3, 0x01,
+	0x36, 0x98, 0xcd, 0xea, 0x30, 0x1f, 0xe8, 0xe7, 0xf0, 0x77, 0x45, 0x5c, 0x53, 0x62, 0xcf, 0xf9,
+	0xa0, 0x84, 0xce, 0x04, 0xae, 0x5f, 0x59, 0x50, 0xe7, 0xce, 0x81, 0x86, 0x9b, 0x37, 0x7f, 0xb6,
+	0x96, 0x82, 0x65, 0xef, 0xf3, 0x71, 0x9d, 0xdc, 0x71, 0x68, 0xe9, 0x2b, 0x97, 0xa9, 0x97, 0x3b,
+	0x2c, 0xe6, 0x76, 0x61, 0x3e, 0xd0, 0xdd, 0xd1, 0x45, 0x6f, 0xf2, 0xcf, 0x8a, 0x1c, 0x1f, 0x2e,
+	0x65, 0xd1, 0x70, 0x29, 0xcb, 0xdd, 0x81, 0xac, 0xaf, 0xa7, 0x7b, 0xb1, 0xb6, 0x72, 0x45, 0x2f,
+	0xf9, 0x55, 0x77, 0x98, 0xfb, 0x9a, 0x93, 0x1d, 0x9c, 0xfe, 0xeb, 0x65, 0x3e, 0x22, 0xe5, 0xbe,
+	0x0e, 0x73, 0xfe, 0xce, 0xeb, 0xa5, 0xa6, 0xe3, 0xef, 0x0a, 0xc5, 0xc7, 0x80, 0xdb, 0x3f, 0x92,
+	0x20, 0xc1, 0x7e, 0x2c, 0x48, 0x09, 0x64, 0xde, 0x6b, 0xd6, 0x1b, 0x8a, 0x5c, 0xfb, 0xf6, 0x83,
+	0x5a, 0xeb, 0x80, 0x44, 0xe8, 0x3c, 0xa4, 0x19, 0x52, 0xaa, 0x54, 0x6a, 0xfb, 0x07, 0x44, 0xa2,
+	0x14, 0xe6, 0x1e, 0x34, 0x2a, 0xcd, 0xc6, 0x76, 0x5d, 0xde, 0xab, 0x55, 0x95, 0x07, 0xfb, 0x24,
+	0x4a, 0x17, 0x81, 0x78, 0xb1, 0x6a, 0xf3, 0x51, 0x83, 0xc4, 0x90, 0x99, 0x8f, 0x2e, 0x8e, 0x73,
+	0x03, 0x54, 0x09, 0xc4, 0xe4, 0x9a, 0x6f, 0xd1, 0x19, 0x5c, 0x74, 0x5f, 0x6e, 0xee, 0xcb, 0xf5,
+	0xda, 0x41, 0x49, 0x7e, 0x4c, 0x92, 0xb7, 0x6f, 0x42, 0x82, 0xfd, 0x40, 0x91, 0xce, 0x01, 0xec,
+	0x36, 0xe5, 0xd2, 0xa3, 0x52, 0x43, 0x91, 0xd7, 0x49, 0xe4, 0xf6, 0xf7, 0xf9, 0x2f, 0x15, 0x45,
+	0xa9, 0xc1, 0x89, 0x7b, 0xa5, 0x8a, 0xf2, 0xa0, 0x71, 0xbf, 0x81, 0xdc, 0x23, 0x34, 0x03, 0x29,
+	0x04, 0x1e, 0xae, 0x2b, 0x6b, 0x44, 0xc2, 0xd9, 0xce, 0x48, 0x59, 0x27, 0x51, 0xdf, 0xb8, 0x40,
+	0x62, 0x1e, 0xea, 0x75, 0x12, 0xf7, 0xbd, 0xdd, 0x20, 0x09, 0xdf, 0xb8, 0x48, 0x66, 0x72, 0xa9,
+	0x8f, 0xff, 0x36, 0x1f, 0xf9, 0xe9, 0xdf, 0xe5, 0x23, 0xb7, 0x7f, 0x21, 0x01, 0xec, 0xef, 0x3c,
+	0xf6, 0x48, 0xb1, 0xbf, 0xf3, 0xd8, 0x2f, 0x05, 0x02, 0xae, 0x14, 0xce, 0x88, 0x49, 0xb1, 0x08,
+	0x64, 0x32, 0x2e, 0x28, 0x72, 0xed, 0xa1, 0x52, 0x22, 0xb1, 0x29, 0x68, 0x99, 0x6b, 0x50, 0xa0,
+	0xeb, 0x82, 0x32, 0x11, 0xc2, 0xca, 0x64, 0xc6, 0x37, 0x7b, 0x43, 0x50, 0x26, 0xd1, 0x22, 0xf2,
+	0xfe, 0xda, 0x5a, 0x81, 0xe3, 0x6b, 0x24, 0x15, 0x40, 0xd6, 0xc9, 0xac, 0x67, 0x57, 0xff, 0x18,
+	0x85, 0xac, 0xff, 0xf4, 0x3a, 0x0f, 0xe9, 0x6a, 0xe9, 0xa0, 0xa4, 0xc8, 0xa5, 0x83, 0x9a, 0xb2,
+	0x46, 0x22, 0x7e, 0x60, 0x9d, 0x48, 0x7e, 0xa0, 0x40, 0xa2, 0x7e, 0x60, 0x83, 0xc4, 0xfc, 0x40,
+	0x91, 0xc4, 0xfd, 0xc0, 0x3d, 0x92, 0xf0, 0x03, 0x9b, 0x64, 0xc6, 0x0f, 0xbc, 0x45, 0x92, 0x7e,
+	0x60, 0x8b, 0xa4, 0xfc, 0xc0, 0xdb, 0x64, 0x16, 0xf7, 0xe5, 0x11, 0x6c, 0x8d, 0x40, 0x00, 0x59,
+	0x27, 0xe9, 0x00, 0x52, 0x20, 0x99, 0x00, 0xb2, 0x41, 0xb2, 0x01, 0xa4, 0x48, 0xe6, 0x02, 0xc8,
+	0x3d, 0x32, 0xef, 0xd1, 0xd8, 0x1a, 0x80, 0xfb, 0x93, 0x3b, 0x9a, 0x86, 0x64, 0xa5, 0xd9, 0x38,
+	0xa8, 0xbd, 0x8f, 0x71, 0x94, 0x86, 0x64, 0xab, 0xd6, 0x6a, 0xd5, 0x9b, 0x0d, 0x22, 0xd1, 0x14,
+	0xc4, 0xef, 0xd7, 0x1e, 0xb7, 0x48, 0x14, 0x67, 0xb8, 0x3f, 0xb6, 0xc1, 0x6d, 0x6c, 0xb3, 0x28,
+	0x68, 0x54, 0xea, 0xb5, 0x16, 0x89, 0xd0, 0x6b, 0x90, 0xad, 0xec, 0x94, 0x1a, 0x8d, 0xda, 0xae,
+	0xb2, 0x57, 0x6a, 0xdd, 0x6f, 0x11, 0xe9, 0x76, 0x11, 0x12, 0x2c, 0x8e, 0x19, 0xfb, 0xdd, 0x52,
+	0xab, 0xa5, 0x94, 0x48, 0xc4, 0x1d, 0x94, 0x89, 0xe4, 0x0e, 0x2a, 0x24, 0x9a, 0x8b, 0xa3, 0x74,
+	0xb7, 0x07, 0x40, 0xc3, 0x1f, 0x3c, 0x29, 0xc0, 0xcc, 0x6e, 0xf3, 0x11, 0x0f, 0xf4, 0x24, 0xc4,
+	0x76, 0x9b, 0x8f, 0x88, 0x84, 0x1b, 0x2c, 0xd7, 0x76, 0x9b, 0x8f, 0x94, 0x46, 0x53, 0xde, 0x2b,
+	0xed, 0x92, 0x28, 0x92, 0x89, 0x67, 0x16, 0xd4, 0xa5, 0x72, 0xf3, 0x61, 0xcd, 0x79, 0x1b, 0xc7,
+	0xcd, 0xec, 0xd4, 0xdf, 0xdd, 0x21, 0x09, 0x5c, 0x17, 0x9f, 0x58, 0x0c, 0xdf, 0xfe, 0xaf, 0x18,
+	0x2c, 0x4e, 0xfb, 0x2e, 0x49, 0xb3, 0x30, 0x5b, 0xa9, 0x57, 0x15, 0x79, 0xfb, 0x01, 0x73, 0x21,
+	0x67, 0x58, 0x6b, 0xd5, 0x44, 0x7a, 0xc1, 0xe1, 0x6e, 0xbd, 0x71, 0x5f, 0xa9, 0xec, 0xd4, 0x2a,
+	0xf7, 0x49, 0x94, 0x25, 0x12, 0x07, 0x2b, 0x55, 0x65, 0x12, 0x73, 0xa8, 0xaa, 0x0f, 0x0e, 0x1e,
+	0x2b, 0x95, 0xc7, 0x95, 0xdd, 0x1a, 0x89, 0xd3, 0x1b, 0x40, 0x19, 0xa3, 0xf7, 0x95, 0xfd, 0x92,
+	0x5c, 0xda, 0x53, 0x5a, 0xb5, 0x83, 0x07, 0xfb, 0x3c, 0x3c, 0x18, 0x6d, 0xed, 0xa1, 0xd2, 0x3a,
+	0x28, 0x1d, 0x3c, 0x68, 0x91, 0x19, 0xba, 0x00, 0xf3, 0x88, 0x35, 0x6a, 0x8f, 0x14, 0xa1, 0x5f,
+	0x92, 0xa4, 0x37, 0x61, 0x41, 0x30, 0x38, 0xa8, 0xef, 0xd5, 0x1b, 0xef, 0x0a, 0x0e, 0x29, 0x87,
+	0xf3, 0x81, 0x9f, 0xf3, 0xec, 0x84, 0xf3, 0xee, 0x84, 0x09, 0xb8, 0xdb, 0xb9, 0x5f, 0x7b, 0x4c,
+	0xd2, 0x0e, 0xcf, 0x52, 0x55, 0xf6, 0xcd, 0xcd, 0x38, 0x12, 0x54, 0x6b, 0x0f, 0xeb, 0x95, 0x1a,
+	0x2e, 0x58, 0x23, 0x59, 0x8c, 0x5a, 0x04, 0xb7, 0x9b, 0x72, 0xa5, 0xa6, 0xf0, 0xac, 0x48, 0xe6,
+	0x68, 0x0e, 0x6e, 0x70, 0x96, 0x38, 0xf6, 0xb1, 0x99, 0x77, 0x44, 0xdb, 0x67, 0xe2, 0xee, 0x36,
+	0x0f, 0x94, 0x7a, 0x63, 0xbb, 0x49, 0x08, 0x7d, 0x05, 0xae, 0xfb, 0x71, 0x47, 0xc2, 0x6b, 0xf4,
+	0x3a, 0x5c, 0xc3, 0x57, 0xe5, 0x5a, 0xa9, 0xd2, 0x6c, 0x88, 0xad, 0x12, 0xea, 0x08, 0x24, 0x60,
+	0x74, 0x43, 0xb2, 0x10, 0x90, 0x72, 0xaf, 0x59, 0xad, 0x91, 0xd7, 0x85, 0x47, 0xfd, 0x32, 0x0a,
+	0x0b, 0x53, 0xae, 0x4e, 0x58, 0x7c, 0x4c, 0xcc, 0xa2, 0xac, 0x93, 0x48, 0x00, 0x29, 0x10, 0x29,
+	0x80, 0x14, 0x49, 0x34, 0x80, 0x6c, 0x91, 0x18, 0xba, 0xbe, 0x97, 0xcf, 0x26, 0x89, 0x07, 0xa0,
+	0x8d, 0x02, 0x49, 0x04, 0xa0, 0xcd, 0x22, 0x99, 0x41, 0xab, 0x78, 0x27, 0x16, 0xb6, 0x48, 0x32,
+	0x80, 0x15, 0xee, 0x6d, 0x92, 0x54, 0x00, 0xbb, 0xb7, 0x5e, 0x20, 0xb3, 0xb8, 0x5f, 0xef, 0xdc,
+	0xb5, 0x42, 0x91, 0x40, 0x00, 0x2c, 0xac, 0x15, 0xb7, 0x48, 0x3a, 0x00, 0x16, 0xd7, 0xde, 0xde,
+	0x24, 0x99, 0x00, 0xb8, 0xb5, 0xfe, 0x76, 0x81, 0x1b, 0xd5, 0xb7, 0x91, 0x8d, 0x2d, 0x4c, 0x23,
+	0x7e, 0x74, 0xa3, 0xf0, 0xd6, 0xe6, 0x16, 0x99, 0x17, 0xaa, 0xfd, 0x27, 0x09, 0xe6, 0xfc, 0x47,
+	0x39, 0xdc, 0x27, 0xb3, 0x65, 0xed, 0x61, 0x4d, 0x7e, 0xac, 0xac, 0x8b, 0xdc, 0xe0, 0x81, 0x0a,
+	0x2d, 0x22, 0x05, 0xa0, 0x62, 0x8b, 0x44, 0x03, 0xd0, 0x56, 0x8b, 0x07, 0x8f, 0x97, 0xd7, 0x66,
+	0x8b, 0xc4, 0x03, 0xd8, 0x46, 0xa1, 0x45, 0x12, 0x01, 0x6c, 0xb3, 0x28, 0x02, 0xc7, 0x3b, 0xb7,
+	0xb0, 0xd5, 0x22, 0x49, 0x21, 0xf5, 0x9f, 0xc7, 0x9c, 0xb3, 0xaf, 0xff, 0x88, 0xbd, 0x00, 0xf3,
+	0xc2, 0x75, 0x2b, 0xcd, 0x07, 0x8d, 0x03, 0x34, 0x65, 0x24, 0x04, 0x6e, 0xa0, 0x5b, 0x04, 0xc1,
+	0xcd, 0x22, 0xaf, 0x8e, 0xfe, 0xe9, 0x85, 0x2d, 0x12, 0x0b, 0xa1, 0x68, 0xd2, 0x78, 0x08, 0x45,
+	0xa3, 0x26, 0xd0, 0xe1, 0xfd, 0x1c, 0xd0, 0xac, 0x33, 0x21, 0x98, 0x19, 0x36, 0x19, 0x82, 0x99,
+	0x69, 0x53, 0x21, 0x98, 0x19, 0x77, 0x16, 0xe3, 0x2f, 0xb0, 0x39, 0x34, 0x2f, 0x84, 0x70, 0x6e,
+	0xe0, 0x74, 0x08, 0xdf, 0xbc, 0x77, 0x6f, 0x03, 0x3d, 0xe7, 0x26, 0x2c, 0xf8, 0xf9, 0x6c, 0xac,
+	0xaf, 0xbd, 0x85, 0xde, 0x13, 0x7c, 0x51, 0xd8, 0x2c, 0xac, 0x17, 0xd1, 0x81, 0x82, 0x2f, 0xee,
+	0x15, 0x8a, 0x85, 0x2d, 0xd7, 0x87, 0x3e, 0x8d, 0x02, 0x0d, 0x37, 0x2c, 0xe8, 0x0e, 0x62, 0x16,
+	0xa6, 0x1c, 0x96, 0x80, 0x03, 0xd0, 0x3a, 0x91, 0x82, 0x50, 0x81, 0x44, 0x83, 0xd0, 0x06, 0x89,
+	0x05, 0xa1, 0x22, 0x89, 0x07, 0xa1, 0x7b, 0x24, 0x11, 0x84, 0xb0, 0x9e, 0x07, 0x20, 0xac, 0xe8,
+	0x01, 0x08, 0x6b, 0x7a, 0x00, 0x7a, 0x9b, 0x27, 0x5c, 0x9f, 0xa8, 0x58, 0xd7, 0x83, 0x18, 0x56,
+	0xf6, 0x20, 0x86, 0xb5, 0x3d, 0x88, 0x61, 0x75, 0x0f, 0x62, 0xa8, 0xd7, 0x20, 0x76, 0x6f, 0xa2,
+	0xd2, 0x7f, 0x93, 0x9c, 0x1f, 0xe3, 0xfb, 0xfb, 0x57, 0x8f, 0xdf, 0xee, 0xd7, 0xe4, 0x7a, 0xb3,
+	0xca, 0xd4, 0x1a, 0x02, 0xd7, 0x89, 0x14, 0x06, 0x51, 0xb5, 0x21, 0x10, 0x95, 0x1b, 0x02, 0x51,
+	0xbd, 0x21, 0x10, 0x15, 0x1c, 0x02, 0x37, 0xc9, 0x4c, 0x18, 0x7c, 0x6b, 0x12, 0xa7, 0xff, 0x11,
+	0x05, 0x70, 0xaf, 0xaa, 0x58, 0x06, 0xe5, 0xe9, 0x1d, 0x87, 0xca, 0x16, 0x89, 0xb0, 0xcc, 0xe8,
+	0x81, 0xd6, 0xd7, 0x88, 0x14, 0xc2, 0x50, 0xf0, 0x20, 0xb6, 0x41, 0x62, 0x21, 0xac, 0x48, 0xe2,
+	0x21, 0x6c, 0x93, 0x24, 0x42, 0xd8, 0x16, 0x99, 0x09, 0x62, 0x85, 0x35, 0x92, 0x0c, 0x61, 0xeb,
+	0x24, 0x15, 0xc2, 0x8a, 0x64, 0x36, 0x84, 0x6d, 0x12, 0x08, 0x61, 0x6f, 0x91, 0x74, 0x08, 0x7b,
+	0x9b, 0x64, 0x82, 0xd8, 0xc6, 0x1a, 0xc9, 0x86, 0xb0, 0x0d, 0x32, 0x17, 0xc2, 0x36, 0x27, 0xae,
+	0xf1, 0x71, 0x0c, 0xa6, 0xdd, 0x43, 0xa1, 0x19, 0xb0, 0xf4, 0x97, 0x2a, 0xf7, 0x95, 0xdd, 0xfa,
+	0x5e, 0xfd, 0x80, 0xd5, 0xc3, 0x10, 0x28, 0x72, 0x9f, 0x1f, 0x2c, 0x92, 0x68, 0x18, 0x14, 0xa9,
+	0x2f, 0xc0, 0x53, 0xa4, 0x3e, 0x3f, 0xca, 0xca, 0x63, 0x08, 0xdd, 0x14, 0x99, 0x2f, 0xc0, 0xa1,
+	0x20, 0x32, 0x5f, 0x40, 0xae, 0x7b, 0x22, 0xf3, 0xf9, 0x61, 0x5e, 0x2a, 0x6f, 0x00, 0x0d, 0x30,
+	0xe1, 0xd5, 0x32, 0x84, 0x8b, 0x82, 0x19, 0xc2, 0x45, 0xcd, 0x0c, 0xe1, 0xa2, 0x6c, 0xde, 0x84,
+	0x05, 0x3f, 0xee, 0x54, 0xce, 0xd0, 0x0b, 0x7f, 0xf1, 0x74, 0x4d, 0xe1, 0xbb, 0x8a, 0xf3, 0xea,
+	0xb2, 0x5a, 0xdb, 0x2d, 0x3d, 0x0e, 0x9a, 0x82, 0x83, 0x01, 0x53, 0x70, 0x30, 0x60, 0x0a, 0x0e,
+	0x06, 0x4c, 0x21, 0x78, 0x06, 0x4c, 0xc1, 0xd1, 0xa0, 0x29, 0x38, 0x1a, 0x34, 0x85, 0xe0, 0x10,
+	0x34, 0x85, 0x90, 0x2b, 0x68, 0x0a, 0x0e, 0x87, 0x4c, 0x21, 0x98, 0x84, 0x4c, 0x21, 0xb8, 0x84,
+	0x4c, 0x21, 0x36, 0x18, 0x32, 0x85, 0xd8, 0x63, 0xc8, 0x14, 0xce, 0x36, 0x43, 0xa6, 0x70, 0x76,
+	0xea, 0x35, 0xc5, 0x0f, 0xa3, 0x90, 0x14, 0x17, 0xec, 0xd8, 0xf4, 0xca, 0xef, 0x0b, 0x2a, 0x4c,
+	0x8f, 0xde, 0xf1, 0x3a, 0x91, 0x7c, 0xe3, 0x02, 0x89, 0xfa, 0xc6, 0x98, 0x57, 0xbc, 0xe3, 0x22,
+	0x89, 0xfb, 0xc6, 0xf7, 0x48, 0xc2, 0x37, 0xc6, 0x04, 0xe8, 0x1d, 0x63, 0x81, 0xf1, 0x8e, 0xb1,
+	0xba, 0x78, 0xc7, 0x58, 0x5a, 0xe6, 0x21, 0xed, 0xca, 0x83, 0x75, 0xc5, 0x07, 0x60, 0x51, 0xf1,
+	0x01, 0x58, 0x51, 0x7c, 0x00, 0x96, 0x13, 0x1f, 0x80, 0xfa, 0xf1, 0x01, 0x6e, 0x21, 0xf9, 0x51,
+	0x14, 0x12, 0xec, 0xa2, 0x1c, 0x09, 0xf6, 0xea, 0x8d, 0xa6, 0x3c, 0xe9, 0x86, 0xd2, 0x90, 0xe4,
+	0x80, 0x68, 0xa6, 0xdd, 0xb7, 0xa2, 0x99, 0x76, 0x01, 0xd1, 0x4c, 0xbb, 0x80, 0x68, 0xa6, 0x5d,
+	0x40, 0x34, 0xd3, 0x2e, 0x20, 0x9a, 0x69, 0x17, 0x10, 0xcd, 0xb4, 0x0b, 0x88, 0x66, 0xda, 0x05,
+	0x44, 0x33, 0xed, 0x02, 0x4e, 0x33, 0xed, 0x41, 0x44, 0x33, 0xed, 0x41, 0x44, 0x33, 0xed, 0x41,
+	0x44, 0x33, 0xed, 0x41, 0x44, 0x33, 0xed, 0x41, 0x26, 0x1a, 0x2a, 0xff, 0x8d, 0xf4, 0xf3, 0xa7,
+	0x79, 0xe9, 0xd3, 0xa7, 0x79, 0xe9, 0x97, 0x4f, 0xf3, 0x91, 0xdf, 0x3c, 0xcd, 0x47, 0x7e, 0xfb,
+	0x34, 0x1f, 0xf9, 0xdd, 0xd3, 0x7c, 0xe4, 0xf7, 0x4f, 0xf3, 0xd2, 0x47, 0xe3, 0xbc, 0xf4, 0xf1,
+	0x38, 0x1f, 0xf9, 0xc9, 0x38, 0x2f, 0xfd, 0x74, 0x9c, 0x8f, 0x7c, 0x32, 0xce, 0x47, 0x7e, 0x36,
+	0xce, 0x47, 0x7e, 0x3e, 0xce, 0x4b, 0x9f, 0x8e, 0xf3, 0xd2, 0x2f, 0xc7, 0xf9, 0xc8, 0x6f, 0xc6,
+	0x79, 0xe9, 0xb7, 0xe3, 0x7c, 0xe4, 0x77, 0xe3, 0xbc, 0xf4, 0xfb, 0x71, 0x3e, 0xf2, 0xd1, 0xb3,
+	0x7c, 0xe4, 0xe3, 0x67, 0x79, 0xe9, 0x07, 0xcf, 0xf2, 0x91, 0xbf, 0x7a, 0x96, 0x97, 0x7e, 0xfc,
+	0x2c, 0x1f, 0xf9, 0xc9, 0xb3, 0x7c, 0xe4, 0xa7, 0xcf, 0xf2, 0xd2, 0x27, 0xcf, 0xf2, 0xd2, 0xcf,
+	0x9e, 0xe5, 0xa5, 0xef, 0x7c, 0xe5, 0xaa, 0xff, 0xcd, 0x64, 0x1b, 0x83, 0xc3, 0xc3, 0x19, 0x76,
+	0x6b, 0xbf, 0xf1, 0x7f, 0x03, 0x00, 0x56, 0xa8, 0xe6, 0x7b, 0x5a, 0x3e, 0x00, 0x00,
 }
@@ -48,6 +48,16 @@ func TestMACVersionCompare(t *testing.T) {
 			B:        MAC_V1_1,
 			Expected: -1,
 		},
+		{
+			A:        MAC_V1_0_3,
+			B:        MAC_V1_0_2,
+			Expected: 1,
+		},
+		{
+			A:        MAC_V1_0_4,
+			B:        MAC_V1_1,
+			Expected: -1,
+		},
 		{
 			A:      MAC_UNKNOWN,
 			B:      MAC_V1_1,
@@ -78,6 +88,20 @@ func TestMACVersionCompare(t *testing.T) {
 	}
 }
 
+func TestMACVersionDuplicatesLinkADRAns(t *testing.T) {
+	for v, expected := range map[MACVersion]bool{
+		MAC_V1_0:   false,
+		MAC_V1_0_1: false,
+		MAC_V1_0_2: true,
+		MAC_V1_0_3: true,
+		MAC_V1_0_4: true,
+		MAC_V1_1:   false,
+	} {
+		assertions.New(t).So(v.DuplicatesLinkADRAns(), should.Equal, expected)
+	}
+	assertions.New(t).So(func() { MAC_UNKNOWN.DuplicatesLinkADRAns() }, should.Panic)
+}
+
 func TestDataRateIndex(t *testing.T) {
 	a := assertions.New(t)
 	a.So(DATA_RATE_4.String(), should.Equal, "4")
@@ -25,6 +25,7 @@ var ProcessUplinkMessageRequestFieldPathsNested = []string{
 	"message.rx_metadata",
 	"message.session_key_id",
 	"message.settings",
+	"message.settings.antenna_index",
 	"message.settings.coding_rate",
 	"message.settings.data_rate",
 	"message.settings.data_rate.modulation",
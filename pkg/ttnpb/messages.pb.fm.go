@@ -57,6 +57,7 @@ var UplinkMessageFieldPathsNested = []string{
 	"received_at",
 	"rx_metadata",
 	"settings",
+	"settings.antenna_index",
 	"settings.coding_rate",
 	"settings.data_rate",
 	"settings.data_rate.modulation",
@@ -240,6 +241,7 @@ var DownlinkMessageFieldPathsNested = []string{
 	"settings.request.rx2_data_rate_index",
 	"settings.request.rx2_frequency",
 	"settings.scheduled",
+	"settings.scheduled.antenna_index",
 	"settings.scheduled.coding_rate",
 	"settings.scheduled.data_rate",
 	"settings.scheduled.data_rate.modulation",
@@ -410,11 +412,97 @@ func (dst *DownlinkMessage) SetFields(src *DownlinkMessage, paths ...string) err
 
 var TxAcknowledgmentFieldPathsNested = []string{
 	"correlation_ids",
+	"downlink_message",
+	"downlink_message.correlation_ids",
+	"downlink_message.end_device_ids",
+	"downlink_message.end_device_ids.application_ids",
+	"downlink_message.end_device_ids.application_ids.application_id",
+	"downlink_message.end_device_ids.dev_addr",
+	"downlink_message.end_device_ids.dev_eui",
+	"downlink_message.end_device_ids.device_id",
+	"downlink_message.end_device_ids.join_eui",
+	"downlink_message.payload",
+	"downlink_message.payload.Payload",
+	"downlink_message.payload.Payload.join_accept_payload",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"downlink_message.payload.Payload.join_accept_payload.net_id",
+	"downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"downlink_message.payload.Payload.join_request_payload",
+	"downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"downlink_message.payload.Payload.join_request_payload.join_eui",
+	"downlink_message.payload.Payload.mac_payload",
+	"downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"downlink_message.payload.Payload.mac_payload.f_hdr",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"downlink_message.payload.Payload.mac_payload.f_port",
+	"downlink_message.payload.Payload.mac_payload.frm_payload",
+	"downlink_message.payload.Payload.rejoin_request_payload",
+	"downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"downlink_message.payload.m_hdr",
+	"downlink_message.payload.m_hdr.m_type",
+	"downlink_message.payload.m_hdr.major",
+	"downlink_message.payload.mic",
+	"downlink_message.raw_payload",
+	"downlink_message.settings",
+	"downlink_message.settings.request",
+	"downlink_message.settings.request.absolute_time",
+	"downlink_message.settings.request.advanced",
+	"downlink_message.settings.request.class",
+	"downlink_message.settings.request.downlink_paths",
+	"downlink_message.settings.request.priority",
+	"downlink_message.settings.request.rx1_data_rate_index",
+	"downlink_message.settings.request.rx1_delay",
+	"downlink_message.settings.request.rx1_frequency",
+	"downlink_message.settings.request.rx2_data_rate_index",
+	"downlink_message.settings.request.rx2_frequency",
+	"downlink_message.settings.scheduled",
+	"downlink_message.settings.scheduled.antenna_index",
+	"downlink_message.settings.scheduled.coding_rate",
+	"downlink_message.settings.scheduled.data_rate",
+	"downlink_message.settings.scheduled.data_rate.modulation",
+	"downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"downlink_message.settings.scheduled.data_rate_index",
+	"downlink_message.settings.scheduled.device_channel_index",
+	"downlink_message.settings.scheduled.enable_crc",
+	"downlink_message.settings.scheduled.frequency",
+	"downlink_message.settings.scheduled.gateway_channel_index",
+	"downlink_message.settings.scheduled.invert_polarization",
+	"downlink_message.settings.scheduled.time",
+	"downlink_message.settings.scheduled.timestamp",
+	"downlink_message.settings.scheduled.tx_power",
 	"result",
 }
 
 var TxAcknowledgmentFieldPathsTopLevel = []string{
 	"correlation_ids",
+	"downlink_message",
 	"result",
 }
 
@@ -440,6 +528,27 @@ func (dst *TxAcknowledgment) SetFields(src *TxAcknowledgment, paths ...string) e
 				var zero TxAcknowledgment_Result
 				dst.Result = zero
 			}
+		case "downlink_message":
+			if len(subs) > 0 {
+				newDst := dst.DownlinkMessage
+				if newDst == nil {
+					newDst = &DownlinkMessage{}
+					dst.DownlinkMessage = newDst
+				}
+				var newSrc *DownlinkMessage
+				if src != nil {
+					newSrc = src.DownlinkMessage
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkMessage = src.DownlinkMessage
+				} else {
+					dst.DownlinkMessage = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -456,6 +565,7 @@ var ApplicationUplinkFieldPathsNested = []string{
 	"rx_metadata",
 	"session_key_id",
 	"settings",
+	"settings.antenna_index",
 	"settings.coding_rate",
 	"settings.data_rate",
 	"settings.data_rate.modulation",
@@ -1146,6 +1256,7 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.uplink_message.rx_metadata",
 	"up.uplink_message.session_key_id",
 	"up.uplink_message.settings",
+	"up.uplink_message.settings.antenna_index",
 	"up.uplink_message.settings.coding_rate",
 	"up.uplink_message.settings.data_rate",
 	"up.uplink_message.settings.data_rate.modulation",
@@ -1564,3 +1675,149 @@ func (dst *DownlinkQueueRequest) SetFields(src *DownlinkQueueRequest, paths ...s
 	}
 	return nil
 }
+
+var GatewayTxAcknowledgmentFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"tx_ack",
+	"tx_ack.correlation_ids",
+	"tx_ack.downlink_message",
+	"tx_ack.downlink_message.correlation_ids",
+	"tx_ack.downlink_message.end_device_ids",
+	"tx_ack.downlink_message.end_device_ids.application_ids",
+	"tx_ack.downlink_message.end_device_ids.application_ids.application_id",
+	"tx_ack.downlink_message.end_device_ids.dev_addr",
+	"tx_ack.downlink_message.end_device_ids.dev_eui",
+	"tx_ack.downlink_message.end_device_ids.device_id",
+	"tx_ack.downlink_message.end_device_ids.join_eui",
+	"tx_ack.downlink_message.payload",
+	"tx_ack.downlink_message.payload.Payload",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.net_id",
+	"tx_ack.downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"tx_ack.downlink_message.payload.Payload.join_request_payload",
+	"tx_ack.downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"tx_ack.downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"tx_ack.downlink_message.payload.Payload.join_request_payload.join_eui",
+	"tx_ack.downlink_message.payload.Payload.mac_payload",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.f_port",
+	"tx_ack.downlink_message.payload.Payload.mac_payload.frm_payload",
+	"tx_ack.downlink_message.payload.Payload.rejoin_request_payload",
+	"tx_ack.downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"tx_ack.downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"tx_ack.downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"tx_ack.downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"tx_ack.downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"tx_ack.downlink_message.payload.m_hdr",
+	"tx_ack.downlink_message.payload.m_hdr.m_type",
+	"tx_ack.downlink_message.payload.m_hdr.major",
+	"tx_ack.downlink_message.payload.mic",
+	"tx_ack.downlink_message.raw_payload",
+	"tx_ack.downlink_message.settings",
+	"tx_ack.downlink_message.settings.request",
+	"tx_ack.downlink_message.settings.request.absolute_time",
+	"tx_ack.downlink_message.settings.request.advanced",
+	"tx_ack.downlink_message.settings.request.class",
+	"tx_ack.downlink_message.settings.request.downlink_paths",
+	"tx_ack.downlink_message.settings.request.priority",
+	"tx_ack.downlink_message.settings.request.rx1_data_rate_index",
+	"tx_ack.downlink_message.settings.request.rx1_delay",
+	"tx_ack.downlink_message.settings.request.rx1_frequency",
+	"tx_ack.downlink_message.settings.request.rx2_data_rate_index",
+	"tx_ack.downlink_message.settings.request.rx2_frequency",
+	"tx_ack.downlink_message.settings.scheduled",
+	"tx_ack.downlink_message.settings.scheduled.antenna_index",
+	"tx_ack.downlink_message.settings.scheduled.coding_rate",
+	"tx_ack.downlink_message.settings.scheduled.data_rate",
+	"tx_ack.downlink_message.settings.scheduled.data_rate.modulation",
+	"tx_ack.downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"tx_ack.downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"tx_ack.downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"tx_ack.downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"tx_ack.downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"tx_ack.downlink_message.settings.scheduled.data_rate_index",
+	"tx_ack.downlink_message.settings.scheduled.device_channel_index",
+	"tx_ack.downlink_message.settings.scheduled.enable_crc",
+	"tx_ack.downlink_message.settings.scheduled.frequency",
+	"tx_ack.downlink_message.settings.scheduled.gateway_channel_index",
+	"tx_ack.downlink_message.settings.scheduled.invert_polarization",
+	"tx_ack.downlink_message.settings.scheduled.time",
+	"tx_ack.downlink_message.settings.scheduled.timestamp",
+	"tx_ack.downlink_message.settings.scheduled.tx_power",
+	"tx_ack.result",
+}
+
+var GatewayTxAcknowledgmentFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"tx_ack",
+}
+
+func (dst *GatewayTxAcknowledgment) SetFields(src *GatewayTxAcknowledgment, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				newDst := &dst.GatewayIdentifiers
+				var newSrc *GatewayIdentifiers
+				if src != nil {
+					newSrc = &src.GatewayIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIdentifiers = src.GatewayIdentifiers
+				} else {
+					var zero GatewayIdentifiers
+					dst.GatewayIdentifiers = zero
+				}
+			}
+		case "tx_ack":
+			if len(subs) > 0 {
+				newDst := &dst.TxAck
+				var newSrc *TxAcknowledgment
+				if src != nil {
+					newSrc = &src.TxAck
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.TxAck = src.TxAck
+				} else {
+					var zero TxAcknowledgment
+					dst.TxAck = zero
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
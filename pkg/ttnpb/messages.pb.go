@@ -84,6 +84,7 @@ const (
 	TxAcknowledgment_TX_FREQ          TxAcknowledgment_Result = 6
 	TxAcknowledgment_TX_POWER         TxAcknowledgment_Result = 7
 	TxAcknowledgment_GPS_UNLOCKED     TxAcknowledgment_Result = 8
+	TxAcknowledgment_CHANNEL_BUSY     TxAcknowledgment_Result = 9
 )
 
 var TxAcknowledgment_Result_name = map[int32]string{
@@ -96,6 +97,7 @@ var TxAcknowledgment_Result_name = map[int32]string{
 	6: "TX_FREQ",
 	7: "TX_POWER",
 	8: "GPS_UNLOCKED",
+	9: "CHANNEL_BUSY",
 }
 var TxAcknowledgment_Result_value = map[string]int32{
 	"SUCCESS":          0,
@@ -107,6 +109,7 @@ var TxAcknowledgment_Result_value = map[string]int32{
 	"TX_FREQ":          6,
 	"TX_POWER":         7,
 	"GPS_UNLOCKED":     8,
+	"CHANNEL_BUSY":     9,
 }
 
 func (TxAcknowledgment_Result) EnumDescriptor() ([]byte, []int) {
@@ -388,10 +391,12 @@ func _DownlinkMessage_OneofSizer(msg proto.Message) (n int) {
 }
 
 type TxAcknowledgment struct {
-	CorrelationIDs       []string                `protobuf:"bytes,1,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
-	Result               TxAcknowledgment_Result `protobuf:"varint,2,opt,name=result,proto3,enum=ttn.lorawan.v3.TxAcknowledgment_Result" json:"result,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	CorrelationIDs []string                `protobuf:"bytes,1,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	Result         TxAcknowledgment_Result `protobuf:"varint,2,opt,name=result,proto3,enum=ttn.lorawan.v3.TxAcknowledgment_Result" json:"result,omitempty"`
+	// The acknowledged downlink message. Set by the Gateway Server.
+	DownlinkMessage      *DownlinkMessage `protobuf:"bytes,3,opt,name=downlink_message,json=downlinkMessage,proto3" json:"downlink_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *TxAcknowledgment) Reset()      { *m = TxAcknowledgment{} }
@@ -440,6 +445,13 @@ func (m *TxAcknowledgment) GetResult() TxAcknowledgment_Result {
 	return TxAcknowledgment_SUCCESS
 }
 
+func (m *TxAcknowledgment) GetDownlinkMessage() *DownlinkMessage {
+	if m != nil {
+		return m.DownlinkMessage
+	}
+	return nil
+}
+
 type ApplicationUplink struct {
 	// Join Server issued identifier for the session keys used by this uplink.
 	SessionKeyID         []byte        `protobuf:"bytes,1,opt,name=session_key_id,json=sessionKeyId,proto3" json:"session_key_id,omitempty"`
@@ -1479,6 +1491,52 @@ func (m *DownlinkQueueRequest) GetDownlinks() []*ApplicationDownlink {
 	return nil
 }
 
+type GatewayTxAcknowledgment struct {
+	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	TxAck                TxAcknowledgment `protobuf:"bytes,2,opt,name=tx_ack,json=txAck,proto3" json:"tx_ack"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GatewayTxAcknowledgment) Reset()      { *m = GatewayTxAcknowledgment{} }
+func (*GatewayTxAcknowledgment) ProtoMessage() {}
+func (*GatewayTxAcknowledgment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_messages_e6e0b619399f62ae, []int{13}
+}
+func (m *GatewayTxAcknowledgment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayTxAcknowledgment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GatewayTxAcknowledgment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *GatewayTxAcknowledgment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayTxAcknowledgment.Merge(dst, src)
+}
+func (m *GatewayTxAcknowledgment) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayTxAcknowledgment) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayTxAcknowledgment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayTxAcknowledgment proto.InternalMessageInfo
+
+func (m *GatewayTxAcknowledgment) GetTxAck() TxAcknowledgment {
+	if m != nil {
+		return m.TxAck
+	}
+	return TxAcknowledgment{}
+}
+
 func init() {
 	proto.RegisterType((*UplinkMessage)(nil), "ttn.lorawan.v3.UplinkMessage")
 	golang_proto.RegisterType((*UplinkMessage)(nil), "ttn.lorawan.v3.UplinkMessage")
@@ -1514,6 +1572,8 @@ func init() {
 	golang_proto.RegisterEnum("ttn.lorawan.v3.PayloadFormatter", PayloadFormatter_name, PayloadFormatter_value)
 	proto.RegisterEnum("ttn.lorawan.v3.TxAcknowledgment_Result", TxAcknowledgment_Result_name, TxAcknowledgment_Result_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.TxAcknowledgment_Result", TxAcknowledgment_Result_name, TxAcknowledgment_Result_value)
+	proto.RegisterType((*GatewayTxAcknowledgment)(nil), "ttn.lorawan.v3.GatewayTxAcknowledgment")
+	golang_proto.RegisterType((*GatewayTxAcknowledgment)(nil), "ttn.lorawan.v3.GatewayTxAcknowledgment")
 }
 func (x PayloadFormatter) String() string {
 	s, ok := PayloadFormatter_name[int32(x)]
@@ -1703,6 +1763,9 @@ func (this *TxAcknowledgment) Equal(that interface{}) bool {
 	if this.Result != that1.Result {
 		return false
 	}
+	if !this.DownlinkMessage.Equal(that1.DownlinkMessage) {
+		return false
+	}
 	return true
 }
 func (this *ApplicationUplink) Equal(that interface{}) bool {
@@ -2324,6 +2387,33 @@ func (this *DownlinkQueueRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *GatewayTxAcknowledgment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GatewayTxAcknowledgment)
+	if !ok {
+		that2, ok := that.(GatewayTxAcknowledgment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.GatewayIdentifiers.Equal(&that1.GatewayIdentifiers) {
+		return false
+	}
+	if !this.TxAck.Equal(&that1.TxAck) {
+		return false
+	}
+	return true
+}
 func (m *UplinkMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2530,6 +2620,16 @@ func (m *TxAcknowledgment) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.Result))
 	}
+	if m.DownlinkMessage != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.DownlinkMessage.Size()))
+		n30, err := m.DownlinkMessage.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
 	return i, nil
 }
 
@@ -3189,6 +3289,40 @@ func (m *DownlinkQueueRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *GatewayTxAcknowledgment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayTxAcknowledgment) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintMessages(dAtA, i, uint64(m.GatewayIdentifiers.Size()))
+	n31, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n31
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintMessages(dAtA, i, uint64(m.TxAck.Size()))
+	n32, err := m.TxAck.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n32
+	return i, nil
+}
+
 func encodeVarintMessages(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -3206,6 +3340,9 @@ func NewPopulatedTxAcknowledgment(r randyMessages, easy bool) *TxAcknowledgment
 		this.CorrelationIDs[i] = randStringMessages(r)
 	}
 	this.Result = TxAcknowledgment_Result([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8}[r.Intn(9)])
+	if r.Intn(10) != 0 {
+		this.DownlinkMessage = NewPopulatedDownlinkMessage(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3445,6 +3582,16 @@ func NewPopulatedDownlinkQueueRequest(r randyMessages, easy bool) *DownlinkQueue
 	}
 	return this
 }
+func NewPopulatedGatewayTxAcknowledgment(r randyMessages, easy bool) *GatewayTxAcknowledgment {
+	this := &GatewayTxAcknowledgment{}
+	v33 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v33
+	v34 := NewPopulatedTxAcknowledgment(r, easy)
+	this.TxAck = *v34
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
 
 type randyMessages interface {
 	Float32() float32
@@ -3620,6 +3767,10 @@ func (m *TxAcknowledgment) Size() (n int) {
 	if m.Result != 0 {
 		n += 1 + sovMessages(uint64(m.Result))
 	}
+	if m.DownlinkMessage != nil {
+		l = m.DownlinkMessage.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -3982,6 +4133,18 @@ func (m *DownlinkQueueRequest) Size() (n int) {
 	}
 	return n
 }
+func (m *GatewayTxAcknowledgment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GatewayIdentifiers.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	l = m.TxAck.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	return n
+}
 
 func sovMessages(x uint64) (n int) {
 	for {
@@ -4052,6 +4215,7 @@ func (this *TxAcknowledgment) String() string {
 	s := strings.Join([]string{`&TxAcknowledgment{`,
 		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
 		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`DownlinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkMessage), "DownlinkMessage", "DownlinkMessage", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4294,6 +4458,17 @@ func (this *DownlinkQueueRequest) String() string {
 	}, "")
 	return s
 }
+func (this *GatewayTxAcknowledgment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayTxAcknowledgment{`,
+		`GatewayIdentifiers:` + strings.Replace(strings.Replace(this.GatewayIdentifiers.String(), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
+		`TxAck:` + strings.Replace(strings.Replace(this.TxAck.String(), "TxAcknowledgment", "TxAcknowledgment", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessages(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -4853,6 +5028,39 @@ func (m *TxAcknowledgment) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkMessage == nil {
+				m.DownlinkMessage = &DownlinkMessage{}
+			}
+			if err := m.DownlinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -6853,6 +7061,116 @@ func (m *DownlinkQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GatewayTxAcknowledgment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayTxAcknowledgment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayTxAcknowledgment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GatewayIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxAck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxAck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessages(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6966,122 +7284,126 @@ func init() {
 }
 
 var fileDescriptor_messages_e6e0b619399f62ae = []byte{
-	// 1857 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xde, 0xa5, 0x28, 0x91, 0x7c, 0xa4, 0xa8, 0xcd, 0x44, 0x71, 0x19, 0xd5, 0x5d, 0xaa, 0x4c,
-	0x83, 0x38, 0x6d, 0x4d, 0xa2, 0x76, 0x9b, 0x1a, 0x4e, 0xff, 0x28, 0x6a, 0x25, 0xd1, 0x92, 0x49,
-	0x66, 0x48, 0x27, 0x76, 0x51, 0x60, 0xb1, 0xda, 0x1d, 0xd2, 0x1b, 0x92, 0xbb, 0x9b, 0xdd, 0xa1,
-	0x64, 0xde, 0x02, 0x14, 0x45, 0x03, 0xe4, 0xe2, 0x5b, 0x73, 0x2a, 0x8c, 0x9e, 0x72, 0x6b, 0x7a,
-	0xf3, 0x31, 0x47, 0x1f, 0x7d, 0xcc, 0x49, 0x8d, 0x28, 0x14, 0xf0, 0xd1, 0xc7, 0x1c, 0x8b, 0x9d,
-	0x9d, 0x25, 0x97, 0x14, 0xa1, 0x50, 0x29, 0x72, 0xe9, 0x49, 0x9c, 0x79, 0xef, 0xfb, 0xde, 0x9b,
-	0x37, 0xef, 0xbd, 0x79, 0x2b, 0xd8, 0xec, 0xd9, 0xae, 0x76, 0xac, 0x59, 0xd7, 0x3d, 0xaa, 0xe9,
-	0xdd, 0x92, 0xe6, 0x98, 0xa5, 0x3e, 0xf1, 0x3c, 0xad, 0x43, 0xbc, 0xa2, 0xe3, 0xda, 0xd4, 0x46,
-	0x59, 0x4a, 0xad, 0x22, 0xd7, 0x2a, 0x1e, 0xdd, 0xdc, 0xb8, 0xde, 0x31, 0xe9, 0xc3, 0xc1, 0x61,
-	0x51, 0xb7, 0xfb, 0xa5, 0x8e, 0xdd, 0xb1, 0x4b, 0x4c, 0xed, 0x70, 0xd0, 0x66, 0x2b, 0xb6, 0x60,
-	0xbf, 0x02, 0xf8, 0xc6, 0x3b, 0x11, 0xf5, 0xfe, 0xb1, 0x49, 0xbb, 0xf6, 0x71, 0xa9, 0x63, 0x5f,
-	0x67, 0xc2, 0xeb, 0x47, 0x5a, 0xcf, 0x34, 0x34, 0x6a, 0xbb, 0x5e, 0x69, 0xfc, 0x93, 0xe3, 0xae,
-	0x76, 0x6c, 0xbb, 0xd3, 0x23, 0x13, 0x76, 0x8f, 0xba, 0x03, 0x9d, 0x72, 0x69, 0x7e, 0x56, 0x4a,
-	0xcd, 0x3e, 0xf1, 0xa8, 0xd6, 0x77, 0xb8, 0xc2, 0x8f, 0xce, 0x9f, 0x8b, 0xb8, 0xee, 0x98, 0xfd,
-	0x8d, 0xf3, 0x62, 0xd3, 0x20, 0x16, 0x35, 0xdb, 0x26, 0x71, 0xbd, 0xd0, 0x85, 0xf3, 0x4a, 0x5d,
-	0x32, 0x0c, 0xa5, 0xf9, 0xf3, 0xd2, 0x30, 0x4a, 0x81, 0xc2, 0xdc, 0xd0, 0x52, 0xcd, 0xd0, 0xa8,
-	0x16, 0x68, 0x14, 0x5e, 0xc4, 0x60, 0xf5, 0x9e, 0xd3, 0x33, 0xad, 0xee, 0xdd, 0x20, 0xe6, 0x28,
-	0x0f, 0x69, 0x57, 0x3b, 0x56, 0x1d, 0x6d, 0xd8, 0xb3, 0x35, 0x23, 0x27, 0x6e, 0x8a, 0xd7, 0x32,
-	0x18, 0x5c, 0xed, 0xb8, 0x11, 0xec, 0xa0, 0x5f, 0x40, 0x22, 0x14, 0xc6, 0x36, 0xc5, 0x6b, 0xe9,
-	0x1b, 0x3f, 0x28, 0x4e, 0xdf, 0x4f, 0x91, 0x53, 0xe1, 0x50, 0x0f, 0xfd, 0x06, 0x92, 0x1e, 0xa1,
-	0xd4, 0xb4, 0x3a, 0x5e, 0x2e, 0xce, 0x30, 0x1b, 0xb3, 0x98, 0xd6, 0xa3, 0x26, 0xd7, 0xd8, 0x8a,
-	0x3f, 0x3b, 0xc9, 0x0b, 0x78, 0x8c, 0x40, 0xef, 0x42, 0xda, 0x7d, 0xa4, 0x86, 0x8e, 0xe7, 0x96,
-	0x37, 0x97, 0xe6, 0x11, 0xe0, 0x47, 0x77, 0xb9, 0x06, 0x06, 0x77, 0xfc, 0x1b, 0x29, 0x90, 0x76,
-	0x89, 0x4e, 0xcc, 0x23, 0x62, 0xa8, 0x1a, 0xcd, 0xad, 0x70, 0xeb, 0xc1, 0xe5, 0x15, 0xc3, 0xcb,
-	0x2b, 0xb6, 0xc2, 0xcb, 0xdb, 0x4a, 0xfa, 0xd6, 0x1f, 0xff, 0x3b, 0x2f, 0x62, 0x08, 0x81, 0x65,
-	0x8a, 0xde, 0x85, 0x35, 0xdd, 0x76, 0x5d, 0xd2, 0xd3, 0xa8, 0x69, 0x5b, 0xaa, 0x69, 0x78, 0xb9,
-	0xc4, 0xe6, 0xd2, 0xb5, 0xd4, 0x16, 0x1a, 0x9d, 0xe4, 0xb3, 0x95, 0x89, 0xa8, 0xba, 0xed, 0xe1,
-	0x6c, 0x44, 0xb5, 0x6a, 0x78, 0xb7, 0xe3, 0x4f, 0x9f, 0xe4, 0x85, 0xc2, 0x5f, 0x96, 0x60, 0x6d,
-	0xdb, 0x3e, 0xb6, 0xbe, 0xef, 0x60, 0xff, 0x09, 0xb2, 0xc4, 0x32, 0x54, 0x83, 0x1c, 0x99, 0x3a,
-	0x61, 0x9e, 0x2e, 0x31, 0xe4, 0x4f, 0x66, 0x91, 0x8a, 0x65, 0x6c, 0x33, 0xa5, 0xea, 0x24, 0xef,
-	0xb6, 0xa4, 0xd1, 0x49, 0x3e, 0x33, 0x91, 0x6c, 0x7b, 0x38, 0x43, 0x26, 0x7a, 0x1e, 0xfa, 0x15,
-	0x24, 0x5c, 0xf2, 0xd1, 0x80, 0x78, 0x94, 0xdf, 0xe4, 0xeb, 0xe7, 0x6f, 0x12, 0x07, 0x0a, 0x7b,
-	0x02, 0x0e, 0x75, 0xd1, 0x6d, 0x48, 0x79, 0xfa, 0x43, 0x62, 0x0c, 0x7a, 0xc4, 0xc8, 0x2d, 0x7f,
-	0x5b, 0x0a, 0xec, 0x09, 0x78, 0xa2, 0x3e, 0x2f, 0xf6, 0x2b, 0x97, 0x8b, 0xfd, 0x16, 0x4c, 0x12,
-	0xb0, 0xf0, 0xaf, 0x18, 0x48, 0xad, 0x47, 0x65, 0xbd, 0x6b, 0xd9, 0xc7, 0x3d, 0x62, 0x74, 0xfa,
-	0xc4, 0x9a, 0x7b, 0xbf, 0xe2, 0xa2, 0x36, 0xd0, 0xef, 0x61, 0xc5, 0x25, 0xde, 0xa0, 0x47, 0xd9,
-	0x1d, 0x65, 0x6f, 0xbc, 0x75, 0xfe, 0x64, 0xd3, 0xe6, 0x8a, 0x98, 0xa9, 0x63, 0x0e, 0x2b, 0xfc,
-	0x5d, 0x84, 0x95, 0x60, 0x0b, 0xa5, 0x21, 0xd1, 0xbc, 0x57, 0xa9, 0x28, 0xcd, 0xa6, 0x24, 0xa0,
-	0x57, 0x60, 0xf5, 0x5e, 0x6d, 0xbf, 0x56, 0xff, 0xa0, 0xa6, 0x2a, 0x18, 0xd7, 0xb1, 0x24, 0xa2,
-	0x0c, 0x24, 0x5b, 0xf5, 0xba, 0x7a, 0x50, 0x6e, 0x29, 0x52, 0x0c, 0xad, 0x42, 0xca, 0x5f, 0x29,
-	0x65, 0x7c, 0xf0, 0x40, 0x5a, 0x42, 0xeb, 0x20, 0x55, 0xea, 0x07, 0x07, 0xd5, 0x66, 0xb5, 0x5e,
-	0x53, 0x1b, 0xe5, 0xca, 0xbe, 0xd2, 0x92, 0xe2, 0xd3, 0xbb, 0x5b, 0x4a, 0xb9, 0x52, 0xaf, 0x49,
-	0xcb, 0xbe, 0xa1, 0xd6, 0x7d, 0x75, 0x07, 0x2b, 0xef, 0x49, 0x2b, 0x8c, 0xf5, 0xbe, 0xda, 0xa8,
-	0x7f, 0xa0, 0x60, 0x29, 0x81, 0x24, 0xc8, 0xec, 0x36, 0x9a, 0xea, 0xbd, 0xda, 0x41, 0xbd, 0xb2,
-	0xaf, 0x6c, 0x4b, 0xc9, 0xc2, 0x7f, 0x62, 0xf0, 0x4a, 0xd9, 0x71, 0x7a, 0xa6, 0xce, 0x0e, 0x1d,
-	0x74, 0x0c, 0xf4, 0x0e, 0x64, 0x3d, 0xe2, 0x79, 0x7e, 0xc0, 0xba, 0x64, 0xa8, 0x9a, 0x3c, 0x81,
-	0x83, 0x1c, 0x6a, 0x06, 0x92, 0x7d, 0x32, 0xac, 0x6e, 0xe3, 0x8c, 0x37, 0x59, 0x19, 0xe8, 0x35,
-	0x58, 0x69, 0xab, 0x8e, 0xed, 0x06, 0xf1, 0x5a, 0xc5, 0xcb, 0xed, 0x86, 0xed, 0x52, 0xf4, 0x2a,
-	0x2c, 0xb7, 0x55, 0xdd, 0xa2, 0x2c, 0x5f, 0x57, 0x71, 0xbc, 0x5d, 0xb1, 0x28, 0x2a, 0x41, 0xba,
-	0xed, 0xf6, 0xc7, 0x15, 0x12, 0x67, 0x06, 0xb2, 0xa3, 0x93, 0x3c, 0xec, 0xe0, 0xbb, 0xbc, 0x4a,
-	0x30, 0xb4, 0xdd, 0x7e, 0x58, 0x31, 0x7f, 0x80, 0x35, 0x83, 0xe8, 0xb6, 0x41, 0x8c, 0x31, 0x68,
-	0x99, 0x57, 0xce, 0x6c, 0xd1, 0x37, 0x59, 0x3f, 0xc7, 0x59, 0xae, 0x1f, 0x32, 0xcc, 0xf4, 0x9b,
-	0x95, 0x4b, 0xf5, 0x9b, 0x68, 0xab, 0x4b, 0x5c, 0xb6, 0xd5, 0x15, 0xfe, 0x1c, 0x83, 0x57, 0x23,
-	0x71, 0x3e, 0xb0, 0x83, 0xbf, 0x28, 0x07, 0x09, 0x8f, 0xb8, 0x7e, 0x0d, 0xb2, 0x10, 0xa7, 0x70,
-	0xb8, 0x44, 0xbf, 0x83, 0x64, 0x8f, 0x6b, 0xf1, 0x0e, 0x91, 0x9b, 0xb5, 0x17, 0xb2, 0x04, 0xad,
-	0xed, 0xf9, 0x49, 0x5e, 0xc4, 0x63, 0x0c, 0x6a, 0x02, 0x68, 0x94, 0xba, 0xe6, 0xe1, 0x80, 0x12,
-	0xbf, 0x53, 0xf8, 0x67, 0xbd, 0x39, 0xcb, 0x30, 0xc7, 0xa5, 0x62, 0x79, 0x8c, 0x52, 0x2c, 0xea,
-	0x0e, 0x71, 0x84, 0x66, 0xe3, 0xb7, 0xb0, 0x36, 0x23, 0x46, 0x12, 0x2c, 0x75, 0xc9, 0x90, 0x7b,
-	0xef, 0xff, 0x44, 0xeb, 0xb0, 0x7c, 0xa4, 0xf5, 0x06, 0x84, 0xb9, 0x9d, 0xc2, 0xc1, 0xe2, 0x76,
-	0xec, 0x96, 0x58, 0xf8, 0x34, 0x06, 0xaf, 0x45, 0x4c, 0xde, 0xb1, 0x4d, 0xab, 0xac, 0xeb, 0xc4,
-	0xa1, 0xdf, 0x39, 0xe3, 0x7e, 0x0d, 0x29, 0xcd, 0x71, 0x54, 0xcf, 0x47, 0xf1, 0x30, 0xfd, 0x70,
-	0xf6, 0x90, 0xfb, 0x64, 0xa8, 0x58, 0x47, 0xa4, 0x67, 0x3b, 0x04, 0x27, 0x34, 0xc7, 0x69, 0xee,
-	0x93, 0x21, 0xba, 0x0f, 0xaf, 0x99, 0x16, 0x1f, 0x0c, 0x88, 0xa1, 0x1a, 0xbc, 0x7f, 0x87, 0x91,
-	0x7a, 0xe3, 0x82, 0x48, 0x85, 0xbd, 0x1e, 0xaf, 0x47, 0x18, 0xc2, 0x4d, 0x0f, 0xbd, 0x05, 0x6b,
-	0x0e, 0xb1, 0x0c, 0xd3, 0xea, 0xa8, 0xdc, 0x55, 0x96, 0xdc, 0x49, 0x9c, 0xe5, 0xdb, 0xfc, 0x38,
-	0x85, 0x97, 0xf1, 0xa9, 0x9c, 0x08, 0x19, 0xfe, 0x5f, 0xab, 0xef, 0x2a, 0xa4, 0x74, 0xdb, 0x6a,
-	0x9b, 0x6e, 0x9f, 0x18, 0xec, 0xb9, 0x4e, 0xe2, 0xc9, 0x06, 0xda, 0x85, 0x94, 0xde, 0xd3, 0x3c,
-	0x4f, 0x3d, 0x54, 0x75, 0x5e, 0x5f, 0x3f, 0x5b, 0xe0, 0x0e, 0x8a, 0x15, 0x1f, 0xb4, 0x55, 0xc1,
-	0x09, 0x3d, 0xf8, 0xe1, 0xd7, 0x8d, 0xe3, 0x9a, 0xb6, 0x6b, 0xd2, 0x61, 0x2e, 0xc9, 0xba, 0x76,
-	0x61, 0x4e, 0x9d, 0xf2, 0x37, 0xa8, 0xc1, 0x35, 0xf1, 0x18, 0x33, 0xef, 0xc1, 0x48, 0x2d, 0xfa,
-	0x60, 0x6c, 0xfc, 0x4d, 0x84, 0x04, 0xf7, 0x08, 0x29, 0x90, 0xec, 0x68, 0x94, 0x1c, 0x6b, 0xc3,
-	0x60, 0xa4, 0x48, 0xdf, 0x78, 0x7b, 0xd6, 0x91, 0xdd, 0x40, 0x5e, 0xb6, 0x28, 0xb1, 0x2c, 0x2d,
-	0xf2, 0x5a, 0xe3, 0x31, 0x14, 0x29, 0xb0, 0xaa, 0x1d, 0x7a, 0x76, 0x6f, 0x40, 0x89, 0xea, 0x4f,
-	0xa2, 0xec, 0x50, 0x17, 0x4f, 0x3a, 0x71, 0x36, 0xe5, 0x64, 0x42, 0x98, 0x2f, 0xe0, 0xa3, 0xca,
-	0x03, 0x58, 0x9f, 0x13, 0x44, 0x0f, 0x95, 0x21, 0x35, 0xa9, 0x00, 0x71, 0xf1, 0x0a, 0x98, 0xa0,
-	0x0a, 0x4f, 0x44, 0x78, 0x7d, 0x8e, 0xca, 0x8e, 0x66, 0xfa, 0x4f, 0x7d, 0x15, 0x92, 0xa1, 0x2a,
-	0xcb, 0xe6, 0xc5, 0xf8, 0xa3, 0x8d, 0x2d, 0x84, 0xa3, 0x5b, 0xb0, 0xcc, 0xc6, 0x6d, 0x5e, 0xee,
-	0x57, 0xcf, 0x4d, 0x3f, 0xbe, 0x70, 0x9b, 0x50, 0xcd, 0xec, 0x85, 0x7d, 0x38, 0x00, 0x14, 0x3e,
-	0x15, 0x21, 0x1f, 0xb1, 0x52, 0x9d, 0x57, 0xbd, 0xff, 0x7b, 0x24, 0xd0, 0x9b, 0xb0, 0xd6, 0xd3,
-	0x3c, 0xaa, 0xb2, 0xaa, 0x63, 0x9d, 0x85, 0x17, 0x64, 0xc6, 0xdf, 0xde, 0xa9, 0x58, 0xd4, 0x47,
-	0x15, 0xfe, 0x9a, 0x80, 0xd5, 0xa9, 0xa7, 0x17, 0xb5, 0xce, 0x0d, 0x78, 0xe2, 0x25, 0x06, 0xbc,
-	0x49, 0xac, 0xa6, 0x07, 0xbb, 0x39, 0x09, 0x1d, 0x5b, 0x78, 0x02, 0xba, 0x03, 0xd9, 0x01, 0x9b,
-	0x09, 0x54, 0xfe, 0xe9, 0xc6, 0x67, 0xce, 0x1f, 0x5f, 0x10, 0x93, 0x60, 0x88, 0xd8, 0x13, 0xf0,
-	0xea, 0x60, 0xea, 0x03, 0x64, 0x0f, 0xd2, 0x1f, 0xda, 0xa6, 0xa5, 0x6a, 0xac, 0xe5, 0xf3, 0x29,
-	0xf3, 0xcd, 0x0b, 0x88, 0x26, 0xef, 0xc3, 0x9e, 0x80, 0xe1, 0xc3, 0xc9, 0x6b, 0xb1, 0x07, 0x99,
-	0x30, 0xdc, 0xaa, 0xa6, 0x77, 0x79, 0x27, 0x5a, 0xe4, 0x9e, 0xf6, 0x04, 0x9c, 0x0e, 0xa1, 0x65,
-	0xbd, 0x8b, 0xee, 0xc0, 0xea, 0x98, 0xc9, 0xf2, 0xa9, 0x56, 0x2e, 0x43, 0x35, 0xf6, 0xa2, 0xa6,
-	0xcd, 0x70, 0x79, 0xc4, 0xa2, 0xbc, 0x8d, 0x5d, 0x96, 0xab, 0xe9, 0x8f, 0xad, 0x2d, 0x58, 0x1b,
-	0x73, 0xb5, 0x59, 0x09, 0xf1, 0xba, 0x7f, 0x7b, 0x01, 0xb6, 0xa0, 0xe6, 0xf6, 0x04, 0x9c, 0x35,
-	0xa6, 0xab, 0xb0, 0x16, 0x61, 0xfd, 0x68, 0x40, 0x06, 0xc4, 0xc8, 0xa5, 0x2e, 0xe3, 0xe3, 0x98,
-	0xef, 0x3d, 0x06, 0x46, 0x36, 0x6c, 0x4c, 0xf3, 0xa9, 0x91, 0x17, 0x31, 0x07, 0x8c, 0xba, 0x74,
-	0x01, 0xf5, 0xbc, 0x0a, 0xdc, 0x13, 0x70, 0x6e, 0xca, 0x4c, 0x44, 0xc9, 0x3f, 0x40, 0x38, 0xe0,
-	0xa8, 0x9e, 0xdd, 0x3b, 0x22, 0x46, 0x2e, 0xfd, 0xad, 0x07, 0x08, 0x27, 0x1b, 0xff, 0x00, 0x21,
-	0xba, 0xc9, 0xc0, 0x5b, 0x71, 0x88, 0x0d, 0x9c, 0xc2, 0x67, 0x31, 0xc8, 0xf1, 0x24, 0xe5, 0x8f,
-	0xd5, 0x8e, 0xed, 0xf6, 0x35, 0x4a, 0x89, 0xeb, 0xa1, 0x0a, 0x64, 0x06, 0x8e, 0xda, 0x0e, 0x37,
-	0x58, 0x49, 0x66, 0x6f, 0x6c, 0xce, 0xda, 0x9b, 0x05, 0xe2, 0xf4, 0xc0, 0x19, 0x2f, 0xd0, 0x2f,
-	0xe1, 0x4a, 0x94, 0x44, 0x75, 0x34, 0x57, 0xeb, 0x13, 0x9f, 0x2e, 0x98, 0x91, 0xd6, 0x23, 0xca,
-	0x8d, 0x50, 0x86, 0x76, 0x81, 0x05, 0x3c, 0x62, 0x7c, 0x69, 0x41, 0xe3, 0x2c, 0x11, 0x27, 0xe6,
-	0x6f, 0x41, 0x6e, 0x9a, 0x28, 0xe2, 0x40, 0x9c, 0x39, 0x70, 0x65, 0x0a, 0x30, 0x76, 0xa1, 0xf0,
-	0x4f, 0x11, 0xd6, 0xb7, 0xa3, 0xb7, 0xc1, 0x3f, 0x01, 0xbf, 0xa7, 0x5e, 0x35, 0xd5, 0x7d, 0x63,
-	0xdf, 0xa5, 0xfb, 0xfe, 0xf4, 0xb1, 0x08, 0xd2, 0x6c, 0x3c, 0x10, 0x82, 0xec, 0x4e, 0x1d, 0xdf,
-	0x2d, 0xb7, 0x5a, 0x0a, 0x56, 0x6b, 0xf5, 0x9a, 0x22, 0x09, 0x28, 0x07, 0xeb, 0x93, 0x3d, 0xac,
-	0x34, 0xea, 0xcd, 0x6a, 0xab, 0x8e, 0x1f, 0x48, 0x22, 0xda, 0x80, 0x2b, 0x13, 0xc9, 0x2e, 0x6e,
-	0x54, 0xd4, 0xa6, 0x82, 0xdf, 0xaf, 0x56, 0xfc, 0x0f, 0xb3, 0x29, 0xd4, 0x9d, 0xf2, 0xfb, 0xe5,
-	0x66, 0x05, 0x57, 0x1b, 0x2d, 0x69, 0x69, 0x5a, 0x52, 0x29, 0x3f, 0x50, 0x6a, 0x35, 0xe5, 0xa0,
-	0xd1, 0x90, 0xe2, 0x5b, 0xff, 0x10, 0x9f, 0x9d, 0xca, 0xe2, 0xf3, 0x53, 0x59, 0xfc, 0xea, 0x54,
-	0x16, 0xbe, 0x3e, 0x95, 0x85, 0x17, 0xa7, 0xb2, 0xf0, 0xf2, 0x54, 0x16, 0xbe, 0x39, 0x95, 0xc5,
-	0x8f, 0x47, 0xb2, 0xf8, 0xc9, 0x48, 0x16, 0x3e, 0x1f, 0xc9, 0xe2, 0x17, 0x23, 0x59, 0x78, 0x3a,
-	0x92, 0x85, 0x2f, 0x47, 0xb2, 0xf0, 0x6c, 0x24, 0x8b, 0xcf, 0x47, 0xb2, 0xf8, 0xd5, 0x48, 0x16,
-	0xbe, 0x1e, 0xc9, 0xe2, 0x8b, 0x91, 0x2c, 0xbc, 0x1c, 0xc9, 0xe2, 0x37, 0x23, 0x59, 0xf8, 0xf8,
-	0x4c, 0x16, 0x3e, 0x39, 0x93, 0xc5, 0xc7, 0x67, 0xb2, 0xf0, 0xd9, 0x99, 0x2c, 0x3e, 0x39, 0x93,
-	0x85, 0xcf, 0xcf, 0x64, 0xe1, 0x8b, 0x33, 0x59, 0x7c, 0x7a, 0x26, 0x8b, 0x5f, 0x9e, 0xc9, 0xe2,
-	0x1f, 0x7f, 0xde, 0xb1, 0x8b, 0xf4, 0x21, 0xa1, 0x0f, 0xfd, 0x2f, 0x90, 0xa2, 0x45, 0xe8, 0xb1,
-	0xed, 0x76, 0x4b, 0xd3, 0xff, 0x39, 0x72, 0xba, 0x9d, 0x12, 0xa5, 0x96, 0x73, 0x78, 0xb8, 0xc2,
-	0x06, 0x89, 0x9b, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x02, 0xcf, 0xed, 0xb1, 0xb6, 0x13, 0x00,
-	0x00,
+	// 1930 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4d, 0x70, 0xdb, 0xc6,
+	0x15, 0x06, 0x28, 0x8a, 0x3f, 0x8f, 0x14, 0x85, 0x6c, 0x64, 0x87, 0x51, 0x5d, 0x50, 0x65, 0x9a,
+	0x89, 0xd3, 0xd6, 0xe4, 0xd4, 0x6e, 0x53, 0x8f, 0xd3, 0xb4, 0x25, 0x29, 0xc8, 0xa4, 0x25, 0x93,
+	0xcc, 0x92, 0x4a, 0xac, 0x4e, 0x67, 0x30, 0x10, 0xb0, 0xa4, 0x11, 0x92, 0x00, 0x02, 0x2c, 0x25,
+	0xf1, 0x96, 0x99, 0x4e, 0xa7, 0x99, 0xc9, 0xa1, 0xbe, 0x35, 0x47, 0x4f, 0x2f, 0xcd, 0xad, 0x3e,
+	0xfa, 0x98, 0xa3, 0x8f, 0x3e, 0xe6, 0xa4, 0x46, 0xd4, 0x74, 0xc6, 0x47, 0x1f, 0x73, 0xec, 0x00,
+	0x58, 0x90, 0x20, 0xc5, 0xd8, 0x92, 0x3b, 0xb9, 0xf4, 0x44, 0xec, 0xbe, 0xf7, 0xbe, 0xf7, 0xf6,
+	0xbd, 0x7d, 0x3f, 0x4b, 0xd8, 0xe8, 0x9b, 0xb6, 0x72, 0xa8, 0x18, 0xd7, 0x1c, 0xaa, 0xa8, 0xbd,
+	0xa2, 0x62, 0xe9, 0xc5, 0x01, 0x71, 0x1c, 0xa5, 0x4b, 0x9c, 0x82, 0x65, 0x9b, 0xd4, 0x44, 0x19,
+	0x4a, 0x8d, 0x02, 0xe3, 0x2a, 0x1c, 0xdc, 0x58, 0xbf, 0xd6, 0xd5, 0xe9, 0xfd, 0xe1, 0x7e, 0x41,
+	0x35, 0x07, 0xc5, 0xae, 0xd9, 0x35, 0x8b, 0x1e, 0xdb, 0xfe, 0xb0, 0xe3, 0xad, 0xbc, 0x85, 0xf7,
+	0xe5, 0x8b, 0xaf, 0xbf, 0x17, 0x62, 0x1f, 0x1c, 0xea, 0xb4, 0x67, 0x1e, 0x16, 0xbb, 0xe6, 0x35,
+	0x8f, 0x78, 0xed, 0x40, 0xe9, 0xeb, 0x9a, 0x42, 0x4d, 0xdb, 0x29, 0x4e, 0x3e, 0x99, 0xdc, 0x95,
+	0xae, 0x69, 0x76, 0xfb, 0x64, 0x8a, 0xee, 0x50, 0x7b, 0xa8, 0x52, 0x46, 0xcd, 0xcd, 0x53, 0xa9,
+	0x3e, 0x20, 0x0e, 0x55, 0x06, 0x16, 0x63, 0xf8, 0xf1, 0xd9, 0x73, 0x11, 0xdb, 0x9e, 0xa0, 0xbf,
+	0x75, 0x96, 0xac, 0x6b, 0xc4, 0xa0, 0x7a, 0x47, 0x27, 0xb6, 0x13, 0x98, 0x70, 0x96, 0xa9, 0x47,
+	0x46, 0x01, 0x35, 0x77, 0x96, 0x1a, 0x78, 0xc9, 0x67, 0x58, 0xe8, 0x5a, 0xaa, 0x68, 0x0a, 0x55,
+	0x7c, 0x8e, 0xfc, 0xb3, 0x08, 0xac, 0xec, 0x5a, 0x7d, 0xdd, 0xe8, 0xdd, 0xf5, 0x7d, 0x8e, 0x72,
+	0x90, 0xb2, 0x95, 0x43, 0xd9, 0x52, 0x46, 0x7d, 0x53, 0xd1, 0xb2, 0xfc, 0x06, 0x7f, 0x35, 0x8d,
+	0xc1, 0x56, 0x0e, 0x9b, 0xfe, 0x0e, 0xfa, 0x25, 0xc4, 0x03, 0x62, 0x64, 0x83, 0xbf, 0x9a, 0xba,
+	0xfe, 0x46, 0x61, 0x36, 0x3e, 0x05, 0x06, 0x85, 0x03, 0x3e, 0xf4, 0x5b, 0x48, 0x38, 0x84, 0x52,
+	0xdd, 0xe8, 0x3a, 0xd9, 0xa8, 0x27, 0xb3, 0x3e, 0x2f, 0xd3, 0x3e, 0x6a, 0x31, 0x8e, 0x72, 0xf4,
+	0xc9, 0x71, 0x8e, 0xc3, 0x13, 0x09, 0xf4, 0x3e, 0xa4, 0xec, 0x23, 0x39, 0x30, 0x3c, 0xbb, 0xbc,
+	0xb1, 0xb4, 0x08, 0x00, 0x1f, 0xdd, 0x65, 0x1c, 0x18, 0xec, 0xc9, 0x37, 0x92, 0x20, 0x65, 0x13,
+	0x95, 0xe8, 0x07, 0x44, 0x93, 0x15, 0x9a, 0x8d, 0x31, 0xed, 0x7e, 0xf0, 0x0a, 0x41, 0xf0, 0x0a,
+	0xed, 0x20, 0x78, 0xe5, 0x84, 0xab, 0xfd, 0xc1, 0xbf, 0x73, 0x3c, 0x86, 0x40, 0xb0, 0x44, 0xd1,
+	0xfb, 0xb0, 0xaa, 0x9a, 0xb6, 0x4d, 0xfa, 0x0a, 0xd5, 0x4d, 0x43, 0xd6, 0x35, 0x27, 0x1b, 0xdf,
+	0x58, 0xba, 0x9a, 0x2c, 0xa3, 0xf1, 0x71, 0x2e, 0x53, 0x99, 0x92, 0x6a, 0x9b, 0x0e, 0xce, 0x84,
+	0x58, 0x6b, 0x9a, 0x73, 0x2b, 0xfa, 0xf8, 0x61, 0x8e, 0xcb, 0xff, 0x65, 0x09, 0x56, 0x37, 0xcd,
+	0x43, 0xe3, 0x87, 0x76, 0xf6, 0x9f, 0x20, 0x43, 0x0c, 0x4d, 0xd6, 0xc8, 0x81, 0xae, 0x12, 0xcf,
+	0xd2, 0x25, 0x4f, 0xf2, 0xa7, 0xf3, 0x92, 0x92, 0xa1, 0x6d, 0x7a, 0x4c, 0xb5, 0xe9, 0xbd, 0x2b,
+	0x0b, 0xe3, 0xe3, 0x5c, 0x7a, 0x4a, 0xd9, 0x74, 0x70, 0x9a, 0x4c, 0xf9, 0x1c, 0xf4, 0x6b, 0x88,
+	0xdb, 0xe4, 0xd3, 0x21, 0x71, 0x28, 0x8b, 0xe4, 0x9b, 0x67, 0x23, 0x89, 0x7d, 0x86, 0x2a, 0x87,
+	0x03, 0x5e, 0x74, 0x0b, 0x92, 0x8e, 0x7a, 0x9f, 0x68, 0xc3, 0x3e, 0xd1, 0xb2, 0xcb, 0x2f, 0xbb,
+	0x02, 0x55, 0x0e, 0x4f, 0xd9, 0x17, 0xf9, 0x3e, 0x76, 0x31, 0xdf, 0x97, 0x61, 0x7a, 0x01, 0xf3,
+	0x7f, 0x5b, 0x02, 0xa1, 0x7d, 0x54, 0x52, 0x7b, 0x86, 0x79, 0xd8, 0x27, 0x5a, 0x77, 0x40, 0x8c,
+	0x85, 0xf1, 0xe5, 0xcf, 0xab, 0x03, 0xfd, 0x1e, 0x62, 0x36, 0x71, 0x86, 0x7d, 0xea, 0xc5, 0x28,
+	0x73, 0xfd, 0x9d, 0xb3, 0x27, 0x9b, 0x55, 0x57, 0xc0, 0x1e, 0x3b, 0x66, 0x62, 0xe8, 0x0e, 0x08,
+	0x1a, 0xbb, 0x19, 0x32, 0xab, 0x7d, 0x2c, 0x68, 0xb9, 0x79, 0xa8, 0xb9, 0x1b, 0x84, 0x57, 0xb5,
+	0xd9, 0x8d, 0xfc, 0x23, 0x1e, 0x62, 0x3e, 0x3c, 0x4a, 0x41, 0xbc, 0xb5, 0x5b, 0xa9, 0x48, 0xad,
+	0x96, 0xc0, 0xa1, 0xd7, 0x60, 0x65, 0xb7, 0xbe, 0x5d, 0x6f, 0x7c, 0x5c, 0x97, 0x25, 0x8c, 0x1b,
+	0x58, 0xe0, 0x51, 0x1a, 0x12, 0xed, 0x46, 0x43, 0xde, 0x29, 0xb5, 0x25, 0x21, 0x82, 0x56, 0x20,
+	0xe9, 0xae, 0xa4, 0x12, 0xde, 0xd9, 0x13, 0x96, 0xd0, 0x1a, 0x08, 0x95, 0xc6, 0xce, 0x4e, 0xad,
+	0x55, 0x6b, 0xd4, 0xe5, 0x66, 0xa9, 0xb2, 0x2d, 0xb5, 0x85, 0xe8, 0xec, 0x6e, 0x59, 0x2a, 0x55,
+	0x1a, 0x75, 0x61, 0xd9, 0x55, 0xd4, 0xbe, 0x27, 0x6f, 0x61, 0xe9, 0x43, 0x21, 0xe6, 0xa1, 0xde,
+	0x93, 0x9b, 0x8d, 0x8f, 0x25, 0x2c, 0xc4, 0x91, 0x00, 0xe9, 0xdb, 0xcd, 0x96, 0xbc, 0x5b, 0xdf,
+	0x69, 0x54, 0xb6, 0xa5, 0x4d, 0x21, 0xe1, 0xee, 0x54, 0xaa, 0xa5, 0x7a, 0x5d, 0xda, 0x91, 0xcb,
+	0xbb, 0xad, 0x3d, 0x21, 0x99, 0xff, 0x4f, 0x04, 0x5e, 0x2b, 0x59, 0x56, 0x5f, 0x57, 0x3d, 0x97,
+	0xfa, 0xf5, 0x08, 0xbd, 0x07, 0x19, 0x87, 0x38, 0x8e, 0x1b, 0x8e, 0x1e, 0x19, 0xc9, 0x3a, 0x4b,
+	0x0f, 0xff, 0x86, 0xb6, 0x7c, 0xca, 0x36, 0x19, 0xd5, 0x36, 0x71, 0xda, 0x99, 0xae, 0x34, 0x74,
+	0x09, 0x62, 0x1d, 0xd9, 0x32, 0x6d, 0x3f, 0x1a, 0x2b, 0x78, 0xb9, 0xd3, 0x34, 0x6d, 0x8a, 0x5e,
+	0x87, 0xe5, 0x8e, 0xac, 0x1a, 0xd4, 0x73, 0xec, 0x0a, 0x8e, 0x76, 0x2a, 0x06, 0x45, 0x45, 0x48,
+	0x75, 0xec, 0xc1, 0x24, 0xff, 0xa2, 0x9e, 0x82, 0xcc, 0xf8, 0x38, 0x07, 0x5b, 0xf8, 0x2e, 0xcb,
+	0x41, 0x0c, 0x1d, 0x7b, 0xc0, 0xbe, 0xd1, 0x1f, 0x60, 0x55, 0x23, 0xaa, 0xa9, 0x11, 0x6d, 0x22,
+	0xb4, 0xcc, 0xf2, 0x72, 0xbe, 0xa4, 0xb4, 0xbc, 0x6e, 0x81, 0x33, 0x8c, 0x3f, 0x40, 0x98, 0xab,
+	0x66, 0xb1, 0x0b, 0x55, 0xb3, 0x70, 0x21, 0x8d, 0x5f, 0xb4, 0x90, 0xe6, 0xff, 0x1c, 0x81, 0xd7,
+	0x43, 0x7e, 0xde, 0x31, 0xfd, 0x5f, 0x94, 0x85, 0xb8, 0x43, 0x6c, 0x37, 0xc3, 0x3d, 0x17, 0x27,
+	0x71, 0xb0, 0x44, 0xbf, 0x83, 0x44, 0x9f, 0x71, 0xb1, 0xfa, 0x93, 0x9d, 0xd7, 0x17, 0xa0, 0xf8,
+	0x85, 0xf3, 0xe9, 0x71, 0x8e, 0xc7, 0x13, 0x19, 0xd4, 0x02, 0x50, 0x28, 0xb5, 0xf5, 0xfd, 0x21,
+	0x25, 0x6e, 0x1d, 0x72, 0xcf, 0x7a, 0x63, 0x1e, 0x61, 0x81, 0x49, 0x85, 0xd2, 0x44, 0x4a, 0x32,
+	0xa8, 0x3d, 0xc2, 0x21, 0x98, 0xf5, 0x0f, 0x60, 0x75, 0x8e, 0x8c, 0x04, 0x58, 0xea, 0x91, 0x11,
+	0xb3, 0xde, 0xfd, 0x44, 0x6b, 0xb0, 0x7c, 0xa0, 0xf4, 0x87, 0xc4, 0x33, 0x3b, 0x89, 0xfd, 0xc5,
+	0xad, 0xc8, 0x4d, 0x3e, 0xff, 0x45, 0x04, 0x2e, 0x85, 0x54, 0xde, 0x31, 0x75, 0xa3, 0xa4, 0xaa,
+	0xc4, 0xa2, 0xaf, 0x7c, 0xe3, 0x7e, 0x03, 0x49, 0xc5, 0xb2, 0x64, 0xc7, 0x95, 0x62, 0x6e, 0xfa,
+	0xd1, 0xfc, 0x21, 0xb7, 0xc9, 0x48, 0x32, 0x0e, 0x48, 0xdf, 0xb4, 0x08, 0x8e, 0x2b, 0x96, 0xd5,
+	0xda, 0x26, 0x23, 0x74, 0x0f, 0x2e, 0xe9, 0x06, 0x1b, 0x3b, 0x88, 0x26, 0x07, 0xa9, 0x1c, 0x78,
+	0xea, 0xad, 0x17, 0x78, 0x2a, 0xa8, 0x03, 0x78, 0x2d, 0x84, 0x10, 0x6c, 0x3a, 0xe8, 0x1d, 0x58,
+	0xb5, 0x88, 0xa1, 0xe9, 0x46, 0x57, 0x66, 0xa6, 0x7a, 0x97, 0x3b, 0x81, 0x33, 0x6c, 0x9b, 0x1d,
+	0x27, 0xff, 0x3c, 0x3a, 0x73, 0x27, 0x02, 0x84, 0xff, 0xd7, 0xec, 0xbb, 0x02, 0x49, 0xd5, 0x34,
+	0x3a, 0xba, 0x3d, 0x20, 0x9a, 0x37, 0x0c, 0x24, 0xf0, 0x74, 0x03, 0xdd, 0x86, 0xa4, 0xda, 0x57,
+	0x1c, 0x47, 0xde, 0x97, 0x55, 0x96, 0x5f, 0x3f, 0x3f, 0x47, 0x0c, 0x0a, 0x15, 0x57, 0xa8, 0x5c,
+	0xc1, 0x71, 0xd5, 0xff, 0x70, 0xf3, 0xc6, 0xb2, 0x75, 0xd3, 0xd6, 0xe9, 0x28, 0x9b, 0xf0, 0x7a,
+	0x42, 0x7e, 0x41, 0x9e, 0xb2, 0x0e, 0xd7, 0x64, 0x9c, 0x78, 0x22, 0xb3, 0xa8, 0x1d, 0x25, 0xcf,
+	0xdb, 0x8e, 0xd6, 0xff, 0xce, 0x43, 0x9c, 0x59, 0x84, 0x24, 0x48, 0x74, 0x15, 0x4a, 0x0e, 0x95,
+	0x91, 0x3f, 0xb0, 0xa4, 0xae, 0xbf, 0x3b, 0x6f, 0xc8, 0x6d, 0x9f, 0x5e, 0x32, 0x28, 0x31, 0x0c,
+	0x25, 0x34, 0x0b, 0xe0, 0x89, 0x28, 0x92, 0x60, 0x45, 0xd9, 0x77, 0xcc, 0xfe, 0x90, 0x12, 0xd9,
+	0x9d, 0x73, 0xb3, 0x09, 0x56, 0x7c, 0xbe, 0x7f, 0x8e, 0x8a, 0x7a, 0x33, 0x54, 0x3a, 0x10, 0x73,
+	0x09, 0x6c, 0x10, 0xda, 0x83, 0xb5, 0x05, 0x4e, 0x74, 0x50, 0x09, 0x92, 0xd3, 0x0c, 0xe0, 0xcf,
+	0x9f, 0x01, 0x53, 0xa9, 0xfc, 0x43, 0x1e, 0xde, 0x5c, 0xc0, 0xb2, 0xa5, 0xe8, 0xee, 0x20, 0x51,
+	0x83, 0x44, 0xc0, 0xea, 0xdd, 0xe6, 0xf3, 0xe1, 0x87, 0x0b, 0x5b, 0x20, 0x8e, 0x6e, 0xc2, 0xb2,
+	0x37, 0xcc, 0xb3, 0x74, 0xbf, 0x72, 0x66, 0xb6, 0x72, 0x89, 0x9b, 0x84, 0x2a, 0x7a, 0x3f, 0xa8,
+	0xc3, 0xbe, 0x40, 0xfe, 0x0b, 0x1e, 0x72, 0x21, 0x2d, 0xb5, 0x45, 0xd9, 0xfb, 0xbf, 0x7b, 0x02,
+	0xbd, 0x0d, 0xab, 0x7d, 0xc5, 0xa1, 0xb2, 0x97, 0x75, 0x5e, 0x65, 0x61, 0x09, 0x99, 0x76, 0xb7,
+	0xb7, 0x2a, 0x06, 0x75, 0xa5, 0xf2, 0x7f, 0x8d, 0xc3, 0xca, 0x4c, 0xeb, 0x45, 0xed, 0x33, 0xe3,
+	0x23, 0x7f, 0x81, 0xf1, 0x71, 0xea, 0xab, 0xd9, 0xb1, 0x71, 0xc1, 0x85, 0x8e, 0x9c, 0x7b, 0xbe,
+	0xba, 0x03, 0x99, 0xa1, 0xb5, 0x60, 0x38, 0xfa, 0xc9, 0x0b, 0x7c, 0xe2, 0x0f, 0x11, 0x55, 0x0e,
+	0xaf, 0x0c, 0x67, 0x9e, 0x37, 0x55, 0x48, 0x7d, 0x62, 0xea, 0x86, 0xac, 0x78, 0x25, 0x9f, 0xcd,
+	0xb0, 0x6f, 0xbf, 0x00, 0x68, 0xda, 0x1f, 0xaa, 0x1c, 0x86, 0x4f, 0x26, 0x2b, 0x54, 0x85, 0xf4,
+	0x64, 0x68, 0x53, 0xd4, 0x1e, 0xab, 0x44, 0xe7, 0x89, 0x53, 0x95, 0xc3, 0xa9, 0x40, 0xb4, 0xa4,
+	0xf6, 0xd0, 0x1d, 0x58, 0x99, 0x20, 0x19, 0x2e, 0x54, 0xec, 0x22, 0x50, 0x13, 0x2b, 0xea, 0xca,
+	0x1c, 0x96, 0x43, 0x0c, 0x9a, 0x8d, 0xbf, 0x12, 0x56, 0xcb, 0x1d, 0x8a, 0xdb, 0x30, 0x99, 0x2e,
+	0xe5, 0x8e, 0x97, 0x42, 0x2c, 0xef, 0xdf, 0x3d, 0x07, 0x9a, 0x9f, 0x73, 0x55, 0x0e, 0x67, 0xb4,
+	0xd9, 0x2c, 0xac, 0x87, 0x50, 0x3f, 0x1d, 0x92, 0x21, 0xd1, 0xb2, 0xc9, 0x8b, 0xd8, 0x38, 0xc1,
+	0xfb, 0xd0, 0x13, 0x46, 0x26, 0xac, 0xcf, 0xe2, 0xc9, 0xa1, 0x8e, 0x98, 0x05, 0x0f, 0xba, 0xf8,
+	0x02, 0xe8, 0x45, 0x19, 0x58, 0xe5, 0x70, 0x76, 0x46, 0x4d, 0x88, 0xc9, 0x3d, 0x40, 0x30, 0xe0,
+	0xc8, 0x8e, 0xd9, 0x3f, 0x20, 0x5a, 0x36, 0xf5, 0xd2, 0x03, 0x04, 0x93, 0x8d, 0x7b, 0x80, 0x40,
+	0xba, 0xe5, 0x09, 0x97, 0xa3, 0x10, 0x19, 0x5a, 0xf9, 0x2f, 0x23, 0x90, 0x65, 0x97, 0x94, 0x35,
+	0xab, 0x2d, 0xd3, 0x1e, 0x28, 0x94, 0x12, 0xdb, 0x41, 0x15, 0x48, 0x0f, 0x2d, 0xb9, 0x13, 0x6c,
+	0x78, 0x29, 0x99, 0xb9, 0xbe, 0x31, 0xaf, 0x6f, 0x5e, 0x10, 0xa7, 0x86, 0xd6, 0x64, 0x81, 0x7e,
+	0x05, 0x97, 0xc3, 0x20, 0xb2, 0xa5, 0xd8, 0xca, 0x80, 0xb8, 0x70, 0xfe, 0x8c, 0xb4, 0x16, 0x62,
+	0x6e, 0x06, 0x34, 0x74, 0x1b, 0x3c, 0x87, 0x87, 0x94, 0x2f, 0x9d, 0x53, 0xb9, 0x77, 0x11, 0xa7,
+	0xea, 0x6f, 0x42, 0x76, 0x16, 0x28, 0x64, 0x40, 0xd4, 0x33, 0xe0, 0xf2, 0x8c, 0xc0, 0xc4, 0x84,
+	0xfc, 0xbf, 0x78, 0x58, 0xdb, 0x0c, 0x47, 0x83, 0x3d, 0x30, 0x7f, 0xa0, 0x5a, 0x35, 0x53, 0x7d,
+	0x23, 0xaf, 0xd4, 0x87, 0xfe, 0xc9, 0xc3, 0x1b, 0xac, 0xaf, 0x9e, 0x79, 0x6a, 0xde, 0x85, 0x14,
+	0xeb, 0xab, 0x21, 0x8b, 0xf3, 0xdf, 0xd3, 0x95, 0x17, 0xdb, 0x0b, 0xdd, 0x80, 0xea, 0xa0, 0x0f,
+	0x20, 0x46, 0x8f, 0xbc, 0x02, 0xe4, 0xb7, 0xa2, 0x8d, 0x97, 0x3d, 0x3e, 0x83, 0x76, 0x44, 0xdd,
+	0xfd, 0x9f, 0x3d, 0xe0, 0x41, 0x98, 0x8f, 0x1c, 0x42, 0x90, 0xd9, 0x6a, 0xe0, 0xbb, 0xa5, 0x76,
+	0x5b, 0xc2, 0x72, 0xbd, 0x51, 0x97, 0x04, 0x0e, 0x65, 0x61, 0x6d, 0xba, 0x87, 0xa5, 0x66, 0xa3,
+	0x55, 0x6b, 0x37, 0xf0, 0x9e, 0xc0, 0xa3, 0x75, 0xb8, 0x3c, 0xa5, 0xdc, 0xc6, 0xcd, 0x8a, 0xdc,
+	0x92, 0xf0, 0x47, 0xb5, 0x8a, 0xfb, 0xa8, 0x9c, 0x91, 0xba, 0x53, 0xfa, 0xa8, 0xd4, 0xaa, 0xe0,
+	0x5a, 0xb3, 0x2d, 0x2c, 0xcd, 0x52, 0x2a, 0xa5, 0x3d, 0xc9, 0x7d, 0x11, 0x36, 0x9b, 0x42, 0xb4,
+	0xfc, 0x0f, 0xfe, 0xc9, 0x89, 0xc8, 0x3f, 0x3d, 0x11, 0xf9, 0x6f, 0x4e, 0x44, 0xee, 0xdb, 0x13,
+	0x91, 0x7b, 0x76, 0x22, 0x72, 0xcf, 0x4f, 0x44, 0xee, 0xbb, 0x13, 0x91, 0xff, 0x6c, 0x2c, 0xf2,
+	0x9f, 0x8f, 0x45, 0xee, 0xab, 0xb1, 0xc8, 0x3f, 0x1a, 0x8b, 0xdc, 0xe3, 0xb1, 0xc8, 0x7d, 0x3d,
+	0x16, 0xb9, 0x27, 0x63, 0x91, 0x7f, 0x3a, 0x16, 0xf9, 0x6f, 0xc6, 0x22, 0xf7, 0xed, 0x58, 0xe4,
+	0x9f, 0x8d, 0x45, 0xee, 0xf9, 0x58, 0xe4, 0xbf, 0x1b, 0x8b, 0xdc, 0x67, 0xa7, 0x22, 0xf7, 0xf9,
+	0xa9, 0xc8, 0x3f, 0x38, 0x15, 0xb9, 0x2f, 0x4f, 0x45, 0xfe, 0xe1, 0xa9, 0xc8, 0x7d, 0x75, 0x2a,
+	0x72, 0x8f, 0x4e, 0x45, 0xfe, 0xf1, 0xa9, 0xc8, 0x7f, 0x7d, 0x2a, 0xf2, 0x7f, 0xfc, 0x45, 0xd7,
+	0x2c, 0xd0, 0xfb, 0x84, 0xde, 0x77, 0xdf, 0x4a, 0x05, 0x83, 0xd0, 0x43, 0xd3, 0xee, 0x15, 0x67,
+	0xff, 0x41, 0xb3, 0x7a, 0xdd, 0x22, 0xa5, 0x86, 0xb5, 0xbf, 0x1f, 0xf3, 0x46, 0x9e, 0x1b, 0xff,
+	0x1d, 0x00, 0x16, 0xbd, 0xfd, 0xb7, 0xbe, 0x14, 0x00, 0x00,
 }
@@ -69,6 +69,11 @@ func (this *DownlinkMessage) Validate() error {
 	return nil
 }
 func (this *TxAcknowledgment) Validate() error {
+	if this.DownlinkMessage != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.DownlinkMessage); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("DownlinkMessage", err)
+		}
+	}
 	return nil
 }
 func (this *ApplicationUplink) Validate() error {
@@ -253,3 +258,12 @@ func (this *DownlinkQueueRequest) Validate() error {
 	}
 	return nil
 }
+func (this *GatewayTxAcknowledgment) Validate() error {
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.GatewayIdentifiers)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("GatewayIdentifiers", err)
+	}
+	if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(&(this.TxAck)); err != nil {
+		return github_com_mwitkow_go_proto_validators.FieldError("TxAck", err)
+	}
+	return nil
+}
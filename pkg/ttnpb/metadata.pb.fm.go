@@ -9,6 +9,8 @@ var RxMetadataFieldPathsNested = []string{
 	"antenna_index",
 	"channel_rssi",
 	"downlink_path_constraint",
+	"downlink_queue_depth",
+	"downlink_utilization",
 	"encrypted_fine_timestamp",
 	"encrypted_fine_timestamp_key_id",
 	"fine_timestamp",
@@ -35,6 +37,8 @@ var RxMetadataFieldPathsTopLevel = []string{
 	"antenna_index",
 	"channel_rssi",
 	"downlink_path_constraint",
+	"downlink_queue_depth",
+	"downlink_utilization",
 	"encrypted_fine_timestamp",
 	"encrypted_fine_timestamp_key_id",
 	"fine_timestamp",
@@ -220,6 +224,26 @@ func (dst *RxMetadata) SetFields(src *RxMetadata, paths ...string) error {
 				var zero []byte
 				dst.UplinkToken = zero
 			}
+		case "downlink_utilization":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_utilization' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkUtilization = src.DownlinkUtilization
+			} else {
+				var zero float32
+				dst.DownlinkUtilization = zero
+			}
+		case "downlink_queue_depth":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_queue_depth' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkQueueDepth = src.DownlinkQueueDepth
+			} else {
+				var zero uint32
+				dst.DownlinkQueueDepth = zero
+			}
 		case "advanced":
 			if len(subs) > 0 {
 				return fmt.Errorf("'advanced' has no subfields, but %s were specified", subs)
@@ -116,6 +116,10 @@ type RxMetadata struct {
 	DownlinkPathConstraint DownlinkPathConstraint `protobuf:"varint,14,opt,name=downlink_path_constraint,json=downlinkPathConstraint,proto3,enum=ttn.lorawan.v3.DownlinkPathConstraint" json:"downlink_path_constraint,omitempty"`
 	// Uplink token to be included in the Tx request in class A downlink; injected by gateway, Gateway Server or fNS.
 	UplinkToken []byte `protobuf:"bytes,15,opt,name=uplink_token,json=uplinkToken,proto3" json:"uplink_token,omitempty"`
+	// Highest duty-cycle utilization of the gateway's sub-bands, as a fraction of the duty-cycle limit; injected by the Gateway Server.
+	DownlinkUtilization float32 `protobuf:"fixed32,16,opt,name=downlink_utilization,json=downlinkUtilization,proto3" json:"downlink_utilization,omitempty"`
+	// Number of downlink messages scheduled on the gateway that are not transmitted yet; injected by the Gateway Server.
+	DownlinkQueueDepth uint32 `protobuf:"varint,17,opt,name=downlink_queue_depth,json=downlinkQueueDepth,proto3" json:"downlink_queue_depth,omitempty"`
 	// Advanced metadata fields
 	// - can be used for advanced information or experimental features that are not yet formally defined in the API
 	// - field names are written in snake_case
@@ -254,6 +258,20 @@ func (m *RxMetadata) GetUplinkToken() []byte {
 	return nil
 }
 
+func (m *RxMetadata) GetDownlinkUtilization() float32 {
+	if m != nil {
+		return m.DownlinkUtilization
+	}
+	return 0
+}
+
+func (m *RxMetadata) GetDownlinkQueueDepth() uint32 {
+	if m != nil {
+		return m.DownlinkQueueDepth
+	}
+	return 0
+}
+
 func (m *RxMetadata) GetAdvanced() *types.Struct {
 	if m != nil {
 		return m.Advanced
@@ -426,6 +444,12 @@ func (this *RxMetadata) Equal(that interface{}) bool {
 	if !bytes.Equal(this.UplinkToken, that1.UplinkToken) {
 		return false
 	}
+	if this.DownlinkUtilization != that1.DownlinkUtilization {
+		return false
+	}
+	if this.DownlinkQueueDepth != that1.DownlinkQueueDepth {
+		return false
+	}
 	if !this.Advanced.Equal(that1.Advanced) {
 		return false
 	}
@@ -577,6 +601,21 @@ func (m *RxMetadata) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintMetadata(dAtA, i, uint64(len(m.UplinkToken)))
 		i += copy(dAtA[i:], m.UplinkToken)
 	}
+	if m.DownlinkUtilization != 0 {
+		dAtA[i] = 0x85
+		i++
+		dAtA[i] = 0x1
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.DownlinkUtilization))))
+		i += 4
+	}
+	if m.DownlinkQueueDepth != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintMetadata(dAtA, i, uint64(m.DownlinkQueueDepth))
+	}
 	if m.Advanced != nil {
 		dAtA[i] = 0x9a
 		i++
@@ -691,6 +730,11 @@ func NewPopulatedRxMetadata(r randyMetadata, easy bool) *RxMetadata {
 	for i := 0; i < v3; i++ {
 		this.UplinkToken[i] = byte(r.Intn(256))
 	}
+	this.DownlinkUtilization = float32(r.Float32())
+	if r.Intn(2) == 0 {
+		this.DownlinkUtilization *= -1
+	}
+	this.DownlinkQueueDepth = r.Uint32()
 	if r.Intn(10) != 0 {
 		this.Advanced = types.NewPopulatedStruct(r, easy)
 	}
@@ -850,6 +894,12 @@ func (m *RxMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetadata(uint64(l))
 	}
+	if m.DownlinkUtilization != 0 {
+		n += 6
+	}
+	if m.DownlinkQueueDepth != 0 {
+		n += 2 + sovMetadata(uint64(m.DownlinkQueueDepth))
+	}
 	if m.Advanced != nil {
 		l = m.Advanced.Size()
 		n += 2 + l + sovMetadata(uint64(l))
@@ -914,6 +964,8 @@ func (this *RxMetadata) String() string {
 		`Location:` + strings.Replace(fmt.Sprintf("%v", this.Location), "Location", "Location", 1) + `,`,
 		`DownlinkPathConstraint:` + fmt.Sprintf("%v", this.DownlinkPathConstraint) + `,`,
 		`UplinkToken:` + fmt.Sprintf("%v", this.UplinkToken) + `,`,
+		`DownlinkUtilization:` + fmt.Sprintf("%v", this.DownlinkUtilization) + `,`,
+		`DownlinkQueueDepth:` + fmt.Sprintf("%v", this.DownlinkQueueDepth) + `,`,
 		`Advanced:` + strings.Replace(fmt.Sprintf("%v", this.Advanced), "Struct", "types.Struct", 1) + `,`,
 		`}`,
 	}, "")
@@ -1296,6 +1348,36 @@ func (m *RxMetadata) Unmarshal(dAtA []byte) error {
 				m.UplinkToken = []byte{}
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkUtilization", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.DownlinkUtilization = float32(math.Float32frombits(v))
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkQueueDepth", wireType)
+			}
+			m.DownlinkQueueDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkQueueDepth |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Advanced", wireType)
@@ -1592,66 +1674,68 @@ func init() {
 }
 
 var fileDescriptor_metadata_8f259ff0d4005ede = []byte{
-	// 961 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x31, 0x50, 0x1b, 0xc7,
-	0x17, 0xc6, 0x6f, 0x41, 0x80, 0x58, 0x81, 0x60, 0xf6, 0x3f, 0xd8, 0x87, 0xe0, 0xbf, 0x77, 0xc1,
-	0x93, 0x8c, 0x92, 0x89, 0x4f, 0x33, 0xe0, 0xc9, 0xa4, 0x45, 0x80, 0x99, 0x1b, 0x83, 0x44, 0x56,
-	0xf2, 0x78, 0x92, 0xe6, 0xb2, 0xdc, 0xad, 0x4e, 0x37, 0x12, 0x7b, 0xca, 0xdd, 0x0a, 0x4c, 0xe7,
-	0x92, 0xd2, 0xe9, 0x52, 0x66, 0x92, 0xc6, 0xa5, 0x8b, 0x14, 0x2e, 0x5d, 0x52, 0x52, 0xba, 0x52,
-	0xac, 0x53, 0xe3, 0xd2, 0x5d, 0x5c, 0x66, 0x6e, 0xb5, 0x88, 0x08, 0x99, 0x4e, 0xef, 0xfb, 0x7e,
-	0xdf, 0xd3, 0xbe, 0x9d, 0xb7, 0x07, 0xcd, 0x76, 0x18, 0xd1, 0x33, 0xca, 0x1f, 0xc6, 0x82, 0xba,
-	0xad, 0x12, 0xed, 0x04, 0xa5, 0x13, 0x26, 0xa8, 0x47, 0x05, 0xb5, 0x3a, 0x51, 0x28, 0x42, 0x94,
-	0x17, 0x82, 0x5b, 0x8a, 0xb2, 0x4e, 0xb7, 0x0a, 0x0f, 0xfd, 0x40, 0x34, 0xbb, 0xc7, 0x96, 0x1b,
-	0x9e, 0x94, 0xfc, 0xd0, 0x0f, 0x4b, 0x12, 0x3b, 0xee, 0x36, 0x64, 0x25, 0x0b, 0xf9, 0x6b, 0x18,
-	0x2f, 0xac, 0xfb, 0x61, 0xe8, 0xb7, 0xd9, 0x0d, 0x15, 0x8b, 0xa8, 0xeb, 0x0a, 0xe5, 0x1a, 0xb7,
-	0x5d, 0x11, 0x9c, 0xb0, 0x58, 0xd0, 0x93, 0x8e, 0x02, 0xfe, 0x3f, 0x79, 0x3e, 0xc6, 0xbb, 0x27,
-	0xb1, 0xb2, 0x1f, 0x4c, 0xda, 0x81, 0xc7, 0xb8, 0x08, 0x1a, 0x01, 0x8b, 0x14, 0xb4, 0xf1, 0xcf,
-	0x2c, 0x84, 0xe4, 0xf9, 0xa1, 0x1a, 0x0b, 0x1d, 0xc2, 0x9c, 0x4f, 0x05, 0x3b, 0xa3, 0xe7, 0x4e,
-	0xe0, 0xc5, 0x3a, 0x30, 0x41, 0x31, 0xb7, 0xb9, 0x61, 0x8d, 0x8f, 0x69, 0xed, 0x0f, 0x11, 0xfb,
-	0xa6, 0x5b, 0x39, 0x7b, 0xd9, 0x33, 0xb4, 0xab, 0x9e, 0x01, 0x08, 0xf4, 0xaf, 0xdd, 0x18, 0x3d,
-	0x80, 0x8b, 0x94, 0x0b, 0xc6, 0x39, 0x75, 0x02, 0xee, 0xb1, 0xe7, 0xfa, 0x94, 0x09, 0x8a, 0x8b,
-	0x64, 0x41, 0x89, 0x76, 0xaa, 0xa1, 0x47, 0x30, 0x93, 0x4e, 0xa6, 0x4f, 0xcb, 0x3f, 0x2b, 0x58,
-	0xc3, 0xb1, 0xad, 0xeb, 0xb1, 0xad, 0xfa, 0xf5, 0xd8, 0xe5, 0xcc, 0xcb, 0xbf, 0x0d, 0x40, 0x24,
-	0x8d, 0xd6, 0xe1, 0xfc, 0xe8, 0x3e, 0xf4, 0x8c, 0x6c, 0x7b, 0x23, 0xa0, 0x2f, 0x61, 0xbe, 0x11,
-	0x70, 0xe6, 0xdc, 0x20, 0x33, 0x26, 0x28, 0x66, 0xc8, 0x62, 0xaa, 0x8e, 0x1a, 0xa2, 0xef, 0xa1,
-	0xce, 0xb8, 0x1b, 0x9d, 0x77, 0x04, 0xf3, 0x9c, 0x5b, 0x81, 0x59, 0x13, 0x14, 0x17, 0xc8, 0xbd,
-	0x91, 0xff, 0x78, 0x2c, 0xc9, 0xa0, 0x71, 0x57, 0xd2, 0x69, 0xb1, 0xf4, 0xf6, 0xf4, 0x39, 0x13,
-	0x14, 0xe7, 0xcb, 0x46, 0xd2, 0x33, 0xd6, 0xf6, 0x3e, 0xdb, 0xe4, 0x09, 0x3b, 0xb7, 0x77, 0xc9,
-	0x1a, 0xbb, 0xd3, 0xf4, 0xd0, 0x3a, 0xcc, 0x44, 0x71, 0x1c, 0xe8, 0x59, 0x13, 0x14, 0xa7, 0xca,
-	0xd9, 0xa4, 0x67, 0x64, 0x48, 0xad, 0x66, 0x13, 0xa9, 0xa2, 0x4d, 0xb8, 0xe0, 0x36, 0x29, 0xe7,
-	0xac, 0xed, 0x48, 0x6a, 0x5e, 0x52, 0x4b, 0x49, 0xcf, 0xc8, 0xed, 0x0c, 0x75, 0x09, 0xe7, 0x14,
-	0x44, 0xd2, 0xcc, 0x0f, 0xf0, 0x7e, 0xca, 0x3a, 0xb1, 0xa0, 0xdc, 0xa3, 0x91, 0xe7, 0x78, 0xec,
-	0x34, 0xa0, 0x22, 0x08, 0xb9, 0x0e, 0x65, 0x7c, 0x35, 0xe9, 0x19, 0x2b, 0x69, 0xae, 0xa6, 0x88,
-	0xdd, 0x6b, 0x80, 0xac, 0xa4, 0xc9, 0x09, 0x19, 0xad, 0xc2, 0xe9, 0x98, 0x47, 0x7a, 0x4e, 0xc6,
-	0xe7, 0x92, 0x9e, 0x31, 0x5d, 0xab, 0x10, 0x92, 0x6a, 0xe8, 0x6b, 0xb8, 0xdc, 0x88, 0xd8, 0x2f,
-	0x5d, 0xc6, 0xdd, 0x73, 0x27, 0x6c, 0x34, 0x62, 0x26, 0xf4, 0x05, 0x13, 0x14, 0xa7, 0xc9, 0xd2,
-	0x48, 0xaf, 0x4a, 0x19, 0x3d, 0x82, 0xd9, 0x76, 0xe8, 0x0e, 0x4f, 0xb2, 0x28, 0x57, 0x41, 0xbf,
-	0xbd, 0x77, 0x07, 0xca, 0x27, 0x23, 0x12, 0xfd, 0x0c, 0x75, 0x2f, 0x3c, 0xe3, 0xed, 0x80, 0xb7,
-	0x9c, 0x0e, 0x15, 0x4d, 0xc7, 0x0d, 0x79, 0x2c, 0x22, 0x1a, 0x70, 0xa1, 0xe7, 0x4d, 0x50, 0xcc,
-	0x6f, 0x7e, 0x75, 0xbb, 0xcb, 0xae, 0xe2, 0x8f, 0xa8, 0x68, 0xee, 0x8c, 0x68, 0x72, 0xcf, 0xfb,
-	0xac, 0x8e, 0xbe, 0x80, 0x0b, 0xdd, 0x8e, 0xec, 0x2f, 0xc2, 0x16, 0xe3, 0xfa, 0x92, 0xdc, 0x8b,
-	0xdc, 0x50, 0xab, 0xa7, 0x12, 0xda, 0x82, 0x59, 0xea, 0x9d, 0x52, 0xee, 0x32, 0x4f, 0x77, 0xe5,
-	0xd1, 0xef, 0x4f, 0x6c, 0x71, 0x4d, 0x3e, 0x6d, 0x32, 0x02, 0x37, 0xfe, 0x02, 0x30, 0x7b, 0x3d,
-	0x10, 0x2a, 0xc0, 0x6c, 0x9b, 0x8a, 0x40, 0x74, 0x3d, 0x26, 0x1f, 0x1d, 0x20, 0xa3, 0x3a, 0xdd,
-	0xf4, 0x76, 0xc8, 0xfd, 0xa1, 0x39, 0x25, 0xcd, 0x1b, 0x21, 0x4d, 0xd2, 0xb6, 0x4a, 0xa6, 0x2f,
-	0x68, 0x86, 0x8c, 0x6a, 0xe9, 0xb9, 0x6e, 0x37, 0xa2, 0xee, 0xb9, 0x7c, 0x22, 0xa9, 0xa7, 0x6a,
-	0xf4, 0x1d, 0x9c, 0x8d, 0xc3, 0x6e, 0xe4, 0x32, 0xf9, 0x32, 0xf2, 0x9b, 0xf8, 0xae, 0xcb, 0xae,
-	0x49, 0x8a, 0x28, 0xfa, 0x9b, 0x5f, 0xa7, 0x60, 0x7e, 0xdc, 0x42, 0x08, 0xe6, 0x6b, 0xd5, 0xa7,
-	0x64, 0x67, 0xcf, 0x79, 0x5a, 0x79, 0x52, 0xa9, 0x3e, 0xab, 0x2c, 0x6b, 0x28, 0x0f, 0xa1, 0xd2,
-	0xf6, 0x8f, 0x6a, 0xcb, 0x00, 0xfd, 0x0f, 0x2e, 0xa9, 0x9a, 0xec, 0xed, 0xdb, 0xb5, 0x3a, 0xf9,
-	0x71, 0x79, 0x1a, 0xad, 0xc2, 0x15, 0x25, 0xda, 0x47, 0xce, 0xfe, 0x5e, 0xf5, 0xa0, 0xba, 0xb3,
-	0x5d, 0xb7, 0xab, 0x95, 0xe5, 0x0c, 0x32, 0xe1, 0xba, 0xb2, 0x9e, 0xd9, 0x8f, 0x6d, 0x27, 0xdd,
-	0xc7, 0x31, 0x62, 0x06, 0x61, 0x58, 0x50, 0x44, 0xb9, 0x3e, 0xe9, 0xcf, 0xfe, 0xa7, 0xc3, 0x41,
-	0x95, 0x6c, 0x4f, 0x12, 0x73, 0xb7, 0x89, 0xfa, 0x6e, 0x75, 0x7b, 0x8c, 0xc8, 0x22, 0x03, 0xae,
-	0x29, 0x62, 0xa7, 0x7a, 0x58, 0xb6, 0x2b, 0x7b, 0xbb, 0x63, 0xc0, 0x7c, 0x21, 0x73, 0xf1, 0x27,
-	0xd6, 0xca, 0x7f, 0x80, 0xcb, 0x3e, 0x06, 0x57, 0x7d, 0x0c, 0xde, 0xf5, 0xb1, 0xf6, 0xbe, 0x8f,
-	0xb5, 0x0f, 0x7d, 0xac, 0x7d, 0xec, 0x63, 0xed, 0x53, 0x1f, 0x83, 0x17, 0x09, 0x06, 0x17, 0x09,
-	0xd6, 0x5e, 0x25, 0x18, 0xbc, 0x4e, 0xb0, 0xf6, 0x26, 0xc1, 0xda, 0xdb, 0x04, 0x6b, 0x97, 0x09,
-	0x06, 0x57, 0x09, 0x06, 0xef, 0x12, 0xac, 0xbd, 0x4f, 0x30, 0xf8, 0x90, 0x60, 0xed, 0x63, 0x82,
-	0xc1, 0xa7, 0x04, 0x6b, 0x2f, 0x06, 0x58, 0xbb, 0x18, 0x60, 0xf0, 0x72, 0x80, 0xb5, 0xdf, 0x06,
-	0x18, 0xfc, 0x3e, 0xc0, 0xda, 0xab, 0x01, 0xd6, 0x5e, 0x0f, 0x30, 0x78, 0x33, 0xc0, 0xe0, 0xed,
-	0x00, 0x83, 0x9f, 0xbe, 0xf5, 0x43, 0x4b, 0x34, 0x99, 0x68, 0x06, 0xdc, 0x8f, 0x2d, 0xce, 0xc4,
-	0x59, 0x18, 0xb5, 0x4a, 0xe3, 0x5f, 0xfd, 0x4e, 0xcb, 0x2f, 0x09, 0xc1, 0x3b, 0xc7, 0xc7, 0xb3,
-	0x72, 0x15, 0xb7, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x96, 0xb9, 0xab, 0xd4, 0xd6, 0x06, 0x00,
-	0x00,
+	// 1005 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x95, 0x31, 0x70, 0x1a, 0x47,
+	0x14, 0x86, 0x6f, 0x25, 0x2c, 0xa1, 0x45, 0x42, 0x64, 0x1d, 0xdb, 0x27, 0xa4, 0xec, 0x11, 0x79,
+	0x92, 0x21, 0x99, 0xf8, 0x48, 0x24, 0x4f, 0x26, 0xad, 0x10, 0xb2, 0x86, 0xb1, 0x04, 0xf2, 0x82,
+	0xc6, 0x93, 0x34, 0x97, 0xd5, 0xdd, 0x72, 0xdc, 0x80, 0xf6, 0xf0, 0xdd, 0x9e, 0x64, 0xa5, 0x72,
+	0xa9, 0xd2, 0xe9, 0x52, 0x66, 0x92, 0xc6, 0xa5, 0x8b, 0x14, 0x2e, 0x5d, 0xaa, 0x54, 0xe9, 0x22,
+	0x43, 0xcc, 0xd1, 0xb8, 0x74, 0xe9, 0x32, 0x73, 0xcb, 0x01, 0x46, 0x58, 0x1d, 0xef, 0xff, 0xbf,
+	0xff, 0xb1, 0x6f, 0x79, 0x3b, 0xc0, 0x5c, 0xdb, 0xf5, 0xe8, 0x29, 0xe5, 0xf7, 0x7c, 0x41, 0xcd,
+	0x56, 0x81, 0x76, 0x9c, 0xc2, 0x31, 0x13, 0xd4, 0xa2, 0x82, 0xea, 0x1d, 0xcf, 0x15, 0x2e, 0x4a,
+	0x0b, 0xc1, 0xf5, 0x98, 0xd2, 0x4f, 0x36, 0xb3, 0xf7, 0x6c, 0x47, 0x34, 0x83, 0x23, 0xdd, 0x74,
+	0x8f, 0x0b, 0xb6, 0x6b, 0xbb, 0x05, 0x89, 0x1d, 0x05, 0x0d, 0x59, 0xc9, 0x42, 0x7e, 0x1a, 0xc4,
+	0xb3, 0x6b, 0xb6, 0xeb, 0xda, 0x6d, 0x36, 0xa6, 0x7c, 0xe1, 0x05, 0xa6, 0x88, 0x5d, 0xed, 0xaa,
+	0x2b, 0x9c, 0x63, 0xe6, 0x0b, 0x7a, 0xdc, 0x89, 0x81, 0x2f, 0xa6, 0xcf, 0xc7, 0x78, 0x70, 0xec,
+	0xc7, 0xf6, 0xdd, 0x69, 0xdb, 0xb1, 0x18, 0x17, 0x4e, 0xc3, 0x61, 0x5e, 0x0c, 0xad, 0xff, 0x3b,
+	0x0f, 0x21, 0x79, 0xba, 0x1f, 0x8f, 0x85, 0xf6, 0x61, 0xca, 0xa6, 0x82, 0x9d, 0xd2, 0x33, 0xc3,
+	0xb1, 0x7c, 0x15, 0xe4, 0x40, 0x3e, 0xb5, 0xb1, 0xae, 0x4f, 0x8e, 0xa9, 0xef, 0x0e, 0x90, 0xf2,
+	0xb8, 0x5b, 0x31, 0x79, 0xd1, 0xd5, 0x94, 0xcb, 0xae, 0x06, 0x08, 0xb4, 0x87, 0xae, 0x8f, 0xee,
+	0xc2, 0x25, 0xca, 0x05, 0xe3, 0x9c, 0x1a, 0x0e, 0xb7, 0xd8, 0x53, 0x75, 0x26, 0x07, 0xf2, 0x4b,
+	0x64, 0x31, 0x16, 0xcb, 0x91, 0x86, 0xee, 0xc3, 0x44, 0x34, 0x99, 0x3a, 0x2b, 0xbf, 0x2c, 0xab,
+	0x0f, 0xc6, 0xd6, 0x87, 0x63, 0xeb, 0xf5, 0xe1, 0xd8, 0xc5, 0xc4, 0xf3, 0xff, 0x34, 0x40, 0x24,
+	0x8d, 0xd6, 0xe0, 0xc2, 0xe8, 0x3e, 0xd4, 0x84, 0x6c, 0x3b, 0x16, 0xd0, 0x57, 0x30, 0xdd, 0x70,
+	0x38, 0x33, 0xc6, 0xc8, 0x8d, 0x1c, 0xc8, 0x27, 0xc8, 0x52, 0xa4, 0x8e, 0x1a, 0xa2, 0x9f, 0xa0,
+	0xca, 0xb8, 0xe9, 0x9d, 0x75, 0x04, 0xb3, 0x8c, 0x2b, 0x81, 0xb9, 0x1c, 0xc8, 0x2f, 0x92, 0xdb,
+	0x23, 0xff, 0xc1, 0x44, 0x92, 0x41, 0xed, 0xba, 0xa4, 0xd1, 0x62, 0xd1, 0xed, 0xa9, 0xf3, 0x39,
+	0x90, 0x5f, 0x28, 0x6a, 0x61, 0x57, 0x5b, 0xdd, 0xf9, 0x64, 0x93, 0x87, 0xec, 0xac, 0x5c, 0x22,
+	0xab, 0xec, 0x5a, 0xd3, 0x42, 0x6b, 0x30, 0xe1, 0xf9, 0xbe, 0xa3, 0x26, 0x73, 0x20, 0x3f, 0x53,
+	0x4c, 0x86, 0x5d, 0x2d, 0x41, 0x6a, 0xb5, 0x32, 0x91, 0x2a, 0xda, 0x80, 0x8b, 0x66, 0x93, 0x72,
+	0xce, 0xda, 0x86, 0xa4, 0x16, 0x24, 0xb5, 0x1c, 0x76, 0xb5, 0xd4, 0xf6, 0x40, 0x97, 0x70, 0x2a,
+	0x86, 0x48, 0x94, 0x79, 0x04, 0xef, 0x44, 0xac, 0xe1, 0x0b, 0xca, 0x2d, 0xea, 0x59, 0x86, 0xc5,
+	0x4e, 0x1c, 0x2a, 0x1c, 0x97, 0xab, 0x50, 0xc6, 0x57, 0xc2, 0xae, 0x76, 0x2b, 0xca, 0xd5, 0x62,
+	0xa2, 0x34, 0x04, 0xc8, 0xad, 0x28, 0x39, 0x25, 0xa3, 0x15, 0x38, 0xeb, 0x73, 0x4f, 0x4d, 0xc9,
+	0xf8, 0x7c, 0xd8, 0xd5, 0x66, 0x6b, 0x15, 0x42, 0x22, 0x0d, 0x7d, 0x03, 0x33, 0x0d, 0x8f, 0x3d,
+	0x09, 0x18, 0x37, 0xcf, 0x0c, 0xb7, 0xd1, 0xf0, 0x99, 0x50, 0x17, 0x73, 0x20, 0x3f, 0x4b, 0x96,
+	0x47, 0x7a, 0x55, 0xca, 0xe8, 0x3e, 0x4c, 0xb6, 0x5d, 0x73, 0x70, 0x92, 0x25, 0xb9, 0x0a, 0xea,
+	0xd5, 0xbd, 0xdb, 0x8b, 0x7d, 0x32, 0x22, 0xd1, 0xaf, 0x50, 0xb5, 0xdc, 0x53, 0xde, 0x76, 0x78,
+	0xcb, 0xe8, 0x50, 0xd1, 0x34, 0x4c, 0x97, 0xfb, 0xc2, 0xa3, 0x0e, 0x17, 0x6a, 0x3a, 0x07, 0xf2,
+	0xe9, 0x8d, 0xaf, 0xaf, 0x76, 0x29, 0xc5, 0xfc, 0x01, 0x15, 0xcd, 0xed, 0x11, 0x4d, 0x6e, 0x5b,
+	0x9f, 0xd4, 0xd1, 0x97, 0x70, 0x31, 0xe8, 0xc8, 0xfe, 0xc2, 0x6d, 0x31, 0xae, 0x2e, 0xcb, 0xbd,
+	0x48, 0x0d, 0xb4, 0x7a, 0x24, 0xa1, 0x4d, 0x98, 0xa4, 0xd6, 0x09, 0xe5, 0x26, 0xb3, 0x54, 0x53,
+	0x1e, 0xfd, 0xce, 0xd4, 0x16, 0xd7, 0xe4, 0xd3, 0x26, 0x23, 0x10, 0xfd, 0x00, 0x3f, 0x1f, 0x9d,
+	0x3c, 0x10, 0x4e, 0xdb, 0xf9, 0x6d, 0x30, 0x7b, 0x26, 0xba, 0x46, 0x72, 0x73, 0xe8, 0x1d, 0x8e,
+	0x2d, 0xf4, 0xfd, 0x47, 0x91, 0x27, 0x01, 0x0b, 0x98, 0x61, 0xb1, 0x8e, 0x68, 0xaa, 0x9f, 0xc9,
+	0xf5, 0x47, 0x43, 0xef, 0x51, 0x64, 0x95, 0x22, 0x67, 0xfd, 0x1f, 0x00, 0x93, 0xc3, 0x5b, 0x43,
+	0x59, 0x98, 0x6c, 0x53, 0xe1, 0x88, 0xc0, 0x62, 0xf2, 0x65, 0x03, 0x32, 0xaa, 0xa3, 0xe7, 0xd4,
+	0x76, 0xb9, 0x3d, 0x30, 0x67, 0xa4, 0x39, 0x16, 0xa2, 0x24, 0x6d, 0xc7, 0xc9, 0xe8, 0x99, 0xde,
+	0x20, 0xa3, 0x5a, 0x7a, 0xa6, 0x19, 0x78, 0xd4, 0x3c, 0x53, 0x13, 0xb1, 0x17, 0xd7, 0xe8, 0x47,
+	0x38, 0xe7, 0xbb, 0x81, 0x67, 0x32, 0xf9, 0xfc, 0xd2, 0x1b, 0xf8, 0xba, 0x5f, 0xb4, 0x26, 0x29,
+	0x12, 0xd3, 0xdf, 0xfe, 0x3e, 0x03, 0xd3, 0x93, 0x16, 0x42, 0x30, 0x5d, 0xab, 0x1e, 0x92, 0xed,
+	0x1d, 0xe3, 0xb0, 0xf2, 0xb0, 0x52, 0x7d, 0x5c, 0xc9, 0x28, 0x28, 0x0d, 0x61, 0xac, 0xed, 0x1e,
+	0xd4, 0x32, 0x00, 0xdd, 0x84, 0xcb, 0x71, 0x4d, 0x76, 0x76, 0xcb, 0xb5, 0x3a, 0xf9, 0x39, 0x33,
+	0x8b, 0x56, 0xe0, 0xad, 0x58, 0x2c, 0x1f, 0x18, 0xbb, 0x3b, 0xd5, 0xbd, 0xea, 0xf6, 0x56, 0xbd,
+	0x5c, 0xad, 0x64, 0x12, 0x28, 0x07, 0xd7, 0x62, 0xeb, 0x71, 0xf9, 0x41, 0xd9, 0x88, 0x96, 0x7e,
+	0x82, 0xb8, 0x81, 0x30, 0xcc, 0xc6, 0x44, 0xb1, 0x3e, 0xed, 0xcf, 0x7d, 0xd4, 0x61, 0xaf, 0x4a,
+	0xb6, 0xa6, 0x89, 0xf9, 0xab, 0x44, 0xbd, 0x54, 0xdd, 0x9a, 0x20, 0x92, 0x48, 0x83, 0xab, 0x31,
+	0xb1, 0x5d, 0xdd, 0x2f, 0x96, 0x2b, 0x3b, 0xa5, 0x09, 0x60, 0x21, 0x9b, 0x38, 0xff, 0x1b, 0x2b,
+	0xc5, 0xbf, 0xc0, 0x45, 0x0f, 0x83, 0xcb, 0x1e, 0x06, 0x6f, 0x7a, 0x58, 0x79, 0xdb, 0xc3, 0xca,
+	0xbb, 0x1e, 0x56, 0xde, 0xf7, 0xb0, 0xf2, 0xa1, 0x87, 0xc1, 0xb3, 0x10, 0x83, 0xf3, 0x10, 0x2b,
+	0x2f, 0x42, 0x0c, 0x5e, 0x86, 0x58, 0x79, 0x15, 0x62, 0xe5, 0x75, 0x88, 0x95, 0x8b, 0x10, 0x83,
+	0xcb, 0x10, 0x83, 0x37, 0x21, 0x56, 0xde, 0x86, 0x18, 0xbc, 0x0b, 0xb1, 0xf2, 0x3e, 0xc4, 0xe0,
+	0x43, 0x88, 0x95, 0x67, 0x7d, 0xac, 0x9c, 0xf7, 0x31, 0x78, 0xde, 0xc7, 0xca, 0x1f, 0x7d, 0x0c,
+	0xfe, 0xec, 0x63, 0xe5, 0x45, 0x1f, 0x2b, 0x2f, 0xfb, 0x18, 0xbc, 0xea, 0x63, 0xf0, 0xba, 0x8f,
+	0xc1, 0x2f, 0xdf, 0xd9, 0xae, 0x2e, 0x9a, 0x4c, 0x34, 0x1d, 0x6e, 0xfb, 0x3a, 0x67, 0xe2, 0xd4,
+	0xf5, 0x5a, 0x85, 0xc9, 0xbf, 0x96, 0x4e, 0xcb, 0x2e, 0x08, 0xc1, 0x3b, 0x47, 0x47, 0x73, 0x72,
+	0xdf, 0x37, 0xff, 0x1f, 0x00, 0x26, 0x29, 0x6e, 0x61, 0x3b, 0x07, 0x00, 0x00,
 }
@@ -42,6 +42,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type GsNsClient interface {
 	HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
+	// ReportTxAcknowledgment reports the failed Tx acknowledgment of a downlink message scheduled by the Network Server.
+	ReportTxAcknowledgment(ctx context.Context, in *GatewayTxAcknowledgment, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type gsNsClient struct {
@@ -61,9 +63,20 @@ func (c *gsNsClient) HandleUplink(ctx context.Context, in *UplinkMessage, opts .
 	return out, nil
 }
 
+func (c *gsNsClient) ReportTxAcknowledgment(ctx context.Context, in *GatewayTxAcknowledgment, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GsNs/ReportTxAcknowledgment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GsNsServer is the server API for GsNs service.
 type GsNsServer interface {
 	HandleUplink(context.Context, *UplinkMessage) (*types.Empty, error)
+	// ReportTxAcknowledgment reports the failed Tx acknowledgment of a downlink message scheduled by the Network Server.
+	ReportTxAcknowledgment(context.Context, *GatewayTxAcknowledgment) (*types.Empty, error)
 }
 
 func RegisterGsNsServer(s *grpc.Server, srv GsNsServer) {
@@ -88,6 +101,24 @@ func _GsNs_HandleUplink_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GsNs_ReportTxAcknowledgment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayTxAcknowledgment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsNsServer).ReportTxAcknowledgment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GsNs/ReportTxAcknowledgment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsNsServer).ReportTxAcknowledgment(ctx, req.(*GatewayTxAcknowledgment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GsNs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.GsNs",
 	HandlerType: (*GsNsServer)(nil),
@@ -96,6 +127,10 @@ var _GsNs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HandleUplink",
 			Handler:    _GsNs_HandleUplink_Handler,
 		},
+		{
+			MethodName: "ReportTxAcknowledgment",
+			Handler:    _GsNs_ReportTxAcknowledgment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/networkserver.proto",
@@ -309,6 +344,8 @@ type NsEndDeviceRegistryClient interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// GetEndDeviceStats returns the traffic statistics of the device that matches the given identifiers.
+	GetEndDeviceStats(ctx context.Context, in *GetEndDeviceStatsRequest, opts ...grpc.CallOption) (*EndDeviceStats, error)
 }
 
 type nsEndDeviceRegistryClient struct {
@@ -346,6 +383,15 @@ func (c *nsEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIde
 	return out, nil
 }
 
+func (c *nsEndDeviceRegistryClient) GetEndDeviceStats(ctx context.Context, in *GetEndDeviceStatsRequest, opts ...grpc.CallOption) (*EndDeviceStats, error) {
+	out := new(EndDeviceStats)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/GetEndDeviceStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NsEndDeviceRegistryServer is the server API for NsEndDeviceRegistry service.
 type NsEndDeviceRegistryServer interface {
 	// Get returns the device that matches the given identifiers.
@@ -356,6 +402,8 @@ type NsEndDeviceRegistryServer interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// GetEndDeviceStats returns the traffic statistics of the device that matches the given identifiers.
+	GetEndDeviceStats(context.Context, *GetEndDeviceStatsRequest) (*EndDeviceStats, error)
 }
 
 func RegisterNsEndDeviceRegistryServer(s *grpc.Server, srv NsEndDeviceRegistryServer) {
@@ -416,6 +464,24 @@ func _NsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NsEndDeviceRegistry_GetEndDeviceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEndDeviceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).GetEndDeviceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/GetEndDeviceStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).GetEndDeviceStats(ctx, req.(*GetEndDeviceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.NsEndDeviceRegistry",
 	HandlerType: (*NsEndDeviceRegistryServer)(nil),
@@ -432,6 +498,10 @@ var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _NsEndDeviceRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "GetEndDeviceStats",
+			Handler:    _NsEndDeviceRegistry_GetEndDeviceStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/networkserver.proto",
@@ -445,45 +515,49 @@ func init() {
 }
 
 var fileDescriptor_networkserver_9c56cf1de73aa617 = []byte{
-	// 627 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x31, 0x48, 0x1c, 0x4f,
-	0x14, 0xc6, 0x67, 0x54, 0x2c, 0x96, 0x3f, 0xff, 0x90, 0x4d, 0x08, 0xe4, 0x92, 0x3c, 0xc2, 0x69,
-	0x20, 0x48, 0xdc, 0x0d, 0xda, 0xd9, 0x19, 0xee, 0xb8, 0x08, 0x2a, 0x51, 0x63, 0x63, 0x0a, 0xd9,
-	0xbb, 0x7b, 0xee, 0x0d, 0xb7, 0xce, 0x6c, 0x76, 0xe6, 0x14, 0x09, 0x82, 0xa4, 0xb2, 0x0c, 0x84,
-	0x40, 0xca, 0x90, 0x4a, 0x48, 0x23, 0x56, 0x96, 0x96, 0x96, 0x42, 0x1a, 0xab, 0xc4, 0x9d, 0x4d,
-	0x61, 0x69, 0x29, 0xa9, 0xc2, 0xed, 0xae, 0xde, 0x79, 0xeb, 0x85, 0x1c, 0x49, 0xb7, 0x33, 0xef,
-	0xfb, 0xbe, 0xf9, 0xbd, 0x99, 0xd9, 0x31, 0x1e, 0x79, 0x22, 0x70, 0xd6, 0x1d, 0x3e, 0x2a, 0x95,
-	0x53, 0xa9, 0xdb, 0x8e, 0xcf, 0x6c, 0x8e, 0x6a, 0x5d, 0x04, 0x75, 0x89, 0xc1, 0x1a, 0x06, 0x96,
-	0x1f, 0x08, 0x25, 0xcc, 0xff, 0x95, 0xe2, 0x56, 0x2a, 0xb5, 0xd6, 0xc6, 0x73, 0xa3, 0x2e, 0x53,
-	0xb5, 0x46, 0xd9, 0xaa, 0x88, 0x55, 0xdb, 0x15, 0xae, 0xb0, 0x63, 0x59, 0xb9, 0xb1, 0x12, 0x8f,
-	0xe2, 0x41, 0xfc, 0x95, 0xd8, 0x73, 0xf7, 0x5d, 0x21, 0x5c, 0x0f, 0xe3, 0x78, 0x87, 0x73, 0xa1,
-	0x1c, 0xc5, 0x04, 0x97, 0x69, 0xf5, 0x5e, 0x5a, 0xbd, 0xcc, 0xc0, 0x55, 0x5f, 0x6d, 0xa4, 0xc5,
-	0x7c, 0x16, 0x10, 0x79, 0x75, 0xb9, 0x8a, 0x6b, 0xac, 0x82, 0xa9, 0x66, 0x28, 0xab, 0x61, 0x55,
-	0xe4, 0x8a, 0xad, 0x30, 0x0c, 0x2e, 0x56, 0x79, 0x98, 0x15, 0xad, 0xa2, 0x94, 0x8e, 0x8b, 0xa9,
-	0x62, 0x6c, 0xc6, 0x18, 0x28, 0xc9, 0x59, 0x69, 0x16, 0x8d, 0xff, 0x9e, 0x3b, 0xbc, 0xea, 0xe1,
-	0xa2, 0xef, 0x31, 0x5e, 0x37, 0x1f, 0x58, 0x57, 0xbb, 0xb7, 0x92, 0xf9, 0x99, 0xc4, 0x9d, 0xbb,
-	0x63, 0x25, 0xfc, 0xd6, 0x05, 0xbf, 0x55, 0x6c, 0xf2, 0x8f, 0x7d, 0xeb, 0x33, 0x06, 0x26, 0x9b,
-	0x79, 0xd3, 0xc6, 0x8d, 0x69, 0xc6, 0xeb, 0x93, 0xbe, 0xef, 0xb1, 0x4a, 0xdc, 0xb9, 0xd9, 0xc5,
-	0x93, 0xcb, 0x2c, 0xd5, 0x66, 0x5a, 0xf4, 0x1f, 0xd3, 0xa7, 0xd4, 0x7c, 0x69, 0xdc, 0x2e, 0x88,
-	0x75, 0xde, 0x24, 0x98, 0x6b, 0x60, 0x03, 0xe7, 0xd1, 0xf7, 0x9c, 0x0a, 0x9a, 0xc3, 0x9d, 0xd6,
-	0x0e, 0xd5, 0xeb, 0x06, 0x4a, 0xd5, 0x0d, 0xd6, 0x9c, 0x33, 0x6e, 0x5e, 0xd1, 0xbf, 0x68, 0xc8,
-	0xda, 0x5f, 0x46, 0x2e, 0x77, 0x44, 0x4e, 0x33, 0xa9, 0xb2, 0x91, 0x45, 0x5e, 0x2d, 0xc4, 0x67,
-	0x39, 0xd5, 0x3a, 0xb1, 0xdc, 0xf0, 0x6f, 0xb6, 0xe1, 0x22, 0x53, 0x8e, 0x7d, 0x1f, 0x30, 0x6e,
-	0xcd, 0xca, 0xcb, 0x80, 0x79, 0x74, 0x99, 0x54, 0xc1, 0x86, 0xb9, 0x47, 0x8d, 0xfe, 0x12, 0x2a,
-	0x73, 0xa8, 0x33, 0xa5, 0x84, 0xaa, 0x4d, 0x9d, 0xd0, 0xdf, 0xed, 0x0a, 0x94, 0xaf, 0xbf, 0xfd,
-	0xfa, 0xe3, 0x7d, 0x1f, 0x9a, 0x15, 0x9b, 0x4b, 0xdb, 0x69, 0x11, 0x48, 0xfb, 0x4d, 0xeb, 0x0a,
-	0x2e, 0xb3, 0xaa, 0xb4, 0xda, 0x8a, 0xd7, 0x8c, 0x37, 0xed, 0x44, 0x9a, 0xf5, 0x5d, 0x7e, 0x6e,
-	0x9a, 0x3f, 0xa9, 0xd1, 0xbf, 0x70, 0x1d, 0xf4, 0x42, 0x6f, 0xd0, 0x7b, 0x34, 0xa6, 0xfe, 0x42,
-	0x73, 0xaf, 0xb2, 0xd8, 0xe9, 0x5f, 0xd3, 0x13, 0x72, 0x9b, 0xa7, 0x85, 0x3b, 0x41, 0x47, 0x96,
-	0xa6, 0xf2, 0x85, 0x7f, 0xb1, 0xc2, 0x04, 0x1d, 0x31, 0x3f, 0x50, 0x63, 0xb0, 0x80, 0x1e, 0x2a,
-	0xfc, 0xc3, 0x0b, 0xd2, 0xe5, 0xce, 0xe5, 0x67, 0xe2, 0xe6, 0x4b, 0x23, 0xc5, 0x2c, 0x59, 0x8f,
-	0x0d, 0x37, 0xe7, 0x9e, 0x7d, 0xa6, 0x87, 0x21, 0xd0, 0xa3, 0x10, 0xe8, 0x71, 0x08, 0xe4, 0x24,
-	0x04, 0x72, 0x1a, 0x02, 0x39, 0x0b, 0x81, 0x9c, 0x87, 0x40, 0xb7, 0x34, 0xd0, 0x6d, 0x0d, 0x64,
-	0x47, 0x03, 0xdd, 0xd5, 0x40, 0xf6, 0x35, 0x90, 0x03, 0x0d, 0xe4, 0x50, 0x03, 0x3d, 0xd2, 0x40,
-	0x8f, 0x35, 0x90, 0x13, 0x0d, 0xf4, 0x54, 0x03, 0x39, 0xd3, 0x40, 0xcf, 0x35, 0x90, 0xad, 0x08,
-	0xc8, 0x76, 0x04, 0xf4, 0x5d, 0x04, 0xe4, 0x63, 0x04, 0xf4, 0x53, 0x04, 0x64, 0x27, 0x02, 0xb2,
-	0x1b, 0x01, 0xdd, 0x8f, 0x80, 0x1e, 0x44, 0x40, 0x97, 0x9e, 0xb8, 0xc2, 0x52, 0x35, 0x54, 0x35,
-	0xc6, 0x5d, 0x69, 0xa5, 0xef, 0xb1, 0x7d, 0xf5, 0xfd, 0xf2, 0xeb, 0xae, 0xad, 0x14, 0xf7, 0xcb,
-	0xe5, 0xc1, 0x78, 0x0f, 0xc6, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xf3, 0xf0, 0xbc, 0xcb,
-	0x05, 0x00, 0x00,
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x3f, 0x48, 0x1c, 0x4f,
+	0x14, 0xde, 0x51, 0xb1, 0x58, 0x7e, 0xfc, 0x7e, 0x38, 0xbf, 0x20, 0xe4, 0x92, 0x3c, 0xc2, 0x69,
+	0x88, 0x48, 0xdc, 0x0d, 0xda, 0xd9, 0x19, 0xee, 0xb8, 0x08, 0x2a, 0xf1, 0x5f, 0x11, 0x53, 0xc8,
+	0xde, 0xdd, 0x73, 0x6f, 0xd8, 0xbd, 0x99, 0x75, 0x67, 0xce, 0x8b, 0x04, 0x41, 0x52, 0x59, 0x06,
+	0x42, 0x20, 0x65, 0x48, 0x25, 0xa4, 0x11, 0x2b, 0x4b, 0x4b, 0x4b, 0x21, 0x8d, 0x55, 0xf0, 0x76,
+	0x53, 0x58, 0x5a, 0x4a, 0xaa, 0x70, 0x7b, 0x7b, 0xde, 0x79, 0xeb, 0x49, 0x24, 0x21, 0xdd, 0xcc,
+	0xbe, 0xef, 0x7d, 0xf3, 0x7d, 0xf3, 0xbe, 0xdd, 0xd5, 0x1f, 0xb9, 0xc2, 0xb7, 0xaa, 0x16, 0x1f,
+	0x93, 0xca, 0x2a, 0x38, 0xa6, 0xe5, 0x31, 0x93, 0xa3, 0xaa, 0x0a, 0xdf, 0x91, 0xe8, 0x6f, 0xa0,
+	0x6f, 0x78, 0xbe, 0x50, 0x82, 0xfe, 0xab, 0x14, 0x37, 0x62, 0xa8, 0xb1, 0x31, 0x91, 0x1a, 0xb3,
+	0x99, 0x2a, 0x55, 0xf2, 0x46, 0x41, 0x94, 0x4d, 0x5b, 0xd8, 0xc2, 0x8c, 0x60, 0xf9, 0xca, 0x5a,
+	0xb4, 0x8b, 0x36, 0xd1, 0xaa, 0xd1, 0x9e, 0xba, 0x6f, 0x0b, 0x61, 0xbb, 0x18, 0xd1, 0x5b, 0x9c,
+	0x0b, 0x65, 0x29, 0x26, 0xb8, 0x8c, 0xab, 0xf7, 0xe2, 0xea, 0x25, 0x07, 0x96, 0x3d, 0xb5, 0x19,
+	0x17, 0xd3, 0x49, 0x81, 0xc8, 0x8b, 0xab, 0x45, 0xdc, 0x60, 0x05, 0x8c, 0x31, 0x43, 0x49, 0x0c,
+	0x2b, 0x22, 0x57, 0x6c, 0x8d, 0xa1, 0xdf, 0x3c, 0xe5, 0x61, 0x12, 0x54, 0x46, 0x29, 0x2d, 0x1b,
+	0x63, 0xc4, 0xf8, 0x2e, 0xd1, 0xfb, 0x72, 0x72, 0x4e, 0xd2, 0xac, 0xfe, 0xcf, 0x73, 0x8b, 0x17,
+	0x5d, 0x5c, 0xf6, 0x5c, 0xc6, 0x1d, 0xfa, 0xc0, 0xb8, 0x6a, 0xdf, 0x68, 0x3c, 0x9f, 0x6d, 0xb4,
+	0xa7, 0x06, 0x8d, 0x86, 0x01, 0xa3, 0x69, 0xc0, 0xc8, 0xd6, 0x0d, 0xd0, 0x97, 0xfa, 0xe0, 0x02,
+	0x7a, 0xc2, 0x57, 0x4b, 0xaf, 0xa7, 0x0a, 0x0e, 0x17, 0x55, 0x17, 0x8b, 0x76, 0x19, 0xb9, 0xa2,
+	0x8f, 0x3b, 0x09, 0x73, 0x96, 0xc2, 0xaa, 0xb5, 0xd9, 0x09, 0xec, 0x46, 0x3d, 0xfe, 0xad, 0x47,
+	0xef, 0x9b, 0xaa, 0x4b, 0x9d, 0xd1, 0xff, 0x9b, 0x61, 0xdc, 0x99, 0xf2, 0x3c, 0x97, 0x15, 0xa2,
+	0x5b, 0xa5, 0x5d, 0x7a, 0x52, 0x09, 0x17, 0x6d, 0x4d, 0xcb, 0xde, 0x08, 0x79, 0x4a, 0xe8, 0x92,
+	0x7e, 0x27, 0x23, 0xaa, 0xbc, 0x6e, 0x6e, 0xbe, 0x82, 0x15, 0x5c, 0x40, 0xcf, 0xb5, 0x0a, 0x48,
+	0x87, 0x3b, 0x5b, 0x3b, 0x50, 0xeb, 0x15, 0x94, 0x5d, 0xc5, 0xd2, 0x79, 0x7d, 0xe0, 0x0a, 0xfe,
+	0x45, 0x45, 0x96, 0x7e, 0x93, 0x72, 0xb5, 0x83, 0x72, 0x86, 0x49, 0x95, 0xa4, 0xcc, 0xf2, 0x62,
+	0x26, 0xca, 0xc9, 0x74, 0x2b, 0x0d, 0xa9, 0xe1, 0x1b, 0xae, 0xa1, 0xc9, 0x29, 0xc7, 0xf7, 0xfb,
+	0xf5, 0xff, 0xe7, 0xe4, 0x25, 0xc1, 0x02, 0xda, 0x4c, 0x2a, 0x7f, 0x93, 0xee, 0x13, 0xbd, 0x37,
+	0x87, 0x8a, 0x0e, 0x25, 0x26, 0x88, 0xaa, 0x0d, 0xdd, 0x50, 0x7f, 0xb7, 0xab, 0xa0, 0xb4, 0xf3,
+	0xf6, 0xeb, 0xf7, 0xf7, 0x3d, 0x48, 0x0b, 0x26, 0x97, 0xa6, 0xd5, 0x52, 0x20, 0xcd, 0x37, 0xad,
+	0x78, 0xaf, 0xb2, 0xa2, 0x34, 0xda, 0x8a, 0xd7, 0xec, 0xb7, 0xcc, 0x06, 0x34, 0xd9, 0x77, 0xb9,
+	0xdc, 0xa2, 0x3f, 0x88, 0xde, 0xbb, 0x78, 0x9d, 0xe8, 0xc5, 0xdb, 0x89, 0xde, 0x27, 0x91, 0xea,
+	0x2f, 0x24, 0xf5, 0x2a, 0x29, 0x3b, 0x7e, 0x23, 0x6f, 0x25, 0xb9, 0xad, 0xa7, 0x25, 0x77, 0x92,
+	0x8c, 0xae, 0x4c, 0xa7, 0x33, 0x7f, 0xe2, 0x84, 0x49, 0x32, 0x4a, 0x3f, 0x10, 0xbd, 0x3f, 0x83,
+	0x2e, 0x2a, 0xfc, 0xc5, 0x80, 0x74, 0xc9, 0x5c, 0x7a, 0x36, 0x32, 0x9f, 0x1b, 0xcd, 0x26, 0x95,
+	0xdd, 0xd2, 0x70, 0x34, 0x94, 0x63, 0xa2, 0x0f, 0xb4, 0x87, 0x66, 0x51, 0x59, 0x4a, 0xd2, 0x91,
+	0x9b, 0x72, 0x15, 0x41, 0x9a, 0x73, 0x82, 0xae, 0x66, 0x22, 0x58, 0x7a, 0x3d, 0x92, 0xeb, 0x50,
+	0xf6, 0x17, 0x12, 0x66, 0xca, 0xfa, 0x91, 0xcf, 0x3e, 0x93, 0xa3, 0x1a, 0x90, 0xe3, 0x1a, 0x90,
+	0x93, 0x1a, 0x68, 0xa7, 0x35, 0xd0, 0xce, 0x6a, 0xa0, 0x9d, 0xd7, 0x40, 0xbb, 0xa8, 0x01, 0xd9,
+	0x0e, 0x80, 0xec, 0x04, 0xa0, 0xed, 0x06, 0x40, 0xf6, 0x02, 0xd0, 0x0e, 0x02, 0xd0, 0x0e, 0x03,
+	0xd0, 0x8e, 0x02, 0x20, 0xc7, 0x01, 0x90, 0x93, 0x00, 0xb4, 0xd3, 0x00, 0xc8, 0x59, 0x00, 0xda,
+	0x79, 0x00, 0xe4, 0x22, 0x00, 0x6d, 0x3b, 0x04, 0x6d, 0x27, 0x04, 0xf2, 0x2e, 0x04, 0xed, 0x63,
+	0x08, 0xe4, 0x53, 0x08, 0xda, 0x6e, 0x08, 0xda, 0x5e, 0x08, 0xe4, 0x20, 0x04, 0x72, 0x18, 0x02,
+	0x59, 0x79, 0x62, 0x0b, 0x43, 0x95, 0x50, 0x95, 0x18, 0xb7, 0xa5, 0x11, 0xff, 0xbe, 0xcc, 0xab,
+	0x9f, 0x7b, 0xcf, 0xb1, 0x4d, 0xa5, 0xb8, 0x97, 0xcf, 0xf7, 0x47, 0x63, 0x9d, 0xf8, 0x39, 0x00,
+	0x7a, 0xd8, 0x13, 0xf0, 0xfa, 0x06, 0x00, 0x00,
 }
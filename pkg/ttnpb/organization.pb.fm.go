@@ -17,6 +17,7 @@ var OrganizationFieldPathsNested = []string{
 	"ids",
 	"ids.organization_id",
 	"name",
+	"state",
 	"updated_at",
 }
 
@@ -27,6 +28,7 @@ var OrganizationFieldPathsTopLevel = []string{
 	"description",
 	"ids",
 	"name",
+	"state",
 	"updated_at",
 }
 
@@ -109,6 +111,16 @@ func (dst *Organization) SetFields(src *Organization, paths ...string) error {
 			} else {
 				dst.ContactInfo = nil
 			}
+		case "state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.State = src.State
+			} else {
+				var zero State
+				dst.State = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -305,6 +317,7 @@ var CreateOrganizationRequestFieldPathsNested = []string{
 	"organization.ids",
 	"organization.ids.organization_id",
 	"organization.name",
+	"organization.state",
 	"organization.updated_at",
 }
 
@@ -370,6 +383,7 @@ var UpdateOrganizationRequestFieldPathsNested = []string{
 	"organization.ids",
 	"organization.ids.organization_id",
 	"organization.name",
+	"organization.state",
 	"organization.updated_at",
 }
 
@@ -418,6 +432,8 @@ func (dst *UpdateOrganizationRequest) SetFields(src *UpdateOrganizationRequest,
 }
 
 var CreateOrganizationAPIKeyRequestFieldPathsNested = []string{
+	"allowed_ips",
+	"expires_at",
 	"name",
 	"organization_ids",
 	"organization_ids.organization_id",
@@ -425,6 +441,8 @@ var CreateOrganizationAPIKeyRequestFieldPathsNested = []string{
 }
 
 var CreateOrganizationAPIKeyRequestFieldPathsTopLevel = []string{
+	"allowed_ips",
+	"expires_at",
 	"name",
 	"organization_ids",
 	"rights",
@@ -470,6 +488,24 @@ func (dst *CreateOrganizationAPIKeyRequest) SetFields(src *CreateOrganizationAPI
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "allowed_ips":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allowed_ips' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowedIPs = src.AllowedIPs
+			} else {
+				dst.AllowedIPs = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -480,16 +516,21 @@ func (dst *CreateOrganizationAPIKeyRequest) SetFields(src *CreateOrganizationAPI
 
 var UpdateOrganizationAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_ips",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
+	"api_key.last_used_at",
 	"api_key.name",
 	"api_key.rights",
+	"field_mask",
 	"organization_ids",
 	"organization_ids.organization_id",
 }
 
 var UpdateOrganizationAPIKeyRequestFieldPathsTopLevel = []string{
 	"api_key",
+	"field_mask",
 	"organization_ids",
 }
 
@@ -532,6 +573,16 @@ func (dst *UpdateOrganizationAPIKeyRequest) SetFields(src *UpdateOrganizationAPI
 					dst.APIKey = zero
 				}
 			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero github_com_gogo_protobuf_types.FieldMask
+				dst.FieldMask = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
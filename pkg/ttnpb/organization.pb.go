@@ -41,8 +41,11 @@ type Organization struct {
 	Description             string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	Attributes              map[string]string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	ContactInfo             []*ContactInfo    `protobuf:"bytes,7,rep,name=contact_info,json=contactInfo,proto3" json:"contact_info,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}          `json:"-"`
-	XXX_sizecache           int32             `json:"-"`
+	// The reviewing state of the organization.
+	// This field can only be modified by admins.
+	State                State    `protobuf:"varint,8,opt,name=state,proto3,enum=ttn.lorawan.v3.State" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Organization) Reset()      { *m = Organization{} }
@@ -119,6 +122,13 @@ func (m *Organization) GetContactInfo() []*ContactInfo {
 	return nil
 }
 
+func (m *Organization) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return STATE_REQUESTED
+}
+
 type Organizations struct {
 	Organizations        []*Organization `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -390,10 +400,12 @@ func (m *UpdateOrganizationRequest) GetFieldMask() types.FieldMask {
 
 type CreateOrganizationAPIKeyRequest struct {
 	OrganizationIdentifiers `protobuf:"bytes,1,opt,name=organization_ids,json=organizationIds,proto3,embedded=organization_ids" json:"organization_ids"`
-	Name                    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights                  []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	Name                    string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights                  []Right    `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	ExpiresAt               *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	AllowedIPs              []string   `protobuf:"bytes,5,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}   `json:"-"`
+	XXX_sizecache           int32      `json:"-"`
 }
 
 func (m *CreateOrganizationAPIKeyRequest) Reset()      { *m = CreateOrganizationAPIKeyRequest{} }
@@ -442,11 +454,26 @@ func (m *CreateOrganizationAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateOrganizationAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateOrganizationAPIKeyRequest) GetAllowedIPs() []string {
+	if m != nil {
+		return m.AllowedIPs
+	}
+	return nil
+}
+
 type UpdateOrganizationAPIKeyRequest struct {
 	OrganizationIdentifiers `protobuf:"bytes,1,opt,name=organization_ids,json=organizationIds,proto3,embedded=organization_ids" json:"organization_ids"`
 	APIKey                  `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	FieldMask               types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral    struct{}        `json:"-"`
+	XXX_sizecache           int32           `json:"-"`
 }
 
 func (m *UpdateOrganizationAPIKeyRequest) Reset()      { *m = UpdateOrganizationAPIKeyRequest{} }
@@ -481,6 +508,13 @@ func (m *UpdateOrganizationAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateOrganizationAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateOrganizationAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type SetOrganizationCollaboratorRequest struct {
 	OrganizationIdentifiers `protobuf:"bytes,1,opt,name=organization_ids,json=organizationIds,proto3,embedded=organization_ids" json:"organization_ids"`
 	Collaborator            Collaborator `protobuf:"bytes,2,opt,name=collaborator,proto3" json:"collaborator"`
@@ -599,6 +633,9 @@ func (this *Organization) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.State != that1.State {
+		return false
+	}
 	return true
 }
 func (this *Organizations) Equal(that interface{}) bool {
@@ -780,6 +817,21 @@ func (this *CreateOrganizationAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedIPs) != len(that1.AllowedIPs) {
+		return false
+	}
+	for i := range this.AllowedIPs {
+		if this.AllowedIPs[i] != that1.AllowedIPs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateOrganizationAPIKeyRequest) Equal(that interface{}) bool {
@@ -807,6 +859,9 @@ func (this *UpdateOrganizationAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *SetOrganizationCollaboratorRequest) Equal(that interface{}) bool {
@@ -916,6 +971,11 @@ func (m *Organization) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.State != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintOrganization(dAtA, i, uint64(m.State))
+	}
 	return i, nil
 }
 
@@ -1149,6 +1209,31 @@ func (m *CreateOrganizationAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintOrganization(dAtA, i, uint64(j13))
 		i += copy(dAtA[i:], dAtA14[:j13])
 	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintOrganization(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n22, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if len(m.AllowedIPs) > 0 {
+		for _, s := range m.AllowedIPs {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -1183,6 +1268,14 @@ func (m *UpdateOrganizationAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n16
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintOrganization(dAtA, i, uint64(m.FieldMask.Size()))
+	n23, err := m.FieldMask.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n23
 	return i, nil
 }
 
@@ -1253,6 +1346,7 @@ func NewPopulatedOrganization(r randyOrganization, easy bool) *Organization {
 			this.ContactInfo[i] = NewPopulatedContactInfo(r, easy)
 		}
 	}
+	this.State = State([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1330,6 +1424,14 @@ func NewPopulatedCreateOrganizationAPIKeyRequest(r randyOrganization, easy bool)
 	for i := 0; i < v15; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
+	if r.Intn(10) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v23 := r.Intn(10)
+	this.AllowedIPs = make([]string, v23)
+	for i := 0; i < v23; i++ {
+		this.AllowedIPs[i] = randStringOrganization(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1341,6 +1443,8 @@ func NewPopulatedUpdateOrganizationAPIKeyRequest(r randyOrganization, easy bool)
 	this.OrganizationIdentifiers = *v16
 	v17 := NewPopulatedAPIKey(r, easy)
 	this.APIKey = *v17
+	v24 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v24
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1463,6 +1567,9 @@ func (m *Organization) Size() (n int) {
 			n += 1 + l + sovOrganization(uint64(l))
 		}
 	}
+	if m.State != 0 {
+		n += 1 + sovOrganization(uint64(m.State))
+	}
 	return n
 }
 
@@ -1564,6 +1671,16 @@ func (m *CreateOrganizationAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovOrganization(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovOrganization(uint64(l))
+	}
+	if len(m.AllowedIPs) > 0 {
+		for _, s := range m.AllowedIPs {
+			l = len(s)
+			n += 1 + l + sovOrganization(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1577,6 +1694,8 @@ func (m *UpdateOrganizationAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovOrganization(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovOrganization(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovOrganization(uint64(l))
 	return n
 }
 
@@ -1628,6 +1747,7 @@ func (this *Organization) String() string {
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`Attributes:` + mapStringForAttributes + `,`,
 		`ContactInfo:` + strings.Replace(fmt.Sprintf("%v", this.ContactInfo), "ContactInfo", "ContactInfo", 1) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1697,6 +1817,8 @@ func (this *CreateOrganizationAPIKeyRequest) String() string {
 		`OrganizationIdentifiers:` + strings.Replace(strings.Replace(this.OrganizationIdentifiers.String(), "OrganizationIdentifiers", "OrganizationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedIPs:` + fmt.Sprintf("%v", this.AllowedIPs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1708,6 +1830,7 @@ func (this *UpdateOrganizationAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateOrganizationAPIKeyRequest{`,
 		`OrganizationIdentifiers:` + strings.Replace(strings.Replace(this.OrganizationIdentifiers.String(), "OrganizationIdentifiers", "OrganizationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(this.APIKey.String(), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2057,6 +2180,25 @@ func (m *Organization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (State(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
@@ -2823,6 +2965,68 @@ func (m *CreateOrganizationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedIPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedIPs = append(m.AllowedIPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
@@ -2933,6 +3137,36 @@ func (m *UpdateOrganizationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
@@ -3177,59 +3411,64 @@ func init() {
 }
 
 var fileDescriptor_organization_956fac6cd9b16990 = []byte{
-	// 857 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x3d, 0x8c, 0x1b, 0x45,
-	0x14, 0x9e, 0xb1, 0x2f, 0x0e, 0x1e, 0x9f, 0x93, 0x68, 0x05, 0xd1, 0xc6, 0x44, 0x63, 0x6b, 0x41,
-	0xc2, 0x45, 0xbc, 0x96, 0x2e, 0x0d, 0x20, 0x01, 0xb2, 0x2d, 0x82, 0x8e, 0x04, 0x05, 0x36, 0x44,
-	0x48, 0x08, 0xc9, 0x1a, 0xdb, 0xe3, 0xf5, 0xc8, 0xf6, 0xce, 0x32, 0x3b, 0x4e, 0x64, 0xaa, 0x94,
-	0x29, 0x53, 0x52, 0x22, 0xaa, 0x2b, 0xd3, 0x71, 0xa2, 0x3a, 0x51, 0x9d, 0xa8, 0xae, 0xbc, 0xea,
-	0x38, 0xef, 0x36, 0x57, 0xa1, 0x2b, 0xaf, 0x44, 0x3b, 0xbb, 0xc6, 0xbb, 0xb6, 0xb1, 0x04, 0x27,
-	0xae, 0x9b, 0xb7, 0xf3, 0xbd, 0x6f, 0xbe, 0xf7, 0xab, 0x45, 0xef, 0x8e, 0xb8, 0x20, 0xcf, 0x89,
-	0x53, 0xf3, 0x24, 0xe9, 0x0e, 0xeb, 0xc4, 0x65, 0x75, 0x2e, 0x6c, 0xe2, 0xb0, 0x1f, 0x88, 0x64,
-	0xdc, 0x31, 0x5d, 0xc1, 0x25, 0xd7, 0x6e, 0x48, 0xe9, 0x98, 0x31, 0xd2, 0x7c, 0x76, 0xbf, 0x54,
-	0xb3, 0x99, 0x1c, 0x4c, 0x3a, 0x66, 0x97, 0x8f, 0xeb, 0x36, 0xb7, 0x79, 0x5d, 0xc1, 0x3a, 0x93,
-	0xbe, 0xb2, 0x94, 0xa1, 0x4e, 0x91, 0x7b, 0xa9, 0x62, 0x73, 0x6e, 0x8f, 0xe8, 0x02, 0xd5, 0x67,
-	0x74, 0xd4, 0x6b, 0x8f, 0x89, 0x37, 0x8c, 0x11, 0xe5, 0x65, 0x84, 0x64, 0x63, 0xea, 0x49, 0x32,
-	0x76, 0x63, 0xc0, 0x1a, 0x9d, 0x5d, 0xee, 0x48, 0xd2, 0x95, 0x6d, 0xe6, 0xf4, 0xe7, 0x0f, 0xbd,
-	0xb3, 0x8a, 0x62, 0x3d, 0xea, 0x48, 0xd6, 0x67, 0x54, 0x78, 0x31, 0x08, 0xaf, 0x82, 0x04, 0xb3,
-	0x07, 0x32, 0xbe, 0x37, 0x4e, 0xb3, 0x68, 0xfb, 0x71, 0x22, 0x07, 0x5a, 0x0b, 0x65, 0x59, 0xcf,
-	0xd3, 0x61, 0x05, 0x56, 0x0b, 0x3b, 0xef, 0x99, 0xe9, 0x5c, 0x98, 0x49, 0xe8, 0xee, 0xe2, 0xb1,
-	0xe6, 0x1b, 0x87, 0x27, 0x65, 0x70, 0x74, 0x52, 0x86, 0x56, 0xe8, 0xad, 0xb5, 0x10, 0xea, 0x0a,
-	0x4a, 0x24, 0xed, 0xb5, 0x89, 0xd4, 0x33, 0x8a, 0xab, 0x64, 0x46, 0x61, 0x9b, 0xf3, 0xb0, 0xcd,
-	0xaf, 0xe7, 0x61, 0x47, 0xee, 0xaf, 0xfe, 0x28, 0x43, 0x2b, 0x1f, 0xfb, 0x35, 0x64, 0x48, 0x32,
-	0x71, 0x7b, 0x73, 0x92, 0xec, 0xbf, 0x21, 0x89, 0xfd, 0x1a, 0x52, 0xd3, 0xd0, 0x96, 0x43, 0xc6,
-	0x54, 0xdf, 0xaa, 0xc0, 0x6a, 0xde, 0x52, 0x67, 0xad, 0x82, 0x0a, 0x3d, 0xea, 0x75, 0x05, 0x73,
-	0xc3, 0x30, 0xf4, 0x6b, 0xea, 0x2a, 0xf9, 0x49, 0x7b, 0x84, 0x10, 0x91, 0x52, 0xb0, 0xce, 0x44,
-	0x52, 0x4f, 0xcf, 0x55, 0xb2, 0xd5, 0xc2, 0xce, 0xbd, 0x4d, 0xb9, 0x30, 0x1b, 0x7f, 0xc3, 0x3f,
-	0x75, 0xa4, 0x98, 0x5a, 0x09, 0x7f, 0xed, 0x63, 0xb4, 0x9d, 0x2c, 0x9f, 0x7e, 0x5d, 0xf1, 0xbd,
-	0xbd, 0xcc, 0xd7, 0x8a, 0x30, 0xbb, 0x4e, 0x9f, 0x5b, 0x85, 0xee, 0xc2, 0x28, 0x7d, 0x84, 0x6e,
-	0x2e, 0xd1, 0x6b, 0xb7, 0x50, 0x76, 0x48, 0xa7, 0xaa, 0x4a, 0x79, 0x2b, 0x3c, 0x6a, 0x6f, 0xa2,
-	0x6b, 0xcf, 0xc8, 0x68, 0x42, 0x55, 0xb6, 0xf3, 0x56, 0x64, 0x7c, 0x98, 0x79, 0x1f, 0x1a, 0x4f,
-	0x50, 0x31, 0x29, 0xd5, 0xd3, 0x9a, 0xa8, 0x98, 0x6c, 0xfb, 0xb0, 0xd8, 0xa1, 0xa0, 0xbb, 0x9b,
-	0x02, 0xb4, 0xd2, 0x2e, 0xc6, 0x2f, 0x10, 0xdd, 0xfe, 0x8c, 0xca, 0x14, 0x84, 0x7e, 0x3f, 0xa1,
-	0x9e, 0xd4, 0xbe, 0x43, 0xb7, 0x92, 0xd8, 0xf6, 0xa5, 0xda, 0xe9, 0x26, 0x4f, 0x41, 0x3c, 0xed,
-	0x13, 0x84, 0x16, 0x03, 0xf5, 0x8f, 0xad, 0xf5, 0x20, 0x84, 0x7c, 0x41, 0xbc, 0x61, 0x73, 0x2b,
-	0xa4, 0xb2, 0xf2, 0xfd, 0xf9, 0x07, 0xe3, 0x4f, 0x88, 0xf4, 0x47, 0xcc, 0x4b, 0x49, 0xf7, 0xe6,
-	0xda, 0xbf, 0x0a, 0x4b, 0x35, 0x1a, 0x91, 0x0e, 0x17, 0x44, 0x72, 0x11, 0xeb, 0xae, 0x6d, 0xd2,
-	0xfd, 0x58, 0x3c, 0xf5, 0xa8, 0x48, 0xa8, 0xb7, 0x52, 0x14, 0x97, 0x16, 0x1c, 0x56, 0x96, 0x8b,
-	0x1e, 0x15, 0x6a, 0x04, 0xf2, 0x56, 0x64, 0x84, 0x5f, 0x47, 0x6c, 0xcc, 0xa4, 0xea, 0xec, 0xa2,
-	0x15, 0x19, 0x61, 0xbb, 0xbb, 0xc4, 0xa6, 0xaa, 0xa7, 0x8b, 0x96, 0x3a, 0x1b, 0x07, 0x10, 0xdd,
-	0x69, 0xa9, 0xa9, 0x5a, 0x57, 0xad, 0xcf, 0xd1, 0x76, 0x32, 0xc5, 0x71, 0xc4, 0x1b, 0x7b, 0x21,
-	0x51, 0x9e, 0x94, 0xaf, 0xf6, 0xcd, 0x52, 0xf6, 0x32, 0xff, 0x21, 0x7b, 0x71, 0xfc, 0x29, 0x22,
-	0x63, 0x0f, 0xa2, 0x3b, 0x4f, 0xd5, 0x4c, 0xff, 0xdf, 0x21, 0x5c, 0xba, 0xbd, 0x7e, 0x83, 0xa8,
-	0xbc, 0x9a, 0xed, 0xc6, 0x97, 0xbb, 0x0f, 0xe9, 0xf4, 0x6a, 0x26, 0x64, 0xbe, 0xf2, 0x32, 0x89,
-	0x95, 0x57, 0x43, 0xb9, 0x68, 0xed, 0xeb, 0xd9, 0x4a, 0xb6, 0x7a, 0x63, 0xe7, 0xad, 0xe5, 0x77,
-	0xac, 0xf0, 0xd6, 0x8a, 0x41, 0xc6, 0xaf, 0x10, 0x95, 0x57, 0xf3, 0x7d, 0x95, 0x41, 0x7c, 0x80,
-	0xae, 0x13, 0x97, 0xb5, 0xc3, 0x25, 0x17, 0x15, 0xe1, 0xf6, 0x32, 0x69, 0xa4, 0x26, 0xc1, 0x91,
-	0x23, 0x2e, 0x7b, 0x48, 0xa7, 0xc6, 0xef, 0x10, 0x19, 0x4f, 0xd2, 0xab, 0xa9, 0x95, 0x68, 0xa6,
-	0xab, 0xd1, 0xff, 0x60, 0xed, 0x28, 0xdc, 0x5d, 0xdd, 0xf9, 0x0b, 0xcc, 0xba, 0xce, 0x6f, 0xfe,
-	0x0c, 0x0f, 0x67, 0x18, 0x1e, 0xcd, 0x30, 0x3c, 0x9e, 0x61, 0x70, 0x3a, 0xc3, 0xe0, 0x6c, 0x86,
-	0xc1, 0xf9, 0x0c, 0x83, 0x8b, 0x19, 0x86, 0x2f, 0x7c, 0x0c, 0x5f, 0xfa, 0x18, 0xec, 0xf9, 0x18,
-	0xbe, 0xf6, 0x31, 0xd8, 0xf7, 0x31, 0x38, 0xf0, 0x31, 0x38, 0xf4, 0x31, 0x3c, 0xf2, 0x31, 0x3c,
-	0xf6, 0x31, 0x38, 0xf5, 0x31, 0x3c, 0xf3, 0x31, 0x38, 0xf7, 0x31, 0xbc, 0xf0, 0x31, 0x78, 0x11,
-	0x60, 0xf0, 0x32, 0xc0, 0xf0, 0x55, 0x80, 0xc1, 0x8f, 0x01, 0x86, 0x3f, 0x05, 0x18, 0xec, 0x05,
-	0x18, 0xbc, 0x0e, 0x30, 0xdc, 0x0f, 0x30, 0x3c, 0x08, 0x30, 0xfc, 0xf6, 0x9e, 0xcd, 0x4d, 0x39,
-	0xa0, 0x72, 0xc0, 0x1c, 0xdb, 0x33, 0x1d, 0x2a, 0x9f, 0x73, 0x31, 0xac, 0xa7, 0xff, 0x25, 0xdc,
-	0xa1, 0x5d, 0x97, 0xd2, 0x71, 0x3b, 0x9d, 0x9c, 0x1a, 0x8c, 0xfb, 0x7f, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0xa6, 0x84, 0x7e, 0xef, 0x60, 0x09, 0x00, 0x00,
+	// 944 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xde, 0x89, 0x9d, 0xb4, 0x1e, 0x27, 0x69, 0xb5, 0x82, 0x6a, 0x1b, 0xca, 0xd8, 0x32, 0x48,
+	0x58, 0xa2, 0x59, 0x4b, 0xe9, 0x05, 0x90, 0x00, 0xd9, 0x16, 0x45, 0xa6, 0x45, 0x2d, 0x13, 0x2a,
+	0x24, 0x84, 0x64, 0x8d, 0xed, 0xf1, 0x66, 0xe4, 0xf5, 0xce, 0x32, 0x33, 0x6e, 0x08, 0xa7, 0x1e,
+	0x7b, 0x41, 0xea, 0x91, 0x23, 0xe2, 0x94, 0x63, 0x0f, 0x48, 0xf4, 0x98, 0x63, 0xc4, 0x29, 0xc7,
+	0x9e, 0x42, 0xbd, 0x7b, 0xe9, 0x09, 0xf5, 0x58, 0x89, 0x0b, 0xda, 0xd9, 0x35, 0xde, 0xb5, 0x8d,
+	0xa5, 0x12, 0x35, 0xb7, 0x79, 0xf3, 0xbe, 0xf7, 0xfc, 0xcd, 0xf7, 0x7e, 0xd6, 0xf0, 0x5d, 0x97,
+	0x0b, 0xb2, 0x4f, 0xbc, 0x6d, 0xa9, 0x48, 0x77, 0x50, 0x23, 0x3e, 0xab, 0x71, 0xe1, 0x10, 0x8f,
+	0xfd, 0x48, 0x14, 0xe3, 0x9e, 0xed, 0x0b, 0xae, 0xb8, 0xb9, 0xa9, 0x94, 0x67, 0x27, 0x48, 0xfb,
+	0xfe, 0x8d, 0xad, 0x6d, 0x87, 0xa9, 0xbd, 0x51, 0xc7, 0xee, 0xf2, 0x61, 0xcd, 0xe1, 0x0e, 0xaf,
+	0x69, 0x58, 0x67, 0xd4, 0xd7, 0x96, 0x36, 0xf4, 0x29, 0x0e, 0xdf, 0x2a, 0x3b, 0x9c, 0x3b, 0x2e,
+	0x9d, 0xa2, 0xfa, 0x8c, 0xba, 0xbd, 0xf6, 0x90, 0xc8, 0x41, 0x82, 0x28, 0xcd, 0x22, 0x14, 0x1b,
+	0x52, 0xa9, 0xc8, 0xd0, 0x4f, 0x00, 0x0b, 0x78, 0x76, 0xb9, 0xa7, 0x48, 0x57, 0xb5, 0x99, 0xd7,
+	0x9f, 0xfc, 0xd0, 0x3b, 0xf3, 0x28, 0xd6, 0xa3, 0x9e, 0x62, 0x7d, 0x46, 0x85, 0x4c, 0x40, 0x68,
+	0x1e, 0x24, 0x98, 0xb3, 0xa7, 0x26, 0xfe, 0xb7, 0xe7, 0xfd, 0xd4, 0x1b, 0x0d, 0x13, 0x77, 0xe5,
+	0xa7, 0x3c, 0x5c, 0xbf, 0x93, 0x92, 0xc8, 0x6c, 0xc2, 0x1c, 0xeb, 0x49, 0x0b, 0x94, 0x41, 0xb5,
+	0xb8, 0xf3, 0x9e, 0x9d, 0x95, 0xca, 0x4e, 0x43, 0x5b, 0x53, 0x2e, 0x8d, 0x8b, 0xc7, 0xa7, 0x25,
+	0xe3, 0xe4, 0xb4, 0x04, 0x70, 0x14, 0x6d, 0x36, 0x21, 0xec, 0x0a, 0x4a, 0x14, 0xed, 0xb5, 0x89,
+	0xb2, 0x56, 0x74, 0xae, 0x2d, 0x3b, 0x56, 0xc5, 0x9e, 0xa8, 0x62, 0x7f, 0x3d, 0x51, 0x25, 0x0e,
+	0x7f, 0xf4, 0x67, 0x09, 0xe0, 0x42, 0x12, 0x57, 0x57, 0x51, 0x92, 0x91, 0xdf, 0x9b, 0x24, 0xc9,
+	0xbd, 0x4a, 0x92, 0x24, 0xae, 0xae, 0x4c, 0x13, 0xe6, 0x3d, 0x32, 0xa4, 0x56, 0xbe, 0x0c, 0xaa,
+	0x05, 0xac, 0xcf, 0x66, 0x19, 0x16, 0x7b, 0x54, 0x76, 0x05, 0xf3, 0xa3, 0x67, 0x58, 0xab, 0xda,
+	0x95, 0xbe, 0x32, 0x6f, 0x43, 0x48, 0x94, 0x12, 0xac, 0x33, 0x52, 0x54, 0x5a, 0x6b, 0xe5, 0x5c,
+	0xb5, 0xb8, 0x73, 0x7d, 0x99, 0x16, 0x76, 0xfd, 0x5f, 0xf8, 0x67, 0x9e, 0x12, 0x07, 0x38, 0x15,
+	0x6f, 0x7e, 0x02, 0xd7, 0xd3, 0xd5, 0xb5, 0x2e, 0xe8, 0x7c, 0x6f, 0xcd, 0xe6, 0x6b, 0xc6, 0x98,
+	0x96, 0xd7, 0xe7, 0xb8, 0xd8, 0x9d, 0x1a, 0xe6, 0xfb, 0x70, 0x55, 0x2a, 0xa2, 0xa8, 0x75, 0xb1,
+	0x0c, 0xaa, 0x9b, 0x3b, 0x6f, 0xce, 0x06, 0xee, 0x46, 0x4e, 0x1c, 0x63, 0xb6, 0x3e, 0x86, 0x97,
+	0x66, 0xb8, 0x98, 0x97, 0x61, 0x6e, 0x40, 0x0f, 0x74, 0x49, 0x0b, 0x38, 0x3a, 0x9a, 0x6f, 0xc0,
+	0xd5, 0xfb, 0xc4, 0x1d, 0x51, 0x5d, 0x9a, 0x02, 0x8e, 0x8d, 0x8f, 0x56, 0x3e, 0x00, 0x95, 0x5d,
+	0xb8, 0x91, 0x7e, 0x97, 0x34, 0x1b, 0x70, 0x23, 0x3d, 0x42, 0x51, 0x67, 0x44, 0xec, 0xaf, 0x2d,
+	0x53, 0x03, 0x67, 0x43, 0x2a, 0xbf, 0x03, 0x78, 0xe5, 0x73, 0xaa, 0x32, 0x10, 0xfa, 0xfd, 0x88,
+	0x4a, 0x65, 0x7e, 0x07, 0x2f, 0xa7, 0xb1, 0xed, 0x33, 0xf5, 0xde, 0x25, 0x9e, 0x81, 0x48, 0xf3,
+	0x53, 0x08, 0xa7, 0xc3, 0xf9, 0x9f, 0x7d, 0x78, 0x33, 0x82, 0x7c, 0x49, 0xe4, 0xa0, 0x91, 0x8f,
+	0x52, 0xe1, 0x42, 0x7f, 0x72, 0x51, 0xf9, 0x0b, 0x40, 0xeb, 0x36, 0x93, 0x19, 0xea, 0x72, 0xc2,
+	0xfd, 0xab, 0xa8, 0xae, 0xae, 0x4b, 0x3a, 0x5c, 0x10, 0xc5, 0x45, 0xc2, 0x7b, 0x7b, 0x19, 0xef,
+	0x3b, 0xe2, 0x9e, 0xa4, 0x22, 0xc5, 0x1e, 0x67, 0x52, 0x9c, 0x99, 0x70, 0x54, 0x59, 0x2e, 0x7a,
+	0x54, 0xe8, 0x79, 0x29, 0xe0, 0xd8, 0x88, 0x6e, 0x5d, 0x36, 0x64, 0x4a, 0x8f, 0xc1, 0x06, 0x8e,
+	0x8d, 0x68, 0x36, 0x7c, 0xe2, 0x50, 0x3d, 0x00, 0x1b, 0x58, 0x9f, 0x2b, 0x47, 0x00, 0x5e, 0x6d,
+	0xea, 0x11, 0x5c, 0x54, 0xad, 0x2f, 0xe0, 0x7a, 0x5a, 0xe2, 0xe4, 0xc5, 0x4b, 0x7b, 0x21, 0x55,
+	0x9e, 0x4c, 0xac, 0xf9, 0xcd, 0x8c, 0x7a, 0x2b, 0xff, 0x43, 0xbd, 0xe4, 0xfd, 0x99, 0x44, 0x95,
+	0x43, 0x00, 0xaf, 0xde, 0xd3, 0x0b, 0xe0, 0x75, 0x3f, 0xe1, 0xcc, 0xed, 0xf5, 0xdb, 0x0a, 0x2c,
+	0xcd, 0xab, 0x5d, 0xbf, 0xdb, 0xba, 0x45, 0x0f, 0xce, 0x67, 0x42, 0x26, 0xfb, 0x71, 0x25, 0xb5,
+	0x1f, 0xb7, 0xe1, 0x5a, 0xfc, 0x09, 0xb1, 0x72, 0xe5, 0xdc, 0xa2, 0x85, 0x83, 0x23, 0x2f, 0x4e,
+	0x40, 0x91, 0x0a, 0xf4, 0x07, 0x9f, 0x09, 0x2a, 0xdb, 0x24, 0xee, 0xb0, 0xe5, 0x7b, 0x3a, 0x1f,
+	0xef, 0xe8, 0x24, 0xa6, 0xae, 0xcc, 0x1a, 0x2c, 0x12, 0xd7, 0xe5, 0xfb, 0xb4, 0xd7, 0x66, 0xbe,
+	0xb4, 0x56, 0xcb, 0xb9, 0x6a, 0xa1, 0xb1, 0x19, 0x9c, 0x96, 0x60, 0x3d, 0xbe, 0x6e, 0xdd, 0x95,
+	0x18, 0x26, 0x90, 0x96, 0x2f, 0x2b, 0x7f, 0x03, 0x58, 0x9a, 0xaf, 0xf0, 0x79, 0xca, 0xf6, 0x21,
+	0xbc, 0x40, 0x7c, 0xd6, 0x8e, 0xd6, 0x6a, 0x5c, 0xf6, 0x2b, 0xb3, 0x49, 0x63, 0x36, 0xa9, 0x1c,
+	0x6b, 0xc4, 0x67, 0xb7, 0xe8, 0xc1, 0x4c, 0xd3, 0xe4, 0x5e, 0xbd, 0x69, 0xfe, 0x00, 0xb0, 0xb2,
+	0x9b, 0xdd, 0xa6, 0xcd, 0x54, 0xff, 0x9f, 0x8f, 0x00, 0x37, 0x17, 0x4e, 0xef, 0xb5, 0xf9, 0x6f,
+	0xda, 0x14, 0xb3, 0x68, 0x58, 0x1b, 0xbf, 0x82, 0xe3, 0x31, 0x02, 0x27, 0x63, 0x04, 0x9e, 0x8e,
+	0x91, 0xf1, 0x6c, 0x8c, 0x8c, 0xe7, 0x63, 0x64, 0xbc, 0x18, 0x23, 0xe3, 0xe5, 0x18, 0x81, 0x07,
+	0x01, 0x02, 0x0f, 0x03, 0x64, 0x1c, 0x06, 0x08, 0x3c, 0x0e, 0x90, 0xf1, 0x24, 0x40, 0xc6, 0x51,
+	0x80, 0x8c, 0xe3, 0x00, 0x81, 0x93, 0x00, 0x81, 0xa7, 0x01, 0x32, 0x9e, 0x05, 0x08, 0x3c, 0x0f,
+	0x90, 0xf1, 0x22, 0x40, 0xe0, 0x65, 0x80, 0x8c, 0x07, 0x21, 0x32, 0x1e, 0x86, 0x08, 0x3c, 0x0a,
+	0x91, 0xf1, 0x73, 0x88, 0xc0, 0x2f, 0x21, 0x32, 0x0e, 0x43, 0x64, 0x3c, 0x0e, 0x11, 0x78, 0x12,
+	0x22, 0x70, 0x14, 0x22, 0xf0, 0xed, 0x75, 0x87, 0xdb, 0x6a, 0x8f, 0xaa, 0x3d, 0xe6, 0x39, 0xd2,
+	0xf6, 0xa8, 0xda, 0xe7, 0x62, 0x50, 0xcb, 0xfe, 0x55, 0xf2, 0x07, 0x4e, 0x4d, 0x29, 0xcf, 0xef,
+	0x74, 0xd6, 0x74, 0x59, 0x6e, 0xfc, 0x33, 0x00, 0xb5, 0x27, 0x41, 0xa3, 0x5f, 0x0a, 0x00, 0x00,
 }
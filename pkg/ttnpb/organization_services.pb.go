@@ -52,6 +52,11 @@ type OrganizationRegistryClient interface {
 	List(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*Organizations, error)
 	Update(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
 	Delete(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Restore a deleted organization. A deleted organization can be restored until it is purged.
+	Restore(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Purge the organization. This permanently deletes the organization and releases its IDs.
+	// This is only available to admins.
+	Purge(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type organizationRegistryClient struct {
@@ -107,6 +112,24 @@ func (c *organizationRegistryClient) Delete(ctx context.Context, in *Organizatio
 	return out, nil
 }
 
+func (c *organizationRegistryClient) Restore(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.OrganizationRegistry/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationRegistryClient) Purge(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.OrganizationRegistry/Purge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrganizationRegistryServer is the server API for OrganizationRegistry service.
 type OrganizationRegistryServer interface {
 	// Create a new organization. This also sets the given user as
@@ -120,6 +143,11 @@ type OrganizationRegistryServer interface {
 	List(context.Context, *ListOrganizationsRequest) (*Organizations, error)
 	Update(context.Context, *UpdateOrganizationRequest) (*Organization, error)
 	Delete(context.Context, *OrganizationIdentifiers) (*types.Empty, error)
+	// Restore a deleted organization. A deleted organization can be restored until it is purged.
+	Restore(context.Context, *OrganizationIdentifiers) (*types.Empty, error)
+	// Purge the organization. This permanently deletes the organization and releases its IDs.
+	// This is only available to admins.
+	Purge(context.Context, *OrganizationIdentifiers) (*types.Empty, error)
 }
 
 func RegisterOrganizationRegistryServer(s *grpc.Server, srv OrganizationRegistryServer) {
@@ -216,6 +244,42 @@ func _OrganizationRegistry_Delete_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrganizationRegistry_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationRegistryServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.OrganizationRegistry/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationRegistryServer).Restore(ctx, req.(*OrganizationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationRegistry_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationRegistryServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.OrganizationRegistry/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationRegistryServer).Purge(ctx, req.(*OrganizationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _OrganizationRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.OrganizationRegistry",
 	HandlerType: (*OrganizationRegistryServer)(nil),
@@ -240,6 +304,14 @@ var _OrganizationRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _OrganizationRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _OrganizationRegistry_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _OrganizationRegistry_Purge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/organization_services.proto",
@@ -33,15 +33,21 @@ func (dst *Rights) SetFields(src *Rights, paths ...string) error {
 }
 
 var APIKeyFieldPathsNested = []string{
+	"allowed_ips",
+	"expires_at",
 	"id",
 	"key",
+	"last_used_at",
 	"name",
 	"rights",
 }
 
 var APIKeyFieldPathsTopLevel = []string{
+	"allowed_ips",
+	"expires_at",
 	"id",
 	"key",
+	"last_used_at",
 	"name",
 	"rights",
 }
@@ -88,6 +94,33 @@ func (dst *APIKey) SetFields(src *APIKey, paths ...string) error {
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "allowed_ips":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allowed_ips' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowedIPs = src.AllowedIPs
+			} else {
+				dst.AllowedIPs = nil
+			}
+		case "last_used_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_used_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastUsedAt = src.LastUsedAt
+			} else {
+				dst.LastUsedAt = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -8,9 +8,14 @@ import golang_proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
+import _ "github.com/gogo/protobuf/types"
+
+import time "time"
 
 import strconv "strconv"
 
+import github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+
 import strings "strings"
 import reflect "reflect"
 
@@ -21,6 +26,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -326,9 +332,15 @@ type APIKey struct {
 	// User-defined (friendly) name for the API key.
 	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	// Rights that are granted to this API key.
-	Rights               []Right  `protobuf:"varint,4,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Rights []Right `protobuf:"varint,4,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// Time after which the API key is no longer valid.
+	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// CIDR ranges from which the API key may be used. If empty, the API key may be used from anywhere.
+	AllowedIPs []string `protobuf:"bytes,6,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
+	// Time at which the API key was last used. This field is read-only.
+	LastUsedAt           *time.Time `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3,stdtime" json:"last_used_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *APIKey) Reset()      { *m = APIKey{} }
@@ -391,6 +403,27 @@ func (m *APIKey) GetRights() []Right {
 	return nil
 }
 
+func (m *APIKey) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetAllowedIPs() []string {
+	if m != nil {
+		return m.AllowedIPs
+	}
+	return nil
+}
+
+func (m *APIKey) GetLastUsedAt() *time.Time {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return nil
+}
+
 type APIKeys struct {
 	APIKeys              []*APIKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -613,6 +646,28 @@ func (this *APIKey) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedIPs) != len(that1.AllowedIPs) {
+		return false
+	}
+	for i := range this.AllowedIPs {
+		if this.AllowedIPs[i] != that1.AllowedIPs[i] {
+			return false
+		}
+	}
+	if that1.LastUsedAt == nil {
+		if this.LastUsedAt != nil {
+			return false
+		}
+	} else if !this.LastUsedAt.Equal(*that1.LastUsedAt) {
+		return false
+	}
 	return true
 }
 func (this *APIKeys) Equal(that interface{}) bool {
@@ -790,6 +845,41 @@ func (m *APIKey) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRights(dAtA, i, uint64(j3))
 		i += copy(dAtA[i:], dAtA4[:j3])
 	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRights(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n9, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if len(m.AllowedIPs) > 0 {
+		for _, s := range m.AllowedIPs {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.LastUsedAt != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRights(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUsedAt)))
+		n10, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUsedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
 	return i, nil
 }
 
@@ -927,6 +1017,17 @@ func NewPopulatedAPIKey(r randyRights, easy bool) *APIKey {
 	for i := 0; i < v2; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
+	if r.Intn(10) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v11 := r.Intn(10)
+	this.AllowedIPs = make([]string, v11)
+	for i := 0; i < v11; i++ {
+		this.AllowedIPs[i] = randStringRights(r)
+	}
+	if r.Intn(10) != 0 {
+		this.LastUsedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1087,6 +1188,20 @@ func (m *APIKey) Size() (n int) {
 		}
 		n += 1 + sovRights(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovRights(uint64(l))
+	}
+	if len(m.AllowedIPs) > 0 {
+		for _, s := range m.AllowedIPs {
+			l = len(s)
+			n += 1 + l + sovRights(uint64(l))
+		}
+	}
+	if m.LastUsedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUsedAt)
+		n += 1 + l + sovRights(uint64(l))
+	}
 	return n
 }
 
@@ -1170,6 +1285,9 @@ func (this *APIKey) String() string {
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedIPs:` + fmt.Sprintf("%v", this.AllowedIPs) + `,`,
+		`LastUsedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastUsedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1511,6 +1629,101 @@ func (m *APIKey) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedIPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedIPs = append(m.AllowedIPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUsedAt == nil {
+				m.LastUsedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUsedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRights(dAtA[iNdEx:])
@@ -1953,73 +2166,80 @@ func init() {
 }
 
 var fileDescriptor_rights_adc4ed83d2eec3d9 = []byte{
-	// 1080 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0x3d, 0x50, 0xdb, 0x48,
-	0x14, 0xc7, 0xb5, 0x86, 0x38, 0xc9, 0x23, 0x90, 0x65, 0x03, 0xc4, 0x18, 0x58, 0x83, 0x21, 0x84,
-	0xe3, 0xc0, 0xbe, 0x83, 0xbb, 0x4b, 0x77, 0x33, 0xb2, 0x2d, 0xc8, 0x0e, 0x8a, 0xc5, 0x48, 0x22,
-	0x4c, 0x68, 0x34, 0x02, 0x1c, 0xa3, 0x81, 0x58, 0x1e, 0x5b, 0x24, 0xc3, 0x55, 0x29, 0x29, 0xaf,
-	0xbc, 0xf2, 0xe6, 0xae, 0x49, 0x99, 0x32, 0x65, 0x4a, 0xba, 0xa3, 0x4c, 0xc5, 0xc4, 0x72, 0x93,
-	0x32, 0x65, 0xca, 0x1b, 0x4b, 0x32, 0xfa, 0xb0, 0x9c, 0xa4, 0x93, 0xf7, 0xfd, 0xde, 0xd7, 0xff,
-	0xbd, 0xdd, 0x31, 0xd0, 0x13, 0xb3, 0xa1, 0xbf, 0xd2, 0x6b, 0xab, 0x4d, 0x4b, 0x3f, 0x38, 0xce,
-	0xeb, 0x75, 0x23, 0xdf, 0x30, 0xaa, 0x47, 0x56, 0x33, 0x57, 0x6f, 0x98, 0x96, 0x49, 0x46, 0x2c,
-	0xab, 0x96, 0xf3, 0x98, 0xdc, 0xcb, 0xf5, 0xf4, 0x6a, 0xd5, 0xb0, 0x8e, 0x4e, 0xf7, 0x73, 0x07,
-	0xe6, 0x8b, 0x7c, 0xd5, 0xac, 0x9a, 0x79, 0x07, 0xdb, 0x3f, 0x7d, 0xee, 0xfc, 0x72, 0x7e, 0x38,
-	0x5f, 0xae, 0x7b, 0x7a, 0xbe, 0x37, 0xbc, 0x71, 0x58, 0xa9, 0x59, 0xc6, 0x73, 0xa3, 0xd2, 0xf0,
-	0x72, 0x64, 0x1f, 0x41, 0x52, 0x76, 0x72, 0x92, 0x55, 0x48, 0xba, 0xd9, 0x53, 0x68, 0x76, 0x60,
-	0x69, 0x64, 0x6d, 0x3c, 0x17, 0x4e, 0x9f, 0x73, 0x38, 0xd9, 0x83, 0xb2, 0xa7, 0x90, 0xe4, 0xb7,
-	0xd9, 0x56, 0xe5, 0x8c, 0x4c, 0x40, 0xc2, 0x38, 0x4c, 0xa1, 0x59, 0xb4, 0x74, 0xbb, 0x90, 0xb4,
-	0xaf, 0x32, 0x09, 0x56, 0x92, 0x13, 0xc6, 0x21, 0xc1, 0x30, 0x70, 0x5c, 0x39, 0x4b, 0x25, 0x3a,
-	0x06, 0xb9, 0xf3, 0x49, 0x08, 0x0c, 0xd6, 0xf4, 0x17, 0x95, 0xd4, 0x80, 0x73, 0xe4, 0x7c, 0x07,
-	0xd2, 0x0e, 0x7e, 0x4f, 0x5a, 0x06, 0x37, 0xdd, 0xb4, 0x4d, 0xf2, 0x3b, 0xdc, 0xd2, 0xeb, 0x86,
-	0x76, 0x5c, 0x39, 0x73, 0x4b, 0x1e, 0x5a, 0x9b, 0x88, 0xfa, 0xba, 0x68, 0x61, 0xc8, 0xbe, 0xca,
-	0x74, 0xdd, 0xe4, 0x9b, 0x7a, 0xdd, 0xe8, 0x7c, 0x64, 0xcf, 0x11, 0xdc, 0x29, 0x9a, 0x27, 0x27,
-	0xfa, 0xbe, 0xd9, 0xd0, 0x2d, 0xb3, 0x41, 0x18, 0x0c, 0x18, 0x87, 0x4d, 0xa7, 0x93, 0xa1, 0xb5,
-	0xd5, 0x68, 0x2c, 0xa9, 0x51, 0xd5, 0x6b, 0xc6, 0x1f, 0xba, 0x65, 0x98, 0x35, 0xa9, 0xb1, 0xd3,
-	0xac, 0x34, 0x98, 0xaf, 0x66, 0xe1, 0xd6, 0xc5, 0x55, 0x86, 0xbb, 0xbc, 0xca, 0x20, 0xb9, 0x13,
-	0x23, 0xd0, 0x55, 0xe2, 0x7b, 0xba, 0x52, 0x60, 0x38, 0x58, 0x49, 0x93, 0x14, 0x60, 0xf8, 0x20,
-	0x78, 0xe0, 0x35, 0x38, 0x1d, 0x0d, 0x13, 0xf4, 0x92, 0xc3, 0x2e, 0xcb, 0xff, 0x8d, 0xc0, 0x0d,
-	0x27, 0x0d, 0x19, 0x85, 0x61, 0x27, 0x91, 0x66, 0xd4, 0x5e, 0xea, 0x27, 0xc6, 0x21, 0xe6, 0xc8,
-	0x3d, 0xb8, 0x2b, 0xb3, 0xcd, 0xc7, 0xaa, 0xb6, 0xa3, 0x08, 0xb2, 0xc6, 0xca, 0x1b, 0x12, 0x46,
-	0x64, 0x06, 0x26, 0x03, 0x87, 0x8a, 0xa0, 0xaa, 0xac, 0xbc, 0xa9, 0x68, 0x05, 0x5e, 0x61, 0x45,
-	0x9c, 0x20, 0xb3, 0x30, 0x1d, 0x67, 0xe6, 0xb7, 0x99, 0xb6, 0x25, 0x3c, 0x53, 0xf0, 0x00, 0x19,
-	0x87, 0xd1, 0x00, 0x51, 0x12, 0x44, 0x41, 0x15, 0xf0, 0x20, 0x99, 0x83, 0x99, 0xc0, 0x31, 0xbf,
-	0xa3, 0x3e, 0x96, 0x64, 0xb6, 0x27, 0x94, 0xb4, 0xa2, 0xc8, 0x84, 0xb2, 0xaa, 0xe0, 0x1b, 0x91,
-	0xd8, 0xfc, 0xf6, 0xb6, 0xc8, 0x8a, 0xbc, 0xca, 0xa4, 0xb2, 0xa2, 0x89, 0x4c, 0x51, 0x71, 0x92,
-	0x64, 0x81, 0xf6, 0x23, 0x8a, 0xb2, 0xc0, 0xab, 0x02, 0xbe, 0x49, 0xa6, 0x21, 0x15, 0x60, 0x36,
-	0x79, 0x55, 0xd8, 0xe5, 0x9f, 0x79, 0x11, 0x6e, 0x11, 0x0a, 0xe9, 0x38, 0xab, 0xe7, 0x7d, 0x9b,
-	0x4c, 0xc1, 0xfd, 0x80, 0xdd, 0xab, 0xcd, 0x75, 0x86, 0x88, 0x36, 0x5d, 0xa3, 0xe7, 0x3b, 0x14,
-	0x69, 0x51, 0x92, 0x37, 0xf9, 0x32, 0xdb, 0x0b, 0x36, 0x70, 0x87, 0xcc, 0x43, 0xa6, 0x2f, 0xe2,
-	0xc5, 0x19, 0x26, 0x04, 0x46, 0x82, 0x5d, 0x8a, 0x22, 0x1e, 0x21, 0x69, 0x98, 0x70, 0xcf, 0x02,
-	0x4d, 0xbb, 0x23, 0xbb, 0x4b, 0x16, 0x60, 0xb6, 0xd7, 0x16, 0x99, 0x1c, 0x26, 0x0f, 0x61, 0xfe,
-	0x2b, 0xd4, 0xf5, 0x00, 0x47, 0xc9, 0x0a, 0x2c, 0x7d, 0x05, 0x2c, 0x4a, 0xa2, 0xc8, 0x17, 0x24,
-	0x99, 0x57, 0x25, 0x59, 0xc1, 0xc4, 0x97, 0x3b, 0x48, 0x7b, 0x53, 0xbf, 0xe7, 0x0f, 0x2c, 0x6c,
-	0x7d, 0xca, 0x8a, 0x82, 0xa2, 0xc9, 0x02, 0x5f, 0xc2, 0x63, 0xbe, 0x26, 0x71, 0xcc, 0xae, 0xcc,
-	0x54, 0x01, 0x8f, 0xc7, 0x57, 0x1f, 0x0c, 0xe4, 0x56, 0x3f, 0x41, 0x96, 0x60, 0xe1, 0x1b, 0xd1,
-	0x5c, 0xf2, 0x7e, 0x7c, 0x6d, 0xaa, 0xcc, 0x6f, 0x6c, 0xb0, 0xa2, 0x5b, 0x5b, 0x8a, 0x2c, 0x42,
-	0xb6, 0x3f, 0xb3, 0xb3, 0xed, 0x95, 0x37, 0x19, 0x9f, 0xb5, 0xcb, 0x95, 0xa4, 0xdd, 0xb2, 0x47,
-	0xa6, 0xe3, 0x07, 0x29, 0xb2, 0xf2, 0x16, 0x9e, 0x22, 0x93, 0x30, 0xde, 0x6b, 0xeb, 0xcc, 0x7f,
-	0x9a, 0x8c, 0x01, 0x76, 0x4d, 0xee, 0xd6, 0x39, 0xa7, 0x33, 0x64, 0x02, 0x88, 0x7b, 0xea, 0x2d,
-	0xb2, 0xbb, 0x11, 0xd4, 0xbf, 0x49, 0xdd, 0xf3, 0xc8, 0x36, 0x64, 0x7c, 0xd1, 0x7b, 0x88, 0xeb,
-	0x4d, 0x98, 0xf5, 0xbb, 0xea, 0x81, 0xc2, 0x5b, 0x30, 0x47, 0x52, 0x30, 0x16, 0x26, 0xbd, 0x0d,
-	0xc8, 0xfa, 0x17, 0xae, 0x6b, 0x09, 0x29, 0x3c, 0xef, 0x2f, 0x6f, 0xd4, 0x1e, 0x50, 0x6d, 0xa1,
-	0xb7, 0x51, 0x47, 0xb1, 0x07, 0xfe, 0x8d, 0xbc, 0xae, 0x50, 0xe5, 0xd5, 0x1d, 0x6f, 0xb5, 0x16,
-	0x49, 0x06, 0xa6, 0x22, 0x6e, 0x92, 0xa7, 0xaa, 0x03, 0x3c, 0xf4, 0x1f, 0xab, 0x2e, 0xd0, 0xd1,
-	0x75, 0xc9, 0x7f, 0x05, 0x82, 0x37, 0xd4, 0x15, 0xf7, 0x07, 0xf2, 0x00, 0xe6, 0x62, 0x8c, 0x11,
-	0x85, 0x97, 0x7d, 0xf1, 0xe2, 0xb1, 0x6b, 0x99, 0x7f, 0xf4, 0x77, 0x3b, 0x9e, 0x7c, 0x22, 0x3c,
-	0x29, 0x08, 0xb2, 0x82, 0x57, 0xfc, 0x6e, 0x43, 0xa0, 0x27, 0xf5, 0x6a, 0x9f, 0x8c, 0xbd, 0xef,
-	0x68, 0x8e, 0x2c, 0xc3, 0xe2, 0xb7, 0x48, 0xef, 0x35, 0xca, 0xfb, 0x03, 0x0a, 0xb1, 0xe1, 0x77,
-	0xf5, 0x27, 0xff, 0xa2, 0xc4, 0x53, 0x5e, 0xb4, 0x9f, 0xfd, 0xbd, 0x0b, 0x71, 0xa1, 0x77, 0x76,
-	0xad, 0x8f, 0xc2, 0x91, 0xf7, 0x76, 0xbd, 0x5f, 0x17, 0xa5, 0x92, 0xc6, 0x87, 0x37, 0x14, 0xff,
-	0xe2, 0x5f, 0xbb, 0x30, 0x2b, 0x8a, 0xf8, 0x57, 0x7f, 0xb9, 0x14, 0xa1, 0x5c, 0xd2, 0x58, 0xf9,
-	0x29, 0x53, 0x05, 0x05, 0xff, 0x46, 0x86, 0xe1, 0xb6, 0x77, 0x1d, 0x45, 0x11, 0x3f, 0x4a, 0x0f,
-	0x9e, 0xff, 0x4b, 0xb9, 0xc2, 0x3f, 0xe8, 0xa2, 0x45, 0xd1, 0x65, 0x8b, 0xa2, 0x0f, 0x2d, 0xca,
-	0x7d, 0x6c, 0x51, 0xee, 0x53, 0x8b, 0x72, 0x9f, 0x5b, 0x94, 0xfb, 0xd2, 0xa2, 0xe8, 0xb5, 0x4d,
-	0xd1, 0xb9, 0x4d, 0xb9, 0x37, 0x36, 0x45, 0x6f, 0x6d, 0xca, 0xbd, 0xb3, 0x29, 0xf7, 0xde, 0xa6,
-	0xdc, 0x85, 0x4d, 0xd1, 0xa5, 0x4d, 0xd1, 0x07, 0x9b, 0x72, 0x1f, 0x6d, 0x8a, 0x3e, 0xd9, 0x94,
-	0xfb, 0x6c, 0x53, 0xf4, 0xc5, 0xa6, 0xdc, 0xeb, 0x36, 0xe5, 0xce, 0xdb, 0x14, 0xfd, 0xd9, 0xa6,
-	0xdc, 0x5f, 0x6d, 0x8a, 0xfe, 0x6e, 0x53, 0xee, 0x4d, 0x9b, 0x72, 0x6f, 0xdb, 0x14, 0xbd, 0x6b,
-	0x53, 0xf4, 0xbe, 0x4d, 0xd1, 0xde, 0x4a, 0xd5, 0xcc, 0x59, 0x47, 0x15, 0xeb, 0xc8, 0xa8, 0x55,
-	0x9b, 0xb9, 0x5a, 0xc5, 0x7a, 0x65, 0x36, 0x8e, 0xf3, 0xe1, 0x7f, 0x77, 0xf5, 0xe3, 0x6a, 0xde,
-	0xb2, 0x6a, 0xf5, 0xfd, 0xfd, 0xa4, 0xf3, 0xc7, 0x6e, 0xfd, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x8c, 0x7c, 0x30, 0xcb, 0x5e, 0x0a, 0x00, 0x00,
+	// 1196 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0x3f, 0x70, 0xda, 0xd8,
+	0x13, 0xc7, 0x25, 0x70, 0x70, 0xbc, 0xc4, 0x44, 0x79, 0x89, 0x1d, 0x42, 0x9c, 0x07, 0xc1, 0xf9,
+	0xc3, 0x2f, 0x3f, 0x5b, 0xdc, 0xd9, 0x77, 0x97, 0xee, 0x6e, 0x04, 0xc8, 0xce, 0x1b, 0x13, 0x60,
+	0x24, 0x11, 0x4f, 0xdc, 0x68, 0x64, 0xa3, 0x60, 0x8d, 0x31, 0x62, 0x90, 0x1c, 0x9f, 0xaf, 0x4a,
+	0xe9, 0x32, 0xe5, 0x95, 0x37, 0x77, 0x4d, 0x66, 0xae, 0x49, 0x99, 0x32, 0xa5, 0xbb, 0x73, 0x99,
+	0xca, 0x17, 0x44, 0x93, 0x32, 0x65, 0xca, 0x1b, 0x24, 0x61, 0xfd, 0x01, 0x12, 0x77, 0x62, 0xf7,
+	0xb3, 0xfb, 0x76, 0xbf, 0xbb, 0xef, 0x0d, 0x80, 0x5b, 0x7a, 0x57, 0x39, 0x54, 0xda, 0xcb, 0x86,
+	0xa9, 0xec, 0xec, 0xe5, 0x95, 0x8e, 0x96, 0xef, 0x6a, 0xcd, 0x5d, 0xd3, 0x60, 0x3b, 0x5d, 0xdd,
+	0xd4, 0x51, 0xc2, 0x34, 0xdb, 0xac, 0xcb, 0xb0, 0x2f, 0x57, 0x53, 0xcb, 0x4d, 0xcd, 0xdc, 0x3d,
+	0xd8, 0x66, 0x77, 0xf4, 0xfd, 0x7c, 0x53, 0x6f, 0xea, 0x79, 0x1b, 0xdb, 0x3e, 0x78, 0x61, 0xff,
+	0xb2, 0x7f, 0xd8, 0x5f, 0x4e, 0x78, 0x6a, 0x71, 0x34, 0xbd, 0xd6, 0x50, 0xdb, 0xa6, 0xf6, 0x42,
+	0x53, 0xbb, 0xee, 0x19, 0xa9, 0x74, 0x53, 0xd7, 0x9b, 0x2d, 0xd5, 0x4b, 0x65, 0x6a, 0xfb, 0xaa,
+	0x61, 0x2a, 0xfb, 0x1d, 0x07, 0xc8, 0x3e, 0x86, 0x98, 0x60, 0x17, 0x85, 0x96, 0x21, 0xe6, 0x94,
+	0x97, 0xa4, 0x33, 0xd1, 0x5c, 0x62, 0x65, 0x8e, 0x0d, 0xd6, 0xc7, 0xda, 0x9c, 0xe0, 0x42, 0xd9,
+	0xbf, 0x23, 0x10, 0xe3, 0x6a, 0x64, 0x43, 0x3d, 0x42, 0xf3, 0x10, 0xd1, 0x1a, 0x49, 0x3a, 0x43,
+	0xe7, 0x66, 0x0a, 0x31, 0xeb, 0x2c, 0x1d, 0x21, 0x25, 0x21, 0xa2, 0x35, 0x10, 0x03, 0xd1, 0x3d,
+	0xf5, 0x28, 0x19, 0x19, 0x38, 0x84, 0xc1, 0x27, 0x42, 0x30, 0xd5, 0x56, 0xf6, 0xd5, 0x64, 0xd4,
+	0x36, 0xd9, 0xdf, 0xbe, 0x73, 0xa7, 0x2e, 0x70, 0x2e, 0xfa, 0x05, 0x40, 0xfd, 0xb5, 0xa3, 0x75,
+	0x55, 0x43, 0x56, 0xcc, 0xe4, 0xa5, 0x0c, 0x9d, 0x8b, 0xaf, 0xa4, 0x58, 0xa7, 0x4d, 0x76, 0xd8,
+	0x26, 0x2b, 0x0d, 0xdb, 0x2c, 0x4c, 0xbd, 0xfe, 0x37, 0x4d, 0x0b, 0x33, 0x6e, 0x0c, 0x67, 0xa2,
+	0x3c, 0xc4, 0x95, 0x56, 0x4b, 0x3f, 0x54, 0x1b, 0xb2, 0xd6, 0x31, 0x92, 0xb1, 0x4c, 0x34, 0x37,
+	0x53, 0x48, 0x58, 0x67, 0x69, 0xe0, 0x1c, 0x33, 0xa9, 0x19, 0x02, 0xb8, 0x08, 0xe9, 0x18, 0xa8,
+	0x00, 0x57, 0x5a, 0x8a, 0x61, 0xca, 0x07, 0x86, 0xda, 0x18, 0x9c, 0x39, 0x7d, 0xc1, 0x33, 0x61,
+	0x10, 0x55, 0x37, 0xd4, 0x06, 0x67, 0x66, 0x09, 0x4c, 0x3b, 0x62, 0x19, 0xe8, 0x67, 0xb8, 0xac,
+	0x74, 0x34, 0x79, 0x4f, 0x3d, 0x72, 0x94, 0x8e, 0xaf, 0xcc, 0x87, 0x3b, 0x76, 0xd0, 0x42, 0xdc,
+	0x3a, 0x4b, 0x0f, 0xc3, 0x84, 0x69, 0xa5, 0xa3, 0x0d, 0x3e, 0xb2, 0xc7, 0x34, 0x5c, 0x29, 0xea,
+	0xad, 0x96, 0xb2, 0xad, 0x77, 0x15, 0x53, 0xef, 0x22, 0x02, 0x51, 0xad, 0x61, 0xd8, 0xfa, 0xc7,
+	0x57, 0x96, 0xc3, 0xb9, 0xaa, 0xdd, 0xa6, 0xd2, 0xd6, 0x7e, 0x53, 0x4c, 0x4d, 0x6f, 0x57, 0xbb,
+	0x75, 0x43, 0xed, 0x12, 0x6f, 0x4b, 0x0a, 0x97, 0x4f, 0xce, 0xd2, 0xd4, 0xe9, 0x59, 0x9a, 0x16,
+	0x06, 0x39, 0x7c, 0xb3, 0x88, 0x5c, 0x64, 0x07, 0x44, 0x98, 0xf5, 0x57, 0x32, 0x90, 0x6a, 0x76,
+	0xc7, 0x6f, 0x70, 0x1b, 0x5c, 0x08, 0xa7, 0xf1, 0x47, 0x09, 0xc1, 0x90, 0x47, 0xff, 0x24, 0xe0,
+	0x92, 0x7d, 0x0c, 0xba, 0x06, 0xb3, 0xf6, 0x41, 0xb2, 0xd6, 0x7e, 0xa9, 0xb4, 0xb4, 0x06, 0x43,
+	0xa1, 0xeb, 0x70, 0x55, 0x20, 0xeb, 0x4f, 0x24, 0xb9, 0x2e, 0xf2, 0x82, 0x4c, 0x2a, 0x6b, 0x55,
+	0x86, 0x46, 0x77, 0xe0, 0x96, 0xcf, 0x28, 0xf2, 0x92, 0x44, 0x2a, 0xeb, 0xa2, 0x5c, 0xe0, 0x44,
+	0x52, 0x64, 0x22, 0x28, 0x03, 0x0b, 0xe3, 0xdc, 0x5c, 0x8d, 0xc8, 0x1b, 0xfc, 0x73, 0x91, 0x89,
+	0xa2, 0x39, 0xb8, 0xe6, 0x23, 0x4a, 0x7c, 0x99, 0x97, 0x78, 0x66, 0x0a, 0xdd, 0x85, 0x3b, 0x3e,
+	0x33, 0x57, 0x97, 0x9e, 0x54, 0x05, 0xb2, 0xc5, 0x97, 0xe4, 0x62, 0x99, 0xf0, 0x15, 0x49, 0x64,
+	0x2e, 0x85, 0x72, 0x73, 0xb5, 0x5a, 0x99, 0x14, 0x39, 0x89, 0x54, 0x2b, 0xa2, 0x5c, 0x26, 0xa2,
+	0xc4, 0xc4, 0x50, 0x16, 0xf0, 0x24, 0xa2, 0x28, 0xf0, 0x9c, 0xc4, 0x33, 0xd3, 0x68, 0x01, 0x92,
+	0x3e, 0x66, 0x9d, 0x93, 0xf8, 0x4d, 0xee, 0xb9, 0x9b, 0xe1, 0x32, 0xc2, 0x90, 0x1a, 0xe7, 0x75,
+	0xa3, 0x67, 0xd0, 0x6d, 0xb8, 0xe9, 0xf3, 0xbb, 0xb5, 0x39, 0xc1, 0x10, 0xd2, 0x66, 0xe8, 0x74,
+	0x63, 0xe3, 0xa1, 0x16, 0xab, 0xc2, 0x3a, 0x57, 0x21, 0x5b, 0xfe, 0x06, 0xae, 0xa0, 0x45, 0x48,
+	0x4f, 0x44, 0xdc, 0x3c, 0xb3, 0x08, 0x41, 0xc2, 0xdf, 0x65, 0xb9, 0xcc, 0x24, 0x50, 0x0a, 0xe6,
+	0x1d, 0x9b, 0xaf, 0x69, 0x67, 0x64, 0x57, 0xd1, 0x3d, 0xc8, 0x8c, 0xfa, 0x42, 0x93, 0x63, 0xd0,
+	0x43, 0x58, 0xfc, 0x0a, 0x75, 0x3e, 0xc0, 0x6b, 0x68, 0x09, 0x72, 0x5f, 0x01, 0x8b, 0xd5, 0x72,
+	0x99, 0x2b, 0x54, 0x05, 0x4e, 0xaa, 0x0a, 0x22, 0x83, 0x3c, 0xb9, 0xfd, 0xb4, 0x3b, 0xf5, 0xeb,
+	0xde, 0xc0, 0x82, 0xde, 0x67, 0xa4, 0xc8, 0x8b, 0xb2, 0xc0, 0x73, 0x25, 0xe6, 0x86, 0xa7, 0xc9,
+	0x38, 0x66, 0x53, 0x20, 0x12, 0xcf, 0xcc, 0x8d, 0xaf, 0xde, 0x9f, 0xc8, 0xa9, 0x7e, 0x1e, 0xe5,
+	0xe0, 0xde, 0x37, 0xb2, 0x39, 0xe4, 0xcd, 0xf1, 0xb5, 0x49, 0x02, 0xb7, 0xb6, 0x46, 0x8a, 0x4e,
+	0x6d, 0x49, 0xf4, 0x00, 0xb2, 0x93, 0x99, 0x7a, 0xcd, 0x2d, 0xef, 0xd6, 0xf8, 0x53, 0x87, 0x5c,
+	0xa9, 0xba, 0x59, 0x71, 0xc9, 0xd4, 0xf8, 0x41, 0x96, 0x49, 0x65, 0x83, 0xb9, 0x8d, 0x6e, 0xc1,
+	0xdc, 0xa8, 0x6f, 0x30, 0xff, 0x05, 0x74, 0x03, 0x18, 0xc7, 0xe5, 0x6c, 0x9d, 0x6d, 0xbd, 0x83,
+	0xe6, 0x01, 0x39, 0x56, 0x77, 0x91, 0x9d, 0x8d, 0xc0, 0xde, 0x4d, 0x1a, 0xda, 0x43, 0xdb, 0x90,
+	0xf6, 0x44, 0x1f, 0x21, 0xce, 0x37, 0x21, 0xe3, 0x75, 0x35, 0x02, 0x05, 0xb7, 0xe0, 0x2e, 0x4a,
+	0xc2, 0x8d, 0x20, 0xe9, 0x6e, 0x40, 0xd6, 0xbb, 0x70, 0x43, 0x4f, 0x40, 0xe1, 0x45, 0x6f, 0x79,
+	0xc3, 0x7e, 0x9f, 0x6a, 0xf7, 0x46, 0x1b, 0xb5, 0x15, 0xbb, 0xef, 0xdd, 0xc8, 0xf3, 0x0a, 0x25,
+	0x4e, 0xaa, 0xbb, 0xab, 0xf5, 0x00, 0xa5, 0xe1, 0x76, 0x28, 0xac, 0xea, 0xaa, 0x6a, 0x03, 0x0f,
+	0xbd, 0xc7, 0x6a, 0x08, 0x0c, 0x74, 0xcd, 0x79, 0xaf, 0x80, 0xff, 0x86, 0x3a, 0xe2, 0xfe, 0x0f,
+	0xdd, 0x87, 0xbb, 0x63, 0x9c, 0x21, 0x85, 0x1f, 0x79, 0xe2, 0x8d, 0xc7, 0xce, 0x65, 0xfe, 0xbf,
+	0xb7, 0xdb, 0xe3, 0xc9, 0xa7, 0xfc, 0xd3, 0x02, 0x2f, 0x88, 0xcc, 0x92, 0xd7, 0x6d, 0x00, 0x74,
+	0xa5, 0x5e, 0x9e, 0x70, 0xe2, 0xe8, 0x3b, 0xca, 0xa2, 0x47, 0xf0, 0xe0, 0x5b, 0xa4, 0xfb, 0x1a,
+	0xe5, 0xbd, 0x01, 0x05, 0xd8, 0xe0, 0xbb, 0xfa, 0x9d, 0x77, 0x51, 0xc6, 0x53, 0x6e, 0xb6, 0xef,
+	0xbd, 0xbd, 0x0b, 0x70, 0x81, 0x77, 0x76, 0x65, 0x82, 0xc2, 0xa1, 0xf7, 0x76, 0x75, 0x52, 0x17,
+	0xa5, 0x92, 0xcc, 0x05, 0x37, 0x94, 0xf9, 0xc1, 0xbb, 0x76, 0x41, 0xb6, 0x5c, 0x66, 0x7e, 0xf4,
+	0x96, 0x4b, 0xe4, 0x2b, 0x25, 0x99, 0x54, 0x9e, 0x11, 0x89, 0x17, 0x99, 0x9f, 0xd0, 0x2c, 0xcc,
+	0x38, 0xf6, 0x01, 0xf6, 0x38, 0x35, 0x75, 0xfc, 0x17, 0xa6, 0x0a, 0x7f, 0xd2, 0x27, 0x3d, 0x4c,
+	0x9f, 0xf6, 0x30, 0xfd, 0xa1, 0x87, 0xa9, 0x8f, 0x3d, 0x4c, 0x7d, 0xea, 0x61, 0xea, 0x73, 0x0f,
+	0x53, 0x5f, 0x7a, 0x98, 0x7e, 0x65, 0x61, 0xfa, 0xd8, 0xc2, 0xd4, 0x1b, 0x0b, 0xd3, 0x6f, 0x2d,
+	0x4c, 0xbd, 0xb3, 0x30, 0xf5, 0xde, 0xc2, 0xd4, 0x89, 0x85, 0xe9, 0x53, 0x0b, 0xd3, 0x1f, 0x2c,
+	0x4c, 0x7d, 0xb4, 0x30, 0xfd, 0xc9, 0xc2, 0xd4, 0x67, 0x0b, 0xd3, 0x5f, 0x2c, 0x4c, 0xbd, 0xea,
+	0x63, 0xea, 0xb8, 0x8f, 0xe9, 0xd7, 0x7d, 0x4c, 0xfd, 0xde, 0xc7, 0xf4, 0x1f, 0x7d, 0x4c, 0xbd,
+	0xe9, 0x63, 0xea, 0x6d, 0x1f, 0xd3, 0xef, 0xfa, 0x98, 0x7e, 0xdf, 0xc7, 0xf4, 0xd6, 0x52, 0x53,
+	0x67, 0xcd, 0x5d, 0xd5, 0xdc, 0xd5, 0xda, 0x4d, 0x83, 0x6d, 0xab, 0xe6, 0xa1, 0xde, 0xdd, 0xcb,
+	0x07, 0xff, 0xb5, 0x76, 0xf6, 0x9a, 0x79, 0xd3, 0x6c, 0x77, 0xb6, 0xb7, 0x63, 0xf6, 0xff, 0xa8,
+	0xd5, 0xff, 0x06, 0x00, 0x86, 0xdd, 0x6a, 0x1e, 0x36, 0x0b, 0x00, 0x00,
 }
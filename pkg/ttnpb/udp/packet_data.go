@@ -114,6 +114,8 @@ type Stat struct {
 	FPGA *uint32       `json:"fpga,omitempty"` // Version of Gateway FPGA (unsigned integer)
 	DSP  *uint32       `json:"dsp,omitempty"`  // Version of Gateway DSP software (unsigned interger)
 	HAL  *string       `json:"hal,omitempty"`  // Version of Gateway driver (format X.X.X)
+
+	Token string `json:"token,omitempty"` // Shared secret to authenticate the gateway (not part of the Semtech protocol)
 }
 
 // TxError is returned in the TxPacketAck
@@ -136,6 +138,8 @@ var (
 	TxErrTxPower TxError = "TX_POWER"
 	// TxErrGPSUnlocked is returned if packet rejected because GPS is unlocked, so GPS timestamp cannot be used
 	TxErrGPSUnlocked TxError = "GPS_UNLOCKED"
+	// TxErrChannelBusy is returned if packet rejected because the channel was busy during listen-before-talk
+	TxErrChannelBusy TxError = "CHANNEL_BUSY"
 )
 
 // TxPacketAck contains a Tx acknowledgment packet
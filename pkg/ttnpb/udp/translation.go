@@ -99,6 +99,7 @@ var (
 		TxErrTxFreq:          ttnpb.TxAcknowledgment_TX_FREQ,
 		TxErrTxPower:         ttnpb.TxAcknowledgment_TX_POWER,
 		TxErrGPSUnlocked:     ttnpb.TxAcknowledgment_GPS_UNLOCKED,
+		TxErrChannelBusy:     ttnpb.TxAcknowledgment_CHANNEL_BUSY,
 	}
 	semtechAckError = map[ttnpb.TxAcknowledgment_Result]TxError{
 		ttnpb.TxAcknowledgment_SUCCESS:          TxErrNone,
@@ -109,6 +110,7 @@ var (
 		ttnpb.TxAcknowledgment_TX_FREQ:          TxErrTxFreq,
 		ttnpb.TxAcknowledgment_TX_POWER:         TxErrTxPower,
 		ttnpb.TxAcknowledgment_GPS_UNLOCKED:     TxErrGPSUnlocked,
+		ttnpb.TxAcknowledgment_CHANNEL_BUSY:     TxErrChannelBusy,
 	}
 )
 
@@ -298,6 +300,7 @@ func ToDownlinkMessage(tx *TxPacket) (*ttnpb.DownlinkMessage, error) {
 		InvertPolarization: tx.IPol,
 		TxPower:            int32(tx.Powe) + eirpDelta,
 		Timestamp:          tx.Tmst,
+		AntennaIndex:       uint32(tx.RFCh),
 	}
 	if lora := scheduled.DataRate.GetLoRa(); lora != nil {
 		scheduled.CodingRate = tx.CodR
@@ -329,6 +332,7 @@ func FromDownlinkMessage(msg *ttnpb.DownlinkMessage) (*TxPacket, error) {
 		Freq: float64(scheduled.Frequency) / 1000000,
 		IPol: scheduled.InvertPolarization,
 		Powe: uint8(scheduled.TxPower) - uint8(eirpDelta),
+		RFCh: uint8(scheduled.AntennaIndex),
 		Size: uint16(len(payload)),
 		Data: base64.StdEncoding.EncodeToString(payload),
 		Tmst: scheduled.Timestamp,
@@ -160,6 +160,15 @@ func TestToGatewayUpRoundtrip(t *testing.T) {
 			},
 			PacketType: udp.TxAck,
 		},
+		{
+			Name: "TxAcknowledgmentChannelBusy",
+			Data: &udp.Data{
+				TxPacketAck: &udp.TxPacketAck{
+					Error: udp.TxErrChannelBusy,
+				},
+			},
+			PacketType: udp.TxAck,
+		},
 	} {
 		a := assertions.New(t)
 
@@ -276,6 +285,7 @@ func TestFromDownlinkMessage(t *testing.T) {
 				TxPower:            20,
 				InvertPolarization: true,
 				Timestamp:          1886440700,
+				AntennaIndex:       1,
 			},
 		},
 		RawPayload: []byte{0x7d, 0xf3, 0x8e},
@@ -284,6 +294,7 @@ func TestFromDownlinkMessage(t *testing.T) {
 	a.So(err, should.BeNil)
 	a.So(tx.DatR, should.Resemble, udp.DataRate{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{Bandwidth: 500000, SpreadingFactor: 10}}}})
 	a.So(tx.Tmst, should.Equal, 1886440700)
+	a.So(tx.RFCh, should.Equal, 1)
 	a.So(tx.NCRC, should.Equal, true)
 	a.So(tx.Data, should.Equal, "ffOO")
 }
@@ -305,6 +316,7 @@ func TestDownlinkRoundtrip(t *testing.T) {
 				TxPower:            20,
 				InvertPolarization: true,
 				Timestamp:          188700000,
+				AntennaIndex:       1,
 			},
 		},
 		RawPayload: []byte{0x7d, 0xf3, 0x8e},
@@ -608,10 +608,12 @@ func (m *UpdateUserPasswordRequest) GetOld() string {
 
 type CreateUserAPIKeyRequest struct {
 	UserIdentifiers      `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights               []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name                 string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights               []Right    `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	ExpiresAt            *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	AllowedIPs           []string   `protobuf:"bytes,5,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *CreateUserAPIKeyRequest) Reset()      { *m = CreateUserAPIKeyRequest{} }
@@ -660,11 +662,26 @@ func (m *CreateUserAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateUserAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateUserAPIKeyRequest) GetAllowedIPs() []string {
+	if m != nil {
+		return m.AllowedIPs
+	}
+	return nil
+}
+
 type UpdateUserAPIKeyRequest struct {
 	UserIdentifiers      `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
 	APIKey               `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateUserAPIKeyRequest) Reset()      { *m = UpdateUserAPIKeyRequest{} }
@@ -699,6 +716,13 @@ func (m *UpdateUserAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateUserAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateUserAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type Invitation struct {
 	Email                string           `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Token                string           `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
@@ -1551,6 +1575,21 @@ func (this *CreateUserAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedIPs) != len(that1.AllowedIPs) {
+		return false
+	}
+	for i := range this.AllowedIPs {
+		if this.AllowedIPs[i] != that1.AllowedIPs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateUserAPIKeyRequest) Equal(that interface{}) bool {
@@ -1578,6 +1617,9 @@ func (this *UpdateUserAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *Invitation) Equal(that interface{}) bool {
@@ -2326,6 +2368,31 @@ func (m *CreateUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintUser(dAtA, i, uint64(j18))
 		i += copy(dAtA[i:], dAtA19[:j18])
 	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintUser(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n33, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	if len(m.AllowedIPs) > 0 {
+		for _, s := range m.AllowedIPs {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -2360,6 +2427,14 @@ func (m *UpdateUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n21
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintUser(dAtA, i, uint64(m.FieldMask.Size()))
+	n34, err := m.FieldMask.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n34
 	return i, nil
 }
 
@@ -2841,6 +2916,14 @@ func NewPopulatedCreateUserAPIKeyRequest(r randyUser, easy bool) *CreateUserAPIK
 	for i := 0; i < v18; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
+	if r.Intn(10) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v34 := r.Intn(10)
+	this.AllowedIPs = make([]string, v34)
+	for i := 0; i < v34; i++ {
+		this.AllowedIPs[i] = randStringUser(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2852,6 +2935,8 @@ func NewPopulatedUpdateUserAPIKeyRequest(r randyUser, easy bool) *UpdateUserAPIK
 	this.UserIdentifiers = *v19
 	v20 := NewPopulatedAPIKey(r, easy)
 	this.APIKey = *v20
+	v35 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v35
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3252,6 +3337,16 @@ func (m *CreateUserAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovUser(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if len(m.AllowedIPs) > 0 {
+		for _, s := range m.AllowedIPs {
+			l = len(s)
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3265,6 +3360,8 @@ func (m *UpdateUserAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovUser(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovUser(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovUser(uint64(l))
 	return n
 }
 
@@ -3569,6 +3666,8 @@ func (this *CreateUserAPIKeyRequest) String() string {
 		`UserIdentifiers:` + strings.Replace(strings.Replace(this.UserIdentifiers.String(), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedIPs:` + fmt.Sprintf("%v", this.AllowedIPs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3580,6 +3679,7 @@ func (this *UpdateUserAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateUserAPIKeyRequest{`,
 		`UserIdentifiers:` + strings.Replace(strings.Replace(this.UserIdentifiers.String(), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(this.APIKey.String(), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5423,6 +5523,68 @@ func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedIPs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedIPs = append(m.AllowedIPs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -5533,6 +5695,36 @@ func (m *UpdateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -6707,95 +6899,98 @@ func init() {
 }
 
 var fileDescriptor_user_80523f4a1b6083b3 = []byte{
-	// 1435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x3f, 0x70, 0x13, 0xc7,
-	0x1a, 0xbf, 0xb5, 0x24, 0x5b, 0xfa, 0xe4, 0x3f, 0xf8, 0xc0, 0x58, 0x4f, 0x86, 0xb5, 0xe6, 0x1e,
-	0x85, 0xdf, 0x7b, 0x58, 0x9a, 0x31, 0xf3, 0x80, 0x04, 0x12, 0x22, 0x63, 0x87, 0xf1, 0x90, 0xcc,
-	0x30, 0x67, 0x93, 0xc9, 0xa4, 0xd1, 0x9c, 0x75, 0x2b, 0x79, 0x47, 0xba, 0x3f, 0xdc, 0xae, 0xec,
-	0x28, 0x15, 0x4d, 0x66, 0x28, 0x28, 0xe8, 0x92, 0xa1, 0x49, 0x86, 0x8a, 0x92, 0x92, 0x92, 0x92,
-	0x22, 0x05, 0x25, 0x15, 0xe0, 0x53, 0x43, 0x49, 0x49, 0x99, 0xd9, 0xbd, 0x3b, 0xdd, 0x59, 0x96,
-	0x07, 0x1b, 0xcc, 0xa4, 0xdb, 0xdd, 0xef, 0xf7, 0xfd, 0xbe, 0xbf, 0xfb, 0xed, 0x1d, 0x9c, 0x69,
-	0x3b, 0x9e, 0xb1, 0x63, 0xd8, 0x8b, 0x8c, 0x1b, 0xf5, 0x56, 0xc5, 0x70, 0x69, 0xa5, 0xc3, 0x88,
-	0x57, 0x76, 0x3d, 0x87, 0x3b, 0xea, 0x24, 0xe7, 0x76, 0x39, 0x44, 0x94, 0xb7, 0x2f, 0x14, 0x17,
-	0x9b, 0x94, 0x6f, 0x75, 0x36, 0xcb, 0x75, 0xc7, 0xaa, 0x34, 0x9d, 0xa6, 0x53, 0x91, 0xb0, 0xcd,
-	0x4e, 0x43, 0xee, 0xe4, 0x46, 0xae, 0x02, 0xf5, 0xe2, 0xc5, 0x04, 0xdc, 0xda, 0xa1, 0xbc, 0xe5,
-	0xec, 0x54, 0x9a, 0xce, 0xa2, 0x14, 0x2e, 0x6e, 0x1b, 0x6d, 0x6a, 0x1a, 0xdc, 0xf1, 0x58, 0xa5,
-	0xbf, 0x0c, 0xf5, 0xe6, 0x9a, 0x8e, 0xd3, 0x6c, 0x93, 0x98, 0x9d, 0x58, 0x2e, 0xef, 0x86, 0xc2,
-	0xd2, 0xa0, 0xb0, 0x41, 0x49, 0xdb, 0xac, 0x59, 0x06, 0x6b, 0x85, 0x88, 0xf9, 0x41, 0x04, 0xa7,
-	0x16, 0x61, 0xdc, 0xb0, 0xdc, 0x10, 0x80, 0xf7, 0x07, 0x5d, 0x6f, 0x53, 0x62, 0xf3, 0x50, 0x7e,
-	0x6e, 0x88, 0xdc, 0xb1, 0xb9, 0x51, 0xe7, 0x35, 0x6a, 0x37, 0xa2, 0xe8, 0xce, 0xee, 0x47, 0x11,
-	0xbb, 0x63, 0xb1, 0x50, 0xfc, 0xef, 0xfd, 0x62, 0x6a, 0x12, 0x9b, 0xd3, 0x06, 0x25, 0x1e, 0x3b,
-	0xd8, 0x13, 0x8f, 0x36, 0xb7, 0x78, 0x28, 0xd7, 0x1e, 0xe6, 0x20, 0x7d, 0x9b, 0x11, 0x4f, 0xbd,
-	0x02, 0x29, 0x6a, 0xb2, 0x02, 0x2a, 0xa1, 0x85, 0xfc, 0xd2, 0x7c, 0x79, 0x6f, 0x5d, 0xca, 0x02,
-	0xb2, 0x16, 0x93, 0x2f, 0x67, 0x9f, 0xbf, 0x9a, 0x57, 0x5e, 0xbc, 0x9a, 0x47, 0xba, 0xd0, 0x52,
-	0xaf, 0x03, 0xd4, 0x3d, 0x62, 0x70, 0x62, 0xd6, 0x0c, 0x5e, 0x18, 0x91, 0x1c, 0xc5, 0x72, 0x90,
-	0xa5, 0x72, 0x94, 0xa5, 0xf2, 0x46, 0x94, 0xa5, 0x40, 0xfd, 0xc1, 0xeb, 0x79, 0xa4, 0xe7, 0x42,
-	0xbd, 0x2a, 0x17, 0x24, 0x1d, 0xd7, 0x8c, 0x48, 0x52, 0x47, 0x21, 0x09, 0xf5, 0xaa, 0x5c, 0x55,
-	0x21, 0x6d, 0x1b, 0x16, 0x29, 0xa4, 0x4b, 0x68, 0x21, 0xa7, 0xcb, 0xb5, 0x5a, 0x82, 0xbc, 0x49,
-	0x58, 0xdd, 0xa3, 0x2e, 0xa7, 0x8e, 0x5d, 0xc8, 0x48, 0x51, 0xf2, 0x48, 0x5d, 0x01, 0x30, 0x38,
-	0xf7, 0xe8, 0x66, 0x87, 0x13, 0x56, 0x18, 0x2d, 0xa5, 0x16, 0xf2, 0x4b, 0xe7, 0x86, 0xe5, 0xa0,
-	0x5c, 0xed, 0xc3, 0x56, 0x6d, 0xee, 0x75, 0xf5, 0x84, 0x9e, 0xfa, 0x35, 0x8c, 0x27, 0xab, 0x58,
-	0x18, 0x93, 0x3c, 0x73, 0x83, 0x3c, 0xd7, 0x03, 0xcc, 0x9a, 0xdd, 0x70, 0xf4, 0x7c, 0x3d, 0xde,
-	0xa8, 0x4b, 0x30, 0xe3, 0x7a, 0xd4, 0x32, 0xbc, 0x6e, 0x8d, 0x58, 0x06, 0x6d, 0xd7, 0x0c, 0xd3,
-	0xf4, 0x08, 0x63, 0x85, 0xac, 0xf4, 0xf8, 0x64, 0x28, 0x5c, 0x15, 0xb2, 0x6a, 0x20, 0x52, 0xdb,
-	0xa0, 0x0d, 0xd5, 0xa9, 0x85, 0x2d, 0x1f, 0x24, 0x33, 0xf7, 0xc1, 0x64, 0xa6, 0x65, 0x22, 0xf1,
-	0x10, 0x13, 0x3f, 0x44, 0x44, 0x55, 0xae, 0x16, 0x21, 0xeb, 0x1a, 0x8c, 0xed, 0x38, 0x9e, 0x59,
-	0x00, 0xe9, 0x54, 0x7f, 0xaf, 0x6e, 0xc0, 0xc9, 0x68, 0x5d, 0x4b, 0xd4, 0x31, 0x7f, 0x84, 0x3a,
-	0x4e, 0x47, 0x04, 0xb7, 0xfb, 0xf5, 0xbc, 0x08, 0xb3, 0x1e, 0xb9, 0xd3, 0xa1, 0x1e, 0xa9, 0x0d,
-	0xb0, 0x17, 0xc6, 0x4b, 0x68, 0x21, 0xab, 0xcf, 0x84, 0xe2, 0x5b, 0x7b, 0x54, 0xd5, 0xff, 0x41,
-	0x86, 0x71, 0x81, 0x9a, 0x28, 0xa1, 0x85, 0xc9, 0xa5, 0x99, 0xc1, 0x22, 0xac, 0x0b, 0xa1, 0x1e,
-	0x60, 0xd4, 0x53, 0x90, 0x31, 0x4c, 0x8b, 0xda, 0x85, 0x49, 0x49, 0x19, 0x6c, 0xd4, 0x45, 0x50,
-	0x39, 0xb1, 0x5c, 0xc7, 0x13, 0xc9, 0xed, 0x87, 0x3d, 0x25, 0xc3, 0x9e, 0xee, 0x4b, 0x22, 0xbb,
-	0x6a, 0x13, 0xce, 0xee, 0x87, 0xd7, 0x12, 0xd7, 0xe2, 0xc4, 0xa1, 0x32, 0x81, 0x64, 0x26, 0x8a,
-	0xfb, 0xf8, 0xaf, 0xf7, 0xef, 0xc9, 0x70, 0x43, 0xe4, 0x67, 0x97, 0x7a, 0x84, 0x09, 0x43, 0xd3,
-	0x9f, 0x64, 0x68, 0x35, 0x20, 0xaa, 0x72, 0xf5, 0x1b, 0x98, 0x72, 0x3d, 0xa7, 0x41, 0xdb, 0xa4,
-	0xe6, 0xd2, 0x3a, 0xef, 0x78, 0xa4, 0xa0, 0x4a, 0xea, 0xd9, 0xc1, 0x6c, 0xde, 0x0a, 0xc4, 0xfa,
-	0x64, 0x88, 0x0f, 0xf7, 0xc5, 0xaf, 0x60, 0x6a, 0xe0, 0xc2, 0xa8, 0x27, 0x20, 0xd5, 0x22, 0x5d,
-	0x39, 0x67, 0x72, 0xba, 0x58, 0x8a, 0xec, 0x6f, 0x1b, 0xed, 0x0e, 0x91, 0x73, 0x23, 0xa7, 0x07,
-	0x9b, 0x2f, 0x47, 0x2e, 0x23, 0xed, 0x3d, 0x82, 0xb1, 0x90, 0x4a, 0xbd, 0x0a, 0x59, 0x62, 0x6d,
-	0x12, 0xd3, 0x24, 0x66, 0x38, 0xa4, 0x4a, 0x07, 0x78, 0x51, 0x5e, 0x0d, 0x71, 0x7a, 0x5f, 0x43,
-	0xbd, 0x0c, 0x19, 0x46, 0x7f, 0x21, 0xac, 0x30, 0x22, 0xef, 0xa4, 0x76, 0x90, 0xea, 0xba, 0x00,
-	0x05, 0x37, 0x3b, 0x50, 0x28, 0x5e, 0x81, 0x6c, 0xc4, 0xa7, 0xce, 0x41, 0xce, 0xa2, 0x16, 0xa9,
-	0xf1, 0xae, 0x4b, 0xc2, 0x08, 0xb2, 0xe2, 0x60, 0xa3, 0xeb, 0x12, 0x31, 0x79, 0x4c, 0x83, 0x1b,
-	0x32, 0x8a, 0x71, 0x5d, 0xae, 0x8b, 0x97, 0x01, 0x62, 0xc6, 0x64, 0xe8, 0x13, 0x1f, 0x0a, 0xfd,
-	0x02, 0x64, 0xc4, 0xbc, 0x61, 0xea, 0x7f, 0x21, 0x23, 0xde, 0x4b, 0x31, 0x99, 0x85, 0xe7, 0xa7,
-	0x86, 0x4d, 0x25, 0x3d, 0x80, 0x68, 0xbf, 0x21, 0x98, 0xbc, 0x41, 0xb8, 0x3c, 0x22, 0x77, 0x3a,
-	0x84, 0x71, 0x75, 0x05, 0xb2, 0x42, 0x56, 0xfb, 0xa8, 0xd9, 0x3e, 0xd6, 0x91, 0x22, 0xa6, 0x5e,
-	0x03, 0x88, 0x1f, 0xc1, 0x03, 0xe7, 0xfb, 0xb7, 0x02, 0xf2, 0xbd, 0xc1, 0x5a, 0xcb, 0x69, 0x41,
-	0xa1, 0xe7, 0x1a, 0xd1, 0x81, 0xe6, 0xc1, 0x74, 0xd0, 0xc0, 0x49, 0xdf, 0x96, 0x20, 0x2d, 0x0c,
-	0x84, 0x7e, 0x0d, 0x8d, 0x2c, 0xe1, 0x8c, 0xc4, 0xaa, 0xff, 0x81, 0x13, 0xd4, 0xde, 0xa6, 0xdc,
-	0x10, 0x73, 0xbb, 0xc6, 0x9d, 0x16, 0xb1, 0xc3, 0xe4, 0x4d, 0xc5, 0xe7, 0x1b, 0xe2, 0x58, 0xbb,
-	0x87, 0x60, 0x3a, 0x98, 0x06, 0x9f, 0x6a, 0xf4, 0x93, 0xc3, 0x6f, 0x00, 0x0e, 0xc2, 0xdf, 0x18,
-	0xbc, 0x6d, 0xc7, 0x5a, 0x27, 0xed, 0x57, 0x04, 0xff, 0x8a, 0x43, 0xfe, 0x2c, 0x36, 0x44, 0x17,
-	0xdb, 0x64, 0x27, 0x4c, 0xba, 0x58, 0x8a, 0x13, 0xa7, 0x6d, 0xca, 0x17, 0x3b, 0xa7, 0x8b, 0xa5,
-	0xf6, 0x08, 0xc1, 0x6c, 0x5c, 0xef, 0xea, 0xad, 0xb5, 0x9b, 0xa4, 0x7b, 0xbc, 0x5e, 0x44, 0xef,
-	0xfc, 0x48, 0xe2, 0x9d, 0x5f, 0x84, 0xd1, 0xe0, 0xdb, 0xa6, 0x90, 0x2a, 0xa5, 0x86, 0x0d, 0x7d,
-	0x5d, 0x48, 0xf5, 0x10, 0xa4, 0x3d, 0x44, 0x30, 0x1b, 0x27, 0xeb, 0x73, 0x38, 0xf9, 0x05, 0x8c,
-	0x19, 0x2e, 0xad, 0x89, 0x4b, 0x1f, 0x34, 0xcd, 0xe9, 0x41, 0x92, 0xc0, 0x6a, 0x42, 0x77, 0xd4,
-	0x70, 0xe9, 0x4d, 0xd2, 0xd5, 0xee, 0xa7, 0x00, 0xd6, 0xfa, 0x0d, 0x2d, 0x06, 0x85, 0x7c, 0xde,
-	0xc3, 0xa9, 0x13, 0x6c, 0xc4, 0x69, 0xf2, 0x06, 0x04, 0x1b, 0xf1, 0x1d, 0x95, 0x78, 0x0c, 0x8e,
-	0xf4, 0x1d, 0x45, 0xfa, 0xb3, 0x7f, 0xef, 0x17, 0x5d, 0xfa, 0x38, 0xbe, 0xe8, 0x32, 0x1f, 0xf7,
-	0x45, 0x57, 0x85, 0xbc, 0x51, 0xaf, 0x13, 0x37, 0x64, 0x19, 0x3d, 0xe4, 0xa7, 0x0c, 0x44, 0x4a,
-	0xf2, 0x21, 0x8b, 0x29, 0x36, 0xbb, 0x85, 0xb1, 0x43, 0x15, 0x34, 0x66, 0x58, 0xee, 0x6a, 0x37,
-	0x21, 0x1f, 0x57, 0x83, 0xa9, 0x57, 0x21, 0x1f, 0x4f, 0x9b, 0x68, 0x34, 0x17, 0x07, 0x09, 0x63,
-	0x0d, 0x3d, 0x09, 0xd7, 0xfe, 0x0f, 0x33, 0xeb, 0xc4, 0x36, 0x13, 0xe2, 0xb0, 0xeb, 0xce, 0xec,
-	0xa9, 0xf2, 0xf2, 0xa8, 0xff, 0x7a, 0x7e, 0xe4, 0x47, 0x14, 0x56, 0x5b, 0xbb, 0x04, 0xb3, 0x2b,
-	0xa4, 0x4d, 0x38, 0x39, 0xaa, 0xe2, 0x7d, 0x04, 0xa7, 0x45, 0x70, 0xeb, 0x84, 0x31, 0xea, 0xd8,
-	0x89, 0x18, 0x8f, 0xa9, 0xcf, 0xcf, 0x03, 0xb0, 0x80, 0xbb, 0x46, 0xcd, 0xa0, 0x19, 0x97, 0x27,
-	0xfc, 0x57, 0xf3, 0xb9, 0xc8, 0xe2, 0x8a, 0x9e, 0x63, 0x91, 0x71, 0xed, 0xaf, 0x11, 0xc8, 0x27,
-	0xdc, 0xf9, 0x27, 0x7c, 0x18, 0x68, 0xef, 0xd4, 0x71, 0xb4, 0x77, 0xfa, 0xe3, 0xda, 0xfb, 0xda,
-	0x9e, 0xdb, 0x9a, 0x39, 0x64, 0x77, 0xc7, 0x37, 0x55, 0xbb, 0x01, 0xe3, 0x89, 0x6c, 0x32, 0xf5,
-	0x12, 0x64, 0xc3, 0x38, 0xa3, 0xc6, 0x9c, 0x1b, 0x96, 0xce, 0x10, 0xaf, 0xf7, 0xc1, 0xda, 0x1f,
-	0x08, 0x66, 0xbf, 0xa3, 0x8c, 0x27, 0xd9, 0x8e, 0x77, 0x1e, 0x9e, 0x82, 0x8c, 0xe3, 0x99, 0xc4,
-	0x8b, 0xe6, 0x95, 0xdc, 0x88, 0xd3, 0x36, 0xb5, 0x68, 0x50, 0x86, 0x09, 0x3d, 0xd8, 0x88, 0x01,
-	0xef, 0x1a, 0xcd, 0xe0, 0x47, 0x6e, 0x42, 0x97, 0xeb, 0xe5, 0x47, 0xe8, 0xf9, 0x2e, 0x46, 0x2f,
-	0x76, 0x31, 0x7a, 0xb9, 0x8b, 0x95, 0x37, 0xbb, 0x58, 0x79, 0xbb, 0x8b, 0x95, 0x77, 0xbb, 0x58,
-	0x79, 0xbf, 0x8b, 0xd1, 0x5d, 0x1f, 0xa3, 0x7b, 0x3e, 0x56, 0x1e, 0xfb, 0x18, 0x3d, 0xf1, 0xb1,
-	0xf2, 0xd4, 0xc7, 0xca, 0x33, 0x1f, 0x2b, 0xcf, 0x7d, 0x8c, 0x5e, 0xf8, 0x18, 0xbd, 0xf4, 0xb1,
-	0xf2, 0xc6, 0xc7, 0xe8, 0xad, 0x8f, 0x95, 0x77, 0x3e, 0x46, 0xef, 0x7d, 0xac, 0xdc, 0xed, 0x61,
-	0xe5, 0x5e, 0x0f, 0xa3, 0x07, 0x3d, 0xac, 0xfc, 0xde, 0xc3, 0xe8, 0xcf, 0x1e, 0x56, 0x1e, 0xf7,
-	0xb0, 0xf2, 0xa4, 0x87, 0xd1, 0xd3, 0x1e, 0x46, 0xcf, 0x7a, 0x18, 0xfd, 0x74, 0xbe, 0xe9, 0x94,
-	0xf9, 0x16, 0xe1, 0x5b, 0xd4, 0x6e, 0xb2, 0xb2, 0x4d, 0xf8, 0x8e, 0xe3, 0xb5, 0x2a, 0x7b, 0x7f,
-	0xac, 0xdd, 0x56, 0xb3, 0xc2, 0xb9, 0xed, 0x6e, 0x6e, 0x8e, 0xca, 0xa2, 0x5d, 0xf8, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0x66, 0x05, 0xca, 0x8f, 0xf9, 0x10, 0x00, 0x00,
+	// 1483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x3f, 0x6c, 0x13, 0x4b,
+	0x1a, 0xdf, 0x89, 0xed, 0xc4, 0xfe, 0x9c, 0x3f, 0x64, 0x21, 0xc4, 0xe7, 0xc0, 0xd8, 0xda, 0xa3,
+	0xc8, 0xdd, 0x11, 0x5b, 0x0a, 0x3a, 0xe0, 0x0e, 0xee, 0x8f, 0x43, 0x72, 0x28, 0xe2, 0x4e, 0x8a,
+	0x36, 0xe1, 0x74, 0xba, 0x66, 0xb5, 0xf1, 0x8e, 0x9d, 0x91, 0xbd, 0x7f, 0xd8, 0x19, 0x27, 0x67,
+	0x2a, 0x9a, 0x93, 0x28, 0x28, 0x28, 0x4e, 0xba, 0xa7, 0xd7, 0xbc, 0xa7, 0x57, 0x51, 0x52, 0x52,
+	0x52, 0x52, 0xbc, 0x82, 0x12, 0xe9, 0x49, 0x81, 0xac, 0x1b, 0x4a, 0x4a, 0xca, 0xa7, 0xd9, 0x9d,
+	0xb5, 0x37, 0x8e, 0x23, 0x12, 0x08, 0x7a, 0xdd, 0xcc, 0x7c, 0xbf, 0xef, 0xf7, 0xfd, 0x9d, 0x6f,
+	0xd6, 0x86, 0x4b, 0x6d, 0xd7, 0x37, 0xf7, 0x4c, 0x67, 0x89, 0x71, 0xb3, 0xde, 0xaa, 0x9a, 0x1e,
+	0xad, 0x76, 0x18, 0xf1, 0x2b, 0x9e, 0xef, 0x72, 0x57, 0x9d, 0xe6, 0xdc, 0xa9, 0x48, 0x44, 0x65,
+	0xf7, 0x5a, 0x71, 0xa9, 0x49, 0xf9, 0x4e, 0x67, 0xbb, 0x52, 0x77, 0xed, 0x6a, 0xd3, 0x6d, 0xba,
+	0xd5, 0x10, 0xb6, 0xdd, 0x69, 0x84, 0xbb, 0x70, 0x13, 0xae, 0x22, 0xf5, 0xe2, 0xf5, 0x04, 0xdc,
+	0xde, 0xa3, 0xbc, 0xe5, 0xee, 0x55, 0x9b, 0xee, 0x52, 0x28, 0x5c, 0xda, 0x35, 0xdb, 0xd4, 0x32,
+	0xb9, 0xeb, 0xb3, 0x6a, 0x7f, 0x29, 0xf5, 0x16, 0x9a, 0xae, 0xdb, 0x6c, 0x93, 0x01, 0x3b, 0xb1,
+	0x3d, 0xde, 0x95, 0xc2, 0xf2, 0xb0, 0xb0, 0x41, 0x49, 0xdb, 0x32, 0x6c, 0x93, 0xb5, 0x24, 0xa2,
+	0x34, 0x8c, 0xe0, 0xd4, 0x26, 0x8c, 0x9b, 0xb6, 0x27, 0x01, 0xf8, 0x68, 0xd0, 0xf5, 0x36, 0x25,
+	0x0e, 0x97, 0xf2, 0x2b, 0x23, 0xe4, 0xae, 0xc3, 0xcd, 0x3a, 0x37, 0xa8, 0xd3, 0x88, 0xa3, 0xbb,
+	0x7c, 0x14, 0x45, 0x9c, 0x8e, 0xcd, 0xa4, 0xf8, 0xd7, 0x47, 0xc5, 0xd4, 0x22, 0x0e, 0xa7, 0x0d,
+	0x4a, 0x7c, 0x76, 0xbc, 0x27, 0x3e, 0x6d, 0xee, 0x70, 0x29, 0xd7, 0xbe, 0xcd, 0x41, 0xfa, 0x3e,
+	0x23, 0xbe, 0x7a, 0x0b, 0x52, 0xd4, 0x62, 0x05, 0x54, 0x46, 0x8b, 0xf9, 0xe5, 0x52, 0xe5, 0x70,
+	0x5d, 0x2a, 0x02, 0xb2, 0x3e, 0x20, 0x5f, 0xc9, 0xbe, 0xda, 0x2f, 0x29, 0xaf, 0xf7, 0x4b, 0x48,
+	0x17, 0x5a, 0xea, 0x1d, 0x80, 0xba, 0x4f, 0x4c, 0x4e, 0x2c, 0xc3, 0xe4, 0x85, 0xb1, 0x90, 0xa3,
+	0x58, 0x89, 0xb2, 0x54, 0x89, 0xb3, 0x54, 0xd9, 0x8a, 0xb3, 0x14, 0xa9, 0x3f, 0x7d, 0x5b, 0x42,
+	0x7a, 0x4e, 0xea, 0xd5, 0xb8, 0x20, 0xe9, 0x78, 0x56, 0x4c, 0x92, 0x3a, 0x0d, 0x89, 0xd4, 0xab,
+	0x71, 0x55, 0x85, 0xb4, 0x63, 0xda, 0xa4, 0x90, 0x2e, 0xa3, 0xc5, 0x9c, 0x1e, 0xae, 0xd5, 0x32,
+	0xe4, 0x2d, 0xc2, 0xea, 0x3e, 0xf5, 0x38, 0x75, 0x9d, 0x42, 0x26, 0x14, 0x25, 0x8f, 0xd4, 0x55,
+	0x00, 0x93, 0x73, 0x9f, 0x6e, 0x77, 0x38, 0x61, 0x85, 0xf1, 0x72, 0x6a, 0x31, 0xbf, 0x7c, 0x65,
+	0x54, 0x0e, 0x2a, 0xb5, 0x3e, 0x6c, 0xcd, 0xe1, 0x7e, 0x57, 0x4f, 0xe8, 0xa9, 0x7f, 0x86, 0xc9,
+	0x64, 0x15, 0x0b, 0x13, 0x21, 0xcf, 0xc2, 0x30, 0xcf, 0x9d, 0x08, 0xb3, 0xee, 0x34, 0x5c, 0x3d,
+	0x5f, 0x1f, 0x6c, 0xd4, 0x65, 0x98, 0xf3, 0x7c, 0x6a, 0x9b, 0x7e, 0xd7, 0x20, 0xb6, 0x49, 0xdb,
+	0x86, 0x69, 0x59, 0x3e, 0x61, 0xac, 0x90, 0x0d, 0x3d, 0x3e, 0x2f, 0x85, 0x6b, 0x42, 0x56, 0x8b,
+	0x44, 0x6a, 0x1b, 0xb4, 0x91, 0x3a, 0x86, 0x6c, 0xf9, 0x28, 0x99, 0xb9, 0x4f, 0x26, 0x33, 0x1d,
+	0x26, 0x12, 0x8f, 0x30, 0xf1, 0xcf, 0x98, 0xa8, 0xc6, 0xd5, 0x22, 0x64, 0x3d, 0x93, 0xb1, 0x3d,
+	0xd7, 0xb7, 0x0a, 0x10, 0x3a, 0xd5, 0xdf, 0xab, 0x5b, 0x70, 0x3e, 0x5e, 0x1b, 0x89, 0x3a, 0xe6,
+	0x4f, 0x51, 0xc7, 0xd9, 0x98, 0xe0, 0x7e, 0xbf, 0x9e, 0xd7, 0x61, 0xde, 0x27, 0x0f, 0x3a, 0xd4,
+	0x27, 0xc6, 0x10, 0x7b, 0x61, 0xb2, 0x8c, 0x16, 0xb3, 0xfa, 0x9c, 0x14, 0x6f, 0x1c, 0x52, 0x55,
+	0x7f, 0x07, 0x19, 0xc6, 0x05, 0x6a, 0xaa, 0x8c, 0x16, 0xa7, 0x97, 0xe7, 0x86, 0x8b, 0xb0, 0x29,
+	0x84, 0x7a, 0x84, 0x51, 0x2f, 0x40, 0xc6, 0xb4, 0x6c, 0xea, 0x14, 0xa6, 0x43, 0xca, 0x68, 0xa3,
+	0x2e, 0x81, 0xca, 0x89, 0xed, 0xb9, 0xbe, 0x48, 0x6e, 0x3f, 0xec, 0x99, 0x30, 0xec, 0xd9, 0xbe,
+	0x24, 0xb6, 0xab, 0x36, 0xe1, 0xf2, 0x51, 0xb8, 0x91, 0xb8, 0x16, 0xe7, 0x4e, 0x94, 0x09, 0x14,
+	0x66, 0xa2, 0x78, 0x84, 0xff, 0x4e, 0xff, 0x9e, 0x8c, 0x36, 0x44, 0xfe, 0xe3, 0x51, 0x9f, 0x30,
+	0x61, 0x68, 0xf6, 0x8b, 0x0c, 0xad, 0x45, 0x44, 0x35, 0xae, 0xfe, 0x15, 0x66, 0x3c, 0xdf, 0x6d,
+	0xd0, 0x36, 0x31, 0x3c, 0x5a, 0xe7, 0x1d, 0x9f, 0x14, 0xd4, 0x90, 0x7a, 0x7e, 0x38, 0x9b, 0x1b,
+	0x91, 0x58, 0x9f, 0x96, 0x78, 0xb9, 0x2f, 0xfe, 0x09, 0x66, 0x86, 0x2e, 0x8c, 0x7a, 0x0e, 0x52,
+	0x2d, 0xd2, 0x0d, 0xe7, 0x4c, 0x4e, 0x17, 0x4b, 0x91, 0xfd, 0x5d, 0xb3, 0xdd, 0x21, 0xe1, 0xdc,
+	0xc8, 0xe9, 0xd1, 0xe6, 0x8f, 0x63, 0x37, 0x91, 0xf6, 0x11, 0xc1, 0x84, 0xa4, 0x52, 0x6f, 0x43,
+	0x96, 0xd8, 0xdb, 0xc4, 0xb2, 0x88, 0x25, 0x87, 0x54, 0xf9, 0x18, 0x2f, 0x2a, 0x6b, 0x12, 0xa7,
+	0xf7, 0x35, 0xd4, 0x9b, 0x90, 0x61, 0xf4, 0x21, 0x61, 0x85, 0xb1, 0xf0, 0x4e, 0x6a, 0xc7, 0xa9,
+	0x6e, 0xd2, 0x87, 0xd2, 0x51, 0x3d, 0x52, 0x28, 0xde, 0x82, 0x6c, 0xcc, 0xa7, 0x2e, 0x40, 0xce,
+	0xa6, 0x36, 0x31, 0x78, 0xd7, 0x23, 0x32, 0x82, 0xac, 0x38, 0xd8, 0xea, 0x7a, 0x44, 0x4c, 0x1e,
+	0xcb, 0xe4, 0x66, 0x18, 0xc5, 0xa4, 0x1e, 0xae, 0x8b, 0x37, 0x01, 0x06, 0x8c, 0xc9, 0xd0, 0xa7,
+	0x3e, 0x15, 0xfa, 0x35, 0xc8, 0x88, 0x79, 0xc3, 0xd4, 0xdf, 0x42, 0x46, 0xbc, 0x97, 0x62, 0x32,
+	0x0b, 0xcf, 0x2f, 0x8c, 0x9a, 0x4a, 0x7a, 0x04, 0xd1, 0xfe, 0x8f, 0x60, 0xfa, 0x2e, 0xe1, 0xe1,
+	0x11, 0x79, 0xd0, 0x21, 0x8c, 0xab, 0xab, 0x90, 0x15, 0x32, 0xe3, 0xb3, 0x66, 0xfb, 0x44, 0x27,
+	0x14, 0x31, 0xf5, 0x2f, 0x00, 0x83, 0x47, 0xf0, 0xd8, 0xf9, 0xfe, 0x37, 0x01, 0xf9, 0x87, 0xc9,
+	0x5a, 0x2b, 0x69, 0x41, 0xa1, 0xe7, 0x1a, 0xf1, 0x81, 0xe6, 0xc3, 0x6c, 0xd4, 0xc0, 0x49, 0xdf,
+	0x96, 0x21, 0x2d, 0x0c, 0x48, 0xbf, 0x46, 0x46, 0x96, 0x70, 0x26, 0xc4, 0xaa, 0xbf, 0x81, 0x73,
+	0xd4, 0xd9, 0xa5, 0xdc, 0x14, 0x73, 0xdb, 0xe0, 0x6e, 0x8b, 0x38, 0x32, 0x79, 0x33, 0x83, 0xf3,
+	0x2d, 0x71, 0xac, 0x3d, 0x46, 0x30, 0x1b, 0x4d, 0x83, 0x2f, 0x35, 0xfa, 0xc5, 0xe1, 0x37, 0x00,
+	0x47, 0xe1, 0x6f, 0x0d, 0xdf, 0xb6, 0x33, 0xad, 0x93, 0xf6, 0x5f, 0x04, 0xbf, 0x1a, 0x84, 0xfc,
+	0x55, 0x6c, 0x88, 0x2e, 0x76, 0xc8, 0x9e, 0x4c, 0xba, 0x58, 0x8a, 0x13, 0xb7, 0x6d, 0x85, 0x2f,
+	0x76, 0x4e, 0x17, 0x4b, 0xed, 0x7f, 0x63, 0x30, 0x3f, 0xa8, 0x77, 0x6d, 0x63, 0xfd, 0x1e, 0xe9,
+	0x9e, 0xad, 0x17, 0xf1, 0x3b, 0x3f, 0x96, 0x78, 0xe7, 0x97, 0x60, 0x3c, 0xfa, 0xb6, 0x29, 0xa4,
+	0xca, 0xa9, 0x51, 0x43, 0x5f, 0x17, 0x52, 0x5d, 0x82, 0x44, 0x55, 0x13, 0x43, 0x33, 0x7d, 0xc2,
+	0x27, 0x32, 0x47, 0xfa, 0xf3, 0xb1, 0x0a, 0x79, 0xb3, 0xdd, 0x76, 0xf7, 0x88, 0x65, 0x50, 0x8f,
+	0x15, 0x32, 0xe5, 0xd4, 0x62, 0x6e, 0x65, 0x3a, 0xd8, 0x2f, 0x41, 0x2d, 0x3a, 0x5e, 0xdf, 0x60,
+	0x3a, 0x48, 0xc8, 0xba, 0xc7, 0xb4, 0x9f, 0x10, 0xcc, 0x0f, 0xca, 0xf3, 0x35, 0xd2, 0xf2, 0x07,
+	0x98, 0x30, 0x3d, 0x6a, 0x88, 0x31, 0x13, 0xb5, 0xe9, 0xc5, 0x61, 0x92, 0xc8, 0x6a, 0x42, 0x77,
+	0xdc, 0xf4, 0xe8, 0x3d, 0xd2, 0x1d, 0x6a, 0xf2, 0xd4, 0xe9, 0x9b, 0xfc, 0x49, 0x0a, 0x60, 0xbd,
+	0x7f, 0x07, 0xc5, 0x6c, 0x0b, 0xbf, 0x48, 0xe4, 0xa0, 0x8c, 0x36, 0xe2, 0x34, 0x79, 0x69, 0xa3,
+	0x8d, 0xf8, 0xf4, 0x4b, 0x94, 0xe2, 0x54, 0x9f, 0x7e, 0x83, 0x72, 0x1c, 0xfe, 0x08, 0x4d, 0x9f,
+	0xc5, 0x47, 0x68, 0xe6, 0xf3, 0x3e, 0x42, 0x6b, 0x90, 0x37, 0xeb, 0x75, 0xe2, 0x49, 0x96, 0xf1,
+	0x13, 0xb6, 0x16, 0xc4, 0x4a, 0xe1, 0xdb, 0x3b, 0xa0, 0xd8, 0xee, 0x16, 0x26, 0x4e, 0xd4, 0x11,
+	0x03, 0x86, 0x95, 0xae, 0x76, 0x0f, 0xf2, 0x83, 0x6a, 0x30, 0xf5, 0x36, 0xe4, 0x07, 0x03, 0x32,
+	0x7e, 0x4d, 0x8a, 0xc3, 0x84, 0x03, 0x0d, 0x3d, 0x09, 0xd7, 0x7e, 0x0f, 0x73, 0x9b, 0xc4, 0xb1,
+	0x12, 0x62, 0xd9, 0xb6, 0x97, 0x0e, 0x55, 0x79, 0x65, 0x3c, 0x78, 0x5b, 0x1a, 0xfb, 0x17, 0x92,
+	0xd5, 0xd6, 0x6e, 0xc0, 0xfc, 0x2a, 0x69, 0x13, 0x4e, 0x4e, 0xab, 0xf8, 0x04, 0xc1, 0x45, 0x11,
+	0xdc, 0x26, 0x61, 0x8c, 0xba, 0x4e, 0x22, 0xc6, 0x33, 0xba, 0x28, 0x57, 0x01, 0x58, 0xc4, 0x6d,
+	0x50, 0x2b, 0x6a, 0xc6, 0x95, 0xa9, 0x60, 0xbf, 0x94, 0x8b, 0x2d, 0xae, 0xea, 0x39, 0x16, 0x1b,
+	0xd7, 0x7e, 0x1c, 0x83, 0x7c, 0xc2, 0x9d, 0x5f, 0xc2, 0x87, 0xa1, 0xf6, 0x4e, 0x9d, 0x45, 0x7b,
+	0xa7, 0x3f, 0xaf, 0xbd, 0x0f, 0x0f, 0xce, 0xcc, 0xa9, 0x07, 0xa7, 0x76, 0x17, 0x26, 0x13, 0xd9,
+	0x64, 0xea, 0x0d, 0xc8, 0xca, 0x38, 0xe3, 0xc6, 0x5c, 0x18, 0x95, 0x4e, 0x89, 0xd7, 0xfb, 0x60,
+	0xed, 0x3b, 0x04, 0xf3, 0x7f, 0xa7, 0x8c, 0x27, 0xd9, 0xce, 0x76, 0xa0, 0x5e, 0x80, 0x8c, 0xeb,
+	0x5b, 0xc4, 0x8f, 0xe7, 0x55, 0xb8, 0x11, 0xa7, 0x6d, 0x6a, 0xd3, 0xa8, 0x0c, 0x53, 0x7a, 0xb4,
+	0x11, 0x6f, 0x92, 0x67, 0x36, 0xa3, 0xdf, 0x9e, 0x53, 0x7a, 0xb8, 0x5e, 0xf9, 0x01, 0xbd, 0x3a,
+	0xc0, 0xe8, 0xf5, 0x01, 0x46, 0x6f, 0x0e, 0xb0, 0xf2, 0xee, 0x00, 0x2b, 0xef, 0x0f, 0xb0, 0xf2,
+	0xe1, 0x00, 0x2b, 0x1f, 0x0f, 0x30, 0x7a, 0x14, 0x60, 0xf4, 0x38, 0xc0, 0xca, 0xb3, 0x00, 0xa3,
+	0xe7, 0x01, 0x56, 0x5e, 0x04, 0x58, 0x79, 0x19, 0x60, 0xe5, 0x55, 0x80, 0xd1, 0xeb, 0x00, 0xa3,
+	0x37, 0x01, 0x56, 0xde, 0x05, 0x18, 0xbd, 0x0f, 0xb0, 0xf2, 0x21, 0xc0, 0xe8, 0x63, 0x80, 0x95,
+	0x47, 0x3d, 0xac, 0x3c, 0xee, 0x61, 0xf4, 0xb4, 0x87, 0x95, 0x6f, 0x7a, 0x18, 0x7d, 0xdf, 0xc3,
+	0xca, 0xb3, 0x1e, 0x56, 0x9e, 0xf7, 0x30, 0x7a, 0xd1, 0xc3, 0xe8, 0x65, 0x0f, 0xa3, 0x7f, 0x5f,
+	0x6d, 0xba, 0x15, 0xbe, 0x43, 0xf8, 0x0e, 0x75, 0x9a, 0xac, 0xe2, 0x10, 0xbe, 0xe7, 0xfa, 0xad,
+	0xea, 0xe1, 0xff, 0x02, 0xbc, 0x56, 0xb3, 0xca, 0xb9, 0xe3, 0x6d, 0x6f, 0x8f, 0x87, 0x45, 0xbb,
+	0xf6, 0xf3, 0x00, 0xb2, 0x4b, 0x03, 0x68, 0xac, 0x11, 0x00, 0x00,
 }
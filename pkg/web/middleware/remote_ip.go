@@ -0,0 +1,33 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"github.com/labstack/echo"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+)
+
+// RemoteIP sets the IP address of the caller in the request context.
+// The X-Forwarded-For header is only used if the request comes from one of the given trusted proxies.
+func RemoteIP(proxies rpcmetadata.TrustedProxies) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ip := proxies.RemoteIP(req.RemoteAddr, req.Header[echo.HeaderXForwardedFor]...)
+			c.SetRequest(req.WithContext(rpcmetadata.NewContextWithRemoteIP(req.Context(), ip)))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestRemoteIP(t *testing.T) {
+	a := assertions.New(t)
+	e := echo.New()
+
+	proxies, err := rpcmetadata.ParseTrustedProxies("10.0.0.0/24")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	var remoteIP string
+	handler := RemoteIP(proxies)(func(c echo.Context) error {
+		remoteIP = rpcmetadata.RemoteIP(c.Request().Context())
+		return nil
+	})
+
+	for _, tc := range []struct {
+		RemoteAddr   string
+		ForwardedFor string
+		RemoteIP     string
+	}{
+		{RemoteAddr: "192.0.2.1:1234", RemoteIP: "192.0.2.1"},
+		{RemoteAddr: "192.0.2.1:1234", ForwardedFor: "198.51.100.1", RemoteIP: "192.0.2.1"},
+		{RemoteAddr: "10.0.0.1:1234", ForwardedFor: "198.51.100.1, 192.0.2.1", RemoteIP: "192.0.2.1"},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = tc.RemoteAddr
+		if tc.ForwardedFor != "" {
+			req.Header.Set(echo.HeaderXForwardedFor, tc.ForwardedFor)
+		}
+		a.So(handler(e.NewContext(req, httptest.NewRecorder())), should.BeNil)
+		a.So(remoteIP, should.Equal, tc.RemoteIP)
+	}
+}
@@ -27,6 +27,9 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/web/cookie"
 	"go.thethings.network/lorawan-stack/pkg/web/middleware"
 )
@@ -47,8 +50,29 @@ type rootGroup struct {
 	*echo.Group
 }
 
+type options struct {
+	rateLimiter ratelimit.Interface
+}
+
+// Option for the web server
+type Option func(*options)
+
+// WithRateLimiter sets the rate limiter
+func WithRateLimiter(limiter ratelimit.Interface) Option {
+	return func(o *options) {
+		o.rateLimiter = limiter
+	}
+}
+
 // New builds a new server.
-func New(ctx context.Context, config config.HTTP) (*Server, error) {
+func New(ctx context.Context, config config.HTTP, opts ...Option) (*Server, error) {
+	options := &options{
+		rateLimiter: ratelimit.NoopRateLimiter,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+
 	logger := log.FromContext(ctx).WithField("namespace", "web")
 
 	hashKey, blockKey := config.Cookie.HashKey, config.Cookie.BlockKey
@@ -76,8 +100,14 @@ func New(ctx context.Context, config config.HTTP) (*Server, error) {
 	server.Logger = &noopLogger{}
 	server.HTTPErrorHandler = ErrorHandler
 
+	trustedProxies, err := rpcmetadata.ParseTrustedProxies(config.TrustedProxies...)
+	if err != nil {
+		return nil, err
+	}
+
 	server.Use(
 		middleware.ID(""),
+		middleware.RemoteIP(trustedProxies),
 		echomiddleware.BodyLimit("16M"),
 		echomiddleware.Secure(),
 		echomiddleware.Recover(),
@@ -90,6 +120,8 @@ func New(ctx context.Context, config config.HTTP) (*Server, error) {
 				"",
 				middleware.Log(logger),
 				middleware.Normalize(middleware.RedirectPermanent),
+				// The calls to the gRPC gateway are rate limited by the gRPC server.
+				ratelimit.EchoMiddleware(options.rateLimiter, "http", ttnpb.HTTPAPIPrefix+"/*"),
 			),
 		},
 		config: config,